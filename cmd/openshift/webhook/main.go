@@ -58,6 +58,7 @@ func main() {
 		webhook.NewDefaultingAdmissionController,
 		webhook.NewValidationAdmissionController,
 		webhook.NewConfigValidationController,
+		webhook.NewNamespaceSCCAdmissionController,
 	)
 }
 
@@ -0,0 +1,53 @@
+package commands
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/openshift-pipelines/pipelines-as-code/pkg/cli"
+	"github.com/spf13/cobra"
+	"github.com/tektoncd/operator/pkg/apis/operator/crdschema"
+)
+
+type crdSchemaOptions struct {
+	root   string
+	verify bool
+}
+
+func CRDSchemaCommand(ioStreams *cli.IOStreams) *cobra.Command {
+	opts := &crdSchemaOptions{}
+	cmd := &cobra.Command{
+		Use:   "crd-schema",
+		Short: "Generate the OpenAPI schemas of the CRD manifests from the types of the operator API",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 0 {
+				return fmt.Errorf("Requires no argument")
+			}
+			return generateCRDSchemas(opts, ioStreams.Out)
+		},
+		Annotations: map[string]string{
+			"commandType": "main",
+		},
+	}
+	cmd.Flags().StringVar(&opts.root, "root", ".", "Root directory of the repository")
+	cmd.Flags().BoolVar(&opts.verify, "verify", false, "Fail when a CRD manifest is outdated instead of updating it")
+	return cmd
+}
+
+func generateCRDSchemas(opts *crdSchemaOptions, out io.Writer) error {
+	outdated, err := crdschema.Update(opts.root, opts.verify)
+	if err != nil {
+		return err
+	}
+	for _, path := range outdated {
+		if opts.verify {
+			fmt.Fprintf(out, "%s is outdated\n", path)
+			continue
+		}
+		fmt.Fprintf(out, "updated %s\n", path)
+	}
+	if opts.verify && len(outdated) > 0 {
+		return fmt.Errorf("%d CRD manifests are outdated, run go run ./cmd/tool crd-schema", len(outdated))
+	}
+	return nil
+}
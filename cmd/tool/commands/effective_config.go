@@ -0,0 +1,85 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/openshift-pipelines/pipelines-as-code/pkg/cli"
+	"github.com/spf13/cobra"
+	clientset "github.com/tektoncd/operator/pkg/client/clientset/versioned"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/effectiveconfig"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/yaml"
+)
+
+type effectiveConfigOptions struct {
+	kubeconfig string
+	platform   string
+	output     string
+}
+
+func EffectiveConfigCommand(ioStreams *cli.IOStreams) *cobra.Command {
+	opts := &effectiveConfigOptions{}
+	cmd := &cobra.Command{
+		Use:   "effective-config <component>",
+		Short: "Dump the effective configuration of a component, one of " + strings.Join(effectiveconfig.Components(), ", "),
+		Long: `Dump as a single YAML document the effective configuration of a component: the section of TektonConfig
+configuring it with the defaults applied, the spec of the component custom resource, and the ConfigMaps and
+container environments rendered into its installer sets.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return fmt.Errorf("Requires the component as only argument")
+			}
+			return exportEffectiveConfig(cmd.Context(), opts, args[0], ioStreams.Out)
+		},
+		Annotations: map[string]string{
+			"commandType": "main",
+		},
+	}
+	cmd.Flags().StringVar(&opts.kubeconfig, "kubeconfig", "", "Path to the kubeconfig of the cluster, the default kubeconfig when empty")
+	cmd.Flags().StringVar(&opts.platform, "platform", "kubernetes", "Platform of the operator applying the TektonConfig defaults, kubernetes or openshift")
+	cmd.Flags().StringVarP(&opts.output, "output", "o", "", "File to write the configuration to, the standard output when empty")
+	return cmd
+}
+
+func exportEffectiveConfig(ctx context.Context, opts *effectiveConfigOptions, component string, out io.Writer) error {
+	if opts.platform != "kubernetes" && opts.platform != "openshift" {
+		return fmt.Errorf("unknown platform %q, expected kubernetes or openshift", opts.platform)
+	}
+	// the defaults of TektonConfig depend on the platform
+	if err := os.Setenv("PLATFORM", opts.platform); err != nil {
+		return err
+	}
+	rules := clientcmd.NewDefaultClientConfigLoadingRules()
+	rules.ExplicitPath = opts.kubeconfig
+	config, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(rules, &clientcmd.ConfigOverrides{}).ClientConfig()
+	if err != nil {
+		return err
+	}
+	operatorClient, err := clientset.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+	snapshot, err := effectiveconfig.Export(ctx, operatorClient, component)
+	if err != nil {
+		return err
+	}
+	data, err := yaml.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+
+	if opts.output != "" {
+		f, err := os.Create(opts.output)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		out = f
+	}
+	_, err = out.Write(data)
+	return err
+}
@@ -0,0 +1,75 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/openshift-pipelines/pipelines-as-code/pkg/cli"
+	security "github.com/openshift/client-go/security/clientset/versioned"
+	"github.com/spf13/cobra"
+	"github.com/tektoncd/operator/pkg/reconciler/common/testing/fixtures"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+type fixturesOptions struct {
+	kubeconfig string
+	output     string
+	fixtures.Options
+}
+
+func FixturesCommand(ioStreams *cli.IOStreams) *cobra.Command {
+	opts := &fixturesOptions{}
+	cmd := &cobra.Command{
+		Use:   "fixtures",
+		Short: "Snapshot the namespaces, SCCs and RBAC of a cluster into fixtures for the fake clients of the tests",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 0 {
+				return fmt.Errorf("Requires no argument")
+			}
+			return snapshotFixtures(cmd.Context(), opts, ioStreams.Out)
+		},
+		Annotations: map[string]string{
+			"commandType": "main",
+		},
+	}
+	cmd.Flags().StringVar(&opts.kubeconfig, "kubeconfig", "", "Path to the kubeconfig of the cluster, the default kubeconfig when empty")
+	cmd.Flags().StringVarP(&opts.output, "output", "o", "", "File to write the fixtures to, the standard output when empty")
+	cmd.Flags().StringVarP(&opts.LabelSelector, "selector", "l", "", "Label selector of the namespaces to snapshot")
+	cmd.Flags().BoolVar(&opts.IncludeSystemNamespaces, "include-system-namespaces", false, "Snapshot the namespaces ignored by the operator")
+	cmd.Flags().BoolVar(&opts.Anonymize, "anonymize", false, "Rename the namespaces and the ServiceAccount users and groups")
+	return cmd
+}
+
+func snapshotFixtures(ctx context.Context, opts *fixturesOptions, out io.Writer) error {
+	rules := clientcmd.NewDefaultClientConfigLoadingRules()
+	rules.ExplicitPath = opts.kubeconfig
+	config, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(rules, &clientcmd.ConfigOverrides{}).ClientConfig()
+	if err != nil {
+		return err
+	}
+	kubeClient, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+	securityClient, err := security.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+	bundle, err := fixtures.Snapshot(ctx, kubeClient, securityClient, opts.Options)
+	if err != nil {
+		return err
+	}
+
+	if opts.output != "" {
+		f, err := os.Create(opts.output)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		out = f
+	}
+	return bundle.Write(out)
+}
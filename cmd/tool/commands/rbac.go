@@ -0,0 +1,85 @@
+package commands
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	mf "github.com/manifestival/manifestival"
+	"github.com/openshift-pipelines/pipelines-as-code/pkg/cli"
+	"github.com/spf13/cobra"
+	"github.com/tektoncd/operator/pkg/reconciler/platform"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/permissions"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+)
+
+type rbacOptions struct {
+	kodata   string
+	platform string
+	name     string
+	output   string
+}
+
+func RBACCommand(ioStreams *cli.IOStreams) *cobra.Command {
+	opts := &rbacOptions{}
+	cmd := &cobra.Command{
+		Use:   "rbac",
+		Short: "Generate the least privilege ClusterRole of the operator from its reconcilers and payload manifests",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 0 {
+				return fmt.Errorf("Requires no argument")
+			}
+			return generateRBAC(opts, ioStreams.Out)
+		},
+		Annotations: map[string]string{
+			"commandType": "main",
+		},
+	}
+	cmd.Flags().StringVar(&opts.kodata, "kodata", "cmd/kubernetes/operator/kodata", "Directory of the payload manifests fetched with make get-releases")
+	cmd.Flags().StringVar(&opts.platform, "platform", "kubernetes", "Platform of the operator, kubernetes or openshift")
+	cmd.Flags().StringVar(&opts.name, "name", "tekton-operator", "Name of the ClusterRole")
+	cmd.Flags().StringVarP(&opts.output, "output", "o", "", "File to write the ClusterRole to, the standard output when empty")
+	return cmd
+}
+
+func generateRBAC(opts *rbacOptions, out io.Writer) error {
+	if opts.platform != "kubernetes" && opts.platform != "openshift" {
+		return fmt.Errorf("unknown platform %q, expected kubernetes or openshift", opts.platform)
+	}
+	manifest, err := mf.ManifestFrom(mf.Recursive(opts.kodata))
+	if err != nil {
+		return fmt.Errorf("failed to read the payload manifests: %w", err)
+	}
+	controllers := make([]platform.ControllerName, 0, len(permissions.ControllerRules))
+	for name := range permissions.ControllerRules {
+		controllers = append(controllers, name)
+	}
+	sort.Slice(controllers, func(i, j int) bool { return controllers[i] < controllers[j] })
+	rules, err := permissions.Rules(opts.platform == "openshift", manifest, controllers...)
+	if err != nil {
+		return err
+	}
+	role, err := runtime.DefaultUnstructuredConverter.ToUnstructured(permissions.ClusterRole(opts.name, rules))
+	if err != nil {
+		return err
+	}
+	unstructured.RemoveNestedField(role, "metadata", "creationTimestamp")
+	data, err := yaml.Marshal(role)
+	if err != nil {
+		return err
+	}
+
+	if opts.output != "" {
+		f, err := os.Create(opts.output)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		out = f
+	}
+	_, err = fmt.Fprintf(out, "# Generated by operator-tool rbac, DO NOT EDIT\n%s", data)
+	return err
+}
@@ -24,6 +24,10 @@ func main() {
 	cmd.AddCommand(commands.BumpCommand(ioStreams))
 	cmd.AddCommand(commands.CheckCommand(ioStreams))
 	cmd.AddCommand(commands.ComponentVersionCommand(ioStreams))
+	cmd.AddCommand(commands.CRDSchemaCommand(ioStreams))
+	cmd.AddCommand(commands.EffectiveConfigCommand(ioStreams))
+	cmd.AddCommand(commands.FixturesCommand(ioStreams))
+	cmd.AddCommand(commands.RBACCommand(ioStreams))
 
 	if err := cmd.Execute(); err != nil {
 		os.Exit(1)
@@ -41,6 +41,9 @@ ${PREFIX}/deepcopy-gen \
   --go-header-file ${REPO_ROOT_DIR}/hack/boilerplate/boilerplate.go.txt \
   github.com/tektoncd/operator/pkg/apis/operator/v1alpha1
 
+# OpenAPI schemas of the CRD manifests, generated from the doc comments and markers of the API types
+go run ${REPO_ROOT_DIR}/cmd/tool crd-schema --root ${REPO_ROOT_DIR}
+
 # Knative Injection
 # This generates the knative inject packages for the operator package (v1alpha1).
 ${REPO_ROOT_DIR}/vendor/knative.dev/pkg/hack/generate-knative.sh "injection" \
@@ -0,0 +1,122 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package crdschema generates the OpenAPI v3 schemas of the CRDs of the operator from the types
+// of its API.
+package crdschema
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// APIDir is the directory of the types of the API, relative to the root of the repository
+const APIDir = "pkg/apis/operator/v1alpha1"
+
+// ManifestPatterns match the CRD manifests, relative to the root of the repository
+var ManifestPatterns = []string{"config/base/300-*_crd.yaml", "config/*/base/300-*_crd.yaml"}
+
+// Schemas returns the schemas of the kinds of the API, generated with the docs of the API in dir
+func Schemas(dir string) (map[string]*apiextensionsv1.JSONSchemaProps, error) {
+	docs, err := ParseDocs(dir)
+	if err != nil {
+		return nil, err
+	}
+	scheme := runtime.NewScheme()
+	if err := v1alpha1.AddToScheme(scheme); err != nil {
+		return nil, err
+	}
+	generator := NewGenerator(docs)
+	schemas := map[string]*apiextensionsv1.JSONSchemaProps{}
+	for kind, t := range scheme.KnownTypes(v1alpha1.SchemeGroupVersion) {
+		if !inModule(t) {
+			continue
+		}
+		// the kinds of the custom resources, without their lists
+		if _, ok := t.FieldByName("ObjectMeta"); !ok {
+			continue
+		}
+		schema, err := generator.CRDSchema(t)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate the schema of %s: %w", kind, err)
+		}
+		schemas[kind] = schema
+	}
+	return schemas, nil
+}
+
+// Manifests returns the CRD manifests of the repository at root
+func Manifests(root string) ([]string, error) {
+	var manifests []string
+	for _, pattern := range ManifestPatterns {
+		matches, err := filepath.Glob(filepath.Join(root, pattern))
+		if err != nil {
+			return nil, err
+		}
+		manifests = append(manifests, matches...)
+	}
+	sort.Strings(manifests)
+	return manifests, nil
+}
+
+// Update writes the generated schemas to the CRD manifests of the repository at root, and returns
+// the manifests which were outdated. With dryRun the manifests are not changed.
+func Update(root string, dryRun bool) ([]string, error) {
+	schemas, err := Schemas(filepath.Join(root, APIDir))
+	if err != nil {
+		return nil, err
+	}
+	manifests, err := Manifests(root)
+	if err != nil {
+		return nil, err
+	}
+	var outdated []string
+	for _, path := range manifests {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		kind, err := CRDKind(data)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		schema, ok := schemas[kind]
+		if !ok {
+			return nil, fmt.Errorf("%s: unknown kind %s", path, kind)
+		}
+		updated, err := ReplaceSchema(data, schema)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		if string(updated) == string(data) {
+			continue
+		}
+		outdated = append(outdated, path)
+		if dryRun {
+			continue
+		}
+		if err := os.WriteFile(path, updated, 0o644); err != nil {
+			return nil, err
+		}
+	}
+	return outdated, nil
+}
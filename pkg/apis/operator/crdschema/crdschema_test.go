@@ -0,0 +1,170 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crdschema
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"gotest.tools/v3/assert"
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/schema/pruning"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/randfill"
+)
+
+const repositoryRoot = "../../../.."
+
+func structural(t *testing.T, schema *apiextensionsv1.JSONSchemaProps) *structuralschema.Structural {
+	t.Helper()
+	internal := &apiextensions.JSONSchemaProps{}
+	assert.NilError(t, apiextensionsv1.Convert_v1_JSONSchemaProps_To_apiextensions_JSONSchemaProps(schema, internal, nil))
+	s, err := structuralschema.NewStructural(internal)
+	assert.NilError(t, err)
+	return s
+}
+
+func TestSchemasAreStructural(t *testing.T) {
+	schemas, err := Schemas(filepath.Join(repositoryRoot, APIDir))
+	assert.NilError(t, err)
+	assert.Assert(t, len(schemas) >= 16)
+	for kind, schema := range schemas {
+		errs := structuralschema.ValidateStructural(nil, structural(t, schema))
+		assert.Equal(t, len(errs), 0, "%s: %v", kind, errs.ToAggregate())
+	}
+}
+
+// TestSchemasKeepFields verifies that the API server does not prune the fields of the custom
+// resources filled with random values
+func TestSchemasKeepFields(t *testing.T) {
+	schemas, err := Schemas(filepath.Join(repositoryRoot, APIDir))
+	assert.NilError(t, err)
+	scheme := runtime.NewScheme()
+	assert.NilError(t, v1alpha1.AddToScheme(scheme))
+	filler := randfill.New().NilChance(0.2).NumElements(1, 2).Funcs(
+		func(m *metav1.ObjectMeta, c randfill.Continue) {
+			*m = metav1.ObjectMeta{Name: "config", Labels: map[string]string{"key": c.String(0)}}
+		},
+		func(q *resource.Quantity, c randfill.Continue) {
+			*q = resource.MustParse("100Mi")
+		},
+		func(i *intstr.IntOrString, c randfill.Continue) {
+			*i = intstr.FromString("25%")
+		},
+		func(u *unstructured.Unstructured, c randfill.Continue) {
+			u.Object = map[string]interface{}{"apiVersion": "v1", "kind": "ConfigMap", "data": map[string]interface{}{"key": c.String(0)}}
+		},
+		func(raw *runtime.RawExtension, c randfill.Continue) {
+			raw.Raw = []byte(`{"key":"value"}`)
+		},
+	)
+
+	for kind, schema := range schemas {
+		s := structural(t, schema)
+		for i := 0; i < 20; i++ {
+			obj, err := scheme.New(v1alpha1.SchemeGroupVersion.WithKind(kind))
+			assert.NilError(t, err)
+			filler.Fill(obj)
+			data, err := json.Marshal(obj)
+			assert.NilError(t, err)
+			var original, pruned map[string]interface{}
+			assert.NilError(t, json.Unmarshal(data, &original))
+			assert.NilError(t, json.Unmarshal(data, &pruned))
+			pruning.Prune(pruned, s, true)
+			assert.DeepEqual(t, pruned, original)
+		}
+	}
+}
+
+func TestManifestsUpToDate(t *testing.T) {
+	outdated, err := Update(repositoryRoot, true)
+	assert.NilError(t, err)
+	assert.Equal(t, len(outdated), 0, "the CRD schemas are outdated, run hack/update-codegen.sh: %v", outdated)
+}
+
+func TestReplaceSchema(t *testing.T) {
+	manifest := `# comment
+spec:
+  versions:
+  - name: v1alpha1
+    schema:
+      openAPIV3Schema:
+        type: object
+        x-kubernetes-preserve-unknown-fields: true
+
+    subresources:
+      status: {}
+`
+	updated, err := ReplaceSchema([]byte(manifest), &apiextensionsv1.JSONSchemaProps{Type: "object", Description: "Schema"})
+	assert.NilError(t, err)
+	assert.Equal(t, string(updated), `# comment
+spec:
+  versions:
+  - name: v1alpha1
+    schema:
+      openAPIV3Schema:
+        description: Schema
+        type: object
+
+    subresources:
+      status: {}
+`)
+	// the schema is replaced in place, replacing it again changes nothing
+	again, err := ReplaceSchema(updated, &apiextensionsv1.JSONSchemaProps{Type: "object", Description: "Schema"})
+	assert.NilError(t, err)
+	assert.Equal(t, string(again), string(updated))
+
+	_, err = ReplaceSchema([]byte("spec: {}\n"), &apiextensionsv1.JSONSchemaProps{})
+	assert.ErrorContains(t, err, "does not have an openAPIV3Schema")
+}
+
+func TestApplyMarkers(t *testing.T) {
+	schema := &apiextensionsv1.JSONSchemaProps{Type: "string"}
+	assert.NilError(t, applyMarkers(schema, []string{
+		"optional",
+		"kubebuilder:validation:Enum=all;basic",
+		"kubebuilder:validation:Pattern=`^[a-z]+$`",
+		"kubebuilder:validation:MaxLength=63",
+		"kubebuilder:default=all",
+		`kubebuilder:validation:XValidation:rule="self != 'lite'",message="lite, is not supported"`,
+	}))
+	assert.DeepEqual(t, schema, &apiextensionsv1.JSONSchemaProps{
+		Type:         "string",
+		Enum:         []apiextensionsv1.JSON{{Raw: []byte(`"all"`)}, {Raw: []byte(`"basic"`)}},
+		Pattern:      "^[a-z]+$",
+		MaxLength:    ptr(int64(63)),
+		Default:      &apiextensionsv1.JSON{Raw: []byte(`"all"`)},
+		XValidations: apiextensionsv1.ValidationRules{{Rule: "self != 'lite'", Message: "lite, is not supported"}},
+	})
+
+	schema = &apiextensionsv1.JSONSchemaProps{Type: "integer"}
+	assert.NilError(t, applyMarkers(schema, []string{"kubebuilder:validation:Minimum=1", "kubebuilder:default=10"}))
+	assert.Equal(t, *schema.Minimum, float64(1))
+	assert.Equal(t, string(schema.Default.Raw), "10")
+
+	assert.ErrorContains(t, applyMarkers(schema, []string{"kubebuilder:validation:Minimum=one"}), "invalid marker")
+	assert.ErrorContains(t, applyMarkers(schema, []string{"kubebuilder:validation:Unique=true"}), "unknown validation Unique")
+	assert.ErrorContains(t, applyMarkers(schema, []string{`kubebuilder:validation:XValidation:message="no rule"`}), "invalid marker")
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crdschema
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Comment is the doc comment of a type or of a field, split into its description and its markers
+type Comment struct {
+	// Description is the text of the comment, without the markers
+	Description string
+	// Markers are the lines of the comment starting with +, without the +
+	Markers []string
+}
+
+// Docs are the comments of the types of a package and of their fields
+type Docs struct {
+	types  map[string]Comment
+	fields map[string]map[string]Comment
+}
+
+// Type returns the comment of the named type
+func (d Docs) Type(name string) Comment {
+	return d.types[name]
+}
+
+// Field returns the comment of the field of the named struct type
+func (d Docs) Field(typeName, fieldName string) Comment {
+	return d.fields[typeName][fieldName]
+}
+
+// ParseDocs reads the doc comments of the types of the Go package in dir and of their fields,
+// the test files are skipped
+func ParseDocs(dir string) (Docs, error) {
+	docs := Docs{types: map[string]Comment{}, fields: map[string]map[string]Comment{}}
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}, parser.ParseComments)
+	if err != nil {
+		return docs, fmt.Errorf("failed to parse the package %s: %w", filepath.Clean(dir), err)
+	}
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				gen, ok := decl.(*ast.GenDecl)
+				if !ok || gen.Tok != token.TYPE {
+					continue
+				}
+				for _, spec := range gen.Specs {
+					typeSpec := spec.(*ast.TypeSpec)
+					// the comment of a single type declaration is attached to the declaration
+					doc := typeSpec.Doc
+					if doc == nil && len(gen.Specs) == 1 {
+						doc = gen.Doc
+					}
+					docs.types[typeSpec.Name.Name] = parseComment(doc)
+					structType, ok := typeSpec.Type.(*ast.StructType)
+					if !ok {
+						continue
+					}
+					fields := map[string]Comment{}
+					for _, field := range structType.Fields.List {
+						for _, name := range field.Names {
+							fields[name.Name] = parseComment(field.Doc)
+						}
+						// the embedded fields are named after their type
+						if len(field.Names) == 0 {
+							if name := embeddedName(field.Type); name != "" {
+								fields[name] = parseComment(field.Doc)
+							}
+						}
+					}
+					docs.fields[typeSpec.Name.Name] = fields
+				}
+			}
+		}
+	}
+	return docs, nil
+}
+
+func embeddedName(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		return t.Name
+	case *ast.StarExpr:
+		return embeddedName(t.X)
+	case *ast.SelectorExpr:
+		return t.Sel.Name
+	}
+	return ""
+}
+
+// parseComment splits a comment into its description and its markers, the lines of the
+// description are joined and its paragraphs are separated by an empty line
+func parseComment(group *ast.CommentGroup) Comment {
+	var comment Comment
+	if group == nil {
+		return comment
+	}
+	var paragraphs []string
+	var lines []string
+	flush := func() {
+		if len(lines) > 0 {
+			paragraphs = append(paragraphs, strings.Join(lines, " "))
+			lines = nil
+		}
+	}
+	for _, line := range strings.Split(group.Text(), "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(line, "+"):
+			comment.Markers = append(comment.Markers, strings.TrimPrefix(line, "+"))
+		case line == "":
+			flush()
+		default:
+			lines = append(lines, line)
+		}
+	}
+	flush()
+	comment.Description = strings.Join(paragraphs, "\n\n")
+	return comment
+}
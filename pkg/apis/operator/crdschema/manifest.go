@@ -0,0 +1,86 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crdschema
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/yaml"
+)
+
+var schemaLine = regexp.MustCompile(`^(\s*)openAPIV3Schema:\s*$`)
+
+// CRDKind returns the kind of the custom resources of a CRD manifest
+func CRDKind(manifest []byte) (string, error) {
+	var crd apiextensionsv1.CustomResourceDefinition
+	if err := yaml.Unmarshal(manifest, &crd); err != nil {
+		return "", err
+	}
+	if crd.Spec.Names.Kind == "" {
+		return "", fmt.Errorf("the manifest is not a CRD")
+	}
+	return crd.Spec.Names.Kind, nil
+}
+
+// ReplaceSchema replaces the openAPIV3Schema of the versions of a CRD manifest with the schema,
+// the rest of the manifest, e.g. its comments, is kept as is
+func ReplaceSchema(manifest []byte, schema *apiextensionsv1.JSONSchemaProps) ([]byte, error) {
+	rendered, err := yaml.Marshal(schema)
+	if err != nil {
+		return nil, err
+	}
+	lines := strings.Split(string(manifest), "\n")
+	var out []string
+	replaced := false
+	for i := 0; i < len(lines); i++ {
+		match := schemaLine.FindStringSubmatch(lines[i])
+		out = append(out, lines[i])
+		if match == nil {
+			continue
+		}
+		indent := match[1]
+		// skip the lines of the current schema, more indented than its key
+		for i+1 < len(lines) && (strings.TrimSpace(lines[i+1]) == "" || strings.HasPrefix(lines[i+1], indent+" ")) {
+			if strings.TrimSpace(lines[i+1]) == "" && !moreIndented(lines[i+2:], indent) {
+				break
+			}
+			i++
+		}
+		for _, line := range strings.Split(strings.TrimSuffix(string(rendered), "\n"), "\n") {
+			out = append(out, indent+"  "+line)
+		}
+		replaced = true
+	}
+	if !replaced {
+		return nil, fmt.Errorf("the manifest does not have an openAPIV3Schema")
+	}
+	return []byte(strings.Join(out, "\n")), nil
+}
+
+// moreIndented returns true when the first non empty line is more indented than indent
+func moreIndented(lines []string, indent string) bool {
+	for _, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		return strings.HasPrefix(line, indent+" ")
+	}
+	return false
+}
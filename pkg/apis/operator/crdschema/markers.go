@@ -0,0 +1,164 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crdschema
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+)
+
+const (
+	validationMarker = "kubebuilder:validation:"
+	defaultMarker    = "kubebuilder:default="
+)
+
+// applyMarkers applies the validation and default markers of a comment to the schema, the
+// markers of the kubebuilder syntax are supported:
+//
+//	+kubebuilder:validation:Enum=a;b
+//	+kubebuilder:validation:Pattern=`^[a-z]+$`
+//	+kubebuilder:validation:Format=date-time
+//	+kubebuilder:validation:Minimum=1
+//	+kubebuilder:validation:Maximum=100
+//	+kubebuilder:validation:MinLength=1
+//	+kubebuilder:validation:MaxLength=63
+//	+kubebuilder:validation:MinItems=1
+//	+kubebuilder:validation:MaxItems=10
+//	+kubebuilder:validation:XValidation:rule="self.a != self.b",message="a and b must differ"
+//	+kubebuilder:default=10
+//
+// The other markers, e.g. +optional, are ignored.
+func applyMarkers(schema *apiextensionsv1.JSONSchemaProps, markers []string) error {
+	for _, marker := range markers {
+		if value, ok := strings.CutPrefix(marker, defaultMarker); ok {
+			raw, err := markerJSON(value, schema.Type)
+			if err != nil {
+				return fmt.Errorf("invalid marker %q: %w", marker, err)
+			}
+			schema.Default = &apiextensionsv1.JSON{Raw: raw}
+			continue
+		}
+		rest, ok := strings.CutPrefix(marker, validationMarker)
+		if !ok {
+			continue
+		}
+		name, value, _ := strings.Cut(rest, "=")
+		if name == "XValidation:rule" {
+			// the rule is the value of the first key of the marker
+			name, value = "XValidation", "rule="+value
+		}
+		if err := applyValidation(schema, name, value); err != nil {
+			return fmt.Errorf("invalid marker %q: %w", marker, err)
+		}
+	}
+	return nil
+}
+
+func applyValidation(schema *apiextensionsv1.JSONSchemaProps, name, value string) error {
+	var err error
+	switch name {
+	case "Enum":
+		for _, item := range strings.Split(value, ";") {
+			raw, err := markerJSON(item, schema.Type)
+			if err != nil {
+				return err
+			}
+			schema.Enum = append(schema.Enum, apiextensionsv1.JSON{Raw: raw})
+		}
+	case "Pattern":
+		schema.Pattern = strings.Trim(value, "`")
+	case "Format":
+		schema.Format = value
+	case "Minimum":
+		var minimum float64
+		minimum, err = strconv.ParseFloat(value, 64)
+		schema.Minimum = &minimum
+	case "Maximum":
+		var maximum float64
+		maximum, err = strconv.ParseFloat(value, 64)
+		schema.Maximum = &maximum
+	case "MinLength":
+		schema.MinLength, err = parseInt(value)
+	case "MaxLength":
+		schema.MaxLength, err = parseInt(value)
+	case "MinItems":
+		schema.MinItems, err = parseInt(value)
+	case "MaxItems":
+		schema.MaxItems, err = parseInt(value)
+	case "XValidation":
+		var rule apiextensionsv1.ValidationRule
+		rule, err = parseValidationRule(value)
+		schema.XValidations = append(schema.XValidations, rule)
+	default:
+		return fmt.Errorf("unknown validation %s", name)
+	}
+	return err
+}
+
+func parseInt(value string) (*int64, error) {
+	i, err := strconv.ParseInt(value, 10, 64)
+	return &i, err
+}
+
+// parseValidationRule parses the rule="...",message="..." arguments of a XValidation marker
+func parseValidationRule(value string) (apiextensionsv1.ValidationRule, error) {
+	var rule apiextensionsv1.ValidationRule
+	for value != "" {
+		key, rest, ok := strings.Cut(value, "=")
+		if !ok {
+			return rule, fmt.Errorf("expected key=value, got %q", value)
+		}
+		quoted, err := strconv.QuotedPrefix(rest)
+		if err != nil {
+			return rule, fmt.Errorf("the value of %s is not a quoted string: %w", key, err)
+		}
+		unquoted, err := strconv.Unquote(quoted)
+		if err != nil {
+			return rule, err
+		}
+		switch key {
+		case "rule":
+			rule.Rule = unquoted
+		case "message":
+			rule.Message = unquoted
+		default:
+			return rule, fmt.Errorf("unknown argument %s", key)
+		}
+		value = strings.TrimPrefix(rest[len(quoted):], ",")
+	}
+	if rule.Rule == "" {
+		return rule, fmt.Errorf("missing rule")
+	}
+	return rule, nil
+}
+
+// markerJSON returns the JSON value of a marker, the values of the string schemas are strings
+// unless they are quoted
+func markerJSON(value, schemaType string) ([]byte, error) {
+	if schemaType == "string" && !strings.HasPrefix(value, `"`) {
+		return json.Marshal(value)
+	}
+	var decoded interface{}
+	if err := json.Unmarshal([]byte(value), &decoded); err != nil {
+		return nil, err
+	}
+	return json.Marshal(decoded)
+}
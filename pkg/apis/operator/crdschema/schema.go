@@ -0,0 +1,280 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crdschema
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// modulePath is the module of the types described in the schemas, the types of the other modules
+// are objects without a schema
+const modulePath = "github.com/tektoncd/operator/"
+
+var (
+	unmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+
+	// wellKnownTypes are the types of the other modules serialized as scalars
+	wellKnownTypes = map[reflect.Type]apiextensionsv1.JSONSchemaProps{
+		reflect.TypeOf(metav1.Duration{}):      {Type: "string"},
+		reflect.TypeOf(metav1.Time{}):          {Type: "string", Format: "date-time"},
+		reflect.TypeOf(resource.Quantity{}):    intOrString(),
+		reflect.TypeOf(intstr.IntOrString{}):   intOrString(),
+		reflect.TypeOf(json.RawMessage{}):      {XPreserveUnknownFields: ptr(true)},
+		reflect.TypeOf(apiextensionsv1.JSON{}): {XPreserveUnknownFields: ptr(true)},
+	}
+)
+
+func intOrString() apiextensionsv1.JSONSchemaProps {
+	return apiextensionsv1.JSONSchemaProps{
+		XIntOrString: true,
+		AnyOf:        []apiextensionsv1.JSONSchemaProps{{Type: "integer"}, {Type: "string"}},
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+// Generator generates the OpenAPI v3 schemas of the CRDs from their Go types. The fields are
+// described by their doc comments and validated by their +kubebuilder markers. The structs of the
+// other modules, e.g. the Kubernetes types embedded in the specs, are objects preserving their
+// fields, they are validated by the components they configure.
+type Generator struct {
+	docs Docs
+	// visiting are the types of the current path, the recursive types are objects preserving
+	// their fields since the structural schemas cannot be recursive
+	visiting map[reflect.Type]bool
+}
+
+// NewGenerator returns a Generator describing the types with the docs of their package
+func NewGenerator(docs Docs) *Generator {
+	return &Generator{docs: docs, visiting: map[reflect.Type]bool{}}
+}
+
+// CRDSchema returns the schema of a custom resource of type t, a struct with Spec and Status
+// fields. The status is written by the operator, it is an object preserving its fields.
+func (g *Generator) CRDSchema(t reflect.Type) (*apiextensionsv1.JSONSchemaProps, error) {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	schema := &apiextensionsv1.JSONSchemaProps{
+		Type:        "object",
+		Description: g.docs.Type(t.Name()).Description,
+		Properties: map[string]apiextensionsv1.JSONSchemaProps{
+			"apiVersion": {
+				Type:        "string",
+				Description: "APIVersion defines the versioned schema of this representation of an object.",
+			},
+			"kind": {
+				Type:        "string",
+				Description: "Kind is a string value representing the REST resource this object represents.",
+			},
+			"metadata": {Type: "object"},
+		},
+	}
+	for _, name := range []string{"Spec", "Status"} {
+		field, ok := t.FieldByName(name)
+		if !ok {
+			continue
+		}
+		jsonName, _ := jsonField(field)
+		if name == "Status" {
+			schema.Properties[jsonName] = apiextensionsv1.JSONSchemaProps{
+				Type:                   "object",
+				Description:            g.fieldDescription(t, field),
+				XPreserveUnknownFields: ptr(true),
+			}
+			continue
+		}
+		fieldSchema, err := g.field(t, field)
+		if err != nil {
+			return nil, fmt.Errorf("%s.%s: %w", t.Name(), name, err)
+		}
+		schema.Properties[jsonName] = fieldSchema
+	}
+	return schema, nil
+}
+
+// field returns the schema of a field of the struct t, with its description and its markers
+func (g *Generator) field(t reflect.Type, field reflect.StructField) (apiextensionsv1.JSONSchemaProps, error) {
+	schema, err := g.schema(field.Type)
+	if err != nil {
+		return schema, err
+	}
+	if description := g.fieldDescription(t, field); description != "" {
+		schema.Description = description
+	}
+	if inModule(t) {
+		if err := applyMarkers(&schema, g.docs.Field(t.Name(), field.Name).Markers); err != nil {
+			return schema, err
+		}
+	}
+	return schema, nil
+}
+
+func (g *Generator) fieldDescription(t reflect.Type, field reflect.StructField) string {
+	if !inModule(t) {
+		return ""
+	}
+	return g.docs.Field(t.Name(), field.Name).Description
+}
+
+// schema returns the schema of the type t, described by the doc comment and validated by the
+// markers of the named types of the module
+func (g *Generator) schema(t reflect.Type) (apiextensionsv1.JSONSchemaProps, error) {
+	if t.Kind() == reflect.Pointer {
+		return g.schema(t.Elem())
+	}
+	if schema, ok := wellKnownTypes[t]; ok {
+		return *schema.DeepCopy(), nil
+	}
+	// the types decoding themselves accept any value, e.g. a string or a boolean
+	if reflect.PointerTo(t).Implements(unmarshalerType) {
+		return apiextensionsv1.JSONSchemaProps{XPreserveUnknownFields: ptr(true)}, nil
+	}
+
+	var schema apiextensionsv1.JSONSchemaProps
+	switch t.Kind() {
+	case reflect.String:
+		schema.Type = "string"
+	case reflect.Bool:
+		schema.Type = "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		schema.Type, schema.Format = "integer", "int32"
+	case reflect.Int64, reflect.Uint64:
+		schema.Type, schema.Format = "integer", "int64"
+	case reflect.Float32, reflect.Float64:
+		schema.Type = "number"
+	case reflect.Interface:
+		schema.XPreserveUnknownFields = ptr(true)
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			schema.Type, schema.Format = "string", "byte"
+			break
+		}
+		items, err := g.schema(t.Elem())
+		if err != nil {
+			return schema, err
+		}
+		schema.Type = "array"
+		schema.Items = &apiextensionsv1.JSONSchemaPropsOrArray{Schema: &items}
+	case reflect.Map:
+		if t.Key().Kind() != reflect.String {
+			return schema, fmt.Errorf("unsupported map key %s", t.Key())
+		}
+		values, err := g.schema(t.Elem())
+		if err != nil {
+			return schema, err
+		}
+		schema.Type = "object"
+		schema.AdditionalProperties = &apiextensionsv1.JSONSchemaPropsOrBool{Allows: true, Schema: &values}
+	case reflect.Struct:
+		if !inModule(t) || g.visiting[t] {
+			return apiextensionsv1.JSONSchemaProps{Type: "object", XPreserveUnknownFields: ptr(true)}, nil
+		}
+		g.visiting[t] = true
+		defer delete(g.visiting, t)
+		schema.Type = "object"
+		if err := g.properties(t, &schema); err != nil {
+			return schema, err
+		}
+	default:
+		return schema, fmt.Errorf("unsupported type %s", t)
+	}
+
+	if inModule(t) && t.Name() != "" {
+		comment := g.docs.Type(t.Name())
+		schema.Description = comment.Description
+		if err := applyMarkers(&schema, comment.Markers); err != nil {
+			return schema, fmt.Errorf("%s: %w", t.Name(), err)
+		}
+	}
+	return schema, nil
+}
+
+// properties adds the fields of the struct t to the properties of the schema, the fields of the
+// embedded structs without a JSON name are inlined
+func (g *Generator) properties(t reflect.Type, schema *apiextensionsv1.JSONSchemaProps) error {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, inline := jsonField(field)
+		if name == "-" || (!field.IsExported() && !field.Anonymous) {
+			continue
+		}
+		if inline {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Pointer {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() != reflect.Struct {
+				return fmt.Errorf("%s.%s: unsupported inline field of type %s", t.Name(), field.Name, embedded)
+			}
+			if !inModule(embedded) {
+				// the fields of the embedded structs of the other modules are not known
+				schema.XPreserveUnknownFields = ptr(true)
+				continue
+			}
+			if err := g.properties(embedded, schema); err != nil {
+				return err
+			}
+			continue
+		}
+		fieldSchema, err := g.field(t, field)
+		if err != nil {
+			return fmt.Errorf("%s.%s: %w", t.Name(), field.Name, err)
+		}
+		if schema.Properties == nil {
+			schema.Properties = map[string]apiextensionsv1.JSONSchemaProps{}
+		}
+		schema.Properties[name] = fieldSchema
+	}
+	return nil
+}
+
+// jsonField returns the JSON name of a struct field, and whether its fields are inlined in the
+// JSON of the struct
+func jsonField(field reflect.StructField) (string, bool) {
+	tag, ok := field.Tag.Lookup("json")
+	name, options, _ := strings.Cut(tag, ",")
+	if name == "-" && options == "" {
+		return "-", false
+	}
+	if field.Anonymous && (!ok || name == "") {
+		return "", true
+	}
+	for _, option := range strings.Split(options, ",") {
+		if option == "inline" {
+			return "", true
+		}
+	}
+	if name == "" {
+		name = field.Name
+	}
+	return name, false
+}
+
+func inModule(t reflect.Type) bool {
+	return strings.HasPrefix(t.PkgPath(), modulePath)
+}
@@ -19,6 +19,7 @@ package v1alpha1
 import (
 	"fmt"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"knative.dev/pkg/apis"
 )
@@ -26,6 +27,7 @@ import (
 var (
 	validatePipelineWebhookConfigurationFailurePolicy = sets.NewString("Ignore", "Fail")
 	validatePipelineWebhookConfigurationSideEffects   = sets.NewString("NoneOnDryRun", "None", "Unknown", "Some")
+	validateWebhookConfigurationReinvocationPolicy    = sets.NewString("Never", "IfNeeded")
 )
 
 func (w *WebhookConfigurationOptions) validate(path string) (errs *apis.FieldError) {
@@ -35,13 +37,26 @@ func (w *WebhookConfigurationOptions) validate(path string) (errs *apis.FieldErr
 	if w.SideEffects != nil && !validatePipelineWebhookConfigurationSideEffects.Has(string(*w.SideEffects)) {
 		errs = errs.Also(apis.ErrInvalidValue(*w.SideEffects, fmt.Sprintf("%s.webhookconfigurationoptions.sideEffects", path)))
 	}
+	if w.ReinvocationPolicy != nil && !validateWebhookConfigurationReinvocationPolicy.Has(string(*w.ReinvocationPolicy)) {
+		errs = errs.Also(apis.ErrInvalidValue(*w.ReinvocationPolicy, fmt.Sprintf("%s.webhookconfigurationoptions.reinvocationPolicy", path)))
+	}
+	if w.NamespaceSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(w.NamespaceSelector); err != nil {
+			errs = errs.Also(apis.ErrInvalidValue(err.Error(), fmt.Sprintf("%s.webhookconfigurationoptions.namespaceSelector", path)))
+		}
+	}
+	if w.ObjectSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(w.ObjectSelector); err != nil {
+			errs = errs.Also(apis.ErrInvalidValue(err.Error(), fmt.Sprintf("%s.webhookconfigurationoptions.objectSelector", path)))
+		}
+	}
 	return errs
 }
 
 func (op *AdditionalOptions) validate(path string) (errs *apis.FieldError) {
 	if op.WebhookConfigurationOptions != nil {
 		for _, webhookConfig := range op.WebhookConfigurationOptions {
-			return webhookConfig.validate(path)
+			errs = errs.Also(webhookConfig.validate(path))
 		}
 	}
 	return errs
@@ -0,0 +1,108 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"regexp"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"knative.dev/pkg/apis"
+)
+
+const (
+	// ChainsOCIRegistryProviderInternal deploys a registry in the target namespace of Chains
+	ChainsOCIRegistryProviderInternal = "internal"
+	// ChainsOCIRegistryProviderOpenShift pushes to the image registry of OpenShift
+	ChainsOCIRegistryProviderOpenShift = "openshift"
+
+	// ChainsRegistryName is the name of the Deployment and of the Service of the internal registry
+	ChainsRegistryName = "tekton-chains-registry"
+	chainsRegistryPort = 5000
+	// openShiftImageRegistryHost is the Service of the image registry of OpenShift
+	openShiftImageRegistryHost = "image-registry.openshift-image-registry.svc:5000"
+
+	defaultChainsRegistryRepository = "chains-artifacts"
+)
+
+var chainsRepositoryRegex = regexp.MustCompile(`^[a-z0-9]+([._-][a-z0-9]+)*(/[a-z0-9]+([._-][a-z0-9]+)*)*$`)
+
+// ChainsOCIRegistry provisions the OCI registry the Chains signatures and attestations are stored in
+type ChainsOCIRegistry struct {
+	// Provider of the registry, internal deploys a registry in the target namespace and openshift
+	// pushes to the image registry of OpenShift
+	Provider string `json:"provider"`
+	// Repository of the artifacts in the registry, chains-artifacts by default. The repositories of
+	// the image registry of OpenShift are in the target namespace.
+	// +optional
+	Repository string `json:"repository,omitempty"`
+	// StorageSize of the PersistentVolumeClaim of the internal registry, the artifacts are stored
+	// in an emptyDir volume and lost on a restart of the registry when it is not set
+	// +optional
+	StorageSize string `json:"storageSize,omitempty"`
+}
+
+// Host returns the host of the registry as it is reached from the cluster
+func (r *ChainsOCIRegistry) Host(targetNamespace string) string {
+	if r.Provider == ChainsOCIRegistryProviderOpenShift {
+		return openShiftImageRegistryHost
+	}
+	return fmt.Sprintf("%s.%s.svc:%d", ChainsRegistryName, targetNamespace, chainsRegistryPort)
+}
+
+// RepositoryURL returns the repository set as storage.oci.repository in the config of Chains
+func (r *ChainsOCIRegistry) RepositoryURL(targetNamespace string) string {
+	repository := r.Repository
+	if repository == "" {
+		repository = defaultChainsRegistryRepository
+	}
+	if r.Provider == ChainsOCIRegistryProviderOpenShift {
+		return fmt.Sprintf("%s/%s/%s", r.Host(targetNamespace), targetNamespace, repository)
+	}
+	return fmt.Sprintf("%s/%s", r.Host(targetNamespace), repository)
+}
+
+// Insecure returns true if the registry serves plain HTTP, the internal registry is only served with
+// TLS on OpenShift, where its certificate is issued by the service CA
+func (r *ChainsOCIRegistry) Insecure() bool {
+	return r.Provider == ChainsOCIRegistryProviderInternal && !IsOpenShiftPlatform()
+}
+
+func (r *ChainsOCIRegistry) validate(path string) (errs *apis.FieldError) {
+	switch r.Provider {
+	case ChainsOCIRegistryProviderInternal:
+	case ChainsOCIRegistryProviderOpenShift:
+		if !IsOpenShiftPlatform() {
+			errs = errs.Also(apis.ErrInvalidValue(r.Provider, path+".provider", "the image registry of OpenShift is only available on OpenShift"))
+		}
+		if r.StorageSize != "" {
+			errs = errs.Also(apis.ErrDisallowedFields(path + ".storageSize"))
+		}
+	default:
+		errs = errs.Also(apis.ErrInvalidValue(r.Provider, path+".provider",
+			fmt.Sprintf("supported providers are %s, %s", ChainsOCIRegistryProviderInternal, ChainsOCIRegistryProviderOpenShift)))
+	}
+	if r.Repository != "" && !chainsRepositoryRegex.MatchString(r.Repository) {
+		errs = errs.Also(apis.ErrInvalidValue(r.Repository, path+".repository"))
+	}
+	if r.StorageSize != "" {
+		if _, err := resource.ParseQuantity(r.StorageSize); err != nil {
+			errs = errs.Also(apis.ErrInvalidValue(r.StorageSize, path+".storageSize", err.Error()))
+		}
+	}
+	return errs
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestChainsOCIRegistryValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		openShift bool
+		registry  ChainsOCIRegistry
+		wantErr   string
+	}{
+		{
+			name:     "internal",
+			registry: ChainsOCIRegistry{Provider: ChainsOCIRegistryProviderInternal, Repository: "signatures/chains", StorageSize: "10Gi"},
+		},
+		{
+			name:     "internal invalid storage size",
+			registry: ChainsOCIRegistry{Provider: ChainsOCIRegistryProviderInternal, StorageSize: "ten"},
+			wantErr:  "invalid value: ten: spec.ociRegistry.storageSize\nquantities must match the regular expression '^([+-]?[0-9.]+)([eEinumkKMGTP]*[-+]?[0-9]*)$'",
+		},
+		{
+			name:     "invalid repository",
+			registry: ChainsOCIRegistry{Provider: ChainsOCIRegistryProviderInternal, Repository: "Chains/"},
+			wantErr:  "invalid value: Chains/: spec.ociRegistry.repository",
+		},
+		{
+			name:      "openshift",
+			openShift: true,
+			registry:  ChainsOCIRegistry{Provider: ChainsOCIRegistryProviderOpenShift},
+		},
+		{
+			name:      "openshift with storage size",
+			openShift: true,
+			registry:  ChainsOCIRegistry{Provider: ChainsOCIRegistryProviderOpenShift, StorageSize: "10Gi"},
+			wantErr:   "must not set the field(s): spec.ociRegistry.storageSize",
+		},
+		{
+			name:     "openshift on kubernetes",
+			registry: ChainsOCIRegistry{Provider: ChainsOCIRegistryProviderOpenShift},
+			wantErr:  "invalid value: openshift: spec.ociRegistry.provider\nthe image registry of OpenShift is only available on OpenShift",
+		},
+		{
+			name:     "unknown provider",
+			registry: ChainsOCIRegistry{Provider: "quay"},
+			wantErr:  "invalid value: quay: spec.ociRegistry.provider\nsupported providers are internal, openshift",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.openShift {
+				t.Setenv("PLATFORM", "openshift")
+			}
+			err := test.registry.validate("spec.ociRegistry")
+			if test.wantErr != "" {
+				assert.Error(t, err, test.wantErr)
+				return
+			}
+			assert.Assert(t, err == nil, err)
+		})
+	}
+}
+
+func TestChainsOCIRegistryRepositoryURL(t *testing.T) {
+	internal := &ChainsOCIRegistry{Provider: ChainsOCIRegistryProviderInternal}
+	assert.Equal(t, internal.RepositoryURL("tekton-chains"), "tekton-chains-registry.tekton-chains.svc:5000/chains-artifacts")
+	assert.Equal(t, internal.Insecure(), true)
+
+	openShift := &ChainsOCIRegistry{Provider: ChainsOCIRegistryProviderOpenShift, Repository: "signatures"}
+	assert.Equal(t, openShift.RepositoryURL("openshift-pipelines"), "image-registry.openshift-image-registry.svc:5000/openshift-pipelines/signatures")
+	assert.Equal(t, openShift.Insecure(), false)
+
+	t.Setenv("PLATFORM", "openshift")
+	assert.Equal(t, internal.Insecure(), false)
+}
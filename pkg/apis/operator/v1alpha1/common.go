@@ -28,6 +28,9 @@ const (
 	// InstallSucceeded is a Condition indiciating that the installation of the component
 	// itself has been successful.
 	InstallSucceeded apis.ConditionType = "InstallSucceeded"
+	// Finalized is a Condition reporting the finalization of a deleted resource, it is Unknown
+	// while the finalization waits on a step and False once the finalization is stuck.
+	Finalized apis.ConditionType = "Finalized"
 )
 
 // TektonComponent is a common interface for accessing meta, spec and status of all known types.
@@ -0,0 +1,45 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+const (
+	// PipelineRunQuotaName is the name of the ResourceQuota limiting the PipelineRuns of a namespace
+	PipelineRunQuotaName = "tekton-pipelineruns"
+	// NamespaceMaxPipelineRunsAnnotation overrides the maximum number of PipelineRuns of a
+	// namespace, 0 removes the limit of the namespace
+	NamespaceMaxPipelineRunsAnnotation = "operator.tekton.dev/max-pipelineruns"
+)
+
+// Concurrency limits the PipelineRuns run at the same time in the namespaces and the fan out of
+// their matrices
+type Concurrency struct {
+	// MaxPipelineRunsPerNamespace is the maximum number of PipelineRuns of a namespace, enforced
+	// by a ResourceQuota on count/pipelineruns.tekton.dev. The PipelineRuns are counted until they
+	// are deleted, the completed PipelineRuns are to be pruned. 0 disables the quotas.
+	// +optional
+	MaxPipelineRunsPerNamespace int64 `json:"maxPipelineRunsPerNamespace,omitempty"`
+	// NamespaceSelector selects the namespaces limited, all the namespaces except the system
+	// namespaces are selected when it is not set
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+	// MaxMatrixCombinations sets default-max-matrix-combinations-count of the pipelines, unless it
+	// is set in the pipeline section
+	// +optional
+	MaxMatrixCombinations *int `json:"maxMatrixCombinations,omitempty"`
+}
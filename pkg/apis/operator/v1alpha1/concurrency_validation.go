@@ -0,0 +1,39 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+)
+
+func (c *Concurrency) validate(path string) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if c.MaxPipelineRunsPerNamespace < 0 {
+		errs = errs.Also(apis.ErrInvalidValue(c.MaxPipelineRunsPerNamespace, path+".maxPipelineRunsPerNamespace", "must not be negative"))
+	}
+	if c.MaxMatrixCombinations != nil && *c.MaxMatrixCombinations < 1 {
+		errs = errs.Also(apis.ErrInvalidValue(*c.MaxMatrixCombinations, path+".maxMatrixCombinations", "must be at least 1"))
+	}
+	if c.NamespaceSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(c.NamespaceSelector); err != nil {
+			errs = errs.Also(apis.ErrInvalidValue(c.NamespaceSelector, path+".namespaceSelector", err.Error()))
+		}
+	}
+	return errs
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+const (
+	// DriftPolicyReport reports the drifted components in the status of TektonConfig and keeps
+	// their drifted fields
+	DriftPolicyReport = "Report"
+	// DriftPolicyRepair reports the drifted components and restores the fields set by TektonConfig
+	DriftPolicyRepair = "Repair"
+)
+
+// DriftDetection configures the periodic comparison of the fields of the components set from
+// TektonConfig, e.g. the pipeline section of TektonPipeline, with their live values, to find
+// the components edited directly
+type DriftDetection struct {
+	// enable the drift detection
+	Enabled bool `json:"enabled"`
+	// How frequent the components are checked, as a duration, 10m by default
+	// +optional
+	Interval string `json:"interval,omitempty"`
+	// What is done with the drifted fields, Report (default) or Repair
+	// +optional
+	Policy string `json:"policy,omitempty"`
+}
+
+// GetPolicy returns the drift policy, Report by default
+func (d *DriftDetection) GetPolicy() string {
+	if d.Policy == "" {
+		return DriftPolicyReport
+	}
+	return d.Policy
+}
+
+// ConfigDriftStatus reports the components whose fields set from TektonConfig were changed directly
+type ConfigDriftStatus struct {
+	// The time of the last check
+	LastCheckTime metav1.Time `json:"lastCheckTime"`
+	// The SHA256 of the fields of the components last found in sync with TektonConfig, by kind,
+	// a component whose fields changed since is drifted
+	// +optional
+	Applied map[string]string `json:"applied,omitempty"`
+	// The drifted components found by the last check
+	// +optional
+	Components []ComponentDrift `json:"components,omitempty"`
+}
+
+// ComponentDrift is a component whose fields set from TektonConfig were changed directly
+type ComponentDrift struct {
+	Kind string `json:"kind"`
+	// The drifted fields, by their path in TektonConfig
+	Fields []string `json:"fields"`
+	// The time the drift was first found
+	DetectedTime metav1.Time `json:"detectedTime"`
+	// Repaired is true when the fields were restored with the Repair policy
+	// +optional
+	Repaired bool `json:"repaired,omitempty"`
+}
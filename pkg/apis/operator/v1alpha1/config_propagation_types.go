@@ -0,0 +1,48 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// ConfigPropagationStatus tracks the propagation of the last spec change of TektonConfig to the
+// components, from the reconcile observing the change until each component is ready with it
+type ConfigPropagationStatus struct {
+	// Generation of the TektonConfig spec being propagated
+	Generation int64 `json:"generation"`
+	// ObservedTime is when the change was observed by the reconciler
+	ObservedTime metav1.Time `json:"observedTime"`
+	// Components holds the propagation by component, for the components installed when the
+	// change was observed or created by it
+	// +optional
+	Components map[string]ComponentPropagationStatus `json:"components,omitempty"`
+}
+
+// ComponentPropagationStatus is the propagation of a TektonConfig spec change to a component
+type ComponentPropagationStatus struct {
+	// BaseGeneration is the generation of the component when the change was observed
+	BaseGeneration int64 `json:"baseGeneration"`
+	// UpdatedTime is when the spec of the component was updated with the change
+	// +optional
+	UpdatedTime *metav1.Time `json:"updatedTime,omitempty"`
+	// ReadyTime is when the component, its ConfigMaps and deployments, were ready with the change
+	// +optional
+	ReadyTime *metav1.Time `json:"readyTime,omitempty"`
+	// LastPropagationDuration is the time from the observation of the last propagated change
+	// until the component was ready with it
+	// +optional
+	LastPropagationDuration *metav1.Duration `json:"lastPropagationDuration,omitempty"`
+}
@@ -42,6 +42,7 @@ const (
 	EnableDevconsoleIntegrationParam = "enable-devconsole-integration"
 
 	LastAppliedHashKey              = "operator.tekton.dev/last-applied-hash"
+	LastAppliedConfigurationKey     = "operator.tekton.dev/last-applied-configuration" // Labels, annotations and spec of a deployment or statefulset last applied, base of the 3-way merge
 	CreatedByKey                    = "operator.tekton.dev/created-by"
 	ReleaseVersionKey               = "operator.tekton.dev/release-version"
 	ComponentKey                    = "operator.tekton.dev/component" // Used in case a component has sub-components eg OpenShiftPipelineAsCode
@@ -54,6 +55,7 @@ const (
 	DeploymentSpecHashValueLabelKey = "operator.tekton.dev/deployment-spec-applied-hash" // used to recreate pods, if there is a change detected in deployments spec
 	PreUpgradeVersionKey            = "operator.tekton.dev/pre-upgrade-version"          // used to monitor and execute pre upgrade functions
 	PostUpgradeVersionKey           = "operator.tekton.dev/post-upgrade-version"         // used to monitor and execute post upgrade functions
+	ManifestsSHA256Key              = "operator.tekton.dev/manifests-sha256"             // SHA256 of the deterministically rendered manifests of an installer set
 
 	UpgradePending = "upgrade pending"
 	Reinstalling   = "reinstalling"
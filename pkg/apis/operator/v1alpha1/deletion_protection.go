@@ -0,0 +1,34 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+const (
+	// DeletionProtectionEnabled blocks the deletion of TektonConfig unless it is confirmed
+	DeletionProtectionEnabled = "enabled"
+	// DeletionProtectionDisabled allows the deletion of TektonConfig
+	DeletionProtectionDisabled = "disabled"
+	// DeletionConfirmationAnnotation set to true on TektonConfig confirms its deletion when
+	// the deletion protection is enabled
+	DeletionConfirmationAnnotation = "operator.tekton.dev/confirm-deletion"
+)
+
+// DeletionBlocked returns true when the deletion protection is enabled and the deletion is
+// not confirmed with the confirmation annotation
+func (tc *TektonConfig) DeletionBlocked() bool {
+	return tc.Spec.DeletionProtection == DeletionProtectionEnabled &&
+		tc.GetAnnotations()[DeletionConfirmationAnnotation] != "true"
+}
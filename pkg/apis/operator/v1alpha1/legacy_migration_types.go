@@ -0,0 +1,45 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// LegacyMigrationStatus reports the removal of the artifacts left by older releases of the
+// operator, the artifacts are removed in batches across the reconciles of TektonConfig
+type LegacyMigrationStatus struct {
+	// Remaining is the number of legacy artifacts detected and not removed yet
+	Remaining int `json:"remaining"`
+	// Removed is the number of legacy artifacts removed by the operator
+	Removed int `json:"removed"`
+	// Migrations holds the migrations having remaining artifacts
+	// +optional
+	Migrations []LegacyMigration `json:"migrations,omitempty"`
+	// Error of the last reconcile of the migrations
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
+// LegacyMigration is a migration of the artifacts of older releases of the operator
+type LegacyMigration struct {
+	// Name of the migration
+	Name string `json:"name"`
+	// Description of the legacy artifacts
+	Description string `json:"description"`
+	// Remaining is the number of legacy artifacts not removed yet
+	Remaining int `json:"remaining"`
+	// Artifacts lists the first remaining artifacts, as <kind> [<namespace>/]<name>
+	// +optional
+	Artifacts []string `json:"artifacts,omitempty"`
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+const (
+	// NamespaceOnboardingAnnotation set to "true" on a namespace requests its onboarding
+	NamespaceOnboardingAnnotation = "operator.tekton.dev/onboard"
+	// DefaultOnboardingServiceAccount is the ServiceAccount created in onboarded namespaces
+	// when none is set
+	DefaultOnboardingServiceAccount = "pipeline"
+	// StarterResourcesAppliedAnnotation is set on the namespaces seeded with the starter
+	// resources, to the name of the ConfigMap they were read from
+	StarterResourcesAppliedAnnotation = "operator.tekton.dev/starter-resources-applied"
+	// StarterResourcesNamespacePlaceholder is replaced with the name of the seeded namespace
+	// in the manifests of the starter resources
+	StarterResourcesNamespacePlaceholder = "$(namespace)"
+)
+
+// NamespaceOnboarding creates a ServiceAccount bound to a set of ClusterRoles in the
+// namespaces annotated with operator.tekton.dev/onboard, on all platforms
+type NamespaceOnboarding struct {
+	// Enable onboards the annotated namespaces
+	// +optional
+	Enable bool `json:"enable,omitempty"`
+	// ServiceAccountName is the ServiceAccount created in the onboarded namespaces,
+	// defaults to pipeline
+	// +optional
+	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+	// RoleBindings bind ClusterRoles to the ServiceAccount in the onboarded namespaces
+	// +optional
+	RoleBindings []OnboardingRoleBinding `json:"roleBindings,omitempty"`
+	// StarterResources seeds the onboarded namespaces with starter Tekton resources
+	// +optional
+	StarterResources *StarterResources `json:"starterResources,omitempty"`
+}
+
+// StarterResources are namespaced resources created once in the onboarded namespaces, such as a
+// default Pipeline, a Pipelines-as-Code Repository or the RBAC of a trigger. They are not updated
+// nor removed afterwards, the namespace owns them.
+type StarterResources struct {
+	// ConfigMap is the ConfigMap in the target namespace holding the manifests of the resources,
+	// every key holds one or more YAML documents
+	ConfigMap string `json:"configMap"`
+	// NamespaceSelector selects the onboarded namespaces seeded with the resources, all the
+	// onboarded namespaces are seeded when it is not set
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+}
+
+// OnboardingRoleBinding is a RoleBinding created in the onboarded namespaces
+type OnboardingRoleBinding struct {
+	// Name of the RoleBinding
+	Name string `json:"name"`
+	// ClusterRole bound to the ServiceAccount
+	ClusterRole string `json:"clusterRole"`
+}
+
+// GetServiceAccountName returns the ServiceAccount created in the onboarded namespaces
+func (o *NamespaceOnboarding) GetServiceAccountName() string {
+	if o.ServiceAccountName == "" {
+		return DefaultOnboardingServiceAccount
+	}
+	return o.ServiceAccountName
+}
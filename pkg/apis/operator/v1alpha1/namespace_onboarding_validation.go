@@ -0,0 +1,65 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"knative.dev/pkg/apis"
+)
+
+func (o *NamespaceOnboarding) validate(path string) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if o.ServiceAccountName != "" {
+		if msgs := validation.IsDNS1123Subdomain(o.ServiceAccountName); len(msgs) > 0 {
+			errs = errs.Also(apis.ErrInvalidValue(o.ServiceAccountName, fmt.Sprintf("%s.serviceAccountName", path), msgs...))
+		}
+	}
+
+	seen := map[string]bool{}
+	for i, rb := range o.RoleBindings {
+		rbPath := fmt.Sprintf("%s.roleBindings[%d]", path, i)
+		if rb.Name == "" {
+			errs = errs.Also(apis.ErrMissingField(rbPath + ".name"))
+		} else if msgs := validation.IsDNS1123Subdomain(rb.Name); len(msgs) > 0 {
+			errs = errs.Also(apis.ErrInvalidValue(rb.Name, rbPath+".name", msgs...))
+		} else if seen[rb.Name] {
+			errs = errs.Also(apis.ErrGeneric(fmt.Sprintf("roleBinding %s is set more than once", rb.Name), rbPath+".name"))
+		}
+		seen[rb.Name] = true
+		if rb.ClusterRole == "" {
+			errs = errs.Also(apis.ErrMissingField(rbPath + ".clusterRole"))
+		}
+	}
+
+	if sr := o.StarterResources; sr != nil {
+		if sr.ConfigMap == "" {
+			errs = errs.Also(apis.ErrMissingField(path + ".starterResources.configMap"))
+		} else if msgs := validation.IsDNS1123Subdomain(sr.ConfigMap); len(msgs) > 0 {
+			errs = errs.Also(apis.ErrInvalidValue(sr.ConfigMap, path+".starterResources.configMap", msgs...))
+		}
+		if sr.NamespaceSelector != nil {
+			if _, err := metav1.LabelSelectorAsSelector(sr.NamespaceSelector); err != nil {
+				errs = errs.Also(apis.ErrInvalidValue(sr.NamespaceSelector, path+".starterResources.namespaceSelector", err.Error()))
+			}
+		}
+	}
+	return errs
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+const (
+	// NotificationSinkSlack posts the notifications to a Slack incoming webhook
+	NotificationSinkSlack = "slack"
+	// NotificationSinkWebhook posts the notifications as JSON to an HTTP endpoint
+	NotificationSinkWebhook = "webhook"
+	// NotificationSinkEvents records the notifications as Kubernetes Events of TektonConfig
+	NotificationSinkEvents = "events"
+
+	// NotificationInstalled is sent when the installation is ready for the first time
+	NotificationInstalled = "installed"
+	// NotificationUpgraded is sent when the installation is ready after an upgrade of the operator
+	NotificationUpgraded = "upgraded"
+	// NotificationDegraded is sent when the installation is no longer ready
+	NotificationDegraded = "degraded"
+	// NotificationRecovered is sent when a degraded installation is ready again
+	NotificationRecovered = "recovered"
+)
+
+// Notifications configures the sinks notified of the lifecycle events of the installation
+type Notifications struct {
+	// Sinks notified of the events
+	// +optional
+	Sinks []NotificationSink `json:"sinks,omitempty"`
+}
+
+// NotificationSink is a destination of the notifications
+type NotificationSink struct {
+	// Name of the sink
+	Name string `json:"name"`
+	// Type of the sink, slack, webhook or events
+	Type string `json:"type"`
+	// URL the notifications are posted to, for the slack and webhook sinks
+	// +optional
+	URL string `json:"url,omitempty"`
+	// SecretName is a Secret of the operator namespace holding the URL in its url key, for the
+	// slack and webhook sinks whose URL holds a token
+	// +optional
+	SecretName string `json:"secretName,omitempty"`
+	// Events routed to the sink, installed, upgraded, degraded or recovered, all the events
+	// when it is not set
+	// +optional
+	Events []string `json:"events,omitempty"`
+}
+
+// Routes returns true when the event is routed to the sink
+func (s *NotificationSink) Routes(event string) bool {
+	if len(s.Events) == 0 {
+		return true
+	}
+	for _, e := range s.Events {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+// NotificationsStatus is the state of the installation last notified
+type NotificationsStatus struct {
+	// ReadyVersion is the version of the operator the installation was last ready with
+	// +optional
+	ReadyVersion string `json:"readyVersion,omitempty"`
+	// Degraded is true when the installation was notified as degraded and did not recover yet
+	// +optional
+	Degraded bool `json:"degraded,omitempty"`
+}
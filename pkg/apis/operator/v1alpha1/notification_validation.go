@@ -0,0 +1,84 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"net/url"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"knative.dev/pkg/apis"
+)
+
+var (
+	notificationSinkTypes = sets.NewString(NotificationSinkSlack, NotificationSinkWebhook, NotificationSinkEvents)
+	notificationEvents    = sets.NewString(NotificationInstalled, NotificationUpgraded, NotificationDegraded, NotificationRecovered)
+)
+
+func (n *Notifications) validate(path string) *apis.FieldError {
+	var errs *apis.FieldError
+	names := sets.NewString()
+	for i, sink := range n.Sinks {
+		errs = errs.Also(sink.validate(fmt.Sprintf("%s.sinks[%d]", path, i)))
+		if sink.Name != "" && names.Has(sink.Name) {
+			errs = errs.Also(apis.ErrGeneric(fmt.Sprintf("duplicate sink name %q", sink.Name), fmt.Sprintf("%s.sinks[%d].name", path, i)))
+		}
+		names.Insert(sink.Name)
+	}
+	return errs
+}
+
+func (s *NotificationSink) validate(path string) *apis.FieldError {
+	var errs *apis.FieldError
+	if s.Name == "" {
+		errs = errs.Also(apis.ErrMissingField(path + ".name"))
+	}
+	if !notificationSinkTypes.Has(s.Type) {
+		errs = errs.Also(apis.ErrInvalidValue(s.Type, path+".type",
+			fmt.Sprintf("must be one of %v", notificationSinkTypes.List())))
+	}
+	switch s.Type {
+	case NotificationSinkSlack, NotificationSinkWebhook:
+		if s.URL == "" && s.SecretName == "" {
+			errs = errs.Also(apis.ErrMissingOneOf(path+".url", path+".secretName"))
+		} else if s.URL != "" && s.SecretName != "" {
+			errs = errs.Also(apis.ErrMultipleOneOf(path+".url", path+".secretName"))
+		}
+	case NotificationSinkEvents:
+		if s.URL != "" || s.SecretName != "" {
+			errs = errs.Also(apis.ErrGeneric(fmt.Sprintf("url and secretName are not supported by the %s sink", NotificationSinkEvents), path))
+		}
+	}
+	if s.URL != "" {
+		if u, err := url.Parse(s.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			errs = errs.Also(apis.ErrInvalidValue(s.URL, path+".url", "must be an http or https URL"))
+		}
+	}
+	if s.SecretName != "" {
+		if msgs := validation.IsDNS1123Subdomain(s.SecretName); len(msgs) > 0 {
+			errs = errs.Also(apis.ErrInvalidValue(s.SecretName, path+".secretName", msgs...))
+		}
+	}
+	for j, e := range s.Events {
+		if !notificationEvents.Has(e) {
+			errs = errs.Also(apis.ErrInvalidValue(e, fmt.Sprintf("%s.events[%d]", path, j),
+				fmt.Sprintf("must be one of %v", notificationEvents.List())))
+		}
+	}
+	return errs
+}
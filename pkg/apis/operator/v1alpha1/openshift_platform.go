@@ -16,6 +16,8 @@ limitations under the License.
 
 package v1alpha1
 
+import corev1 "k8s.io/api/core/v1"
+
 type OpenShift struct {
 	// PipelinesAsCode allows configuring PipelinesAsCode configurations
 	// +optional
@@ -23,6 +25,18 @@ type OpenShift struct {
 	// SCC allows configuring security context constraints used by workloads
 	// +optional
 	SCC *SCC `json:"scc,omitempty"`
+	// EditRoleBinding allows configuring the openshift-pipelines-edit RoleBinding
+	// created in the namespaces reconciled by the operator
+	// +optional
+	EditRoleBinding *EditRoleBinding `json:"editRoleBinding,omitempty"`
+	// RBAC allows configuring the ServiceAccount created in the namespaces
+	// reconciled by the operator
+	// +optional
+	RBAC *RBAC `json:"rbac,omitempty"`
+	// CABundles allows configuring the CA bundle ConfigMaps created in the
+	// namespaces reconciled by the operator
+	// +optional
+	CABundles *CABundles `json:"caBundles,omitempty"`
 }
 
 type PipelinesAsCode struct {
@@ -44,4 +58,108 @@ type SCC struct {
 	// namespace or in the Default field.
 	// +optional
 	MaxAllowed string `json:"maxAllowed,omitempty"`
+	// ServiceAccounts grants SCCs to additional ServiceAccounts, next to the
+	// `pipeline` SA, in the namespaces reconciled by the operator
+	// +optional
+	ServiceAccounts []SCCServiceAccount `json:"serviceAccounts,omitempty"`
+}
+
+// SCCServiceAccount grants an SCC to a ServiceAccount
+type SCCServiceAccount struct {
+	// Name of the ServiceAccount, the ServiceAccount itself is not created
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+	Name string `json:"name"`
+	// SCC granted to the ServiceAccount, it cannot be less restrictive than
+	// the MaxAllowed SCC
+	// +kubebuilder:validation:MinLength=1
+	SCC string `json:"scc"`
+	// Namespaces limits the grant to the listed namespaces, by default the SCC
+	// is granted in all the namespaces reconciled by the operator
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+}
+
+// EditRoleBinding configures the openshift-pipelines-edit RoleBinding
+type EditRoleBinding struct {
+	// Subjects are bound to the edit ClusterRole in every reconciled namespace, next
+	// to the `pipeline` SA. A namespace can override them with the
+	// `operator.tekton.dev/edit-subjects` annotation.
+	// +optional
+	Subjects []EditRoleBindingSubject `json:"subjects,omitempty"`
+}
+
+// EditRoleBindingSubject is a group or a user bound to the edit ClusterRole
+type EditRoleBindingSubject struct {
+	// Kind of the subject, one of Group or User
+	// +kubebuilder:validation:Enum=Group;User
+	Kind string `json:"kind"`
+	// Name of the group or of the user
+	Name string `json:"name"`
+}
+
+// RBAC configures the ServiceAccount created in the namespaces reconciled by the operator
+type RBAC struct {
+	// ServiceAccountName is the name of the ServiceAccount created in every
+	// reconciled namespace and bound to the SCC, edit and clusterinterceptors
+	// roles, `pipeline` by default
+	// +optional
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+	// AdditionalServiceAccounts are bound, next to the ServiceAccount created by the operator, to
+	// the SCC and edit roles in every reconciled namespace, the ServiceAccounts themselves are
+	// not created
+	// +optional
+	AdditionalServiceAccounts []string `json:"additionalServiceAccounts,omitempty"`
+	// PipelineClusterRole is the ClusterRole bound to the ServiceAccount by the edit RoleBinding
+	// in every reconciled namespace, `edit` by default. A narrower ClusterRole, e.g. without
+	// access to the secrets, can be used instead.
+	// +optional
+	PipelineClusterRole string `json:"pipelineClusterRole,omitempty"`
+	// CleanupOnDelete deletes the RBAC resources and CA bundle ConfigMaps created in the
+	// reconciled namespaces when the TektonConfig is deleted, true by default. When false
+	// the resources are kept, without the owner references which would garbage collect them.
+	// +optional
+	// +kubebuilder:default=true
+	CleanupOnDelete *bool `json:"cleanupOnDelete,omitempty"`
+}
+
+// CABundles configures the CA bundle ConfigMaps created in the namespaces reconciled by the operator
+// +kubebuilder:validation:XValidation:rule="!has(self.trusted) || !has(self.service) || !has(self.trusted.name) || !has(self.service.name) || self.trusted.name != self.service.name",message="the trusted and service CA bundle ConfigMaps must have different names"
+type CABundles struct {
+	// Trusted configures the ConfigMap of the trusted CA certificates of the cluster,
+	// `config-trusted-cabundle` by default
+	// +optional
+	Trusted CABundleConfigMap `json:"trusted,omitempty"`
+	// Service configures the ConfigMap of the service serving certificates,
+	// `config-service-cabundle` by default
+	// +optional
+	Service CABundleConfigMap `json:"service,omitempty"`
+	// Additional are PEM encoded certificates of the operator namespace appended to the
+	// trusted CA certificates of every reconciled namespace
+	// +optional
+	Additional []CABundleSource `json:"additional,omitempty"`
+}
+
+// CABundleConfigMap configures a CA bundle ConfigMap created in the namespaces
+type CABundleConfigMap struct {
+	// Name of the ConfigMap, the ConfigMaps created with a previous name are kept
+	// +optional
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+	Name string `json:"name,omitempty"`
+	// Disabled stops creating the ConfigMap, the existing ConfigMaps are kept
+	// +optional
+	Disabled bool `json:"disabled,omitempty"`
+}
+
+// CABundleSource is a key of a ConfigMap or of a Secret of the operator namespace holding PEM
+// encoded certificates, one of ConfigMap or Secret is set
+// +kubebuilder:validation:XValidation:rule="has(self.configMap) != has(self.secret)",message="exactly one of configMap or secret must be set"
+type CABundleSource struct {
+	// +optional
+	ConfigMap *corev1.ConfigMapKeySelector `json:"configMap,omitempty"`
+	// +optional
+	Secret *corev1.SecretKeySelector `json:"secret,omitempty"`
 }
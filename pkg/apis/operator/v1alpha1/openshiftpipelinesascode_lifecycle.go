@@ -144,3 +144,16 @@ func (pac *OpenShiftPipelinesAsCodeStatus) GetVersion() string {
 func (pac *OpenShiftPipelinesAsCodeStatus) SetVersion(version string) {
 	pac.Version = version
 }
+
+// MarkCredentialsVerified marks the CredentialsVerified status as true.
+func (pac *OpenShiftPipelinesAsCodeStatus) MarkCredentialsVerified() {
+	opacCondSet.Manage(pac).MarkTrue(CredentialsVerified)
+}
+
+// MarkCredentialsNotVerified marks the CredentialsVerified status as false with the given message.
+func (pac *OpenShiftPipelinesAsCodeStatus) MarkCredentialsNotVerified(msg string) {
+	opacCondSet.Manage(pac).MarkFalse(
+		CredentialsVerified,
+		"Error",
+		"Credentials not verified: %s", msg)
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+const (
+	// PayloadGroupKey groups the installer sets of a payload installed by a blue/green switchover,
+	// on the main installer sets it holds the group the next version is staged in
+	PayloadGroupKey = "operator.tekton.dev/payload-group"
+	// PayloadStagingNamespaceKey holds the staging namespace on the main installer sets while the
+	// switchover is enabled, the next version of the component is staged in it instead of
+	// upgrading the main installer sets in place
+	PayloadStagingNamespaceKey = "operator.tekton.dev/payload-staging-namespace"
+	// PayloadSwitchedKey marks the staged installer sets the webhooks are switched to, the
+	// components then replace their main installer sets
+	PayloadSwitchedKey = "operator.tekton.dev/payload-switched"
+	// WebhookNamespaceKey holds the namespace of the services the webhook configurations of the
+	// main installer sets point to, when they are served by a staged payload
+	WebhookNamespaceKey = "operator.tekton.dev/webhook-namespace"
+
+	PayloadGroupBlue  = "blue"
+	PayloadGroupGreen = "green"
+
+	// phases of a blue/green payload switchover
+	SwitchoverPhaseStaging   = "Staging"
+	SwitchoverPhaseVerified  = "Verified"
+	SwitchoverPhaseSwitched  = "Switched"
+	SwitchoverPhaseCompleted = "Completed"
+)
+
+// PayloadSwitchover allows installing a new payload version alongside the current one
+// in a staging namespace, verifying it, and then switching the services and webhooks
+// over to it
+type PayloadSwitchover struct {
+	// Enable stages the payload of the next operator version in the staging namespace
+	// +optional
+	Enable bool `json:"enable,omitempty"`
+	// StagingNamespace is the namespace in which the new payload is installed
+	// +optional
+	StagingNamespace string `json:"stagingNamespace,omitempty"`
+	// Switch approves the switchover, once the staged payload is verified the
+	// webhooks are pointed at it and the previously staged payload is removed
+	// +optional
+	Switch bool `json:"switch,omitempty"`
+}
+
+// PayloadSwitchoverStatus holds the observed state of a blue/green payload switchover
+type PayloadSwitchoverStatus struct {
+	// Phase is the current phase of the switchover
+	// +optional
+	Phase string `json:"phase,omitempty"`
+	// ActiveGroup is the payload group the webhooks point to, empty if the
+	// webhooks point to the payload in the target namespace
+	// +optional
+	ActiveGroup string `json:"activeGroup,omitempty"`
+	// ActiveNamespace is the namespace of the services the webhooks point to
+	// +optional
+	ActiveNamespace string `json:"activeNamespace,omitempty"`
+	// StagedGroup is the payload group installed in the staging namespace
+	// +optional
+	StagedGroup string `json:"stagedGroup,omitempty"`
+	// StagedVersion is the operator version of the staged payload
+	// +optional
+	StagedVersion string `json:"stagedVersion,omitempty"`
+	// Message holds details about the current phase
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// OtherPayloadGroup returns the group which is not the given one
+func OtherPayloadGroup(group string) string {
+	if group == PayloadGroupBlue {
+		return PayloadGroupGreen
+	}
+	return PayloadGroupBlue
+}
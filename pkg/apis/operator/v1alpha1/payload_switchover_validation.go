@@ -0,0 +1,48 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+	"knative.dev/pkg/apis"
+)
+
+func (ps *PayloadSwitchover) validate(targetNamespace, path string) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if ps.Switch && !ps.Enable {
+		errs = errs.Also(apis.ErrGeneric("switch requires the payload switchover to be enabled", fmt.Sprintf("%s.switch", path)))
+	}
+
+	if !ps.Enable {
+		return errs
+	}
+
+	nsPath := fmt.Sprintf("%s.stagingNamespace", path)
+	if ps.StagingNamespace == "" {
+		return errs.Also(apis.ErrMissingField(nsPath))
+	}
+	if msgs := validation.IsDNS1123Label(ps.StagingNamespace); len(msgs) > 0 {
+		errs = errs.Also(apis.ErrInvalidValue(ps.StagingNamespace, nsPath, msgs...))
+	}
+	if ps.StagingNamespace == targetNamespace {
+		errs = errs.Also(apis.ErrInvalidValue(ps.StagingNamespace, nsPath, "staging namespace must be different from the target namespace"))
+	}
+	return errs
+}
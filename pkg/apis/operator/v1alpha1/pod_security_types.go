@@ -0,0 +1,83 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// PodSecurityEnforceLabel is the label of a namespace setting the Pod Security Admission level
+	// enforced on its pods
+	PodSecurityEnforceLabel = "pod-security.kubernetes.io/enforce"
+	// PodSecurityLevelPrivileged is the Pod Security Admission level without restrictions, the
+	// level of a namespace without the enforce label is privileged unless the cluster defaults to another level
+	PodSecurityLevelPrivileged = "privileged"
+	// PodSecurityLevelBaseline is the Pod Security Admission level preventing known privilege escalations
+	PodSecurityLevelBaseline = "baseline"
+	// PodSecurityLevelRestricted is the Pod Security Admission level following the pod hardening best practices
+	PodSecurityLevelRestricted = "restricted"
+)
+
+// PodSecurityDefaults holds the seccomp and AppArmor profiles set on the pods of the components
+// and in the default pod template of the TaskRuns and PipelineRuns
+type PodSecurityDefaults struct {
+	// SeccompProfile set in the security context of the pods
+	// +optional
+	SeccompProfile *corev1.SeccompProfile `json:"seccompProfile,omitempty"`
+	// AppArmorProfile of the containers, set in the container.apparmor.security.beta.kubernetes.io
+	// annotations of the pods of the components and in the security context of the default pod template
+	// +optional
+	AppArmorProfile *corev1.AppArmorProfile `json:"appArmorProfile,omitempty"`
+}
+
+// AppArmorAnnotationValue returns the value of the container.apparmor.security.beta.kubernetes.io
+// annotations of the AppArmor profile
+func (p *PodSecurityDefaults) AppArmorAnnotationValue() string {
+	if p == nil || p.AppArmorProfile == nil {
+		return ""
+	}
+	switch p.AppArmorProfile.Type {
+	case corev1.AppArmorProfileTypeLocalhost:
+		if p.AppArmorProfile.LocalhostProfile == nil {
+			return ""
+		}
+		return corev1.DeprecatedAppArmorBetaProfileNamePrefix + *p.AppArmorProfile.LocalhostProfile
+	case corev1.AppArmorProfileTypeUnconfined:
+		return corev1.DeprecatedAppArmorBetaProfileNameUnconfined
+	default:
+		return corev1.DeprecatedAppArmorBetaProfileRuntimeDefault
+	}
+}
+
+// Violations returns the profiles not allowed by the Pod Security Admission level, the baseline
+// and restricted levels forbid unconfined profiles
+func (p *PodSecurityDefaults) Violations(level string) []string {
+	if p == nil || (level != PodSecurityLevelBaseline && level != PodSecurityLevelRestricted) {
+		return nil
+	}
+	var violations []string
+	if p.SeccompProfile != nil && p.SeccompProfile.Type == corev1.SeccompProfileTypeUnconfined {
+		violations = append(violations, fmt.Sprintf("seccompProfile %s is not allowed by the %s level", corev1.SeccompProfileTypeUnconfined, level))
+	}
+	if p.AppArmorProfile != nil && p.AppArmorProfile.Type == corev1.AppArmorProfileTypeUnconfined {
+		violations = append(violations, fmt.Sprintf("appArmorProfile %s is not allowed by the %s level", corev1.AppArmorProfileTypeUnconfined, level))
+	}
+	return violations
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"knative.dev/pkg/apis"
+)
+
+func (p *PodSecurityDefaults) validate(path string) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if s := p.SeccompProfile; s != nil {
+		errs = errs.Also(validateProfile(string(s.Type), s.LocalhostProfile, path+".seccompProfile",
+			string(corev1.SeccompProfileTypeRuntimeDefault), string(corev1.SeccompProfileTypeLocalhost), string(corev1.SeccompProfileTypeUnconfined)))
+	}
+	if a := p.AppArmorProfile; a != nil {
+		errs = errs.Also(validateProfile(string(a.Type), a.LocalhostProfile, path+".appArmorProfile",
+			string(corev1.AppArmorProfileTypeRuntimeDefault), string(corev1.AppArmorProfileTypeLocalhost), string(corev1.AppArmorProfileTypeUnconfined)))
+	}
+	return errs
+}
+
+// validateProfile checks the type of a seccomp or AppArmor profile, the localhost profile is
+// required by the Localhost type and not allowed with the other types
+func validateProfile(profileType string, localhostProfile *string, path, runtimeDefault, localhost, unconfined string) *apis.FieldError {
+	switch profileType {
+	case runtimeDefault, unconfined:
+		if localhostProfile != nil {
+			return apis.ErrGeneric(fmt.Sprintf("localhostProfile is only supported with the %s type", localhost), path+".localhostProfile")
+		}
+	case localhost:
+		if localhostProfile == nil || *localhostProfile == "" {
+			return apis.ErrMissingField(path + ".localhostProfile")
+		}
+	default:
+		return apis.ErrInvalidValue(profileType, path+".type", fmt.Sprintf("must be %s, %s or %s", runtimeDefault, localhost, unconfined))
+	}
+	return nil
+}
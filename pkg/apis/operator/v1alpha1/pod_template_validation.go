@@ -0,0 +1,42 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/pod"
+	"knative.dev/pkg/apis"
+	"sigs.k8s.io/yaml"
+)
+
+// validatePodTemplates rejects the default pod templates which are not valid YAML or do not match
+// the schema of the pod templates of Tekton, as the templates are rendered into the config-defaults
+// ConfigMap and a malformed template only fails the creation of the TaskRun pods
+func (p *OptionalPipelineProperties) validatePodTemplates(path string) (errs *apis.FieldError) {
+	if p.DefaultPodTemplate != "" {
+		if err := yaml.UnmarshalStrict([]byte(p.DefaultPodTemplate), &pod.Template{}); err != nil {
+			errs = errs.Also(apis.ErrGeneric(fmt.Sprintf("invalid pod template: %v", err), path+".default-pod-template"))
+		}
+	}
+	if p.DefaultAffinityAssistantPodTemplate != "" {
+		if err := yaml.UnmarshalStrict([]byte(p.DefaultAffinityAssistantPodTemplate), &pod.AffinityAssistantTemplate{}); err != nil {
+			errs = errs.Also(apis.ErrGeneric(fmt.Sprintf("invalid affinity assistant pod template: %v", err), path+".default-affinity-assistant-pod-template"))
+		}
+	}
+	return errs
+}
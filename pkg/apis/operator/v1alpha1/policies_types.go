@@ -0,0 +1,116 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Policies generates the ValidatingAdmissionPolicies enforcing common guardrails on the Tekton
+// resources with CEL, without an external policy engine
+type Policies struct {
+	// ForbidLatestImages rejects the TaskRuns whose inline steps or sidecars use an image with
+	// the latest tag or without a tag
+	// +optional
+	ForbidLatestImages bool `json:"forbidLatestImages,omitempty"`
+	// RequiredPipelineRunLabels are the labels the PipelineRuns are created with
+	// +optional
+	RequiredPipelineRunLabels []string `json:"requiredPipelineRunLabels,omitempty"`
+	// ValidationActions of the policy bindings, Deny, Warn or Audit, defaults to Deny
+	// +optional
+	ValidationActions []admissionregistrationv1.ValidationAction `json:"validationActions,omitempty"`
+	// NamespaceSelector selects the namespaces the policies apply to, all the namespaces are
+	// selected when it is not set
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+	// Bundle installs a curated bundle of policies enforcing the Tekton best practices with
+	// Gatekeeper or Kyverno
+	// +optional
+	Bundle *PolicyBundle `json:"bundle,omitempty"`
+}
+
+const (
+	// PolicyEngineAuto selects the policy engine installed on the cluster
+	PolicyEngineAuto       = "auto"
+	PolicyEngineGatekeeper = "gatekeeper"
+	PolicyEngineKyverno    = "kyverno"
+
+	// PolicyBundleModeAudit reports the violations without rejecting the resources
+	PolicyBundleModeAudit = "audit"
+	// PolicyBundleModeEnforce rejects the resources violating the policies
+	PolicyBundleModeEnforce = "enforce"
+
+	// PolicySetPinnedImages rejects the step images with the latest tag
+	PolicySetPinnedImages = "pinnedImages"
+	// PolicySetUnprivilegedSteps rejects the privileged steps
+	PolicySetUnprivilegedSteps = "unprivilegedSteps"
+	// PolicySetStepLimits rejects the steps without a memory limit
+	PolicySetStepLimits = "stepLimits"
+)
+
+// PolicySets are the policy sets of the bundle
+var PolicySets = []string{PolicySetPinnedImages, PolicySetUnprivilegedSteps, PolicySetStepLimits}
+
+// PolicyBundle configures the policies installed with an external policy engine
+type PolicyBundle struct {
+	// Enable installs the policy bundle
+	// +optional
+	Enable bool `json:"enable,omitempty"`
+	// Engine is the policy engine, auto, gatekeeper or kyverno, auto selects the engine installed
+	// on the cluster and is the default
+	// +optional
+	Engine string `json:"engine,omitempty"`
+	// Sets are the policy sets installed, all the sets are installed when it is not set
+	// +optional
+	Sets []string `json:"sets,omitempty"`
+	// Mode is audit or enforce, the violations are only reported in audit mode, the default
+	// +optional
+	Mode string `json:"mode,omitempty"`
+}
+
+// GetEngine returns the policy engine of the bundle
+func (b *PolicyBundle) GetEngine() string {
+	if b.Engine == "" {
+		return PolicyEngineAuto
+	}
+	return b.Engine
+}
+
+// GetSets returns the policy sets of the bundle
+func (b *PolicyBundle) GetSets() []string {
+	if len(b.Sets) == 0 {
+		return PolicySets
+	}
+	return b.Sets
+}
+
+// GetMode returns the mode of the bundle
+func (b *PolicyBundle) GetMode() string {
+	if b.Mode == "" {
+		return PolicyBundleModeAudit
+	}
+	return b.Mode
+}
+
+// GetValidationActions returns the validation actions of the policy bindings
+func (p *Policies) GetValidationActions() []admissionregistrationv1.ValidationAction {
+	if len(p.ValidationActions) == 0 {
+		return []admissionregistrationv1.ValidationAction{admissionregistrationv1.Deny}
+	}
+	return p.ValidationActions
+}
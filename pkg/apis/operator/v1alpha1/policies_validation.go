@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"strings"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"knative.dev/pkg/apis"
+)
+
+func (p *Policies) validate(path string) *apis.FieldError {
+	var errs *apis.FieldError
+
+	for i, label := range p.RequiredPipelineRunLabels {
+		if msgs := validation.IsQualifiedName(label); len(msgs) > 0 {
+			err := apis.ErrInvalidArrayValue(label, path+".requiredPipelineRunLabels", i)
+			err.Details = strings.Join(msgs, ", ")
+			errs = errs.Also(err)
+		}
+	}
+	actions := map[admissionregistrationv1.ValidationAction]bool{}
+	for i, action := range p.ValidationActions {
+		switch action {
+		case admissionregistrationv1.Deny, admissionregistrationv1.Warn, admissionregistrationv1.Audit:
+		default:
+			errs = errs.Also(apis.ErrInvalidArrayValue(action, path+".validationActions", i))
+			continue
+		}
+		if actions[action] {
+			errs = errs.Also(apis.ErrGeneric(fmt.Sprintf("duplicate validation action %q", action), fmt.Sprintf("%s.validationActions[%d]", path, i)))
+		}
+		actions[action] = true
+	}
+	if actions[admissionregistrationv1.Deny] && actions[admissionregistrationv1.Warn] {
+		errs = errs.Also(apis.ErrGeneric("Deny and Warn are not allowed together", path+".validationActions"))
+	}
+	if p.NamespaceSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(p.NamespaceSelector); err != nil {
+			errs = errs.Also(apis.ErrInvalidValue(p.NamespaceSelector, path+".namespaceSelector", err.Error()))
+		}
+	}
+	if p.Bundle != nil {
+		errs = errs.Also(p.Bundle.validate(path + ".bundle"))
+	}
+	return errs
+}
+
+func (b *PolicyBundle) validate(path string) *apis.FieldError {
+	var errs *apis.FieldError
+
+	switch b.Engine {
+	case "", PolicyEngineAuto, PolicyEngineGatekeeper, PolicyEngineKyverno:
+	default:
+		errs = errs.Also(apis.ErrInvalidValue(b.Engine, path+".engine"))
+	}
+	switch b.Mode {
+	case "", PolicyBundleModeAudit, PolicyBundleModeEnforce:
+	default:
+		errs = errs.Also(apis.ErrInvalidValue(b.Mode, path+".mode"))
+	}
+	seen := map[string]bool{}
+	for i, set := range b.Sets {
+		if !isValueInArray(PolicySets, set) {
+			err := apis.ErrInvalidArrayValue(set, path+".sets", i)
+			err.Details = "supported sets: " + strings.Join(PolicySets, ", ")
+			errs = errs.Also(err)
+			continue
+		}
+		if seen[set] {
+			errs = errs.Also(apis.ErrGeneric(fmt.Sprintf("duplicate policy set %q", set), fmt.Sprintf("%s.sets[%d]", path, i)))
+		}
+		seen[set] = true
+	}
+	return errs
+}
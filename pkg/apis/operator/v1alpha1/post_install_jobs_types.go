@@ -0,0 +1,44 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// PostInstallJobs configures the Jobs of the payload run on install and upgrade, e.g. the
+// migrations and cleanups. The unset fields keep the values of the payload.
+type PostInstallJobs struct {
+	// ActiveDeadlineSeconds is the time a Job may run before it is failed
+	// +optional
+	ActiveDeadlineSeconds *int64 `json:"activeDeadlineSeconds,omitempty"`
+	// BackoffLimit is the number of retries of a Job before it is failed
+	// +optional
+	BackoffLimit *int32 `json:"backoffLimit,omitempty"`
+	// Parallelism is the maximum number of pods of a Job running at once
+	// +optional
+	Parallelism *int32 `json:"parallelism,omitempty"`
+	// Resources of the containers of the Jobs
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+	// NodeSelector of the pods of the Jobs
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// Tolerations of the pods of the Jobs
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+}
@@ -0,0 +1,45 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	"knative.dev/pkg/apis"
+)
+
+func (j *PostInstallJobs) validate(path string) *apis.FieldError {
+	var errs *apis.FieldError
+	if d := j.ActiveDeadlineSeconds; d != nil && *d <= 0 {
+		errs = errs.Also(apis.ErrInvalidValue(fmt.Sprint(*d), path+".activeDeadlineSeconds", "must be greater than 0"))
+	}
+	if b := j.BackoffLimit; b != nil && *b < 0 {
+		errs = errs.Also(apis.ErrInvalidValue(fmt.Sprint(*b), path+".backoffLimit", "must not be negative"))
+	}
+	if p := j.Parallelism; p != nil && *p <= 0 {
+		errs = errs.Also(apis.ErrInvalidValue(fmt.Sprint(*p), path+".parallelism", "must be greater than 0"))
+	}
+	if r := j.Resources; r != nil {
+		for name, limit := range r.Limits {
+			if request, ok := r.Requests[name]; ok && request.Cmp(limit) > 0 {
+				errs = errs.Also(apis.ErrInvalidValue(request.String(), fmt.Sprintf("%s.resources.requests.%s", path, name),
+					fmt.Sprintf("must not be greater than the limit %s", limit.String())))
+			}
+		}
+	}
+	return errs
+}
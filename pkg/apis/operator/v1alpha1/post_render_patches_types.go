@@ -0,0 +1,25 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// PostRenderPatches references the ConfigMap of patches applied by the operator to the rendered
+// resources of the payload, for the fields of the resources which are not exposed in the API
+type PostRenderPatches struct {
+	// ConfigMap in the operator namespace, each key of the ConfigMap holds a patch and the
+	// kind and name of the resources it applies to
+	ConfigMap string `json:"configMap"`
+}
@@ -0,0 +1,32 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation"
+	"knative.dev/pkg/apis"
+)
+
+func (p *PostRenderPatches) validate(path string) *apis.FieldError {
+	if p.ConfigMap == "" {
+		return apis.ErrMissingField(path + ".configMap")
+	}
+	if msgs := validation.IsDNS1123Subdomain(p.ConfigMap); len(msgs) > 0 {
+		return apis.ErrInvalidValue(p.ConfigMap, path+".configMap", msgs...)
+	}
+	return nil
+}
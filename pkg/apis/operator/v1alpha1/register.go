@@ -73,6 +73,9 @@ const (
 
 	// KindSyncerService is the Kind of SyncerService in a GVK context.
 	KindSyncerService = "SyncerService"
+
+	// KindTektonNamespaceStatus is the Kind of TektonNamespaceStatus in a GVK context.
+	KindTektonNamespaceStatus = "TektonNamespaceStatus"
 )
 
 // Resource takes an unqualified resource and returns a Group qualified GroupResource
@@ -114,6 +117,8 @@ func addKnownTypes(s *runtime.Scheme) error {
 		&TektonMulticlusterProxyAAEList{},
 		&SyncerService{},
 		&SyncerServiceList{},
+		&TektonNamespaceStatus{},
+		&TektonNamespaceStatusList{},
 	)
 	metav1.AddToGroupVersion(s, SchemeGroupVersion)
 	return nil
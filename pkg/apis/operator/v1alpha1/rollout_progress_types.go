@@ -0,0 +1,42 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// RolloutProgressStatus is the progress of the install or upgrade of the components, counted from
+// the resources applied by their installer sets
+type RolloutProgressStatus struct {
+	// Percentage of the resources of the installer sets which are applied
+	Percentage int `json:"percentage"`
+	// AppliedResources is the number of applied resources of all the components
+	AppliedResources int `json:"appliedResources"`
+	// TotalResources is the number of resources of all the components
+	TotalResources int `json:"totalResources"`
+	// Components holds the progress by component, keyed by the kind creating the installer sets
+	// +optional
+	Components map[string]ComponentRolloutProgress `json:"components,omitempty"`
+}
+
+// ComponentRolloutProgress is the progress of the install or upgrade of a component
+type ComponentRolloutProgress struct {
+	// Version of the component when it reports it
+	// +optional
+	Version string `json:"version,omitempty"`
+	// Applied is the number of applied resources of the installer sets of the component
+	Applied int `json:"applied"`
+	// Total is the number of resources of the installer sets of the component
+	Total int `json:"total"`
+}
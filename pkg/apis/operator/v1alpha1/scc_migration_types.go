@@ -0,0 +1,40 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// SCCAnnotationMigrationStatus reports the migration of the namespaces using the default SCC
+// to the SCC namespace annotation, the namespaces are migrated in batches across the reconciles
+// of TektonConfig
+type SCCAnnotationMigrationStatus struct {
+	// Mode of the migration, report, apply or rollback
+	Mode string `json:"mode"`
+	// SCC annotated in the namespaces
+	SCC string `json:"scc"`
+	// Pending is the number of namespaces using the default SCC not annotated yet
+	Pending int `json:"pending"`
+	// Migrated is the number of namespaces annotated by the migration
+	Migrated int `json:"migrated"`
+	// ChangingCount is the number of pending namespaces whose granted SCC changes once annotated
+	ChangingCount int `json:"changingCount"`
+	// Changing lists the first pending namespaces whose granted SCC changes once annotated,
+	// as <namespace>: <granted SCC> -> <annotated SCC>
+	// +optional
+	Changing []string `json:"changing,omitempty"`
+	// Error of the last reconcile of the migration, the migration is paused while it is set
+	// +optional
+	Error string `json:"error,omitempty"`
+}
@@ -21,6 +21,12 @@ import (
 	"knative.dev/pkg/apis"
 )
 
+const (
+	// CredentialsVerified reports whether the rotated credentials of a component could be verified,
+	// it is not a dependent condition and does not change the readiness of the component
+	CredentialsVerified apis.ConditionType = "CredentialsVerified"
+)
+
 var (
 	_ TektonComponentStatus = (*TektonChainStatus)(nil)
 
@@ -137,6 +143,19 @@ func (tcs *TektonChainStatus) MarkDependencyMissing(msg string) {
 		"Dependency missing: %s", msg)
 }
 
+// MarkCredentialsVerified marks the CredentialsVerified status as true.
+func (tcs *TektonChainStatus) MarkCredentialsVerified() {
+	chainCondSet.Manage(tcs).MarkTrue(CredentialsVerified)
+}
+
+// MarkCredentialsNotVerified marks the CredentialsVerified status as false with the given message.
+func (tcs *TektonChainStatus) MarkCredentialsNotVerified(msg string) {
+	chainCondSet.Manage(tcs).MarkFalse(
+		CredentialsVerified,
+		"Error",
+		"Credentials not verified: %s", msg)
+}
+
 func (tcs *TektonChainStatus) GetTektonInstallerSet() string {
 	return tcs.TektonInstallerSet
 }
@@ -154,3 +173,19 @@ func (tcs *TektonChainStatus) GetVersion() string {
 func (tcs *TektonChainStatus) SetVersion(version string) {
 	tcs.Version = version
 }
+
+func (tcs *TektonChainStatus) MarkWorkloadIdentityReady() {
+	chainCondSet.Manage(tcs).MarkTrue(WorkloadIdentityReady)
+}
+
+func (tcs *TektonChainStatus) MarkWorkloadIdentityNotReady(msg string) {
+	chainCondSet.Manage(tcs).MarkFalse(
+		WorkloadIdentityReady,
+		"Error",
+		"Workload identity not configured: %s", msg)
+}
+
+// ClearWorkloadIdentity removes the WorkloadIdentityReady condition once the workload identity is not configured
+func (tcs *TektonChainStatus) ClearWorkloadIdentity() {
+	_ = chainCondSet.Manage(tcs).ClearCondition(WorkloadIdentityReady)
+}
@@ -74,6 +74,12 @@ type Chain struct {
 	ControllerEnvs  []corev1.EnvVar `json:"controllerEnvs,omitempty"`
 	// options holds additions fields and these fields will be updated on the manifests
 	Options AdditionalOptions `json:"options"`
+	// WorkloadIdentity configures the cloud workload identity of the Chains controller service account
+	// +optional
+	WorkloadIdentity *WorkloadIdentity `json:"workloadIdentity,omitempty"`
+	// OCIRegistry provisions the OCI registry the signatures and attestations are stored in
+	// +optional
+	OCIRegistry *ChainsOCIRegistry `json:"ociRegistry,omitempty"`
 }
 
 // ChainProperties defines the field to provide chain configuration
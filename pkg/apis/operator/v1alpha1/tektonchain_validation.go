@@ -50,6 +50,14 @@ func (tc *TektonChain) Validate(ctx context.Context) (errs *apis.FieldError) {
 	// execute common spec validations
 	errs = errs.Also(tc.Spec.CommonSpec.validate("spec"))
 
+	if tc.Spec.WorkloadIdentity != nil {
+		errs = errs.Also(tc.Spec.WorkloadIdentity.validate("spec.workloadIdentity"))
+	}
+
+	if tc.Spec.OCIRegistry != nil {
+		errs = errs.Also(tc.Spec.OCIRegistry.validate("spec.ociRegistry"))
+	}
+
 	return errs.Also(tc.Spec.ValidateControllerEnv(), tc.Spec.ValidateChainConfig("spec"))
 }
 
@@ -243,3 +243,47 @@ func Test_SetDefaults_SCC(t *testing.T) {
 		})
 	}
 }
+
+func Test_SetDefaults_MinimalFootprint(t *testing.T) {
+	buckets := uint(3)
+	tc := &TektonConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "config",
+		},
+		Spec: TektonConfigSpec{
+			CommonSpec: CommonSpec{
+				TargetNamespace: "tekton-pipelines",
+			},
+			Config: Config{Footprint: FootprintMinimal},
+			Pipeline: Pipeline{
+				PipelineProperties: PipelineProperties{
+					PipelineMetricsProperties: PipelineMetricsProperties{MetricsTaskrunLevel: "task"},
+				},
+			},
+			Chain: Chain{
+				ChainProperties: ChainProperties{
+					Performance: PerformanceProperties{PerformanceLeaderElectionConfig: PerformanceLeaderElectionConfig{Buckets: &buckets}},
+				},
+			},
+		},
+	}
+
+	tc.SetDefaults(context.TODO())
+	assert.Equal(t, *tc.Spec.Pipeline.Performance.Buckets, uint(1))
+	assert.Equal(t, *tc.Spec.Pipeline.Performance.Replicas, int32(1))
+	assert.Equal(t, tc.Spec.Pipeline.Performance.DisableHA, true)
+	assert.Equal(t, *tc.Spec.Result.Performance.Buckets, uint(1))
+	assert.Equal(t, tc.Spec.Result.Performance.DisableHA, true)
+	assert.Equal(t, *tc.Spec.Result.PrometheusHistogram, false)
+	// the values set by the user are kept
+	assert.Equal(t, *tc.Spec.Chain.Performance.Buckets, uint(3))
+	assert.Equal(t, tc.Spec.Pipeline.MetricsTaskrunLevel, "task")
+	assert.Equal(t, tc.Spec.Pipeline.MetricsPipelinerunLevel, "namespace")
+	assert.Equal(t, tc.Spec.Pipeline.MetricsTaskrunDurationType, "lastvalue")
+
+	// nothing is tuned without the minimal footprint
+	tc = &TektonConfig{Spec: TektonConfigSpec{CommonSpec: CommonSpec{TargetNamespace: "tekton-pipelines"}}}
+	tc.SetDefaults(context.TODO())
+	assert.Assert(t, tc.Spec.Pipeline.Performance.Buckets == nil)
+	assert.Assert(t, tc.Spec.Result.PrometheusHistogram == nil)
+}
@@ -28,12 +28,16 @@ func (tc *TektonConfig) SetDefaults(ctx context.Context) {
 	if tc.Spec.Profile == "" {
 		tc.Spec.Profile = ProfileBasic
 	}
+	if tc.Spec.Config.Footprint == FootprintMinimal {
+		tc.Spec.setMinimalFootprintDefaults()
+	}
 	tc.Spec.Pipeline.setDefaults()
 	tc.Spec.Trigger.setDefaults()
 	tc.Spec.Chain.setDefaults()
 	tc.Spec.Result.setDefaults()
 	tc.Spec.TektonPruner.SetDefaults()
 	tc.Spec.Scheduler.SetDefaults()
+	normalizeParams(tc.Spec.Params)
 
 	if IsOpenShiftPlatform() {
 		if tc.Spec.Platforms.OpenShift.PipelinesAsCode == nil {
@@ -67,6 +71,7 @@ func (tc *TektonConfig) SetDefaults(ctx context.Context) {
 			tc.Spec.Platforms.OpenShift.SCC.Default = PipelinesSCC
 		}
 
+		tc.SetOpenShiftParamDefaults()
 		setAddonDefaults(&tc.Spec.Addon)
 	} else {
 		tc.Spec.Addon = Addon{}
@@ -98,3 +103,36 @@ func (tc *TektonConfig) SetDefaults(ctx context.Context) {
 		}
 	}
 }
+
+// setMinimalFootprintDefaults runs a single replica of the controllers without HA buckets, and
+// keeps the metrics of the pipelines at the namespace level without histograms. The values set
+// by the user are kept, except disable-ha.
+func (s *TektonConfigSpec) setMinimalFootprintDefaults() {
+	for _, performance := range []*PerformanceProperties{&s.Pipeline.Performance, &s.Result.Performance, &s.Chain.Performance} {
+		if performance.Buckets == nil {
+			buckets := uint(1)
+			performance.Buckets = &buckets
+		}
+		if performance.Replicas == nil {
+			performance.Replicas = ptr.Int32(1)
+		}
+		performance.DisableHA = true
+	}
+
+	metrics := &s.Pipeline.PipelineMetricsProperties
+	if metrics.MetricsPipelinerunLevel == "" {
+		metrics.MetricsPipelinerunLevel = "namespace"
+	}
+	if metrics.MetricsTaskrunLevel == "" {
+		metrics.MetricsTaskrunLevel = "namespace"
+	}
+	if metrics.MetricsPipelinerunDurationType == "" {
+		metrics.MetricsPipelinerunDurationType = "lastvalue"
+	}
+	if metrics.MetricsTaskrunDurationType == "" {
+		metrics.MetricsTaskrunDurationType = "lastvalue"
+	}
+	if s.Result.PrometheusHistogram == nil {
+		s.Result.PrometheusHistogram = ptr.Bool(false)
+	}
+}
@@ -28,6 +28,21 @@ const (
 	PostInstall     apis.ConditionType = "PostInstall"
 	PreUpgrade      apis.ConditionType = "PreUpgrade"
 	PostUpgrade     apis.ConditionType = "PostUpgrade"
+	// NamespacesReconciled reports the outcome of the per-namespace reconciliation,
+	// it is informational and does not affect the Ready condition
+	NamespacesReconciled apis.ConditionType = "NamespacesReconciled"
+	// DependenciesReady reports the components waiting on the readiness of the components they
+	// depend on, it is informational and does not affect the Ready condition
+	DependenciesReady apis.ConditionType = "DependenciesReady"
+	// DeprecationsResolved reports the deprecated fields set in TektonConfig and the component
+	// resources, it is informational and does not affect the Ready condition
+	DeprecationsResolved apis.ConditionType = "DeprecationsResolved"
+	// PermissionsGranted reports the permissions needed by the operator and not granted to it,
+	// it is informational and does not affect the Ready condition
+	PermissionsGranted apis.ConditionType = "PermissionsGranted"
+	// SubsystemsEnabled reports the subsystems disabled because the operator is not granted their
+	// permissions, it is informational and does not affect the Ready condition
+	SubsystemsEnabled apis.ConditionType = "SubsystemsEnabled"
 )
 
 var (
@@ -103,6 +118,99 @@ func (tcs *TektonConfigStatus) MarkPostInstallFailed(msg string) {
 		"PostReconciliation failed with message: %s", msg)
 }
 
+func (tcs *TektonConfigStatus) MarkNamespacesReconciled() {
+	configCondSet.Manage(tcs).MarkTrue(NamespacesReconciled)
+}
+
+func (tcs *TektonConfigStatus) MarkNamespacesReconcileFailed(msg string) {
+	configCondSet.Manage(tcs).MarkFalse(
+		NamespacesReconciled,
+		"Error",
+		"%s", msg)
+}
+
+// MarkNamespacePatchesPendingConfirmation reports the namespaces waiting for the confirmation of their patches
+func (tcs *TektonConfigStatus) MarkNamespacePatchesPendingConfirmation(msg string) {
+	configCondSet.Manage(tcs).MarkFalse(
+		NamespacesReconciled,
+		"ConfirmationRequired",
+		"%s", msg)
+}
+
+func (tcs *TektonConfigStatus) MarkDependenciesReady() {
+	configCondSet.Manage(tcs).MarkTrue(DependenciesReady)
+}
+
+func (tcs *TektonConfigStatus) MarkWaitingOnDependencies(msg string) {
+	configCondSet.Manage(tcs).MarkFalse(
+		DependenciesReady,
+		"WaitingOnDependencies",
+		"%s", msg)
+}
+
+func (tcs *TektonConfigStatus) MarkDeprecationsResolved() {
+	configCondSet.Manage(tcs).MarkTrue(DeprecationsResolved)
+}
+
+func (tcs *TektonConfigStatus) MarkDeprecatedFieldsInUse(msg string) {
+	configCondSet.Manage(tcs).MarkFalse(
+		DeprecationsResolved,
+		"DeprecatedFieldsInUse",
+		"%s", msg)
+}
+
+func (tcs *TektonConfigStatus) MarkPermissionsGranted() {
+	configCondSet.Manage(tcs).MarkTrue(PermissionsGranted)
+}
+
+func (tcs *TektonConfigStatus) MarkMissingPermissions(msg string) {
+	configCondSet.Manage(tcs).MarkFalse(
+		PermissionsGranted,
+		"MissingPermissions",
+		"%s", msg)
+}
+
+func (tcs *TektonConfigStatus) MarkSubsystemsEnabled() {
+	configCondSet.Manage(tcs).MarkTrue(SubsystemsEnabled)
+}
+
+func (tcs *TektonConfigStatus) MarkSubsystemsDisabled(msg string) {
+	configCondSet.Manage(tcs).MarkFalse(
+		SubsystemsEnabled,
+		"SubsystemsDisabled",
+		"%s", msg)
+}
+
+func (tcs *TektonConfigStatus) MarkVulnerabilityGatePassed() {
+	configCondSet.Manage(tcs).MarkTrue(VulnerabilityGatePassed)
+}
+
+func (tcs *TektonConfigStatus) MarkVulnerabilityGateFailed(msg string) {
+	configCondSet.Manage(tcs).MarkFalse(
+		VulnerabilityGatePassed,
+		"VulnerabilitiesFound",
+		"%s", msg)
+}
+
+func (tcs *TektonConfigStatus) ClearVulnerabilityGate() {
+	_ = configCondSet.Manage(tcs).ClearCondition(VulnerabilityGatePassed)
+}
+
+func (tcs *TektonConfigStatus) MarkTLSPolicyCompliant() {
+	configCondSet.Manage(tcs).MarkTrue(TLSPolicyCompliant)
+}
+
+func (tcs *TektonConfigStatus) MarkTLSPolicyNotCompliant(reason, msg string) {
+	configCondSet.Manage(tcs).MarkFalse(
+		TLSPolicyCompliant,
+		reason,
+		"%s", msg)
+}
+
+func (tcs *TektonConfigStatus) ClearTLSPolicyCompliant() {
+	_ = configCondSet.Manage(tcs).ClearCondition(TLSPolicyCompliant)
+}
+
 func (tcs *TektonConfigStatus) MarkPreUpgradeComplete() bool {
 	condition := configCondSet.Manage(tcs).GetCondition(PreUpgrade)
 	if condition != nil && condition.Status == corev1.ConditionTrue {
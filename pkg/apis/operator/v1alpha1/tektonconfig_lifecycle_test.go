@@ -124,6 +124,26 @@ func TestTektonConfigErrorPath(t *testing.T) {
 
 }
 
+func TestTektonConfigNamespacesReconciled(t *testing.T) {
+	tc := &TektonConfigStatus{}
+	tc.InitializeConditions()
+	tc.MarkPreInstallComplete()
+	tc.MarkComponentsReady()
+	tc.MarkPostInstallComplete()
+	tc.MarkPreUpgradeComplete()
+	tc.MarkPostUpgradeComplete()
+
+	// namespace failures are informational and do not affect readiness
+	tc.MarkNamespacesReconcileFailed("failed reconciling 1 of 2 namespaces")
+	apistest.CheckConditionFailed(tc, NamespacesReconciled, t)
+	if ready := tc.IsReady(); !ready {
+		t.Errorf("tc.IsReady() = %v, want true", ready)
+	}
+
+	tc.MarkNamespacesReconciled()
+	apistest.CheckConditionSucceeded(tc, NamespacesReconciled, t)
+}
+
 func TestPreUpgradeVersion(t *testing.T) {
 	tc := &TektonConfig{}
 
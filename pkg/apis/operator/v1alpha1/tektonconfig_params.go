@@ -0,0 +1,318 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"knative.dev/pkg/apis"
+)
+
+// params supported in TektonConfig spec.params
+const (
+	CreateRbacResourceParam             = "createRbacResource"
+	CreateCABundleConfigMapsParam       = "createCABundleConfigMaps"
+	LegacyPipelineRbacParam             = "legacyPipelineRbac"
+	ReapInactiveNamespaceRBACParam      = "reapInactiveNamespaceRBAC"
+	InactiveNamespaceRBACRetentionParam = "inactiveNamespaceRBACRetention"
+	NamespaceFailurePolicyParam         = "namespaceFailurePolicy"
+	NamespaceFailureThresholdParam      = "namespaceFailureThreshold"
+	NamespaceDefaultsMaxTimeoutParam    = "namespaceDefaultsMaxTimeout"
+	NamespaceDefaultsMaxPruneKeepParam  = "namespaceDefaultsMaxPruneKeep"
+	// NamespaceDefaultsMaxPruneKeepSinceParam bounds the keep-since requested in namespaces, in minutes
+	NamespaceDefaultsMaxPruneKeepSinceParam = "namespaceDefaultsMaxPruneKeepSince"
+	// SCCAuditIntervalParam is the minimum time between two reports of the SCCs granted in the namespaces
+	SCCAuditIntervalParam = "sccAuditInterval"
+	// SCCAnnotationMigrationParam reports, applies or rolls back the migration of the namespaces
+	// using the default SCC to the SCC namespace annotation
+	SCCAnnotationMigrationParam = "sccAnnotationMigration"
+	// SCCAnnotationMigrationSCCParam is the SCC annotated in the namespaces, the default SCC when empty
+	SCCAnnotationMigrationSCCParam = "sccAnnotationMigrationSCC"
+	// SCCAnnotationMigrationBatchSizeParam is the number of namespaces annotated or rolled back per reconcile
+	SCCAnnotationMigrationBatchSizeParam = "sccAnnotationMigrationBatchSize"
+	// ClusterInterceptorsSubjectsParam selects the subjects bound to the clusterinterceptors ClusterRole
+	ClusterInterceptorsSubjectsParam = "clusterInterceptorsSubjects"
+	// ControllerWatchdogParam enables the restart of the component controllers whose workqueue is wedged
+	ControllerWatchdogParam = "controllerWatchdog"
+	// ControllerWatchdogStallTimeoutParam is the time without progress of a workqueue after which its controller is restarted
+	ControllerWatchdogStallTimeoutParam = "controllerWatchdogStallTimeout"
+	// NamespacePatchConcurrencyParam is the number of namespaces whose labels are patched concurrently
+	NamespacePatchConcurrencyParam = "namespacePatchConcurrency"
+	// NamespacePatchQPSParam is the number of namespace patches sent per second
+	NamespacePatchQPSParam = "namespacePatchQPS"
+	// NamespacePatchBurstParam is the number of namespace patches sent at once above the QPS
+	NamespacePatchBurstParam = "namespacePatchBurst"
+	// RBACWorkerCountParam is the number of namespaces whose RBAC and CA bundles are reconciled concurrently
+	RBACWorkerCountParam = "rbacWorkerCount"
+	// NamespacePatchConfirmationThresholdParam is the number of namespaces above which the
+	// namespace patches wait for the confirmation param
+	NamespacePatchConfirmationThresholdParam = "namespacePatchConfirmationThreshold"
+	// ConfirmNamespacePatchesParam confirms the patches of more namespaces than the confirmation threshold
+	ConfirmNamespacePatchesParam = "confirmNamespacePatches"
+	// OperatorEventBurstParam is the number of events the operator emits in the namespaces per reconcile
+	OperatorEventBurstParam = "operatorEventBurst"
+	// OperatorEventTTLParam is the time after its last occurrence an event of the operator is deleted
+	OperatorEventTTLParam = "operatorEventTTL"
+)
+
+var (
+	// TektonConfigParams are the params supported in TektonConfig spec.params, the params
+	// without possible values are checked with their format in tektonConfigParamFormats
+	TektonConfigParams = map[string]ParamValue{
+		CreateRbacResourceParam:        defaultParamValue,
+		CreateCABundleConfigMapsParam:  defaultParamValue,
+		LegacyPipelineRbacParam:        defaultParamValue,
+		ReapInactiveNamespaceRBACParam: {Default: "false", Possible: []string{"true", "false"}},
+		NamespaceFailurePolicyParam:    {Default: "continue", Possible: []string{"continue", "failFast", "threshold"}},
+		ControllerWatchdogParam:        {Default: "true", Possible: []string{"true", "false"}},
+		ConfirmNamespacePatchesParam:   {Default: "false", Possible: []string{"true", "false"}},
+		SCCAnnotationMigrationParam:    {Default: "none", Possible: []string{"none", "report", "apply", "rollback"}},
+
+		ClusterInterceptorsSubjectsParam: {Default: "serviceAccounts", Possible: []string{"serviceAccounts", "namespaceGroups", "allServiceAccounts"}},
+
+		InactiveNamespaceRBACRetentionParam:      {Default: "720h"},
+		NamespaceFailureThresholdParam:           {Default: "10"},
+		NamespaceDefaultsMaxTimeoutParam:         {},
+		NamespaceDefaultsMaxPruneKeepParam:       {},
+		NamespaceDefaultsMaxPruneKeepSinceParam:  {},
+		SCCAuditIntervalParam:                    {Default: "1h"},
+		SCCAnnotationMigrationSCCParam:           {},
+		SCCAnnotationMigrationBatchSizeParam:     {Default: "50"},
+		ControllerWatchdogStallTimeoutParam:      {Default: "15m"},
+		NamespacePatchConcurrencyParam:           {Default: "10"},
+		NamespacePatchQPSParam:                   {Default: "20"},
+		NamespacePatchBurstParam:                 {Default: "20"},
+		RBACWorkerCountParam:                     {Default: "10"},
+		NamespacePatchConfirmationThresholdParam: {Default: "1000"},
+		OperatorEventBurstParam:                  {Default: "10"},
+		OperatorEventTTLParam:                    {Default: "24h"},
+	}
+
+	// ParamsFromComponents are the components whose properties are set from spec.paramsFrom, named
+	// as their field in the spec
+	ParamsFromComponents = []string{"pipeline", "trigger", "chain", "result", "dashboard"}
+
+	tektonConfigParamFormats = map[string]func(value string) error{
+		InactiveNamespaceRBACRetentionParam:      validatePositiveDuration,
+		NamespaceFailureThresholdParam:           validatePercentage,
+		NamespaceDefaultsMaxTimeoutParam:         validateTimeout,
+		NamespaceDefaultsMaxPruneKeepParam:       validatePositiveInteger,
+		NamespaceDefaultsMaxPruneKeepSinceParam:  validatePositiveInteger,
+		SCCAuditIntervalParam:                    validatePositiveDuration,
+		SCCAnnotationMigrationBatchSizeParam:     validatePositiveInteger,
+		ControllerWatchdogStallTimeoutParam:      validatePositiveDuration,
+		NamespacePatchConcurrencyParam:           validatePositiveInteger,
+		NamespacePatchQPSParam:                   validatePositiveInteger,
+		NamespacePatchBurstParam:                 validatePositiveInteger,
+		RBACWorkerCountParam:                     validatePositiveInteger,
+		NamespacePatchConfirmationThresholdParam: validatePositiveInteger,
+		OperatorEventBurstParam:                  validatePositiveInteger,
+		OperatorEventTTLParam:                    validatePositiveDuration,
+	}
+)
+
+// normalizeParams trims the values of the params and lower cases the values of the boolean params,
+// invalid values are left as they are for the validation to reject them
+func normalizeParams(params []Param) {
+	for i := range params {
+		params[i].Value = strings.TrimSpace(params[i].Value)
+		paramValue, ok := TektonConfigParams[params[i].Name]
+		if !ok || !isValueInArray(paramValue.Possible, "true") {
+			continue
+		}
+		if value := strings.ToLower(params[i].Value); value == "true" || value == "false" {
+			params[i].Value = value
+		}
+	}
+}
+
+// SetOpenShiftParamDefaults adds the params defaulted on OpenShift which are missing in spec.params
+func (tc *TektonConfig) SetOpenShiftParamDefaults() {
+	paramsMap := ParseParams(tc.Spec.Params)
+	rbacValue, rbacParamFound := paramsMap[CreateRbacResourceParam]
+
+	if !rbacParamFound {
+		tc.Spec.Params = append(tc.Spec.Params, Param{Name: CreateRbacResourceParam, Value: "true"})
+	}
+	if _, ok := paramsMap[LegacyPipelineRbacParam]; !ok {
+		tc.Spec.Params = append(tc.Spec.Params, Param{Name: LegacyPipelineRbacParam, Value: "true"})
+	}
+
+	// TODO: Remove this upgrade workaround after version 1.22.
+	// This logic is only needed to preserve backward compatibility for users upgrading to 1.21
+	// who had createRbacResource=false and no createCABundleConfigMaps param set.
+	if _, ok := paramsMap[CreateCABundleConfigMapsParam]; !ok {
+		defaultVal := "true"
+		if rbacParamFound && rbacValue == "false" {
+			defaultVal = "false"
+		}
+		tc.Spec.Params = append(tc.Spec.Params, Param{Name: CreateCABundleConfigMapsParam, Value: defaultVal})
+	}
+}
+
+// validateTektonConfigParams rejects unknown params, with a suggestion for likely typos,
+// params set more than once and invalid values
+func validateTektonConfigParams(params []Param, pathToParams string) *apis.FieldError {
+	var errs *apis.FieldError
+
+	seen := map[string]bool{}
+	for i, p := range params {
+		paramValue, ok := TektonConfigParams[p.Name]
+		if !ok {
+			err := apis.ErrInvalidKeyName(p.Name, pathToParams)
+			if suggestion := suggestParamName(p.Name); suggestion != "" {
+				err.Details = fmt.Sprintf("did you mean %q?", suggestion)
+			}
+			errs = errs.Also(err)
+			continue
+		}
+		if seen[p.Name] {
+			errs = errs.Also(apis.ErrGeneric(fmt.Sprintf("param %s is set more than once", p.Name), fmt.Sprintf("%s[%d]", pathToParams, i)))
+			continue
+		}
+		seen[p.Name] = true
+
+		path := pathToParams + "." + p.Name
+		if len(paramValue.Possible) > 0 {
+			if !isValueInArray(paramValue.Possible, p.Value) {
+				err := apis.ErrInvalidArrayValue(p.Value, path, i)
+				err.Details = fmt.Sprintf("must be one of %s", strings.Join(paramValue.Possible, ", "))
+				errs = errs.Also(err)
+			}
+			continue
+		}
+		if validate, ok := tektonConfigParamFormats[p.Name]; ok {
+			if err := validate(p.Value); err != nil {
+				invalid := apis.ErrInvalidArrayValue(p.Value, path, i)
+				invalid.Details = err.Error()
+				errs = errs.Also(invalid)
+			}
+		}
+	}
+	return errs
+}
+
+// validateParamsFrom rejects the sources of params which do not reference exactly one ConfigMap
+// or Secret, or reference an unknown component
+func validateParamsFrom(sources []ParamsFromSource, pathToSources string) *apis.FieldError {
+	var errs *apis.FieldError
+	for i, source := range sources {
+		path := fmt.Sprintf("%s[%d]", pathToSources, i)
+		switch {
+		case source.ConfigMap != nil && source.Secret != nil:
+			errs = errs.Also(apis.ErrMultipleOneOf(path+".configMap", path+".secret"))
+		case source.ConfigMap != nil && source.ConfigMap.Name == "":
+			errs = errs.Also(apis.ErrMissingField(path + ".configMap.name"))
+		case source.Secret != nil && source.Secret.Name == "":
+			errs = errs.Also(apis.ErrMissingField(path + ".secret.name"))
+		case source.ConfigMap == nil && source.Secret == nil:
+			errs = errs.Also(apis.ErrMissingOneOf(path+".configMap", path+".secret"))
+		}
+		if source.Component != "" && !isValueInArray(ParamsFromComponents, source.Component) {
+			err := apis.ErrInvalidValue(source.Component, path+".component")
+			err.Details = fmt.Sprintf("must be one of %s", strings.Join(ParamsFromComponents, ", "))
+			errs = errs.Also(err)
+		}
+	}
+	return errs
+}
+
+// ValidateResolvedParams normalizes and validates the params and the pipeline properties once the
+// values of spec.paramsFrom are resolved, the webhook only validates the values of the spec
+func (spec *TektonConfigSpec) ValidateResolvedParams() *apis.FieldError {
+	normalizeParams(spec.Params)
+	return validateTektonConfigParams(spec.Params, "spec.params").Also(spec.Pipeline.PipelineProperties.validate("spec.pipeline"))
+}
+
+// suggestParamName returns the supported param closest to the name, if it is close enough
+// to be a typo
+func suggestParamName(name string) string {
+	names := make([]string, 0, len(TektonConfigParams))
+	for n := range TektonConfigParams {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+
+	suggestion := ""
+	best := len(name)/3 + 1
+	for _, n := range names {
+		if d := editDistance(strings.ToLower(name), strings.ToLower(n)); d < best {
+			best = d
+			suggestion = n
+		}
+	}
+	return suggestion
+}
+
+// editDistance returns the Levenshtein distance between two strings
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
+
+func validatePositiveDuration(value string) error {
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		return fmt.Errorf("must be a positive duration such as 720h")
+	}
+	return nil
+}
+
+func validatePercentage(value string) error {
+	n, err := strconv.Atoi(strings.TrimSuffix(value, "%"))
+	if err != nil || n < 0 || n > 100 {
+		return fmt.Errorf("must be a percentage between 0 and 100")
+	}
+	return nil
+}
+
+func validateTimeout(value string) error {
+	if n, err := strconv.ParseUint(value, 10, 32); err == nil && n > 0 {
+		return nil
+	}
+	if err := validatePositiveDuration(value); err != nil {
+		return fmt.Errorf("must be a positive duration such as 2h or a number of minutes")
+	}
+	return nil
+}
+
+func validatePositiveInteger(value string) error {
+	n, err := strconv.ParseUint(value, 10, 32)
+	if err != nil || n == 0 {
+		return fmt.Errorf("must be a positive integer")
+	}
+	return nil
+}
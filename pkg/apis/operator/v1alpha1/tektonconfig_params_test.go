@@ -0,0 +1,102 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func Test_ValidateTektonConfigParams(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  []Param
+		wantErr string
+	}{
+		{
+			name: "valid",
+			params: []Param{
+				{Name: CreateRbacResourceParam, Value: "false"},
+				{Name: NamespaceFailurePolicyParam, Value: "threshold"},
+				{Name: NamespaceFailureThresholdParam, Value: "25%"},
+				{Name: InactiveNamespaceRBACRetentionParam, Value: "168h"},
+				{Name: NamespaceDefaultsMaxTimeoutParam, Value: "90"},
+				{Name: NamespaceDefaultsMaxPruneKeepParam, Value: "50"},
+			},
+		},
+		{
+			name:    "unknown param with a suggestion",
+			params:  []Param{{Name: "createRbacResources", Value: "true"}},
+			wantErr: "invalid key name \"createRbacResources\": spec.params\ndid you mean \"createRbacResource\"?",
+		},
+		{
+			name:    "unknown param without a suggestion",
+			params:  []Param{{Name: "foo", Value: "true"}},
+			wantErr: "invalid key name \"foo\": spec.params",
+		},
+		{
+			name:    "invalid boolean value",
+			params:  []Param{{Name: LegacyPipelineRbacParam, Value: "yes"}},
+			wantErr: "invalid value: yes: spec.params.legacyPipelineRbac[0]\nmust be one of true, false",
+		},
+		{
+			name:    "invalid format",
+			params:  []Param{{Name: NamespaceFailureThresholdParam, Value: "150%"}},
+			wantErr: "invalid value: 150%: spec.params.namespaceFailureThreshold[0]\nmust be a percentage between 0 and 100",
+		},
+		{
+			name: "param set more than once",
+			params: []Param{
+				{Name: CreateRbacResourceParam, Value: "true"},
+				{Name: CreateRbacResourceParam, Value: "false"},
+			},
+			wantErr: "param createRbacResource is set more than once: spec.params[1]",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validateTektonConfigParams(test.params, "spec.params")
+			if test.wantErr == "" {
+				assert.Assert(t, err == nil, "unexpected error: %v", err)
+				return
+			}
+			assert.Equal(t, err.Error(), test.wantErr)
+		})
+	}
+}
+
+func Test_SetDefaults_Params(t *testing.T) {
+	t.Setenv("PLATFORM", "openshift")
+
+	tc := &TektonConfig{
+		Spec: TektonConfigSpec{
+			Params: []Param{
+				{Name: CreateRbacResourceParam, Value: " False "},
+				{Name: LegacyPipelineRbacParam, Value: "invalid"},
+			},
+		},
+	}
+	tc.SetDefaults(context.TODO())
+
+	assert.DeepEqual(t, tc.Spec.Params, []Param{
+		{Name: CreateRbacResourceParam, Value: "false"},
+		{Name: LegacyPipelineRbacParam, Value: "invalid"},
+		{Name: CreateCABundleConfigMapsParam, Value: "false"},
+	})
+}
@@ -69,6 +69,40 @@ type Prune struct {
 	// Optional deadline in seconds for starting the job if it misses scheduled time for any reason.
 	// Missed jobs executions will be counted as failed ones.
 	StartingDeadlineSeconds *int64 `json:"startingDeadlineSeconds,omitempty"`
+	// Reports the PersistentVolumeClaims of the workspaces no longer used by a PipelineRun
+	// +optional
+	OrphanedPVCs *OrphanedPVCScan `json:"orphaned-pvcs,omitempty"`
+}
+
+const (
+	// OrphanedPVCPolicyReport reports the orphaned PersistentVolumeClaims in the status of TektonConfig
+	OrphanedPVCPolicyReport = "Report"
+	// OrphanedPVCPolicyDelete reports and deletes the orphaned PersistentVolumeClaims
+	OrphanedPVCPolicyDelete = "Delete"
+)
+
+// OrphanedPVCScan configures the scan of the PersistentVolumeClaims created for the workspaces
+// of the PipelineRuns, directly or by the affinity assistant, whose PipelineRun is gone
+type OrphanedPVCScan struct {
+	// enable the scan of the orphaned PersistentVolumeClaims
+	Enabled bool `json:"enabled"`
+	// The minimum age of a PersistentVolumeClaim to be orphaned, as a duration, 1h by default
+	// +optional
+	MinAge string `json:"min-age,omitempty"`
+	// How frequent the scan should happen, as a duration, 1h by default
+	// +optional
+	Interval string `json:"interval,omitempty"`
+	// What is done with the orphaned PersistentVolumeClaims, Report (default) or Delete
+	// +optional
+	CleanupPolicy string `json:"cleanup-policy,omitempty"`
+}
+
+// GetCleanupPolicy returns the cleanup policy, Report by default
+func (s *OrphanedPVCScan) GetCleanupPolicy() string {
+	if s.CleanupPolicy == "" {
+		return OrphanedPVCPolicyReport
+	}
+	return s.CleanupPolicy
 }
 
 func (p Prune) IsEmpty() bool {
@@ -80,8 +114,30 @@ type NamespaceMetadata struct {
 	Annotations map[string]string `json:"annotations,omitempty"`
 }
 
+// ParamsFromSource is a ConfigMap or a Secret of the operator namespace whose keys are params of
+// TektonConfig, or properties of a component, one of ConfigMap or Secret is set
+// +kubebuilder:validation:XValidation:rule="has(self.configMap) != has(self.secret)",message="exactly one of configMap or secret must be set"
+type ParamsFromSource struct {
+	// +optional
+	ConfigMap *corev1.LocalObjectReference `json:"configMap,omitempty"`
+	// +optional
+	Secret *corev1.LocalObjectReference `json:"secret,omitempty"`
+	// Component whose properties are set from the keys, named as the fields of the component
+	// in the spec, e.g. enable-api-fields or performance.buckets for pipeline. The keys are
+	// params of spec.params when the component is not set.
+	// +optional
+	// +kubebuilder:validation:Enum=pipeline;trigger;chain;result;dashboard
+	Component string `json:"component,omitempty"`
+	// Optional ignores the source when it does not exist
+	// +optional
+	Optional bool `json:"optional,omitempty"`
+}
+
 // TektonConfigSpec defines the desired state of TektonConfig
 type TektonConfigSpec struct {
+	// Profile selects the components installed, one of `all`, `basic` or `lite`
+	// +optional
+	// +kubebuilder:validation:Enum=all;basic;lite
 	Profile string `json:"profile,omitempty"`
 	// Config holds the configuration for resources created by TektonConfig
 	// +optional
@@ -122,12 +178,57 @@ type TektonConfigSpec struct {
 	// Params is the list of params passed for all platforms
 	// +optional
 	Params []Param `json:"params,omitempty"`
+	// ParamsFrom sets params and properties of the components from ConfigMaps and Secrets of
+	// the operator namespace, resolved on each reconcile. The values of a source override the
+	// values of the spec and of the previous sources.
+	// +optional
+	ParamsFrom []ParamsFromSource `json:"paramsFrom,omitempty"`
 	// Platforms allows configuring platform specific configurations
 	// +optional
 	Platforms Platforms `json:"platforms,omitempty"`
 	// holds target namespace metadata
 	// +optional
 	TargetNamespaceMetadata *NamespaceMetadata `json:"targetNamespaceMetadata,omitempty"`
+	// PayloadSwitchover holds the configuration for blue/green payload switchovers
+	// +optional
+	PayloadSwitchover *PayloadSwitchover `json:"payloadSwitchover,omitempty"`
+	// NamespaceOnboarding creates a ServiceAccount and RoleBindings in the namespaces
+	// requesting onboarding
+	// +optional
+	NamespaceOnboarding *NamespaceOnboarding `json:"namespaceOnboarding,omitempty"`
+	// VulnerabilityGate scans the payload images before the components are installed
+	// +optional
+	VulnerabilityGate *VulnerabilityGate `json:"vulnerabilityGate,omitempty"`
+	// TrustedCA distributes trusted CA certificates to the namespaces on Kubernetes
+	// +optional
+	TrustedCA *TrustedCA `json:"trustedCA,omitempty"`
+	// Notifications configures the sinks notified of the install, upgrade and degraded events
+	// +optional
+	Notifications *Notifications `json:"notifications,omitempty"`
+	// Concurrency limits the PipelineRuns of the namespaces and the fan out of their matrices
+	// +optional
+	Concurrency *Concurrency `json:"concurrency,omitempty"`
+	// Policies generates ValidatingAdmissionPolicies enforcing guardrails on the Tekton resources
+	// +optional
+	Policies *Policies `json:"policies,omitempty"`
+	// PostRenderPatches patches the rendered resources of the payload
+	// +optional
+	PostRenderPatches *PostRenderPatches `json:"postRenderPatches,omitempty"`
+	// Security holds the TLS policy of the webhooks and HTTPS endpoints of the components
+	// +optional
+	Security *Security `json:"security,omitempty"`
+	// DriftDetection reports or repairs the fields of the components set from TektonConfig and
+	// changed directly on the components
+	// +optional
+	DriftDetection *DriftDetection `json:"driftDetection,omitempty"`
+	// PostInstallJobs configures the Jobs run on install and upgrade by the components
+	// +optional
+	PostInstallJobs *PostInstallJobs `json:"postInstallJobs,omitempty"`
+	// DeletionProtection blocks the deletion of TektonConfig, which uninstalls all the components,
+	// unless the deletion is confirmed with the operator.tekton.dev/confirm-deletion annotation.
+	// enabled or disabled, disabled by default
+	// +optional
+	DeletionProtection string `json:"deletionProtection,omitempty"`
 }
 
 // TektonConfigStatus defines the observed state of TektonConfig
@@ -145,6 +246,193 @@ type TektonConfigStatus struct {
 	// The current installer set name
 	// +optional
 	TektonInstallerSet map[string]string `json:"tektonInstallerSets,omitempty"`
+
+	// The state of the blue/green payload switchover
+	// +optional
+	PayloadSwitchover *PayloadSwitchoverStatus `json:"payloadSwitchover,omitempty"`
+
+	// The SHA256 of the manifests rendered deterministically into the installer sets
+	// +optional
+	RenderedManifests *RenderedManifestsStatus `json:"renderedManifests,omitempty"`
+
+	// The propagation of the last spec change to the components
+	// +optional
+	Propagation *ConfigPropagationStatus `json:"propagation,omitempty"`
+
+	// The state of the installation last notified to the notification sinks
+	// +optional
+	Notifications *NotificationsStatus `json:"notifications,omitempty"`
+
+	// The progress of the install or upgrade of the components
+	// +optional
+	Progress *RolloutProgressStatus `json:"progress,omitempty"`
+
+	// The removal of the artifacts left by older releases of the operator
+	// +optional
+	LegacyMigration *LegacyMigrationStatus `json:"legacyMigration,omitempty"`
+
+	// The migration of the namespaces using the default SCC to the SCC namespace annotation
+	// +optional
+	SCCMigration *SCCAnnotationMigrationStatus `json:"sccMigration,omitempty"`
+
+	// The proxy injected in the components and the default pod template, and its last change
+	// +optional
+	Proxy *ProxyStatus `json:"proxy,omitempty"`
+
+	// The orphaned PersistentVolumeClaims of the workspaces found by the last scan
+	// +optional
+	OrphanedPVCs *OrphanedPVCsStatus `json:"orphanedPVCs,omitempty"`
+
+	// The trusted CA certificates of the cluster the workloads were started with, and their
+	// last rotation
+	// +optional
+	TrustedCABundle *TrustedCABundleStatus `json:"trustedCABundle,omitempty"`
+
+	// The params and properties resolved from spec.paramsFrom
+	// +optional
+	ParamsFrom *ParamsFromStatus `json:"paramsFrom,omitempty"`
+
+	// The version and commit of the operator binary reconciling the TektonConfig
+	// +optional
+	Operator *OperatorBuildStatus `json:"operator,omitempty"`
+
+	// The payload version and SHA256 of the installed manifests by component kind
+	// +optional
+	PayloadVersions map[string]PayloadVersionStatus `json:"payloadVersions,omitempty"`
+
+	// The namespaces whose RBAC resources and CA bundles failed to be reconciled
+	// +optional
+	RBAC *RBACStatus `json:"rbacStatus,omitempty"`
+
+	// The components whose fields set from TektonConfig were changed directly
+	// +optional
+	Drift *ConfigDriftStatus `json:"drift,omitempty"`
+}
+
+// RBACStatus reports the namespaces whose RBAC resources and CA bundles failed to be reconciled
+type RBACStatus struct {
+	// The number of failed namespaces
+	FailedCount int `json:"failedCount"`
+	// The failed namespaces in the order of their names, the first 50 are listed
+	// +optional
+	FailedNamespaces []NamespaceFailure `json:"failedNamespaces,omitempty"`
+}
+
+// NamespaceFailure is the failure of the reconciliation of a namespace
+type NamespaceFailure struct {
+	Namespace string `json:"namespace"`
+	// The error of the last reconciliation of the namespace
+	Reason string `json:"reason"`
+	// The time of the first of the consecutive failures of the namespace
+	Since metav1.Time `json:"since"`
+}
+
+// ProxyStatus reports the proxy environment variables of the operator injected in the
+// deployments of the components and in the default pod template of the pipelines
+type ProxyStatus struct {
+	// The injected proxy environment variables, without their credentials
+	// +optional
+	Env map[string]string `json:"env,omitempty"`
+	// The time of the last change of the proxy
+	// +optional
+	LastChangeTime *metav1.Time `json:"lastChangeTime,omitempty"`
+	// The environment variables changed by the last change of the proxy
+	// +optional
+	Changed []string `json:"changed,omitempty"`
+	// The configs updated by the last change of the proxy
+	// +optional
+	UpdatedConfigs []string `json:"updatedConfigs,omitempty"`
+	// The components whose deployments were restarted by the last change of the proxy
+	// +optional
+	RestartedComponents []string `json:"restartedComponents,omitempty"`
+}
+
+// TrustedCABundleStatus reports the trusted CA certificates of the cluster mounted in the
+// workloads of the components, they are restarted when the certificates rotate
+type TrustedCABundleStatus struct {
+	// The hash of the trusted CA certificates of the cluster
+	Hash string `json:"hash"`
+	// The time of the last rotation of the certificates
+	// +optional
+	LastRotationTime *metav1.Time `json:"lastRotationTime,omitempty"`
+	// The workloads restarted by the last rotation, as Kind/name in the target namespace
+	// +optional
+	RestartedWorkloads []string `json:"restartedWorkloads,omitempty"`
+}
+
+// ParamsFromStatus reports the values resolved from the sources of spec.paramsFrom, without the
+// values themselves which may be sensitive
+type ParamsFromStatus struct {
+	// The hash of the values resolved from the sources
+	Hash string `json:"hash"`
+	// The time the resolved values last changed
+	// +optional
+	LastChangeTime *metav1.Time `json:"lastChangeTime,omitempty"`
+}
+
+// OrphanedPVCsStatus summarizes the orphaned PersistentVolumeClaims
+type OrphanedPVCsStatus struct {
+	// The time of the last scan
+	LastScanTime metav1.Time `json:"lastScanTime"`
+	// The number of orphaned PersistentVolumeClaims
+	Count int `json:"count"`
+	// The total storage of the orphaned PersistentVolumeClaims
+	// +optional
+	TotalSize string `json:"totalSize,omitempty"`
+	// The number of orphaned PersistentVolumeClaims deleted by the last scan
+	// +optional
+	Deleted int `json:"deleted,omitempty"`
+	// The orphaned PersistentVolumeClaims by namespace
+	// +optional
+	Namespaces []OrphanedPVCNamespaceSummary `json:"namespaces,omitempty"`
+}
+
+// OrphanedPVCNamespaceSummary summarizes the orphaned PersistentVolumeClaims of a namespace
+type OrphanedPVCNamespaceSummary struct {
+	Namespace string `json:"namespace"`
+	// The number of orphaned PersistentVolumeClaims
+	Count int `json:"count"`
+	// The total storage of the orphaned PersistentVolumeClaims
+	// +optional
+	Size string `json:"size,omitempty"`
+	// The creation time of the oldest orphaned PersistentVolumeClaim
+	Oldest metav1.Time `json:"oldest"`
+}
+
+// OperatorBuildStatus identifies the operator binary
+type OperatorBuildStatus struct {
+	// Version of the operator
+	Version string `json:"version"`
+
+	// VCS revision the operator was built from, empty when it was not recorded at build time
+	// +optional
+	Commit string `json:"commit,omitempty"`
+}
+
+// PayloadVersionStatus is the payload installed for a component, the operator versions differ
+// from the version of the operator binary while a partial upgrade is in progress or stuck
+type PayloadVersionStatus struct {
+	// Version of the payload reported by the component
+	// +optional
+	Version string `json:"version,omitempty"`
+
+	// SHA256 of the manifests of the installer sets of the component
+	SHA256 string `json:"sha256"`
+
+	// Release versions of the operator which created the installer sets of the component
+	// +optional
+	OperatorVersions []string `json:"operatorVersions,omitempty"`
+}
+
+// RenderedManifestsStatus holds the SHA256 of the deterministically rendered manifests, the
+// SHA256 of the bundle is the same on clusters installing the same manifests
+type RenderedManifestsStatus struct {
+	// SHA256 of the bundle, computed from the SHA256 of the installer sets regardless of their names
+	SHA256 string `json:"sha256"`
+
+	// SHA256 of the rendered manifests by installer set name
+	// +optional
+	InstallerSets map[string]string `json:"installerSets,omitempty"`
 }
 
 func (in *TektonConfigStatus) MarkInstallerSetReady() {
@@ -186,6 +474,54 @@ type Config struct {
 	// PriorityClassName holds the priority class to be set to pod template
 	// +optional
 	PriorityClassName string `json:"priorityClassName,omitempty"`
+	// MetricsTLS serves the metrics and profiling endpoints of the components over TLS
+	// +optional
+	MetricsTLS *MetricsTLS `json:"metricsTLS,omitempty"`
+	// PodSecurity holds the seccomp and AppArmor profiles of the pods of the components, and of
+	// the default pod template of the pipelines
+	// +optional
+	PodSecurity *PodSecurityDefaults `json:"podSecurity,omitempty"`
+	// ProxyInDefaultPodTemplate adds the proxy environment variables of the operator to the
+	// default pod template of the pipelines, in every namespace, the pods of the pipelines are
+	// otherwise given the proxy by the proxy webhook in the namespaces which did not opt out
+	// +optional
+	ProxyInDefaultPodTemplate bool `json:"proxyInDefaultPodTemplate,omitempty"`
+	// ControlPlaneProxy propagates the proxy environment variables of the operator to the
+	// deployments of the components, enabled when not set
+	// +optional
+	ControlPlaneProxy *bool `json:"controlPlaneProxy,omitempty"`
+	// ProxyCredentials references a secret of the operator namespace holding the username and
+	// password of the proxy, they are set in the proxy environment variables of the deployments
+	// of the components
+	// +optional
+	ProxyCredentials *corev1.LocalObjectReference `json:"proxyCredentials,omitempty"`
+	// WorkloadProxy injects the proxy environment variables of the operator in the pods of the
+	// pipelines, through the proxy webhook and the default pod template, enabled when not set
+	// +optional
+	WorkloadProxy *bool `json:"workloadProxy,omitempty"`
+	// Footprint tunes the components for the size of the cluster, minimal runs a single replica
+	// of the components with lower resource requests for single node and edge clusters
+	// +optional
+	Footprint string `json:"footprint,omitempty"`
+}
+
+// FootprintMinimal runs the components with a single replica, lower resource requests, no HA
+// buckets, fewer metrics and longer resync intervals
+const FootprintMinimal = "minimal"
+
+// MetricsTLS serves the metrics, and optionally the profiling, endpoints of the components over
+// TLS with serving certificates managed by the operator
+type MetricsTLS struct {
+	// Enable serves the metrics over TLS
+	// +optional
+	Enable bool `json:"enable,omitempty"`
+	// Profiling also serves the profiling endpoints over TLS
+	// +optional
+	Profiling bool `json:"profiling,omitempty"`
+	// ProxyImage is the image of the TLS proxies, defaults to the image of the operator
+	// configuration
+	// +optional
+	ProxyImage string `json:"proxyImage,omitempty"`
 }
 
 type Platforms struct {
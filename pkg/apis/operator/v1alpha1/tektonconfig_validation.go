@@ -19,10 +19,15 @@ package v1alpha1
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/tektoncd/operator/pkg/common"
 	"github.com/tektoncd/operator/pkg/reconciler/openshift"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/validation/path"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"knative.dev/pkg/apis"
 	kubeclient "knative.dev/pkg/client/injection/kube/client"
 	"knative.dev/pkg/logging"
@@ -100,6 +105,54 @@ func (tc *TektonConfig) Validate(ctx context.Context) (errs *apis.FieldError) {
 			}
 			errs = errs.Also(sccErrors)
 		}
+
+		// validate SCCs granted to additional ServiceAccounts
+		saErrs := validateSCCServiceAccounts(tc.Spec.Platforms.OpenShift.SCC.ServiceAccounts, "spec.platforms.openshift.scc.serviceAccounts")
+		errs = errs.Also(saErrs)
+		if saErrs == nil {
+			for i, sa := range tc.Spec.Platforms.OpenShift.SCC.ServiceAccounts {
+				path := fmt.Sprintf("spec.platforms.openshift.scc.serviceAccounts[%d].scc", i)
+				if err := verifySCCExists(ctx, sa.SCC); err != nil {
+					errs = errs.Also(apis.ErrGeneric(fmt.Sprintf("error verifying SCC exists: %s - %v", sa.SCC, err), path))
+					continue
+				}
+				if maxAllowedSCC == "" {
+					continue
+				}
+				hasPriority, err := compareSCCAMoreRestrictiveThanB(ctx, sa.SCC, maxAllowedSCC)
+				if err != nil {
+					errs = errs.Also(apis.ErrGeneric(fmt.Sprintf("error comparing priority between maxAllowed and ServiceAccount SCC in TektonConfig: %v", err), path))
+				} else if !hasPriority {
+					errs = errs.Also(apis.ErrGeneric(fmt.Sprintf("SCC (%s) of ServiceAccount %s must not be less restrictive than the maxAllowed SCC (%s)", sa.SCC, sa.Name, maxAllowedSCC), path))
+				}
+			}
+		}
+	}
+
+	if IsOpenShiftPlatform() && tc.Spec.Platforms.OpenShift.EditRoleBinding != nil {
+		errs = errs.Also(validateEditRoleBindingSubjects(tc.Spec.Platforms.OpenShift.EditRoleBinding.Subjects, "spec.platforms.openshift.editRoleBinding.subjects"))
+	}
+
+	if IsOpenShiftPlatform() && tc.Spec.Platforms.OpenShift.RBAC != nil {
+		if name := tc.Spec.Platforms.OpenShift.RBAC.ServiceAccountName; name != "" {
+			if msgs := validation.IsDNS1123Subdomain(name); len(msgs) > 0 {
+				errs = errs.Also(apis.ErrInvalidValue(name, "spec.platforms.openshift.rbac.serviceAccountName", strings.Join(msgs, ", ")))
+			}
+		}
+		for i, name := range tc.Spec.Platforms.OpenShift.RBAC.AdditionalServiceAccounts {
+			if msgs := validation.IsDNS1123Subdomain(name); len(msgs) > 0 {
+				errs = errs.Also(apis.ErrInvalidValue(name, fmt.Sprintf("spec.platforms.openshift.rbac.additionalServiceAccounts[%d]", i), strings.Join(msgs, ", ")))
+			}
+		}
+		if name := tc.Spec.Platforms.OpenShift.RBAC.PipelineClusterRole; name != "" {
+			if msgs := path.IsValidPathSegmentName(name); len(msgs) > 0 {
+				errs = errs.Also(apis.ErrInvalidValue(name, "spec.platforms.openshift.rbac.pipelineClusterRole", strings.Join(msgs, ", ")))
+			}
+		}
+	}
+
+	if IsOpenShiftPlatform() && tc.Spec.Platforms.OpenShift.CABundles != nil {
+		errs = errs.Also(tc.Spec.Platforms.OpenShift.CABundles.validate("spec.platforms.openshift.caBundles"))
 	}
 
 	// validate pruner specifications (legacy job-based pruner)
@@ -118,6 +171,9 @@ func (tc *TektonConfig) Validate(ctx context.Context) (errs *apis.FieldError) {
 		errs = errs.Also(validateHubParams(tc.Spec.Hub.Params, "spec.hub.params"))
 	}
 
+	errs = errs.Also(validateTektonConfigParams(tc.Spec.Params, "spec.params"))
+	errs = errs.Also(validateParamsFrom(tc.Spec.ParamsFrom, "spec.paramsFrom"))
+
 	errs = errs.Also(tc.Spec.Pipeline.PipelineProperties.validate("spec.pipeline"))
 
 	errs = errs.Also(tc.Spec.Pipeline.Options.validate("spec.pipeline.options"))
@@ -126,14 +182,78 @@ func (tc *TektonConfig) Validate(ctx context.Context) (errs *apis.FieldError) {
 	errs = errs.Also(tc.Spec.Chain.Options.validate("spec.chain.options"))
 	errs = errs.Also(tc.Spec.Trigger.Options.validate("spec.trigger.options"))
 	errs = errs.Also(tc.Spec.Result.Options.validate("spec.result.options"))
+	if tc.Spec.Result.RetentionPolicy != nil {
+		errs = errs.Also(tc.Spec.Result.RetentionPolicy.validate("spec.result.retention_policy"))
+	}
+	if tc.Spec.Result.WorkloadIdentity != nil {
+		errs = errs.Also(tc.Spec.Result.WorkloadIdentity.validate("spec.result.workloadIdentity"))
+	}
+	if tc.Spec.Chain.WorkloadIdentity != nil {
+		errs = errs.Also(tc.Spec.Chain.WorkloadIdentity.validate("spec.chain.workloadIdentity"))
+	}
+	if tc.Spec.Chain.OCIRegistry != nil {
+		errs = errs.Also(tc.Spec.Chain.OCIRegistry.validate("spec.chain.ociRegistry"))
+	}
 	errs = errs.Also(tc.Spec.MulticlusterProxyAAE.Options.validate("spec.multiclusterProxyAAE.options"))
 
+	if tc.Spec.PayloadSwitchover != nil {
+		errs = errs.Also(tc.Spec.PayloadSwitchover.validate(tc.Spec.GetTargetNamespace(), "spec.payloadSwitchover"))
+	}
+
+	if tc.Spec.NamespaceOnboarding != nil {
+		errs = errs.Also(tc.Spec.NamespaceOnboarding.validate("spec.namespaceOnboarding"))
+	}
+
+	if tc.Spec.VulnerabilityGate != nil {
+		errs = errs.Also(tc.Spec.VulnerabilityGate.validate("spec.vulnerabilityGate"))
+	}
+
+	if tc.Spec.DeletionProtection != "" && tc.Spec.DeletionProtection != DeletionProtectionEnabled &&
+		tc.Spec.DeletionProtection != DeletionProtectionDisabled {
+		errs = errs.Also(apis.ErrInvalidValue(tc.Spec.DeletionProtection, "spec.deletionProtection",
+			fmt.Sprintf("must be %s or %s", DeletionProtectionEnabled, DeletionProtectionDisabled)))
+	}
+	if tc.Spec.Notifications != nil {
+		errs = errs.Also(tc.Spec.Notifications.validate("spec.notifications"))
+	}
+	if tc.Spec.TrustedCA != nil {
+		errs = errs.Also(tc.Spec.TrustedCA.validate("spec.trustedCA"))
+	}
+	if tc.Spec.Concurrency != nil {
+		errs = errs.Also(tc.Spec.Concurrency.validate("spec.concurrency"))
+	}
+	if tc.Spec.Policies != nil {
+		errs = errs.Also(tc.Spec.Policies.validate("spec.policies"))
+	}
+	if tc.Spec.PostRenderPatches != nil {
+		errs = errs.Also(tc.Spec.PostRenderPatches.validate("spec.postRenderPatches"))
+	}
+	if tc.Spec.DriftDetection != nil {
+		errs = errs.Also(tc.Spec.DriftDetection.validate("spec.driftDetection"))
+	}
+	if tc.Spec.PostInstallJobs != nil {
+		errs = errs.Also(tc.Spec.PostInstallJobs.validate("spec.postInstallJobs"))
+	}
+	if tc.Spec.Security != nil && tc.Spec.Security.TLS != nil {
+		errs = errs.Also(tc.Spec.Security.TLS.validate("spec.security.tls"))
+	}
+	if tc.Spec.Config.PodSecurity != nil {
+		errs = errs.Also(tc.Spec.Config.PodSecurity.validate("spec.config.podSecurity"))
+	}
+	if footprint := tc.Spec.Config.Footprint; footprint != "" && footprint != FootprintMinimal {
+		errs = errs.Also(apis.ErrInvalidValue(footprint, "spec.config.footprint"))
+	}
+
 	return errs.Also(tc.Spec.Trigger.TriggersProperties.validate("spec.trigger"))
 }
 
 func (p Prune) validate() *apis.FieldError {
 	var errs *apis.FieldError
 
+	if p.OrphanedPVCs != nil {
+		errs = errs.Also(p.OrphanedPVCs.validate("spec.pruner.orphaned-pvcs"))
+	}
+
 	// if pruner job disable no validation required
 	if p.Disabled {
 		return errs
@@ -170,6 +290,25 @@ func (p Prune) validate() *apis.FieldError {
 	return errs
 }
 
+func (s *OrphanedPVCScan) validate(path string) *apis.FieldError {
+	var errs *apis.FieldError
+	for _, f := range []struct{ field, value string }{{"min-age", s.MinAge}, {"interval", s.Interval}} {
+		field, value := f.field, f.value
+		if value == "" {
+			continue
+		}
+		if d, err := time.ParseDuration(value); err != nil || d <= 0 {
+			errs = errs.Also(apis.ErrInvalidValue(value, path+"."+field))
+		}
+	}
+	switch s.CleanupPolicy {
+	case "", OrphanedPVCPolicyReport, OrphanedPVCPolicyDelete:
+	default:
+		errs = errs.Also(apis.ErrInvalidValue(s.CleanupPolicy, path+".cleanup-policy"))
+	}
+	return errs
+}
+
 func isValueInArray(arr []string, key string) bool {
 	for _, p := range arr {
 		if p == key {
@@ -179,6 +318,89 @@ func isValueInArray(arr []string, key string) bool {
 	return false
 }
 
+// validateSCCServiceAccounts validates the SCCs granted to additional ServiceAccounts,
+// a ServiceAccount can be listed only once
+func validateSCCServiceAccounts(serviceAccounts []SCCServiceAccount, path string) *apis.FieldError {
+	var errs *apis.FieldError
+	seen := map[string]bool{}
+	for i, sa := range serviceAccounts {
+		if sa.Name == "" {
+			errs = errs.Also(apis.ErrMissingField(fmt.Sprintf("%s[%d].name", path, i)))
+		} else if msgs := validation.IsDNS1123Subdomain(sa.Name); len(msgs) > 0 {
+			errs = errs.Also(apis.ErrInvalidValue(sa.Name, fmt.Sprintf("%s[%d].name", path, i), strings.Join(msgs, ", ")))
+		} else if seen[sa.Name] {
+			errs = errs.Also(apis.ErrGeneric(fmt.Sprintf("ServiceAccount %s is listed more than once", sa.Name), fmt.Sprintf("%s[%d].name", path, i)))
+		}
+		seen[sa.Name] = true
+		if sa.SCC == "" {
+			errs = errs.Also(apis.ErrMissingField(fmt.Sprintf("%s[%d].scc", path, i)))
+		}
+	}
+	return errs
+}
+
+// validateEditRoleBindingSubjects validates the groups and users bound to the edit ClusterRole,
+// a subject can be listed only once
+func validateEditRoleBindingSubjects(subjects []EditRoleBindingSubject, path string) *apis.FieldError {
+	var errs *apis.FieldError
+	seen := map[EditRoleBindingSubject]bool{}
+	for i, s := range subjects {
+		if s.Kind != rbacv1.GroupKind && s.Kind != rbacv1.UserKind {
+			errs = errs.Also(apis.ErrInvalidValue(s.Kind, fmt.Sprintf("%s[%d].kind", path, i), fmt.Sprintf("must be %s or %s", rbacv1.GroupKind, rbacv1.UserKind)))
+		}
+		if s.Name == "" {
+			errs = errs.Also(apis.ErrMissingField(fmt.Sprintf("%s[%d].name", path, i)))
+		} else if seen[s] {
+			errs = errs.Also(apis.ErrGeneric(fmt.Sprintf("%s %s is listed more than once", s.Kind, s.Name), fmt.Sprintf("%s[%d].name", path, i)))
+		}
+		seen[s] = true
+	}
+	return errs
+}
+
+func (c *CABundles) validate(path string) *apis.FieldError {
+	var errs *apis.FieldError
+	for field, cm := range map[string]CABundleConfigMap{"trusted": c.Trusted, "service": c.Service} {
+		if cm.Name == "" {
+			continue
+		}
+		if msgs := validation.IsDNS1123Subdomain(cm.Name); len(msgs) > 0 {
+			errs = errs.Also(apis.ErrInvalidValue(cm.Name, fmt.Sprintf("%s.%s.name", path, field), strings.Join(msgs, ", ")))
+		}
+	}
+	if c.Trusted.Name != "" && c.Trusted.Name == c.Service.Name {
+		errs = errs.Also(apis.ErrGeneric("the trusted and service CA bundle ConfigMaps must have different names", path+".service.name"))
+	}
+	if len(c.Additional) > 0 && c.Trusted.Disabled {
+		errs = errs.Also(apis.ErrGeneric("the additional CAs are written to the trusted CA bundle ConfigMap, which is disabled", path+".additional"))
+	}
+	for i, source := range c.Additional {
+		p := fmt.Sprintf("%s.additional[%d]", path, i)
+		switch {
+		case source.ConfigMap != nil && source.Secret != nil:
+			errs = errs.Also(apis.ErrMultipleOneOf(p+".configMap", p+".secret"))
+		case source.ConfigMap != nil:
+			errs = errs.Also(validateKeySelector(source.ConfigMap.Name, source.ConfigMap.Key, p+".configMap"))
+		case source.Secret != nil:
+			errs = errs.Also(validateKeySelector(source.Secret.Name, source.Secret.Key, p+".secret"))
+		default:
+			errs = errs.Also(apis.ErrMissingOneOf(p+".configMap", p+".secret"))
+		}
+	}
+	return errs
+}
+
+func validateKeySelector(name, key, path string) *apis.FieldError {
+	var errs *apis.FieldError
+	if name == "" {
+		errs = errs.Also(apis.ErrMissingField(path + ".name"))
+	}
+	if key == "" {
+		errs = errs.Also(apis.ErrMissingField(path + ".key"))
+	}
+	return errs
+}
+
 func verifySCCExists(ctx context.Context, sccName string) error {
 	securityClient := common.GetSecurityClient(ctx)
 	_, err := securityClient.SecurityV1().SecurityContextConstraints().Get(ctx, sccName, metav1.GetOptions{})
@@ -23,6 +23,8 @@ import (
 	"github.com/tektoncd/pruner/pkg/config"
 	"gotest.tools/v3/assert"
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"knative.dev/pkg/apis"
 	"knative.dev/pkg/ptr"
@@ -107,6 +109,37 @@ func Test_ValidateTektonConfig_InvalidPruningResource(t *testing.T) {
 	assert.Equal(t, "expected exactly one, got neither: spec.pruner.keep, spec.pruner.keep-since\ninvalid value: task: spec.pruner.resources[0]", err.Error())
 }
 
+func Test_ValidateTektonConfig_InvalidOrphanedPVCScan(t *testing.T) {
+	tc := &TektonConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "config",
+			Namespace: "namespace",
+		},
+		Spec: TektonConfigSpec{
+			CommonSpec: CommonSpec{
+				TargetNamespace: "namespace",
+			},
+			Profile: "all",
+			Pruner: Prune{
+				Disabled: true,
+				OrphanedPVCs: &OrphanedPVCScan{
+					Enabled:       true,
+					MinAge:        "1d",
+					Interval:      "30m",
+					CleanupPolicy: "Archive",
+				},
+			},
+		},
+	}
+
+	err := tc.Validate(context.TODO())
+	assert.Equal(t, "invalid value: 1d: spec.pruner.orphaned-pvcs.min-age\ninvalid value: Archive: spec.pruner.orphaned-pvcs.cleanup-policy", err.Error())
+
+	tc.Spec.Pruner.OrphanedPVCs.MinAge = "24h"
+	tc.Spec.Pruner.OrphanedPVCs.CleanupPolicy = OrphanedPVCPolicyDelete
+	assert.Assert(t, tc.Validate(context.TODO()) == nil)
+}
+
 func Test_ValidateTektonConfig_MissingKeepKeepsinceSchedule(t *testing.T) {
 
 	tc := &TektonConfig{
@@ -241,6 +274,40 @@ func Test_ValidateTektonConfig_InvalidPipelineOptions(t *testing.T) {
 	assert.Equal(t, "invalid value: InvalidPolicy: spec.pipeline.options.webhookconfigurationoptions.failurePolicy", err.Error())
 }
 
+func Test_ValidateTektonConfig_InvalidWebhookSelectors(t *testing.T) {
+	invalidReinvocation := admissionregistrationv1.ReinvocationPolicyType("Always")
+	tc := &TektonConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "config",
+			Namespace: "namespace",
+		},
+		Spec: TektonConfigSpec{
+			CommonSpec: CommonSpec{
+				TargetNamespace: "namespace",
+			},
+			Profile: "all",
+			Pipeline: Pipeline{
+				Options: AdditionalOptions{
+					WebhookConfigurationOptions: map[string]WebhookConfigurationOptions{
+						"webhook.pipeline.tekton.dev": {
+							ReinvocationPolicy: &invalidReinvocation,
+							NamespaceSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{
+								Key:      "kubernetes.io/metadata.name",
+								Operator: metav1.LabelSelectorOpNotIn,
+							}}},
+						},
+					},
+				},
+			},
+			Pruner: Prune{Disabled: true},
+		},
+	}
+
+	err := tc.Validate(context.TODO())
+	assert.ErrorContains(t, err, "invalid value: Always: spec.pipeline.options.webhookconfigurationoptions.reinvocationPolicy")
+	assert.ErrorContains(t, err, "spec.pipeline.options.webhookconfigurationoptions.namespaceSelector")
+}
+
 func Test_ValidateTektonConfig_InvalidTriggerProperties(t *testing.T) {
 
 	tc := &TektonConfig{
@@ -367,3 +434,560 @@ func Test_ValidateTektonConfig_PrunerConfig_Invalid(t *testing.T) {
 	err := tc.Validate(context.TODO())
 	assert.ErrorContains(t, err, "pruner config validation failed")
 }
+
+func Test_ValidateTektonConfig_PayloadSwitchover(t *testing.T) {
+	tests := []struct {
+		name       string
+		switchover *PayloadSwitchover
+		wantErr    string
+	}{
+		{
+			name:       "valid",
+			switchover: &PayloadSwitchover{Enable: true, StagingNamespace: "tekton-staging", Switch: true},
+		},
+		{
+			name:       "missing staging namespace",
+			switchover: &PayloadSwitchover{Enable: true},
+			wantErr:    "missing field(s): spec.payloadSwitchover.stagingNamespace",
+		},
+		{
+			name:       "staging namespace same as target namespace",
+			switchover: &PayloadSwitchover{Enable: true, StagingNamespace: "namespace"},
+			wantErr:    "invalid value: namespace: spec.payloadSwitchover.stagingNamespace\nstaging namespace must be different from the target namespace",
+		},
+		{
+			name:       "switch without enable",
+			switchover: &PayloadSwitchover{Switch: true},
+			wantErr:    "switch requires the payload switchover to be enabled: spec.payloadSwitchover.switch",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tc := &TektonConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "config",
+				},
+				Spec: TektonConfigSpec{
+					CommonSpec: CommonSpec{
+						TargetNamespace: "namespace",
+					},
+					Pruner:            Prune{Disabled: true},
+					PayloadSwitchover: test.switchover,
+				},
+			}
+			err := tc.Validate(context.TODO())
+			if test.wantErr == "" {
+				assert.Assert(t, err == nil, "unexpected error: %v", err)
+				return
+			}
+			assert.Equal(t, test.wantErr, err.Error())
+		})
+	}
+}
+
+func Test_ValidateSCCServiceAccounts(t *testing.T) {
+	tests := []struct {
+		name            string
+		serviceAccounts []SCCServiceAccount
+		wantErr         string
+	}{
+		{
+			name:            "valid",
+			serviceAccounts: []SCCServiceAccount{{Name: "buildah-sa", SCC: "anyuid"}, {Name: "dind-sa", SCC: "privileged", Namespaces: []string{"ci"}}},
+		},
+		{
+			name:            "missing name and scc",
+			serviceAccounts: []SCCServiceAccount{{}},
+			wantErr:         "missing field(s): spec.platforms.openshift.scc.serviceAccounts[0].name, spec.platforms.openshift.scc.serviceAccounts[0].scc",
+		},
+		{
+			name:            "invalid name",
+			serviceAccounts: []SCCServiceAccount{{Name: "Buildah_SA", SCC: "anyuid"}},
+			wantErr:         "invalid value: Buildah_SA: spec.platforms.openshift.scc.serviceAccounts[0].name",
+		},
+		{
+			name:            "duplicate name",
+			serviceAccounts: []SCCServiceAccount{{Name: "buildah-sa", SCC: "anyuid"}, {Name: "buildah-sa", SCC: "privileged"}},
+			wantErr:         "ServiceAccount buildah-sa is listed more than once: spec.platforms.openshift.scc.serviceAccounts[1].name",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validateSCCServiceAccounts(test.serviceAccounts, "spec.platforms.openshift.scc.serviceAccounts")
+			if test.wantErr == "" {
+				assert.Assert(t, err == nil, "unexpected error: %v", err)
+				return
+			}
+			assert.ErrorContains(t, err, test.wantErr)
+		})
+	}
+}
+
+func Test_ValidateNamespaceOnboarding(t *testing.T) {
+	tc := &TektonConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "config"},
+		Spec: TektonConfigSpec{
+			CommonSpec: CommonSpec{TargetNamespace: "tekton-pipelines"},
+			NamespaceOnboarding: &NamespaceOnboarding{
+				Enable:             true,
+				ServiceAccountName: "Pipeline",
+				RoleBindings: []OnboardingRoleBinding{
+					{Name: "tekton-edit", ClusterRole: "edit"},
+					{Name: "tekton-edit", ClusterRole: "view"},
+					{Name: "tekton-view"},
+				},
+				StarterResources: &StarterResources{},
+			},
+		},
+	}
+	err := tc.Validate(context.TODO())
+	assert.ErrorContains(t, err, "invalid value: Pipeline: spec.namespaceOnboarding.serviceAccountName")
+	assert.ErrorContains(t, err, "roleBinding tekton-edit is set more than once: spec.namespaceOnboarding.roleBindings[1].name")
+	assert.ErrorContains(t, err, "missing field(s): spec.namespaceOnboarding.roleBindings[2].clusterRole")
+	assert.ErrorContains(t, err, "spec.namespaceOnboarding.starterResources.configMap")
+}
+
+func Test_ValidateVulnerabilityGate(t *testing.T) {
+	tc := &TektonConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "config"},
+		Spec: TektonConfigSpec{
+			CommonSpec: CommonSpec{TargetNamespace: "tekton-pipelines"},
+			Pruner:     Prune{Disabled: true},
+			VulnerabilityGate: &VulnerabilityGate{
+				Enable:   true,
+				Scanner:  "clair",
+				Endpoint: "quay.io",
+				Severity: "Severe",
+				Action:   "deny",
+			},
+		},
+	}
+	err := tc.Validate(context.TODO())
+	assert.ErrorContains(t, err, "invalid value: clair: spec.vulnerabilityGate.scanner")
+	assert.ErrorContains(t, err, "invalid value: quay.io: spec.vulnerabilityGate.endpoint")
+	assert.ErrorContains(t, err, "invalid value: Severe: spec.vulnerabilityGate.severity")
+	assert.ErrorContains(t, err, "invalid value: deny: spec.vulnerabilityGate.action")
+
+	tc.Spec.VulnerabilityGate = &VulnerabilityGate{Enable: true, Scanner: VulnerabilityScannerQuay, Endpoint: "https://quay.io", Severity: "critical"}
+	err = tc.Validate(context.TODO())
+	assert.Assert(t, err == nil, "unexpected error: %v", err)
+	assert.Assert(t, tc.Spec.VulnerabilityGate.Fails(SeverityCritical))
+	assert.Assert(t, !tc.Spec.VulnerabilityGate.Fails(SeverityHigh))
+}
+
+func Test_ValidateTrustedCA(t *testing.T) {
+	tc := &TektonConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "config"},
+		Spec: TektonConfigSpec{
+			CommonSpec: CommonSpec{TargetNamespace: "tekton-pipelines"},
+			Pruner:     Prune{Disabled: true},
+			TrustedCA: &TrustedCA{
+				Enable:        true,
+				Source:        "cert-manager",
+				Key:           "ca bundle",
+				UseDefaultCAs: true,
+			},
+		},
+	}
+	err := tc.Validate(context.TODO())
+	assert.ErrorContains(t, err, "invalid value: cert-manager: spec.trustedCA.source")
+	assert.ErrorContains(t, err, "missing field(s): spec.trustedCA.configMap")
+	assert.ErrorContains(t, err, "invalid value: ca bundle: spec.trustedCA.key")
+	assert.ErrorContains(t, err, "useDefaultCAs is only supported with the TrustManager source: spec.trustedCA.useDefaultCAs")
+
+	tc.Spec.TrustedCA = &TrustedCA{Enable: true, Source: TrustedCASourceTrustManager, ConfigMap: "corporate-ca", UseDefaultCAs: true}
+	err = tc.Validate(context.TODO())
+	assert.Assert(t, err == nil, "unexpected error: %v", err)
+	assert.Equal(t, tc.Spec.TrustedCA.GetKey(), DefaultTrustedCAKey)
+}
+
+func Test_ValidateNotifications(t *testing.T) {
+	tc := &TektonConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "config"},
+		Spec: TektonConfigSpec{
+			CommonSpec: CommonSpec{TargetNamespace: "tekton-pipelines"},
+			Pruner:     Prune{Disabled: true},
+			Notifications: &Notifications{Sinks: []NotificationSink{
+				{Name: "slack", Type: NotificationSinkSlack},
+				{Name: "slack", Type: "email", Events: []string{"deleted"}},
+				{Name: "hook", Type: NotificationSinkWebhook, URL: "ftp://example.com", SecretName: "hook"},
+				{Type: NotificationSinkEvents, URL: "https://example.com"},
+			}},
+		},
+	}
+	err := tc.Validate(context.TODO())
+	assert.ErrorContains(t, err, "expected exactly one, got neither: spec.notifications.sinks[0].secretName, spec.notifications.sinks[0].url")
+	assert.ErrorContains(t, err, "duplicate sink name \"slack\": spec.notifications.sinks[1].name")
+	assert.ErrorContains(t, err, "invalid value: email: spec.notifications.sinks[1].type")
+	assert.ErrorContains(t, err, "invalid value: deleted: spec.notifications.sinks[1].events[0]")
+	assert.ErrorContains(t, err, "expected exactly one, got both: spec.notifications.sinks[2].secretName, spec.notifications.sinks[2].url")
+	assert.ErrorContains(t, err, "invalid value: ftp://example.com: spec.notifications.sinks[2].url")
+	assert.ErrorContains(t, err, "missing field(s): spec.notifications.sinks[3].name")
+	assert.ErrorContains(t, err, "url and secretName are not supported by the events sink: spec.notifications.sinks[3]")
+
+	tc.Spec.Notifications = &Notifications{Sinks: []NotificationSink{
+		{Name: "slack", Type: NotificationSinkSlack, SecretName: "slack-webhook", Events: []string{NotificationDegraded}},
+		{Name: "events", Type: NotificationSinkEvents},
+	}}
+	err = tc.Validate(context.TODO())
+	assert.Assert(t, err == nil, "unexpected error: %v", err)
+}
+
+func Test_ValidateDeletionProtection(t *testing.T) {
+	tc := &TektonConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "config"},
+		Spec: TektonConfigSpec{
+			CommonSpec:         CommonSpec{TargetNamespace: "tekton-pipelines"},
+			Pruner:             Prune{Disabled: true},
+			DeletionProtection: "on",
+		},
+	}
+	err := tc.Validate(context.TODO())
+	assert.ErrorContains(t, err, "invalid value: on: spec.deletionProtection")
+
+	tc.Spec.DeletionProtection = DeletionProtectionEnabled
+	err = tc.Validate(context.TODO())
+	assert.Assert(t, err == nil, "unexpected error: %v", err)
+	assert.Assert(t, tc.DeletionBlocked())
+	tc.Annotations = map[string]string{DeletionConfirmationAnnotation: "true"}
+	assert.Assert(t, !tc.DeletionBlocked())
+}
+
+func Test_ValidateConcurrency(t *testing.T) {
+	noMatrix := 0
+	tc := &TektonConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "config"},
+		Spec: TektonConfigSpec{
+			CommonSpec: CommonSpec{TargetNamespace: "tekton-pipelines"},
+			Pruner:     Prune{Disabled: true},
+			Concurrency: &Concurrency{
+				MaxPipelineRunsPerNamespace: -1,
+				MaxMatrixCombinations:       &noMatrix,
+			},
+		},
+	}
+	err := tc.Validate(context.TODO())
+	assert.ErrorContains(t, err, "invalid value: -1: spec.concurrency.maxPipelineRunsPerNamespace")
+	assert.ErrorContains(t, err, "invalid value: 0: spec.concurrency.maxMatrixCombinations")
+
+	matrix := 64
+	tc.Spec.Concurrency = &Concurrency{MaxPipelineRunsPerNamespace: 20, MaxMatrixCombinations: &matrix}
+	err = tc.Validate(context.TODO())
+	assert.Assert(t, err == nil, "unexpected error: %v", err)
+}
+
+func Test_ValidatePolicies(t *testing.T) {
+	tc := &TektonConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "config"},
+		Spec: TektonConfigSpec{
+			CommonSpec: CommonSpec{TargetNamespace: "tekton-pipelines"},
+			Pruner:     Prune{Disabled: true},
+			Policies: &Policies{
+				RequiredPipelineRunLabels: []string{"team", "-invalid"},
+				ValidationActions: []admissionregistrationv1.ValidationAction{
+					admissionregistrationv1.Deny, admissionregistrationv1.Warn, "Block", admissionregistrationv1.Warn},
+			},
+		},
+	}
+	err := tc.Validate(context.TODO())
+	assert.ErrorContains(t, err, "invalid value: -invalid: spec.policies.requiredPipelineRunLabels[1]")
+	assert.ErrorContains(t, err, "invalid value: Block: spec.policies.validationActions[2]")
+	assert.ErrorContains(t, err, "duplicate validation action \"Warn\": spec.policies.validationActions[3]")
+	assert.ErrorContains(t, err, "Deny and Warn are not allowed together: spec.policies.validationActions")
+
+	tc.Spec.Policies = &Policies{ForbidLatestImages: true, RequiredPipelineRunLabels: []string{"app.kubernetes.io/name"},
+		ValidationActions: []admissionregistrationv1.ValidationAction{admissionregistrationv1.Warn, admissionregistrationv1.Audit}}
+	err = tc.Validate(context.TODO())
+	assert.Assert(t, err == nil, "unexpected error: %v", err)
+
+	tc.Spec.Policies = &Policies{Bundle: &PolicyBundle{Enable: true, Engine: "opa", Mode: "deny",
+		Sets: []string{PolicySetPinnedImages, "signedImages", PolicySetPinnedImages}}}
+	err = tc.Validate(context.TODO())
+	assert.ErrorContains(t, err, "invalid value: opa: spec.policies.bundle.engine")
+	assert.ErrorContains(t, err, "invalid value: deny: spec.policies.bundle.mode")
+	assert.ErrorContains(t, err, "invalid value: signedImages: spec.policies.bundle.sets[1]")
+	assert.ErrorContains(t, err, "duplicate policy set \"pinnedImages\": spec.policies.bundle.sets[2]")
+
+	tc.Spec.Policies = &Policies{Bundle: &PolicyBundle{Enable: true, Engine: PolicyEngineKyverno, Mode: PolicyBundleModeEnforce,
+		Sets: []string{PolicySetStepLimits}}}
+	err = tc.Validate(context.TODO())
+	assert.Assert(t, err == nil, "unexpected error: %v", err)
+}
+
+func Test_ValidatePodSecurity(t *testing.T) {
+	tc := &TektonConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "config"},
+		Spec: TektonConfigSpec{
+			CommonSpec: CommonSpec{TargetNamespace: "tekton-pipelines"},
+			Pruner:     Prune{Disabled: true},
+			Config: Config{PodSecurity: &PodSecurityDefaults{
+				SeccompProfile:  &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeLocalhost},
+				AppArmorProfile: &corev1.AppArmorProfile{Type: "Default"},
+			}},
+		},
+	}
+	err := tc.Validate(context.TODO())
+	assert.ErrorContains(t, err, "missing field(s): spec.config.podSecurity.seccompProfile.localhostProfile")
+	assert.ErrorContains(t, err, "invalid value: Default: spec.config.podSecurity.appArmorProfile.type")
+
+	profile := "profiles/tekton.json"
+	tc.Spec.Config.PodSecurity = &PodSecurityDefaults{
+		SeccompProfile:  &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault, LocalhostProfile: &profile},
+		AppArmorProfile: &corev1.AppArmorProfile{Type: corev1.AppArmorProfileTypeUnconfined},
+	}
+	err = tc.Validate(context.TODO())
+	assert.ErrorContains(t, err, "localhostProfile is only supported with the Localhost type: spec.config.podSecurity.seccompProfile.localhostProfile")
+
+	tc.Spec.Config.PodSecurity.SeccompProfile = &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeLocalhost, LocalhostProfile: &profile}
+	err = tc.Validate(context.TODO())
+	assert.Assert(t, err == nil, "unexpected error: %v", err)
+	assert.Equal(t, tc.Spec.Config.PodSecurity.AppArmorAnnotationValue(), "unconfined")
+	assert.Assert(t, tc.Spec.Config.PodSecurity.Violations(PodSecurityLevelPrivileged) == nil)
+	assert.DeepEqual(t, tc.Spec.Config.PodSecurity.Violations(PodSecurityLevelRestricted),
+		[]string{"appArmorProfile Unconfined is not allowed by the restricted level"})
+}
+
+func Test_ValidateEditRoleBindingSubjects(t *testing.T) {
+	err := validateEditRoleBindingSubjects([]EditRoleBindingSubject{
+		{Kind: "Group", Name: "developers"},
+		{Kind: "ServiceAccount", Name: "default"},
+		{Kind: "User"},
+		{Kind: "Group", Name: "developers"},
+	}, "spec.platforms.openshift.editRoleBinding.subjects")
+	assert.ErrorContains(t, err, "invalid value: ServiceAccount: spec.platforms.openshift.editRoleBinding.subjects[1].kind")
+	assert.ErrorContains(t, err, "missing field(s): spec.platforms.openshift.editRoleBinding.subjects[2].name")
+	assert.ErrorContains(t, err, "Group developers is listed more than once: spec.platforms.openshift.editRoleBinding.subjects[3].name")
+}
+
+func Test_ValidatePostRenderPatches(t *testing.T) {
+	tc := &TektonConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "config"},
+		Spec: TektonConfigSpec{
+			CommonSpec:        CommonSpec{TargetNamespace: "tekton-pipelines"},
+			Pruner:            Prune{Disabled: true},
+			PostRenderPatches: &PostRenderPatches{},
+		},
+	}
+	err := tc.Validate(context.TODO())
+	assert.ErrorContains(t, err, "missing field(s): spec.postRenderPatches.configMap")
+
+	tc.Spec.PostRenderPatches.ConfigMap = "Payload_Patches"
+	err = tc.Validate(context.TODO())
+	assert.ErrorContains(t, err, "invalid value: Payload_Patches: spec.postRenderPatches.configMap")
+
+	tc.Spec.PostRenderPatches.ConfigMap = "payload-patches"
+	err = tc.Validate(context.TODO())
+	assert.Assert(t, err == nil, "unexpected error: %v", err)
+}
+
+func Test_ValidateRBACServiceAccountName(t *testing.T) {
+	t.Setenv("PLATFORM", "openshift")
+	tc := &TektonConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "config"},
+		Spec: TektonConfigSpec{
+			CommonSpec: CommonSpec{TargetNamespace: "openshift-pipelines"},
+			Pruner:     Prune{Disabled: true},
+			Platforms: Platforms{OpenShift: OpenShift{
+				RBAC: &RBAC{ServiceAccountName: "Pipeline_SA"},
+			}},
+		},
+	}
+	err := tc.Validate(context.TODO())
+	assert.ErrorContains(t, err, "invalid value: Pipeline_SA: spec.platforms.openshift.rbac.serviceAccountName")
+
+	tc.Spec.Platforms.OpenShift.RBAC.ServiceAccountName = "ci-builder"
+	err = tc.Validate(context.TODO())
+	assert.Assert(t, err == nil, "unexpected error: %v", err)
+}
+
+func Test_ValidateRBACAdditionalServiceAccounts(t *testing.T) {
+	t.Setenv("PLATFORM", "openshift")
+	tc := &TektonConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "config"},
+		Spec: TektonConfigSpec{
+			CommonSpec: CommonSpec{TargetNamespace: "openshift-pipelines"},
+			Pruner:     Prune{Disabled: true},
+			Platforms: Platforms{OpenShift: OpenShift{
+				RBAC: &RBAC{AdditionalServiceAccounts: []string{"buildbot", "Deployer_SA"}},
+			}},
+		},
+	}
+	err := tc.Validate(context.TODO())
+	assert.ErrorContains(t, err, "invalid value: Deployer_SA: spec.platforms.openshift.rbac.additionalServiceAccounts[1]")
+
+	tc.Spec.Platforms.OpenShift.RBAC.AdditionalServiceAccounts = []string{"buildbot", "deployer"}
+	err = tc.Validate(context.TODO())
+	assert.Assert(t, err == nil, "unexpected error: %v", err)
+}
+
+func Test_ValidateRBACPipelineClusterRole(t *testing.T) {
+	t.Setenv("PLATFORM", "openshift")
+	tc := &TektonConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "config"},
+		Spec: TektonConfigSpec{
+			CommonSpec: CommonSpec{TargetNamespace: "openshift-pipelines"},
+			Pruner:     Prune{Disabled: true},
+			Platforms: Platforms{OpenShift: OpenShift{
+				RBAC: &RBAC{PipelineClusterRole: "pipelines/edit"},
+			}},
+		},
+	}
+	err := tc.Validate(context.TODO())
+	assert.ErrorContains(t, err, "invalid value: pipelines/edit: spec.platforms.openshift.rbac.pipelineClusterRole")
+
+	tc.Spec.Platforms.OpenShift.RBAC.PipelineClusterRole = "pipelines-edit-no-secrets"
+	err = tc.Validate(context.TODO())
+	assert.Assert(t, err == nil, "unexpected error: %v", err)
+}
+
+func Test_ValidateCABundles(t *testing.T) {
+	t.Setenv("PLATFORM", "openshift")
+	tc := &TektonConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "config"},
+		Spec: TektonConfigSpec{
+			CommonSpec: CommonSpec{TargetNamespace: "openshift-pipelines"},
+			Pruner:     Prune{Disabled: true},
+			Platforms: Platforms{OpenShift: OpenShift{
+				CABundles: &CABundles{
+					Trusted: CABundleConfigMap{Name: "Proxy_CABundle", Disabled: true},
+					Additional: []CABundleSource{
+						{},
+						{ConfigMap: &corev1.ConfigMapKeySelector{Key: "ca.crt"}},
+						{
+							ConfigMap: &corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "proxy-ca"}, Key: "ca.crt"},
+							Secret:    &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "proxy-ca"}, Key: "ca.crt"},
+						},
+					},
+				},
+			}},
+		},
+	}
+	err := tc.Validate(context.TODO())
+	assert.ErrorContains(t, err, "invalid value: Proxy_CABundle: spec.platforms.openshift.caBundles.trusted.name")
+	assert.ErrorContains(t, err, "the additional CAs are written to the trusted CA bundle ConfigMap, which is disabled: spec.platforms.openshift.caBundles.additional")
+	assert.ErrorContains(t, err, "expected exactly one, got neither: spec.platforms.openshift.caBundles.additional[0].configMap, spec.platforms.openshift.caBundles.additional[0].secret")
+	assert.ErrorContains(t, err, "missing field(s): spec.platforms.openshift.caBundles.additional[1].configMap.name")
+	assert.ErrorContains(t, err, "expected exactly one, got both: spec.platforms.openshift.caBundles.additional[2].configMap, spec.platforms.openshift.caBundles.additional[2].secret")
+
+	tc.Spec.Platforms.OpenShift.CABundles = &CABundles{
+		Trusted: CABundleConfigMap{Name: "proxy-cabundle"},
+		Service: CABundleConfigMap{Name: "proxy-cabundle"},
+	}
+	err = tc.Validate(context.TODO())
+	assert.ErrorContains(t, err, "the trusted and service CA bundle ConfigMaps must have different names: spec.platforms.openshift.caBundles.service.name")
+
+	tc.Spec.Platforms.OpenShift.CABundles = &CABundles{
+		Trusted: CABundleConfigMap{Name: "proxy-cabundle"},
+		Service: CABundleConfigMap{Disabled: true},
+		Additional: []CABundleSource{
+			{Secret: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "proxy-ca"}, Key: "ca.crt"}},
+		},
+	}
+	err = tc.Validate(context.TODO())
+	assert.Assert(t, err == nil, "unexpected error: %v", err)
+}
+
+func Test_ValidateTLSPolicy(t *testing.T) {
+	tc := &TektonConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "config"},
+		Spec: TektonConfigSpec{
+			CommonSpec: CommonSpec{TargetNamespace: "tekton-pipelines"},
+			Pruner:     Prune{Disabled: true},
+			Security: &Security{TLS: &TLSPolicy{
+				MinVersion:   "1.1",
+				CipherSuites: []string{"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256", "TLS_RSA_WITH_RC4_128_SHA"},
+			}},
+		},
+	}
+	err := tc.Validate(context.TODO())
+	assert.ErrorContains(t, err, "invalid value: 1.1: spec.security.tls.minVersion")
+	assert.ErrorContains(t, err, "invalid value: TLS_RSA_WITH_RC4_128_SHA: spec.security.tls.cipherSuites[1]")
+
+	tc.Spec.Security.TLS = &TLSPolicy{MinVersion: TLSVersion13, CipherSuites: []string{"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256"}}
+	err = tc.Validate(context.TODO())
+	assert.ErrorContains(t, err, "the cipher suites of TLS 1.3 cannot be configured: spec.security.tls.cipherSuites")
+
+	tc.Spec.Security.TLS = &TLSPolicy{MinVersion: TLSVersion12, CipherSuites: []string{"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256"}}
+	err = tc.Validate(context.TODO())
+	assert.Assert(t, err == nil, "unexpected error: %v", err)
+}
+
+func Test_ValidateDriftDetection(t *testing.T) {
+	tc := &TektonConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "config"},
+		Spec: TektonConfigSpec{
+			CommonSpec:     CommonSpec{TargetNamespace: "tekton-pipelines"},
+			Pruner:         Prune{Disabled: true},
+			DriftDetection: &DriftDetection{Enabled: true, Interval: "-5m", Policy: "Revert"},
+		},
+	}
+	err := tc.Validate(context.TODO())
+	assert.ErrorContains(t, err, "invalid value: -5m: spec.driftDetection.interval")
+	assert.ErrorContains(t, err, "invalid value: Revert: spec.driftDetection.policy")
+
+	tc.Spec.DriftDetection = &DriftDetection{Enabled: true, Interval: "30m", Policy: DriftPolicyRepair}
+	err = tc.Validate(context.TODO())
+	assert.Assert(t, err == nil, "unexpected error: %v", err)
+}
+
+func Test_ValidatePostInstallJobs(t *testing.T) {
+	tc := &TektonConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "config"},
+		Spec: TektonConfigSpec{
+			CommonSpec: CommonSpec{TargetNamespace: "tekton-pipelines"},
+			Pruner:     Prune{Disabled: true},
+			PostInstallJobs: &PostInstallJobs{
+				ActiveDeadlineSeconds: ptr.Int64(0),
+				BackoffLimit:          ptr.Int32(-1),
+				Parallelism:           ptr.Int32(-2),
+				Resources: &corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("512Mi")},
+					Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("256Mi")},
+				},
+			},
+		},
+	}
+	err := tc.Validate(context.TODO())
+	assert.ErrorContains(t, err, "invalid value: 0: spec.postInstallJobs.activeDeadlineSeconds")
+	assert.ErrorContains(t, err, "invalid value: -1: spec.postInstallJobs.backoffLimit")
+	assert.ErrorContains(t, err, "invalid value: -2: spec.postInstallJobs.parallelism")
+	assert.ErrorContains(t, err, "invalid value: 512Mi: spec.postInstallJobs.resources.requests.memory")
+
+	tc.Spec.PostInstallJobs = &PostInstallJobs{
+		ActiveDeadlineSeconds: ptr.Int64(600),
+		BackoffLimit:          ptr.Int32(0),
+		Parallelism:           ptr.Int32(2),
+		Resources: &corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("128Mi")},
+			Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("256Mi")},
+		},
+	}
+	err = tc.Validate(context.TODO())
+	assert.Assert(t, err == nil, "unexpected error: %v", err)
+}
+
+func Test_ValidateTektonConfig_InvalidParamsFrom(t *testing.T) {
+	tc := &TektonConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "config",
+			Namespace: "namespace",
+		},
+		Spec: TektonConfigSpec{
+			CommonSpec: CommonSpec{
+				TargetNamespace: "namespace",
+			},
+			Profile: "all",
+			Pruner:  Prune{Disabled: true},
+			ParamsFrom: []ParamsFromSource{
+				{ConfigMap: &corev1.LocalObjectReference{Name: "params"}},
+				{Secret: &corev1.LocalObjectReference{Name: "pipeline"}, Component: "pipeline", Optional: true},
+				{ConfigMap: &corev1.LocalObjectReference{Name: "both"}, Secret: &corev1.LocalObjectReference{Name: "both"}},
+				{Component: "pruner"},
+				{Secret: &corev1.LocalObjectReference{}},
+			},
+		},
+	}
+
+	err := tc.Validate(context.TODO())
+	assert.Equal(t, "expected exactly one, got both: spec.paramsFrom[2].configMap, spec.paramsFrom[2].secret\nexpected exactly one, got neither: spec.paramsFrom[3].configMap, spec.paramsFrom[3].secret\ninvalid value: pruner: spec.paramsFrom[3].component\nmust be one of pipeline, trigger, chain, result, dashboard\nmissing field(s): spec.paramsFrom[4].secret.name", err.Error())
+}
@@ -31,6 +31,14 @@ const (
 	ControllerReady      apis.ConditionType = "ControllersReady"
 	AllDeploymentsReady  apis.ConditionType = "AllDeploymentsReady"
 	JobsInstalled        apis.ConditionType = "JobsInstalled"
+	// ZonesSpread reports the Deployments spread across the zones by a topology spread constraint
+	// whose replicas run in fewer zones than they could, it is informational and does not affect
+	// the Ready condition
+	ZonesSpread apis.ConditionType = "ZonesSpread"
+	// KindsServed reports the resources of the manifests skipped as their kinds are not served by
+	// the cluster, e.g. Routes on Kubernetes, it is informational and does not affect the Ready
+	// condition
+	KindsServed apis.ConditionType = "KindsServed"
 )
 
 var (
@@ -181,3 +189,33 @@ func (tis *TektonInstallerSetStatus) MarkJobsInstallationFailed(msg string) {
 		"Error",
 		"Install failed with message: %s", msg)
 }
+
+func (tis *TektonInstallerSetStatus) MarkZonesSpread() {
+	installerSetCondSet.Manage(tis).MarkTrue(ZonesSpread)
+}
+
+func (tis *TektonInstallerSetStatus) MarkZonesSpreadDegraded(msg string) {
+	installerSetCondSet.Manage(tis).MarkFalse(
+		ZonesSpread,
+		"Degraded",
+		"%s", msg)
+}
+
+func (tis *TektonInstallerSetStatus) ClearZonesSpread() {
+	_ = installerSetCondSet.Manage(tis).ClearCondition(ZonesSpread)
+}
+
+func (tis *TektonInstallerSetStatus) MarkKindsServed() {
+	installerSetCondSet.Manage(tis).MarkTrue(KindsServed)
+}
+
+func (tis *TektonInstallerSetStatus) MarkKindsNotServed(msg string) {
+	installerSetCondSet.Manage(tis).MarkFalse(
+		KindsServed,
+		"Skipped",
+		"%s", msg)
+}
+
+func (tis *TektonInstallerSetStatus) ClearKindsServed() {
+	_ = installerSetCondSet.Manage(tis).ClearCondition(KindsServed)
+}
@@ -38,6 +38,21 @@ type TektonInstallerSet struct {
 // TektonInstallerSetSpec defines the desired state of TektonInstallerSet
 type TektonInstallerSetSpec struct {
 	Manifests mf.Slice `json:"manifests,omitempty"`
+	// ManifestsRef references the manifests stored outside of the installer set, it is used when
+	// the manifests are not set
+	// +optional
+	ManifestsRef *ManifestsReference `json:"manifestsRef,omitempty"`
+}
+
+// ManifestsReference references the manifests of an installer set stored in ConfigMaps, so that
+// large payloads do not reach the size limit of the objects in etcd
+type ManifestsReference struct {
+	// Namespace of the ConfigMaps
+	Namespace string `json:"namespace"`
+	// ConfigMaps holding the gzipped chunks of the manifests, in order
+	ConfigMaps []string `json:"configMaps"`
+	// SHA256 of the manifests, verified when they are loaded
+	SHA256 string `json:"sha256"`
 }
 
 // TektonInstallerSetStatus defines the observed state of TektonInstallerSet
@@ -0,0 +1,69 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TektonNamespaceStatus reports the onboarding state of a namespace reconciled by the operator on
+// OpenShift, it is named after the namespace and maintained by the RBAC reconciler
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type TektonNamespaceStatus struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Status NamespaceOnboardingStatus `json:"status,omitempty"`
+}
+
+// NamespaceOnboardingStatus is the onboarding state of a namespace
+type NamespaceOnboardingStatus struct {
+	// RBACVersion is the version of the operator which reconciled the RBAC resources
+	// +optional
+	RBACVersion string `json:"rbacVersion,omitempty"`
+	// CAVersion is the version of the operator which reconciled the CA bundle ConfigMaps
+	// +optional
+	CAVersion string `json:"caVersion,omitempty"`
+	// SCC granted to the pipelines of the namespace
+	// +optional
+	SCC string `json:"scc,omitempty"`
+	// IneffectivePermissions are the permissions of the pipelines denied to the ServiceAccount of
+	// the namespace although its bindings are reconciled, e.g. by a deny policy or because the
+	// bound ClusterRole does not exist
+	// +optional
+	IneffectivePermissions []string `json:"ineffectivePermissions,omitempty"`
+	// LastError of the reconciliation of the namespace, it is cleared once the namespace is reconciled
+	// +optional
+	LastError string `json:"lastError,omitempty"`
+	// LastErrorTime is the time of the last error
+	// +optional
+	LastErrorTime *metav1.Time `json:"lastErrorTime,omitempty"`
+	// LastUpdateTime is the time the state changed
+	// +optional
+	LastUpdateTime metav1.Time `json:"lastUpdateTime,omitempty"`
+}
+
+// TektonNamespaceStatusList contains a list of TektonNamespaceStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type TektonNamespaceStatusList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TektonNamespaceStatus `json:"items"`
+}
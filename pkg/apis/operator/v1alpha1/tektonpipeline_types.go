@@ -161,6 +161,16 @@ type WebhookConfigurationOptions struct {
 	FailurePolicy  *admissionregistrationv1.FailurePolicyType `json:"failurePolicy,omitempty"`
 	TimeoutSeconds *int32                                     `json:"timeoutSeconds,omitempty"`
 	SideEffects    *admissionregistrationv1.SideEffectClass   `json:"sideEffects,omitempty"`
+	// NamespaceSelector is added to the namespace selector of the webhook, the requests for the
+	// objects of the namespaces it does not match are not sent to the webhook
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+	// ObjectSelector is added to the object selector of the webhook
+	// +optional
+	ObjectSelector *metav1.LabelSelector `json:"objectSelector,omitempty"`
+	// ReinvocationPolicy of a mutating webhook
+	// +optional
+	ReinvocationPolicy *admissionregistrationv1.ReinvocationPolicyType `json:"reinvocationPolicy,omitempty"`
 }
 
 // PipelineMetricsProperties defines the fields which are configurable for
@@ -99,6 +99,8 @@ func (p *PipelineProperties) validate(path string) (errs *apis.FieldError) {
 		errs = errs.Also(apis.ErrInvalidValue(p.Coschedule, fmt.Sprintf("%s.coschedule", path)))
 	}
 
+	errs = errs.Also(p.OptionalPipelineProperties.validatePodTemplates(path))
+
 	// validate performance properties
 	errs = errs.Also(p.Performance.Validate(fmt.Sprintf("%s.performance", path)))
 
@@ -245,6 +245,53 @@ func TestValidateTektonPipeline_DisableInlineSpec(t *testing.T) {
 	}
 }
 
+func TestValidateTektonPipeline_PodTemplates(t *testing.T) {
+	tp := &TektonPipeline{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "pipeline",
+			Namespace: "tekton-pipelines-ns",
+		},
+		Spec: TektonPipelineSpec{
+			CommonSpec: CommonSpec{
+				TargetNamespace: "tekton-pipelines-ns",
+			},
+		},
+	}
+
+	tests := []struct {
+		name              string
+		podTemplate       string
+		affinityAssistant string
+		err               string
+	}{
+		{name: "valid templates", podTemplate: "nodeSelector:\n  disk: ssd\nsecurityContext:\n  runAsNonRoot: true", affinityAssistant: "tolerations:\n- key: foo\n  operator: Exists"},
+		{
+			name:        "malformed YAML",
+			podTemplate: "nodeSelector:\n  disk: ssd\n env: [",
+			err:         "invalid pod template: error converting YAML to JSON: yaml: line 2: did not find expected key: spec.default-pod-template",
+		},
+		{
+			name:        "unknown field",
+			podTemplate: "nodeSelectors:\n  disk: ssd",
+			err:         `invalid pod template: error unmarshaling JSON: while decoding JSON: json: unknown field "nodeSelectors": spec.default-pod-template`,
+		},
+		{
+			name:              "field not supported by the affinity assistant",
+			affinityAssistant: "volumes: []",
+			err:               `invalid affinity assistant pod template: error unmarshaling JSON: while decoding JSON: json: unknown field "volumes": spec.default-affinity-assistant-pod-template`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tp.Spec.Pipeline.DefaultPodTemplate = test.podTemplate
+			tp.Spec.Pipeline.DefaultAffinityAssistantPodTemplate = test.affinityAssistant
+			errs := tp.Validate(context.TODO())
+			assert.Equal(t, test.err, errs.Error())
+		})
+	}
+}
+
 func Test_ValidateTektonPipeline_OnDelete(t *testing.T) {
 
 	td := &TektonPipeline{
@@ -32,6 +32,12 @@ var (
 	)
 )
 
+const (
+	// APITLSVerified reports the TLS handshake with the Results API with the CA of its serving
+	// certificate, it does not affect the readiness of TektonResult
+	APITLSVerified apis.ConditionType = "APITLSVerified"
+)
+
 // GroupVersionKind returns SchemeGroupVersion of a TektonResult
 func (tr *TektonResult) GroupVersionKind() schema.GroupVersionKind {
 	return SchemeGroupVersion.WithKind(KindTektonResult)
@@ -135,3 +141,35 @@ func (trs *TektonResultStatus) GetVersion() string {
 func (trs *TektonResultStatus) SetVersion(version string) {
 	trs.Version = version
 }
+
+func (trs *TektonResultStatus) MarkWorkloadIdentityReady() {
+	resultsCondSet.Manage(trs).MarkTrue(WorkloadIdentityReady)
+}
+
+func (trs *TektonResultStatus) MarkWorkloadIdentityNotReady(msg string) {
+	resultsCondSet.Manage(trs).MarkFalse(
+		WorkloadIdentityReady,
+		"Error",
+		"Workload identity not configured: %s", msg)
+}
+
+// ClearWorkloadIdentity removes the WorkloadIdentityReady condition once the workload identity is not configured
+func (trs *TektonResultStatus) ClearWorkloadIdentity() {
+	_ = resultsCondSet.Manage(trs).ClearCondition(WorkloadIdentityReady)
+}
+
+func (trs *TektonResultStatus) MarkAPITLSVerified() {
+	resultsCondSet.Manage(trs).MarkTrue(APITLSVerified)
+}
+
+func (trs *TektonResultStatus) MarkAPITLSVerificationFailed(msg string) {
+	resultsCondSet.Manage(trs).MarkFalse(
+		APITLSVerified,
+		"Error",
+		"%s", msg)
+}
+
+// ClearAPITLSVerified removes the APITLSVerified condition where the serving certificate is not generated by the operator
+func (trs *TektonResultStatus) ClearAPITLSVerified() {
+	_ = resultsCondSet.Manage(trs).ClearCondition(APITLSVerified)
+}
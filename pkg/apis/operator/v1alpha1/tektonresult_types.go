@@ -75,6 +75,26 @@ type Result struct {
 	Options AdditionalOptions `json:"options"`
 	// +optional
 	Performance PerformanceProperties `json:"performance,omitempty"`
+	// RetentionPolicy holds the configuration of the retention-policy-agent
+	// +optional
+	RetentionPolicy *RetentionPolicyProperties `json:"retention_policy,omitempty"`
+	// WorkloadIdentity configures the cloud workload identity of the Results API service account
+	// +optional
+	WorkloadIdentity *WorkloadIdentity `json:"workloadIdentity,omitempty"`
+}
+
+// RetentionPolicyProperties defines the fields which are configurable for
+// the retention-policy-agent, these are rendered into the retention policy ConfigMap
+type RetentionPolicyProperties struct {
+	// RunAt is the cron schedule at which the retention-policy-agent prunes the records
+	// +optional
+	RunAt string `json:"runAt,omitempty"`
+	// MaxRetention is the number of days after which results and records are pruned
+	// +optional
+	MaxRetention *uint `json:"maxRetention,omitempty"`
+	// MaxRecords is the maximum number of records kept per result, older records are pruned first
+	// +optional
+	MaxRecords *uint `json:"maxRecords,omitempty"`
 }
 
 // ResultsAPIProperties defines the fields which are configurable for
@@ -141,6 +161,17 @@ type TektonResultStatus struct {
 	// The current installer set name for TektonResult
 	// +optional
 	TektonInstallerSet string `json:"tektonInstallerSet,omitempty"`
+
+	// The state of the retention-policy-agent
+	// +optional
+	RetentionPolicy *RetentionPolicyStatus `json:"retentionPolicy,omitempty"`
+}
+
+// RetentionPolicyStatus defines the observed state of the retention-policy-agent
+type RetentionPolicyStatus struct {
+	// RunAt is the cron schedule the retention-policy-agent is configured with
+	// +optional
+	RunAt string `json:"runAt,omitempty"`
 }
 
 func (trs *TektonResultStatus) MarkPreReconcilerFailed(msg string) {
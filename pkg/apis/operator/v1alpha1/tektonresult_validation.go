@@ -57,5 +57,30 @@ func (trs *TektonResultSpec) validate(path string) (errs *apis.FieldError) {
 	// validate performance properties
 	errs = errs.Also(trs.Performance.Validate(fmt.Sprintf("%s.performance", path)))
 
+	if trs.RetentionPolicy != nil {
+		errs = errs.Also(trs.RetentionPolicy.validate(fmt.Sprintf("%s.retention_policy", path)))
+	}
+
+	if trs.WorkloadIdentity != nil {
+		errs = errs.Also(trs.WorkloadIdentity.validate(fmt.Sprintf("%s.workloadIdentity", path)))
+	}
+
+	return errs
+}
+
+func (rp *RetentionPolicyProperties) validate(path string) (errs *apis.FieldError) {
+	if rp.RunAt != "" {
+		// the schedule is parsed by the retention-policy-agent, only its standard 5 fields format is checked
+		if fields := strings.Fields(rp.RunAt); len(fields) != 5 {
+			errs = errs.Also(apis.ErrInvalidValue(rp.RunAt, fmt.Sprintf("%s.runAt", path),
+				fmt.Sprintf("expected 5 fields in cron schedule %q, found %d", rp.RunAt, len(fields))))
+		}
+	}
+	if rp.MaxRetention != nil && *rp.MaxRetention == 0 {
+		errs = errs.Also(apis.ErrInvalidValue(*rp.MaxRetention, fmt.Sprintf("%s.maxRetention", path), "must be greater than 0"))
+	}
+	if rp.MaxRecords != nil && *rp.MaxRecords == 0 {
+		errs = errs.Also(apis.ErrInvalidValue(*rp.MaxRecords, fmt.Sprintf("%s.maxRecords", path), "must be greater than 0"))
+	}
 	return errs
 }
@@ -113,3 +113,45 @@ func TestTektonResultWatcherPerformancePropertiesValidate(t *testing.T) {
 		"spec.performance.replicas must equal spec.performance.buckets for statefulset ordinals"
 	assert.Equal(t, expectedErrorMessage, errs.Error())
 }
+
+func TestTektonResultRetentionPolicyValidate(t *testing.T) {
+	zero := uint(0)
+	thirty := uint(30)
+	tests := []struct {
+		name    string
+		policy  *RetentionPolicyProperties
+		wantErr string
+	}{
+		{
+			name:   "valid",
+			policy: &RetentionPolicyProperties{RunAt: "7 7 * * 7", MaxRetention: &thirty, MaxRecords: &thirty},
+		},
+		{
+			name:    "invalid schedule",
+			policy:  &RetentionPolicyProperties{RunAt: "every sunday"},
+			wantErr: "invalid value: every sunday: spec.retention_policy.runAt\nexpected 5 fields in cron schedule \"every sunday\", found 2",
+		},
+		{
+			name:    "zero retention and records",
+			policy:  &RetentionPolicyProperties{MaxRetention: &zero, MaxRecords: &zero},
+			wantErr: "invalid value: 0: spec.retention_policy.maxRecords, spec.retention_policy.maxRetention\nmust be greater than 0",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tr := &TektonResult{
+				ObjectMeta: metav1.ObjectMeta{Name: "result"},
+				Spec: TektonResultSpec{
+					CommonSpec: CommonSpec{TargetNamespace: "foo"},
+					Result:     Result{RetentionPolicy: test.policy},
+				},
+			}
+			errs := tr.Validate(context.TODO())
+			if test.wantErr == "" {
+				assert.Assert(t, errs == nil, "unexpected error: %v", errs)
+				return
+			}
+			assert.Equal(t, test.wantErr, errs.Error())
+		})
+	}
+}
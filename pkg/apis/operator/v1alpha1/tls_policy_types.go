@@ -0,0 +1,47 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import "knative.dev/pkg/apis"
+
+const (
+	TLSVersion12 = "1.2"
+	TLSVersion13 = "1.3"
+
+	// TLSPolicyCompliant reports whether the endpoints of the components reject the TLS versions and
+	// cipher suites excluded by the TLS policy, it does not change the readiness of the TektonConfig
+	TLSPolicyCompliant apis.ConditionType = "TLSPolicyCompliant"
+)
+
+// Security holds the security settings applied to the components
+type Security struct {
+	// TLS is the policy of the webhook servers and HTTPS endpoints of the components
+	// +optional
+	TLS *TLSPolicy `json:"tls,omitempty"`
+}
+
+// TLSPolicy sets the minimum TLS version and the cipher suites of the webhook servers and of the
+// TLS proxies of the metrics of the components. The webhooks only support the minimum TLS version.
+type TLSPolicy struct {
+	// MinVersion is the minimum TLS version, 1.2 or 1.3
+	// +optional
+	MinVersion string `json:"minVersion,omitempty"`
+	// CipherSuites are the TLS 1.2 cipher suites, by their IANA names, the cipher suites of TLS 1.3
+	// cannot be configured
+	// +optional
+	CipherSuites []string `json:"cipherSuites,omitempty"`
+}
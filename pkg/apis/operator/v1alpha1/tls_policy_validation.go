@@ -0,0 +1,46 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"crypto/tls"
+	"fmt"
+
+	"knative.dev/pkg/apis"
+)
+
+func (p *TLSPolicy) validate(path string) *apis.FieldError {
+	var errs *apis.FieldError
+	switch p.MinVersion {
+	case "", TLSVersion12, TLSVersion13:
+	default:
+		errs = errs.Also(apis.ErrInvalidValue(p.MinVersion, path+".minVersion", fmt.Sprintf("must be %s or %s", TLSVersion12, TLSVersion13)))
+	}
+	if p.MinVersion == TLSVersion13 && len(p.CipherSuites) > 0 {
+		errs = errs.Also(apis.ErrGeneric("the cipher suites of TLS 1.3 cannot be configured", path+".cipherSuites"))
+	}
+	secure := map[string]bool{}
+	for _, c := range tls.CipherSuites() {
+		secure[c.Name] = true
+	}
+	for i, name := range p.CipherSuites {
+		if !secure[name] {
+			errs = errs.Also(apis.ErrInvalidValue(name, fmt.Sprintf("%s.cipherSuites[%d]", path, i), "must be a secure TLS 1.2 cipher suite"))
+		}
+	}
+	return errs
+}
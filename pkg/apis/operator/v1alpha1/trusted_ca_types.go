@@ -0,0 +1,83 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+const (
+	// TrustedCASourceConfigMap copies the certificates of a ConfigMap of the operator namespace
+	TrustedCASourceConfigMap = "ConfigMap"
+	// TrustedCASourceTrustManager distributes the certificates with a trust-manager Bundle
+	TrustedCASourceTrustManager = "TrustManager"
+	// DefaultTrustedCAKey is the key of the certificates in the source ConfigMap when none is set
+	DefaultTrustedCAKey = "ca-bundle.crt"
+	// TrustedCAInjectionLabel set to "true" on a ConfigMap requests the injection of the trusted
+	// CA certificates, it is the label honoured by OpenShift
+	TrustedCAInjectionLabel = "config.openshift.io/inject-trusted-cabundle"
+	// NamespaceCABundleOptOutAnnotation set to "true" on a namespace opts it out of the CA bundle
+	// ConfigMaps created by the operator
+	NamespaceCABundleOptOutAnnotation = "operator.tekton.dev/ca-bundle-opt-out"
+	// NamespaceExtraCAAnnotation names a ConfigMap of the namespace whose certificates are appended
+	// to the trusted CA certificates of the config-trusted-cabundle ConfigMap of the namespace
+	NamespaceExtraCAAnnotation = "operator.tekton.dev/extra-ca-configmap"
+	// NamespaceAdditionalTrustAnnotation holds PEM encoded certificates of a namespace, appended to
+	// the trusted CA certificates of the cluster in the config-additional-trust-bundle ConfigMap
+	// of the namespace
+	NamespaceAdditionalTrustAnnotation = "operator.tekton.dev/additional-trust"
+)
+
+// TrustedCA distributes trusted CA certificates to the config-trusted-cabundle ConfigMap of the
+// namespaces on Kubernetes, where the CA injection of OpenShift is not available. It is ignored
+// on OpenShift.
+type TrustedCA struct {
+	// Enable distributes the certificates
+	// +optional
+	Enable bool `json:"enable,omitempty"`
+	// Source of the certificates, ConfigMap or TrustManager, defaults to ConfigMap
+	// +optional
+	Source string `json:"source,omitempty"`
+	// ConfigMap holding the certificates, in the operator namespace for the ConfigMap source
+	// and in the trust namespace of trust-manager for the TrustManager source
+	ConfigMap string `json:"configMap"`
+	// Key of the certificates in the ConfigMap, defaults to ca-bundle.crt
+	// +optional
+	Key string `json:"key,omitempty"`
+	// UseDefaultCAs adds the default CAs of trust-manager to the certificates, only for the
+	// TrustManager source
+	// +optional
+	UseDefaultCAs bool `json:"useDefaultCAs,omitempty"`
+	// NamespaceSelector selects the namespaces the certificates are distributed to, all the
+	// namespaces except the system namespaces are selected when it is not set
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+}
+
+// GetSource returns the source of the certificates
+func (t *TrustedCA) GetSource() string {
+	if t.Source == "" {
+		return TrustedCASourceConfigMap
+	}
+	return t.Source
+}
+
+// GetKey returns the key of the certificates in the source ConfigMap
+func (t *TrustedCA) GetKey() string {
+	if t.Key == "" {
+		return DefaultTrustedCAKey
+	}
+	return t.Key
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"knative.dev/pkg/apis"
+)
+
+func (t *TrustedCA) validate(path string) *apis.FieldError {
+	var errs *apis.FieldError
+
+	switch t.Source {
+	case "", TrustedCASourceConfigMap, TrustedCASourceTrustManager:
+	default:
+		errs = errs.Also(apis.ErrInvalidValue(t.Source, path+".source",
+			fmt.Sprintf("must be %s or %s", TrustedCASourceConfigMap, TrustedCASourceTrustManager)))
+	}
+	if t.ConfigMap == "" {
+		if t.Enable {
+			errs = errs.Also(apis.ErrMissingField(path + ".configMap"))
+		}
+	} else if msgs := validation.IsDNS1123Subdomain(t.ConfigMap); len(msgs) > 0 {
+		errs = errs.Also(apis.ErrInvalidValue(t.ConfigMap, path+".configMap", msgs...))
+	}
+	if t.Key != "" {
+		if msgs := validation.IsConfigMapKey(t.Key); len(msgs) > 0 {
+			errs = errs.Also(apis.ErrInvalidValue(t.Key, path+".key", msgs...))
+		}
+	}
+	if t.UseDefaultCAs && t.GetSource() != TrustedCASourceTrustManager {
+		errs = errs.Also(apis.ErrGeneric(fmt.Sprintf("useDefaultCAs is only supported with the %s source", TrustedCASourceTrustManager), path+".useDefaultCAs"))
+	}
+	if t.NamespaceSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(t.NamespaceSelector); err != nil {
+			errs = errs.Also(apis.ErrInvalidValue(t.NamespaceSelector, path+".namespaceSelector", err.Error()))
+		}
+	}
+	return errs
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"strings"
+
+	"knative.dev/pkg/apis"
+)
+
+const (
+	// VulnerabilityScannerQuay queries the security API of a Quay registry, backed by Clair
+	VulnerabilityScannerQuay = "quay"
+	// VulnerabilityScannerGeneric posts the image to the endpoint and reads the vulnerabilities
+	// from the response, to front scanners such as a Trivy server with an adapter
+	VulnerabilityScannerGeneric = "generic"
+
+	// VulnerabilityGateActionWarn reports the vulnerabilities and installs the components
+	VulnerabilityGateActionWarn = "warn"
+	// VulnerabilityGateActionBlock stops the install of the components until the payload
+	// images have no vulnerability of the gate severity or above
+	VulnerabilityGateActionBlock = "block"
+
+	SeverityLow      = "Low"
+	SeverityMedium   = "Medium"
+	SeverityHigh     = "High"
+	SeverityCritical = "Critical"
+
+	// VulnerabilityGatePassed reports whether the payload images have vulnerabilities of the
+	// gate severity or above, it does not change the readiness of the TektonConfig
+	VulnerabilityGatePassed apis.ConditionType = "VulnerabilityGatePassed"
+)
+
+// severityRanks orders the severities, the severities of the scanners not in the list are
+// ranked below Low
+var severityRanks = map[string]int{
+	strings.ToLower(SeverityLow):      1,
+	strings.ToLower(SeverityMedium):   2,
+	strings.ToLower(SeverityHigh):     3,
+	strings.ToLower(SeverityCritical): 4,
+}
+
+// VulnerabilityGate queries a scanner for the vulnerabilities of the payload images before
+// the components are installed
+type VulnerabilityGate struct {
+	// Enable scans the payload images before installing the components
+	// +optional
+	Enable bool `json:"enable,omitempty"`
+	// Scanner is the API of the scanner, one of quay or generic
+	// +optional
+	Scanner string `json:"scanner,omitempty"`
+	// Endpoint is the URL of the scanner
+	// +optional
+	Endpoint string `json:"endpoint,omitempty"`
+	// CredentialsSecret is a secret in the target namespace holding the bearer token of the
+	// scanner in its token key
+	// +optional
+	CredentialsSecret string `json:"credentialsSecret,omitempty"`
+	// Severity is the lowest severity failing the gate, one of Low, Medium, High or Critical,
+	// defaults to High
+	// +optional
+	Severity string `json:"severity,omitempty"`
+	// Action is taken when the gate fails, one of warn or block, defaults to warn
+	// +optional
+	Action string `json:"action,omitempty"`
+}
+
+// GetSeverity returns the lowest severity failing the gate
+func (vg *VulnerabilityGate) GetSeverity() string {
+	if vg.Severity == "" {
+		return SeverityHigh
+	}
+	return vg.Severity
+}
+
+// GetAction returns the action taken when the gate fails
+func (vg *VulnerabilityGate) GetAction() string {
+	if vg.Action == "" {
+		return VulnerabilityGateActionWarn
+	}
+	return vg.Action
+}
+
+// Fails returns true if a vulnerability of the severity fails the gate
+func (vg *VulnerabilityGate) Fails(severity string) bool {
+	return severityRanks[strings.ToLower(severity)] >= severityRanks[strings.ToLower(vg.GetSeverity())]
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+	"knative.dev/pkg/apis"
+)
+
+func (vg *VulnerabilityGate) validate(path string) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if !vg.Enable {
+		return errs
+	}
+
+	switch vg.Scanner {
+	case VulnerabilityScannerQuay, VulnerabilityScannerGeneric:
+	case "":
+		errs = errs.Also(apis.ErrMissingField(fmt.Sprintf("%s.scanner", path)))
+	default:
+		errs = errs.Also(apis.ErrInvalidValue(vg.Scanner, fmt.Sprintf("%s.scanner", path),
+			fmt.Sprintf("supported scanners are %s, %s", VulnerabilityScannerQuay, VulnerabilityScannerGeneric)))
+	}
+
+	endpointPath := fmt.Sprintf("%s.endpoint", path)
+	if vg.Endpoint == "" {
+		errs = errs.Also(apis.ErrMissingField(endpointPath))
+	} else if u, err := url.Parse(vg.Endpoint); err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		errs = errs.Also(apis.ErrInvalidValue(vg.Endpoint, endpointPath, "must be an http or https URL"))
+	}
+
+	if vg.CredentialsSecret != "" {
+		if msgs := validation.IsDNS1123Subdomain(vg.CredentialsSecret); len(msgs) > 0 {
+			errs = errs.Also(apis.ErrInvalidValue(vg.CredentialsSecret, fmt.Sprintf("%s.credentialsSecret", path), msgs...))
+		}
+	}
+
+	if vg.Severity != "" {
+		if _, ok := severityRanks[strings.ToLower(vg.Severity)]; !ok {
+			errs = errs.Also(apis.ErrInvalidValue(vg.Severity, fmt.Sprintf("%s.severity", path),
+				fmt.Sprintf("supported severities are %s, %s, %s, %s", SeverityLow, SeverityMedium, SeverityHigh, SeverityCritical)))
+		}
+	}
+
+	switch vg.Action {
+	case "", VulnerabilityGateActionWarn, VulnerabilityGateActionBlock:
+	default:
+		errs = errs.Also(apis.ErrInvalidValue(vg.Action, fmt.Sprintf("%s.action", path),
+			fmt.Sprintf("supported actions are %s, %s", VulnerabilityGateActionWarn, VulnerabilityGateActionBlock)))
+	}
+	return errs
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"regexp"
+
+	"knative.dev/pkg/apis"
+)
+
+const (
+	WorkloadIdentityProviderAWS   = "aws"
+	WorkloadIdentityProviderGCP   = "gcp"
+	WorkloadIdentityProviderAzure = "azure"
+
+	// the annotations and labels read by the workload identity webhooks of the cloud providers
+	AWSRoleARNAnnotation           = "eks.amazonaws.com/role-arn"
+	GCPServiceAccountAnnotation    = "iam.gke.io/gcp-service-account"
+	AzureClientIDAnnotation        = "azure.workload.identity/client-id"
+	AzureTenantIDAnnotation        = "azure.workload.identity/tenant-id"
+	AzureUseWorkloadIdentityLabel  = "azure.workload.identity/use"
+	workloadIdentityProvidersValue = WorkloadIdentityProviderAWS + ", " + WorkloadIdentityProviderGCP + ", " + WorkloadIdentityProviderAzure
+
+	// WorkloadIdentityReady reports whether the workload identity annotations were accepted on the
+	// service accounts of the component, it does not change the readiness of the component
+	WorkloadIdentityReady apis.ConditionType = "WorkloadIdentityReady"
+)
+
+var (
+	awsRoleARNRegex        = regexp.MustCompile(`^arn:aws[a-z-]*:iam::[0-9]{12}:role/[\w+=,.@/-]+$`)
+	gcpServiceAccountRegex = regexp.MustCompile(`^[a-z][a-z0-9-]{4,28}[a-z0-9]@[a-z0-9-]+\.iam\.gserviceaccount\.com$`)
+	azureIDRegex           = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+)
+
+// WorkloadIdentity configures the cloud workload identity of the service accounts of a
+// component, so that the component accesses the cloud object storage without static keys
+type WorkloadIdentity struct {
+	// Provider is the cloud provider, one of aws, gcp or azure
+	Provider string `json:"provider"`
+	// RoleARN is the IAM role assumed by the service accounts with IRSA, for aws
+	// +optional
+	RoleARN string `json:"roleARN,omitempty"`
+	// GCPServiceAccount is the Google service account impersonated by the service accounts, for gcp
+	// +optional
+	GCPServiceAccount string `json:"gcpServiceAccount,omitempty"`
+	// ClientID is the client id of the user assigned managed identity, for azure
+	// +optional
+	ClientID string `json:"clientID,omitempty"`
+	// TenantID is the tenant of the managed identity when it is not the tenant of the cluster, for azure
+	// +optional
+	TenantID string `json:"tenantID,omitempty"`
+}
+
+// ServiceAccountAnnotations returns the annotations to set on the service accounts of the component
+func (wi *WorkloadIdentity) ServiceAccountAnnotations() map[string]string {
+	switch wi.Provider {
+	case WorkloadIdentityProviderAWS:
+		return map[string]string{AWSRoleARNAnnotation: wi.RoleARN}
+	case WorkloadIdentityProviderGCP:
+		return map[string]string{GCPServiceAccountAnnotation: wi.GCPServiceAccount}
+	case WorkloadIdentityProviderAzure:
+		annotations := map[string]string{AzureClientIDAnnotation: wi.ClientID}
+		if wi.TenantID != "" {
+			annotations[AzureTenantIDAnnotation] = wi.TenantID
+		}
+		return annotations
+	}
+	return nil
+}
+
+// PodLabels returns the labels to set on the pods running with the service accounts, Azure
+// only injects the tokens into the pods opting in
+func (wi *WorkloadIdentity) PodLabels() map[string]string {
+	if wi.Provider == WorkloadIdentityProviderAzure {
+		return map[string]string{AzureUseWorkloadIdentityLabel: "true"}
+	}
+	return nil
+}
+
+func (wi *WorkloadIdentity) validate(path string) (errs *apis.FieldError) {
+	required := func(value, field string, regex *regexp.Regexp) *apis.FieldError {
+		if value == "" {
+			return apis.ErrMissingField(fmt.Sprintf("%s.%s", path, field))
+		}
+		if !regex.MatchString(value) {
+			return apis.ErrInvalidValue(value, fmt.Sprintf("%s.%s", path, field))
+		}
+		return nil
+	}
+	switch wi.Provider {
+	case WorkloadIdentityProviderAWS:
+		errs = errs.Also(required(wi.RoleARN, "roleARN", awsRoleARNRegex))
+	case WorkloadIdentityProviderGCP:
+		errs = errs.Also(required(wi.GCPServiceAccount, "gcpServiceAccount", gcpServiceAccountRegex))
+	case WorkloadIdentityProviderAzure:
+		errs = errs.Also(required(wi.ClientID, "clientID", azureIDRegex))
+		if wi.TenantID != "" && !azureIDRegex.MatchString(wi.TenantID) {
+			errs = errs.Also(apis.ErrInvalidValue(wi.TenantID, fmt.Sprintf("%s.tenantID", path)))
+		}
+	default:
+		errs = errs.Also(apis.ErrInvalidValue(wi.Provider, fmt.Sprintf("%s.provider", path),
+			fmt.Sprintf("supported providers are %s", workloadIdentityProvidersValue)))
+	}
+	return errs
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestWorkloadIdentityValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		wi      WorkloadIdentity
+		wantErr string
+	}{
+		{
+			name: "aws",
+			wi:   WorkloadIdentity{Provider: WorkloadIdentityProviderAWS, RoleARN: "arn:aws:iam::123456789012:role/tekton-results"},
+		},
+		{
+			name:    "aws without role",
+			wi:      WorkloadIdentity{Provider: WorkloadIdentityProviderAWS},
+			wantErr: "missing field(s): spec.workloadIdentity.roleARN",
+		},
+		{
+			name: "gcp",
+			wi:   WorkloadIdentity{Provider: WorkloadIdentityProviderGCP, GCPServiceAccount: "tekton-chains@project-1.iam.gserviceaccount.com"},
+		},
+		{
+			name:    "gcp invalid service account",
+			wi:      WorkloadIdentity{Provider: WorkloadIdentityProviderGCP, GCPServiceAccount: "tekton-chains"},
+			wantErr: "invalid value: tekton-chains: spec.workloadIdentity.gcpServiceAccount",
+		},
+		{
+			name: "azure",
+			wi:   WorkloadIdentity{Provider: WorkloadIdentityProviderAzure, ClientID: "00000000-0000-0000-0000-000000000001", TenantID: "00000000-0000-0000-0000-000000000002"},
+		},
+		{
+			name:    "azure invalid tenant",
+			wi:      WorkloadIdentity{Provider: WorkloadIdentityProviderAzure, ClientID: "00000000-0000-0000-0000-000000000001", TenantID: "tenant"},
+			wantErr: "invalid value: tenant: spec.workloadIdentity.tenantID",
+		},
+		{
+			name:    "unknown provider",
+			wi:      WorkloadIdentity{Provider: "ibm"},
+			wantErr: "invalid value: ibm: spec.workloadIdentity.provider\nsupported providers are aws, gcp, azure",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.wi.validate("spec.workloadIdentity")
+			if test.wantErr != "" {
+				assert.Error(t, err, test.wantErr)
+				return
+			}
+			assert.Assert(t, err == nil, err)
+		})
+	}
+}
@@ -27,6 +27,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	v2 "k8s.io/api/autoscaling/v2"
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -156,6 +157,73 @@ func (in *ApiSpec) DeepCopy() *ApiSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CABundleConfigMap) DeepCopyInto(out *CABundleConfigMap) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CABundleConfigMap.
+func (in *CABundleConfigMap) DeepCopy() *CABundleConfigMap {
+	if in == nil {
+		return nil
+	}
+	out := new(CABundleConfigMap)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CABundleSource) DeepCopyInto(out *CABundleSource) {
+	*out = *in
+	if in.ConfigMap != nil {
+		in, out := &in.ConfigMap, &out.ConfigMap
+		*out = new(v1.ConfigMapKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Secret != nil {
+		in, out := &in.Secret, &out.Secret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CABundleSource.
+func (in *CABundleSource) DeepCopy() *CABundleSource {
+	if in == nil {
+		return nil
+	}
+	out := new(CABundleSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CABundles) DeepCopyInto(out *CABundles) {
+	*out = *in
+	out.Trusted = in.Trusted
+	out.Service = in.Service
+	if in.Additional != nil {
+		in, out := &in.Additional, &out.Additional
+		*out = make([]CABundleSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CABundles.
+func (in *CABundles) DeepCopy() *CABundles {
+	if in == nil {
+		return nil
+	}
+	out := new(CABundles)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Catalog) DeepCopyInto(out *Catalog) {
 	*out = *in
@@ -200,6 +268,16 @@ func (in *Chain) DeepCopyInto(out *Chain) {
 		}
 	}
 	in.Options.DeepCopyInto(&out.Options)
+	if in.WorkloadIdentity != nil {
+		in, out := &in.WorkloadIdentity, &out.WorkloadIdentity
+		*out = new(WorkloadIdentity)
+		**out = **in
+	}
+	if in.OCIRegistry != nil {
+		in, out := &in.OCIRegistry, &out.OCIRegistry
+		*out = new(ChainsOCIRegistry)
+		**out = **in
+	}
 	return
 }
 
@@ -255,6 +333,22 @@ func (in *ChainProperties) DeepCopy() *ChainProperties {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChainsOCIRegistry) DeepCopyInto(out *ChainsOCIRegistry) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChainsOCIRegistry.
+func (in *ChainsOCIRegistry) DeepCopy() *ChainsOCIRegistry {
+	if in == nil {
+		return nil
+	}
+	out := new(ChainsOCIRegistry)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CommonSpec) DeepCopyInto(out *CommonSpec) {
 	*out = *in
@@ -271,6 +365,99 @@ func (in *CommonSpec) DeepCopy() *CommonSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentDrift) DeepCopyInto(out *ComponentDrift) {
+	*out = *in
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.DetectedTime.DeepCopyInto(&out.DetectedTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentDrift.
+func (in *ComponentDrift) DeepCopy() *ComponentDrift {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentDrift)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentPropagationStatus) DeepCopyInto(out *ComponentPropagationStatus) {
+	*out = *in
+	if in.UpdatedTime != nil {
+		in, out := &in.UpdatedTime, &out.UpdatedTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ReadyTime != nil {
+		in, out := &in.ReadyTime, &out.ReadyTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastPropagationDuration != nil {
+		in, out := &in.LastPropagationDuration, &out.LastPropagationDuration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentPropagationStatus.
+func (in *ComponentPropagationStatus) DeepCopy() *ComponentPropagationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentPropagationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentRolloutProgress) DeepCopyInto(out *ComponentRolloutProgress) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentRolloutProgress.
+func (in *ComponentRolloutProgress) DeepCopy() *ComponentRolloutProgress {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentRolloutProgress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Concurrency) DeepCopyInto(out *Concurrency) {
+	*out = *in
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxMatrixCombinations != nil {
+		in, out := &in.MaxMatrixCombinations, &out.MaxMatrixCombinations
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Concurrency.
+func (in *Concurrency) DeepCopy() *Concurrency {
+	if in == nil {
+		return nil
+	}
+	out := new(Concurrency)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Config) DeepCopyInto(out *Config) {
 	*out = *in
@@ -288,6 +475,31 @@ func (in *Config) DeepCopyInto(out *Config) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.MetricsTLS != nil {
+		in, out := &in.MetricsTLS, &out.MetricsTLS
+		*out = new(MetricsTLS)
+		**out = **in
+	}
+	if in.PodSecurity != nil {
+		in, out := &in.PodSecurity, &out.PodSecurity
+		*out = new(PodSecurityDefaults)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ControlPlaneProxy != nil {
+		in, out := &in.ControlPlaneProxy, &out.ControlPlaneProxy
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ProxyCredentials != nil {
+		in, out := &in.ProxyCredentials, &out.ProxyCredentials
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+	if in.WorkloadProxy != nil {
+		in, out := &in.WorkloadProxy, &out.WorkloadProxy
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -301,6 +513,61 @@ func (in *Config) DeepCopy() *Config {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigDriftStatus) DeepCopyInto(out *ConfigDriftStatus) {
+	*out = *in
+	in.LastCheckTime.DeepCopyInto(&out.LastCheckTime)
+	if in.Applied != nil {
+		in, out := &in.Applied, &out.Applied
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Components != nil {
+		in, out := &in.Components, &out.Components
+		*out = make([]ComponentDrift, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigDriftStatus.
+func (in *ConfigDriftStatus) DeepCopy() *ConfigDriftStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigDriftStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigPropagationStatus) DeepCopyInto(out *ConfigPropagationStatus) {
+	*out = *in
+	in.ObservedTime.DeepCopyInto(&out.ObservedTime)
+	if in.Components != nil {
+		in, out := &in.Components, &out.Components
+		*out = make(map[string]ComponentPropagationStatus, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigPropagationStatus.
+func (in *ConfigPropagationStatus) DeepCopy() *ConfigPropagationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigPropagationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomLogoSpec) DeepCopyInto(out *CustomLogoSpec) {
 	*out = *in
@@ -419,6 +686,59 @@ func (in *DeploymentPerformanceArgs) DeepCopy() *DeploymentPerformanceArgs {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DriftDetection) DeepCopyInto(out *DriftDetection) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DriftDetection.
+func (in *DriftDetection) DeepCopy() *DriftDetection {
+	if in == nil {
+		return nil
+	}
+	out := new(DriftDetection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EditRoleBinding) DeepCopyInto(out *EditRoleBinding) {
+	*out = *in
+	if in.Subjects != nil {
+		in, out := &in.Subjects, &out.Subjects
+		*out = make([]EditRoleBindingSubject, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EditRoleBinding.
+func (in *EditRoleBinding) DeepCopy() *EditRoleBinding {
+	if in == nil {
+		return nil
+	}
+	out := new(EditRoleBinding)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EditRoleBindingSubject) DeepCopyInto(out *EditRoleBindingSubject) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EditRoleBindingSubject.
+func (in *EditRoleBindingSubject) DeepCopy() *EditRoleBindingSubject {
+	if in == nil {
+		return nil
+	}
+	out := new(EditRoleBindingSubject)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Hub) DeepCopyInto(out *Hub) {
 	*out = *in
@@ -441,6 +761,50 @@ func (in *Hub) DeepCopy() *Hub {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LegacyMigration) DeepCopyInto(out *LegacyMigration) {
+	*out = *in
+	if in.Artifacts != nil {
+		in, out := &in.Artifacts, &out.Artifacts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LegacyMigration.
+func (in *LegacyMigration) DeepCopy() *LegacyMigration {
+	if in == nil {
+		return nil
+	}
+	out := new(LegacyMigration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LegacyMigrationStatus) DeepCopyInto(out *LegacyMigrationStatus) {
+	*out = *in
+	if in.Migrations != nil {
+		in, out := &in.Migrations, &out.Migrations
+		*out = make([]LegacyMigration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LegacyMigrationStatus.
+func (in *LegacyMigrationStatus) DeepCopy() *LegacyMigrationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LegacyMigrationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LokiStackProperties) DeepCopyInto(out *LokiStackProperties) {
 	*out = *in
@@ -457,6 +821,27 @@ func (in *LokiStackProperties) DeepCopy() *LokiStackProperties {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManifestsReference) DeepCopyInto(out *ManifestsReference) {
+	*out = *in
+	if in.ConfigMaps != nil {
+		in, out := &in.ConfigMaps, &out.ConfigMaps
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManifestsReference.
+func (in *ManifestsReference) DeepCopy() *ManifestsReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ManifestsReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ManualApproval) DeepCopyInto(out *ManualApproval) {
 	*out = *in
@@ -570,6 +955,22 @@ func (in *ManualApprovalGateStatus) DeepCopy() *ManualApprovalGateStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricsTLS) DeepCopyInto(out *MetricsTLS) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricsTLS.
+func (in *MetricsTLS) DeepCopy() *MetricsTLS {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricsTLS)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MultiClusterConfig) DeepCopyInto(out *MultiClusterConfig) {
 	*out = *in
@@ -603,6 +1004,23 @@ func (in *MulticlusterProxyAAEOptions) DeepCopy() *MulticlusterProxyAAEOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceFailure) DeepCopyInto(out *NamespaceFailure) {
+	*out = *in
+	in.Since.DeepCopyInto(&out.Since)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceFailure.
+func (in *NamespaceFailure) DeepCopy() *NamespaceFailure {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceFailure)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NamespaceMetadata) DeepCopyInto(out *NamespaceMetadata) {
 	*out = *in
@@ -633,6 +1051,134 @@ func (in *NamespaceMetadata) DeepCopy() *NamespaceMetadata {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceOnboarding) DeepCopyInto(out *NamespaceOnboarding) {
+	*out = *in
+	if in.RoleBindings != nil {
+		in, out := &in.RoleBindings, &out.RoleBindings
+		*out = make([]OnboardingRoleBinding, len(*in))
+		copy(*out, *in)
+	}
+	if in.StarterResources != nil {
+		in, out := &in.StarterResources, &out.StarterResources
+		*out = new(StarterResources)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceOnboarding.
+func (in *NamespaceOnboarding) DeepCopy() *NamespaceOnboarding {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceOnboarding)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceOnboardingStatus) DeepCopyInto(out *NamespaceOnboardingStatus) {
+	*out = *in
+	if in.IneffectivePermissions != nil {
+		in, out := &in.IneffectivePermissions, &out.IneffectivePermissions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastErrorTime != nil {
+		in, out := &in.LastErrorTime, &out.LastErrorTime
+		*out = (*in).DeepCopy()
+	}
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceOnboardingStatus.
+func (in *NamespaceOnboardingStatus) DeepCopy() *NamespaceOnboardingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceOnboardingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationSink) DeepCopyInto(out *NotificationSink) {
+	*out = *in
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationSink.
+func (in *NotificationSink) DeepCopy() *NotificationSink {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Notifications) DeepCopyInto(out *Notifications) {
+	*out = *in
+	if in.Sinks != nil {
+		in, out := &in.Sinks, &out.Sinks
+		*out = make([]NotificationSink, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Notifications.
+func (in *Notifications) DeepCopy() *Notifications {
+	if in == nil {
+		return nil
+	}
+	out := new(Notifications)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationsStatus) DeepCopyInto(out *NotificationsStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationsStatus.
+func (in *NotificationsStatus) DeepCopy() *NotificationsStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationsStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OnboardingRoleBinding) DeepCopyInto(out *OnboardingRoleBinding) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OnboardingRoleBinding.
+func (in *OnboardingRoleBinding) DeepCopy() *OnboardingRoleBinding {
+	if in == nil {
+		return nil
+	}
+	out := new(OnboardingRoleBinding)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OpenShift) DeepCopyInto(out *OpenShift) {
 	*out = *in
@@ -644,7 +1190,22 @@ func (in *OpenShift) DeepCopyInto(out *OpenShift) {
 	if in.SCC != nil {
 		in, out := &in.SCC, &out.SCC
 		*out = new(SCC)
-		**out = **in
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EditRoleBinding != nil {
+		in, out := &in.EditRoleBinding, &out.EditRoleBinding
+		*out = new(EditRoleBinding)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RBAC != nil {
+		in, out := &in.RBAC, &out.RBAC
+		*out = new(RBAC)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CABundles != nil {
+		in, out := &in.CABundles, &out.CABundles
+		*out = new(CABundles)
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
@@ -756,6 +1317,22 @@ func (in *OpenShiftPipelinesAsCodeStatus) DeepCopy() *OpenShiftPipelinesAsCodeSt
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperatorBuildStatus) DeepCopyInto(out *OperatorBuildStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperatorBuildStatus.
+func (in *OperatorBuildStatus) DeepCopy() *OperatorBuildStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OperatorBuildStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OptionalPipelineProperties) DeepCopyInto(out *OptionalPipelineProperties) {
 	*out = *in
@@ -793,6 +1370,63 @@ func (in *OptionalTriggersProperties) DeepCopy() *OptionalTriggersProperties {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrphanedPVCNamespaceSummary) DeepCopyInto(out *OrphanedPVCNamespaceSummary) {
+	*out = *in
+	in.Oldest.DeepCopyInto(&out.Oldest)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrphanedPVCNamespaceSummary.
+func (in *OrphanedPVCNamespaceSummary) DeepCopy() *OrphanedPVCNamespaceSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(OrphanedPVCNamespaceSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrphanedPVCScan) DeepCopyInto(out *OrphanedPVCScan) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrphanedPVCScan.
+func (in *OrphanedPVCScan) DeepCopy() *OrphanedPVCScan {
+	if in == nil {
+		return nil
+	}
+	out := new(OrphanedPVCScan)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrphanedPVCsStatus) DeepCopyInto(out *OrphanedPVCsStatus) {
+	*out = *in
+	in.LastScanTime.DeepCopyInto(&out.LastScanTime)
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]OrphanedPVCNamespaceSummary, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrphanedPVCsStatus.
+func (in *OrphanedPVCsStatus) DeepCopy() *OrphanedPVCsStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OrphanedPVCsStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PACSettings) DeepCopyInto(out *PACSettings) {
 	*out = *in
@@ -810,53 +1444,152 @@ func (in *PACSettings) DeepCopyInto(out *PACSettings) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
-	in.Options.DeepCopyInto(&out.Options)
+	in.Options.DeepCopyInto(&out.Options)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PACSettings.
+func (in *PACSettings) DeepCopy() *PACSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(PACSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Param) DeepCopyInto(out *Param) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Param.
+func (in *Param) DeepCopy() *Param {
+	if in == nil {
+		return nil
+	}
+	out := new(Param)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ParamValue) DeepCopyInto(out *ParamValue) {
+	*out = *in
+	if in.Possible != nil {
+		in, out := &in.Possible, &out.Possible
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ParamValue.
+func (in *ParamValue) DeepCopy() *ParamValue {
+	if in == nil {
+		return nil
+	}
+	out := new(ParamValue)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ParamsFromSource) DeepCopyInto(out *ParamsFromSource) {
+	*out = *in
+	if in.ConfigMap != nil {
+		in, out := &in.ConfigMap, &out.ConfigMap
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+	if in.Secret != nil {
+		in, out := &in.Secret, &out.Secret
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ParamsFromSource.
+func (in *ParamsFromSource) DeepCopy() *ParamsFromSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ParamsFromSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ParamsFromStatus) DeepCopyInto(out *ParamsFromStatus) {
+	*out = *in
+	if in.LastChangeTime != nil {
+		in, out := &in.LastChangeTime, &out.LastChangeTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ParamsFromStatus.
+func (in *ParamsFromStatus) DeepCopy() *ParamsFromStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ParamsFromStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PayloadSwitchover) DeepCopyInto(out *PayloadSwitchover) {
+	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PACSettings.
-func (in *PACSettings) DeepCopy() *PACSettings {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PayloadSwitchover.
+func (in *PayloadSwitchover) DeepCopy() *PayloadSwitchover {
 	if in == nil {
 		return nil
 	}
-	out := new(PACSettings)
+	out := new(PayloadSwitchover)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Param) DeepCopyInto(out *Param) {
+func (in *PayloadSwitchoverStatus) DeepCopyInto(out *PayloadSwitchoverStatus) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Param.
-func (in *Param) DeepCopy() *Param {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PayloadSwitchoverStatus.
+func (in *PayloadSwitchoverStatus) DeepCopy() *PayloadSwitchoverStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(Param)
+	out := new(PayloadSwitchoverStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ParamValue) DeepCopyInto(out *ParamValue) {
+func (in *PayloadVersionStatus) DeepCopyInto(out *PayloadVersionStatus) {
 	*out = *in
-	if in.Possible != nil {
-		in, out := &in.Possible, &out.Possible
+	if in.OperatorVersions != nil {
+		in, out := &in.OperatorVersions, &out.OperatorVersions
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ParamValue.
-func (in *ParamValue) DeepCopy() *ParamValue {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PayloadVersionStatus.
+func (in *PayloadVersionStatus) DeepCopy() *PayloadVersionStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ParamValue)
+	out := new(PayloadVersionStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1111,6 +1844,197 @@ func (in *Platforms) DeepCopy() *Platforms {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodSecurityDefaults) DeepCopyInto(out *PodSecurityDefaults) {
+	*out = *in
+	if in.SeccompProfile != nil {
+		in, out := &in.SeccompProfile, &out.SeccompProfile
+		*out = new(v1.SeccompProfile)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AppArmorProfile != nil {
+		in, out := &in.AppArmorProfile, &out.AppArmorProfile
+		*out = new(v1.AppArmorProfile)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodSecurityDefaults.
+func (in *PodSecurityDefaults) DeepCopy() *PodSecurityDefaults {
+	if in == nil {
+		return nil
+	}
+	out := new(PodSecurityDefaults)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Policies) DeepCopyInto(out *Policies) {
+	*out = *in
+	if in.RequiredPipelineRunLabels != nil {
+		in, out := &in.RequiredPipelineRunLabels, &out.RequiredPipelineRunLabels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ValidationActions != nil {
+		in, out := &in.ValidationActions, &out.ValidationActions
+		*out = make([]admissionregistrationv1.ValidationAction, len(*in))
+		copy(*out, *in)
+	}
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Bundle != nil {
+		in, out := &in.Bundle, &out.Bundle
+		*out = new(PolicyBundle)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Policies.
+func (in *Policies) DeepCopy() *Policies {
+	if in == nil {
+		return nil
+	}
+	out := new(Policies)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyBundle) DeepCopyInto(out *PolicyBundle) {
+	*out = *in
+	if in.Sets != nil {
+		in, out := &in.Sets, &out.Sets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyBundle.
+func (in *PolicyBundle) DeepCopy() *PolicyBundle {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyBundle)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostInstallJobs) DeepCopyInto(out *PostInstallJobs) {
+	*out = *in
+	if in.ActiveDeadlineSeconds != nil {
+		in, out := &in.ActiveDeadlineSeconds, &out.ActiveDeadlineSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.BackoffLimit != nil {
+		in, out := &in.BackoffLimit, &out.BackoffLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Parallelism != nil {
+		in, out := &in.Parallelism, &out.Parallelism
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]v1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostInstallJobs.
+func (in *PostInstallJobs) DeepCopy() *PostInstallJobs {
+	if in == nil {
+		return nil
+	}
+	out := new(PostInstallJobs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostRenderPatches) DeepCopyInto(out *PostRenderPatches) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostRenderPatches.
+func (in *PostRenderPatches) DeepCopy() *PostRenderPatches {
+	if in == nil {
+		return nil
+	}
+	out := new(PostRenderPatches)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProxyStatus) DeepCopyInto(out *ProxyStatus) {
+	*out = *in
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.LastChangeTime != nil {
+		in, out := &in.LastChangeTime, &out.LastChangeTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Changed != nil {
+		in, out := &in.Changed, &out.Changed
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.UpdatedConfigs != nil {
+		in, out := &in.UpdatedConfigs, &out.UpdatedConfigs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RestartedComponents != nil {
+		in, out := &in.RestartedComponents, &out.RestartedComponents
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxyStatus.
+func (in *ProxyStatus) DeepCopy() *ProxyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Prune) DeepCopyInto(out *Prune) {
 	*out = *in
@@ -1134,6 +2058,11 @@ func (in *Prune) DeepCopyInto(out *Prune) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.OrphanedPVCs != nil {
+		in, out := &in.OrphanedPVCs, &out.OrphanedPVCs
+		*out = new(OrphanedPVCScan)
+		**out = **in
+	}
 	return
 }
 
@@ -1170,6 +2099,78 @@ func (in *Pruner) DeepCopy() *Pruner {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RBAC) DeepCopyInto(out *RBAC) {
+	*out = *in
+	if in.AdditionalServiceAccounts != nil {
+		in, out := &in.AdditionalServiceAccounts, &out.AdditionalServiceAccounts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CleanupOnDelete != nil {
+		in, out := &in.CleanupOnDelete, &out.CleanupOnDelete
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RBAC.
+func (in *RBAC) DeepCopy() *RBAC {
+	if in == nil {
+		return nil
+	}
+	out := new(RBAC)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RBACStatus) DeepCopyInto(out *RBACStatus) {
+	*out = *in
+	if in.FailedNamespaces != nil {
+		in, out := &in.FailedNamespaces, &out.FailedNamespaces
+		*out = make([]NamespaceFailure, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RBACStatus.
+func (in *RBACStatus) DeepCopy() *RBACStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RBACStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RenderedManifestsStatus) DeepCopyInto(out *RenderedManifestsStatus) {
+	*out = *in
+	if in.InstallerSets != nil {
+		in, out := &in.InstallerSets, &out.InstallerSets
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RenderedManifestsStatus.
+func (in *RenderedManifestsStatus) DeepCopy() *RenderedManifestsStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RenderedManifestsStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Resolvers) DeepCopyInto(out *Resolvers) {
 	*out = *in
@@ -1258,6 +2259,16 @@ func (in *Result) DeepCopyInto(out *Result) {
 	out.LokiStackProperties = in.LokiStackProperties
 	in.Options.DeepCopyInto(&out.Options)
 	in.Performance.DeepCopyInto(&out.Performance)
+	if in.RetentionPolicy != nil {
+		in, out := &in.RetentionPolicy, &out.RetentionPolicy
+		*out = new(RetentionPolicyProperties)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WorkloadIdentity != nil {
+		in, out := &in.WorkloadIdentity, &out.WorkloadIdentity
+		*out = new(WorkloadIdentity)
+		**out = **in
+	}
 	return
 }
 
@@ -1337,12 +2348,77 @@ func (in *ResultsAPIProperties) DeepCopyInto(out *ResultsAPIProperties) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResultsAPIProperties.
-func (in *ResultsAPIProperties) DeepCopy() *ResultsAPIProperties {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResultsAPIProperties.
+func (in *ResultsAPIProperties) DeepCopy() *ResultsAPIProperties {
+	if in == nil {
+		return nil
+	}
+	out := new(ResultsAPIProperties)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetentionPolicyProperties) DeepCopyInto(out *RetentionPolicyProperties) {
+	*out = *in
+	if in.MaxRetention != nil {
+		in, out := &in.MaxRetention, &out.MaxRetention
+		*out = new(uint)
+		**out = **in
+	}
+	if in.MaxRecords != nil {
+		in, out := &in.MaxRecords, &out.MaxRecords
+		*out = new(uint)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetentionPolicyProperties.
+func (in *RetentionPolicyProperties) DeepCopy() *RetentionPolicyProperties {
+	if in == nil {
+		return nil
+	}
+	out := new(RetentionPolicyProperties)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetentionPolicyStatus) DeepCopyInto(out *RetentionPolicyStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetentionPolicyStatus.
+func (in *RetentionPolicyStatus) DeepCopy() *RetentionPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RetentionPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutProgressStatus) DeepCopyInto(out *RolloutProgressStatus) {
+	*out = *in
+	if in.Components != nil {
+		in, out := &in.Components, &out.Components
+		*out = make(map[string]ComponentRolloutProgress, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutProgressStatus.
+func (in *RolloutProgressStatus) DeepCopy() *RolloutProgressStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ResultsAPIProperties)
+	out := new(RolloutProgressStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1350,6 +2426,13 @@ func (in *ResultsAPIProperties) DeepCopy() *ResultsAPIProperties {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SCC) DeepCopyInto(out *SCC) {
 	*out = *in
+	if in.ServiceAccounts != nil {
+		in, out := &in.ServiceAccounts, &out.ServiceAccounts
+		*out = make([]SCCServiceAccount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1363,6 +2446,48 @@ func (in *SCC) DeepCopy() *SCC {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SCCAnnotationMigrationStatus) DeepCopyInto(out *SCCAnnotationMigrationStatus) {
+	*out = *in
+	if in.Changing != nil {
+		in, out := &in.Changing, &out.Changing
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SCCAnnotationMigrationStatus.
+func (in *SCCAnnotationMigrationStatus) DeepCopy() *SCCAnnotationMigrationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SCCAnnotationMigrationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SCCServiceAccount) DeepCopyInto(out *SCCServiceAccount) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SCCServiceAccount.
+func (in *SCCServiceAccount) DeepCopy() *SCCServiceAccount {
+	if in == nil {
+		return nil
+	}
+	out := new(SCCServiceAccount)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Scheduler) DeepCopyInto(out *Scheduler) {
 	*out = *in
@@ -1425,6 +2550,48 @@ func (in *Scope) DeepCopy() *Scope {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Security) DeepCopyInto(out *Security) {
+	*out = *in
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(TLSPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Security.
+func (in *Security) DeepCopy() *Security {
+	if in == nil {
+		return nil
+	}
+	out := new(Security)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StarterResources) DeepCopyInto(out *StarterResources) {
+	*out = *in
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StarterResources.
+func (in *StarterResources) DeepCopy() *StarterResources {
+	if in == nil {
+		return nil
+	}
+	out := new(StarterResources)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SyncerService) DeepCopyInto(out *SyncerService) {
 	*out = *in
@@ -1539,6 +2706,27 @@ func (in *SyncerServiceStatus) DeepCopy() *SyncerServiceStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TLSPolicy) DeepCopyInto(out *TLSPolicy) {
+	*out = *in
+	if in.CipherSuites != nil {
+		in, out := &in.CipherSuites, &out.CipherSuites
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSPolicy.
+func (in *TLSPolicy) DeepCopy() *TLSPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(TLSPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TektonAddon) DeepCopyInto(out *TektonAddon) {
 	*out = *in
@@ -1822,12 +3010,74 @@ func (in *TektonConfigSpec) DeepCopyInto(out *TektonConfigSpec) {
 		*out = make([]Param, len(*in))
 		copy(*out, *in)
 	}
+	if in.ParamsFrom != nil {
+		in, out := &in.ParamsFrom, &out.ParamsFrom
+		*out = make([]ParamsFromSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	in.Platforms.DeepCopyInto(&out.Platforms)
 	if in.TargetNamespaceMetadata != nil {
 		in, out := &in.TargetNamespaceMetadata, &out.TargetNamespaceMetadata
 		*out = new(NamespaceMetadata)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PayloadSwitchover != nil {
+		in, out := &in.PayloadSwitchover, &out.PayloadSwitchover
+		*out = new(PayloadSwitchover)
+		**out = **in
+	}
+	if in.NamespaceOnboarding != nil {
+		in, out := &in.NamespaceOnboarding, &out.NamespaceOnboarding
+		*out = new(NamespaceOnboarding)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VulnerabilityGate != nil {
+		in, out := &in.VulnerabilityGate, &out.VulnerabilityGate
+		*out = new(VulnerabilityGate)
+		**out = **in
+	}
+	if in.TrustedCA != nil {
+		in, out := &in.TrustedCA, &out.TrustedCA
+		*out = new(TrustedCA)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Notifications != nil {
+		in, out := &in.Notifications, &out.Notifications
+		*out = new(Notifications)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Concurrency != nil {
+		in, out := &in.Concurrency, &out.Concurrency
+		*out = new(Concurrency)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Policies != nil {
+		in, out := &in.Policies, &out.Policies
+		*out = new(Policies)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PostRenderPatches != nil {
+		in, out := &in.PostRenderPatches, &out.PostRenderPatches
+		*out = new(PostRenderPatches)
+		**out = **in
+	}
+	if in.Security != nil {
+		in, out := &in.Security, &out.Security
+		*out = new(Security)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DriftDetection != nil {
+		in, out := &in.DriftDetection, &out.DriftDetection
+		*out = new(DriftDetection)
+		**out = **in
+	}
+	if in.PostInstallJobs != nil {
+		in, out := &in.PostInstallJobs, &out.PostInstallJobs
+		*out = new(PostInstallJobs)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1852,6 +3102,83 @@ func (in *TektonConfigStatus) DeepCopyInto(out *TektonConfigStatus) {
 			(*out)[key] = val
 		}
 	}
+	if in.PayloadSwitchover != nil {
+		in, out := &in.PayloadSwitchover, &out.PayloadSwitchover
+		*out = new(PayloadSwitchoverStatus)
+		**out = **in
+	}
+	if in.RenderedManifests != nil {
+		in, out := &in.RenderedManifests, &out.RenderedManifests
+		*out = new(RenderedManifestsStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Propagation != nil {
+		in, out := &in.Propagation, &out.Propagation
+		*out = new(ConfigPropagationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Notifications != nil {
+		in, out := &in.Notifications, &out.Notifications
+		*out = new(NotificationsStatus)
+		**out = **in
+	}
+	if in.Progress != nil {
+		in, out := &in.Progress, &out.Progress
+		*out = new(RolloutProgressStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LegacyMigration != nil {
+		in, out := &in.LegacyMigration, &out.LegacyMigration
+		*out = new(LegacyMigrationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SCCMigration != nil {
+		in, out := &in.SCCMigration, &out.SCCMigration
+		*out = new(SCCAnnotationMigrationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Proxy != nil {
+		in, out := &in.Proxy, &out.Proxy
+		*out = new(ProxyStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OrphanedPVCs != nil {
+		in, out := &in.OrphanedPVCs, &out.OrphanedPVCs
+		*out = new(OrphanedPVCsStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TrustedCABundle != nil {
+		in, out := &in.TrustedCABundle, &out.TrustedCABundle
+		*out = new(TrustedCABundleStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ParamsFrom != nil {
+		in, out := &in.ParamsFrom, &out.ParamsFrom
+		*out = new(ParamsFromStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Operator != nil {
+		in, out := &in.Operator, &out.Operator
+		*out = new(OperatorBuildStatus)
+		**out = **in
+	}
+	if in.PayloadVersions != nil {
+		in, out := &in.PayloadVersions, &out.PayloadVersions
+		*out = make(map[string]PayloadVersionStatus, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.RBAC != nil {
+		in, out := &in.RBAC, &out.RBAC
+		*out = new(RBACStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = new(ConfigDriftStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2162,6 +3489,11 @@ func (in *TektonInstallerSetSpec) DeepCopyInto(out *TektonInstallerSetSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ManifestsRef != nil {
+		in, out := &in.ManifestsRef, &out.ManifestsRef
+		*out = new(ManifestsReference)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2288,6 +3620,66 @@ func (in *TektonMulticlusterProxyAAEStatus) DeepCopy() *TektonMulticlusterProxyA
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TektonNamespaceStatus) DeepCopyInto(out *TektonNamespaceStatus) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TektonNamespaceStatus.
+func (in *TektonNamespaceStatus) DeepCopy() *TektonNamespaceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TektonNamespaceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TektonNamespaceStatus) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TektonNamespaceStatusList) DeepCopyInto(out *TektonNamespaceStatusList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TektonNamespaceStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TektonNamespaceStatusList.
+func (in *TektonNamespaceStatusList) DeepCopy() *TektonNamespaceStatusList {
+	if in == nil {
+		return nil
+	}
+	out := new(TektonNamespaceStatusList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TektonNamespaceStatusList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TektonPipeline) DeepCopyInto(out *TektonPipeline) {
 	*out = *in
@@ -2583,6 +3975,11 @@ func (in *TektonResultSpec) DeepCopy() *TektonResultSpec {
 func (in *TektonResultStatus) DeepCopyInto(out *TektonResultStatus) {
 	*out = *in
 	in.Status.DeepCopyInto(&out.Status)
+	if in.RetentionPolicy != nil {
+		in, out := &in.RetentionPolicy, &out.RetentionPolicy
+		*out = new(RetentionPolicyStatus)
+		**out = **in
+	}
 	return
 }
 
@@ -2845,6 +4242,68 @@ func (in *TriggersProperties) DeepCopy() *TriggersProperties {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrustedCA) DeepCopyInto(out *TrustedCA) {
+	*out = *in
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustedCA.
+func (in *TrustedCA) DeepCopy() *TrustedCA {
+	if in == nil {
+		return nil
+	}
+	out := new(TrustedCA)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrustedCABundleStatus) DeepCopyInto(out *TrustedCABundleStatus) {
+	*out = *in
+	if in.LastRotationTime != nil {
+		in, out := &in.LastRotationTime, &out.LastRotationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.RestartedWorkloads != nil {
+		in, out := &in.RestartedWorkloads, &out.RestartedWorkloads
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustedCABundleStatus.
+func (in *TrustedCABundleStatus) DeepCopy() *TrustedCABundleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TrustedCABundleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VulnerabilityGate) DeepCopyInto(out *VulnerabilityGate) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VulnerabilityGate.
+func (in *VulnerabilityGate) DeepCopy() *VulnerabilityGate {
+	if in == nil {
+		return nil
+	}
+	out := new(VulnerabilityGate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WebhookConfigurationOptions) DeepCopyInto(out *WebhookConfigurationOptions) {
 	*out = *in
@@ -2863,6 +4322,21 @@ func (in *WebhookConfigurationOptions) DeepCopyInto(out *WebhookConfigurationOpt
 		*out = new(admissionregistrationv1.SideEffectClass)
 		**out = **in
 	}
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ObjectSelector != nil {
+		in, out := &in.ObjectSelector, &out.ObjectSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReinvocationPolicy != nil {
+		in, out := &in.ReinvocationPolicy, &out.ReinvocationPolicy
+		*out = new(admissionregistrationv1.ReinvocationPolicyType)
+		**out = **in
+	}
 	return
 }
 
@@ -2875,3 +4349,19 @@ func (in *WebhookConfigurationOptions) DeepCopy() *WebhookConfigurationOptions {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadIdentity) DeepCopyInto(out *WorkloadIdentity) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadIdentity.
+func (in *WorkloadIdentity) DeepCopy() *WorkloadIdentity {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadIdentity)
+	in.DeepCopyInto(out)
+	return out
+}
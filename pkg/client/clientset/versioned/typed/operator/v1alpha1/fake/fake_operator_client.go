@@ -68,6 +68,10 @@ func (c *FakeOperatorV1alpha1) TektonMulticlusterProxyAAEs() v1alpha1.TektonMult
 	return newFakeTektonMulticlusterProxyAAEs(c)
 }
 
+func (c *FakeOperatorV1alpha1) TektonNamespaceStatuses() v1alpha1.TektonNamespaceStatusInterface {
+	return newFakeTektonNamespaceStatuses(c)
+}
+
 func (c *FakeOperatorV1alpha1) TektonPipelines() v1alpha1.TektonPipelineInterface {
 	return newFakeTektonPipelines(c)
 }
@@ -38,6 +38,8 @@ type TektonInstallerSetExpansion interface{}
 
 type TektonMulticlusterProxyAAEExpansion interface{}
 
+type TektonNamespaceStatusExpansion interface{}
+
 type TektonPipelineExpansion interface{}
 
 type TektonPrunerExpansion interface{}
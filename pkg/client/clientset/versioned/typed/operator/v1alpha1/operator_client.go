@@ -38,6 +38,7 @@ type OperatorV1alpha1Interface interface {
 	TektonHubsGetter
 	TektonInstallerSetsGetter
 	TektonMulticlusterProxyAAEsGetter
+	TektonNamespaceStatusesGetter
 	TektonPipelinesGetter
 	TektonPrunersGetter
 	TektonResultsGetter
@@ -90,6 +91,10 @@ func (c *OperatorV1alpha1Client) TektonMulticlusterProxyAAEs() TektonMulticluste
 	return newTektonMulticlusterProxyAAEs(c)
 }
 
+func (c *OperatorV1alpha1Client) TektonNamespaceStatuses() TektonNamespaceStatusInterface {
+	return newTektonNamespaceStatuses(c)
+}
+
 func (c *OperatorV1alpha1Client) TektonPipelines() TektonPipelineInterface {
 	return newTektonPipelines(c)
 }
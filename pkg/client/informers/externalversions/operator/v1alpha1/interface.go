@@ -44,6 +44,8 @@ type Interface interface {
 	TektonInstallerSets() TektonInstallerSetInformer
 	// TektonMulticlusterProxyAAEs returns a TektonMulticlusterProxyAAEInformer.
 	TektonMulticlusterProxyAAEs() TektonMulticlusterProxyAAEInformer
+	// TektonNamespaceStatuses returns a TektonNamespaceStatusInformer.
+	TektonNamespaceStatuses() TektonNamespaceStatusInformer
 	// TektonPipelines returns a TektonPipelineInformer.
 	TektonPipelines() TektonPipelineInformer
 	// TektonPruners returns a TektonPrunerInformer.
@@ -117,6 +119,11 @@ func (v *version) TektonMulticlusterProxyAAEs() TektonMulticlusterProxyAAEInform
 	return &tektonMulticlusterProxyAAEInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// TektonNamespaceStatuses returns a TektonNamespaceStatusInformer.
+func (v *version) TektonNamespaceStatuses() TektonNamespaceStatusInformer {
+	return &tektonNamespaceStatusInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // TektonPipelines returns a TektonPipelineInformer.
 func (v *version) TektonPipelines() TektonPipelineInformer {
 	return &tektonPipelineInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
@@ -58,6 +58,10 @@ type TektonInstallerSetListerExpansion interface{}
 // TektonMulticlusterProxyAAELister.
 type TektonMulticlusterProxyAAEListerExpansion interface{}
 
+// TektonNamespaceStatusListerExpansion allows custom methods to be added to
+// TektonNamespaceStatusLister.
+type TektonNamespaceStatusListerExpansion interface{}
+
 // TektonPipelineListerExpansion allows custom methods to be added to
 // TektonPipelineLister.
 type TektonPipelineListerExpansion interface{}
@@ -93,3 +93,33 @@ func SCCAMoreRestrictiveThanB(prioritizedSCCList []*securityv1.SecurityContextCo
 
 	return sccAIndex <= sccBIndex, nil
 }
+
+// ValidateSCCConfig checks the default SCC exists and, when set, the maxAllowed SCC exists and
+// is not more restrictive than the default SCC. It is run by the webhook when TektonConfig is
+// applied and by the reconciler, as the SCCs can be deleted later.
+func ValidateSCCConfig(ctx context.Context, securityClient security.Interface, defaultSCC, maxAllowedSCC string) error {
+	if defaultSCC == "" {
+		return fmt.Errorf("tektonConfig.Spec.Platforms.OpenShift.SCC.Default cannot be empty")
+	}
+	if err := VerifySCCExists(ctx, defaultSCC, securityClient); err != nil {
+		return fmt.Errorf("failed to verify scc %s exists, %w", defaultSCC, err)
+	}
+	if maxAllowedSCC == "" {
+		return nil
+	}
+	if err := VerifySCCExists(ctx, maxAllowedSCC, securityClient); err != nil {
+		return fmt.Errorf("failed to verify scc %s exists, %w", maxAllowedSCC, err)
+	}
+	prioritizedSCCList, err := GetSCCRestrictiveList(ctx, securityClient)
+	if err != nil {
+		return err
+	}
+	isPriority, err := SCCAMoreRestrictiveThanB(prioritizedSCCList, defaultSCC, maxAllowedSCC)
+	if err != nil {
+		return err
+	}
+	if !isPriority {
+		return fmt.Errorf("maxAllowed SCC: %s must be less restrictive than the default SCC: %s", maxAllowedSCC, defaultSCC)
+	}
+	return nil
+}
@@ -0,0 +1,304 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	mf "github.com/manifestival/manifestival"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	"knative.dev/pkg/logging"
+)
+
+const (
+	// NodeArchLabel is the well known label holding the architecture of a node
+	NodeArchLabel = corev1.LabelArchStable
+
+	imageEnvPrefix = "IMAGE_"
+)
+
+// SupportedArchitectures are the architectures for which images can be overridden, an image
+// for an architecture is set with the env of the image followed by the upper cased architecture,
+// e.g. IMAGE_PIPELINES_CONTROLLER_ARM64
+var SupportedArchitectures = []string{"amd64", "arm64", "ppc64le", "s390x"}
+
+// ArchPlacement is the result of the architecture selection for the cluster
+type ArchPlacement struct {
+	// Architectures the workloads are scheduled on, empty when workloads are not restricted
+	Architectures []string
+	// NodeArchitectures are the architectures of the nodes with their number of nodes
+	NodeArchitectures map[string]int
+}
+
+// restricted returns true when some nodes are excluded from scheduling
+func (p *ArchPlacement) restricted() bool {
+	return p != nil && len(p.Architectures) > 0 && len(p.Architectures) < len(p.NodeArchitectures)
+}
+
+// single returns the architecture when workloads run on a single architecture
+func (p *ArchPlacement) single() string {
+	if p == nil || len(p.Architectures) != 1 {
+		return ""
+	}
+	return p.Architectures[0]
+}
+
+var (
+	archPlacementMutex sync.RWMutex
+	archPlacement      *ArchPlacement
+)
+
+// SetArchPlacement sets the architectures on which the workloads are scheduled
+func SetArchPlacement(p *ArchPlacement) {
+	archPlacementMutex.Lock()
+	defer archPlacementMutex.Unlock()
+	archPlacement = p
+}
+
+// GetArchPlacement returns the architectures on which the workloads are scheduled, nil when
+// no image is overridden per architecture
+func GetArchPlacement() *ArchPlacement {
+	archPlacementMutex.RLock()
+	defer archPlacementMutex.RUnlock()
+	return archPlacement
+}
+
+// splitArchSuffix returns the name of the image and the architecture for the env of an image
+// set for an architecture, the architecture is empty otherwise
+func splitArchSuffix(name string) (string, string) {
+	for _, arch := range SupportedArchitectures {
+		suffix := "_" + strings.ToUpper(arch)
+		if strings.HasSuffix(name, suffix) && len(name) > len(suffix) {
+			return strings.TrimSuffix(name, suffix), arch
+		}
+	}
+	return name, ""
+}
+
+// archImagesFromEnv returns the images set for an architecture and the images without
+// architecture, both with the prefix stripped
+func archImagesFromEnv(prefix string) (map[string]string, map[string]map[string]string) {
+	images := map[string]string{}
+	archImages := map[string]map[string]string{}
+	for _, env := range os.Environ() {
+		if !strings.HasPrefix(env, prefix) {
+			continue
+		}
+		keyValue := strings.SplitN(env, "=", 2)
+		name, arch := splitArchSuffix(strings.TrimPrefix(keyValue[0], prefix))
+		if arch == "" {
+			images[name] = keyValue[1]
+			continue
+		}
+		if archImages[name] == nil {
+			archImages[name] = map[string]string{}
+		}
+		archImages[name][arch] = keyValue[1]
+	}
+	return images, archImages
+}
+
+// selectArchImages replaces the images by the images of the architecture when workloads run on a
+// single architecture, the images without architecture are expected to be multi-arch
+func selectArchImages(images map[string]string, archImages map[string]map[string]string, placement *ArchPlacement) map[string]string {
+	arch := placement.single()
+	if arch == "" {
+		return images
+	}
+	for name, perArch := range archImages {
+		if image, ok := perArch[arch]; ok {
+			images[name] = image
+		}
+	}
+	return images
+}
+
+// ComputeArchPlacement selects the architectures the workloads are scheduled on. An architecture
+// is usable when every image overridden per architecture has an image for it, or a multi-arch
+// image without architecture. When some image only exists for some architectures, workloads are
+// restricted to the usable architecture with the most nodes, so that a single image is used for
+// each workload. An error is returned when no node architecture is usable.
+func ComputeArchPlacement(images map[string]string, archImages map[string]map[string]string, nodeArchs map[string]int) (*ArchPlacement, error) {
+	// without node architectures the images cannot be checked, workloads are not restricted
+	if len(archImages) == 0 || len(nodeArchs) == 0 {
+		return nil, nil
+	}
+
+	names := make([]string, 0, len(archImages))
+	for name := range archImages {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	usable := []string{}
+	missing := map[string][]string{}
+	for arch := range nodeArchs {
+		for _, name := range names {
+			if _, ok := archImages[name][arch]; !ok && images[name] == "" {
+				missing[arch] = append(missing[arch], name)
+			}
+		}
+		if len(missing[arch]) == 0 {
+			usable = append(usable, arch)
+		}
+	}
+	sort.Strings(usable)
+
+	if len(usable) == 0 {
+		archs := make([]string, 0, len(missing))
+		for arch := range missing {
+			archs = append(archs, arch)
+		}
+		sort.Strings(archs)
+		msgs := make([]string, 0, len(archs))
+		for _, arch := range archs {
+			msgs = append(msgs, fmt.Sprintf("%s: %s", arch, strings.Join(missing[arch], ", ")))
+		}
+		return nil, fmt.Errorf("no node architecture has images for all the components, missing images %s", strings.Join(msgs, "; "))
+	}
+
+	multiArch := true
+	for _, name := range names {
+		if images[name] == "" {
+			multiArch = false
+			break
+		}
+	}
+	if !multiArch && len(usable) > 1 {
+		// usable is sorted, ties are resolved with the first architecture
+		selected := usable[0]
+		for _, arch := range usable[1:] {
+			if nodeArchs[arch] > nodeArchs[selected] {
+				selected = arch
+			}
+		}
+		usable = []string{selected}
+	}
+	return &ArchPlacement{Architectures: usable, NodeArchitectures: nodeArchs}, nil
+}
+
+// RefreshArchPlacement selects the architectures the workloads are scheduled on from the
+// architectures of the nodes and the images overridden per architecture in the env of the
+// operator. The nodes are not listed when no image is overridden per architecture. The previous
+// placement is kept when no node architecture has images for all the components.
+func RefreshArchPlacement(ctx context.Context, kubeClient kubernetes.Interface) error {
+	images, archImages := archImagesFromEnv(imageEnvPrefix)
+	if len(archImages) == 0 {
+		SetArchPlacement(nil)
+		return nil
+	}
+
+	nodes, err := kubeClient.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	nodeArchs := map[string]int{}
+	for _, node := range nodes.Items {
+		if arch := node.Labels[NodeArchLabel]; arch != "" {
+			nodeArchs[arch]++
+		}
+	}
+
+	placement, err := ComputeArchPlacement(images, archImages, nodeArchs)
+	if err != nil {
+		return err
+	}
+	if placement == nil {
+		SetArchPlacement(nil)
+		return nil
+	}
+	if previous := GetArchPlacement(); previous == nil || !slices.Equal(previous.Architectures, placement.Architectures) {
+		logging.FromContext(ctx).Infow("selected the node architectures of the workloads",
+			"architectures", placement.Architectures, "nodeArchitectures", nodeArchs)
+	}
+	SetArchPlacement(placement)
+	return nil
+}
+
+// ArchNodeAffinity restricts the workloads to the nodes of the selected architectures when
+// the images are not available for all the node architectures
+func ArchNodeAffinity() mf.Transformer {
+	placement := GetArchPlacement()
+	return func(u *unstructured.Unstructured) error {
+		if !placement.restricted() {
+			return nil
+		}
+		switch u.GetKind() {
+		case "Deployment", "StatefulSet", "DaemonSet", "Job":
+		default:
+			return nil
+		}
+
+		podSpecMap, found, err := unstructured.NestedMap(u.Object, "spec", "template", "spec")
+		if err != nil || !found {
+			return err
+		}
+		podSpec := &corev1.PodSpec{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(podSpecMap, podSpec); err != nil {
+			return err
+		}
+		addArchRequirement(podSpec, placement.Architectures)
+		updated, err := runtime.DefaultUnstructuredConverter.ToUnstructured(podSpec)
+		if err != nil {
+			return err
+		}
+		return unstructured.SetNestedMap(u.Object, updated, "spec", "template", "spec")
+	}
+}
+
+// addArchRequirement adds the architecture requirement to every node selector term, as the terms
+// are ORed, existing requirements are kept
+func addArchRequirement(podSpec *corev1.PodSpec, archs []string) {
+	requirement := corev1.NodeSelectorRequirement{
+		Key:      NodeArchLabel,
+		Operator: corev1.NodeSelectorOpIn,
+		Values:   archs,
+	}
+	if podSpec.Affinity == nil {
+		podSpec.Affinity = &corev1.Affinity{}
+	}
+	if podSpec.Affinity.NodeAffinity == nil {
+		podSpec.Affinity.NodeAffinity = &corev1.NodeAffinity{}
+	}
+	nodeAffinity := podSpec.Affinity.NodeAffinity
+	if nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+		nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution = &corev1.NodeSelector{}
+	}
+	selector := nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution
+	if len(selector.NodeSelectorTerms) == 0 {
+		selector.NodeSelectorTerms = []corev1.NodeSelectorTerm{{}}
+	}
+	for i := range selector.NodeSelectorTerms {
+		term := &selector.NodeSelectorTerms[i]
+		exists := slices.ContainsFunc(term.MatchExpressions, func(r corev1.NodeSelectorRequirement) bool {
+			return r.Key == requirement.Key && r.Operator == requirement.Operator && slices.Equal(r.Values, requirement.Values)
+		})
+		if !exists {
+			term.MatchExpressions = append(term.MatchExpressions, requirement)
+		}
+	}
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestComputeArchPlacement(t *testing.T) {
+	nodeArchs := map[string]int{"amd64": 3, "arm64": 5, "s390x": 1}
+	tests := []struct {
+		name       string
+		images     map[string]string
+		archImages map[string]map[string]string
+		want       []string
+		wantErr    string
+	}{
+		{
+			name:   "no image per architecture",
+			images: map[string]string{"CONTROLLER": "controller"},
+		},
+		{
+			name:       "multi-arch images for all the components",
+			images:     map[string]string{"CONTROLLER": "controller", "WEBHOOK": "webhook"},
+			archImages: map[string]map[string]string{"CONTROLLER": {"arm64": "controller-arm64"}},
+			want:       []string{"amd64", "arm64", "s390x"},
+		},
+		{
+			name:   "image only available for some architectures",
+			images: map[string]string{"WEBHOOK": "webhook"},
+			archImages: map[string]map[string]string{
+				"CONTROLLER": {"amd64": "controller-amd64", "arm64": "controller-arm64"},
+			},
+			want: []string{"arm64"},
+		},
+		{
+			name:   "architecture missing an image is excluded",
+			images: map[string]string{},
+			archImages: map[string]map[string]string{
+				"CONTROLLER": {"amd64": "controller-amd64", "arm64": "controller-arm64"},
+				"WEBHOOK":    {"amd64": "webhook-amd64"},
+			},
+			want: []string{"amd64"},
+		},
+		{
+			name:   "no architecture with all the images",
+			images: map[string]string{},
+			archImages: map[string]map[string]string{
+				"CONTROLLER": {"ppc64le": "controller-ppc64le"},
+			},
+			wantErr: "no node architecture has images for all the components, missing images amd64: CONTROLLER; arm64: CONTROLLER; s390x: CONTROLLER",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			placement, err := ComputeArchPlacement(test.images, test.archImages, nodeArchs)
+			if test.wantErr != "" {
+				assert.Error(t, err, test.wantErr)
+				return
+			}
+			assert.NilError(t, err)
+			if test.want == nil {
+				assert.Assert(t, placement == nil)
+				return
+			}
+			assert.DeepEqual(t, placement.Architectures, test.want)
+		})
+	}
+}
+
+func TestImagesFromEnvPerArch(t *testing.T) {
+	t.Setenv("IMAGE_PIPELINES_CONTROLLER", "docker.io/pipeline")
+	t.Setenv("IMAGE_PIPELINES_CONTROLLER_ARM64", "docker.io/pipeline-arm64")
+	t.Setenv("IMAGE_PIPELINES_WEBHOOK_ARM64", "docker.io/webhook-arm64")
+	defer SetArchPlacement(nil)
+
+	// images per architecture are ignored when workloads are not restricted to one
+	SetArchPlacement(nil)
+	assert.DeepEqual(t, ImagesFromEnv(PipelinesImagePrefix), map[string]string{"CONTROLLER": "docker.io/pipeline"})
+
+	SetArchPlacement(&ArchPlacement{Architectures: []string{"arm64"}, NodeArchitectures: map[string]int{"amd64": 1, "arm64": 1}})
+	assert.DeepEqual(t, ImagesFromEnv(PipelinesImagePrefix), map[string]string{
+		"CONTROLLER": "docker.io/pipeline-arm64",
+		"WEBHOOK":    "docker.io/webhook-arm64",
+	})
+}
+
+func TestRefreshArchPlacement(t *testing.T) {
+	defer SetArchPlacement(nil)
+	node := func(name, arch string) *corev1.Node {
+		return &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{NodeArchLabel: arch}}}
+	}
+	kubeClient := fake.NewSimpleClientset(node("node-1", "amd64"), node("node-2", "arm64"))
+
+	t.Setenv("IMAGE_PIPELINES_CONTROLLER_AMD64", "docker.io/pipeline-amd64")
+	assert.NilError(t, RefreshArchPlacement(context.TODO(), kubeClient))
+	assert.DeepEqual(t, GetArchPlacement().Architectures, []string{"amd64"})
+
+	// the previous placement is kept when no architecture has all the images
+	t.Setenv("IMAGE_PIPELINES_WEBHOOK_ARM64", "docker.io/webhook-arm64")
+	assert.ErrorContains(t, RefreshArchPlacement(context.TODO(), kubeClient), "no node architecture has images for all the components")
+	assert.DeepEqual(t, GetArchPlacement().Architectures, []string{"amd64"})
+}
+
+func TestArchNodeAffinity(t *testing.T) {
+	defer SetArchPlacement(nil)
+	deployment := &appsv1.Deployment{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+		ObjectMeta: metav1.ObjectMeta{Name: "controller"},
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "controller", Image: "controller"}},
+					Affinity: &corev1.Affinity{NodeAffinity: &corev1.NodeAffinity{
+						RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+							NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+								MatchExpressions: []corev1.NodeSelectorRequirement{{Key: "zone", Operator: corev1.NodeSelectorOpExists}},
+							}},
+						},
+					}},
+				},
+			},
+		},
+	}
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(deployment)
+	assert.NilError(t, err)
+	u := &unstructured.Unstructured{Object: obj}
+
+	// all the node architectures are usable
+	SetArchPlacement(&ArchPlacement{Architectures: []string{"amd64", "arm64"}, NodeArchitectures: map[string]int{"amd64": 1, "arm64": 1}})
+	assert.NilError(t, ArchNodeAffinity()(u))
+	got := &appsv1.Deployment{}
+	assert.NilError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, got))
+	assert.DeepEqual(t, got.Spec.Template.Spec.Affinity, deployment.Spec.Template.Spec.Affinity)
+
+	SetArchPlacement(&ArchPlacement{Architectures: []string{"arm64"}, NodeArchitectures: map[string]int{"amd64": 1, "arm64": 1}})
+	transformer := ArchNodeAffinity()
+	assert.NilError(t, transformer(u))
+	// the transformer is idempotent
+	assert.NilError(t, transformer(u))
+
+	got = &appsv1.Deployment{}
+	assert.NilError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, got))
+	assert.DeepEqual(t, got.Spec.Template.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms,
+		[]corev1.NodeSelectorTerm{{
+			MatchExpressions: []corev1.NodeSelectorRequirement{
+				{Key: "zone", Operator: corev1.NodeSelectorOpExists},
+				{Key: NodeArchLabel, Operator: corev1.NodeSelectorOpIn, Values: []string{"arm64"}},
+			},
+		}})
+}
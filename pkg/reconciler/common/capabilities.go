@@ -0,0 +1,180 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	mf "github.com/manifestival/manifestival"
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+)
+
+// capabilitiesTTL is the time after which the APIs served by the cluster are discovered again,
+// as CRDs and aggregated APIs may be installed while the operator runs
+const capabilitiesTTL = 5 * time.Minute
+
+// compatibleVersions are the API versions of the kinds which can be created with any of the
+// versions, without changing the manifests, in order of preference
+var compatibleVersions = map[schema.GroupKind][]string{
+	{Group: "batch", Kind: "CronJob"}:                       {"v1", "v1beta1"},
+	{Group: "autoscaling", Kind: "HorizontalPodAutoscaler"}: {"v2", "v2beta2"},
+	{Group: "policy", Kind: "PodDisruptionBudget"}:          {"v1", "v1beta1"},
+}
+
+// optionalKinds are only created on the clusters serving them, so that the same payload installs
+// on OpenShift and on Kubernetes, e.g. Routes and the console resources only exist on OpenShift and
+// ServiceMonitors only exist with the Prometheus operator
+var optionalKinds = map[schema.GroupKind]bool{
+	{Group: "route.openshift.io", Kind: "Route"}:                         true,
+	{Group: "security.openshift.io", Kind: "SecurityContextConstraints"}: true,
+	{Group: "console.openshift.io", Kind: "ConsolePlugin"}:               true,
+	{Group: "console.openshift.io", Kind: "ConsoleYAMLSample"}:           true,
+	{Group: "console.openshift.io", Kind: "ConsoleQuickStart"}:           true,
+	{Group: "console.openshift.io", Kind: "ConsoleCLIDownload"}:          true,
+	{Group: "console.openshift.io", Kind: "ConsoleLink"}:                 true,
+	{Group: "monitoring.coreos.com", Kind: "ServiceMonitor"}:             true,
+}
+
+// Capabilities are the API versions and kinds served by the cluster
+type Capabilities struct {
+	served map[schema.GroupVersionKind]bool
+}
+
+// NewCapabilities returns the capabilities of the cluster serving the resources
+func NewCapabilities(resources []*metav1.APIResourceList) *Capabilities {
+	c := &Capabilities{served: map[schema.GroupVersionKind]bool{}}
+	for _, list := range resources {
+		if list == nil {
+			continue
+		}
+		gv, err := schema.ParseGroupVersion(list.GroupVersion)
+		if err != nil {
+			continue
+		}
+		for _, r := range list.APIResources {
+			c.served[gv.WithKind(r.Kind)] = true
+		}
+	}
+	return c
+}
+
+// DiscoverCapabilities returns the capabilities of the cluster, the groups which could not be
+// discovered, e.g. an unavailable aggregated API, are not served and are returned in a
+// discovery.ErrGroupDiscoveryFailed error along with the capabilities
+func DiscoverCapabilities(discoveryClient discovery.DiscoveryInterface) (*Capabilities, error) {
+	_, resources, err := discovery.ServerGroupsAndResources(discoveryClient)
+	if err != nil && !discovery.IsGroupDiscoveryFailedError(err) {
+		return nil, err
+	}
+	return NewCapabilities(resources), err
+}
+
+// Serves returns true if the cluster serves the kind in the API version
+func (c *Capabilities) Serves(gvk schema.GroupVersionKind) bool {
+	return c.served[gvk]
+}
+
+// SkipUnserved returns the manifest without the resources of the optional kinds which are not
+// served by the cluster, along with the skipped resources as kind/name
+func (c *Capabilities) SkipUnserved(manifest mf.Manifest, logger *zap.SugaredLogger) (mf.Manifest, []string) {
+	var skipped []string
+	result := manifest.Filter(func(u *unstructured.Unstructured) bool {
+		gvk := u.GroupVersionKind()
+		if !optionalKinds[gvk.GroupKind()] || c.Serves(gvk) {
+			return true
+		}
+		logger.Infow("skipping resource of an API not served by the cluster",
+			"kind", gvk.Kind, "apiVersion", u.GetAPIVersion(), "name", u.GetName())
+		skipped = append(skipped, fmt.Sprintf("%s/%s", gvk.Kind, u.GetName()))
+		return false
+	})
+	return result, skipped
+}
+
+// SelectVersions returns the manifest with the API version of each resource replaced by a
+// compatible version served by the cluster, when the cluster does not serve the version of the
+// manifest. The optional kinds which are not served by the cluster are removed and returned as
+// kind/name. The other resources are kept as they are, and fail to be created if the cluster does
+// not serve them.
+func (c *Capabilities) SelectVersions(manifest mf.Manifest, logger *zap.SugaredLogger) (mf.Manifest, []string, error) {
+	result, skipped := c.SkipUnserved(manifest, logger)
+	result, err := result.Transform(func(u *unstructured.Unstructured) error {
+		gvk := u.GroupVersionKind()
+		if c.Serves(gvk) {
+			return nil
+		}
+		for _, version := range compatibleVersions[gvk.GroupKind()] {
+			candidate := gvk.GroupKind().WithVersion(version)
+			if c.Serves(candidate) {
+				logger.Debugw("creating resource with the API version served by the cluster",
+					"kind", gvk.Kind, "name", u.GetName(), "from", gvk.GroupVersion().String(), "to", candidate.GroupVersion().String())
+				u.SetAPIVersion(candidate.GroupVersion().String())
+				return nil
+			}
+		}
+		return nil
+	})
+	return result, skipped, err
+}
+
+// CapabilitiesCache discovers the capabilities of the cluster again once they are older than
+// the TTL, the last capabilities are kept when the discovery fails
+type CapabilitiesCache struct {
+	discoveryClient discovery.DiscoveryInterface
+	now             func() time.Time
+
+	mutex        sync.Mutex
+	capabilities *Capabilities
+	discoveredAt time.Time
+}
+
+func NewCapabilitiesCache(discoveryClient discovery.DiscoveryInterface) *CapabilitiesCache {
+	return &CapabilitiesCache{discoveryClient: discoveryClient, now: time.Now}
+}
+
+// Get returns the capabilities of the cluster
+func (cc *CapabilitiesCache) Get() (*Capabilities, error) {
+	cc.mutex.Lock()
+	defer cc.mutex.Unlock()
+	if cc.capabilities != nil && cc.now().Sub(cc.discoveredAt) < capabilitiesTTL {
+		return cc.capabilities, nil
+	}
+	capabilities, err := DiscoverCapabilities(cc.discoveryClient)
+	if capabilities == nil {
+		if cc.capabilities != nil {
+			return cc.capabilities, nil
+		}
+		return nil, err
+	}
+	// the kinds of the groups which could not be discovered are kept from the last discovery
+	if failed, ok := err.(*discovery.ErrGroupDiscoveryFailed); ok && cc.capabilities != nil {
+		for gvk := range cc.capabilities.served {
+			if _, ok := failed.Groups[gvk.GroupVersion()]; ok {
+				capabilities.served[gvk] = true
+			}
+		}
+	}
+	cc.capabilities = capabilities
+	cc.discoveredAt = cc.now()
+	return capabilities, nil
+}
@@ -0,0 +1,201 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	mf "github.com/manifestival/manifestival"
+	"go.uber.org/zap"
+	"gotest.tools/v3/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func apiResources(groupVersion string, kinds ...string) *metav1.APIResourceList {
+	list := &metav1.APIResourceList{GroupVersion: groupVersion}
+	for _, kind := range kinds {
+		list.APIResources = append(list.APIResources, metav1.APIResource{Kind: kind})
+	}
+	return list
+}
+
+func capabilityTestResource(apiVersion, kind, name string) unstructured.Unstructured {
+	u := unstructured.Unstructured{}
+	u.SetAPIVersion(apiVersion)
+	u.SetKind(kind)
+	u.SetName(name)
+	return u
+}
+
+func TestCapabilitiesSelectVersions(t *testing.T) {
+	// the APIs served by clusters of different versions
+	clusters := map[string][]*metav1.APIResourceList{
+		"kubernetes 1.20": {
+			apiResources("v1", "ConfigMap"),
+			apiResources("batch/v1beta1", "CronJob"),
+			apiResources("autoscaling/v2beta2", "HorizontalPodAutoscaler"),
+			apiResources("policy/v1beta1", "PodDisruptionBudget"),
+		},
+		"kubernetes 1.23": {
+			apiResources("v1", "ConfigMap"),
+			apiResources("batch/v1", "CronJob"),
+			apiResources("batch/v1beta1", "CronJob"),
+			apiResources("autoscaling/v2", "HorizontalPodAutoscaler"),
+			apiResources("autoscaling/v2beta2", "HorizontalPodAutoscaler"),
+			apiResources("policy/v1", "PodDisruptionBudget"),
+		},
+		"kubernetes 1.30": {
+			apiResources("v1", "ConfigMap"),
+			apiResources("batch/v1", "CronJob"),
+			apiResources("autoscaling/v2", "HorizontalPodAutoscaler"),
+			apiResources("policy/v1", "PodDisruptionBudget"),
+		},
+		"openshift 4.16": {
+			apiResources("v1", "ConfigMap"),
+			apiResources("batch/v1", "CronJob"),
+			apiResources("autoscaling/v2", "HorizontalPodAutoscaler"),
+			apiResources("policy/v1", "PodDisruptionBudget"),
+			apiResources("route.openshift.io/v1", "Route"),
+			apiResources("console.openshift.io/v1", "ConsolePlugin"),
+		},
+	}
+	resources := []unstructured.Unstructured{
+		capabilityTestResource("v1", "ConfigMap", "config"),
+		capabilityTestResource("batch/v1", "CronJob", "pruner"),
+		capabilityTestResource("autoscaling/v2", "HorizontalPodAutoscaler", "webhook"),
+		capabilityTestResource("policy/v1beta1", "PodDisruptionBudget", "webhook"),
+		capabilityTestResource("route.openshift.io/v1", "Route", "results"),
+		capabilityTestResource("console.openshift.io/v1", "ConsolePlugin", "pipelines-console-plugin"),
+	}
+
+	tests := []struct {
+		cluster string
+		// API versions of the resources, by kind
+		want map[string]string
+		// skipped resources of the optional kinds not served by the cluster
+		wantSkipped []string
+	}{
+		{
+			cluster: "kubernetes 1.20",
+			want: map[string]string{
+				"ConfigMap":               "v1",
+				"CronJob":                 "batch/v1beta1",
+				"HorizontalPodAutoscaler": "autoscaling/v2beta2",
+				"PodDisruptionBudget":     "policy/v1beta1",
+			},
+			wantSkipped: []string{"Route/results", "ConsolePlugin/pipelines-console-plugin"},
+		},
+		{
+			cluster: "kubernetes 1.23",
+			want: map[string]string{
+				"ConfigMap":               "v1",
+				"CronJob":                 "batch/v1",
+				"HorizontalPodAutoscaler": "autoscaling/v2",
+				"PodDisruptionBudget":     "policy/v1",
+			},
+			wantSkipped: []string{"Route/results", "ConsolePlugin/pipelines-console-plugin"},
+		},
+		{
+			cluster: "kubernetes 1.30",
+			want: map[string]string{
+				"ConfigMap":               "v1",
+				"CronJob":                 "batch/v1",
+				"HorizontalPodAutoscaler": "autoscaling/v2",
+				"PodDisruptionBudget":     "policy/v1",
+			},
+			wantSkipped: []string{"Route/results", "ConsolePlugin/pipelines-console-plugin"},
+		},
+		{
+			cluster: "openshift 4.16",
+			want: map[string]string{
+				"ConfigMap":               "v1",
+				"CronJob":                 "batch/v1",
+				"HorizontalPodAutoscaler": "autoscaling/v2",
+				"PodDisruptionBudget":     "policy/v1",
+				"Route":                   "route.openshift.io/v1",
+				"ConsolePlugin":           "console.openshift.io/v1",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.cluster, func(t *testing.T) {
+			manifest, err := mf.ManifestFrom(mf.Slice(resources))
+			assert.NilError(t, err)
+			selected, skipped, err := NewCapabilities(clusters[test.cluster]).SelectVersions(manifest, zap.NewNop().Sugar())
+			assert.NilError(t, err)
+			assert.DeepEqual(t, skipped, test.wantSkipped)
+			got := map[string]string{}
+			for _, u := range selected.Resources() {
+				got[u.GetKind()] = u.GetAPIVersion()
+			}
+			assert.DeepEqual(t, got, test.want)
+		})
+	}
+}
+
+func TestCapabilitiesKeepUnknownVersions(t *testing.T) {
+	// resources without a compatible version are kept, their creation reports the missing API
+	manifest, err := mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{
+		capabilityTestResource("example.dev/v1", "Widget", "widget"),
+	}))
+	assert.NilError(t, err)
+	selected, skipped, err := NewCapabilities(nil).SelectVersions(manifest, zap.NewNop().Sugar())
+	assert.NilError(t, err)
+	assert.Equal(t, len(skipped), 0)
+	assert.Equal(t, len(selected.Resources()), 1)
+	assert.Equal(t, selected.Resources()[0].GetAPIVersion(), "example.dev/v1")
+}
+
+func TestCapabilitiesCache(t *testing.T) {
+	kubeClient := fake.NewSimpleClientset()
+	discoveryClient := kubeClient.Discovery().(*fakediscovery.FakeDiscovery)
+	discoveryClient.Resources = []*metav1.APIResourceList{apiResources("batch/v1beta1", "CronJob")}
+	now := time.Now()
+	cache := NewCapabilitiesCache(discoveryClient)
+	cache.now = func() time.Time { return now }
+
+	capabilities, err := cache.Get()
+	assert.NilError(t, err)
+	assert.Assert(t, capabilities.Serves(schema.FromAPIVersionAndKind("batch/v1beta1", "CronJob")))
+
+	// the cluster is upgraded, the capabilities are discovered again after the TTL
+	discoveryClient.Resources = []*metav1.APIResourceList{apiResources("batch/v1", "CronJob")}
+	capabilities, err = cache.Get()
+	assert.NilError(t, err)
+	assert.Assert(t, !capabilities.Serves(schema.FromAPIVersionAndKind("batch/v1", "CronJob")))
+	now = now.Add(capabilitiesTTL)
+	capabilities, err = cache.Get()
+	assert.NilError(t, err)
+	assert.Assert(t, capabilities.Serves(schema.FromAPIVersionAndKind("batch/v1", "CronJob")))
+
+	// the last capabilities are kept when the discovery fails
+	kubeClient.PrependReactor("get", "resource", func(k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.New("connection refused")
+	})
+	now = now.Add(capabilitiesTTL)
+	capabilities, err = cache.Get()
+	assert.NilError(t, err)
+	assert.Assert(t, capabilities.Serves(schema.FromAPIVersionAndKind("batch/v1", "CronJob")))
+}
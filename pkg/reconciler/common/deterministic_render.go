@@ -0,0 +1,172 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// DeterministicRenderKey of the controllers ConfigMap enables the deterministic rendering of
+// the manifests of the installer sets
+const DeterministicRenderKey = "deterministic-render"
+
+// deterministicRender is set once when the operator starts
+var deterministicRender atomic.Bool
+
+// metadataFieldsSetByServer are stripped from the rendered manifests, they are set by the
+// API server and differ between clusters
+var metadataFieldsSetByServer = []string{"creationTimestamp", "generation", "managedFields", "resourceVersion", "selfLink", "uid"}
+
+// NewDeterministicRenderFromMap reads whether the manifests are rendered deterministically
+// from the data of the controllers ConfigMap, it is disabled by default
+func NewDeterministicRenderFromMap(data map[string]string) (bool, error) {
+	value, ok := data[DeterministicRenderKey]
+	if !ok {
+		return false, nil
+	}
+	enabled, err := strconv.ParseBool(strings.TrimSpace(value))
+	if err != nil {
+		return false, fmt.Errorf("invalid value %q for %s, must be true or false", value, DeterministicRenderKey)
+	}
+	return enabled, nil
+}
+
+// SetDeterministicRender sets whether the manifests of the installer sets are rendered deterministically
+func SetDeterministicRender(enabled bool) {
+	deterministicRender.Store(enabled)
+}
+
+// DeterministicRender returns whether the manifests of the installer sets are rendered deterministically
+func DeterministicRender() bool {
+	return deterministicRender.Load()
+}
+
+// RenderDeterministic returns a copy of the resources sorted by kind, namespace and name, without
+// the status, the metadata set by the API server and the null fields. An error is returned when
+// an image is not pinned by digest, as the image of a tag may change between installs.
+func RenderDeterministic(resources []unstructured.Unstructured) ([]unstructured.Unstructured, error) {
+	rendered := make([]unstructured.Unstructured, 0, len(resources))
+	var unpinned []string
+	for _, u := range resources {
+		u := *u.DeepCopy()
+		delete(u.Object, "status")
+		if metadata, ok := u.Object["metadata"].(map[string]interface{}); ok {
+			for _, field := range metadataFieldsSetByServer {
+				delete(metadata, field)
+			}
+		}
+		stripNullFields(u.Object)
+		for _, image := range unstructuredImages(u.Object) {
+			if !strings.Contains(image, "@sha256:") {
+				unpinned = append(unpinned, fmt.Sprintf("%s %s: %s", u.GetKind(), u.GetName(), image))
+			}
+		}
+		rendered = append(rendered, u)
+	}
+	if len(unpinned) > 0 {
+		sort.Strings(unpinned)
+		return nil, fmt.Errorf("images must be pinned by digest to be rendered deterministically: %s", strings.Join(unpinned, ", "))
+	}
+	sort.SliceStable(rendered, func(i, j int) bool {
+		a, b := rendered[i], rendered[j]
+		if a.GetKind() != b.GetKind() {
+			return a.GetKind() < b.GetKind()
+		}
+		if a.GetNamespace() != b.GetNamespace() {
+			return a.GetNamespace() < b.GetNamespace()
+		}
+		return a.GetName() < b.GetName()
+	})
+	return rendered, nil
+}
+
+// ManifestsSHA256 returns the SHA256 of the JSON encoding of the resources, the keys of the
+// objects are encoded in sorted order
+func ManifestsSHA256(resources []unstructured.Unstructured) (string, error) {
+	objects := make([]map[string]interface{}, 0, len(resources))
+	for _, u := range resources {
+		objects = append(objects, u.Object)
+	}
+	data, err := json.Marshal(objects)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// BundleSHA256 returns the SHA256 of a bundle of manifests from the SHA256 of its parts, it
+// does not depend on the order of the parts
+func BundleSHA256(sums []string) string {
+	sorted := append([]string{}, sums...)
+	sort.Strings(sorted)
+	sum := sha256.Sum256([]byte(strings.Join(sorted, "\n")))
+	return hex.EncodeToString(sum[:])
+}
+
+func stripNullFields(obj interface{}) {
+	switch o := obj.(type) {
+	case map[string]interface{}:
+		for key, value := range o {
+			if value == nil {
+				delete(o, key)
+				continue
+			}
+			stripNullFields(value)
+		}
+	case []interface{}:
+		for _, value := range o {
+			stripNullFields(value)
+		}
+	}
+}
+
+// unstructuredImages returns the images of the containers and init containers in the object
+func unstructuredImages(obj interface{}) []string {
+	var images []string
+	switch o := obj.(type) {
+	case map[string]interface{}:
+		for key, value := range o {
+			if key == "containers" || key == "initContainers" {
+				containers, _ := value.([]interface{})
+				for _, c := range containers {
+					if container, ok := c.(map[string]interface{}); ok {
+						if image, ok := container["image"].(string); ok && image != "" {
+							images = append(images, image)
+						}
+					}
+				}
+				continue
+			}
+			images = append(images, unstructuredImages(value)...)
+		}
+	case []interface{}:
+		for _, value := range o {
+			images = append(images, unstructuredImages(value)...)
+		}
+	}
+	return images
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func renderTestDeployment(name, image string) unstructured.Unstructured {
+	return unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]interface{}{
+			"name":              name,
+			"namespace":         "tekton-pipelines",
+			"creationTimestamp": nil,
+			"resourceVersion":   "42",
+		},
+		"spec": map[string]interface{}{
+			"template": map[string]interface{}{
+				"metadata": map[string]interface{}{"creationTimestamp": nil},
+				"spec": map[string]interface{}{
+					"containers": []interface{}{map[string]interface{}{"name": name, "image": image}},
+					"volumes":    []interface{}{map[string]interface{}{"name": "tmp", "emptyDir": map[string]interface{}{}}},
+				},
+			},
+		},
+		"status": map[string]interface{}{"replicas": int64(1)},
+	}}
+}
+
+func TestNewDeterministicRenderFromMap(t *testing.T) {
+	enabled, err := NewDeterministicRenderFromMap(map[string]string{})
+	assert.NilError(t, err)
+	assert.Assert(t, !enabled)
+
+	enabled, err = NewDeterministicRenderFromMap(map[string]string{DeterministicRenderKey: "true"})
+	assert.NilError(t, err)
+	assert.Assert(t, enabled)
+
+	_, err = NewDeterministicRenderFromMap(map[string]string{DeterministicRenderKey: "always"})
+	assert.ErrorContains(t, err, "invalid value")
+}
+
+func TestRenderDeterministic(t *testing.T) {
+	digest := "@sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+	webhook := renderTestDeployment("webhook", "gcr.io/tekton/webhook"+digest)
+	controller := renderTestDeployment("controller", "gcr.io/tekton/controller"+digest)
+	sa := unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ServiceAccount",
+		"metadata":   map[string]interface{}{"name": "controller", "namespace": "tekton-pipelines"},
+	}}
+
+	rendered, err := RenderDeterministic([]unstructured.Unstructured{webhook, sa, controller})
+	assert.NilError(t, err)
+	names := []string{}
+	for _, u := range rendered {
+		names = append(names, u.GetKind()+"/"+u.GetName())
+	}
+	assert.DeepEqual(t, names, []string{"Deployment/controller", "Deployment/webhook", "ServiceAccount/controller"})
+
+	u := rendered[0]
+	_, ok := u.Object["status"]
+	assert.Assert(t, !ok)
+	_, ok, _ = unstructured.NestedFieldNoCopy(u.Object, "metadata", "resourceVersion")
+	assert.Assert(t, !ok)
+	_, ok, _ = unstructured.NestedFieldNoCopy(u.Object, "spec", "template", "metadata", "creationTimestamp")
+	assert.Assert(t, !ok)
+	// empty objects are meaningful and kept
+	volumes, _, _ := unstructured.NestedSlice(u.Object, "spec", "template", "spec", "volumes")
+	_, ok = volumes[0].(map[string]interface{})["emptyDir"]
+	assert.Assert(t, ok)
+	// the resources are not modified
+	_, ok = controller.Object["status"]
+	assert.Assert(t, ok)
+
+	// the same manifests in any order have the same SHA256
+	sum, err := ManifestsSHA256(rendered)
+	assert.NilError(t, err)
+	reordered, err := RenderDeterministic([]unstructured.Unstructured{controller, sa, webhook})
+	assert.NilError(t, err)
+	other, err := ManifestsSHA256(reordered)
+	assert.NilError(t, err)
+	assert.Equal(t, sum, other)
+
+	_, err = RenderDeterministic([]unstructured.Unstructured{renderTestDeployment("controller", "gcr.io/tekton/controller:v0.59.0")})
+	assert.ErrorContains(t, err, "Deployment controller: gcr.io/tekton/controller:v0.59.0")
+}
+
+func TestBundleSHA256(t *testing.T) {
+	assert.Equal(t, BundleSHA256([]string{"a", "b"}), BundleSHA256([]string{"b", "a"}))
+	assert.Assert(t, BundleSHA256([]string{"a", "b"}) != BundleSHA256([]string{"a", "c"}))
+}
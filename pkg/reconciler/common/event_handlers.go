@@ -0,0 +1,137 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8scache "k8s.io/client-go/tools/cache"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+// HandleSpecChanges returns the event handler of the informer of the reconciled resources. It calls h
+// for the added and deleted resources and for the updates changing the spec or the metadata of a
+// resource, the updates only changing the status are ignored. The resyncs of the informer are handled.
+func HandleSpecChanges(h func(interface{})) k8scache.ResourceEventHandler {
+	return handleUpdates(h, specChanged)
+}
+
+// HandleReadinessChanges returns the event handler of the informer of the resources owned by the
+// reconciled resources, e.g. their installer sets. It calls h for the updates handled by
+// HandleSpecChanges and for the status updates changing the conditions, ignoring their last
+// transition time, or the observed generation of a resource. The status updates of the resources
+// whose status is not known are all handled.
+func HandleReadinessChanges(h func(interface{})) k8scache.ResourceEventHandler {
+	return handleUpdates(h, func(oldObj, newObj interface{}) bool {
+		return specChanged(oldObj, newObj) || readinessChanged(oldObj, newObj)
+	})
+}
+
+// HandleNamespaceChanges returns the event handler of the namespaces informer. It calls h for the
+// added and deleted namespaces and for the updates changing the labels, the annotations or the phase
+// of a namespace, the other updates do not change the resources the operator creates in a namespace.
+func HandleNamespaceChanges(h func(interface{})) k8scache.ResourceEventHandler {
+	return handleUpdates(h, func(oldObj, newObj interface{}) bool {
+		oldNs, ok := oldObj.(*corev1.Namespace)
+		newNs, ok2 := newObj.(*corev1.Namespace)
+		if !ok || !ok2 {
+			return true
+		}
+		return oldNs.ResourceVersion == newNs.ResourceVersion ||
+			!equality.Semantic.DeepEqual(oldNs.Labels, newNs.Labels) ||
+			!equality.Semantic.DeepEqual(oldNs.Annotations, newNs.Annotations) ||
+			oldNs.Status.Phase != newNs.Status.Phase ||
+			!oldNs.DeletionTimestamp.Equal(newNs.DeletionTimestamp)
+	})
+}
+
+func handleUpdates(h func(interface{}), changed func(oldObj, newObj interface{}) bool) k8scache.ResourceEventHandler {
+	return k8scache.ResourceEventHandlerFuncs{
+		AddFunc: h,
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			if changed(oldObj, newObj) {
+				h(newObj)
+			}
+		},
+		DeleteFunc: h,
+	}
+}
+
+// specChanged returns true when the generation or the metadata of the resource changed, or on a
+// resync of the informer. The generation of the operator resources is only incremented on spec changes.
+func specChanged(oldObj, newObj interface{}) bool {
+	oldMeta, ok := oldObj.(metav1.Object)
+	newMeta, ok2 := newObj.(metav1.Object)
+	if !ok || !ok2 {
+		return true
+	}
+	return oldMeta.GetResourceVersion() == newMeta.GetResourceVersion() ||
+		oldMeta.GetGeneration() != newMeta.GetGeneration() ||
+		!equality.Semantic.DeepEqual(oldMeta.GetLabels(), newMeta.GetLabels()) ||
+		!equality.Semantic.DeepEqual(oldMeta.GetAnnotations(), newMeta.GetAnnotations()) ||
+		!equality.Semantic.DeepEqual(oldMeta.GetFinalizers(), newMeta.GetFinalizers()) ||
+		!equality.Semantic.DeepEqual(oldMeta.GetOwnerReferences(), newMeta.GetOwnerReferences()) ||
+		!oldMeta.GetDeletionTimestamp().Equal(newMeta.GetDeletionTimestamp())
+}
+
+func readinessChanged(oldObj, newObj interface{}) bool {
+	oldStatus, newStatus := duckStatus(oldObj), duckStatus(newObj)
+	if oldStatus == nil || newStatus == nil {
+		return true
+	}
+	if oldStatus.ObservedGeneration != newStatus.ObservedGeneration ||
+		len(oldStatus.Conditions) != len(newStatus.Conditions) {
+		return true
+	}
+	for i := range oldStatus.Conditions {
+		if !sameCondition(oldStatus.Conditions[i], newStatus.Conditions[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+func sameCondition(a, b apis.Condition) bool {
+	return a.Type == b.Type && a.Status == b.Status && a.Severity == b.Severity &&
+		a.Reason == b.Reason && a.Message == b.Message
+}
+
+// duckStatus returns the status of the operator resources watched as owned resources
+func duckStatus(obj interface{}) *duckv1.Status {
+	switch o := obj.(type) {
+	case *v1alpha1.TektonInstallerSet:
+		return &o.Status.Status
+	case *v1alpha1.TektonPipeline:
+		return &o.Status.Status
+	case *v1alpha1.TektonTrigger:
+		return &o.Status.Status
+	case *v1alpha1.TektonChain:
+		return &o.Status.Status
+	case *v1alpha1.TektonResult:
+		return &o.Status.Status
+	case *v1alpha1.TektonDashboard:
+		return &o.Status.Status
+	case *v1alpha1.TektonAddon:
+		return &o.Status.Status
+	case *v1alpha1.OpenShiftPipelinesAsCode:
+		return &o.Status.Status
+	}
+	return nil
+}
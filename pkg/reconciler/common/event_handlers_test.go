@@ -0,0 +1,114 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+)
+
+func TestHandleSpecChanges(t *testing.T) {
+	var handled []interface{}
+	handler := HandleSpecChanges(func(obj interface{}) { handled = append(handled, obj) })
+
+	old := &v1alpha1.TektonPipeline{ObjectMeta: metav1.ObjectMeta{Name: "pipeline", Generation: 1, ResourceVersion: "1"}}
+	old.Status.MarkPreReconcilerComplete()
+
+	// the status updates are ignored
+	statusUpdate := old.DeepCopy()
+	statusUpdate.ResourceVersion = "2"
+	statusUpdate.Status.MarkInstallerSetNotReady("waiting")
+	handler.OnUpdate(old, statusUpdate)
+	assert.Equal(t, len(handled), 0)
+
+	// the spec and metadata updates and the resyncs are handled
+	specUpdate := statusUpdate.DeepCopy()
+	specUpdate.ResourceVersion = "3"
+	specUpdate.Generation = 2
+	handler.OnUpdate(statusUpdate, specUpdate)
+	annotated := specUpdate.DeepCopy()
+	annotated.ResourceVersion = "4"
+	annotated.Annotations = map[string]string{"operator.tekton.dev/action": "restart"}
+	handler.OnUpdate(specUpdate, annotated)
+	handler.OnUpdate(annotated, annotated)
+	handler.OnAdd(old, false)
+	handler.OnDelete(old)
+	assert.DeepEqual(t, handled, []interface{}{specUpdate, annotated, annotated, old, old})
+}
+
+func TestHandleReadinessChanges(t *testing.T) {
+	var handled []interface{}
+	handler := HandleReadinessChanges(func(obj interface{}) { handled = append(handled, obj) })
+
+	old := &v1alpha1.TektonInstallerSet{ObjectMeta: metav1.ObjectMeta{Name: "pipeline-main-deployment", ResourceVersion: "1"}}
+	old.Status.InitializeConditions()
+	old.Status.MarkDeploymentsAvailableFailed("waiting")
+
+	// only the last transition time of the conditions changed
+	touched := old.DeepCopy()
+	touched.ResourceVersion = "2"
+	for i := range touched.Status.Conditions {
+		touched.Status.Conditions[i].LastTransitionTime = apis.VolatileTime{Inner: metav1.Now()}
+	}
+	handler.OnUpdate(old, touched)
+	assert.Equal(t, len(handled), 0)
+
+	ready := touched.DeepCopy()
+	ready.ResourceVersion = "3"
+	ready.Status.MarkDeploymentsAvailable()
+	handler.OnUpdate(touched, ready)
+	observed := ready.DeepCopy()
+	observed.ResourceVersion = "4"
+	observed.Status.ObservedGeneration = 1
+	handler.OnUpdate(ready, observed)
+	assert.DeepEqual(t, handled, []interface{}{ready, observed})
+
+	// the status of the other resources is not known
+	handled = nil
+	oldCM := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "config", ResourceVersion: "1"}}
+	newCM := oldCM.DeepCopy()
+	newCM.ResourceVersion = "2"
+	handler.OnUpdate(oldCM, newCM)
+	assert.Equal(t, len(handled), 1)
+}
+
+func TestHandleNamespaceChanges(t *testing.T) {
+	var handled []interface{}
+	handler := HandleNamespaceChanges(func(obj interface{}) { handled = append(handled, obj) })
+
+	old := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a", ResourceVersion: "1"}}
+	managed := old.DeepCopy()
+	managed.ResourceVersion = "2"
+	managed.ManagedFields = []metav1.ManagedFieldsEntry{{Manager: "kubectl"}}
+	handler.OnUpdate(old, managed)
+	assert.Equal(t, len(handled), 0)
+
+	labeled := managed.DeepCopy()
+	labeled.ResourceVersion = "3"
+	labeled.Labels = map[string]string{"ca": "corporate"}
+	handler.OnUpdate(managed, labeled)
+	terminating := labeled.DeepCopy()
+	terminating.ResourceVersion = "4"
+	terminating.Status.Phase = corev1.NamespaceTerminating
+	handler.OnUpdate(labeled, terminating)
+	assert.DeepEqual(t, handled, []interface{}{labeled, terminating})
+}
@@ -0,0 +1,197 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	"knative.dev/pkg/logging"
+)
+
+const (
+	// OperatorEventLabel marks the events emitted by the operator, with their reason
+	OperatorEventLabel = "operator.tekton.dev/event-reason"
+
+	DefaultOperatorEventBurst = 10
+	DefaultOperatorEventTTL   = 24 * time.Hour
+)
+
+// Event is an event of the operator about an object
+type Event struct {
+	// Object the event is about, the event is created in its namespace unless Namespace is set
+	Object corev1.ObjectReference
+	// Namespace of the event, for the objects which are cluster scoped
+	Namespace string
+	// GenerateName is the prefix of the name of the event, the name of the object by default
+	GenerateName string
+	// Owner is set on the event, so that it is deleted with its owner
+	Owner *metav1.OwnerReference
+
+	Type    string
+	Reason  string
+	Action  string
+	Message string
+
+	ReportingController string
+	ReportingInstance   string
+}
+
+// Events emits the events of the operator. A repeated event, with the same reason and message on
+// the same object, increments the count of the existing event, and the events above the burst are
+// dropped. A zero burst emits all the events.
+type Events struct {
+	kubeClientSet kubernetes.Interface
+	burst         int
+
+	// mutex guards the count of the emitted events, as the namespaces are reconciled concurrently
+	mutex   sync.Mutex
+	emitted int
+}
+
+func NewEvents(kubeClientSet kubernetes.Interface, burst int) *Events {
+	return &Events{kubeClientSet: kubeClientSet, burst: burst}
+}
+
+// EventsConfig returns the burst and the TTL of the events of the operator configured through the
+// TektonConfig params, the invalid values are replaced by the defaults
+func EventsConfig(ctx context.Context, tc *v1alpha1.TektonConfig) (int, time.Duration) {
+	logger := logging.FromContext(ctx)
+	burst, ttl := DefaultOperatorEventBurst, DefaultOperatorEventTTL
+	if tc == nil {
+		return burst, ttl
+	}
+	for _, v := range tc.Spec.Params {
+		switch v.Name {
+		case v1alpha1.OperatorEventBurstParam:
+			value, err := strconv.Atoi(v.Value)
+			if err != nil || value <= 0 {
+				logger.Warnf("invalid value %q for param %s, using default %d", v.Value, v.Name, DefaultOperatorEventBurst)
+				continue
+			}
+			burst = value
+		case v1alpha1.OperatorEventTTLParam:
+			value, err := time.ParseDuration(v.Value)
+			if err != nil || value <= 0 {
+				logger.Warnf("invalid value %q for param %s, using default %s", v.Value, v.Name, DefaultOperatorEventTTL)
+				continue
+			}
+			ttl = value
+		}
+	}
+	return burst, ttl
+}
+
+// Emit creates the event, or increments the count of the existing event with the same reason and
+// message on the same object
+func (e *Events) Emit(ctx context.Context, event Event) error {
+	logger := logging.FromContext(ctx)
+	namespace := event.Namespace
+	if namespace == "" {
+		namespace = event.Object.Namespace
+	}
+	e.mutex.Lock()
+	if e.burst > 0 && e.emitted >= e.burst {
+		e.mutex.Unlock()
+		logger.Debugf("event %s in namespace %s dropped, %d events were emitted", event.Reason, namespace, e.burst)
+		return nil
+	}
+	e.emitted++
+	e.mutex.Unlock()
+
+	client := e.kubeClientSet.CoreV1().Events(namespace)
+	existing, err := client.List(ctx, metav1.ListOptions{
+		LabelSelector: labels.Set{OperatorEventLabel: event.Reason}.String(),
+	})
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	for _, item := range existing.Items {
+		if item.Message != event.Message || item.InvolvedObject.Kind != event.Object.Kind || item.InvolvedObject.Name != event.Object.Name {
+			continue
+		}
+		item := item.DeepCopy()
+		item.Count++
+		item.LastTimestamp = metav1.NewTime(now)
+		logger.Debugf("event %s/%s occurred %d times", item.Namespace, item.Name, item.Count)
+		_, err := client.Update(ctx, item, metav1.UpdateOptions{})
+		return err
+	}
+
+	generateName := event.GenerateName
+	if generateName == "" {
+		generateName = event.Object.Name + "-"
+	}
+	created := &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: generateName,
+			Namespace:    namespace,
+			Labels:       map[string]string{OperatorEventLabel: event.Reason},
+		},
+		InvolvedObject:      event.Object,
+		Type:                event.Type,
+		Reason:              event.Reason,
+		Action:              event.Action,
+		Message:             event.Message,
+		Source:              corev1.EventSource{Component: event.ReportingController},
+		ReportingController: event.ReportingController,
+		ReportingInstance:   event.ReportingInstance,
+		EventTime:           metav1.NewMicroTime(now),
+		FirstTimestamp:      metav1.NewTime(now),
+		LastTimestamp:       metav1.NewTime(now),
+		Count:               1,
+	}
+	if event.Owner != nil {
+		created.OwnerReferences = []metav1.OwnerReference{*event.Owner}
+	}
+	_, err = client.Create(ctx, created, metav1.CreateOptions{})
+	return err
+}
+
+// CleanupStaleEvents deletes the events of the operator which did not occur within their TTL
+func CleanupStaleEvents(ctx context.Context, kubeClientSet kubernetes.Interface, ttl time.Duration) error {
+	logger := logging.FromContext(ctx)
+
+	events, err := kubeClientSet.CoreV1().Events(metav1.NamespaceAll).List(ctx, metav1.ListOptions{LabelSelector: OperatorEventLabel})
+	if err != nil {
+		return fmt.Errorf("failed to list the events of the operator: %w", err)
+	}
+	for _, e := range events.Items {
+		last := e.LastTimestamp.Time
+		if last.IsZero() {
+			last = e.EventTime.Time
+		}
+		if time.Since(last) < ttl {
+			continue
+		}
+		logger.Infof("deleting event %s/%s, it last occurred at %s", e.Namespace, e.Name, last.Format(time.RFC3339))
+		if err := kubeClientSet.CoreV1().Events(e.Namespace).Delete(ctx, e.Name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
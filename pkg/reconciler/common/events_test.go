@@ -0,0 +1,142 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func eventsTestClient() *kubefake.Clientset {
+	kubeClient := kubefake.NewSimpleClientset()
+	// the fake clientset does not generate the names
+	generated := 0
+	kubeClient.PrependReactor("create", "events", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		event := action.(k8stesting.CreateAction).GetObject().(*corev1.Event)
+		generated++
+		event.Name = fmt.Sprintf("%s%d", event.GenerateName, generated)
+		return false, nil, nil
+	})
+	return kubeClient
+}
+
+func TestEventsEmit(t *testing.T) {
+	ctx := context.Background()
+	kubeClient := eventsTestClient()
+	events := NewEvents(kubeClient, 3)
+	owner := metav1.OwnerReference{APIVersion: "operator.tekton.dev/v1alpha1", Kind: "TektonInstallerSet", Name: "rbac"}
+	event := func(name, message string) Event {
+		return Event{
+			Object:              corev1.ObjectReference{Kind: "Namespace", APIVersion: "v1", Name: name, Namespace: name},
+			Owner:               &owner,
+			Type:                corev1.EventTypeWarning,
+			Reason:              "RequestedSCCNotFound",
+			Message:             message,
+			ReportingController: "openshift-pipelines-operator",
+		}
+	}
+
+	// the repeated event increments the count of the existing event
+	assert.NilError(t, events.Emit(ctx, event("foo", "not found")))
+	assert.NilError(t, events.Emit(ctx, event("foo", "not found")))
+	// an event with another message is a new event
+	assert.NilError(t, events.Emit(ctx, event("foo", "still not found")))
+	// the events above the burst are dropped
+	assert.NilError(t, events.Emit(ctx, event("bar", "not found")))
+
+	list, err := kubeClient.CoreV1().Events("foo").List(ctx, metav1.ListOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, len(list.Items), 2)
+	counts := map[string]int32{}
+	for _, e := range list.Items {
+		counts[e.Message] = e.Count
+		assert.Equal(t, e.Labels[OperatorEventLabel], "RequestedSCCNotFound")
+		assert.Equal(t, e.Source.Component, "openshift-pipelines-operator")
+		assert.DeepEqual(t, e.OwnerReferences, []metav1.OwnerReference{owner})
+	}
+	assert.DeepEqual(t, counts, map[string]int32{"not found": 2, "still not found": 1})
+	assert.Equal(t, list.Items[0].GenerateName, "foo-")
+
+	list, err = kubeClient.CoreV1().Events("bar").List(ctx, metav1.ListOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, len(list.Items), 0)
+}
+
+func TestEventsEmitNamespace(t *testing.T) {
+	ctx := context.Background()
+	kubeClient := eventsTestClient()
+	// a zero burst emits all the events
+	events := NewEvents(kubeClient, 0)
+	for i := 0; i < DefaultOperatorEventBurst+1; i++ {
+		assert.NilError(t, events.Emit(ctx, Event{
+			Object:       corev1.ObjectReference{Kind: v1alpha1.KindTektonConfig, Name: fmt.Sprintf("config-%d", i)},
+			Namespace:    metav1.NamespaceDefault,
+			GenerateName: "config-",
+			Type:         corev1.EventTypeWarning,
+			Reason:       "DeletionBlocked",
+			Message:      "blocked",
+		}))
+	}
+	list, err := kubeClient.CoreV1().Events(metav1.NamespaceDefault).List(ctx, metav1.ListOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, len(list.Items), DefaultOperatorEventBurst+1)
+	assert.Equal(t, list.Items[0].GenerateName, "config-")
+	assert.Assert(t, list.Items[0].OwnerReferences == nil)
+}
+
+func TestEventsConfig(t *testing.T) {
+	ctx := context.Background()
+	burst, ttl := EventsConfig(ctx, nil)
+	assert.Equal(t, burst, DefaultOperatorEventBurst)
+	assert.Equal(t, ttl, DefaultOperatorEventTTL)
+
+	burst, ttl = EventsConfig(ctx, &v1alpha1.TektonConfig{Spec: v1alpha1.TektonConfigSpec{Params: []v1alpha1.Param{
+		{Name: v1alpha1.OperatorEventBurstParam, Value: "5"},
+		{Name: v1alpha1.OperatorEventTTLParam, Value: "-1h"},
+	}}})
+	assert.Equal(t, burst, 5)
+	assert.Equal(t, ttl, DefaultOperatorEventTTL)
+}
+
+func TestCleanupStaleEvents(t *testing.T) {
+	ctx := context.Background()
+	stale := metav1.NewTime(time.Now().Add(-2 * time.Hour))
+	kubeClient := kubefake.NewSimpleClientset(
+		&corev1.Event{ObjectMeta: metav1.ObjectMeta{Name: "stale", Namespace: "foo", Labels: map[string]string{OperatorEventLabel: "r"}}, LastTimestamp: stale},
+		&corev1.Event{ObjectMeta: metav1.ObjectMeta{Name: "recent", Namespace: "foo", Labels: map[string]string{OperatorEventLabel: "r"}}, LastTimestamp: metav1.Now()},
+		&corev1.Event{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "foo"}, LastTimestamp: stale},
+	)
+	assert.NilError(t, CleanupStaleEvents(ctx, kubeClient, time.Hour))
+
+	list, err := kubeClient.CoreV1().Events("foo").List(ctx, metav1.ListOptions{})
+	assert.NilError(t, err)
+	var names []string
+	for _, e := range list.Items {
+		names = append(names, e.Name)
+	}
+	assert.DeepEqual(t, names, []string{"other", "recent"})
+}
@@ -31,6 +31,12 @@ type Extension interface {
 	Finalize(context.Context, v1alpha1.TektonComponent) error
 }
 
+// NamespaceObserver is implemented by the extensions of TektonConfig reconciling the resources of
+// the namespaces, they are notified of the events of the namespaces before TektonConfig is enqueued
+type NamespaceObserver interface {
+	NamespaceChanged(obj interface{})
+}
+
 // ExtensionGenerator creates an Extension from a Context
 type ExtensionGenerator func(context.Context) Extension
 
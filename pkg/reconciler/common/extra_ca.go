@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+)
+
+// CABundleOptedOut returns true when the namespace opts out of the CA bundle ConfigMaps
+func CABundleOptedOut(ns *corev1.Namespace) bool {
+	return ns.Annotations[v1alpha1.NamespaceCABundleOptOutAnnotation] == "true"
+}
+
+// ExtraCertificates returns the certificates of the ConfigMap of the namespace named by its
+// operator.tekton.dev/extra-ca-configmap annotation, the values of all its keys in the order
+// of the keys. It returns an empty string when the namespace does not have the annotation.
+func ExtraCertificates(ctx context.Context, configMaps corev1client.ConfigMapsGetter, ns *corev1.Namespace) (string, error) {
+	name := ns.Annotations[v1alpha1.NamespaceExtraCAAnnotation]
+	if name == "" {
+		return "", nil
+	}
+	cm, err := configMaps.ConfigMaps(ns.Name).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return "", fmt.Errorf("failed to get the extra CA ConfigMap %s/%s: %w", ns.Name, name, err)
+	}
+	keys := make([]string, 0, len(cm.Data))
+	for key := range cm.Data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var certificates string
+	for _, key := range keys {
+		certificates = AppendCertificates(certificates, cm.Data[key])
+	}
+	return certificates, nil
+}
+
+// AdditionalTrustCertificates returns the PEM encoded certificates of the
+// operator.tekton.dev/additional-trust annotation of the namespace. It returns an error when the
+// annotation holds anything else than certificates, and an empty string when the namespace does
+// not have the annotation.
+func AdditionalTrustCertificates(ns *corev1.Namespace) (string, error) {
+	certificates, err := PEMCertificates(ns.Annotations[v1alpha1.NamespaceAdditionalTrustAnnotation])
+	if err != nil {
+		return "", fmt.Errorf("invalid annotation %s of namespace %s: %w", v1alpha1.NamespaceAdditionalTrustAnnotation, ns.Name, err)
+	}
+	return certificates, nil
+}
+
+// PEMCertificates returns the PEM encoded certificates of data, re-encoded without the text
+// around the blocks. It returns an error when data holds anything else than certificates.
+func PEMCertificates(data string) (string, error) {
+	rest := []byte(data)
+	var certificates []byte
+	for len(bytes.TrimSpace(rest)) > 0 {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return "", fmt.Errorf("not a PEM block")
+		}
+		if block.Type != "CERTIFICATE" {
+			return "", fmt.Errorf("unexpected PEM block %s", block.Type)
+		}
+		if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+			return "", err
+		}
+		certificates = append(certificates, pem.EncodeToMemory(block)...)
+	}
+	return string(certificates), nil
+}
+
+// AppendCertificates appends the PEM encoded extra certificates to the certificates, separated by a new line
+func AppendCertificates(certificates, extra string) string {
+	if extra == "" {
+		return certificates
+	}
+	if certificates != "" && !strings.HasSuffix(certificates, "\n") {
+		certificates += "\n"
+	}
+	certificates += extra
+	if !strings.HasSuffix(certificates, "\n") {
+		certificates += "\n"
+	}
+	return certificates
+}
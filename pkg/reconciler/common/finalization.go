@@ -0,0 +1,146 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	mf "github.com/manifestival/manifestival"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	op "github.com/tektoncd/operator/pkg/client/clientset/versioned/typed/operator/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/logging"
+)
+
+// FinalizationStuckTimeout is the time after which a deleted resource whose finalization still
+// waits on a step is reported as stuck
+const FinalizationStuckTimeout = 10 * time.Minute
+
+// FinalizationStage orders the steps of the finalization of a resource
+type FinalizationStage int
+
+const (
+	// FinalizeComponents deletes the component CRs, and the instances of the CRDs of a component,
+	// while their controllers are still running to finalize them
+	FinalizeComponents FinalizationStage = iota
+	// FinalizePlatform runs the finalization of the extension of the platform
+	FinalizePlatform
+	// FinalizeInstallerSets deletes the installer sets, last as they deploy the controllers
+	// finalizing the resources of the previous stages
+	FinalizeInstallerSets
+)
+
+// FinalizationStep is a step of the finalization of a resource
+type FinalizationStep struct {
+	Name  string
+	Stage FinalizationStage
+	// Optional steps log their errors without blocking the next steps
+	Optional bool
+	// Run deletes the resources of the step, it returns v1alpha1.RECONCILE_AGAIN_ERR until they are gone
+	Run func(context.Context) error
+}
+
+// Finalize runs the steps in the order of their stages, and in the given order within a stage. A
+// step failing or still waiting for its resources to be deleted blocks the next steps, the pending
+// step is reported in the Finalized condition of the status, as stuck once the resource is deleted
+// for longer than FinalizationStuckTimeout.
+func Finalize(ctx context.Context, obj metav1.Object, status apis.ConditionsAccessor, steps ...FinalizationStep) error {
+	logger := logging.FromContext(ctx)
+
+	steps = append([]FinalizationStep(nil), steps...)
+	sort.SliceStable(steps, func(i, j int) bool { return steps[i].Stage < steps[j].Stage })
+	for _, step := range steps {
+		err := step.Run(ctx)
+		if err == nil {
+			continue
+		}
+		if step.Optional {
+			logger.Errorw("failed to finalize, continuing", "step", step.Name, "error", err)
+			continue
+		}
+		markFinalizationPending(ctx, obj, status, step.Name, err)
+		return err
+	}
+	return nil
+}
+
+// markFinalizationPending reports the step the finalization of the resource waits on
+func markFinalizationPending(ctx context.Context, obj metav1.Object, status apis.ConditionsAccessor, step string, err error) {
+	waiting := step
+	if !errors.Is(err, v1alpha1.RECONCILE_AGAIN_ERR) {
+		waiting = fmt.Sprintf("%s: %v", step, err)
+	}
+	condition := apis.Condition{
+		Type:     v1alpha1.Finalized,
+		Status:   corev1.ConditionUnknown,
+		Reason:   "Finalizing",
+		Message:  fmt.Sprintf("waiting for %s", waiting),
+		Severity: apis.ConditionSeverityInfo,
+	}
+	if deleted := obj.GetDeletionTimestamp(); deleted != nil {
+		if pending := time.Since(deleted.Time); pending >= FinalizationStuckTimeout {
+			condition.Status = corev1.ConditionFalse
+			condition.Reason = "FinalizationStuck"
+			condition.Severity = apis.ConditionSeverityWarning
+			condition.Message = fmt.Sprintf("deleted %s ago, waiting for %s", pending.Round(time.Second), waiting)
+			logging.FromContext(ctx).Warnf("the finalization of %s is stuck, %s", obj.GetName(), condition.Message)
+		}
+	}
+	apis.NewLivingConditionSet().Manage(status).SetCondition(condition)
+}
+
+// CRDsFinalizationStep deletes the CRDs of a component first, so that the instances of the CRDs
+// with a finalizer are deleted while the controller of the component is still running
+func CRDsFinalizationStep(manifest mf.Manifest) FinalizationStep {
+	return FinalizationStep{
+		Name:  "CRDs",
+		Stage: FinalizeComponents,
+		Run:   func(context.Context) error { return manifest.Filter(mf.CRDs).Delete() },
+	}
+}
+
+// PlatformFinalizationStep runs the finalization of the extension of the platform, its errors are
+// logged without blocking the deletion
+func PlatformFinalizationStep(extension Extension, comp v1alpha1.TektonComponent) FinalizationStep {
+	return FinalizationStep{
+		Name:     "platform",
+		Stage:    FinalizePlatform,
+		Optional: true,
+		Run:      func(ctx context.Context) error { return extension.Finalize(ctx, comp) },
+	}
+}
+
+// InstallerSetsFinalizationStep deletes the installer sets of a component matching the label selector
+func InstallerSetsFinalizationStep(installerSets op.TektonInstallerSetInterface, ls metav1.LabelSelector) FinalizationStep {
+	return FinalizationStep{
+		Name:  "installer sets",
+		Stage: FinalizeInstallerSets,
+		Run: func(ctx context.Context) error {
+			labelSelector, err := LabelSelector(ls)
+			if err != nil {
+				return err
+			}
+			return installerSets.DeleteCollection(ctx, metav1.DeleteOptions{}, metav1.ListOptions{LabelSelector: labelSelector})
+		},
+	}
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestFinalize(t *testing.T) {
+	var ran []string
+	step := func(name string, stage FinalizationStage, err error) FinalizationStep {
+		return FinalizationStep{Name: name, Stage: stage, Run: func(context.Context) error {
+			ran = append(ran, name)
+			return err
+		}}
+	}
+	tp := &v1alpha1.TektonPipeline{ObjectMeta: metav1.ObjectMeta{Name: "pipeline"}}
+
+	optional := step("platform", FinalizePlatform, errors.New("failed"))
+	optional.Optional = true
+	err := Finalize(context.Background(), tp, &tp.Status,
+		step("main installer set", FinalizeInstallerSets, nil),
+		optional,
+		step("CRDs", FinalizeComponents, nil),
+	)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, ran, []string{"CRDs", "platform", "main installer set"})
+	assert.Assert(t, tp.Status.GetCondition(v1alpha1.Finalized) == nil)
+
+	ran = nil
+	err = Finalize(context.Background(), tp, &tp.Status,
+		step("CRDs", FinalizeComponents, v1alpha1.RECONCILE_AGAIN_ERR),
+		step("main installer set", FinalizeInstallerSets, nil),
+	)
+	assert.Equal(t, err, v1alpha1.RECONCILE_AGAIN_ERR)
+	assert.DeepEqual(t, ran, []string{"CRDs"})
+	condition := tp.Status.GetCondition(v1alpha1.Finalized)
+	assert.Equal(t, condition.Status, corev1.ConditionUnknown)
+	assert.Equal(t, condition.Reason, "Finalizing")
+	assert.Equal(t, condition.Message, "waiting for CRDs")
+}
+
+func TestFinalizeStuck(t *testing.T) {
+	deleted := metav1.NewTime(time.Now().Add(-FinalizationStuckTimeout - time.Minute))
+	tp := &v1alpha1.TektonPipeline{ObjectMeta: metav1.ObjectMeta{Name: "pipeline", DeletionTimestamp: &deleted}}
+
+	err := Finalize(context.Background(), tp, &tp.Status, FinalizationStep{
+		Name:  "main installer set",
+		Stage: FinalizeInstallerSets,
+		Run:   func(context.Context) error { return errors.New("forbidden") },
+	})
+	assert.ErrorContains(t, err, "forbidden")
+	condition := tp.Status.GetCondition(v1alpha1.Finalized)
+	assert.Equal(t, condition.Status, corev1.ConditionFalse)
+	assert.Equal(t, condition.Reason, "FinalizationStuck")
+	assert.Assert(t, strings.HasSuffix(condition.Message, "waiting for main installer set: forbidden"), condition.Message)
+}
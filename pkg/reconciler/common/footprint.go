@@ -0,0 +1,102 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"fmt"
+	"strings"
+
+	mf "github.com/manifestival/manifestival"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"knative.dev/pkg/ptr"
+)
+
+const (
+	// MinimalFootprintResyncPeriod is the resync period of the controllers supporting it
+	MinimalFootprintResyncPeriod = "24h"
+)
+
+var (
+	// the resource requests of the containers are lowered to these values
+	minimalFootprintRequests = corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse("10m"),
+		corev1.ResourceMemory: resource.MustParse("64Mi"),
+	}
+)
+
+// MinimalFootprint returns true if the components are tuned for single node and edge clusters
+func MinimalFootprint(config v1alpha1.Config) bool {
+	return config.Footprint == v1alpha1.FootprintMinimal
+}
+
+// applyMinimalFootprint runs a single replica of the Deployment, and lowers the resource
+// requests of its containers, the limits are kept
+func applyMinimalFootprint(d *appsv1.Deployment) {
+	d.Spec.Replicas = ptr.Int32(1)
+	for _, containers := range [][]corev1.Container{d.Spec.Template.Spec.InitContainers, d.Spec.Template.Spec.Containers} {
+		for i := range containers {
+			requests := containers[i].Resources.Requests
+			for name, minimal := range minimalFootprintRequests {
+				if request, ok := requests[name]; ok && request.Cmp(minimal) > 0 {
+					requests[name] = minimal.DeepCopy()
+				}
+			}
+		}
+	}
+}
+
+// AddMinimalFootprintResyncPeriod extends the resync period of a controller with the minimal
+// footprint, the resync period set in the manifest is kept
+func AddMinimalFootprintResyncPeriod(config v1alpha1.Config, deploymentName, containerName string) mf.Transformer {
+	return func(u *unstructured.Unstructured) error {
+		if !MinimalFootprint(config) || u.GetKind() != "Deployment" || u.GetName() != deploymentName {
+			return nil
+		}
+		d := &appsv1.Deployment{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, d); err != nil {
+			return err
+		}
+		for i, c := range d.Spec.Template.Spec.Containers {
+			if c.Name != containerName || hasArg(c.Args, "resync-period") {
+				continue
+			}
+			d.Spec.Template.Spec.Containers[i].Args = append(c.Args, fmt.Sprintf("-resync-period=%s", MinimalFootprintResyncPeriod))
+		}
+		obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(d)
+		if err != nil {
+			return err
+		}
+		u.SetUnstructuredContent(obj)
+		return nil
+	}
+}
+
+// hasArg returns true if the flag is set in the arguments, with one or two dashes
+func hasArg(args []string, flag string) bool {
+	for _, arg := range args {
+		name := strings.SplitN(strings.TrimLeft(arg, "-"), "=", 2)[0]
+		if strings.HasPrefix(arg, "-") && name == flag {
+			return true
+		}
+	}
+	return false
+}
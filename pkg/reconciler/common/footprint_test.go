@@ -0,0 +1,115 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+
+	mf "github.com/manifestival/manifestival"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"gotest.tools/v3/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"knative.dev/pkg/ptr"
+)
+
+func footprintDeployment(t *testing.T, args ...string) *unstructured.Unstructured {
+	t.Helper()
+	d := &appsv1.Deployment{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+		ObjectMeta: metav1.ObjectMeta{Name: "controller"},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.Int32(3),
+			Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{Containers: []corev1.Container{{
+				Name: "controller",
+				Args: args,
+				Resources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("100m"),
+						corev1.ResourceMemory: resource.MustParse("32Mi"),
+					},
+					Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+				},
+			}, {
+				Name: "sidecar",
+			}}}},
+		},
+	}
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(d)
+	assert.NilError(t, err)
+	return &unstructured.Unstructured{Object: obj}
+}
+
+func toDeployment(t *testing.T, u unstructured.Unstructured) *appsv1.Deployment {
+	t.Helper()
+	d := &appsv1.Deployment{}
+	assert.NilError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, d))
+	return d
+}
+
+func TestAddConfigurationMinimalFootprint(t *testing.T) {
+	manifest, err := mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{*footprintDeployment(t)}))
+	assert.NilError(t, err)
+
+	manifest, err = manifest.Transform(AddConfiguration(v1alpha1.Config{Footprint: v1alpha1.FootprintMinimal}))
+	assert.NilError(t, err)
+	d := toDeployment(t, manifest.Resources()[0])
+	assert.Equal(t, *d.Spec.Replicas, int32(1))
+	container := d.Spec.Template.Spec.Containers[0]
+	cpu := container.Resources.Requests[corev1.ResourceCPU]
+	memory := container.Resources.Requests[corev1.ResourceMemory]
+	limit := container.Resources.Limits[corev1.ResourceMemory]
+	assert.Equal(t, cpu.String(), "10m")
+	// the requests are never raised and the limits are kept
+	assert.Equal(t, memory.String(), "32Mi")
+	assert.Equal(t, limit.String(), "1Gi")
+	assert.Equal(t, len(d.Spec.Template.Spec.Containers[1].Resources.Requests), 0)
+
+	// the deployments are not tuned without the minimal footprint
+	manifest, err = mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{*footprintDeployment(t)}))
+	assert.NilError(t, err)
+	manifest, err = manifest.Transform(AddConfiguration(v1alpha1.Config{}))
+	assert.NilError(t, err)
+	assert.Equal(t, *toDeployment(t, manifest.Resources()[0]).Spec.Replicas, int32(3))
+}
+
+func TestAddMinimalFootprintResyncPeriod(t *testing.T) {
+	minimal := v1alpha1.Config{Footprint: v1alpha1.FootprintMinimal}
+	tests := []struct {
+		name   string
+		config v1alpha1.Config
+		args   []string
+		want   []string
+	}{
+		{name: "minimal", config: minimal, args: []string{"-kubeconfig-writer-image", "img"}, want: []string{"-kubeconfig-writer-image", "img", "-resync-period=24h"}},
+		{name: "resync period of the manifest", config: minimal, args: []string{"--resync-period=1h"}, want: []string{"--resync-period=1h"}},
+		{name: "not minimal", config: v1alpha1.Config{}, args: []string{"-threads-per-controller=2"}, want: []string{"-threads-per-controller=2"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := footprintDeployment(t, tt.args...)
+			assert.NilError(t, AddMinimalFootprintResyncPeriod(tt.config, "controller", "controller")(u))
+			d := toDeployment(t, *u)
+			assert.DeepEqual(t, d.Spec.Template.Spec.Containers[0].Args, tt.want)
+			assert.Assert(t, d.Spec.Template.Spec.Containers[1].Args == nil)
+		})
+	}
+}
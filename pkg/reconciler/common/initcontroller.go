@@ -20,6 +20,7 @@ import (
 	"context"
 	"os"
 	"path/filepath"
+	"runtime/debug"
 	"strings"
 
 	mfc "github.com/manifestival/client-go-client"
@@ -52,6 +53,21 @@ func OperatorVersion(ctx context.Context) (string, error) {
 	return operatorVersion, nil
 }
 
+// OperatorCommit returns the VCS revision recorded in the operator binary at build time, it is
+// empty when the binary was built without VCS information
+func OperatorCommit() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return ""
+	}
+	for _, setting := range info.Settings {
+		if setting.Key == "vcs.revision" {
+			return setting.Value
+		}
+	}
+	return ""
+}
+
 func (ctrl Controller) InitController(ctx context.Context, opts PayloadOptions) (mf.Manifest, string) {
 
 	mfclient, err := mfc.NewClient(injection.GetConfig(ctx))
@@ -0,0 +1,318 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync/atomic"
+
+	mf "github.com/manifestival/manifestival"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	utiljson "k8s.io/apimachinery/pkg/util/json"
+	"k8s.io/client-go/kubernetes"
+	"knative.dev/pkg/logging"
+)
+
+const (
+	// ManifestsStorageKey of the controllers ConfigMap selects where the manifests of the
+	// installer sets are stored, inline (default) or configmap
+	ManifestsStorageKey = "installerset-manifests-storage"
+	// ManifestsStorageInline stores the manifests in the installer sets
+	ManifestsStorageInline = "inline"
+	// ManifestsStorageConfigMap stores the manifests in chunked ConfigMaps referenced by the
+	// installer sets
+	ManifestsStorageConfigMap = "configmap"
+
+	// ManifestsChunkLabel is set on the ConfigMaps holding the chunks of the manifests, with the
+	// beginning of the SHA256 of the manifests
+	ManifestsChunkLabel = "operator.tekton.dev/installer-set-manifests"
+
+	manifestsChunkKey = "manifests.gz"
+	// manifestsChunkSize is the maximum size of the gzipped manifests stored in a ConfigMap, it
+	// is below the size limit of the ConfigMaps once encoded in base64
+	manifestsChunkSize = 512 * 1024
+	// manifestsInlineLimit is the size of the manifests below which they are stored inline with
+	// the configmap storage, the small installer sets are not worth the extra objects
+	manifestsInlineLimit = 64 * 1024
+)
+
+var errManifestsStoreNotSet = errors.New("the manifests of the installer set are stored in configmaps, but the manifests store is not set")
+
+// NewManifestsStorageFromMap reads where the manifests of the installer sets are stored from
+// the data of the controllers ConfigMap, inline by default
+func NewManifestsStorageFromMap(data map[string]string) (string, error) {
+	value := strings.TrimSpace(data[ManifestsStorageKey])
+	switch value {
+	case "":
+		return ManifestsStorageInline, nil
+	case ManifestsStorageInline, ManifestsStorageConfigMap:
+		return value, nil
+	}
+	return "", fmt.Errorf("invalid value %q for %s, must be %s or %s", value, ManifestsStorageKey, ManifestsStorageInline, ManifestsStorageConfigMap)
+}
+
+// ManifestsStore stores the manifests of the installer sets in chunked ConfigMaps. The
+// ConfigMaps are named after the SHA256 of the manifests, so the installer sets with the same
+// manifests share them, and they are owned by the installer sets referencing them.
+type ManifestsStore struct {
+	kubeClientSet kubernetes.Interface
+	namespace     string
+	storage       string
+}
+
+// manifestsStore is set once when the operator starts
+var manifestsStore atomic.Pointer[ManifestsStore]
+
+// NewManifestsStore returns a store keeping the ConfigMaps in the namespace, the new manifests
+// are stored in ConfigMaps with the configmap storage and inline otherwise
+func NewManifestsStore(kubeClientSet kubernetes.Interface, namespace, storage string) *ManifestsStore {
+	return &ManifestsStore{kubeClientSet: kubeClientSet, namespace: namespace, storage: storage}
+}
+
+// SetManifestsStore sets the store of the manifests of the installer sets
+func SetManifestsStore(s *ManifestsStore) {
+	manifestsStore.Store(s)
+}
+
+// StoreInstallerSetManifests sets the manifests of the installer set, inline or in ConfigMaps
+// depending on the storage and their size. The installer set is to be adopted by the ConfigMaps
+// once created.
+func StoreInstallerSetManifests(ctx context.Context, set *v1alpha1.TektonInstallerSet, resources []unstructured.Unstructured) error {
+	s := manifestsStore.Load()
+	if s == nil || s.storage != ManifestsStorageConfigMap {
+		set.Spec.Manifests = resources
+		set.Spec.ManifestsRef = nil
+		return nil
+	}
+	ref, err := s.store(ctx, resources)
+	if err != nil {
+		return err
+	}
+	if ref == nil {
+		set.Spec.Manifests = resources
+		set.Spec.ManifestsRef = nil
+		return nil
+	}
+	set.Spec.Manifests = nil
+	set.Spec.ManifestsRef = ref
+	return nil
+}
+
+// InstallerSetManifests returns the manifests of the installer set, the manifests stored in
+// ConfigMaps are verified with their SHA256
+func InstallerSetManifests(ctx context.Context, set *v1alpha1.TektonInstallerSet) (mf.Slice, error) {
+	if len(set.Spec.Manifests) > 0 || set.Spec.ManifestsRef == nil {
+		return set.Spec.Manifests, nil
+	}
+	s := manifestsStore.Load()
+	if s == nil {
+		return nil, errManifestsStoreNotSet
+	}
+	return s.load(ctx, set.Spec.ManifestsRef)
+}
+
+// AdoptInstallerSetManifests sets the installer set as an owner of the ConfigMaps holding its
+// manifests, so that they are deleted with the installer sets referencing them
+func AdoptInstallerSetManifests(ctx context.Context, set *v1alpha1.TektonInstallerSet) error {
+	ref := set.Spec.ManifestsRef
+	if ref == nil || len(set.Spec.Manifests) > 0 {
+		return nil
+	}
+	s := manifestsStore.Load()
+	if s == nil {
+		return errManifestsStoreNotSet
+	}
+	owner := *metav1.NewControllerRef(set, v1alpha1.SchemeGroupVersion.WithKind("TektonInstallerSet"))
+	owner.Controller = nil
+	for _, name := range ref.ConfigMaps {
+		err := Retry(ctx, "adopt installer set manifests", func() error {
+			cm, err := s.kubeClientSet.CoreV1().ConfigMaps(ref.Namespace).Get(ctx, name, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			for _, o := range cm.OwnerReferences {
+				if o.UID == owner.UID {
+					return nil
+				}
+			}
+			cm.OwnerReferences = append(cm.OwnerReferences, owner)
+			_, err = s.kubeClientSet.CoreV1().ConfigMaps(ref.Namespace).Update(ctx, cm, metav1.UpdateOptions{})
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("failed to adopt the configmap %s/%s of the manifests: %w", ref.Namespace, name, err)
+		}
+	}
+	return nil
+}
+
+// ReleaseInstallerSetManifests removes the installer set from the owners of the ConfigMaps of the
+// previous manifests which it no longer references, the ConfigMaps without owners are deleted
+func ReleaseInstallerSetManifests(ctx context.Context, set *v1alpha1.TektonInstallerSet, previous *v1alpha1.ManifestsReference) error {
+	if previous == nil {
+		return nil
+	}
+	s := manifestsStore.Load()
+	if s == nil {
+		return errManifestsStoreNotSet
+	}
+	referenced := map[string]bool{}
+	if ref := set.Spec.ManifestsRef; ref != nil && len(set.Spec.Manifests) == 0 {
+		for _, name := range ref.ConfigMaps {
+			referenced[ref.Namespace+"/"+name] = true
+		}
+	}
+	for _, name := range previous.ConfigMaps {
+		if referenced[previous.Namespace+"/"+name] {
+			continue
+		}
+		err := Retry(ctx, "release installer set manifests", func() error {
+			cm, err := s.kubeClientSet.CoreV1().ConfigMaps(previous.Namespace).Get(ctx, name, metav1.GetOptions{})
+			if apierrors.IsNotFound(err) {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			owners := make([]metav1.OwnerReference, 0, len(cm.OwnerReferences))
+			for _, o := range cm.OwnerReferences {
+				if o.UID != set.UID {
+					owners = append(owners, o)
+				}
+			}
+			if len(owners) == 0 {
+				err := s.kubeClientSet.CoreV1().ConfigMaps(previous.Namespace).Delete(ctx, name, metav1.DeleteOptions{
+					Preconditions: &metav1.Preconditions{ResourceVersion: &cm.ResourceVersion},
+				})
+				if apierrors.IsNotFound(err) {
+					return nil
+				}
+				return err
+			}
+			if len(owners) == len(cm.OwnerReferences) {
+				return nil
+			}
+			cm.OwnerReferences = owners
+			_, err = s.kubeClientSet.CoreV1().ConfigMaps(previous.Namespace).Update(ctx, cm, metav1.UpdateOptions{})
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("failed to release the configmap %s/%s of the manifests: %w", previous.Namespace, name, err)
+		}
+	}
+	return nil
+}
+
+// store writes the gzipped manifests in chunks to the ConfigMaps named after their SHA256, the
+// existing ConfigMaps hold the same manifests and are kept. Nothing is written for the manifests
+// below the inline limit.
+func (s *ManifestsStore) store(ctx context.Context, resources []unstructured.Unstructured) (*v1alpha1.ManifestsReference, error) {
+	objects := make([]map[string]interface{}, 0, len(resources))
+	for _, u := range resources {
+		objects = append(objects, u.Object)
+	}
+	data, err := json.Marshal(objects)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < manifestsInlineLimit {
+		return nil, nil
+	}
+	sum := sha256.Sum256(data)
+	ref := &v1alpha1.ManifestsReference{Namespace: s.namespace, SHA256: hex.EncodeToString(sum[:])}
+
+	var compressed bytes.Buffer
+	zw := gzip.NewWriter(&compressed)
+	if _, err := zw.Write(data); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	chunks := compressed.Bytes()
+	for i := 0; i == 0 || len(chunks) > 0; i++ {
+		n := min(len(chunks), manifestsChunkSize)
+		cm := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      fmt.Sprintf("tis-manifests-%s-%d", ref.SHA256[:16], i),
+				Namespace: s.namespace,
+				Labels:    map[string]string{ManifestsChunkLabel: ref.SHA256[:16]},
+			},
+			BinaryData: map[string][]byte{manifestsChunkKey: chunks[:n]},
+		}
+		chunks = chunks[n:]
+		err := Retry(ctx, "store installer set manifests", func() error {
+			_, err := s.kubeClientSet.CoreV1().ConfigMaps(s.namespace).Create(ctx, cm, metav1.CreateOptions{})
+			if apierrors.IsAlreadyExists(err) {
+				return nil
+			}
+			return err
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to store the manifests in the configmap %s/%s: %w", s.namespace, cm.Name, err)
+		}
+		ref.ConfigMaps = append(ref.ConfigMaps, cm.Name)
+	}
+	logging.FromContext(ctx).Debugf("stored %d resources in %d configmaps, %d bytes gzipped to %d", len(resources), len(ref.ConfigMaps), len(data), compressed.Len())
+	return ref, nil
+}
+
+// load reads the manifests from the chunks of the ConfigMaps and verifies their SHA256
+func (s *ManifestsStore) load(ctx context.Context, ref *v1alpha1.ManifestsReference) (mf.Slice, error) {
+	var compressed bytes.Buffer
+	for _, name := range ref.ConfigMaps {
+		cm, err := s.kubeClientSet.CoreV1().ConfigMaps(ref.Namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the configmap %s/%s of the manifests: %w", ref.Namespace, name, err)
+		}
+		compressed.Write(cm.BinaryData[manifestsChunkKey])
+	}
+	zr, err := gzip.NewReader(&compressed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the manifests of configmaps %s: %w", strings.Join(ref.ConfigMaps, ", "), err)
+	}
+	data, err := io.ReadAll(zr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the manifests of configmaps %s: %w", strings.Join(ref.ConfigMaps, ", "), err)
+	}
+	if sum := sha256.Sum256(data); hex.EncodeToString(sum[:]) != ref.SHA256 {
+		return nil, fmt.Errorf("the manifests of configmaps %s do not match their SHA256 %s", strings.Join(ref.ConfigMaps, ", "), ref.SHA256)
+	}
+	// the numbers are decoded as int64 as in the manifests read from the installer sets
+	var objects []map[string]interface{}
+	if err := utiljson.Unmarshal(data, &objects); err != nil {
+		return nil, err
+	}
+	resources := make(mf.Slice, 0, len(objects))
+	for _, o := range objects {
+		resources = append(resources, unstructured.Unstructured{Object: o})
+	}
+	return resources, nil
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"gotest.tools/v3/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+// largeManifests returns ConfigMaps with random data, the gzipped manifests take two chunks
+func largeManifests(t *testing.T) []unstructured.Unstructured {
+	var resources []unstructured.Unstructured
+	for i := 0; i < 3; i++ {
+		data := make([]byte, 200*1024)
+		_, err := rand.Read(data)
+		assert.NilError(t, err)
+		resources = append(resources, unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata":   map[string]interface{}{"name": fmt.Sprintf("config-%d", i), "namespace": "tekton-pipelines"},
+			"data":       map[string]interface{}{"data": hex.EncodeToString(data), "replicas": int64(i)},
+		}})
+	}
+	return resources
+}
+
+func TestNewManifestsStorageFromMap(t *testing.T) {
+	storage, err := NewManifestsStorageFromMap(map[string]string{})
+	assert.NilError(t, err)
+	assert.Equal(t, storage, ManifestsStorageInline)
+
+	storage, err = NewManifestsStorageFromMap(map[string]string{ManifestsStorageKey: "configmap"})
+	assert.NilError(t, err)
+	assert.Equal(t, storage, ManifestsStorageConfigMap)
+
+	_, err = NewManifestsStorageFromMap(map[string]string{ManifestsStorageKey: "oci"})
+	assert.ErrorContains(t, err, "invalid value")
+}
+
+func TestInstallerSetManifestsInline(t *testing.T) {
+	ctx := context.TODO()
+	SetManifestsStore(NewManifestsStore(fake.NewSimpleClientset(), "tekton-operator", ManifestsStorageInline))
+	defer SetManifestsStore(nil)
+
+	resources := largeManifests(t)
+	set := &v1alpha1.TektonInstallerSet{}
+	assert.NilError(t, StoreInstallerSetManifests(ctx, set, resources))
+	assert.Assert(t, set.Spec.ManifestsRef == nil)
+	assert.Equal(t, len(set.Spec.Manifests), 3)
+
+	// the small manifests are kept inline with the configmap storage
+	SetManifestsStore(NewManifestsStore(fake.NewSimpleClientset(), "tekton-operator", ManifestsStorageConfigMap))
+	assert.NilError(t, StoreInstallerSetManifests(ctx, set, resources[:0]))
+	assert.Assert(t, set.Spec.ManifestsRef == nil)
+}
+
+func TestInstallerSetManifestsConfigMap(t *testing.T) {
+	ctx := context.TODO()
+	kube := fake.NewSimpleClientset()
+	SetManifestsStore(NewManifestsStore(kube, "tekton-operator", ManifestsStorageConfigMap))
+	defer SetManifestsStore(nil)
+
+	resources := largeManifests(t)
+	set := &v1alpha1.TektonInstallerSet{ObjectMeta: metav1.ObjectMeta{Name: "pipeline-main-static", UID: types.UID("set-1")}}
+	assert.NilError(t, StoreInstallerSetManifests(ctx, set, resources))
+	assert.Assert(t, len(set.Spec.Manifests) == 0)
+	ref := set.Spec.ManifestsRef
+	assert.Assert(t, ref != nil)
+	assert.Equal(t, len(ref.ConfigMaps), 2)
+	sum, err := ManifestsSHA256(resources)
+	assert.NilError(t, err)
+	assert.Equal(t, ref.SHA256, sum)
+
+	loaded, err := InstallerSetManifests(ctx, set)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []unstructured.Unstructured(loaded), resources)
+
+	// the installer sets with the same manifests share the ConfigMaps
+	other := &v1alpha1.TektonInstallerSet{ObjectMeta: metav1.ObjectMeta{Name: "pipeline-main-static-2", UID: types.UID("set-2")}}
+	assert.NilError(t, StoreInstallerSetManifests(ctx, other, resources))
+	assert.DeepEqual(t, other.Spec.ManifestsRef, ref)
+	assert.NilError(t, AdoptInstallerSetManifests(ctx, set))
+	assert.NilError(t, AdoptInstallerSetManifests(ctx, set))
+	assert.NilError(t, AdoptInstallerSetManifests(ctx, other))
+	for _, name := range ref.ConfigMaps {
+		cm, err := kube.CoreV1().ConfigMaps("tekton-operator").Get(ctx, name, metav1.GetOptions{})
+		assert.NilError(t, err)
+		assert.Equal(t, len(cm.OwnerReferences), 2)
+	}
+
+	// the ConfigMaps are released by the updated installer sets, and deleted without owners
+	set.Spec.ManifestsRef = nil
+	set.Spec.Manifests = resources[:1]
+	assert.NilError(t, ReleaseInstallerSetManifests(ctx, set, ref))
+	cm, err := kube.CoreV1().ConfigMaps("tekton-operator").Get(ctx, ref.ConfigMaps[0], metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, len(cm.OwnerReferences), 1)
+	assert.Equal(t, cm.OwnerReferences[0].UID, types.UID("set-2"))
+
+	other.Spec.ManifestsRef = nil
+	assert.NilError(t, ReleaseInstallerSetManifests(ctx, other, ref))
+	_, err = kube.CoreV1().ConfigMaps("tekton-operator").Get(ctx, ref.ConfigMaps[0], metav1.GetOptions{})
+	assert.Assert(t, apierrors.IsNotFound(err))
+}
+
+func TestInstallerSetManifestsIntegrity(t *testing.T) {
+	ctx := context.TODO()
+	kube := fake.NewSimpleClientset()
+	SetManifestsStore(NewManifestsStore(kube, "tekton-operator", ManifestsStorageConfigMap))
+	defer SetManifestsStore(nil)
+
+	set := &v1alpha1.TektonInstallerSet{}
+	assert.NilError(t, StoreInstallerSetManifests(ctx, set, largeManifests(t)))
+
+	tampered := set.DeepCopy()
+	tampered.Spec.ManifestsRef.SHA256 = "0000"
+	_, err := InstallerSetManifests(ctx, tampered)
+	assert.ErrorContains(t, err, "do not match their SHA256")
+
+	assert.NilError(t, kube.CoreV1().ConfigMaps("tekton-operator").Delete(ctx, set.Spec.ManifestsRef.ConfigMaps[1], metav1.DeleteOptions{}))
+	_, err = InstallerSetManifests(ctx, set)
+	assert.ErrorContains(t, err, "failed to get the configmap")
+
+	SetManifestsStore(nil)
+	_, err = InstallerSetManifests(ctx, set)
+	assert.ErrorIs(t, err, errManifestsStoreNotSet)
+}
@@ -0,0 +1,285 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	mf "github.com/manifestival/manifestival"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// PolicySeverity defines how a violation of a manifest policy rule is handled
+type PolicySeverity string
+
+const (
+	// PolicySeverityIgnore disables a rule
+	PolicySeverityIgnore PolicySeverity = "ignore"
+	// PolicySeverityWarning reports violations of a rule
+	PolicySeverityWarning PolicySeverity = "warning"
+	// PolicySeverityError reports violations of a rule and blocks the install of the violating resources
+	PolicySeverityError PolicySeverity = "error"
+
+	// ManifestPolicyKeyPrefix followed by a rule name sets the severity of the rule
+	ManifestPolicyKeyPrefix = "manifest-policy."
+
+	PolicyRuleNoLatestTag    = "no-latest-tag"
+	PolicyRuleResources      = "resources"
+	PolicyRuleRunAsNonRoot   = "run-as-non-root"
+	PolicyRuleSeccompProfile = "seccomp-profile"
+)
+
+// policyRule checks the pod spec of a workload and returns the violations
+type policyRule func(spec *corev1.PodSpec) []string
+
+var builtinPolicyRules = map[string]policyRule{
+	PolicyRuleNoLatestTag:    checkNoLatestTag,
+	PolicyRuleResources:      checkResources,
+	PolicyRuleRunAsNonRoot:   checkRunAsNonRoot,
+	PolicyRuleSeccompProfile: checkSeccompProfile,
+}
+
+// podSpecPaths are the paths of the pod spec in the workloads checked by the policy
+var podSpecPaths = map[string][]string{
+	"Deployment":  {"spec", "template", "spec"},
+	"StatefulSet": {"spec", "template", "spec"},
+	"DaemonSet":   {"spec", "template", "spec"},
+	"ReplicaSet":  {"spec", "template", "spec"},
+	"Job":         {"spec", "template", "spec"},
+	"CronJob":     {"spec", "jobTemplate", "spec", "template", "spec"},
+}
+
+// PolicyViolation is a violation of a manifest policy rule by a resource
+type PolicyViolation struct {
+	Rule      string
+	Severity  PolicySeverity
+	Kind      string
+	Namespace string
+	Name      string
+	Message   string
+}
+
+func (v PolicyViolation) String() string {
+	name := v.Name
+	if v.Namespace != "" {
+		name = v.Namespace + "/" + v.Name
+	}
+	return fmt.Sprintf("%s %s violates %s: %s", v.Kind, name, v.Rule, v.Message)
+}
+
+// ManifestPolicy validates the workloads of the payload against the built-in rules
+type ManifestPolicy struct {
+	severities map[string]PolicySeverity
+}
+
+// NewManifestPolicyFromMap creates a ManifestPolicy from ConfigMap data, rules without
+// an explicit severity report violations as warnings
+func NewManifestPolicyFromMap(data map[string]string) (*ManifestPolicy, error) {
+	p := &ManifestPolicy{severities: map[string]PolicySeverity{}}
+	for rule := range builtinPolicyRules {
+		p.severities[rule] = PolicySeverityWarning
+	}
+	for key, value := range data {
+		if !strings.HasPrefix(key, ManifestPolicyKeyPrefix) {
+			continue
+		}
+		rule := strings.TrimPrefix(key, ManifestPolicyKeyPrefix)
+		if _, ok := builtinPolicyRules[rule]; !ok {
+			return nil, fmt.Errorf("unknown manifest policy rule %q", rule)
+		}
+		switch severity := PolicySeverity(strings.TrimSpace(value)); severity {
+		case PolicySeverityIgnore, PolicySeverityWarning, PolicySeverityError:
+			p.severities[rule] = severity
+		default:
+			return nil, fmt.Errorf("invalid severity %q for manifest policy rule %q, must be one of %s, %s or %s",
+				value, rule, PolicySeverityIgnore, PolicySeverityWarning, PolicySeverityError)
+		}
+	}
+	return p, nil
+}
+
+// Check returns the violations of the rules by the resources
+func (p *ManifestPolicy) Check(resources []unstructured.Unstructured) ([]PolicyViolation, error) {
+	rules := make([]string, 0, len(builtinPolicyRules))
+	for rule := range builtinPolicyRules {
+		if p.severities[rule] != PolicySeverityIgnore {
+			rules = append(rules, rule)
+		}
+	}
+	sort.Strings(rules)
+
+	violations := []PolicyViolation{}
+	for _, u := range resources {
+		path, ok := podSpecPaths[u.GetKind()]
+		if !ok {
+			continue
+		}
+		obj, found, err := unstructured.NestedMap(u.Object, path...)
+		if err != nil || !found {
+			continue
+		}
+		spec := &corev1.PodSpec{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj, spec); err != nil {
+			return nil, fmt.Errorf("failed to read pod spec of %s %s: %w", u.GetKind(), u.GetName(), err)
+		}
+		for _, rule := range rules {
+			for _, msg := range builtinPolicyRules[rule](spec) {
+				violations = append(violations, PolicyViolation{
+					Rule:      rule,
+					Severity:  p.severities[rule],
+					Kind:      u.GetKind(),
+					Namespace: u.GetNamespace(),
+					Name:      u.GetName(),
+					Message:   msg,
+				})
+			}
+		}
+	}
+	return violations, nil
+}
+
+// Enforce returns an error if the resources violate any rule with error severity
+func (p *ManifestPolicy) Enforce(resources []unstructured.Unstructured) error {
+	violations, err := p.Check(resources)
+	if err != nil {
+		return err
+	}
+	blocking := []string{}
+	for _, v := range violations {
+		if v.Severity == PolicySeverityError {
+			blocking = append(blocking, v.String())
+		}
+	}
+	if len(blocking) == 0 {
+		return nil
+	}
+	return fmt.Errorf("manifest policy violations: %s", strings.Join(blocking, "; "))
+}
+
+// LintPayload checks all the manifests of the payload against the policy and
+// logs the violations
+func (p *ManifestPolicy) LintPayload(logger *zap.SugaredLogger) {
+	manifest, err := mf.ManifestFrom(mf.Recursive(ComponentBaseDir()))
+	if err != nil {
+		logger.Errorw("failed to read the payload manifests for policy checks", "error", err)
+		return
+	}
+	violations, err := p.Check(manifest.Resources())
+	if err != nil {
+		logger.Errorw("failed to check the payload manifests", "error", err)
+		return
+	}
+	for _, v := range violations {
+		if v.Severity == PolicySeverityError {
+			logger.Errorf("payload %s, the install of the resource will be blocked", v)
+			continue
+		}
+		logger.Warnf("payload %s", v)
+	}
+	logger.Infof("checked the payload manifests against the manifest policy, found %d violations", len(violations))
+}
+
+var (
+	manifestPolicyMutex sync.RWMutex
+	manifestPolicy      = defaultManifestPolicy()
+)
+
+func defaultManifestPolicy() *ManifestPolicy {
+	p, _ := NewManifestPolicyFromMap(nil)
+	return p
+}
+
+// SetManifestPolicy sets the policy enforced on the install of resources
+func SetManifestPolicy(p *ManifestPolicy) {
+	manifestPolicyMutex.Lock()
+	defer manifestPolicyMutex.Unlock()
+	manifestPolicy = p
+}
+
+// GetManifestPolicy returns the policy enforced on the install of resources, by
+// default no rule blocks an install
+func GetManifestPolicy() *ManifestPolicy {
+	manifestPolicyMutex.RLock()
+	defer manifestPolicyMutex.RUnlock()
+	return manifestPolicy
+}
+
+func allContainers(spec *corev1.PodSpec) []corev1.Container {
+	return append(append([]corev1.Container{}, spec.InitContainers...), spec.Containers...)
+}
+
+func checkNoLatestTag(spec *corev1.PodSpec) []string {
+	violations := []string{}
+	for _, c := range allContainers(spec) {
+		if isLatestImage(c.Image) {
+			violations = append(violations, fmt.Sprintf("container %s uses image %q without a pinned tag or digest", c.Name, c.Image))
+		}
+	}
+	return violations
+}
+
+// isLatestImage returns true if an image refers to the latest tag, either explicitly or implicitly
+func isLatestImage(image string) bool {
+	if image == "" || strings.Contains(image, "@") {
+		return false
+	}
+	name := image[strings.LastIndex(image, "/")+1:]
+	i := strings.LastIndex(name, ":")
+	return i < 0 || name[i+1:] == "latest"
+}
+
+func checkResources(spec *corev1.PodSpec) []string {
+	violations := []string{}
+	for _, c := range spec.Containers {
+		if len(c.Resources.Requests) == 0 && len(c.Resources.Limits) == 0 {
+			violations = append(violations, fmt.Sprintf("container %s has no resource requests or limits", c.Name))
+		}
+	}
+	return violations
+}
+
+func checkRunAsNonRoot(spec *corev1.PodSpec) []string {
+	if spec.SecurityContext != nil && spec.SecurityContext.RunAsNonRoot != nil && *spec.SecurityContext.RunAsNonRoot {
+		return nil
+	}
+	violations := []string{}
+	for _, c := range allContainers(spec) {
+		if c.SecurityContext == nil || c.SecurityContext.RunAsNonRoot == nil || !*c.SecurityContext.RunAsNonRoot {
+			violations = append(violations, fmt.Sprintf("container %s does not set runAsNonRoot", c.Name))
+		}
+	}
+	return violations
+}
+
+func checkSeccompProfile(spec *corev1.PodSpec) []string {
+	if spec.SecurityContext != nil && spec.SecurityContext.SeccompProfile != nil && spec.SecurityContext.SeccompProfile.Type != "" {
+		return nil
+	}
+	violations := []string{}
+	for _, c := range allContainers(spec) {
+		if c.SecurityContext == nil || c.SecurityContext.SeccompProfile == nil || c.SecurityContext.SeccompProfile.Type == "" {
+			violations = append(violations, fmt.Sprintf("container %s does not set a seccompProfile", c.Name))
+		}
+	}
+	return violations
+}
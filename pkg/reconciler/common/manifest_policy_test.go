@@ -0,0 +1,144 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"knative.dev/pkg/ptr"
+)
+
+func toUnstructuredObject(t *testing.T, obj runtime.Object, kind string) unstructured.Unstructured {
+	t.Helper()
+	m, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	assert.NilError(t, err)
+	u := unstructured.Unstructured{Object: m}
+	u.SetKind(kind)
+	return u
+}
+
+func compliantPodSpec() corev1.PodSpec {
+	return corev1.PodSpec{
+		SecurityContext: &corev1.PodSecurityContext{
+			RunAsNonRoot:   ptr.Bool(true),
+			SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+		},
+		Containers: []corev1.Container{{
+			Name:  "controller",
+			Image: "ghcr.io/tektoncd/pipeline/controller:v1.0.0",
+			Resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")},
+			},
+		}},
+	}
+}
+
+func TestNewManifestPolicyFromMap(t *testing.T) {
+	p, err := NewManifestPolicyFromMap(map[string]string{
+		"manifest-policy.no-latest-tag": "error",
+		"manifest-policy.resources":     "ignore",
+		"workers":                       "4",
+	})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, p.severities, map[string]PolicySeverity{
+		PolicyRuleNoLatestTag:    PolicySeverityError,
+		PolicyRuleResources:      PolicySeverityIgnore,
+		PolicyRuleRunAsNonRoot:   PolicySeverityWarning,
+		PolicyRuleSeccompProfile: PolicySeverityWarning,
+	})
+
+	_, err = NewManifestPolicyFromMap(map[string]string{"manifest-policy.no-root": "error"})
+	assert.ErrorContains(t, err, "unknown manifest policy rule \"no-root\"")
+	_, err = NewManifestPolicyFromMap(map[string]string{"manifest-policy.resources": "fatal"})
+	assert.ErrorContains(t, err, "invalid severity \"fatal\"")
+}
+
+func TestManifestPolicyCheck(t *testing.T) {
+	compliant := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "compliant", Namespace: "tekton-pipelines"},
+		Spec:       appsv1.DeploymentSpec{Template: corev1.PodTemplateSpec{Spec: compliantPodSpec()}},
+	}
+
+	violating := compliantPodSpec()
+	violating.SecurityContext = nil
+	violating.Containers[0].Image = "registry:5000/tektoncd/pruner"
+	violating.Containers[0].Resources = corev1.ResourceRequirements{}
+	violating.Containers[0].SecurityContext = &corev1.SecurityContext{
+		RunAsNonRoot:   ptr.Bool(true),
+		SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+	}
+	violating.InitContainers = []corev1.Container{{Name: "init", Image: "busybox:latest"}}
+	cronJob := &batchv1.CronJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "pruner", Namespace: "tekton-pipelines"},
+		Spec: batchv1.CronJobSpec{JobTemplate: batchv1.JobTemplateSpec{Spec: batchv1.JobSpec{
+			Template: corev1.PodTemplateSpec{Spec: violating},
+		}}},
+	}
+	resources := []unstructured.Unstructured{
+		toUnstructuredObject(t, compliant, "Deployment"),
+		toUnstructuredObject(t, cronJob, "CronJob"),
+		toUnstructuredObject(t, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "config"}}, "ConfigMap"),
+	}
+
+	p, err := NewManifestPolicyFromMap(map[string]string{"manifest-policy.resources": "ignore"})
+	assert.NilError(t, err)
+	violations, err := p.Check(resources)
+	assert.NilError(t, err)
+	messages := []string{}
+	for _, v := range violations {
+		messages = append(messages, v.String())
+	}
+	assert.DeepEqual(t, messages, []string{
+		"CronJob tekton-pipelines/pruner violates no-latest-tag: container init uses image \"busybox:latest\" without a pinned tag or digest",
+		"CronJob tekton-pipelines/pruner violates no-latest-tag: container controller uses image \"registry:5000/tektoncd/pruner\" without a pinned tag or digest",
+		"CronJob tekton-pipelines/pruner violates run-as-non-root: container init does not set runAsNonRoot",
+		"CronJob tekton-pipelines/pruner violates seccomp-profile: container init does not set a seccompProfile",
+	})
+
+	// only rules with error severity block the install
+	assert.NilError(t, p.Enforce(resources))
+	p, err = NewManifestPolicyFromMap(map[string]string{"manifest-policy.run-as-non-root": "error"})
+	assert.NilError(t, err)
+	assert.Error(t, p.Enforce(resources), "manifest policy violations: CronJob tekton-pipelines/pruner violates run-as-non-root: container init does not set runAsNonRoot")
+}
+
+func TestIsLatestImage(t *testing.T) {
+	tests := []struct {
+		image string
+		want  bool
+	}{
+		{image: "busybox", want: true},
+		{image: "busybox:latest", want: true},
+		{image: "registry:5000/busybox", want: true},
+		{image: "registry:5000/busybox:1.36", want: false},
+		{image: "ghcr.io/tektoncd/pipeline/controller@sha256:abcdef", want: false},
+		{image: "ghcr.io/tektoncd/pipeline/controller:latest@sha256:abcdef", want: false},
+	}
+	for _, test := range tests {
+		t.Run(test.image, func(t *testing.T) {
+			assert.Equal(t, isLatestImage(test.image), test.want)
+		})
+	}
+}
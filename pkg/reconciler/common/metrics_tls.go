@@ -0,0 +1,351 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	mf "github.com/manifestival/manifestival"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes"
+	"knative.dev/pkg/logging"
+	certresources "knative.dev/pkg/webhook/certificates/resources"
+)
+
+const (
+	// MetricsTLSProxyImageEnvKey is the environment variable of the image of the TLS proxies
+	MetricsTLSProxyImageEnvKey = "IMAGE_METRICS_TLS_PROXY"
+	// MetricsTLSSecretAnnotation is set on the Services serving their metrics over TLS, to the
+	// name of the Secret holding their serving certificate
+	MetricsTLSSecretAnnotation = "operator.tekton.dev/metrics-tls-secret"
+	// MetricsTLSPortName is the name of the TLS metrics port of the Services
+	MetricsTLSPortName = "https-metrics"
+	// ProfilingTLSPortName is the name of the TLS profiling port of the Services
+	ProfilingTLSPortName = "https-profiling"
+
+	metricsPortName        = "http-metrics"
+	profilingPortName      = "http-profiling"
+	metricsPort            = 9090
+	profilingPort          = 8008
+	metricsTLSPort         = 9443
+	profilingTLSPort       = 9444
+	metricsTLSVolume       = "metrics-tls"
+	metricsTLSMountPath    = "/etc/tls/private"
+	metricsTLSSecretSuffix = "-metrics-tls"
+	// metricsHostEnvKey binds the metrics server of the knative based components to an address
+	metricsHostEnvKey = "METRICS_PROMETHEUS_HOST"
+	// openShiftServingCertAnnotation requests a serving certificate from the service CA of OpenShift
+	openShiftServingCertAnnotation = "service.beta.openshift.io/serving-cert-secret-name"
+
+	// metricsTLSCertValidity is the validity of the serving certificates generated on Kubernetes,
+	// they are generated again once they expire within metricsTLSCertRenewal
+	metricsTLSCertValidity = 365 * 24 * time.Hour
+	metricsTLSCertRenewal  = 30 * 24 * time.Hour
+)
+
+// MetricsTLSSecretName returns the name of the Secret holding the serving certificate of the
+// metrics of a Service, it is mounted by the workloads selected by the Service
+func MetricsTLSSecretName(serviceName string) string {
+	return serviceName + metricsTLSSecretSuffix
+}
+
+// AddMetricsTLS serves the metrics, and the profiling when enabled, of the workloads of the manifest
+// over TLS when the metrics TLS is enabled. A TLS proxy is added to the Deployments and StatefulSets
+// selected by a Service with a metrics port, the metrics server of the component is bound to the
+// loopback address and the plain text ports of the Service are replaced by TLS ports. On OpenShift
+// the serving certificates are issued by the service CA, on Kubernetes they are generated by
+// ReconcileMetricsTLSSecrets.
+func AddMetricsTLS(manifest mf.Manifest, spec *v1alpha1.MetricsTLS) mf.Transformer {
+	if spec == nil || !spec.Enable {
+		return func(*unstructured.Unstructured) error { return nil }
+	}
+	services := metricsServices(manifest)
+	return func(u *unstructured.Unstructured) error {
+		serviceName, selected := services[u.GetKind()+"/"+u.GetName()]
+		switch u.GetKind() {
+		case "Deployment":
+			if !selected {
+				return nil
+			}
+			d := &appsv1.Deployment{}
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, d); err != nil {
+				return err
+			}
+			if err := addMetricsTLSProxies(spec, serviceName, &d.Spec.Template.Spec); err != nil {
+				return err
+			}
+			return setUnstructured(u, d)
+		case "StatefulSet":
+			if !selected {
+				return nil
+			}
+			sts := &appsv1.StatefulSet{}
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, sts); err != nil {
+				return err
+			}
+			if err := addMetricsTLSProxies(spec, serviceName, &sts.Spec.Template.Spec); err != nil {
+				return err
+			}
+			return setUnstructured(u, sts)
+		case "Service":
+			svc := &corev1.Service{}
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, svc); err != nil {
+				return err
+			}
+			addMetricsTLSPorts(spec, svc)
+			return setUnstructured(u, svc)
+		}
+		return nil
+	}
+}
+
+// metricsServices returns the names of the Services with a metrics port by kind and name of the
+// workloads they select
+func metricsServices(manifest mf.Manifest) map[string]string {
+	var services []*corev1.Service
+	for _, u := range manifest.Filter(mf.ByKind("Service")).Resources() {
+		svc := &corev1.Service{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, svc); err != nil || len(svc.Spec.Selector) == 0 {
+			continue
+		}
+		for _, p := range svc.Spec.Ports {
+			if p.Name == metricsPortName {
+				services = append(services, svc)
+				break
+			}
+		}
+	}
+	result := map[string]string{}
+	for _, u := range manifest.Filter(mf.Any(mf.ByKind("Deployment"), mf.ByKind("StatefulSet"))).Resources() {
+		podLabels, _, _ := unstructured.NestedStringMap(u.Object, "spec", "template", "metadata", "labels")
+		for _, svc := range services {
+			if svc.Namespace == u.GetNamespace() && labels.SelectorFromSet(svc.Spec.Selector).Matches(labels.Set(podLabels)) {
+				result[u.GetKind()+"/"+u.GetName()] = svc.Name
+				break
+			}
+		}
+	}
+	return result
+}
+
+func setUnstructured(u *unstructured.Unstructured, obj interface{}) error {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return err
+	}
+	u.SetUnstructuredContent(content)
+	return nil
+}
+
+func metricsTLSProxyImage(spec *v1alpha1.MetricsTLS) (string, error) {
+	if spec.ProxyImage != "" {
+		return spec.ProxyImage, nil
+	}
+	if image := os.Getenv(MetricsTLSProxyImageEnvKey); image != "" {
+		return image, nil
+	}
+	return "", fmt.Errorf("metrics TLS is enabled but no proxy image is set, '%s' environment variable is not set", MetricsTLSProxyImageEnvKey)
+}
+
+// addMetricsTLSProxies adds the TLS proxies to a pod of which a container exposes the metrics port,
+// they serve the certificate of the Service
+func addMetricsTLSProxies(spec *v1alpha1.MetricsTLS, serviceName string, pod *corev1.PodSpec) error {
+	exposes := func(port int32) bool {
+		for _, c := range pod.Containers {
+			for _, p := range c.Ports {
+				if p.ContainerPort == port {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	if !exposes(metricsPort) || exposes(metricsTLSPort) {
+		return nil
+	}
+	image, err := metricsTLSProxyImage(spec)
+	if err != nil {
+		return err
+	}
+
+	for i := range pod.Containers {
+		c := &pod.Containers[i]
+		for _, p := range c.Ports {
+			if p.ContainerPort == metricsPort {
+				c.Env = append(c.Env, corev1.EnvVar{Name: metricsHostEnvKey, Value: "127.0.0.1"})
+				break
+			}
+		}
+	}
+	pod.Containers = append(pod.Containers, metricsTLSProxy("metrics-tls-proxy", "metrics-tls", image, metricsTLSPort, metricsPort, "/metrics"))
+	if spec.Profiling && exposes(profilingPort) {
+		pod.Containers = append(pod.Containers, metricsTLSProxy("profiling-tls-proxy", "profiling-tls", image, profilingTLSPort, profilingPort, "/debug/pprof/*"))
+	}
+	pod.Volumes = append(pod.Volumes, corev1.Volume{
+		Name: metricsTLSVolume,
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{SecretName: MetricsTLSSecretName(serviceName)},
+		},
+	})
+	return nil
+}
+
+// metricsTLSProxy returns a kube-rbac-proxy container terminating TLS in front of the port of
+// the pod, the paths are proxied without authorization as the plain text ports were
+func metricsTLSProxy(name, portName, image string, listenPort, upstreamPort int32, paths string) corev1.Container {
+	allowPrivilegeEscalation := false
+	return corev1.Container{
+		Name:  name,
+		Image: image,
+		Args: []string{
+			fmt.Sprintf("--secure-listen-address=0.0.0.0:%d", listenPort),
+			fmt.Sprintf("--upstream=http://127.0.0.1:%d/", upstreamPort),
+			"--ignore-paths=" + paths,
+			"--tls-cert-file=" + metricsTLSMountPath + "/tls.crt",
+			"--tls-private-key-file=" + metricsTLSMountPath + "/tls.key",
+		},
+		Ports: []corev1.ContainerPort{{Name: portName, ContainerPort: listenPort, Protocol: corev1.ProtocolTCP}},
+		Resources: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("10m"),
+				corev1.ResourceMemory: resource.MustParse("20Mi"),
+			},
+		},
+		SecurityContext: &corev1.SecurityContext{
+			AllowPrivilegeEscalation: &allowPrivilegeEscalation,
+			Capabilities:             &corev1.Capabilities{Drop: []corev1.Capability{"ALL"}},
+		},
+		VolumeMounts: []corev1.VolumeMount{{Name: metricsTLSVolume, MountPath: metricsTLSMountPath, ReadOnly: true}},
+	}
+}
+
+// addMetricsTLSPorts replaces the plain text metrics and profiling ports of the Service by TLS ports
+func addMetricsTLSPorts(spec *v1alpha1.MetricsTLS, svc *corev1.Service) {
+	ports := make([]corev1.ServicePort, 0, len(svc.Spec.Ports))
+	secured := false
+	for _, p := range svc.Spec.Ports {
+		switch {
+		case p.Name == metricsPortName:
+			p = corev1.ServicePort{Name: MetricsTLSPortName, Port: metricsTLSPort, TargetPort: intstr.FromInt32(metricsTLSPort), Protocol: corev1.ProtocolTCP}
+			secured = true
+		case p.Name == profilingPortName && spec.Profiling:
+			p = corev1.ServicePort{Name: ProfilingTLSPortName, Port: profilingTLSPort, TargetPort: intstr.FromInt32(profilingTLSPort), Protocol: corev1.ProtocolTCP}
+		}
+		ports = append(ports, p)
+	}
+	if !secured {
+		return
+	}
+	svc.Spec.Ports = ports
+	if svc.Annotations == nil {
+		svc.Annotations = map[string]string{}
+	}
+	secretName := MetricsTLSSecretName(svc.Name)
+	svc.Annotations[MetricsTLSSecretAnnotation] = secretName
+	if v1alpha1.IsOpenShiftPlatform() {
+		svc.Annotations[openShiftServingCertAnnotation] = secretName
+	}
+}
+
+// ReconcileMetricsTLSSecrets generates the serving certificates of the Services of the namespace
+// serving their metrics over TLS on Kubernetes, they are generated again when they are about to
+// expire. The certificate of the CA is in the ca.crt key of the Secret. On OpenShift the serving
+// certificates are issued by the service CA.
+func ReconcileMetricsTLSSecrets(ctx context.Context, kubeClient kubernetes.Interface, namespace string) error {
+	if v1alpha1.IsOpenShiftPlatform() {
+		return nil
+	}
+	services, err := kubeClient.CoreV1().Services(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, svc := range services.Items {
+		secretName, ok := svc.Annotations[MetricsTLSSecretAnnotation]
+		if !ok {
+			continue
+		}
+		if err := ensureMetricsTLSSecret(ctx, kubeClient, namespace, svc.Name, secretName); err != nil {
+			errs = append(errs, fmt.Errorf("failed to generate the metrics serving certificate of service %s/%s: %w", namespace, svc.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func ensureMetricsTLSSecret(ctx context.Context, kubeClient kubernetes.Interface, namespace, serviceName, secretName string) error {
+	secrets := kubeClient.CoreV1().Secrets(namespace)
+	existing, err := secrets.Get(ctx, secretName, metav1.GetOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	found := err == nil
+	if found && !metricsTLSCertExpiring(existing.Data[corev1.TLSCertKey]) {
+		return nil
+	}
+
+	serverKey, serverCert, caCert, err := certresources.CreateCerts(ctx, serviceName, namespace, time.Now().Add(metricsTLSCertValidity))
+	if err != nil {
+		return err
+	}
+	data := map[string][]byte{
+		corev1.TLSCertKey:       serverCert,
+		corev1.TLSPrivateKeyKey: serverKey,
+		"ca.crt":                caCert,
+	}
+	if found {
+		logging.FromContext(ctx).Infof("renewing the metrics serving certificate %s/%s", namespace, secretName)
+		existing = existing.DeepCopy()
+		existing.Data = data
+		_, err = secrets.Update(ctx, existing, metav1.UpdateOptions{})
+		return err
+	}
+	logging.FromContext(ctx).Infof("generating the metrics serving certificate %s/%s", namespace, secretName)
+	_, err = secrets.Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: secretName, Namespace: namespace},
+		Type:       corev1.SecretTypeTLS,
+		Data:       data,
+	}, metav1.CreateOptions{})
+	return err
+}
+
+// metricsTLSCertExpiring returns true if the certificate cannot be read or expires within the renewal period
+func metricsTLSCertExpiring(data []byte) bool {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return true
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return true
+	}
+	return time.Until(cert.NotAfter) < metricsTLSCertRenewal
+}
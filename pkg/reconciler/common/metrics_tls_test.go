@@ -0,0 +1,192 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mf "github.com/manifestival/manifestival"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"gotest.tools/v3/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	certresources "knative.dev/pkg/webhook/certificates/resources"
+)
+
+func metricsTLSTestManifest(t *testing.T) mf.Manifest {
+	t.Helper()
+	objects := []runtime.Object{
+		&appsv1.Deployment{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+			ObjectMeta: metav1.ObjectMeta{Name: "tekton-chains-controller", Namespace: "tekton-pipelines"},
+			Spec: appsv1.DeploymentSpec{Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "tekton-chains-controller", "version": "v0.25.0"}},
+				Spec: corev1.PodSpec{Containers: []corev1.Container{{
+					Name:  "controller",
+					Ports: []corev1.ContainerPort{{Name: "metrics", ContainerPort: 9090}, {Name: "profiling", ContainerPort: 8008}},
+				}}},
+			}},
+		},
+		// exposes the metrics port without being selected by a Service
+		&appsv1.Deployment{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+			ObjectMeta: metav1.ObjectMeta{Name: "tekton-events-controller", Namespace: "tekton-pipelines"},
+			Spec: appsv1.DeploymentSpec{Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "tekton-events-controller"}},
+				Spec: corev1.PodSpec{Containers: []corev1.Container{{
+					Name:  "controller",
+					Ports: []corev1.ContainerPort{{Name: "metrics", ContainerPort: 9090}},
+				}}},
+			}},
+		},
+		&corev1.Service{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Service"},
+			ObjectMeta: metav1.ObjectMeta{Name: "tekton-chains-metrics", Namespace: "tekton-pipelines"},
+			Spec: corev1.ServiceSpec{
+				Selector: map[string]string{"app": "tekton-chains-controller"},
+				Ports: []corev1.ServicePort{
+					{Name: "http-metrics", Port: 9090},
+					{Name: "http-profiling", Port: 8008},
+				},
+			},
+		},
+	}
+	var resources []unstructured.Unstructured
+	for _, obj := range objects {
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		assert.NilError(t, err)
+		resources = append(resources, unstructured.Unstructured{Object: content})
+	}
+	manifest, err := mf.ManifestFrom(mf.Slice(resources))
+	assert.NilError(t, err)
+	return manifest
+}
+
+func TestAddMetricsTLS(t *testing.T) {
+	manifest := metricsTLSTestManifest(t)
+	spec := &v1alpha1.MetricsTLS{Enable: true, Profiling: true, ProxyImage: "kube-rbac-proxy"}
+	transformed, err := manifest.Transform(AddMetricsTLS(manifest, spec))
+	assert.NilError(t, err)
+
+	d := &appsv1.Deployment{}
+	u := transformed.Filter(mf.ByName("tekton-chains-controller")).Resources()[0]
+	assert.NilError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, d))
+	pod := d.Spec.Template.Spec
+	assert.Equal(t, len(pod.Containers), 3)
+	assert.DeepEqual(t, pod.Containers[0].Env, []corev1.EnvVar{{Name: metricsHostEnvKey, Value: "127.0.0.1"}})
+	assert.Equal(t, pod.Containers[1].Name, "metrics-tls-proxy")
+	assert.Equal(t, pod.Containers[1].Image, "kube-rbac-proxy")
+	assert.Equal(t, pod.Containers[1].Args[1], "--upstream=http://127.0.0.1:9090/")
+	assert.Equal(t, pod.Containers[2].Name, "profiling-tls-proxy")
+	assert.Equal(t, pod.Containers[2].Args[1], "--upstream=http://127.0.0.1:8008/")
+	// the workload mounts the certificate of the Service selecting it
+	assert.Equal(t, pod.Volumes[0].Secret.SecretName, "tekton-chains-metrics-metrics-tls")
+
+	d = &appsv1.Deployment{}
+	u = transformed.Filter(mf.ByName("tekton-events-controller")).Resources()[0]
+	assert.NilError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, d))
+	assert.Equal(t, len(d.Spec.Template.Spec.Containers), 1)
+
+	svc := &corev1.Service{}
+	u = transformed.Filter(mf.ByKind("Service")).Resources()[0]
+	assert.NilError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, svc))
+	assert.Equal(t, len(svc.Spec.Ports), 2)
+	assert.Equal(t, svc.Spec.Ports[0].Name, MetricsTLSPortName)
+	assert.Equal(t, svc.Spec.Ports[0].TargetPort.IntValue(), metricsTLSPort)
+	assert.Equal(t, svc.Spec.Ports[1].Name, ProfilingTLSPortName)
+	assert.Equal(t, svc.Annotations[MetricsTLSSecretAnnotation], "tekton-chains-metrics-metrics-tls")
+	_, ok := svc.Annotations[openShiftServingCertAnnotation]
+	assert.Assert(t, !ok)
+
+	// transforming again does not add the proxies twice
+	again, err := transformed.Transform(AddMetricsTLS(transformed, spec))
+	assert.NilError(t, err)
+	u = again.Filter(mf.ByName("tekton-chains-controller")).Resources()[0]
+	containers, _, _ := unstructured.NestedSlice(u.Object, "spec", "template", "spec", "containers")
+	assert.Equal(t, len(containers), 3)
+}
+
+func TestAddMetricsTLSOpenShift(t *testing.T) {
+	t.Setenv("PLATFORM", "openshift")
+	t.Setenv(MetricsTLSProxyImageEnvKey, "origin-kube-rbac-proxy")
+	manifest := metricsTLSTestManifest(t)
+	transformed, err := manifest.Transform(AddMetricsTLS(manifest, &v1alpha1.MetricsTLS{Enable: true}))
+	assert.NilError(t, err)
+
+	u := transformed.Filter(mf.ByKind("Service")).Resources()[0]
+	assert.Equal(t, u.GetAnnotations()[openShiftServingCertAnnotation], "tekton-chains-metrics-metrics-tls")
+	// the profiling is kept in plain text
+	ports, _, _ := unstructured.NestedSlice(u.Object, "spec", "ports")
+	assert.Equal(t, ports[1].(map[string]interface{})["name"], "http-profiling")
+	u = transformed.Filter(mf.ByName("tekton-chains-controller")).Resources()[0]
+	containers, _, _ := unstructured.NestedSlice(u.Object, "spec", "template", "spec", "containers")
+	assert.Equal(t, len(containers), 2)
+	assert.Equal(t, containers[1].(map[string]interface{})["image"], "origin-kube-rbac-proxy")
+}
+
+func TestAddMetricsTLSWithoutProxyImage(t *testing.T) {
+	t.Setenv(MetricsTLSProxyImageEnvKey, "")
+	manifest := metricsTLSTestManifest(t)
+	_, err := manifest.Transform(AddMetricsTLS(manifest, &v1alpha1.MetricsTLS{Enable: true}))
+	assert.ErrorContains(t, err, "'IMAGE_METRICS_TLS_PROXY' environment variable is not set")
+
+	// nothing is changed when the metrics TLS is disabled
+	transformed, err := manifest.Transform(AddMetricsTLS(manifest, nil))
+	assert.NilError(t, err)
+	assert.DeepEqual(t, transformed.Resources(), manifest.Resources())
+}
+
+func TestReconcileMetricsTLSSecrets(t *testing.T) {
+	ctx := context.TODO()
+	kubeClient := fake.NewSimpleClientset(
+		&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "tekton-chains-metrics", Namespace: "tekton-pipelines",
+			Annotations: map[string]string{MetricsTLSSecretAnnotation: "tekton-chains-metrics-metrics-tls"}}},
+		&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "tekton-pipelines-webhook", Namespace: "tekton-pipelines"}},
+	)
+	assert.NilError(t, ReconcileMetricsTLSSecrets(ctx, kubeClient, "tekton-pipelines"))
+	secret, err := kubeClient.CoreV1().Secrets("tekton-pipelines").Get(ctx, "tekton-chains-metrics-metrics-tls", metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, secret.Type, corev1.SecretTypeTLS)
+	assert.Assert(t, !metricsTLSCertExpiring(secret.Data[corev1.TLSCertKey]))
+	assert.Assert(t, len(secret.Data["ca.crt"]) > 0)
+	secrets, err := kubeClient.CoreV1().Secrets("tekton-pipelines").List(ctx, metav1.ListOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, len(secrets.Items), 1)
+
+	// a valid certificate is kept
+	assert.NilError(t, ReconcileMetricsTLSSecrets(ctx, kubeClient, "tekton-pipelines"))
+	kept, err := kubeClient.CoreV1().Secrets("tekton-pipelines").Get(ctx, "tekton-chains-metrics-metrics-tls", metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, kept.Data, secret.Data)
+
+	// an expiring certificate is renewed
+	key, cert, ca, err := certresources.CreateCerts(ctx, "tekton-chains-metrics", "tekton-pipelines", time.Now().Add(24*time.Hour))
+	assert.NilError(t, err)
+	secret.Data = map[string][]byte{corev1.TLSCertKey: cert, corev1.TLSPrivateKeyKey: key, "ca.crt": ca}
+	_, err = kubeClient.CoreV1().Secrets("tekton-pipelines").Update(ctx, secret, metav1.UpdateOptions{})
+	assert.NilError(t, err)
+	assert.NilError(t, ReconcileMetricsTLSSecrets(ctx, kubeClient, "tekton-pipelines"))
+	renewed, err := kubeClient.CoreV1().Secrets("tekton-pipelines").Get(ctx, "tekton-chains-metrics-metrics-tls", metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Assert(t, !metricsTLSCertExpiring(renewed.Data[corev1.TLSCertKey]))
+}
@@ -0,0 +1,237 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"knative.dev/pkg/logging"
+)
+
+const (
+	// NamespaceDefaultsConfigMapName is the ConfigMap publishing the defaults requested in a namespace
+	NamespaceDefaultsConfigMapName = "tekton-namespace-defaults"
+	// namespaceDefaultsLabel marks the ConfigMaps maintained by the operator
+	namespaceDefaultsLabel = "operator.tekton.dev/namespace-defaults"
+
+	// namespace annotations requesting defaults
+	namespaceDefaultsAnnotationTimeout        = "operator.tekton.dev/defaults.timeout"
+	namespaceDefaultsAnnotationPruneKeep      = "operator.tekton.dev/defaults.prune-keep"
+	namespaceDefaultsAnnotationPruneKeepSince = "operator.tekton.dev/defaults.prune-keep-since"
+
+	// keys of the published ConfigMap
+	NamespaceDefaultsTimeoutMinutesKey = "default-timeout-minutes"
+	NamespaceDefaultsPruneKeepKey      = "prune-keep"
+	NamespaceDefaultsPruneKeepSinceKey = "prune-keep-since"
+
+	// TektonConfig params bounding the defaults requested in namespaces
+	namespaceDefaultsMaxTimeoutParamName        = v1alpha1.NamespaceDefaultsMaxTimeoutParam
+	namespaceDefaultsMaxPruneKeepParamName      = v1alpha1.NamespaceDefaultsMaxPruneKeepParam
+	namespaceDefaultsMaxPruneKeepSinceParamName = v1alpha1.NamespaceDefaultsMaxPruneKeepSinceParam
+)
+
+// namespaceDefaultsBounds are the upper bounds set by the admin, zero means unbounded
+type namespaceDefaultsBounds struct {
+	maxTimeoutMinutes uint
+	maxKeep           uint
+	maxKeepSince      uint
+}
+
+// ReconcileNamespaceDefaults publishes the defaults requested through namespace annotations, such as
+// the timeout of TaskRuns and PipelineRuns and pruning hints, in a ConfigMap in each namespace. The
+// requested values are clamped to the bounds set in the TektonConfig params. The ConfigMap is removed
+// from namespaces which no longer request any default.
+func ReconcileNamespaceDefaults(ctx context.Context, kubeClient kubernetes.Interface, tc *v1alpha1.TektonConfig) error {
+	logger := logging.FromContext(ctx)
+	bounds := getNamespaceDefaultsBounds(logger, tc)
+	ownerRef := *metav1.NewControllerRef(tc, tc.GetGroupVersionKind())
+
+	namespaceList, err := kubeClient.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	published := map[string]bool{}
+	ignorePattern := regexp.MustCompile(NamespaceIgnorePattern)
+	for i := range namespaceList.Items {
+		ns := &namespaceList.Items[i]
+		if ignorePattern.MatchString(ns.Name) || isNamespaceTerminating(ns) {
+			continue
+		}
+		data := namespaceDefaults(logger, ns, bounds)
+		if len(data) == 0 {
+			continue
+		}
+		published[ns.Name] = true
+		if err := ensureNamespaceDefaultsConfigMap(ctx, kubeClient, ns.Name, data, ownerRef); err != nil {
+			errs = append(errs, fmt.Errorf("failed to publish defaults in namespace %s: %w", ns.Name, err))
+		}
+	}
+
+	// remove the ConfigMaps of namespaces which no longer request defaults
+	cms, err := kubeClient.CoreV1().ConfigMaps(metav1.NamespaceAll).List(ctx, metav1.ListOptions{LabelSelector: namespaceDefaultsLabel})
+	if err != nil {
+		return errors.Join(append(errs, err)...)
+	}
+	for _, cm := range cms.Items {
+		if cm.Name != NamespaceDefaultsConfigMapName || published[cm.Namespace] {
+			continue
+		}
+		logger.Infof("namespace %s no longer requests defaults, removing configmap %s", cm.Namespace, cm.Name)
+		if err := kubeClient.CoreV1().ConfigMaps(cm.Namespace).Delete(ctx, cm.Name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("failed to remove defaults from namespace %s: %w", cm.Namespace, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// getNamespaceDefaultsBounds reads the bounds from the TektonConfig params, invalid values are ignored
+func getNamespaceDefaultsBounds(logger *zap.SugaredLogger, tc *v1alpha1.TektonConfig) namespaceDefaultsBounds {
+	bounds := namespaceDefaultsBounds{}
+	for _, p := range tc.Spec.Params {
+		switch p.Name {
+		case namespaceDefaultsMaxTimeoutParamName:
+			minutes, err := parseTimeoutMinutes(p.Value)
+			if err != nil {
+				logger.Warnf("invalid value %q for param %s, timeouts are not bounded: %v", p.Value, p.Name, err)
+				continue
+			}
+			bounds.maxTimeoutMinutes = minutes
+		case namespaceDefaultsMaxPruneKeepParamName, namespaceDefaultsMaxPruneKeepSinceParamName:
+			value, err := strconv.ParseUint(p.Value, 10, 32)
+			if err != nil || value == 0 {
+				logger.Warnf("invalid value %q for param %s, must be a positive integer", p.Value, p.Name)
+				continue
+			}
+			if p.Name == namespaceDefaultsMaxPruneKeepParamName {
+				bounds.maxKeep = uint(value)
+			} else {
+				bounds.maxKeepSince = uint(value)
+			}
+		}
+	}
+	return bounds
+}
+
+// namespaceDefaults returns the defaults requested in the annotations of a namespace, invalid
+// values are skipped and values exceeding the bounds are clamped
+func namespaceDefaults(logger *zap.SugaredLogger, ns *corev1.Namespace, bounds namespaceDefaultsBounds) map[string]string {
+	data := map[string]string{}
+	annotations := ns.GetAnnotations()
+
+	if value, ok := annotations[namespaceDefaultsAnnotationTimeout]; ok {
+		minutes, err := parseTimeoutMinutes(value)
+		if err != nil {
+			logger.Errorw("invalid default timeout requested", "namespace", ns.Name, "value", value, "error", err)
+		} else {
+			data[NamespaceDefaultsTimeoutMinutesKey] = strconv.FormatUint(uint64(clampToBound(logger, ns.Name, namespaceDefaultsAnnotationTimeout, minutes, bounds.maxTimeoutMinutes)), 10)
+		}
+	}
+
+	for annotation, entry := range map[string]struct {
+		key   string
+		bound uint
+	}{
+		namespaceDefaultsAnnotationPruneKeep:      {key: NamespaceDefaultsPruneKeepKey, bound: bounds.maxKeep},
+		namespaceDefaultsAnnotationPruneKeepSince: {key: NamespaceDefaultsPruneKeepSinceKey, bound: bounds.maxKeepSince},
+	} {
+		value, ok := annotations[annotation]
+		if !ok {
+			continue
+		}
+		n, err := strconv.ParseUint(value, 10, 32)
+		if err != nil || n == 0 {
+			logger.Errorw("invalid pruning default requested, must be a positive integer", "namespace", ns.Name, "annotation", annotation, "value", value)
+			continue
+		}
+		data[entry.key] = strconv.FormatUint(uint64(clampToBound(logger, ns.Name, annotation, uint(n), entry.bound)), 10)
+	}
+	return data
+}
+
+func clampToBound(logger *zap.SugaredLogger, namespace, annotation string, value, bound uint) uint {
+	if bound == 0 || value <= bound {
+		return value
+	}
+	logger.Warnf("namespace %s requests %d with annotation %s, exceeding the bound %d set in TektonConfig, using %d", namespace, value, annotation, bound, bound)
+	return bound
+}
+
+// parseTimeoutMinutes parses a duration such as "90m" or "2h", or a number of minutes, and
+// returns the number of minutes rounded up
+func parseTimeoutMinutes(value string) (uint, error) {
+	if minutes, err := strconv.ParseUint(value, 10, 32); err == nil {
+		if minutes == 0 {
+			return 0, fmt.Errorf("timeout must be greater than zero")
+		}
+		return uint(minutes), nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, err
+	}
+	if d <= 0 {
+		return 0, fmt.Errorf("timeout must be greater than zero")
+	}
+	return uint(math.Ceil(d.Minutes())), nil
+}
+
+func ensureNamespaceDefaultsConfigMap(ctx context.Context, kubeClient kubernetes.Interface, namespace string, data map[string]string, ownerRef metav1.OwnerReference) error {
+	cmClient := kubeClient.CoreV1().ConfigMaps(namespace)
+	existing, err := cmClient.Get(ctx, NamespaceDefaultsConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+		_, err = cmClient.Create(ctx, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            NamespaceDefaultsConfigMapName,
+				Namespace:       namespace,
+				Labels:          map[string]string{namespaceDefaultsLabel: "true"},
+				OwnerReferences: []metav1.OwnerReference{ownerRef},
+			},
+			Data: data,
+		}, metav1.CreateOptions{})
+		return err
+	}
+
+	if reflect.DeepEqual(existing.Data, data) && existing.Labels[namespaceDefaultsLabel] == "true" {
+		return nil
+	}
+	existing = existing.DeepCopy()
+	if existing.Labels == nil {
+		existing.Labels = map[string]string{}
+	}
+	existing.Labels[namespaceDefaultsLabel] = "true"
+	existing.Data = data
+	_, err = cmClient.Update(ctx, existing, metav1.UpdateOptions{})
+	return err
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"knative.dev/pkg/logging"
+)
+
+func TestNamespaceDefaults(t *testing.T) {
+	logger := logging.FromContext(context.TODO())
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		bounds      namespaceDefaultsBounds
+		want        map[string]string
+	}{
+		{
+			name: "no annotations",
+			want: map[string]string{},
+		},
+		{
+			name: "all defaults",
+			annotations: map[string]string{
+				namespaceDefaultsAnnotationTimeout:        "90m",
+				namespaceDefaultsAnnotationPruneKeep:      "5",
+				namespaceDefaultsAnnotationPruneKeepSince: "1440",
+			},
+			want: map[string]string{
+				NamespaceDefaultsTimeoutMinutesKey: "90",
+				NamespaceDefaultsPruneKeepKey:      "5",
+				NamespaceDefaultsPruneKeepSinceKey: "1440",
+			},
+		},
+		{
+			name:        "timeout in minutes rounded up",
+			annotations: map[string]string{namespaceDefaultsAnnotationTimeout: "90s"},
+			want:        map[string]string{NamespaceDefaultsTimeoutMinutesKey: "2"},
+		},
+		{
+			name: "values clamped to the bounds",
+			annotations: map[string]string{
+				namespaceDefaultsAnnotationTimeout:   "5h",
+				namespaceDefaultsAnnotationPruneKeep: "500",
+			},
+			bounds: namespaceDefaultsBounds{maxTimeoutMinutes: 120, maxKeep: 50},
+			want: map[string]string{
+				NamespaceDefaultsTimeoutMinutesKey: "120",
+				NamespaceDefaultsPruneKeepKey:      "50",
+			},
+		},
+		{
+			name: "invalid values skipped",
+			annotations: map[string]string{
+				namespaceDefaultsAnnotationTimeout:        "forever",
+				namespaceDefaultsAnnotationPruneKeep:      "0",
+				namespaceDefaultsAnnotationPruneKeepSince: "-1",
+			},
+			want: map[string]string{},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ns", Annotations: test.annotations}}
+			assert.DeepEqual(t, namespaceDefaults(logger, ns, test.bounds), test.want)
+		})
+	}
+}
+
+func TestGetNamespaceDefaultsBounds(t *testing.T) {
+	tc := &v1alpha1.TektonConfig{
+		Spec: v1alpha1.TektonConfigSpec{
+			Params: []v1alpha1.Param{
+				{Name: namespaceDefaultsMaxTimeoutParamName, Value: "2h"},
+				{Name: namespaceDefaultsMaxPruneKeepParamName, Value: "50"},
+				{Name: namespaceDefaultsMaxPruneKeepSinceParamName, Value: "none"},
+			},
+		},
+	}
+	bounds := getNamespaceDefaultsBounds(logging.FromContext(context.TODO()), tc)
+	assert.Equal(t, bounds, namespaceDefaultsBounds{maxTimeoutMinutes: 120, maxKeep: 50})
+}
+
+func TestReconcileNamespaceDefaults(t *testing.T) {
+	ctx := context.TODO()
+	tc := &v1alpha1.TektonConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.ConfigResourceName},
+		Spec: v1alpha1.TektonConfigSpec{
+			Params: []v1alpha1.Param{{Name: namespaceDefaultsMaxTimeoutParamName, Value: "60"}},
+		},
+	}
+	kubeClient := fake.NewSimpleClientset(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a", Annotations: map[string]string{namespaceDefaultsAnnotationTimeout: "2h"}}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-b"}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "kube-system", Annotations: map[string]string{namespaceDefaultsAnnotationTimeout: "2h"}}},
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+			Name:      NamespaceDefaultsConfigMapName,
+			Namespace: "team-b",
+			Labels:    map[string]string{namespaceDefaultsLabel: "true"},
+		}},
+	)
+
+	assert.NilError(t, ReconcileNamespaceDefaults(ctx, kubeClient, tc))
+
+	cm, err := kubeClient.CoreV1().ConfigMaps("team-a").Get(ctx, NamespaceDefaultsConfigMapName, metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, cm.Data, map[string]string{NamespaceDefaultsTimeoutMinutesKey: "60"})
+	assert.Equal(t, cm.OwnerReferences[0].Name, v1alpha1.ConfigResourceName)
+
+	// namespaces without defaults have no configmap
+	_, err = kubeClient.CoreV1().ConfigMaps("team-b").Get(ctx, NamespaceDefaultsConfigMapName, metav1.GetOptions{})
+	assert.Assert(t, apierrors.IsNotFound(err))
+	_, err = kubeClient.CoreV1().ConfigMaps("kube-system").Get(ctx, NamespaceDefaultsConfigMapName, metav1.GetOptions{})
+	assert.Assert(t, apierrors.IsNotFound(err))
+
+	// a changed bound is published again
+	tc.Spec.Params[0].Value = "3h"
+	assert.NilError(t, ReconcileNamespaceDefaults(ctx, kubeClient, tc))
+	cm, err = kubeClient.CoreV1().ConfigMaps("team-a").Get(ctx, NamespaceDefaultsConfigMapName, metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, cm.Data, map[string]string{NamespaceDefaultsTimeoutMinutesKey: "120"})
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+)
+
+// ErrNamespaceTerminating is returned when the metadata of a terminating namespace would be patched
+var ErrNamespaceTerminating = errors.New("namespace is terminating")
+
+// NamespaceMetadataPatch describes the operator labels and annotations to set on or
+// remove from a namespace, all other labels and annotations are left untouched
+type NamespaceMetadataPatch struct {
+	SetLabels         map[string]string
+	RemoveLabels      []string
+	SetAnnotations    map[string]string
+	RemoveAnnotations []string
+}
+
+type jsonPatchOperation struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+// PatchNamespaceMetadata applies the changes to the labels and annotations of a namespace
+// with a single JSON patch, so they are applied atomically. The patch is guarded by the
+// resourceVersion of the namespace and recomputed on conflicts. Terminating namespaces
+// are never patched, ErrNamespaceTerminating is returned for them.
+func PatchNamespaceMetadata(ctx context.Context, kubeClient kubernetes.Interface, name string, p NamespaceMetadataPatch) error {
+	return retry.OnError(retry.DefaultRetry, isPatchConflict, func() error {
+		ns, err := kubeClient.CoreV1().Namespaces().Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if isNamespaceTerminating(ns) {
+			return fmt.Errorf("cannot patch namespace %s: %w", name, ErrNamespaceTerminating)
+		}
+
+		ops := namespaceMetadataPatchOperations(ns, p)
+		if len(ops) == 0 {
+			return nil
+		}
+		if ns.ResourceVersion != "" {
+			ops = append([]jsonPatchOperation{{Op: "test", Path: "/metadata/resourceVersion", Value: ns.ResourceVersion}}, ops...)
+		}
+		payload, err := json.Marshal(ops)
+		if err != nil {
+			return fmt.Errorf("failed to marshal metadata patch for namespace %s: %w", name, err)
+		}
+		_, err = kubeClient.CoreV1().Namespaces().Patch(ctx, name, types.JSONPatchType, payload, metav1.PatchOptions{})
+		return err
+	})
+}
+
+// namespaceMetadataPatchOperations returns the JSON patch operations changing the metadata
+// of the namespace, no operation is returned for values already in the desired state
+func namespaceMetadataPatchOperations(ns *corev1.Namespace, p NamespaceMetadataPatch) []jsonPatchOperation {
+	ops := metadataMapOperations("labels", ns.Labels, p.SetLabels, p.RemoveLabels)
+	return append(ops, metadataMapOperations("annotations", ns.Annotations, p.SetAnnotations, p.RemoveAnnotations)...)
+}
+
+func metadataMapOperations(field string, current, set map[string]string, remove []string) []jsonPatchOperation {
+	ops := []jsonPatchOperation{}
+	removed := map[string]bool{}
+	for _, key := range remove {
+		if _, ok := current[key]; ok && !removed[key] {
+			removed[key] = true
+			ops = append(ops, jsonPatchOperation{Op: "remove", Path: metadataPath(field, key)})
+		}
+	}
+
+	keys := make([]string, 0, len(set))
+	for key, value := range set {
+		if v, ok := current[key]; ok && v == value && !removed[key] {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	if len(keys) == 0 {
+		return ops
+	}
+	sort.Strings(keys)
+
+	if current == nil {
+		// the map has to exist before keys can be added to it
+		values := map[string]string{}
+		for _, key := range keys {
+			values[key] = set[key]
+		}
+		return append(ops, jsonPatchOperation{Op: "add", Path: "/metadata/" + field, Value: values})
+	}
+	for _, key := range keys {
+		ops = append(ops, jsonPatchOperation{Op: "add", Path: metadataPath(field, key), Value: set[key]})
+	}
+	return ops
+}
+
+// metadataPath escapes the key as a JSON pointer reference token
+func metadataPath(field, key string) string {
+	key = strings.ReplaceAll(key, "~", "~0")
+	key = strings.ReplaceAll(key, "/", "~1")
+	return "/metadata/" + field + "/" + key
+}
+
+func isNamespaceTerminating(ns *corev1.Namespace) bool {
+	return ns.DeletionTimestamp != nil || ns.Status.Phase == corev1.NamespaceTerminating
+}
+
+// NamespaceGoingAway returns true if the error was caused by the namespace entering Terminating
+// or being deleted while its resources were reconciled. The API server refuses to create content
+// in a terminating namespace, the NotFound and Conflict errors are only attributed to the
+// namespace once a fresh read finds it terminating or gone.
+func NamespaceGoingAway(ctx context.Context, kubeClient kubernetes.Interface, namespace string, err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, ErrNamespaceTerminating) || apierrors.HasStatusCause(err, corev1.NamespaceTerminatingCause) {
+		return true
+	}
+	if !apierrors.IsNotFound(err) && !apierrors.IsConflict(err) {
+		return false
+	}
+	ns, getErr := kubeClient.CoreV1().Namespaces().Get(ctx, namespace, metav1.GetOptions{})
+	if apierrors.IsNotFound(getErr) {
+		return true
+	}
+	return getErr == nil && isNamespaceTerminating(ns)
+}
+
+// isPatchConflict returns true if the patch failed because the namespace changed, a failed
+// test operation of a JSON patch is reported as invalid by the API server
+func isPatchConflict(err error) bool {
+	return apierrors.IsConflict(err) || apierrors.IsInvalid(err)
+}
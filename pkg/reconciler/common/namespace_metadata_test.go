@@ -0,0 +1,200 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func countPatches(client *fake.Clientset) int {
+	count := 0
+	for _, action := range client.Actions() {
+		if action.GetVerb() == "patch" {
+			count++
+		}
+	}
+	return count
+}
+
+func TestPatchNamespaceMetadata(t *testing.T) {
+	ctx := context.TODO()
+	client := fake.NewSimpleClientset(&corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "team-a",
+			Labels: map[string]string{
+				"team":                          "a",
+				"operator.tekton.dev/old-label": "v1",
+			},
+			Annotations: map[string]string{"owner": "team-a"},
+		},
+	})
+
+	err := PatchNamespaceMetadata(ctx, client, "team-a", NamespaceMetadataPatch{
+		SetLabels: map[string]string{
+			"operator.tekton.dev/version": "v2",
+			"operator.tekton.dev/trusted": "v2",
+		},
+		RemoveLabels:      []string{"operator.tekton.dev/old-label", "operator.tekton.dev/missing"},
+		SetAnnotations:    map[string]string{"operator.tekton.dev/reconciled~at": "now"},
+		RemoveAnnotations: []string{"operator.tekton.dev/missing"},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, countPatches(client), 1)
+
+	ns, err := client.CoreV1().Namespaces().Get(ctx, "team-a", metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, ns.Labels, map[string]string{
+		"team":                        "a",
+		"operator.tekton.dev/version": "v2",
+		"operator.tekton.dev/trusted": "v2",
+	})
+	assert.DeepEqual(t, ns.Annotations, map[string]string{
+		"owner":                             "team-a",
+		"operator.tekton.dev/reconciled~at": "now",
+	})
+
+	// nothing to change, nothing is patched
+	err = PatchNamespaceMetadata(ctx, client, "team-a", NamespaceMetadataPatch{
+		SetLabels:    map[string]string{"operator.tekton.dev/version": "v2"},
+		RemoveLabels: []string{"operator.tekton.dev/old-label"},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, countPatches(client), 1)
+}
+
+func TestPatchNamespaceMetadataWithoutLabels(t *testing.T) {
+	ctx := context.TODO()
+	client := fake.NewSimpleClientset(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a"}})
+
+	err := PatchNamespaceMetadata(ctx, client, "team-a", NamespaceMetadataPatch{
+		SetLabels: map[string]string{"operator.tekton.dev/version": "v2"},
+	})
+	assert.NilError(t, err)
+	ns, err := client.CoreV1().Namespaces().Get(ctx, "team-a", metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, ns.Labels, map[string]string{"operator.tekton.dev/version": "v2"})
+}
+
+func TestPatchNamespaceMetadataTerminating(t *testing.T) {
+	now := metav1.Now()
+	for _, ns := range []*corev1.Namespace{
+		{ObjectMeta: metav1.ObjectMeta{Name: "deleted", DeletionTimestamp: &now, Finalizers: []string{"kubernetes"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "terminating"}, Status: corev1.NamespaceStatus{Phase: corev1.NamespaceTerminating}},
+	} {
+		t.Run(ns.Name, func(t *testing.T) {
+			client := fake.NewSimpleClientset(ns)
+			err := PatchNamespaceMetadata(context.TODO(), client, ns.Name, NamespaceMetadataPatch{
+				SetLabels: map[string]string{"operator.tekton.dev/version": "v2"},
+			})
+			assert.Assert(t, errors.Is(err, ErrNamespaceTerminating), "unexpected error: %v", err)
+			assert.Equal(t, countPatches(client), 0)
+		})
+	}
+}
+
+func TestPatchNamespaceMetadataRetriesConflicts(t *testing.T) {
+	ctx := context.TODO()
+	client := fake.NewSimpleClientset(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a", ResourceVersion: "1"}})
+	conflicts := 0
+	client.PrependReactor("patch", "namespaces", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		patch := string(action.(k8stesting.PatchAction).GetPatch())
+		assert.Assert(t, len(patch) > 0 && patch[:2] == "[{")
+		if conflicts < 2 {
+			conflicts++
+			return true, nil, apierrors.NewConflict(schema.GroupResource{Resource: "namespaces"}, "team-a", errors.New("resourceVersion changed"))
+		}
+		return false, nil, nil
+	})
+
+	err := PatchNamespaceMetadata(ctx, client, "team-a", NamespaceMetadataPatch{
+		SetLabels: map[string]string{"operator.tekton.dev/version": "v2"},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, countPatches(client), 3)
+
+	// other errors are not retried
+	client.PrependReactor("patch", "namespaces", func(k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, apierrors.NewForbidden(schema.GroupResource{Resource: "namespaces"}, "team-a", errors.New("denied"))
+	})
+	err = PatchNamespaceMetadata(ctx, client, "team-a", NamespaceMetadataPatch{
+		SetLabels: map[string]string{"operator.tekton.dev/version": "v3"},
+	})
+	assert.Assert(t, apierrors.IsForbidden(err))
+	assert.Equal(t, countPatches(client), 4)
+}
+
+func TestNamespaceMetadataPatchOperations(t *testing.T) {
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+		Labels: map[string]string{"a/b": "1", "c": "2"},
+	}}
+	ops := namespaceMetadataPatchOperations(ns, NamespaceMetadataPatch{
+		SetLabels:    map[string]string{"c": "3", "d~e": "4", "a/b": "1"},
+		RemoveLabels: []string{"a/b"},
+	})
+	// a label both removed and set is set again
+	assert.DeepEqual(t, ops, []jsonPatchOperation{
+		{Op: "remove", Path: "/metadata/labels/a~1b"},
+		{Op: "add", Path: "/metadata/labels/a~1b", Value: "1"},
+		{Op: "add", Path: "/metadata/labels/c", Value: "3"},
+		{Op: "add", Path: "/metadata/labels/d~0e", Value: "4"},
+	})
+}
+
+func TestNamespaceGoingAway(t *testing.T) {
+	now := metav1.Now()
+	client := fake.NewSimpleClientset(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "active"}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "terminating", DeletionTimestamp: &now, Finalizers: []string{"kubernetes"}}},
+	)
+	terminatingErr := apierrors.NewForbidden(schema.GroupResource{Resource: "serviceaccounts"}, "pipeline",
+		errors.New("unable to create new content in namespace active because it is being terminated"))
+	terminatingErr.ErrStatus.Details.Causes = []metav1.StatusCause{{Type: corev1.NamespaceTerminatingCause, Field: "active"}}
+	notFound := apierrors.NewNotFound(schema.GroupResource{Resource: "serviceaccounts"}, "pipeline")
+	conflict := apierrors.NewConflict(schema.GroupResource{Resource: "serviceaccounts"}, "pipeline", errors.New("modified"))
+
+	tests := []struct {
+		name      string
+		namespace string
+		err       error
+		want      bool
+	}{
+		{name: "no error", namespace: "terminating", err: nil, want: false},
+		{name: "terminating cause", namespace: "active", err: fmt.Errorf("failed to create: %w", terminatingErr), want: true},
+		{name: "patch of a terminating namespace", namespace: "active", err: fmt.Errorf("patch: %w", ErrNamespaceTerminating), want: true},
+		{name: "not found in an active namespace", namespace: "active", err: notFound, want: false},
+		{name: "not found in a terminating namespace", namespace: "terminating", err: notFound, want: true},
+		{name: "conflict in a deleted namespace", namespace: "deleted", err: conflict, want: true},
+		{name: "other error in a deleted namespace", namespace: "deleted", err: errors.New("timeout"), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, NamespaceGoingAway(context.TODO(), client, tt.namespace, tt.err), tt.want)
+		})
+	}
+}
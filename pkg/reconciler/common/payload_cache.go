@@ -0,0 +1,275 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// PayloadCacheLabel is set on the ConfigMaps holding the chunks of a cached payload
+	PayloadCacheLabel = "operator.tekton.dev/payload-cache"
+
+	payloadKeyAnnotation    = "operator.tekton.dev/payload-key"
+	payloadDigestAnnotation = "operator.tekton.dev/payload-digest"
+	payloadChunkAnnotation  = "operator.tekton.dev/payload-chunk"
+	payloadChunksAnnotation = "operator.tekton.dev/payload-chunks"
+	payloadChunkKey         = "payload"
+	// payloadChunkSize keeps each chunk below the 1MiB size limit of a ConfigMap
+	payloadChunkSize = 900 * 1024
+	payloadDigestAlg = "sha256:"
+)
+
+// ErrPayloadMissingFromCache is returned when a payload is neither in the cache nor downloadable,
+// e.g. in a disconnected cluster
+var ErrPayloadMissingFromCache = errors.New("payload missing from cache")
+
+// PayloadCache stores the payloads downloaded by the operator in ConfigMap chunks of its
+// namespace, so that the other replicas and the restarted operators don't download them again.
+// Payloads are addressed by their sha256 digest, or by the sha256 of their URL, and verified
+// against their digest on every read.
+type PayloadCache struct {
+	kubeClient kubernetes.Interface
+	namespace  string
+}
+
+// NewPayloadCache returns a PayloadCache storing the payloads in the namespace
+func NewPayloadCache(kubeClient kubernetes.Interface, namespace string) *PayloadCache {
+	return &PayloadCache{kubeClient: kubeClient, namespace: namespace}
+}
+
+// Get returns the cached payload with the digest, ErrPayloadMissingFromCache is returned when
+// the payload or one of its chunks is not cached
+func (c *PayloadCache) Get(ctx context.Context, digest string) ([]byte, error) {
+	sum, err := parsePayloadDigest(digest)
+	if err != nil {
+		return nil, err
+	}
+	return c.get(ctx, sum, sum)
+}
+
+// Put stores the payload in the cache, after verifying it matches its digest
+func (c *PayloadCache) Put(ctx context.Context, digest string, data []byte) error {
+	sum, err := parsePayloadDigest(digest)
+	if err != nil {
+		return err
+	}
+	if err := verifyPayload(data, sum); err != nil {
+		return err
+	}
+	return c.put(ctx, sum, data)
+}
+
+// get returns the payload cached under the key, it is verified against the sum, or against
+// the digest recorded with its chunks when the sum is empty
+func (c *PayloadCache) get(ctx context.Context, key, sum string) ([]byte, error) {
+	list, err := c.kubeClient.CoreV1().ConfigMaps(c.namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", PayloadCacheLabel, payloadCacheKey(key)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the cached chunks of payload %s: %w", key, err)
+	}
+	if len(list.Items) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrPayloadMissingFromCache, payloadName(key, sum))
+	}
+
+	digest := ""
+	if sum != "" {
+		digest = payloadDigestAlg + sum
+	}
+	chunks := map[int][]byte{}
+	total := 0
+	for _, cm := range list.Items {
+		if cm.Annotations[payloadKeyAnnotation] != key {
+			continue
+		}
+		if digest == "" {
+			digest = cm.Annotations[payloadDigestAnnotation]
+		}
+		if cm.Annotations[payloadDigestAnnotation] != digest {
+			continue
+		}
+		index, err := strconv.Atoi(cm.Annotations[payloadChunkAnnotation])
+		if err != nil {
+			return nil, fmt.Errorf("invalid chunk index in ConfigMap %s/%s: %w", cm.Namespace, cm.Name, err)
+		}
+		if total, err = strconv.Atoi(cm.Annotations[payloadChunksAnnotation]); err != nil {
+			return nil, fmt.Errorf("invalid chunk count in ConfigMap %s/%s: %w", cm.Namespace, cm.Name, err)
+		}
+		chunks[index] = cm.BinaryData[payloadChunkKey]
+	}
+	name := payloadName(key, strings.TrimPrefix(digest, payloadDigestAlg))
+	if total == 0 || len(chunks) != total {
+		return nil, fmt.Errorf("%w: %s has %d of %d chunks cached", ErrPayloadMissingFromCache, name, len(chunks), total)
+	}
+
+	indexes := make([]int, 0, len(chunks))
+	for i := range chunks {
+		indexes = append(indexes, i)
+	}
+	sort.Ints(indexes)
+	var data []byte
+	for i, index := range indexes {
+		if i != index {
+			return nil, fmt.Errorf("%w: chunk %d of %s is not cached", ErrPayloadMissingFromCache, i, name)
+		}
+		data = append(data, chunks[index]...)
+	}
+	if err := verifyPayload(data, strings.TrimPrefix(digest, payloadDigestAlg)); err != nil {
+		return nil, fmt.Errorf("cached payload %s is corrupted: %w", name, err)
+	}
+	return data, nil
+}
+
+// put stores the payload under the key in chunks, along with its digest
+func (c *PayloadCache) put(ctx context.Context, key string, data []byte) error {
+	digest := fmt.Sprintf("%s%x", payloadDigestAlg, sha256.Sum256(data))
+	total := (len(data) + payloadChunkSize - 1) / payloadChunkSize
+	configMaps := c.kubeClient.CoreV1().ConfigMaps(c.namespace)
+	for i := 0; i < total; i++ {
+		end := min((i+1)*payloadChunkSize, len(data))
+		cm := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      fmt.Sprintf("tekton-payload-%s-%d", key[:12], i),
+				Namespace: c.namespace,
+				Labels:    map[string]string{PayloadCacheLabel: payloadCacheKey(key)},
+				Annotations: map[string]string{
+					payloadKeyAnnotation:    key,
+					payloadDigestAnnotation: digest,
+					payloadChunkAnnotation:  strconv.Itoa(i),
+					payloadChunksAnnotation: strconv.Itoa(total),
+				},
+			},
+			BinaryData: map[string][]byte{payloadChunkKey: data[i*payloadChunkSize : end]},
+		}
+		_, err := configMaps.Create(ctx, cm, metav1.CreateOptions{})
+		if apierrors.IsAlreadyExists(err) {
+			// another replica cached the payload, or the chunk was corrupted
+			_, err = configMaps.Update(ctx, cm, metav1.UpdateOptions{})
+		}
+		if err != nil {
+			return fmt.Errorf("failed to cache chunk %d of payload %s: %w", i, digest, err)
+		}
+	}
+	return nil
+}
+
+// FetchPayload returns the payload from the cache, and downloads it into the cache when it is
+// not cached yet. The payload is addressed by its digest, or by its URL when the digest is empty,
+// the URL must then always point to the same content, e.g. a file at a commit. When the payload
+// cannot be downloaded the returned error wraps ErrPayloadMissingFromCache.
+func FetchPayload(ctx context.Context, cache *PayloadCache, payloadURL, digest string, download func(ctx context.Context, payloadURL string) ([]byte, error)) ([]byte, error) {
+	key, sum := fmt.Sprintf("%x", sha256.Sum256([]byte(payloadURL))), ""
+	if digest != "" {
+		var err error
+		if sum, err = parsePayloadDigest(digest); err != nil {
+			return nil, err
+		}
+		key = sum
+	}
+
+	data, cacheErr := cache.get(ctx, key, sum)
+	if cacheErr == nil {
+		return data, nil
+	}
+	data, err := download(ctx, payloadURL)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s could not be downloaded from %s: %v (%v)", ErrPayloadMissingFromCache, payloadName(key, sum), payloadURL, err, cacheErr)
+	}
+	if sum != "" {
+		if err := verifyPayload(data, sum); err != nil {
+			return nil, fmt.Errorf("payload downloaded from %s: %w", payloadURL, err)
+		}
+	}
+	if err := cache.put(ctx, key, data); err != nil {
+		return nil, fmt.Errorf("payload downloaded from %s: %w", payloadURL, err)
+	}
+	return data, nil
+}
+
+// DownloadPayload downloads a payload over HTTP(S)
+func DownloadPayload(ctx context.Context, payloadURL string) ([]byte, error) {
+	u, err := url.Parse(payloadURL)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != "https" && u.Scheme != "http" {
+		return nil, fmt.Errorf("unsupported payload URL scheme %q, only http and https are supported", u.Scheme)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, payloadURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// payloadName names a payload in the errors, by its digest when it is known
+func payloadName(key, sum string) string {
+	if sum != "" {
+		return payloadDigestAlg + sum
+	}
+	return "payload " + key[:12]
+}
+
+// parsePayloadDigest returns the hex encoded sum of a sha256:<sum> digest
+func parsePayloadDigest(digest string) (string, error) {
+	sum, ok := strings.CutPrefix(digest, payloadDigestAlg)
+	if !ok {
+		return "", fmt.Errorf("invalid payload digest %q, must be %s<sum>", digest, payloadDigestAlg)
+	}
+	if b, err := hex.DecodeString(sum); err != nil || len(b) != sha256.Size {
+		return "", fmt.Errorf("invalid payload digest %q, must be %s<sum>", digest, payloadDigestAlg)
+	}
+	return strings.ToLower(sum), nil
+}
+
+func verifyPayload(data []byte, sum string) error {
+	actual := sha256.Sum256(data)
+	if hex.EncodeToString(actual[:]) != sum {
+		return fmt.Errorf("payload sha256 %x does not match the expected %s", actual, sum)
+	}
+	return nil
+}
+
+// payloadCacheKey is the label value of the chunks of a payload, a label value is at most
+// 63 characters long
+func payloadCacheKey(sum string) string {
+	return sum[:63]
+}
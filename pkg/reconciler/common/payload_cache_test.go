@@ -0,0 +1,156 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func payloadDigest(data []byte) string {
+	return fmt.Sprintf("%s%x", payloadDigestAlg, sha256.Sum256(data))
+}
+
+func TestPayloadCache(t *testing.T) {
+	ctx := context.TODO()
+	kubeClient := fake.NewSimpleClientset()
+	cache := NewPayloadCache(kubeClient, "tekton-operator")
+	data := bytes.Repeat([]byte("payload"), payloadChunkSize/3)
+	digest := payloadDigest(data)
+
+	_, err := cache.Get(ctx, digest)
+	assert.Assert(t, errors.Is(err, ErrPayloadMissingFromCache))
+
+	assert.NilError(t, cache.Put(ctx, digest, data))
+	list, err := kubeClient.CoreV1().ConfigMaps("tekton-operator").List(ctx, metav1.ListOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, len(list.Items), 3)
+
+	cached, err := cache.Get(ctx, digest)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, cached, data)
+
+	// a second replica caching the same payload updates the chunks
+	assert.NilError(t, cache.Put(ctx, digest, data))
+
+	assert.ErrorContains(t, cache.Put(ctx, digest, []byte("other")), "does not match")
+	assert.ErrorContains(t, cache.Put(ctx, "md5:abc", data), "invalid payload digest")
+
+	// a corrupted chunk fails the integrity check
+	cm := list.Items[1]
+	cm.BinaryData[payloadChunkKey] = []byte("corrupted")
+	_, err = kubeClient.CoreV1().ConfigMaps("tekton-operator").Update(ctx, &cm, metav1.UpdateOptions{})
+	assert.NilError(t, err)
+	_, err = cache.Get(ctx, digest)
+	assert.ErrorContains(t, err, "is corrupted")
+
+	// a missing chunk is reported as missing from the cache
+	assert.NilError(t, kubeClient.CoreV1().ConfigMaps("tekton-operator").Delete(ctx, cm.Name, metav1.DeleteOptions{}))
+	_, err = cache.Get(ctx, digest)
+	assert.Assert(t, errors.Is(err, ErrPayloadMissingFromCache))
+	assert.ErrorContains(t, err, "has 2 of 3 chunks cached")
+}
+
+func TestFetchPayload(t *testing.T) {
+	ctx := context.TODO()
+	cache := NewPayloadCache(fake.NewSimpleClientset(), "tekton-operator")
+	data := []byte("payload")
+	digest := payloadDigest(data)
+	downloads := 0
+	download := func(context.Context, string) ([]byte, error) {
+		downloads++
+		return data, nil
+	}
+	disconnected := func(context.Context, string) ([]byte, error) {
+		return nil, errors.New("dial tcp: no route to host")
+	}
+
+	_, err := FetchPayload(ctx, cache, "https://example.com/payload.tar.gz", digest, disconnected)
+	assert.Assert(t, errors.Is(err, ErrPayloadMissingFromCache))
+	assert.ErrorContains(t, err, "no route to host")
+
+	for i := 0; i < 2; i++ {
+		fetched, err := FetchPayload(ctx, cache, "https://example.com/payload.tar.gz", digest, download)
+		assert.NilError(t, err)
+		assert.DeepEqual(t, fetched, data)
+	}
+	assert.Equal(t, downloads, 1)
+
+	// once cached the payload is available in the disconnected cluster
+	fetched, err := FetchPayload(ctx, cache, "https://example.com/payload.tar.gz", digest, disconnected)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, fetched, data)
+
+	tampered := func(context.Context, string) ([]byte, error) {
+		return []byte("tampered"), nil
+	}
+	_, err = FetchPayload(ctx, cache, "https://example.com/other.tar.gz", payloadDigest([]byte("other")), tampered)
+	assert.ErrorContains(t, err, "does not match")
+}
+
+func TestFetchPayloadByURL(t *testing.T) {
+	ctx := context.TODO()
+	kubeClient := fake.NewSimpleClientset()
+	cache := NewPayloadCache(kubeClient, "tekton-operator")
+	data := []byte("payload")
+	download := func(context.Context, string) ([]byte, error) {
+		return data, nil
+	}
+	disconnected := func(context.Context, string) ([]byte, error) {
+		return nil, errors.New("dial tcp: no route to host")
+	}
+
+	_, err := FetchPayload(ctx, cache, "https://example.com/config.yaml", "", disconnected)
+	assert.Assert(t, errors.Is(err, ErrPayloadMissingFromCache))
+
+	fetched, err := FetchPayload(ctx, cache, "https://example.com/config.yaml", "", download)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, fetched, data)
+
+	// the payload is cached by its URL
+	fetched, err = FetchPayload(ctx, cache, "https://example.com/config.yaml", "", disconnected)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, fetched, data)
+	_, err = FetchPayload(ctx, cache, "https://example.com/other.yaml", "", disconnected)
+	assert.Assert(t, errors.Is(err, ErrPayloadMissingFromCache))
+
+	// and verified against the digest recorded with its chunks
+	list, err := kubeClient.CoreV1().ConfigMaps("tekton-operator").List(ctx, metav1.ListOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, len(list.Items), 1)
+	cm := list.Items[0]
+	assert.Equal(t, cm.Annotations[payloadDigestAnnotation], payloadDigest(data))
+	cm.BinaryData[payloadChunkKey] = []byte("corrupted")
+	_, err = kubeClient.CoreV1().ConfigMaps("tekton-operator").Update(ctx, &cm, metav1.UpdateOptions{})
+	assert.NilError(t, err)
+	_, err = FetchPayload(ctx, cache, "https://example.com/config.yaml", "", disconnected)
+	assert.Assert(t, errors.Is(err, ErrPayloadMissingFromCache))
+	assert.ErrorContains(t, err, "is corrupted")
+}
+
+func TestDownloadPayload(t *testing.T) {
+	_, err := DownloadPayload(context.TODO(), "ftp://example.com/payload.tar.gz")
+	assert.ErrorContains(t, err, "only http and https are supported")
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+)
+
+// applyPodSecurityDefaults sets the seccomp profile in the security context of the pod, and the
+// AppArmor profile of its containers in the container.apparmor.security.beta.kubernetes.io annotations
+func applyPodSecurityDefaults(template *corev1.PodTemplateSpec, defaults *v1alpha1.PodSecurityDefaults) {
+	if defaults == nil {
+		return
+	}
+	if defaults.SeccompProfile != nil {
+		if template.Spec.SecurityContext == nil {
+			template.Spec.SecurityContext = &corev1.PodSecurityContext{}
+		}
+		template.Spec.SecurityContext.SeccompProfile = defaults.SeccompProfile.DeepCopy()
+	}
+	if value := defaults.AppArmorAnnotationValue(); value != "" {
+		if template.Annotations == nil {
+			template.Annotations = map[string]string{}
+		}
+		for _, containers := range [][]corev1.Container{template.Spec.InitContainers, template.Spec.Containers} {
+			for _, c := range containers {
+				template.Annotations[corev1.DeprecatedAppArmorBetaContainerAnnotationKeyPrefix+c.Name] = value
+			}
+		}
+	}
+}
+
+// DefaultPodTemplateWithPodSecurity returns the default pod template with the seccomp and AppArmor
+// profiles added to its security context, the profiles already set in the template are kept
+func DefaultPodTemplateWithPodSecurity(podTemplate string, defaults *v1alpha1.PodSecurityDefaults) (string, error) {
+	if defaults == nil || (defaults.SeccompProfile == nil && defaults.AppArmorProfile == nil) {
+		return podTemplate, nil
+	}
+	template := map[string]interface{}{}
+	if err := yaml.Unmarshal([]byte(podTemplate), &template); err != nil {
+		return "", fmt.Errorf("failed to parse the default pod template: %w", err)
+	}
+	if template == nil {
+		template = map[string]interface{}{}
+	}
+	securityContext, _ := template["securityContext"].(map[string]interface{})
+	if securityContext == nil {
+		securityContext = map[string]interface{}{}
+	}
+	if _, ok := securityContext["seccompProfile"]; !ok && defaults.SeccompProfile != nil {
+		securityContext["seccompProfile"] = defaults.SeccompProfile
+	}
+	if _, ok := securityContext["appArmorProfile"]; !ok && defaults.AppArmorProfile != nil {
+		securityContext["appArmorProfile"] = defaults.AppArmorProfile
+	}
+	template["securityContext"] = securityContext
+	out, err := yaml.Marshal(template)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(string(out), "\n"), nil
+}
+
+// CheckPodSecurityLevel returns an error when the seccomp or AppArmor profiles are not allowed by
+// the Pod Security Admission level enforced in the namespace of the components
+func CheckPodSecurityLevel(ctx context.Context, kubeClientSet kubernetes.Interface, namespace string, defaults *v1alpha1.PodSecurityDefaults) error {
+	if defaults == nil {
+		return nil
+	}
+	ns, err := kubeClientSet.CoreV1().Namespaces().Get(ctx, namespace, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	level := ns.Labels[v1alpha1.PodSecurityEnforceLabel]
+	if violations := defaults.Violations(level); len(violations) > 0 {
+		return fmt.Errorf("the pod security defaults are not allowed in namespace %s: %s", namespace, strings.Join(violations, ", "))
+	}
+	return nil
+}
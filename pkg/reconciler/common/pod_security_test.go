@@ -0,0 +1,90 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"path"
+	"testing"
+
+	mf "github.com/manifestival/manifestival"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"gotest.tools/v3/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	"knative.dev/pkg/ptr"
+)
+
+func TestAddConfigurationPodSecurity(t *testing.T) {
+	manifest, err := mf.ManifestFrom(mf.Recursive(path.Join("testdata", "test-add-configurations.yaml")))
+	assert.NilError(t, err)
+	config := v1alpha1.Config{PodSecurity: &v1alpha1.PodSecurityDefaults{
+		SeccompProfile:  &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeLocalhost, LocalhostProfile: ptr.String("profiles/tekton.json")},
+		AppArmorProfile: &corev1.AppArmorProfile{Type: corev1.AppArmorProfileTypeRuntimeDefault},
+	}}
+
+	manifest, err = manifest.Transform(AddConfiguration(config))
+	assert.NilError(t, err)
+	d := &appsv1.Deployment{}
+	assert.NilError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(manifest.Resources()[0].Object, d))
+	assert.DeepEqual(t, d.Spec.Template.Spec.SecurityContext.SeccompProfile, config.PodSecurity.SeccompProfile)
+	assert.Assert(t, len(d.Spec.Template.Spec.Containers) > 0)
+	for _, c := range d.Spec.Template.Spec.Containers {
+		assert.Equal(t, d.Spec.Template.Annotations[corev1.DeprecatedAppArmorBetaContainerAnnotationKeyPrefix+c.Name], "runtime/default")
+	}
+}
+
+func TestDefaultPodTemplateWithPodSecurity(t *testing.T) {
+	defaults := &v1alpha1.PodSecurityDefaults{
+		SeccompProfile:  &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+		AppArmorProfile: &corev1.AppArmorProfile{Type: corev1.AppArmorProfileTypeRuntimeDefault},
+	}
+
+	got, err := DefaultPodTemplateWithPodSecurity("", defaults)
+	assert.NilError(t, err)
+	assert.Equal(t, got, "securityContext:\n  appArmorProfile:\n    type: RuntimeDefault\n  seccompProfile:\n    type: RuntimeDefault")
+
+	// the profiles of the template are kept
+	got, err = DefaultPodTemplateWithPodSecurity("nodeSelector:\n  disk: ssd\nsecurityContext:\n  seccompProfile:\n    type: Unconfined", defaults)
+	assert.NilError(t, err)
+	assert.Equal(t, got, "nodeSelector:\n  disk: ssd\nsecurityContext:\n  appArmorProfile:\n    type: RuntimeDefault\n  seccompProfile:\n    type: Unconfined")
+
+	got, err = DefaultPodTemplateWithPodSecurity("nodeSelector: {}", nil)
+	assert.NilError(t, err)
+	assert.Equal(t, got, "nodeSelector: {}")
+
+	_, err = DefaultPodTemplateWithPodSecurity("nodeSelector: [", defaults)
+	assert.ErrorContains(t, err, "failed to parse the default pod template")
+}
+
+func TestCheckPodSecurityLevel(t *testing.T) {
+	kubeClient := fake.NewSimpleClientset(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "tekton-pipelines",
+			Labels: map[string]string{v1alpha1.PodSecurityEnforceLabel: v1alpha1.PodSecurityLevelBaseline}}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "privileged"}},
+	)
+	defaults := &v1alpha1.PodSecurityDefaults{SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeUnconfined}}
+
+	err := CheckPodSecurityLevel(context.TODO(), kubeClient, "tekton-pipelines", defaults)
+	assert.Error(t, err, "the pod security defaults are not allowed in namespace tekton-pipelines: seccompProfile Unconfined is not allowed by the baseline level")
+	assert.NilError(t, CheckPodSecurityLevel(context.TODO(), kubeClient, "privileged", defaults))
+	assert.NilError(t, CheckPodSecurityLevel(context.TODO(), kubeClient, "missing", defaults))
+	assert.NilError(t, CheckPodSecurityLevel(context.TODO(), kubeClient, "tekton-pipelines", nil))
+}
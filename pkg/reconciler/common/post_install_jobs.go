@@ -0,0 +1,67 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	mf "github.com/manifestival/manifestival"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	batchv1 "k8s.io/api/batch/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// ApplyPostInstallJobs sets the deadline, retries, parallelism, resources and node placement
+// configured in TektonConfig on the Jobs of the payload. The fields which are not configured keep
+// the values of the payload.
+func ApplyPostInstallJobs(jobs *v1alpha1.PostInstallJobs) mf.Transformer {
+	return func(u *unstructured.Unstructured) error {
+		if jobs == nil || u.GetKind() != "Job" {
+			return nil
+		}
+		job := &batchv1.Job{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, job); err != nil {
+			return err
+		}
+		if jobs.ActiveDeadlineSeconds != nil {
+			job.Spec.ActiveDeadlineSeconds = jobs.ActiveDeadlineSeconds
+		}
+		if jobs.BackoffLimit != nil {
+			job.Spec.BackoffLimit = jobs.BackoffLimit
+		}
+		if jobs.Parallelism != nil {
+			job.Spec.Parallelism = jobs.Parallelism
+		}
+		podSpec := &job.Spec.Template.Spec
+		if jobs.Resources != nil {
+			for i := range podSpec.Containers {
+				podSpec.Containers[i].Resources = *jobs.Resources.DeepCopy()
+			}
+		}
+		if len(jobs.NodeSelector) > 0 {
+			podSpec.NodeSelector = jobs.NodeSelector
+		}
+		if len(jobs.Tolerations) > 0 {
+			podSpec.Tolerations = jobs.Tolerations
+		}
+		obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(job)
+		if err != nil {
+			return err
+		}
+		u.SetUnstructuredContent(obj)
+		return nil
+	}
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+
+	mf "github.com/manifestival/manifestival"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"gotest.tools/v3/assert"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"knative.dev/pkg/ptr"
+)
+
+func TestApplyPostInstallJobs(t *testing.T) {
+	job := &batchv1.Job{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "batch/v1", Kind: "Job"},
+		ObjectMeta: metav1.ObjectMeta{Name: "tekton-results-migrate", Namespace: "tekton-pipelines"},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: ptr.Int32(6),
+			Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+				NodeSelector: map[string]string{"kubernetes.io/os": "linux"},
+				Containers:   []corev1.Container{{Name: "migrate"}, {Name: "wait"}},
+			}},
+		},
+	}
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(job)
+	assert.NilError(t, err)
+	manifest, err := mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{{Object: obj}}))
+	assert.NilError(t, err)
+
+	// no configuration leaves the manifest as it is
+	unchanged, err := manifest.Transform(ApplyPostInstallJobs(nil))
+	assert.NilError(t, err)
+	assert.DeepEqual(t, unchanged.Resources()[0].Object, obj)
+
+	resources := corev1.ResourceRequirements{
+		Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("256Mi")},
+	}
+	tolerations := []corev1.Toleration{{Key: "infra", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule}}
+	transformed, err := manifest.Transform(ApplyPostInstallJobs(&v1alpha1.PostInstallJobs{
+		ActiveDeadlineSeconds: ptr.Int64(600),
+		Parallelism:           ptr.Int32(2),
+		Resources:             &resources,
+		Tolerations:           tolerations,
+	}))
+	assert.NilError(t, err)
+	got := &batchv1.Job{}
+	assert.NilError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(transformed.Resources()[0].Object, got))
+	assert.Equal(t, *got.Spec.ActiveDeadlineSeconds, int64(600))
+	assert.Equal(t, *got.Spec.Parallelism, int32(2))
+	// the fields which are not configured keep the values of the payload
+	assert.Equal(t, *got.Spec.BackoffLimit, int32(6))
+	assert.DeepEqual(t, got.Spec.Template.Spec.NodeSelector, map[string]string{"kubernetes.io/os": "linux"})
+	assert.DeepEqual(t, got.Spec.Template.Spec.Tolerations, tolerations)
+	for _, c := range got.Spec.Template.Spec.Containers {
+		assert.Equal(t, c.Resources.Limits.Memory().String(), "256Mi")
+	}
+}
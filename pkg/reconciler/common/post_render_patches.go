@@ -0,0 +1,146 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	mf "github.com/manifestival/manifestival"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/yaml"
+)
+
+// PostRenderPatchTarget selects the resources a patch applies to, the empty group, version and
+// namespace match any value
+type PostRenderPatchTarget struct {
+	Group     string `json:"group,omitempty"`
+	Version   string `json:"version,omitempty"`
+	Kind      string `json:"kind"`
+	Name      string `json:"name"`
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// PostRenderPatch is a patch of a key of the post render patches ConfigMap, a list of JSON6902
+// operations or a strategic merge patch. The strategic merge patch of a kind without a Go type,
+// e.g. a custom resource, is applied as a JSON merge patch.
+type PostRenderPatch struct {
+	Target PostRenderPatchTarget `json:"target"`
+	Patch  string                `json:"patch"`
+
+	// key of the ConfigMap holding the patch
+	key        string
+	operations jsonpatch.Patch
+	merge      []byte
+}
+
+// NewPostRenderPatchesFromMap parses the patches of the data of the post render patches
+// ConfigMap, the patches are applied in the order of their keys
+func NewPostRenderPatchesFromMap(data map[string]string) ([]PostRenderPatch, error) {
+	keys := make([]string, 0, len(data))
+	for key := range data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	patches := make([]PostRenderPatch, 0, len(keys))
+	for _, key := range keys {
+		p := PostRenderPatch{key: key}
+		if err := yaml.UnmarshalStrict([]byte(data[key]), &p); err != nil {
+			return nil, fmt.Errorf("invalid post render patch %s: %w", key, err)
+		}
+		if p.Target.Kind == "" || p.Target.Name == "" {
+			return nil, fmt.Errorf("invalid post render patch %s: the kind and name of the target are required", key)
+		}
+		patch, err := yaml.YAMLToJSON([]byte(p.Patch))
+		if err != nil {
+			return nil, fmt.Errorf("invalid post render patch %s: %w", key, err)
+		}
+		switch patch = bytes.TrimSpace(patch); {
+		case bytes.HasPrefix(patch, []byte("[")):
+			if p.operations, err = jsonpatch.DecodePatch(patch); err != nil {
+				return nil, fmt.Errorf("invalid post render patch %s: %w", key, err)
+			}
+		case bytes.HasPrefix(patch, []byte("{")):
+			p.merge = patch
+		default:
+			return nil, fmt.Errorf("invalid post render patch %s: the patch must be a list of JSON6902 operations or a strategic merge patch", key)
+		}
+		patches = append(patches, p)
+	}
+	return patches, nil
+}
+
+// matches returns true if the resource is a target of the patch
+func (p PostRenderPatch) matches(u *unstructured.Unstructured) bool {
+	gvk := u.GroupVersionKind()
+	t := p.Target
+	return t.Kind == gvk.Kind && t.Name == u.GetName() &&
+		(t.Group == "" || t.Group == gvk.Group) &&
+		(t.Version == "" || t.Version == gvk.Version) &&
+		(t.Namespace == "" || t.Namespace == u.GetNamespace())
+}
+
+// apply patches the resource, the kind, namespace and name of the resource cannot be patched
+func (p PostRenderPatch) apply(u *unstructured.Unstructured) error {
+	doc, err := json.Marshal(u.Object)
+	if err != nil {
+		return err
+	}
+	switch {
+	case p.operations != nil:
+		doc, err = p.operations.Apply(doc)
+	default:
+		if typed, newErr := scheme.Scheme.New(u.GroupVersionKind()); newErr == nil {
+			doc, err = strategicpatch.StrategicMergePatch(doc, p.merge, typed)
+		} else {
+			doc, err = jsonpatch.MergePatch(doc, p.merge)
+		}
+	}
+	if err != nil {
+		return err
+	}
+	patched := &unstructured.Unstructured{}
+	if err := patched.UnmarshalJSON(doc); err != nil {
+		return err
+	}
+	if patched.GroupVersionKind() != u.GroupVersionKind() || patched.GetNamespace() != u.GetNamespace() || patched.GetName() != u.GetName() {
+		return fmt.Errorf("the kind, namespace and name of the resource cannot be patched")
+	}
+	u.Object = patched.Object
+	return nil
+}
+
+// ApplyPostRenderPatches applies the patches to the resources they target
+func ApplyPostRenderPatches(patches []PostRenderPatch) mf.Transformer {
+	return func(u *unstructured.Unstructured) error {
+		for _, p := range patches {
+			if !p.matches(u) {
+				continue
+			}
+			if err := p.apply(u); err != nil {
+				return fmt.Errorf("failed to apply the post render patch %s to %s %s: %w", p.key, u.GetKind(), u.GetName(), err)
+			}
+		}
+		return nil
+	}
+}
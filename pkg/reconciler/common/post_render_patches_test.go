@@ -0,0 +1,144 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+
+	mf "github.com/manifestival/manifestival"
+	"gotest.tools/v3/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func postRenderTestManifest(t *testing.T) mf.Manifest {
+	t.Helper()
+	deployment := unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]interface{}{"name": "tekton-pipelines-webhook", "namespace": "tekton-pipelines"},
+		"spec": map[string]interface{}{
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"containers": []interface{}{
+						map[string]interface{}{"name": "webhook", "image": "webhook:v1", "args": []interface{}{"-v=1"}},
+						map[string]interface{}{"name": "proxy", "image": "proxy:v1"},
+					},
+				},
+			},
+		},
+	}}
+	task := unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "tekton.dev/v1",
+		"kind":       "Task",
+		"metadata":   map[string]interface{}{"name": "git-clone", "namespace": "tekton-pipelines"},
+		"spec":       map[string]interface{}{"description": "clones", "steps": []interface{}{"clone"}},
+	}}
+	manifest, err := mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{deployment, task}))
+	assert.NilError(t, err)
+	return manifest
+}
+
+func TestApplyPostRenderPatches(t *testing.T) {
+	patches, err := NewPostRenderPatchesFromMap(map[string]string{
+		// the strategic merge patch merges the containers by name
+		"01-webhook-memory.yaml": `
+target:
+  group: apps
+  kind: Deployment
+  name: tekton-pipelines-webhook
+patch: |
+  spec:
+    template:
+      spec:
+        containers:
+        - name: proxy
+          resources:
+            limits:
+              memory: 1Gi
+`,
+		"02-webhook-args.yaml": `
+target:
+  kind: Deployment
+  name: tekton-pipelines-webhook
+  namespace: tekton-pipelines
+patch: |
+  - op: add
+    path: /spec/template/spec/containers/0/args/-
+    value: -logtostderr
+`,
+		// a kind without a Go type is patched with a JSON merge patch, replacing the lists
+		"03-task.yaml": `
+target:
+  kind: Task
+  name: git-clone
+patch: |
+  spec:
+    steps:
+    - fetch
+`,
+		"04-other-namespace.yaml": `
+target:
+  kind: Task
+  name: git-clone
+  namespace: openshift-pipelines
+patch: |
+  spec:
+    description: not applied
+`,
+	})
+	assert.NilError(t, err)
+
+	patched, err := postRenderTestManifest(t).Transform(ApplyPostRenderPatches(patches))
+	assert.NilError(t, err)
+	deployment, task := patched.Resources()[0], patched.Resources()[1]
+
+	containers, _, _ := unstructured.NestedSlice(deployment.Object, "spec", "template", "spec", "containers")
+	assert.Equal(t, len(containers), 2)
+	webhook := containers[0].(map[string]interface{})
+	assert.DeepEqual(t, webhook["args"], []interface{}{"-v=1", "-logtostderr"})
+	memory, _, _ := unstructured.NestedString(containers[1].(map[string]interface{}), "resources", "limits", "memory")
+	assert.Equal(t, memory, "1Gi")
+
+	steps, _, _ := unstructured.NestedSlice(task.Object, "spec", "steps")
+	assert.DeepEqual(t, steps, []interface{}{"fetch"})
+	description, _, _ := unstructured.NestedString(task.Object, "spec", "description")
+	assert.Equal(t, description, "clones")
+}
+
+func TestApplyPostRenderPatchesErrors(t *testing.T) {
+	_, err := NewPostRenderPatchesFromMap(map[string]string{"patch": "target:\n  kind: Deployment\npatch: '{}'"})
+	assert.ErrorContains(t, err, "invalid post render patch patch: the kind and name of the target are required")
+
+	_, err = NewPostRenderPatchesFromMap(map[string]string{"patch": "target:\n  kind: Task\n  name: git-clone\npatch: clone"})
+	assert.ErrorContains(t, err, "must be a list of JSON6902 operations or a strategic merge patch")
+
+	_, err = NewPostRenderPatchesFromMap(map[string]string{"patch": "target:\n  kind: Task\n  name: git-clone\npatches: '{}'"})
+	assert.ErrorContains(t, err, "invalid post render patch patch")
+
+	patches, err := NewPostRenderPatchesFromMap(map[string]string{"rename": `
+target:
+  kind: Task
+  name: git-clone
+patch: |
+  - op: replace
+    path: /metadata/name
+    value: git-fetch
+`})
+	assert.NilError(t, err)
+	_, err = postRenderTestManifest(t).Transform(ApplyPostRenderPatches(patches))
+	assert.ErrorContains(t, err, "failed to apply the post render patch rename to Task git-clone: the kind, namespace and name of the resource cannot be patched")
+}
@@ -16,15 +16,42 @@ limitations under the License.
 package common
 
 import (
+	"fmt"
 	"os"
 	"sort"
+	"strings"
 
+	mf "github.com/manifestival/manifestival"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/pod"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+)
+
+// NoProxyAnnotation set on a deployment lists the hosts, comma separated, appended to the NO_PROXY
+// of the operator when a proxy is configured, e.g. the in-cluster services called by the deployment
+const NoProxyAnnotation = "operator.tekton.dev/no-proxy"
+
+const (
+	// ProxyAnnotation set to disabled on a deployment removes the proxy environment variables of
+	// the operator from the deployment, it is set when the control plane proxy is disabled
+	ProxyAnnotation = "operator.tekton.dev/proxy"
+	ProxyDisabled   = "disabled"
+
+	// WorkloadProxyEnvKey set to false on the proxy webhook stops the injection of the proxy
+	// environment variables in the pods of the pipelines, the CA bundles are still injected
+	WorkloadProxyEnvKey = "WORKLOAD_PROXY"
+
+	// ProxyWebhookDeployment is the deployment of the proxy webhook, it holds the proxy injected
+	// in the pods of the pipelines and is part of the workload proxy
+	ProxyWebhookDeployment = "tekton-operator-proxy-webhook"
 )
 
 // ApplyProxySettings is a transformer that propagate any proxy environment variables
 // set on the operator deployment to the underlying deployment.
+// When the deployment references the proxy credentials of the config, the authenticated proxy
+// variables are referenced from the tekton-proxy-credentials secret instead of being set inline.
 func ApplyProxySettings(u *unstructured.Unstructured) error {
 	if u.GetKind() != "Deployment" {
 		// Don't do anything on something else than Deployment
@@ -39,9 +66,12 @@ func ApplyProxySettings(u *unstructured.Unstructured) error {
 		Value: os.Getenv("HTTP_PROXY"),
 	}, {
 		Name:  "NO_PROXY",
-		Value: os.Getenv("NO_PROXY"),
+		Value: noProxy(os.Getenv("NO_PROXY"), u.GetAnnotations()[NoProxyAnnotation]),
 	}}
 
+	authenticated := u.GetAnnotations()[ProxyCredentialsAnnotation] != ""
+	disabled := u.GetAnnotations()[ProxyAnnotation] == ProxyDisabled
+
 	m := u.Object
 	containers, found, err := unstructured.NestedSlice(m, "spec", "template", "spec", "containers")
 	if err != nil {
@@ -57,12 +87,17 @@ func ApplyProxySettings(u *unstructured.Unstructured) error {
 			return err
 		}
 		for _, e := range proxyEnv {
-			if e.Value == "" {
+			if e.Value == "" || disabled {
 				// Remove existing envvar if they are not set.
 				// This probably means the proxy configuration has been removed
+				// or the control plane proxy is disabled
 				delete(envs, e.Name)
 				continue
 			}
+			if authenticated && isAuthenticatedProxyEnv(e.Name) {
+				envs[e.Name] = proxyCredentialsEnv(e.Name)
+				continue
+			}
 			envs[e.Name] = e.Value
 		}
 		if len(envs) == 0 {
@@ -79,6 +114,174 @@ func ApplyProxySettings(u *unstructured.Unstructured) error {
 	return nil
 }
 
+// ControlPlaneProxyEnabled returns true unless the proxy of the deployments of the components is disabled
+func ControlPlaneProxyEnabled(config v1alpha1.Config) bool {
+	return config.ControlPlaneProxy == nil || *config.ControlPlaneProxy
+}
+
+// WorkloadProxyEnabled returns true unless the proxy of the pods of the pipelines is disabled
+func WorkloadProxyEnabled(config v1alpha1.Config) bool {
+	return config.WorkloadProxy == nil || *config.WorkloadProxy
+}
+
+// ControlPlaneProxy is a transformer annotating the deployments of the components, except the proxy
+// webhook, so that ApplyProxySettings removes the proxy when the control plane proxy is disabled, and
+// references the proxy credentials of the config
+func ControlPlaneProxy(config v1alpha1.Config) mf.Transformer {
+	return func(u *unstructured.Unstructured) error {
+		if u.GetKind() != "Deployment" || u.GetName() == ProxyWebhookDeployment {
+			return nil
+		}
+		annotations := u.GetAnnotations()
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		if ControlPlaneProxyEnabled(config) {
+			delete(annotations, ProxyAnnotation)
+		} else {
+			annotations[ProxyAnnotation] = ProxyDisabled
+		}
+		if config.ProxyCredentials != nil && config.ProxyCredentials.Name != "" {
+			annotations[ProxyCredentialsAnnotation] = config.ProxyCredentials.Name
+		} else {
+			delete(annotations, ProxyCredentialsAnnotation)
+		}
+		if len(annotations) == 0 {
+			annotations = nil
+		}
+		u.SetAnnotations(annotations)
+		return nil
+	}
+}
+
+// WorkloadProxy is a transformer setting on the proxy webhook whether the proxy is injected in the
+// pods of the pipelines
+func WorkloadProxy(config v1alpha1.Config) mf.Transformer {
+	return func(u *unstructured.Unstructured) error {
+		if u.GetKind() != "Deployment" || u.GetName() != ProxyWebhookDeployment {
+			return nil
+		}
+		containers, found, err := unstructured.NestedSlice(u.Object, "spec", "template", "spec", "containers")
+		if err != nil || !found {
+			return err
+		}
+		for _, c := range containers {
+			envs, err := extractEnvs(c.(map[string]interface{}))
+			if err != nil {
+				return err
+			}
+			if WorkloadProxyEnabled(config) {
+				delete(envs, WorkloadProxyEnvKey)
+			} else {
+				envs[WorkloadProxyEnvKey] = "false"
+			}
+			if len(envs) == 0 {
+				unstructured.RemoveNestedField(c.(map[string]interface{}), "env")
+			} else if err := unstructured.SetNestedSlice(c.(map[string]interface{}), toUnstructured(envs), "env"); err != nil {
+				return err
+			}
+		}
+		return unstructured.SetNestedSlice(u.Object, containers, "spec", "template", "spec", "containers")
+	}
+}
+
+// ProxyEnv returns the proxy environment variables set on the operator, without their
+// credentials, as injected in the pods of the pipelines
+func ProxyEnv() []corev1.EnvVar {
+	env := []corev1.EnvVar{}
+	for _, name := range []string{"HTTPS_PROXY", "HTTP_PROXY", "NO_PROXY"} {
+		value := os.Getenv(name)
+		if name != "NO_PROXY" {
+			value = StripProxyCredentials(value)
+		}
+		if value != "" {
+			env = append(env, corev1.EnvVar{Name: name, Value: value})
+		}
+	}
+	return env
+}
+
+// DefaultPodTemplateWithProxy returns the default pod template with the proxy environment
+// variables of the operator merged in its env, the variables already set in the template are kept
+func DefaultPodTemplateWithProxy(podTemplate string) (string, error) {
+	proxyEnv := ProxyEnv()
+	if len(proxyEnv) == 0 {
+		return podTemplate, nil
+	}
+	template := &pod.Template{}
+	if err := yaml.UnmarshalStrict([]byte(podTemplate), template); err != nil {
+		return "", fmt.Errorf("failed to parse the default pod template: %w", err)
+	}
+	template.Env = mergeEnvByName(template.Env, proxyEnv)
+	out, err := yaml.Marshal(template)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(string(out), "\n"), nil
+}
+
+// mergeEnvByName returns the variables of env followed by the variables of defaults which are not
+// in env. The variables are deduplicated by name, the last definition of a variable wins as in
+// the containers.
+func mergeEnvByName(env, defaults []corev1.EnvVar) []corev1.EnvVar {
+	index := map[string]int{}
+	merged := make([]corev1.EnvVar, 0, len(env)+len(defaults))
+	for _, e := range env {
+		if i, ok := index[e.Name]; ok {
+			merged[i] = e
+			continue
+		}
+		index[e.Name] = len(merged)
+		merged = append(merged, e)
+	}
+	for _, e := range defaults {
+		if _, ok := index[e.Name]; !ok {
+			merged = append(merged, e)
+		}
+	}
+	return merged
+}
+
+// noProxy appends the hosts of the deployment to the NO_PROXY of the operator, they are only
+// needed when a proxy is configured
+func noProxy(operatorNoProxy, deploymentNoProxy string) string {
+	if deploymentNoProxy == "" || (os.Getenv("HTTPS_PROXY") == "" && os.Getenv("HTTP_PROXY") == "") {
+		return operatorNoProxy
+	}
+	hosts := []string{}
+	seen := map[string]bool{}
+	for _, host := range strings.Split(operatorNoProxy+","+deploymentNoProxy, ",") {
+		host = strings.TrimSpace(host)
+		if host == "" || seen[host] {
+			continue
+		}
+		seen[host] = true
+		hosts = append(hosts, host)
+	}
+	return strings.Join(hosts, ",")
+}
+
+func isAuthenticatedProxyEnv(name string) bool {
+	for _, n := range authenticatedProxyEnvs {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+func proxyCredentialsEnv(name string) map[string]interface{} {
+	return map[string]interface{}{
+		"name": name,
+		"valueFrom": map[string]interface{}{
+			"secretKeyRef": map[string]interface{}{
+				"name": ProxyCredentialsSecretName,
+				"key":  name,
+			},
+		},
+	}
+}
+
 func extractEnvs(uc map[string]interface{}) (map[string]interface{}, error) {
 	currentEnv, found, err := unstructured.NestedSlice(uc, "env")
 	if err != nil {
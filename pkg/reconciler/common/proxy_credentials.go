@@ -0,0 +1,279 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/kubernetes"
+	"knative.dev/pkg/system"
+)
+
+const (
+	// ProxyCredentialsAnnotation set on a deployment names the secret of the operator namespace
+	// holding the proxy credentials, from spec.config.proxyCredentials
+	ProxyCredentialsAnnotation = "operator.tekton.dev/proxy-credentials-secret"
+	// ProxyCredentialsUsernameKey and ProxyCredentialsPasswordKey are the keys
+	// of the credentials in the secret
+	ProxyCredentialsUsernameKey = "username"
+	ProxyCredentialsPasswordKey = "password"
+
+	// ProxyCredentialsSecretName is the secret rendered in the namespace of the
+	// components, it holds the proxy urls including the credentials
+	ProxyCredentialsSecretName = "tekton-proxy-credentials"
+	// ProxyCredentialsHashAnnotation holds the checksum of the rendered secret on the pod
+	// template of the deployments referencing it, so that rotated credentials roll them
+	ProxyCredentialsHashAnnotation = "operator.tekton.dev/proxy-credentials-hash"
+
+	redactedUserInfo          = "xxxxx"
+	proxyCredentialsCreatedBy = "TektonInstallerSet"
+)
+
+// authenticatedProxyEnvs are the proxy variables which carry credentials
+var authenticatedProxyEnvs = []string{"HTTPS_PROXY", "HTTP_PROXY"}
+
+// ProxyCredentialsSource returns the name of the secret holding the proxy credentials of a
+// deployment referencing the rendered tekton-proxy-credentials secret, or an empty string
+func ProxyCredentialsSource(u *unstructured.Unstructured) (string, error) {
+	source := u.GetAnnotations()[ProxyCredentialsAnnotation]
+	if u.GetKind() != "Deployment" || source == "" {
+		return "", nil
+	}
+	referenced, err := referencesProxyCredentials(u)
+	if err != nil || !referenced {
+		return "", err
+	}
+	return source, nil
+}
+
+// EnsureProxyCredentials renders the proxy urls with the credentials from the source
+// secret into the tekton-proxy-credentials secret of the given namespace, components
+// reference the rendered secret so the credentials never show up in deployment specs
+// or installer sets. The rendered secret is owned by the given owners, the installer sets
+// of the deployments referencing it. It returns the checksum of the rendered data.
+func EnsureProxyCredentials(ctx context.Context, kubeClient kubernetes.Interface, namespace, sourceName string, owners []metav1.OwnerReference) (string, error) {
+	source, err := kubeClient.CoreV1().Secrets(system.Namespace()).Get(ctx, sourceName, metav1.GetOptions{})
+	if err != nil {
+		return "", fmt.Errorf("failed to get proxy credentials secret %s/%s: %w", system.Namespace(), sourceName, err)
+	}
+	username := strings.TrimSpace(string(source.Data[ProxyCredentialsUsernameKey]))
+	if username == "" {
+		return "", fmt.Errorf("proxy credentials secret %s/%s has no %q key", system.Namespace(), sourceName, ProxyCredentialsUsernameKey)
+	}
+	password := strings.TrimSpace(string(source.Data[ProxyCredentialsPasswordKey]))
+
+	data := map[string][]byte{}
+	for _, name := range authenticatedProxyEnvs {
+		value := os.Getenv(name)
+		if value == "" {
+			continue
+		}
+		authenticated, err := withProxyUserInfo(value, url.UserPassword(username, password))
+		if err != nil {
+			return "", fmt.Errorf("invalid %s: %w", name, err)
+		}
+		data[name] = []byte(authenticated)
+	}
+	checksum := secretDataChecksum(data)
+
+	existing, err := kubeClient.CoreV1().Secrets(namespace).Get(ctx, ProxyCredentialsSecretName, metav1.GetOptions{})
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return "", err
+		}
+		_, err = kubeClient.CoreV1().Secrets(namespace).Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            ProxyCredentialsSecretName,
+				Namespace:       namespace,
+				Labels:          proxyCredentialsLabels(),
+				OwnerReferences: withProxyCredentialsOwners(nil, owners),
+			},
+			Type: corev1.SecretTypeOpaque,
+			Data: data,
+		}, metav1.CreateOptions{})
+		return checksum, err
+	}
+	ownerReferences := withProxyCredentialsOwners(existing.OwnerReferences, owners)
+	if secretDataEqual(existing.Data, data) && len(ownerReferences) == len(existing.OwnerReferences) &&
+		existing.Labels[v1alpha1.CreatedByKey] == proxyCredentialsCreatedBy {
+		return checksum, nil
+	}
+	existing.Data = data
+	existing.OwnerReferences = ownerReferences
+	if existing.Labels == nil {
+		existing.Labels = map[string]string{}
+	}
+	for k, v := range proxyCredentialsLabels() {
+		existing.Labels[k] = v
+	}
+	_, err = kubeClient.CoreV1().Secrets(namespace).Update(ctx, existing, metav1.UpdateOptions{})
+	return checksum, err
+}
+
+func proxyCredentialsLabels() map[string]string {
+	return map[string]string{
+		"app.kubernetes.io/part-of": "tekton-pipelines",
+		v1alpha1.CreatedByKey:       proxyCredentialsCreatedBy,
+	}
+}
+
+// withProxyCredentialsOwners adds the missing owners to the owner references of the rendered
+// secret, it is shared by the installer sets of a namespace so none of them is its controller
+// and the secret is garbage collected with the last of them
+func withProxyCredentialsOwners(refs, owners []metav1.OwnerReference) []metav1.OwnerReference {
+	for _, owner := range owners {
+		found := false
+		for _, ref := range refs {
+			if ref.UID == owner.UID {
+				found = true
+				break
+			}
+		}
+		if !found {
+			refs = append(refs, metav1.OwnerReference{
+				APIVersion: owner.APIVersion,
+				Kind:       owner.Kind,
+				Name:       owner.Name,
+				UID:        owner.UID,
+			})
+		}
+	}
+	return refs
+}
+
+// SetProxyCredentialsHash sets the checksum of the rendered proxy credentials on the pod
+// template of a deployment referencing them, the pods are rolled when the checksum changes
+func SetProxyCredentialsHash(u *unstructured.Unstructured, checksum string) error {
+	if u.GetKind() != "Deployment" || checksum == "" {
+		return nil
+	}
+	referenced, err := referencesProxyCredentials(u)
+	if err != nil || !referenced {
+		return err
+	}
+	annotations, _, err := unstructured.NestedStringMap(u.Object, "spec", "template", "metadata", "annotations")
+	if err != nil {
+		return err
+	}
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[ProxyCredentialsHashAnnotation] = checksum
+	return unstructured.SetNestedStringMap(u.Object, annotations, "spec", "template", "metadata", "annotations")
+}
+
+// referencesProxyCredentials returns true if a container of the deployment has an environment
+// variable from the rendered tekton-proxy-credentials secret
+func referencesProxyCredentials(u *unstructured.Unstructured) (bool, error) {
+	containers, _, err := unstructured.NestedSlice(u.Object, "spec", "template", "spec", "containers")
+	if err != nil {
+		return false, err
+	}
+	for _, c := range containers {
+		container, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		envs, _, _ := unstructured.NestedSlice(container, "env")
+		for _, e := range envs {
+			env, ok := e.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if name, _, _ := unstructured.NestedString(env, "valueFrom", "secretKeyRef", "name"); name == ProxyCredentialsSecretName {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// RedactProxyURL hides the credentials of a proxy url, it is meant for logs and status
+func RedactProxyURL(value string) string {
+	if !strings.Contains(value, "@") {
+		return value
+	}
+	u, err := url.Parse(value)
+	if err != nil || u.User == nil {
+		// do not risk leaking anything which looks like credentials
+		return redactedUserInfo
+	}
+	u.User = url.User(redactedUserInfo)
+	return u.String()
+}
+
+// StripProxyCredentials removes the credentials from a proxy url
+func StripProxyCredentials(value string) string {
+	if !strings.Contains(value, "@") {
+		return value
+	}
+	stripped, err := withProxyUserInfo(value, nil)
+	if err != nil {
+		return ""
+	}
+	return stripped
+}
+
+func withProxyUserInfo(value string, userInfo *url.Userinfo) (string, error) {
+	u, err := url.Parse(value)
+	if err != nil || u.Host == "" {
+		// the parse error includes the url, which can include credentials
+		return "", fmt.Errorf("proxy url %q is not a valid url", RedactProxyURL(value))
+	}
+	u.User = userInfo
+	return u.String(), nil
+}
+
+// secretDataChecksum is the sha256 of the secret data, in the order of its keys
+func secretDataChecksum(data map[string][]byte) string {
+	keys := make([]string, 0, len(data))
+	for k := range data {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	h := sha256.New()
+	for _, k := range keys {
+		fmt.Fprintf(h, "%s=%d:", k, len(data[k]))
+		h.Write(data[k])
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func secretDataEqual(a, b map[string][]byte) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if !bytes.Equal(v, b[k]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,193 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+	"golang.org/x/time/rate"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	utilnet "k8s.io/apimachinery/pkg/util/net"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
+	"knative.dev/pkg/logging"
+	"knative.dev/pkg/metrics"
+)
+
+// ErrorClass is the class of an error returned by the API server, it selects the retry policy
+type ErrorClass string
+
+const (
+	// ErrorClassConflict is an update of a stale version of the object
+	ErrorClassConflict ErrorClass = "conflict"
+	// ErrorClassForbidden is a request denied by the RBAC of the operator
+	ErrorClassForbidden ErrorClass = "forbidden"
+	// ErrorClassQuota is a request denied by a ResourceQuota
+	ErrorClassQuota ErrorClass = "quota"
+	// ErrorClassWebhookTimeout is a request the API server could not admit, as an admission
+	// webhook did not respond in time or was unreachable
+	ErrorClassWebhookTimeout ErrorClass = "webhook-timeout"
+	// ErrorClassTransient is a timeout, a throttled request or a lost connection to the API server
+	ErrorClassTransient ErrorClass = "transient"
+	// ErrorClassPermanent is any other error, retrying would fail the same way
+	ErrorClassPermanent ErrorClass = "permanent"
+)
+
+// retryPolicies are the backoffs of the retried error classes, errors of the other classes are
+// returned at once. Forbidden and quota errors only go away when an administrator changes the
+// cluster, the next reconcile picks them up.
+var retryPolicies = map[ErrorClass]wait.Backoff{
+	ErrorClassConflict:       retry.DefaultRetry,
+	ErrorClassWebhookTimeout: {Steps: 4, Duration: 500 * time.Millisecond, Factor: 2.0, Jitter: 0.1},
+	ErrorClassTransient:      {Steps: 5, Duration: 200 * time.Millisecond, Factor: 2.0, Jitter: 0.1},
+}
+
+// retryLimiter is shared by all the retries of the operator, so that a degraded API server is
+// not flooded with retries from every reconciler at once
+var retryLimiter = rate.NewLimiter(rate.Limit(10), 20)
+
+var (
+	retryCount = stats.Int64("retry_count",
+		"number of API requests retried or given up by the operator",
+		stats.UnitDimensionless)
+	retryOperationKey = tag.MustNewKey("operation")
+	retryClassKey     = tag.MustNewKey("class")
+	retryOutcomeKey   = tag.MustNewKey("outcome")
+	registerRetryView = sync.OnceValue(func() error {
+		return view.Register(&view.View{
+			Description: retryCount.Description(),
+			Measure:     retryCount,
+			Aggregation: view.Count(),
+			TagKeys:     []tag.Key{retryOperationKey, retryClassKey, retryOutcomeKey},
+		})
+	})
+)
+
+const (
+	retryOutcomeRetried   = "retried"
+	retryOutcomeExhausted = "exhausted"
+	retryOutcomeFailed    = "not-retried"
+)
+
+// ClassifyError returns the class of an error returned by a request to the API server
+func ClassifyError(err error) ErrorClass {
+	switch {
+	case err == nil:
+		return ""
+	case apierrors.IsConflict(err):
+		return ErrorClassConflict
+	case apierrors.IsForbidden(err) && strings.Contains(err.Error(), "exceeded quota"):
+		return ErrorClassQuota
+	case apierrors.IsForbidden(err):
+		return ErrorClassForbidden
+	case strings.Contains(err.Error(), "failed calling webhook"):
+		return ErrorClassWebhookTimeout
+	case apierrors.IsServerTimeout(err), apierrors.IsTimeout(err), apierrors.IsTooManyRequests(err),
+		apierrors.IsServiceUnavailable(err), apierrors.IsInternalError(err):
+		return ErrorClassTransient
+	case errors.Is(err, io.EOF), errors.Is(err, io.ErrUnexpectedEOF), utilnet.IsConnectionRefused(err),
+		utilnet.IsConnectionReset(err), utilnet.IsProbableEOF(err), utilnet.IsHTTP2ConnectionLost(err):
+		return ErrorClassTransient
+	}
+	var netErr interface{ Timeout() bool }
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return ErrorClassTransient
+	}
+	return ErrorClassPermanent
+}
+
+// Retry calls fn until it succeeds, returns an error which is not retried or the retry policy
+// of the error class is exhausted. fn should read the object again before updating it, so that
+// conflicts can be resolved. The last error of fn is returned.
+func Retry(ctx context.Context, operation string, fn func() error) error {
+	return retryClasses(ctx, operation, fn, nil)
+}
+
+// RetryNonIdempotent is Retry for requests which must not be sent twice, such as the creation of
+// an object with a generated name. Only the errors of requests which were rejected before being
+// persisted are retried, a lost connection may hide a request which succeeded.
+func RetryNonIdempotent(ctx context.Context, operation string, fn func() error) error {
+	return retryClasses(ctx, operation, fn, func(err error) bool {
+		return apierrors.IsTooManyRequests(err) || apierrors.IsServiceUnavailable(err)
+	})
+}
+
+// retryClasses retries fn, when transient is not nil only the transient errors it accepts are retried
+func retryClasses(ctx context.Context, operation string, fn func() error, transient func(error) bool) error {
+	logger := logging.FromContext(ctx)
+	backoffs := map[ErrorClass]*wait.Backoff{}
+	for {
+		err := fn()
+		if err == nil {
+			return nil
+		}
+		class := ClassifyError(err)
+		policy, ok := retryPolicies[class]
+		if !ok || (class == ErrorClassTransient && transient != nil && !transient(err)) {
+			recordRetry(ctx, operation, class, retryOutcomeFailed)
+			return err
+		}
+		backoff, ok := backoffs[class]
+		if !ok {
+			backoff = &policy
+			backoffs[class] = backoff
+		}
+		if backoff.Steps <= 1 {
+			recordRetry(ctx, operation, class, retryOutcomeExhausted)
+			return err
+		}
+		delay := backoff.Step()
+		if seconds, ok := apierrors.SuggestsClientDelay(err); ok && time.Duration(seconds)*time.Second > delay {
+			delay = time.Duration(seconds) * time.Second
+		}
+		recordRetry(ctx, operation, class, retryOutcomeRetried)
+		logger.Debugf("retrying %s in %v after %s error: %v", operation, delay, class, err)
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+		if werr := retryLimiter.Wait(ctx); werr != nil {
+			return err
+		}
+	}
+}
+
+func recordRetry(ctx context.Context, operation string, class ErrorClass, outcome string) {
+	if err := registerRetryView(); err != nil {
+		return
+	}
+	ctx, err := tag.New(ctx,
+		tag.Insert(retryOperationKey, operation),
+		tag.Insert(retryClassKey, string(class)),
+		tag.Insert(retryOutcomeKey, outcome))
+	if err != nil {
+		return
+	}
+	metrics.Record(ctx, retryCount.M(1))
+}
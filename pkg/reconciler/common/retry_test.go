@@ -0,0 +1,158 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"syscall"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+var rbResource = schema.GroupResource{Group: "rbac.authorization.k8s.io", Resource: "rolebindings"}
+
+func TestClassifyError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want ErrorClass
+	}{
+		{name: "nil", err: nil, want: ""},
+		{name: "conflict", err: apierrors.NewConflict(rbResource, "edit", errors.New("object was modified")), want: ErrorClassConflict},
+		{name: "forbidden", err: apierrors.NewForbidden(rbResource, "edit", errors.New("cannot update")), want: ErrorClassForbidden},
+		{name: "quota", err: apierrors.NewForbidden(rbResource, "edit", errors.New("exceeded quota: compute, requested: pods=1")), want: ErrorClassQuota},
+		{name: "webhook timeout", err: apierrors.NewInternalError(errors.New(`failed calling webhook "validation.webhook.pipeline.tekton.dev": context deadline exceeded`)), want: ErrorClassWebhookTimeout},
+		{name: "server timeout", err: apierrors.NewServerTimeout(rbResource, "update", 1), want: ErrorClassTransient},
+		{name: "too many requests", err: apierrors.NewTooManyRequests("slow down", 1), want: ErrorClassTransient},
+		{name: "unavailable", err: apierrors.NewServiceUnavailable("unavailable"), want: ErrorClassTransient},
+		{name: "connection refused", err: fmt.Errorf("dial tcp: %w", syscall.ECONNREFUSED), want: ErrorClassTransient},
+		{name: "eof", err: fmt.Errorf("get: %w", io.EOF), want: ErrorClassTransient},
+		{name: "not found", err: apierrors.NewNotFound(rbResource, "edit"), want: ErrorClassPermanent},
+		{name: "invalid", err: apierrors.NewBadRequest("invalid"), want: ErrorClassPermanent},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, ClassifyError(test.err), test.want)
+		})
+	}
+}
+
+func fastRetryPolicies(t *testing.T) {
+	saved := retryPolicies
+	retryPolicies = map[ErrorClass]wait.Backoff{
+		ErrorClassConflict:       {Steps: 3, Duration: time.Millisecond},
+		ErrorClassWebhookTimeout: {Steps: 2, Duration: time.Millisecond},
+		ErrorClassTransient:      {Steps: 3, Duration: time.Millisecond},
+	}
+	t.Cleanup(func() { retryPolicies = saved })
+}
+
+func TestRetry(t *testing.T) {
+	fastRetryPolicies(t)
+	ctx := context.TODO()
+	conflict := apierrors.NewConflict(rbResource, "edit", errors.New("object was modified"))
+	refused := fmt.Errorf("dial tcp: %w", syscall.ECONNREFUSED)
+
+	calls := 0
+	err := Retry(ctx, "update rolebinding", func() error {
+		calls++
+		if calls < 3 {
+			return conflict
+		}
+		return nil
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, calls, 3)
+
+	// the policy of the class is exhausted
+	calls = 0
+	err = Retry(ctx, "update rolebinding", func() error {
+		calls++
+		return conflict
+	})
+	assert.Assert(t, apierrors.IsConflict(err))
+	assert.Equal(t, calls, 3)
+
+	// each class has its own policy
+	calls = 0
+	err = Retry(ctx, "update rolebinding", func() error {
+		calls++
+		if calls%2 == 0 {
+			return conflict
+		}
+		return refused
+	})
+	assert.Assert(t, errors.Is(err, syscall.ECONNREFUSED))
+	assert.Equal(t, calls, 5)
+
+	// forbidden errors are not retried
+	calls = 0
+	err = Retry(ctx, "update rolebinding", func() error {
+		calls++
+		return apierrors.NewForbidden(rbResource, "edit", errors.New("cannot update"))
+	})
+	assert.Assert(t, apierrors.IsForbidden(err))
+	assert.Equal(t, calls, 1)
+
+	// the retries stop with the context
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	calls = 0
+	err = Retry(cancelled, "update rolebinding", func() error {
+		calls++
+		return refused
+	})
+	assert.Assert(t, errors.Is(err, syscall.ECONNREFUSED))
+	assert.Equal(t, calls, 1)
+}
+
+func TestRetryNonIdempotent(t *testing.T) {
+	fastRetryPolicies(t)
+	ctx := context.TODO()
+
+	// a lost connection may hide a created object
+	calls := 0
+	err := RetryNonIdempotent(ctx, "create installer set", func() error {
+		calls++
+		return fmt.Errorf("dial tcp: %w", syscall.ECONNRESET)
+	})
+	assert.Assert(t, err != nil)
+	assert.Equal(t, calls, 1)
+
+	// throttled and rejected requests are retried
+	calls = 0
+	err = RetryNonIdempotent(ctx, "create installer set", func() error {
+		calls++
+		switch calls {
+		case 1:
+			return apierrors.NewServiceUnavailable("unavailable")
+		case 2:
+			return apierrors.NewInternalError(errors.New(`failed calling webhook "webhook.operator.tekton.dev": context deadline exceeded`))
+		}
+		return nil
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, calls, 3)
+}
@@ -0,0 +1,403 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package fixtures snapshots the namespaces, SecurityContextConstraints and RBAC of a cluster
+// into a bundle of fixtures, which are loaded into the fake clients of the tests to reproduce
+// the reconciliation of the RBAC of a cluster locally.
+package fixtures
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	securityv1 "github.com/openshift/api/security/v1"
+	security "github.com/openshift/client-go/security/clientset/versioned"
+	fakesecurity "github.com/openshift/client-go/security/clientset/versioned/fake"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/kubernetes"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	serviceAccountUserPrefix   = "system:serviceaccount:"
+	serviceAccountsGroupPrefix = "system:serviceaccounts:"
+)
+
+// Bundle holds the fixtures of a cluster
+type Bundle struct {
+	Namespaces                 []corev1.Namespace
+	SecurityContextConstraints []securityv1.SecurityContextConstraints
+	ServiceAccounts            []corev1.ServiceAccount
+	Roles                      []rbacv1.Role
+	RoleBindings               []rbacv1.RoleBinding
+	ClusterRoles               []rbacv1.ClusterRole
+	ClusterRoleBindings        []rbacv1.ClusterRoleBinding
+}
+
+// Options selects the state of the cluster which is snapshotted
+type Options struct {
+	// LabelSelector selects the namespaces, all the namespaces by default
+	LabelSelector string
+	// IncludeSystemNamespaces includes the namespaces ignored by the operator, such as kube-system
+	IncludeSystemNamespaces bool
+	// Anonymize renames the namespaces, and the users and groups of their ServiceAccounts
+	Anonymize bool
+}
+
+// Snapshot returns the namespaces of the cluster with their ServiceAccounts, Roles and RoleBindings,
+// the ClusterRoles they bind and the ClusterRoleBindings of their ServiceAccounts. The
+// SecurityContextConstraints are snapshotted when securityClient is not nil and the cluster serves them.
+func Snapshot(ctx context.Context, kubeClient kubernetes.Interface, securityClient security.Interface, opts Options) (*Bundle, error) {
+	bundle := &Bundle{}
+	namespaces, err := kubeClient.CoreV1().Namespaces().List(ctx, metav1.ListOptions{LabelSelector: opts.LabelSelector})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the namespaces: %w", err)
+	}
+	ignorePattern := regexp.MustCompile(common.NamespaceIgnorePattern)
+	selected := sets.New[string]()
+	for _, ns := range namespaces.Items {
+		if !opts.IncludeSystemNamespaces && ignorePattern.MatchString(ns.Name) {
+			continue
+		}
+		selected.Insert(ns.Name)
+		bundle.Namespaces = append(bundle.Namespaces, ns)
+	}
+
+	clusterRoles := sets.New[string]()
+	for _, ns := range sets.List(selected) {
+		sas, err := kubeClient.CoreV1().ServiceAccounts(ns).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list the serviceaccounts of namespace %s: %w", ns, err)
+		}
+		bundle.ServiceAccounts = append(bundle.ServiceAccounts, sas.Items...)
+		roles, err := kubeClient.RbacV1().Roles(ns).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list the roles of namespace %s: %w", ns, err)
+		}
+		bundle.Roles = append(bundle.Roles, roles.Items...)
+		rbs, err := kubeClient.RbacV1().RoleBindings(ns).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list the rolebindings of namespace %s: %w", ns, err)
+		}
+		for _, rb := range rbs.Items {
+			if rb.RoleRef.Kind == "ClusterRole" {
+				clusterRoles.Insert(rb.RoleRef.Name)
+			}
+		}
+		bundle.RoleBindings = append(bundle.RoleBindings, rbs.Items...)
+	}
+
+	crbs, err := kubeClient.RbacV1().ClusterRoleBindings().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the clusterrolebindings: %w", err)
+	}
+	for _, crb := range crbs.Items {
+		if !bindsNamespaces(crb.Subjects, selected) {
+			continue
+		}
+		clusterRoles.Insert(crb.RoleRef.Name)
+		bundle.ClusterRoleBindings = append(bundle.ClusterRoleBindings, crb)
+	}
+	for _, name := range sets.List(clusterRoles) {
+		cr, err := kubeClient.RbacV1().ClusterRoles().Get(ctx, name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the clusterrole %s: %w", name, err)
+		}
+		bundle.ClusterRoles = append(bundle.ClusterRoles, *cr)
+	}
+
+	if securityClient != nil {
+		sccs, err := securityClient.SecurityV1().SecurityContextConstraints().List(ctx, metav1.ListOptions{})
+		// the SecurityContextConstraints are only served on OpenShift
+		if err != nil && !apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("failed to list the securitycontextconstraints: %w", err)
+		}
+		if err == nil {
+			bundle.SecurityContextConstraints = sccs.Items
+		}
+	}
+
+	bundle.clean()
+	if opts.Anonymize {
+		bundle.anonymize()
+	}
+	return bundle, nil
+}
+
+// bindsNamespaces returns true when one of the subjects is a ServiceAccount of the namespaces
+func bindsNamespaces(subjects []rbacv1.Subject, namespaces sets.Set[string]) bool {
+	for _, s := range subjects {
+		if s.Kind == rbacv1.ServiceAccountKind && namespaces.Has(s.Namespace) {
+			return true
+		}
+	}
+	return false
+}
+
+// clean sets the type of the objects and removes the fields set by the API server, so that the
+// objects can be created in the fake clients
+func (b *Bundle) clean() {
+	for _, obj := range b.objects() {
+		m, _ := obj.(metav1.Object)
+		m.SetResourceVersion("")
+		m.SetUID("")
+		m.SetGeneration(0)
+		m.SetManagedFields(nil)
+		m.SetCreationTimestamp(metav1.Time{})
+		m.SetSelfLink("")
+	}
+	for i := range b.Namespaces {
+		b.Namespaces[i].TypeMeta = metav1.TypeMeta{APIVersion: "v1", Kind: "Namespace"}
+	}
+	for i := range b.SecurityContextConstraints {
+		b.SecurityContextConstraints[i].TypeMeta = metav1.TypeMeta{APIVersion: securityv1.GroupVersion.String(), Kind: "SecurityContextConstraints"}
+	}
+	for i := range b.ServiceAccounts {
+		b.ServiceAccounts[i].TypeMeta = metav1.TypeMeta{APIVersion: "v1", Kind: "ServiceAccount"}
+	}
+	for i := range b.Roles {
+		b.Roles[i].TypeMeta = metav1.TypeMeta{APIVersion: rbacv1.SchemeGroupVersion.String(), Kind: "Role"}
+	}
+	for i := range b.RoleBindings {
+		b.RoleBindings[i].TypeMeta = metav1.TypeMeta{APIVersion: rbacv1.SchemeGroupVersion.String(), Kind: "RoleBinding"}
+	}
+	for i := range b.ClusterRoles {
+		b.ClusterRoles[i].TypeMeta = metav1.TypeMeta{APIVersion: rbacv1.SchemeGroupVersion.String(), Kind: "ClusterRole"}
+	}
+	for i := range b.ClusterRoleBindings {
+		b.ClusterRoleBindings[i].TypeMeta = metav1.TypeMeta{APIVersion: rbacv1.SchemeGroupVersion.String(), Kind: "ClusterRoleBinding"}
+	}
+}
+
+// anonymize renames the namespaces ns-0001, ns-0002... in their sorted order, along with the
+// subjects of the bindings and the users and groups of the SecurityContextConstraints
+func (b *Bundle) anonymize() {
+	names := make([]string, 0, len(b.Namespaces))
+	for _, ns := range b.Namespaces {
+		names = append(names, ns.Name)
+	}
+	sort.Strings(names)
+	renamed := map[string]string{}
+	for i, name := range names {
+		renamed[name] = fmt.Sprintf("ns-%04d", i+1)
+	}
+	rename := func(name string) string {
+		if r, ok := renamed[name]; ok {
+			return r
+		}
+		return name
+	}
+	renameUser := func(user string) string {
+		if rest, ok := strings.CutPrefix(user, serviceAccountUserPrefix); ok {
+			if ns, sa, ok := strings.Cut(rest, ":"); ok {
+				return serviceAccountUserPrefix + rename(ns) + ":" + sa
+			}
+		}
+		return user
+	}
+	renameGroup := func(group string) string {
+		if ns, ok := strings.CutPrefix(group, serviceAccountsGroupPrefix); ok {
+			return serviceAccountsGroupPrefix + rename(ns)
+		}
+		return group
+	}
+	renameSubjects := func(subjects []rbacv1.Subject) {
+		for i := range subjects {
+			subjects[i].Namespace = rename(subjects[i].Namespace)
+			switch subjects[i].Kind {
+			case rbacv1.UserKind:
+				subjects[i].Name = renameUser(subjects[i].Name)
+			case rbacv1.GroupKind:
+				subjects[i].Name = renameGroup(subjects[i].Name)
+			}
+		}
+	}
+
+	for i := range b.Namespaces {
+		b.Namespaces[i].Name = rename(b.Namespaces[i].Name)
+		// the name of the namespace is also in its metadata name label
+		if _, ok := b.Namespaces[i].Labels[corev1.LabelMetadataName]; ok {
+			b.Namespaces[i].Labels[corev1.LabelMetadataName] = b.Namespaces[i].Name
+		}
+	}
+	for i := range b.ServiceAccounts {
+		b.ServiceAccounts[i].Namespace = rename(b.ServiceAccounts[i].Namespace)
+	}
+	for i := range b.Roles {
+		b.Roles[i].Namespace = rename(b.Roles[i].Namespace)
+	}
+	for i := range b.RoleBindings {
+		b.RoleBindings[i].Namespace = rename(b.RoleBindings[i].Namespace)
+		renameSubjects(b.RoleBindings[i].Subjects)
+	}
+	for i := range b.ClusterRoleBindings {
+		renameSubjects(b.ClusterRoleBindings[i].Subjects)
+	}
+	for i := range b.SecurityContextConstraints {
+		scc := &b.SecurityContextConstraints[i]
+		for j := range scc.Users {
+			scc.Users[j] = renameUser(scc.Users[j])
+		}
+		for j := range scc.Groups {
+			scc.Groups[j] = renameGroup(scc.Groups[j])
+		}
+	}
+}
+
+// objects returns the Kubernetes objects of the bundle followed by its SecurityContextConstraints
+func (b *Bundle) objects() []runtime.Object {
+	objects := b.KubeObjects()
+	for i := range b.SecurityContextConstraints {
+		objects = append(objects, &b.SecurityContextConstraints[i])
+	}
+	return objects
+}
+
+// KubeObjects returns the objects of the bundle served by Kubernetes
+func (b *Bundle) KubeObjects() []runtime.Object {
+	var objects []runtime.Object
+	for i := range b.Namespaces {
+		objects = append(objects, &b.Namespaces[i])
+	}
+	for i := range b.ServiceAccounts {
+		objects = append(objects, &b.ServiceAccounts[i])
+	}
+	for i := range b.Roles {
+		objects = append(objects, &b.Roles[i])
+	}
+	for i := range b.RoleBindings {
+		objects = append(objects, &b.RoleBindings[i])
+	}
+	for i := range b.ClusterRoles {
+		objects = append(objects, &b.ClusterRoles[i])
+	}
+	for i := range b.ClusterRoleBindings {
+		objects = append(objects, &b.ClusterRoleBindings[i])
+	}
+	return objects
+}
+
+// KubeClient returns a fake Kubernetes client holding the objects of the bundle
+func (b *Bundle) KubeClient() *kubefake.Clientset {
+	return kubefake.NewSimpleClientset(b.KubeObjects()...)
+}
+
+// SecurityClient returns a fake OpenShift security client holding the SecurityContextConstraints of
+// the bundle. They are created through the client, the fake object tracker guesses a wrong resource
+// for the SecurityContextConstraints kind.
+func (b *Bundle) SecurityClient() (*fakesecurity.Clientset, error) {
+	client := fakesecurity.NewSimpleClientset()
+	for i := range b.SecurityContextConstraints {
+		if _, err := client.SecurityV1().SecurityContextConstraints().Create(context.Background(), &b.SecurityContextConstraints[i], metav1.CreateOptions{}); err != nil {
+			return nil, err
+		}
+	}
+	return client, nil
+}
+
+// Write writes the objects of the bundle as YAML documents
+func (b *Bundle) Write(w io.Writer) error {
+	for _, obj := range b.objects() {
+		data, err := yaml.Marshal(obj)
+		if err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintf(w, "---\n%s", data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Load reads a bundle written by Write
+func Load(path string) (*Bundle, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return Read(f)
+}
+
+// Read reads the YAML documents of a bundle, the objects of other kinds are rejected
+func Read(r io.Reader) (*Bundle, error) {
+	bundle := &Bundle{}
+	decoder := utilyaml.NewYAMLOrJSONDecoder(bufio.NewReader(r), 4096)
+	for {
+		u := &unstructured.Unstructured{}
+		if err := decoder.Decode(&u.Object); err != nil {
+			if errors.Is(err, io.EOF) {
+				return bundle, nil
+			}
+			return nil, err
+		}
+		if len(u.Object) == 0 {
+			continue
+		}
+		if err := bundle.add(u); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (b *Bundle) add(u *unstructured.Unstructured) error {
+	var obj interface{}
+	switch u.GetKind() {
+	case "Namespace":
+		b.Namespaces = append(b.Namespaces, corev1.Namespace{})
+		obj = &b.Namespaces[len(b.Namespaces)-1]
+	case "SecurityContextConstraints":
+		b.SecurityContextConstraints = append(b.SecurityContextConstraints, securityv1.SecurityContextConstraints{})
+		obj = &b.SecurityContextConstraints[len(b.SecurityContextConstraints)-1]
+	case "ServiceAccount":
+		b.ServiceAccounts = append(b.ServiceAccounts, corev1.ServiceAccount{})
+		obj = &b.ServiceAccounts[len(b.ServiceAccounts)-1]
+	case "Role":
+		b.Roles = append(b.Roles, rbacv1.Role{})
+		obj = &b.Roles[len(b.Roles)-1]
+	case "RoleBinding":
+		b.RoleBindings = append(b.RoleBindings, rbacv1.RoleBinding{})
+		obj = &b.RoleBindings[len(b.RoleBindings)-1]
+	case "ClusterRole":
+		b.ClusterRoles = append(b.ClusterRoles, rbacv1.ClusterRole{})
+		obj = &b.ClusterRoles[len(b.ClusterRoles)-1]
+	case "ClusterRoleBinding":
+		b.ClusterRoleBindings = append(b.ClusterRoleBindings, rbacv1.ClusterRoleBinding{})
+		obj = &b.ClusterRoleBindings[len(b.ClusterRoleBindings)-1]
+	default:
+		return fmt.Errorf("unexpected %s %s in the fixtures", u.GetKind(), u.GetName())
+	}
+	return runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, obj)
+}
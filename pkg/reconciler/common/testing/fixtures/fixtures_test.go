@@ -0,0 +1,147 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fixtures
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	securityv1 "github.com/openshift/api/security/v1"
+	fakesecurity "github.com/openshift/client-go/security/clientset/versioned/fake"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+)
+
+func clusterState(t *testing.T) (*kubefake.Clientset, *fakesecurity.Clientset) {
+	t.Helper()
+	kubeClient := kubefake.NewSimpleClientset(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a", ResourceVersion: "42",
+			Labels: map[string]string{corev1.LabelMetadataName: "team-a"}}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "kube-system"}},
+		&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: "pipeline", Namespace: "team-a"}},
+		&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "kube-system"}},
+		&rbacv1.RoleBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "pipelines-scc-rolebinding", Namespace: "team-a"},
+			RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: "pipelines-scc-clusterrole"},
+			Subjects:   []rbacv1.Subject{{Kind: rbacv1.ServiceAccountKind, Name: "pipeline", Namespace: "team-a"}},
+		},
+		&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "pipelines-scc-clusterrole"}},
+		&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "tekton-aggregate-view"}},
+		&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "cluster-admin"}},
+		&rbacv1.ClusterRoleBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "openshift-pipelines-clusterinterceptors"},
+			RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: "tekton-aggregate-view"},
+			Subjects:   []rbacv1.Subject{{Kind: rbacv1.ServiceAccountKind, Name: "pipeline", Namespace: "team-a"}},
+		},
+		// binds a ServiceAccount of an ignored namespace
+		&rbacv1.ClusterRoleBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "system-admin"},
+			RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: "cluster-admin"},
+			Subjects:   []rbacv1.Subject{{Kind: rbacv1.ServiceAccountKind, Name: "default", Namespace: "kube-system"}},
+		},
+	)
+	securityClient := fakesecurity.NewSimpleClientset()
+	_, err := securityClient.SecurityV1().SecurityContextConstraints().Create(context.TODO(), &securityv1.SecurityContextConstraints{
+		ObjectMeta: metav1.ObjectMeta{Name: "pipelines-scc"},
+		Users:      []string{"system:serviceaccount:team-a:pipeline"},
+		Groups:     []string{"system:serviceaccounts:team-a", "system:authenticated"},
+	}, metav1.CreateOptions{})
+	assert.NilError(t, err)
+	return kubeClient, securityClient
+}
+
+func TestSnapshot(t *testing.T) {
+	ctx := context.TODO()
+	kubeClient, securityClient := clusterState(t)
+	bundle, err := Snapshot(ctx, kubeClient, securityClient, Options{})
+	assert.NilError(t, err)
+	assert.Equal(t, len(bundle.Namespaces), 1)
+	assert.Equal(t, bundle.Namespaces[0].Name, "team-a")
+	assert.Equal(t, bundle.Namespaces[0].ResourceVersion, "")
+	assert.Equal(t, len(bundle.ServiceAccounts), 1)
+	assert.Equal(t, len(bundle.RoleBindings), 1)
+	assert.Equal(t, len(bundle.ClusterRoleBindings), 1)
+	assert.Equal(t, bundle.ClusterRoleBindings[0].Name, "openshift-pipelines-clusterinterceptors")
+	// only the ClusterRoles bound in the namespaces are snapshotted
+	var clusterRoles []string
+	for _, cr := range bundle.ClusterRoles {
+		clusterRoles = append(clusterRoles, cr.Name)
+	}
+	assert.DeepEqual(t, clusterRoles, []string{"pipelines-scc-clusterrole", "tekton-aggregate-view"})
+	assert.Equal(t, len(bundle.SecurityContextConstraints), 1)
+
+	bundle, err = Snapshot(ctx, kubeClient, nil, Options{IncludeSystemNamespaces: true})
+	assert.NilError(t, err)
+	assert.Equal(t, len(bundle.Namespaces), 2)
+	assert.Equal(t, len(bundle.ClusterRoleBindings), 2)
+	assert.Equal(t, len(bundle.SecurityContextConstraints), 0)
+}
+
+func TestWriteRead(t *testing.T) {
+	ctx := context.TODO()
+	kubeClient, securityClient := clusterState(t)
+	bundle, err := Snapshot(ctx, kubeClient, securityClient, Options{})
+	assert.NilError(t, err)
+
+	buf := &bytes.Buffer{}
+	assert.NilError(t, bundle.Write(buf))
+	got, err := Read(buf)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, got, bundle)
+
+	// the fixtures are loaded in the fake clients of the tests
+	sa, err := got.KubeClient().CoreV1().ServiceAccounts("team-a").Get(ctx, "pipeline", metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, sa.Name, "pipeline")
+	securityClient, err = got.SecurityClient()
+	assert.NilError(t, err)
+	scc, err := securityClient.SecurityV1().SecurityContextConstraints().Get(ctx, "pipelines-scc", metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, scc.Users, []string{"system:serviceaccount:team-a:pipeline"})
+
+	_, err = Read(strings.NewReader("apiVersion: v1\nkind: Secret\nmetadata:\n  name: token\n"))
+	assert.ErrorContains(t, err, "unexpected Secret token in the fixtures")
+}
+
+func TestLoad(t *testing.T) {
+	bundle, err := Load("testdata/cluster.yaml")
+	assert.NilError(t, err)
+	assert.Equal(t, len(bundle.Namespaces), 2)
+	assert.Equal(t, len(bundle.SecurityContextConstraints), 1)
+	assert.Equal(t, len(bundle.RoleBindings), 1)
+	assert.Equal(t, bundle.RoleBindings[0].RoleRef.Name, "pipelines-scc-clusterrole")
+}
+
+func TestAnonymize(t *testing.T) {
+	kubeClient, securityClient := clusterState(t)
+	bundle, err := Snapshot(context.TODO(), kubeClient, securityClient, Options{Anonymize: true})
+	assert.NilError(t, err)
+	assert.Equal(t, bundle.Namespaces[0].Name, "ns-0001")
+	assert.Equal(t, bundle.Namespaces[0].Labels[corev1.LabelMetadataName], "ns-0001")
+	assert.Equal(t, bundle.ServiceAccounts[0].Namespace, "ns-0001")
+	assert.Equal(t, bundle.RoleBindings[0].Namespace, "ns-0001")
+	assert.Equal(t, bundle.RoleBindings[0].Subjects[0].Namespace, "ns-0001")
+	assert.Equal(t, bundle.ClusterRoleBindings[0].Subjects[0].Namespace, "ns-0001")
+	scc := bundle.SecurityContextConstraints[0]
+	assert.DeepEqual(t, scc.Users, []string{"system:serviceaccount:ns-0001:pipeline"})
+	assert.DeepEqual(t, scc.Groups, []string{"system:serviceaccounts:ns-0001", "system:authenticated"})
+}
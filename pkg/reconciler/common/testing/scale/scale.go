@@ -0,0 +1,192 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package scale measures the reconciliation of the namespaces of a synthetic cluster, in the
+// fake clients of the tests, and reports the regressions of the API calls and of the duration
+// against a baseline report.
+package scale
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+// Namespaces returns n synthetic namespaces, named scale-0001, scale-0002...
+func Namespaces(n int) []corev1.Namespace {
+	namespaces := make([]corev1.Namespace, 0, n)
+	for i := 1; i <= n; i++ {
+		namespaces = append(namespaces, corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("scale-%04d", i)},
+		})
+	}
+	return namespaces
+}
+
+// reactors are the fake clientsets, which react to the actions of the clients
+type reactors interface {
+	PrependReactor(verb, resource string, reaction k8stesting.ReactionFunc)
+}
+
+// APICalls counts the API calls made through fake clientsets, by verb and resource
+type APICalls struct {
+	mutex sync.Mutex
+	calls map[string]int
+}
+
+// Count counts the API calls of the fake clientsets, the calls are not changed
+func (c *APICalls) Count(clientsets ...reactors) {
+	for _, clientset := range clientsets {
+		clientset.PrependReactor("*", "*", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			c.mutex.Lock()
+			defer c.mutex.Unlock()
+			if c.calls == nil {
+				c.calls = map[string]int{}
+			}
+			c.calls[action.GetVerb()+" "+action.GetResource().Resource]++
+			return false, nil, nil
+		})
+	}
+}
+
+// Reset returns the counts of the API calls since the last reset
+func (c *APICalls) Reset() map[string]int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	calls := c.calls
+	c.calls = map[string]int{}
+	return calls
+}
+
+// Pass is a reconciliation of the namespaces
+type Pass struct {
+	Name string `json:"name"`
+	// Duration of the pass, in milliseconds
+	Duration int64 `json:"durationMs"`
+	// APICalls is the total number of API calls of the pass
+	APICalls int `json:"apiCalls"`
+	// Calls are the API calls by verb and resource
+	Calls map[string]int `json:"calls"`
+}
+
+// NewPass returns the pass measured from its start and the API calls made since
+func NewPass(name string, start time.Time, calls map[string]int) Pass {
+	p := Pass{Name: name, Duration: time.Since(start).Milliseconds(), Calls: calls}
+	for _, n := range calls {
+		p.APICalls += n
+	}
+	return p
+}
+
+// Report is the outcome of the passes on a number of namespaces
+type Report struct {
+	Namespaces int    `json:"namespaces"`
+	Passes     []Pass `json:"passes"`
+}
+
+// Tolerance is the growth allowed over the baseline, in percents of the values per namespace, a
+// zero tolerance of the duration skips its comparison as it depends on the machine
+type Tolerance struct {
+	APICalls int
+	Duration int
+}
+
+// Regressions returns the passes of the report exceeding the tolerance over the same passes of the
+// baseline, the values are compared per namespace as the reports may differ in their namespaces
+func (r *Report) Regressions(baseline *Report, tolerance Tolerance) []string {
+	previous := map[string]Pass{}
+	for _, p := range baseline.Passes {
+		previous[p.Name] = p
+	}
+	var regressions []string
+	for _, p := range r.Passes {
+		b, ok := previous[p.Name]
+		if !ok {
+			continue
+		}
+		if exceeds(p.APICalls, r.Namespaces, b.APICalls, baseline.Namespaces, tolerance.APICalls) {
+			regressions = append(regressions, fmt.Sprintf("pass %s made %d API calls for %d namespaces, the baseline made %d for %d namespaces (%s)",
+				p.Name, p.APICalls, r.Namespaces, b.APICalls, baseline.Namespaces, callsDiff(b.Calls, p.Calls)))
+		}
+		if tolerance.Duration > 0 && exceeds(int(p.Duration), r.Namespaces, int(b.Duration), baseline.Namespaces, tolerance.Duration) {
+			regressions = append(regressions, fmt.Sprintf("pass %s took %dms for %d namespaces, the baseline took %dms for %d namespaces",
+				p.Name, p.Duration, r.Namespaces, b.Duration, baseline.Namespaces))
+		}
+	}
+	return regressions
+}
+
+// exceeds returns true if the value per namespace grows by more than the tolerance
+func exceeds(value, namespaces, baseline, baselineNamespaces, tolerance int) bool {
+	if namespaces == 0 || baselineNamespaces == 0 {
+		return false
+	}
+	// value/namespaces > baseline/baselineNamespaces * (100+tolerance)/100
+	return value*baselineNamespaces*100 > baseline*namespaces*(100+tolerance)
+}
+
+// callsDiff describes the API calls which differ from the baseline
+func callsDiff(baseline, calls map[string]int) string {
+	keys := map[string]bool{}
+	for k := range baseline {
+		keys[k] = true
+	}
+	for k := range calls {
+		keys[k] = true
+	}
+	var diff []string
+	for k := range keys {
+		if baseline[k] != calls[k] {
+			diff = append(diff, fmt.Sprintf("%s: %d -> %d", k, baseline[k], calls[k]))
+		}
+	}
+	sort.Strings(diff)
+	if len(diff) == 0 {
+		return "same calls"
+	}
+	return strings.Join(diff, ", ")
+}
+
+// Write writes the report to the JSON file
+func (r *Report) Write(path string) error {
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+// Load reads the report of the JSON file
+func Load(path string) (*Report, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	r := &Report{}
+	if err := json.Unmarshal(data, r); err != nil {
+		return nil, fmt.Errorf("invalid report %s: %w", path, err)
+	}
+	return r, nil
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scale
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestAPICalls(t *testing.T) {
+	namespaces := Namespaces(3)
+	assert.Equal(t, namespaces[2].Name, "scale-0003")
+
+	kubeClient := kubefake.NewSimpleClientset()
+	calls := &APICalls{}
+	calls.Count(kubeClient)
+	for _, ns := range namespaces {
+		_, err := kubeClient.CoreV1().Namespaces().Create(context.TODO(), &ns, metav1.CreateOptions{})
+		assert.NilError(t, err)
+	}
+	_, err := kubeClient.CoreV1().Namespaces().List(context.TODO(), metav1.ListOptions{})
+	assert.NilError(t, err)
+
+	assert.DeepEqual(t, calls.Reset(), map[string]int{"create namespaces": 3, "list namespaces": 1})
+	assert.DeepEqual(t, calls.Reset(), map[string]int{})
+}
+
+func TestRegressions(t *testing.T) {
+	baseline := &Report{Namespaces: 100, Passes: []Pass{
+		{Name: "initial", Duration: 100, APICalls: 2000, Calls: map[string]int{"create serviceaccounts": 100, "get serviceaccounts": 1900}},
+		{Name: "resync", Duration: 10, APICalls: 400},
+	}}
+	path := filepath.Join(t.TempDir(), "baseline.json")
+	assert.NilError(t, baseline.Write(path))
+	loaded, err := Load(path)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, loaded, baseline)
+
+	// the values are compared per namespace
+	report := &Report{Namespaces: 200, Passes: []Pass{
+		{Name: "initial", Duration: 400, APICalls: 4400, Calls: map[string]int{"create serviceaccounts": 200, "get serviceaccounts": 4200}},
+		{Name: "resync", Duration: 20, APICalls: 800},
+		{Name: "upgrade", Duration: 500, APICalls: 9000},
+	}}
+	assert.DeepEqual(t, report.Regressions(loaded, Tolerance{APICalls: 10}), []string(nil))
+	assert.DeepEqual(t, report.Regressions(loaded, Tolerance{APICalls: 5, Duration: 50}), []string{
+		"pass initial made 4400 API calls for 200 namespaces, the baseline made 2000 for 100 namespaces (create serviceaccounts: 100 -> 200, get serviceaccounts: 1900 -> 4200)",
+		"pass initial took 400ms for 200 namespaces, the baseline took 100ms for 100 namespaces",
+	})
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"crypto/tls"
+	"strings"
+
+	mf "github.com/manifestival/manifestival"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	// webhookTLSMinVersionEnvKey sets the minimum TLS version of the knative based webhooks
+	webhookTLSMinVersionEnvKey = "WEBHOOK_TLS_MIN_VERSION"
+	// webhookSecretNameEnvKey is set on the containers of the knative based webhooks
+	webhookSecretNameEnvKey = "WEBHOOK_SECRET_NAME"
+
+	tlsProxyListenFlag       = "--secure-listen-address="
+	tlsProxyMinVersionFlag   = "--tls-min-version="
+	tlsProxyCipherSuitesFlag = "--tls-cipher-suites="
+)
+
+// TLSVersion returns the crypto/tls version of a TLS version of the TLS policy, 1.2 by default
+func TLSVersion(version string) uint16 {
+	if version == v1alpha1.TLSVersion13 {
+		return tls.VersionTLS13
+	}
+	return tls.VersionTLS12
+}
+
+// ApplyTLSPolicy sets the minimum TLS version of the TLS policy on the knative based webhooks, with
+// the WEBHOOK_TLS_MIN_VERSION environment variable, and the minimum TLS version and cipher suites
+// on the kube-rbac-proxy containers serving the metrics over TLS. The other containers are not
+// changed as they have no setting for their TLS version.
+func ApplyTLSPolicy(policy *v1alpha1.TLSPolicy) mf.Transformer {
+	return func(u *unstructured.Unstructured) error {
+		if policy == nil {
+			return nil
+		}
+		switch u.GetKind() {
+		case "Deployment":
+			d := &appsv1.Deployment{}
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, d); err != nil {
+				return err
+			}
+			if !applyTLSPolicyToPod(policy, &d.Spec.Template.Spec) {
+				return nil
+			}
+			return setUnstructured(u, d)
+		case "StatefulSet":
+			sts := &appsv1.StatefulSet{}
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, sts); err != nil {
+				return err
+			}
+			if !applyTLSPolicyToPod(policy, &sts.Spec.Template.Spec) {
+				return nil
+			}
+			return setUnstructured(u, sts)
+		}
+		return nil
+	}
+}
+
+// applyTLSPolicyToPod returns true when a container of the pod is changed
+func applyTLSPolicyToPod(policy *v1alpha1.TLSPolicy, pod *corev1.PodSpec) bool {
+	changed := false
+	for i := range pod.Containers {
+		c := &pod.Containers[i]
+		if policy.MinVersion != "" && hasEnv(c, webhookSecretNameEnvKey) {
+			setEnv(c, webhookTLSMinVersionEnvKey, policy.MinVersion)
+			changed = true
+		}
+		if isTLSProxy(c) {
+			if policy.MinVersion != "" {
+				c.Args = setFlag(c.Args, tlsProxyMinVersionFlag, "VersionTLS"+strings.ReplaceAll(policy.MinVersion, ".", ""))
+				changed = true
+			}
+			if len(policy.CipherSuites) > 0 {
+				c.Args = setFlag(c.Args, tlsProxyCipherSuitesFlag, strings.Join(policy.CipherSuites, ","))
+				changed = true
+			}
+		}
+	}
+	return changed
+}
+
+func isTLSProxy(c *corev1.Container) bool {
+	for _, arg := range c.Args {
+		if strings.HasPrefix(arg, tlsProxyListenFlag) {
+			return true
+		}
+	}
+	return false
+}
+
+func hasEnv(c *corev1.Container, name string) bool {
+	for _, env := range c.Env {
+		if env.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func setEnv(c *corev1.Container, name, value string) {
+	for i := range c.Env {
+		if c.Env[i].Name == name {
+			c.Env[i] = corev1.EnvVar{Name: name, Value: value}
+			return
+		}
+	}
+	c.Env = append(c.Env, corev1.EnvVar{Name: name, Value: value})
+}
+
+// setFlag replaces the value of the flag in the arguments, or appends the flag
+func setFlag(args []string, flag, value string) []string {
+	for i, arg := range args {
+		if strings.HasPrefix(arg, flag) {
+			args[i] = flag + value
+			return args
+		}
+	}
+	return append(args, flag+value)
+}
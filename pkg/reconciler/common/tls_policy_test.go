@@ -0,0 +1,70 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+
+	mf "github.com/manifestival/manifestival"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"gotest.tools/v3/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestApplyTLSPolicy(t *testing.T) {
+	deployment := &appsv1.Deployment{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+		ObjectMeta: metav1.ObjectMeta{Name: "tekton-pipelines-webhook", Namespace: "tekton-pipelines"},
+		Spec: appsv1.DeploymentSpec{Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{Containers: []corev1.Container{
+			{Name: "webhook", Env: []corev1.EnvVar{{Name: "WEBHOOK_SECRET_NAME", Value: "webhook-certs"}}},
+			{Name: "metrics-tls-proxy", Args: []string{"--secure-listen-address=0.0.0.0:9443", "--tls-min-version=VersionTLS11"}},
+			{Name: "controller"},
+		}}}},
+	}
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(deployment)
+	assert.NilError(t, err)
+	manifest, err := mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{{Object: obj}}))
+	assert.NilError(t, err)
+
+	// no policy leaves the manifest as it is
+	unchanged, err := manifest.Transform(ApplyTLSPolicy(nil))
+	assert.NilError(t, err)
+	assert.DeepEqual(t, unchanged.Resources()[0].Object, obj)
+
+	transformed, err := manifest.Transform(ApplyTLSPolicy(&v1alpha1.TLSPolicy{
+		MinVersion:   v1alpha1.TLSVersion12,
+		CipherSuites: []string{"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256", "TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384"},
+	}))
+	assert.NilError(t, err)
+	got := &appsv1.Deployment{}
+	assert.NilError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(transformed.Resources()[0].Object, got))
+	containers := got.Spec.Template.Spec.Containers
+	assert.DeepEqual(t, containers[0].Env, []corev1.EnvVar{
+		{Name: "WEBHOOK_SECRET_NAME", Value: "webhook-certs"},
+		{Name: "WEBHOOK_TLS_MIN_VERSION", Value: "1.2"},
+	})
+	assert.DeepEqual(t, containers[1].Args, []string{
+		"--secure-listen-address=0.0.0.0:9443",
+		"--tls-min-version=VersionTLS12",
+		"--tls-cipher-suites=TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384",
+	})
+	assert.Assert(t, containers[2].Env == nil && containers[2].Args == nil)
+}
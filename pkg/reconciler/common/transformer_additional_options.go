@@ -18,6 +18,7 @@ package common
 
 import (
 	"context"
+	"slices"
 	"strings"
 
 	mf "github.com/manifestival/manifestival"
@@ -28,6 +29,8 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	apimachineryRuntime "k8s.io/apimachinery/pkg/runtime"
 	"knative.dev/pkg/logging"
@@ -807,6 +810,8 @@ func (ot *OptionsTransformer) updateWebhookConfiguration(u *unstructured.Unstruc
 				if webhookOptions.SideEffects != nil {
 					targetWebhookConfiguration.Webhooks[i].SideEffects = webhookOptions.SideEffects
 				}
+				targetWebhookConfiguration.Webhooks[i].NamespaceSelector = mergeLabelSelectors(w.NamespaceSelector, webhookOptions.NamespaceSelector)
+				targetWebhookConfiguration.Webhooks[i].ObjectSelector = mergeLabelSelectors(w.ObjectSelector, webhookOptions.ObjectSelector)
 			}
 		}
 		// convert webhookconfigurtion to unstructured object
@@ -833,6 +838,11 @@ func (ot *OptionsTransformer) updateWebhookConfiguration(u *unstructured.Unstruc
 				if webhookOptions.SideEffects != nil {
 					targetWebhookConfiguration.Webhooks[i].SideEffects = webhookOptions.SideEffects
 				}
+				targetWebhookConfiguration.Webhooks[i].NamespaceSelector = mergeLabelSelectors(w.NamespaceSelector, webhookOptions.NamespaceSelector)
+				targetWebhookConfiguration.Webhooks[i].ObjectSelector = mergeLabelSelectors(w.ObjectSelector, webhookOptions.ObjectSelector)
+				if webhookOptions.ReinvocationPolicy != nil {
+					targetWebhookConfiguration.Webhooks[i].ReinvocationPolicy = webhookOptions.ReinvocationPolicy
+				}
 			}
 		}
 		// convert webhookconfigurtion to unstructured object
@@ -845,3 +855,29 @@ func (ot *OptionsTransformer) updateWebhookConfiguration(u *unstructured.Unstruc
 
 	return nil
 }
+
+// mergeLabelSelectors adds the labels and the expressions of the extra selector to the selector of a
+// webhook, the webhook then only receives the requests matched by both selectors
+func mergeLabelSelectors(current, extra *metav1.LabelSelector) *metav1.LabelSelector {
+	if extra == nil {
+		return current
+	}
+	merged := &metav1.LabelSelector{}
+	if current != nil {
+		merged = current.DeepCopy()
+	}
+	for k, v := range extra.MatchLabels {
+		if merged.MatchLabels == nil {
+			merged.MatchLabels = map[string]string{}
+		}
+		merged.MatchLabels[k] = v
+	}
+	for _, expression := range extra.MatchExpressions {
+		if !slices.ContainsFunc(merged.MatchExpressions, func(e metav1.LabelSelectorRequirement) bool {
+			return equality.Semantic.DeepEqual(e, expression)
+		}) {
+			merged.MatchExpressions = append(merged.MatchExpressions, expression)
+		}
+	}
+	return merged
+}
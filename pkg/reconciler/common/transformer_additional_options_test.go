@@ -41,6 +41,12 @@ func TestExecuteAdditionalOptionsTransformer(t *testing.T) {
 	ignorePolicy := admissionregistrationv1.Ignore
 	failPolicy := admissionregistrationv1.Fail
 	sideEffectUnknown := admissionregistrationv1.SideEffectClassUnknown
+	neverReinvoke := admissionregistrationv1.NeverReinvocationPolicy
+	excludeNamespaces := &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{
+		Key:      "kubernetes.io/metadata.name",
+		Operator: metav1.LabelSelectorOpNotIn,
+		Values:   []string{"kube-system", "openshift-etcd"},
+	}}}
 
 	// verify the changes applied on the manifest
 
@@ -575,6 +581,25 @@ func TestExecuteAdditionalOptionsTransformer(t *testing.T) {
 			inputFilename:          "./testdata/test-additional-options-base-webhook.yaml",
 			expectedResultFilename: "./testdata/test-additional-options-test-webhook.yaml",
 		},
+		{
+			name: "test-webhook-selectors",
+			additionalOptions: v1alpha1.AdditionalOptions{
+				Disabled: ptr.Bool(false),
+				WebhookConfigurationOptions: map[string]v1alpha1.WebhookConfigurationOptions{
+					"validation.webhook.pipeline.tekton.dev": {
+						FailurePolicy:     &ignorePolicy,
+						NamespaceSelector: excludeNamespaces,
+					},
+					"webhook.pipeline.tekton.dev": {
+						NamespaceSelector:  excludeNamespaces,
+						ObjectSelector:     &metav1.LabelSelector{MatchLabels: map[string]string{"tekton.dev/admission": "enabled"}},
+						ReinvocationPolicy: &neverReinvoke,
+					},
+				},
+			},
+			inputFilename:          "./testdata/test-additional-options-base-webhook-selectors.yaml",
+			expectedResultFilename: "./testdata/test-additional-options-test-webhook-selectors.yaml",
+		},
 		{
 			name: "test-runtimeclassname-for-deployments",
 			additionalOptions: v1alpha1.AdditionalOptions{
@@ -80,6 +80,7 @@ func transformers(ctx context.Context, obj v1alpha1.TektonComponent) []mf.Transf
 		injectNamespaceClusterRole(obj.GetSpec().GetTargetNamespace()),
 		ReplaceNamespaceInWebhookNamespaceSelector(obj.GetSpec().GetTargetNamespace()),
 		AddDeploymentRestrictedPSA(),
+		ArchNodeAffinity(),
 	}
 }
 
@@ -166,21 +167,11 @@ func injectNamespaceCRClusterInterceptorClientConfig(targetNamespace string) mf.
 	}
 }
 
-// ImagesFromEnv will provide map of key value.
+// ImagesFromEnv will provide map of key value. Images set for an architecture replace
+// the images when the workloads run on that single architecture.
 func ImagesFromEnv(prefix string) map[string]string {
-	images := map[string]string{}
-	for _, env := range os.Environ() {
-		if !strings.HasPrefix(env, prefix) {
-			continue
-		}
-
-		keyValue := strings.Split(env, "=")
-		name := strings.TrimPrefix(keyValue[0], prefix)
-		url := keyValue[1]
-		images[name] = url
-	}
-
-	return images
+	images, archImages := archImagesFromEnv(prefix)
+	return selectArchImages(images, archImages, GetArchPlacement())
 }
 
 // ImageRegistryDomainOverride will add or override the registry used in the image list
@@ -715,6 +706,10 @@ func AddConfiguration(config v1alpha1.Config) mf.Transformer {
 		d.Spec.Template.Spec.NodeSelector = config.NodeSelector
 		d.Spec.Template.Spec.Tolerations = config.Tolerations
 		d.Spec.Template.Spec.PriorityClassName = config.PriorityClassName
+		applyPodSecurityDefaults(&d.Spec.Template, config.PodSecurity)
+		if MinimalFootprint(config) {
+			applyMinimalFootprint(d)
+		}
 
 		unstrObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(d)
 		if err != nil {
@@ -0,0 +1,92 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	mf "github.com/manifestival/manifestival"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/kubernetes"
+)
+
+// AddWorkloadIdentity is a transformer that sets the workload identity annotations on the service
+// accounts, and the workload identity labels on the pods of the Deployments and StatefulSets
+// running with them
+func AddWorkloadIdentity(wi *v1alpha1.WorkloadIdentity, serviceAccounts ...string) mf.Transformer {
+	return func(u *unstructured.Unstructured) error {
+		if wi == nil {
+			return nil
+		}
+		switch u.GetKind() {
+		case "ServiceAccount":
+			if !slices.Contains(serviceAccounts, u.GetName()) {
+				return nil
+			}
+			annotations := u.GetAnnotations()
+			if annotations == nil {
+				annotations = map[string]string{}
+			}
+			for k, v := range wi.ServiceAccountAnnotations() {
+				annotations[k] = v
+			}
+			u.SetAnnotations(annotations)
+		case "Deployment", "StatefulSet":
+			labels := wi.PodLabels()
+			if len(labels) == 0 {
+				return nil
+			}
+			sa, _, err := unstructured.NestedString(u.Object, "spec", "template", "spec", "serviceAccountName")
+			if err != nil || !slices.Contains(serviceAccounts, sa) {
+				return err
+			}
+			podLabels, _, err := unstructured.NestedStringMap(u.Object, "spec", "template", "metadata", "labels")
+			if err != nil {
+				return err
+			}
+			if podLabels == nil {
+				podLabels = map[string]string{}
+			}
+			for k, v := range labels {
+				podLabels[k] = v
+			}
+			return unstructured.SetNestedStringMap(u.Object, podLabels, "spec", "template", "metadata", "labels")
+		}
+		return nil
+	}
+}
+
+// VerifyWorkloadIdentity checks that the workload identity annotations were accepted on the
+// service accounts in the cluster, they can be removed or changed by admission plugins
+func VerifyWorkloadIdentity(ctx context.Context, kubeClient kubernetes.Interface, namespace string, wi *v1alpha1.WorkloadIdentity, serviceAccounts ...string) error {
+	for _, name := range serviceAccounts {
+		sa, err := kubeClient.CoreV1().ServiceAccounts(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to get service account %s/%s: %w", namespace, name, err)
+		}
+		for k, v := range wi.ServiceAccountAnnotations() {
+			if sa.Annotations[k] != v {
+				return fmt.Errorf("service account %s/%s has %s=%q, expected %q", namespace, name, k, sa.Annotations[k], v)
+			}
+		}
+	}
+	return nil
+}
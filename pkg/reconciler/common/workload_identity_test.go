@@ -0,0 +1,81 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"gotest.tools/v3/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestAddWorkloadIdentity(t *testing.T) {
+	wi := &v1alpha1.WorkloadIdentity{
+		Provider: v1alpha1.WorkloadIdentityProviderAzure,
+		ClientID: "00000000-0000-0000-0000-000000000001",
+	}
+	sa := &unstructured.Unstructured{}
+	sa.SetKind("ServiceAccount")
+	sa.SetName("tekton-results-api")
+	other := sa.DeepCopy()
+	other.SetName("tekton-results-watcher")
+	deployment := unstructuredDeployment(t, func(d *appsv1.Deployment) {
+		d.Spec.Template.Spec.ServiceAccountName = "tekton-results-api"
+	})
+
+	transformer := AddWorkloadIdentity(wi, "tekton-results-api")
+	for _, u := range []*unstructured.Unstructured{sa, other, deployment} {
+		assert.NilError(t, transformer(u))
+	}
+	assert.DeepEqual(t, sa.GetAnnotations(), map[string]string{v1alpha1.AzureClientIDAnnotation: wi.ClientID})
+	assert.Assert(t, other.GetAnnotations() == nil)
+	labels, _, _ := unstructured.NestedStringMap(deployment.Object, "spec", "template", "metadata", "labels")
+	assert.Equal(t, labels[v1alpha1.AzureUseWorkloadIdentityLabel], "true")
+
+	// nothing is changed without workload identity
+	sa = &unstructured.Unstructured{}
+	sa.SetKind("ServiceAccount")
+	sa.SetName("tekton-results-api")
+	assert.NilError(t, AddWorkloadIdentity(nil, "tekton-results-api")(sa))
+	assert.Assert(t, sa.GetAnnotations() == nil)
+}
+
+func TestVerifyWorkloadIdentity(t *testing.T) {
+	wi := &v1alpha1.WorkloadIdentity{
+		Provider: v1alpha1.WorkloadIdentityProviderAWS,
+		RoleARN:  "arn:aws:iam::123456789012:role/tekton-results",
+	}
+	client := fake.NewSimpleClientset(&corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "tekton-results-api",
+			Namespace:   "tekton-pipelines",
+			Annotations: map[string]string{v1alpha1.AWSRoleARNAnnotation: wi.RoleARN},
+		},
+	}, &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{Name: "tekton-chains-controller", Namespace: "tekton-pipelines"},
+	})
+
+	assert.NilError(t, VerifyWorkloadIdentity(context.TODO(), client, "tekton-pipelines", wi, "tekton-results-api"))
+	err := VerifyWorkloadIdentity(context.TODO(), client, "tekton-pipelines", wi, "tekton-chains-controller")
+	assert.Error(t, err, `service account tekton-pipelines/tekton-chains-controller has eks.amazonaws.com/role-arn="", expected "arn:aws:iam::123456789012:role/tekton-results"`)
+}
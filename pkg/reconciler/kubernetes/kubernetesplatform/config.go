@@ -17,6 +17,8 @@ limitations under the License.
 package kubernetesplatform
 
 import (
+	"slices"
+
 	k8sManualApprovalGate "github.com/tektoncd/operator/pkg/reconciler/kubernetes/manualapprovalgate"
 	k8sChain "github.com/tektoncd/operator/pkg/reconciler/kubernetes/tektonchain"
 	k8sConfig "github.com/tektoncd/operator/pkg/reconciler/kubernetes/tektonconfig"
@@ -30,6 +32,10 @@ import (
 	k8stektonscheduler "github.com/tektoncd/operator/pkg/reconciler/kubernetes/tektonscheduler"
 	k8sTrigger "github.com/tektoncd/operator/pkg/reconciler/kubernetes/tektontrigger"
 	"github.com/tektoncd/operator/pkg/reconciler/platform"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/componentaction"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/gitsource"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/secretrotation"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/watchdog"
 	"knative.dev/pkg/injection"
 )
 
@@ -82,5 +88,17 @@ var (
 		ControllerTektonResults: injection.NamedControllerConstructor{
 			Name:                  string(ControllerTektonResults),
 			ControllerConstructor: k8sResult.NewController},
+		platform.ControllerComponentAction: injection.NamedControllerConstructor{
+			Name:                  string(platform.ControllerComponentAction),
+			ControllerConstructor: componentaction.NewExtendedController(slices.Concat(componentaction.CommonKinds, []componentaction.Kind{componentaction.DashboardKind})...)},
+		platform.ControllerGitSource: injection.NamedControllerConstructor{
+			Name:                  string(platform.ControllerGitSource),
+			ControllerConstructor: gitsource.NewController},
+		platform.ControllerSecretRotation: injection.NamedControllerConstructor{
+			Name:                  string(platform.ControllerSecretRotation),
+			ControllerConstructor: secretrotation.NewExtendedController(secretrotation.ChainsCredentials)},
+		platform.ControllerWatchdog: injection.NamedControllerConstructor{
+			Name:                  string(platform.ControllerWatchdog),
+			ControllerConstructor: watchdog.NewController},
 	}
 )
@@ -78,13 +78,13 @@ func NewExtendedController(generator common.ExtensionGenerator) injection.Contro
 
 		logger.Debug("Setting up event handlers for ManualApprovalGate")
 
-		if _, err := manualapprovalgateinformer.Get(ctx).Informer().AddEventHandler(controller.HandleAll(impl.Enqueue)); err != nil {
+		if _, err := manualapprovalgateinformer.Get(ctx).Informer().AddEventHandler(common.HandleSpecChanges(impl.Enqueue)); err != nil {
 			logger.Panicf("Couldn't register ManualApprovalGate informer event handler: %w", err)
 		}
 
 		if _, err := tektonInstallerinformer.Get(ctx).Informer().AddEventHandler(cache.FilteringResourceEventHandler{
 			FilterFunc: controller.FilterController(&v1alpha1.ManualApprovalGate{}),
-			Handler:    controller.HandleAll(impl.EnqueueControllerOf),
+			Handler:    common.HandleReadinessChanges(impl.EnqueueControllerOf),
 		}); err != nil {
 			logger.Panicf("Couldn't register TektonInstallerSet informer event handler: %w", err)
 		}
@@ -28,6 +28,7 @@ import (
 	"github.com/tektoncd/operator/pkg/reconciler/common"
 	"github.com/tektoncd/operator/pkg/reconciler/kubernetes/tektoninstallerset/client"
 	"k8s.io/client-go/tools/cache"
+	kubeclient "knative.dev/pkg/client/injection/kube/client"
 	"knative.dev/pkg/configmap"
 	"knative.dev/pkg/controller"
 	"knative.dev/pkg/injection"
@@ -71,6 +72,7 @@ func NewExtendedController(generator common.ExtensionGenerator) injection.Contro
 
 		c := &Reconciler{
 			operatorClientSet:  operatorclient.Get(ctx),
+			kubeClientSet:      kubeclient.Get(ctx),
 			installerSetClient: client.NewInstallerSetClient(tisClient, operatorVer, chainVer, v1alpha1.KindTektonChain, metrics),
 			extension:          generator(ctx),
 			manifest:           manifest,
@@ -83,13 +85,13 @@ func NewExtendedController(generator common.ExtensionGenerator) injection.Contro
 
 		logger.Debug("Setting up event handlers for Tekton Chain")
 
-		if _, err := tektonChaininformer.Get(ctx).Informer().AddEventHandler(controller.HandleAll(impl.Enqueue)); err != nil {
+		if _, err := tektonChaininformer.Get(ctx).Informer().AddEventHandler(common.HandleSpecChanges(impl.Enqueue)); err != nil {
 			logger.Panicf("Couldn't register TektonChain informer event handler: %w", err)
 		}
 
 		if _, err := tektonInstallerinformer.Get(ctx).Informer().AddEventHandler(cache.FilteringResourceEventHandler{
 			FilterFunc: controller.FilterController(&v1alpha1.TektonChain{}),
-			Handler:    controller.HandleAll(impl.EnqueueControllerOf),
+			Handler:    common.HandleReadinessChanges(impl.EnqueueControllerOf),
 		}); err != nil {
 			logger.Panicf("Couldn't register TektonInstallerSet informer event handler: %w", err)
 		}
@@ -109,6 +109,13 @@ func (r *Reconciler) createInstallerSet(ctx context.Context, tc *v1alpha1.Tekton
 	manifest = manifest.Filter(mf.Not(mf.ByKind("Secret")),
 		mf.Not(mf.All(mf.ByName("chains-config"), mf.ByKind("ConfigMap"))))
 
+	// add the registry provisioned as the storage of the artifacts
+	registryManifest, err := ociRegistryManifest(tc)
+	if err != nil {
+		return nil, err
+	}
+	manifest = manifest.Append(registryManifest)
+
 	transformer := filterAndTransform(r.extension)
 	if _, err = transformer(ctx, &manifest, tc); err != nil {
 		tc.Status.MarkNotReady("transformation failed: " + err.Error())
@@ -0,0 +1,328 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonchain
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	mf "github.com/manifestival/manifestival"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"golang.org/x/crypto/bcrypt"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"knative.dev/pkg/logging"
+	"knative.dev/pkg/ptr"
+)
+
+const (
+	chainsRegistryContainer         = v1alpha1.ChainsRegistryName
+	chainsRegistryImage             = "docker.io/library/registry:2"
+	chainsRegistryPort              = 5000
+	chainsRegistryUID               = 65532
+	chainsRegistryUser              = "tekton-chains"
+	chainsRegistryHtpasswdSecret    = "tekton-chains-registry-htpasswd"
+	chainsRegistryAuthSecret        = "tekton-chains-registry-auth"
+	chainsRegistryTLSSecret         = "tekton-chains-registry-tls"
+	chainsRegistryDataClaim         = "tekton-chains-registry-data"
+	chainsRegistryPusherRoleBinding = "tekton-chains-registry-pusher"
+	openShiftImageBuilderRole       = "system:image-builder"
+	servingCertSecretAnnotation     = "service.beta.openshift.io/serving-cert-secret-name"
+)
+
+// chainProperties returns the properties of the Chains config, the OCI storage points to the
+// provisioned registry unless the repository is set in the properties
+func chainProperties(tc *v1alpha1.TektonChain) v1alpha1.ChainProperties {
+	properties := tc.Spec.Chain.ChainProperties
+	registry := tc.Spec.OCIRegistry
+	if registry == nil {
+		return properties
+	}
+	if properties.StorageOCIRepository == "" {
+		properties.StorageOCIRepository = registry.RepositoryURL(tc.Spec.GetTargetNamespace())
+	}
+	if properties.StorageOCIRepositoryInsecure == nil {
+		properties.StorageOCIRepositoryInsecure = ptr.Bool(registry.Insecure())
+	}
+	return properties
+}
+
+// ociRegistryManifest returns the resources of the registry provisioned for the Chains artifacts,
+// the internal registry is deployed in the target namespace and the controller is allowed to push
+// to the image registry of OpenShift
+func ociRegistryManifest(tc *v1alpha1.TektonChain) (mf.Manifest, error) {
+	registry := tc.Spec.OCIRegistry
+	if registry == nil {
+		return mf.Manifest{}, nil
+	}
+	namespace := tc.Spec.GetTargetNamespace()
+	var objects []runtime.Object
+	switch registry.Provider {
+	case v1alpha1.ChainsOCIRegistryProviderInternal:
+		objects = internalRegistryObjects(registry, namespace)
+	case v1alpha1.ChainsOCIRegistryProviderOpenShift:
+		objects = []runtime.Object{imageBuilderRoleBinding(namespace)}
+	}
+	resources := make([]unstructured.Unstructured, 0, len(objects))
+	for _, obj := range objects {
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		if err != nil {
+			return mf.Manifest{}, err
+		}
+		resources = append(resources, unstructured.Unstructured{Object: content})
+	}
+	return mf.ManifestFrom(mf.Slice(resources))
+}
+
+func internalRegistryObjects(registry *v1alpha1.ChainsOCIRegistry, namespace string) []runtime.Object {
+	labels := map[string]string{
+		"app.kubernetes.io/name":      v1alpha1.ChainsRegistryName,
+		"app.kubernetes.io/component": "registry",
+		"app.kubernetes.io/part-of":   "tekton-chains",
+	}
+	meta := func(name string) metav1.ObjectMeta {
+		return metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: labels}
+	}
+
+	env := []corev1.EnvVar{
+		{Name: "REGISTRY_AUTH", Value: "htpasswd"},
+		{Name: "REGISTRY_AUTH_HTPASSWD_REALM", Value: "Tekton Chains"},
+		{Name: "REGISTRY_AUTH_HTPASSWD_PATH", Value: "/auth/htpasswd"},
+	}
+	mounts := []corev1.VolumeMount{
+		{Name: "data", MountPath: "/var/lib/registry"},
+		{Name: "auth", MountPath: "/auth", ReadOnly: true},
+	}
+	data := corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}
+	if registry.StorageSize != "" {
+		data = corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: chainsRegistryDataClaim}}
+	}
+	volumes := []corev1.Volume{
+		{Name: "data", VolumeSource: data},
+		{Name: "auth", VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: chainsRegistryHtpasswdSecret}}},
+	}
+	scheme := corev1.URISchemeHTTP
+	securityContext := &corev1.PodSecurityContext{RunAsNonRoot: ptr.Bool(true)}
+	service := meta(v1alpha1.ChainsRegistryName)
+
+	// on OpenShift the registry is served with the certificate issued by the service CA, which is
+	// trusted by the Chains controller, and the user is assigned by the security context constraints
+	if v1alpha1.IsOpenShiftPlatform() {
+		env = append(env,
+			corev1.EnvVar{Name: "REGISTRY_HTTP_TLS_CERTIFICATE", Value: "/certs/tls.crt"},
+			corev1.EnvVar{Name: "REGISTRY_HTTP_TLS_KEY", Value: "/certs/tls.key"},
+		)
+		mounts = append(mounts, corev1.VolumeMount{Name: "tls", MountPath: "/certs", ReadOnly: true})
+		volumes = append(volumes, corev1.Volume{Name: "tls", VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: chainsRegistryTLSSecret}}})
+		scheme = corev1.URISchemeHTTPS
+		service.Annotations = map[string]string{servingCertSecretAnnotation: chainsRegistryTLSSecret}
+	} else {
+		securityContext.RunAsUser = ptr.Int64(chainsRegistryUID)
+		securityContext.FSGroup = ptr.Int64(chainsRegistryUID)
+	}
+
+	objects := []runtime.Object{
+		&appsv1.Deployment{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+			ObjectMeta: meta(v1alpha1.ChainsRegistryName),
+			Spec: appsv1.DeploymentSpec{
+				Replicas: ptr.Int32(1),
+				Selector: &metav1.LabelSelector{MatchLabels: labels},
+				// the data volume is mounted by a single pod
+				Strategy: appsv1.DeploymentStrategy{Type: appsv1.RecreateDeploymentStrategyType},
+				Template: corev1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{Labels: labels},
+					Spec: corev1.PodSpec{
+						SecurityContext: securityContext,
+						Containers: []corev1.Container{{
+							Name:         chainsRegistryContainer,
+							Image:        chainsRegistryImage,
+							Env:          env,
+							Ports:        []corev1.ContainerPort{{Name: "registry", ContainerPort: chainsRegistryPort}},
+							VolumeMounts: mounts,
+							ReadinessProbe: &corev1.Probe{
+								ProbeHandler: corev1.ProbeHandler{HTTPGet: &corev1.HTTPGetAction{
+									Path:   "/",
+									Port:   intstr.FromInt32(chainsRegistryPort),
+									Scheme: scheme,
+								}},
+							},
+						}},
+						Volumes: volumes,
+					},
+				},
+			},
+		},
+		&corev1.Service{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Service"},
+			ObjectMeta: service,
+			Spec: corev1.ServiceSpec{
+				Selector: labels,
+				Ports: []corev1.ServicePort{{
+					Name:       "registry",
+					Port:       chainsRegistryPort,
+					TargetPort: intstr.FromInt32(chainsRegistryPort),
+				}},
+			},
+		},
+	}
+	if registry.StorageSize != "" {
+		objects = append(objects, &corev1.PersistentVolumeClaim{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "PersistentVolumeClaim"},
+			ObjectMeta: meta(chainsRegistryDataClaim),
+			Spec: corev1.PersistentVolumeClaimSpec{
+				AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+				Resources: corev1.VolumeResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse(registry.StorageSize)},
+				},
+			},
+		})
+	}
+	return objects
+}
+
+// imageBuilderRoleBinding allows the Chains controller to push to the image streams of the target
+// namespace, the controller authenticates with the docker config OpenShift links to its service account
+func imageBuilderRoleBinding(namespace string) *rbacv1.RoleBinding {
+	return &rbacv1.RoleBinding{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "rbac.authorization.k8s.io/v1", Kind: "RoleBinding"},
+		ObjectMeta: metav1.ObjectMeta{Name: chainsRegistryPusherRoleBinding, Namespace: namespace},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "ClusterRole",
+			Name:     openShiftImageBuilderRole,
+		},
+		Subjects: []rbacv1.Subject{{
+			Kind:      rbacv1.ServiceAccountKind,
+			Name:      chainControllerServiceAccount,
+			Namespace: namespace,
+		}},
+	}
+}
+
+// addRegistryPullSecret adds the credentials of the internal registry to the image pull secrets of
+// the Chains controller service account, which Chains authenticates to the storage with
+func addRegistryPullSecret(registry *v1alpha1.ChainsOCIRegistry) mf.Transformer {
+	return func(u *unstructured.Unstructured) error {
+		if registry == nil || registry.Provider != v1alpha1.ChainsOCIRegistryProviderInternal ||
+			u.GetKind() != "ServiceAccount" || u.GetName() != chainControllerServiceAccount {
+			return nil
+		}
+		secrets, _, err := unstructured.NestedSlice(u.Object, "imagePullSecrets")
+		if err != nil {
+			return err
+		}
+		if slices.ContainsFunc(secrets, func(s interface{}) bool {
+			ref, ok := s.(map[string]interface{})
+			return ok && ref["name"] == chainsRegistryAuthSecret
+		}) {
+			return nil
+		}
+		secrets = append(secrets, map[string]interface{}{"name": chainsRegistryAuthSecret})
+		return unstructured.SetNestedSlice(u.Object, secrets, "imagePullSecrets")
+	}
+}
+
+// ensureOCIRegistryCredentials creates the htpasswd of the internal registry and the docker config
+// the Chains controller pushes with. The password is generated once, both secrets are generated
+// again when one of them is missing. The secrets are owned by the TektonChain and are not part of
+// an installer set, so that the password is kept across upgrades.
+func (r *Reconciler) ensureOCIRegistryCredentials(ctx context.Context, tc *v1alpha1.TektonChain) error {
+	registry := tc.Spec.OCIRegistry
+	if registry == nil || registry.Provider != v1alpha1.ChainsOCIRegistryProviderInternal {
+		return nil
+	}
+	logger := logging.FromContext(ctx)
+	namespace := tc.Spec.GetTargetNamespace()
+	secrets := r.kubeClientSet.CoreV1().Secrets(namespace)
+
+	missing := false
+	for _, name := range []string{chainsRegistryHtpasswdSecret, chainsRegistryAuthSecret} {
+		_, err := secrets.Get(ctx, name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			missing = true
+		} else if err != nil {
+			return err
+		}
+	}
+	if !missing {
+		return nil
+	}
+
+	password, err := generateRandomPassword(ctx)
+	if err != nil {
+		return err
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+	dockerConfig, err := json.Marshal(map[string]interface{}{
+		"auths": map[string]interface{}{
+			registry.Host(namespace): map[string]string{
+				"username": chainsRegistryUser,
+				"password": password,
+				"auth":     base64.StdEncoding.EncodeToString([]byte(chainsRegistryUser + ":" + password)),
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	ownerRef := *metav1.NewControllerRef(tc, tc.GetGroupVersionKind())
+	for _, secret := range []*corev1.Secret{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: chainsRegistryHtpasswdSecret},
+			Type:       corev1.SecretTypeOpaque,
+			Data:       map[string][]byte{"htpasswd": []byte(fmt.Sprintf("%s:%s\n", chainsRegistryUser, hash))},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: chainsRegistryAuthSecret},
+			Type:       corev1.SecretTypeDockerConfigJson,
+			Data:       map[string][]byte{corev1.DockerConfigJsonKey: dockerConfig},
+		},
+	} {
+		secret.Namespace = namespace
+		secret.OwnerReferences = []metav1.OwnerReference{ownerRef}
+		_, err := secrets.Create(ctx, secret, metav1.CreateOptions{})
+		if apierrors.IsAlreadyExists(err) {
+			_, err = secrets.Update(ctx, secret, metav1.UpdateOptions{})
+		}
+		if apierrors.IsNotFound(err) {
+			// the target namespace is created by the installer set, the credentials are created
+			// on the next reconciliation
+			logger.Debugw("Target namespace not created yet, skipping the registry credentials", "namespace", namespace)
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+	logger.Infow("Created the registry credentials", "namespace", namespace)
+	return nil
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonchain
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"golang.org/x/crypto/bcrypt"
+	"gotest.tools/v3/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	"knative.dev/pkg/ptr"
+)
+
+func ociRegistryChain(registry *v1alpha1.ChainsOCIRegistry) *v1alpha1.TektonChain {
+	return &v1alpha1.TektonChain{
+		ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.ChainResourceName, UID: "chain-uid"},
+		Spec: v1alpha1.TektonChainSpec{
+			CommonSpec: v1alpha1.CommonSpec{TargetNamespace: "tekton-chains"},
+			Chain:      v1alpha1.Chain{OCIRegistry: registry},
+		},
+	}
+}
+
+func TestChainPropertiesOCIRegistry(t *testing.T) {
+	tc := ociRegistryChain(&v1alpha1.ChainsOCIRegistry{Provider: v1alpha1.ChainsOCIRegistryProviderInternal})
+	properties := chainProperties(tc)
+	assert.Equal(t, properties.StorageOCIRepository, "tekton-chains-registry.tekton-chains.svc:5000/chains-artifacts")
+	assert.DeepEqual(t, properties.StorageOCIRepositoryInsecure, ptr.Bool(true))
+
+	// the repository set in the properties is kept
+	tc.Spec.StorageOCIRepository = "quay.io/org/signatures"
+	tc.Spec.StorageOCIRepositoryInsecure = ptr.Bool(false)
+	properties = chainProperties(tc)
+	assert.Equal(t, properties.StorageOCIRepository, "quay.io/org/signatures")
+	assert.DeepEqual(t, properties.StorageOCIRepositoryInsecure, ptr.Bool(false))
+
+	properties = chainProperties(ociRegistryChain(nil))
+	assert.Equal(t, properties.StorageOCIRepository, "")
+	assert.Assert(t, properties.StorageOCIRepositoryInsecure == nil)
+}
+
+func TestOCIRegistryManifest(t *testing.T) {
+	manifest, err := ociRegistryManifest(ociRegistryChain(nil))
+	assert.NilError(t, err)
+	assert.Equal(t, len(manifest.Resources()), 0)
+
+	manifest, err = ociRegistryManifest(ociRegistryChain(&v1alpha1.ChainsOCIRegistry{
+		Provider:    v1alpha1.ChainsOCIRegistryProviderInternal,
+		StorageSize: "5Gi",
+	}))
+	assert.NilError(t, err)
+	var kinds []string
+	for _, u := range manifest.Resources() {
+		kinds = append(kinds, u.GetKind()+"/"+u.GetName())
+		assert.Equal(t, u.GetNamespace(), "tekton-chains")
+	}
+	assert.DeepEqual(t, kinds, []string{
+		"Deployment/tekton-chains-registry",
+		"Service/tekton-chains-registry",
+		"PersistentVolumeClaim/tekton-chains-registry-data",
+	})
+	d := &appsv1.Deployment{}
+	assert.NilError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(manifest.Resources()[0].Object, d))
+	assert.Equal(t, d.Spec.Template.Spec.Volumes[0].PersistentVolumeClaim.ClaimName, chainsRegistryDataClaim)
+	assert.Equal(t, d.Spec.Template.Spec.Volumes[1].Secret.SecretName, chainsRegistryHtpasswdSecret)
+	assert.DeepEqual(t, d.Spec.Template.Spec.SecurityContext.RunAsUser, ptr.Int64(chainsRegistryUID))
+
+	// on OpenShift the registry is served with the certificate of the service CA
+	t.Setenv("PLATFORM", "openshift")
+	manifest, err = ociRegistryManifest(ociRegistryChain(&v1alpha1.ChainsOCIRegistry{Provider: v1alpha1.ChainsOCIRegistryProviderInternal}))
+	assert.NilError(t, err)
+	assert.Equal(t, len(manifest.Resources()), 2)
+	assert.NilError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(manifest.Resources()[0].Object, d))
+	assert.Assert(t, d.Spec.Template.Spec.Volumes[0].EmptyDir != nil)
+	assert.Equal(t, d.Spec.Template.Spec.Volumes[2].Secret.SecretName, chainsRegistryTLSSecret)
+	assert.Assert(t, d.Spec.Template.Spec.SecurityContext.RunAsUser == nil)
+	assert.Equal(t, d.Spec.Template.Spec.Containers[0].ReadinessProbe.HTTPGet.Scheme, corev1.URISchemeHTTPS)
+	assert.Equal(t, manifest.Resources()[1].GetAnnotations()[servingCertSecretAnnotation], chainsRegistryTLSSecret)
+
+	manifest, err = ociRegistryManifest(ociRegistryChain(&v1alpha1.ChainsOCIRegistry{Provider: v1alpha1.ChainsOCIRegistryProviderOpenShift}))
+	assert.NilError(t, err)
+	assert.Equal(t, len(manifest.Resources()), 1)
+	roleBinding := manifest.Resources()[0]
+	assert.Equal(t, roleBinding.GetKind(), "RoleBinding")
+	role, _, _ := unstructured.NestedString(roleBinding.Object, "roleRef", "name")
+	assert.Equal(t, role, openShiftImageBuilderRole)
+}
+
+func TestAddRegistryPullSecret(t *testing.T) {
+	sa := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion":       "v1",
+		"kind":             "ServiceAccount",
+		"metadata":         map[string]interface{}{"name": chainControllerServiceAccount},
+		"imagePullSecrets": []interface{}{map[string]interface{}{"name": "pull-secret"}},
+	}}
+	transformer := addRegistryPullSecret(&v1alpha1.ChainsOCIRegistry{Provider: v1alpha1.ChainsOCIRegistryProviderInternal})
+	assert.NilError(t, transformer(sa))
+	assert.NilError(t, transformer(sa))
+	secrets, _, _ := unstructured.NestedSlice(sa.Object, "imagePullSecrets")
+	assert.DeepEqual(t, secrets, []interface{}{
+		map[string]interface{}{"name": "pull-secret"},
+		map[string]interface{}{"name": chainsRegistryAuthSecret},
+	})
+}
+
+func TestEnsureOCIRegistryCredentials(t *testing.T) {
+	ctx := context.Background()
+	kubeClient := fake.NewSimpleClientset()
+	r := &Reconciler{kubeClientSet: kubeClient}
+	tc := ociRegistryChain(&v1alpha1.ChainsOCIRegistry{Provider: v1alpha1.ChainsOCIRegistryProviderInternal})
+
+	assert.NilError(t, r.ensureOCIRegistryCredentials(ctx, tc))
+	secrets := kubeClient.CoreV1().Secrets("tekton-chains")
+	htpasswd, err := secrets.Get(ctx, chainsRegistryHtpasswdSecret, metav1.GetOptions{})
+	assert.NilError(t, err)
+	auth, err := secrets.Get(ctx, chainsRegistryAuthSecret, metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, auth.Type, corev1.SecretTypeDockerConfigJson)
+	assert.Equal(t, auth.OwnerReferences[0].Name, v1alpha1.ChainResourceName)
+
+	// the password of the docker config matches the htpasswd
+	config := struct {
+		Auths map[string]struct{ Username, Password string }
+	}{}
+	assert.NilError(t, json.Unmarshal(auth.Data[corev1.DockerConfigJsonKey], &config))
+	credentials := config.Auths["tekton-chains-registry.tekton-chains.svc:5000"]
+	user, hash, _ := strings.Cut(strings.TrimSpace(string(htpasswd.Data["htpasswd"])), ":")
+	assert.Equal(t, user, credentials.Username)
+	assert.NilError(t, bcrypt.CompareHashAndPassword([]byte(hash), []byte(credentials.Password)))
+
+	// the password is kept while both secrets exist
+	assert.NilError(t, r.ensureOCIRegistryCredentials(ctx, tc))
+	kept, err := secrets.Get(ctx, chainsRegistryAuthSecret, metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, kept.Data, auth.Data)
+
+	// both secrets are generated again when one is missing
+	assert.NilError(t, secrets.Delete(ctx, chainsRegistryHtpasswdSecret, metav1.DeleteOptions{}))
+	assert.NilError(t, r.ensureOCIRegistryCredentials(ctx, tc))
+	regenerated, err := secrets.Get(ctx, chainsRegistryAuthSecret, metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Assert(t, string(regenerated.Data[corev1.DockerConfigJsonKey]) != string(auth.Data[corev1.DockerConfigJsonKey]))
+	_, err = secrets.Get(ctx, chainsRegistryHtpasswdSecret, metav1.GetOptions{})
+	assert.NilError(t, err)
+}
@@ -40,6 +40,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
 	"knative.dev/pkg/apis"
 	"knative.dev/pkg/logging"
 	pkgreconciler "knative.dev/pkg/reconciler"
@@ -69,6 +70,8 @@ type Reconciler struct {
 
 	// operatorClientSet allows us to configure operator objects
 	operatorClientSet clientset.Interface
+	// kubeClientSet allows us to talk to the k8s for core APIs
+	kubeClientSet kubernetes.Interface
 	// manifest has the source manifest of Tekton Triggers for a
 	// particular version
 	manifest mf.Manifest
@@ -352,6 +355,13 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, tc *v1alpha1.TektonChain
 		logger.Debugw("Using standard deployment type", "resource", v1alpha1.ChainResourceName)
 	}
 
+	// The credentials of the internal registry are created before its deployment mounts them
+	if err := r.ensureOCIRegistryCredentials(ctx, tc); err != nil {
+		logger.Errorw("Failed to create the registry credentials", "error", err)
+		tc.Status.MarkNotReady("failed to create the registry credentials: " + err.Error())
+		return err
+	}
+
 	// Check if a Tekton Chain InstallerSet already exists, if not then create one
 	labelSelector, err := common.LabelSelector(ls)
 	if err != nil {
@@ -471,6 +481,14 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, tc *v1alpha1.TektonChain
 			manifest = manifest.Filter(mf.Not(mf.ByKind("Secret")),
 				mf.Not(mf.All(mf.ByName("chains-config"), mf.ByKind("ConfigMap"))))
 
+			// add the registry provisioned as the storage of the artifacts
+			registryManifest, err := ociRegistryManifest(tc)
+			if err != nil {
+				logger.Errorw("Failed to generate the registry manifest", "error", err)
+				return err
+			}
+			manifest = manifest.Append(registryManifest)
+
 			transformer := filterAndTransform(r.extension)
 			if _, err := transformer(ctx, &manifest, tc); err != nil {
 				logger.Errorw("Manifest transformation failed", "error", err)
@@ -628,6 +646,8 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, tc *v1alpha1.TektonChain
 	tc.Status.MarkInstallerSetReady()
 	logger.Infow("InstallerSet not ready", "name", installedTIS.Name, "message", ready.Message)
 
+	r.updateWorkloadIdentityStatus(ctx, tc)
+
 	if err := r.extension.PostReconcile(ctx, tc); err != nil {
 		errMsg := fmt.Sprintf("PostReconciliation failed: %s", err.Error())
 		tc.Status.MarkPostReconcilerFailed(errMsg)
@@ -650,33 +670,29 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, tc *v1alpha1.TektonChain
 
 // FinalizeKind removes all resources after deletion of a TektonChain.
 func (r *Reconciler) FinalizeKind(ctx context.Context, original *v1alpha1.TektonChain) pkgreconciler.Event {
-	logger := logging.FromContext(ctx)
-
 	// Delete CRDs before deleting rest of resources so that any instance
 	// of CRDs which has finalizer set will get deleted before we remove
 	// the controller's deployment for it
-	if err := r.manifest.Filter(mf.CRDs).Delete(); err != nil {
-		logger.Error("Failed to deleted CRDs for TektonChain")
-		return err
-	}
+	return common.Finalize(ctx, original, &original.Status,
+		common.CRDsFinalizationStep(r.manifest),
+		common.InstallerSetsFinalizationStep(r.operatorClientSet.OperatorV1alpha1().TektonInstallerSets(), ls),
+		common.PlatformFinalizationStep(r.extension, original),
+	)
+}
 
-	labelSelector, err := common.LabelSelector(ls)
-	if err != nil {
-		return err
+// updateWorkloadIdentityStatus reports whether the workload identity annotations were accepted on the
+// service account of the Chains controller, it does not fail the reconcile
+func (r *Reconciler) updateWorkloadIdentityStatus(ctx context.Context, tc *v1alpha1.TektonChain) {
+	if tc.Spec.WorkloadIdentity == nil {
+		tc.Status.ClearWorkloadIdentity()
+		return
 	}
-	if err := r.operatorClientSet.OperatorV1alpha1().TektonInstallerSets().
-		DeleteCollection(ctx, metav1.DeleteOptions{}, metav1.ListOptions{
-			LabelSelector: labelSelector,
-		}); err != nil {
-		logger.Error("Failed to delete installer set created by TektonChain", err)
-		return err
-	}
-
-	if err := r.extension.Finalize(ctx, original); err != nil {
-		logger.Error("Failed to finalize platform resources", err)
+	if err := common.VerifyWorkloadIdentity(ctx, r.kubeClientSet, tc.Spec.GetTargetNamespace(), tc.Spec.WorkloadIdentity, chainControllerServiceAccount); err != nil {
+		logging.FromContext(ctx).Warnw("Workload identity not configured", "error", err)
+		tc.Status.MarkWorkloadIdentityNotReady(err.Error())
+		return
 	}
-
-	return nil
+	tc.Status.MarkWorkloadIdentityReady()
 }
 
 func (r *Reconciler) updateTektonChainStatus(tc *v1alpha1.TektonChain, createdIs *v1alpha1.TektonInstallerSet) error {
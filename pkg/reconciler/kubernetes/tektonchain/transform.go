@@ -29,6 +29,7 @@ const (
 	leaderElectionChainConfig                       = "tekton-chains-config-leader-election"
 	chainControllerDeployment                       = "tekton-chains-controller"
 	chainControllerContainer                        = "tekton-chains-controller"
+	chainControllerServiceAccount                   = "tekton-chains-controller"
 	tektonChainsControllerName                      = "tekton-chains-controller"
 	tektonChainsServiceName                         = "tekton-chains-controller"
 	tektonChainsControllerStatefulServiceName       = "STATEFUL_SERVICE_NAME"
@@ -45,10 +46,14 @@ func filterAndTransform(extension common.Extension) client.FilterAndTransform {
 			common.DeploymentImages(chainImages),
 			common.DeploymentEnvVarKubernetesMinVersion(),
 			common.AddConfiguration(chainCR.Spec.Config),
-			common.AddConfigMapValues(ChainsConfig, chainCR.Spec.Chain.ChainProperties),
+			common.ControlPlaneProxy(chainCR.Spec.Config),
+			common.AddMetricsTLS(*manifest, chainCR.Spec.Config.MetricsTLS),
+			common.AddConfigMapValues(ChainsConfig, chainProperties(chainCR)),
 			common.AddDeploymentRestrictedPSA(),
 			AddControllerEnv(chainCR.Spec.Chain.ControllerEnvs),
 			common.UpdatePerformanceFlagsInDeploymentAndLeaderConfigMap(&chainCR.Spec.Performance, leaderElectionChainConfig, chainControllerDeployment, chainControllerContainer),
+			common.AddWorkloadIdentity(chainCR.Spec.WorkloadIdentity, chainControllerServiceAccount),
+			addRegistryPullSecret(chainCR.Spec.OCIRegistry),
 		}
 		if chainCR.Spec.GenerateSigningSecret {
 			extra = append(extra, common.AddSecretData(generateSigningSecrets(ctx), map[string]string{
@@ -21,6 +21,7 @@ import (
 
 	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
 	tektonDashboardinformer "github.com/tektoncd/operator/pkg/client/injection/informers/operator/v1alpha1/tektondashboard"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
 	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig"
 	"k8s.io/client-go/tools/cache"
 	"knative.dev/pkg/configmap"
@@ -35,7 +36,7 @@ func NewController(ctx context.Context, cmw configmap.Watcher) *controller.Impl
 	ctrl := tektonconfig.NewExtensibleController(KubernetesExtension)(ctx, cmw)
 	if _, err := tektonDashboardinformer.Get(ctx).Informer().AddEventHandler(cache.FilteringResourceEventHandler{
 		FilterFunc: controller.FilterControllerGVK(v1alpha1.SchemeGroupVersion.WithKind("TektonConfig")),
-		Handler:    controller.HandleAll(ctrl.EnqueueControllerOf),
+		Handler:    common.HandleReadinessChanges(ctrl.EnqueueControllerOf),
 	}); err != nil {
 		logger.Panicf("Couldn't register TektonDashboard informer event handler: %w", err)
 	}
@@ -85,13 +85,13 @@ func NewExtendedController(generator common.ExtensionGenerator) injection.Contro
 
 		logger.Debug("Setting up event handlers for tekton-dashboard")
 
-		if _, err := tektonDashboardInformer.Informer().AddEventHandler(controller.HandleAll(impl.Enqueue)); err != nil {
+		if _, err := tektonDashboardInformer.Informer().AddEventHandler(common.HandleSpecChanges(impl.Enqueue)); err != nil {
 			logger.Panicf("Couldn't register TektonDashboard informer event handler: %w", err)
 		}
 
 		if _, err := tektonInstallerinformer.Get(ctx).Informer().AddEventHandler(cache.FilteringResourceEventHandler{
 			FilterFunc: controller.FilterController(&v1alpha1.TektonDashboard{}),
-			Handler:    controller.HandleAll(impl.EnqueueControllerOf),
+			Handler:    common.HandleReadinessChanges(impl.EnqueueControllerOf),
 		}); err != nil {
 			logger.Panicf("Couldn't register TektonInstallerSet informer event handler: %w", err)
 		}
@@ -21,14 +21,12 @@ import (
 
 	mf "github.com/manifestival/manifestival"
 	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
-	"knative.dev/pkg/logging"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
 	pkgreconciler "knative.dev/pkg/reconciler"
 )
 
 // FinalizeKind removes all resources after deletion of a TektonDashboards.
 func (r *Reconciler) FinalizeKind(ctx context.Context, original *v1alpha1.TektonDashboard) pkgreconciler.Event {
-	logger := logging.FromContext(ctx)
-
 	// Delete CRDs before deleting rest of resources so that any instance
 	// of CRDs which has finalizer set will get deleted before we remove
 	// the controller;s deployment for it
@@ -40,17 +38,9 @@ func (r *Reconciler) FinalizeKind(ctx context.Context, original *v1alpha1.Tekton
 		manifest = r.fullaccessManifest
 	}
 
-	if err := manifest.Filter(mf.CRDs).Delete(); err != nil {
-		logger.Error("Failed to deleted CRDs for TektonDashboard")
-		return err
-	}
-
-	if err := r.installerSetClient.CleanupMainSet(ctx); err != nil {
-		logger.Error("failed to cleanup main installerset: ", err)
-	}
-
-	if err := r.extension.Finalize(ctx, original); err != nil {
-		logger.Error("Failed to finalize platform resources", err)
-	}
-	return nil
+	return common.Finalize(ctx, original, &original.Status,
+		common.CRDsFinalizationStep(manifest),
+		common.PlatformFinalizationStep(r.extension, original),
+		common.FinalizationStep{Name: "main installer set", Stage: common.FinalizeInstallerSets, Optional: true, Run: r.installerSetClient.CleanupMainSet},
+	)
 }
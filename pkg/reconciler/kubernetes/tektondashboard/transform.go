@@ -42,6 +42,7 @@ func filterAndTransform(extension common.Extension) client.FilterAndTransform {
 		extra := []mf.Transformer{
 			common.InjectOperandNameLabelOverwriteExisting(v1alpha1.OperandTektoncdDashboard),
 			common.AddConfiguration(dashboard.Spec.Config),
+			common.ControlPlaneProxy(dashboard.Spec.Config),
 			common.AddDeploymentRestrictedPSA(),
 			common.DeploymentImages(images),
 			common.DeploymentEnvVarKubernetesMinVersion(),
@@ -35,6 +35,7 @@ import (
 	"knative.dev/pkg/controller"
 	"knative.dev/pkg/injection"
 	"knative.dev/pkg/logging"
+	"knative.dev/pkg/system"
 )
 
 // NewController initializes the controller and is called by the generated code
@@ -71,18 +72,19 @@ func NewExtendedController(generator common.ExtensionGenerator) injection.Contro
 			extension:         generator(ctx),
 			manifest:          manifest,
 			operatorVersion:   operatorVer,
+			payloadCache:      common.NewPayloadCache(kubeClient, system.Namespace()),
 		}
 		impl := tektonHubReconciler.NewImpl(ctx, c)
 
 		logger.Debug("Setting up event handlers")
 
-		if _, err := tektonHubInformer.Informer().AddEventHandler(controller.HandleAll(impl.Enqueue)); err != nil {
+		if _, err := tektonHubInformer.Informer().AddEventHandler(common.HandleSpecChanges(impl.Enqueue)); err != nil {
 			logger.Panicf("Couldn't register TektonHub informer event handler: %w", err)
 		}
 
 		if _, err := tektonInstallerinformer.Get(ctx).Informer().AddEventHandler(cache.FilteringResourceEventHandler{
 			FilterFunc: controller.FilterController(&v1alpha1.TektonHub{}),
-			Handler:    controller.HandleAll(impl.EnqueueControllerOf),
+			Handler:    common.HandleReadinessChanges(impl.EnqueueControllerOf),
 		}); err != nil {
 			logger.Panicf("Couldn't register TektonInstallerSet informer event handler: %w", err)
 		}
@@ -21,8 +21,6 @@ import (
 	"bytes"
 	"context"
 	"fmt"
-	"io"
-	"net/http"
 	"path/filepath"
 	"reflect"
 	"strings"
@@ -61,6 +59,8 @@ type Reconciler struct {
 	// Platform-specific behavior to affect the transform
 	extension       common.Extension
 	operatorVersion string
+	// payloadCache holds the configuration fetched from the hub config URL
+	payloadCache *common.PayloadCache
 }
 
 const (
@@ -132,24 +132,10 @@ type Data struct {
 
 // FinalizeKind removes all resources after deletion of a TektonHub.
 func (r *Reconciler) FinalizeKind(ctx context.Context, original *v1alpha1.TektonHub) pkgreconciler.Event {
-	logger := logging.FromContext(ctx)
-
-	labelSelector, err := common.LabelSelector(ls)
-	if err != nil {
-		return err
-	}
-	if err := r.operatorClientSet.OperatorV1alpha1().TektonInstallerSets().
-		DeleteCollection(ctx, metav1.DeleteOptions{}, metav1.ListOptions{
-			LabelSelector: labelSelector,
-		}); err != nil {
-		logger.Error("Failed to delete installer set created by TektonHub", err)
-		return err
-	}
-
-	if err := r.extension.Finalize(ctx, original); err != nil {
-		logger.Error("Failed to finalize platform resources", err)
-	}
-	return nil
+	return common.Finalize(ctx, original, &original.Status,
+		common.InstallerSetsFinalizationStep(r.operatorClientSet.OperatorV1alpha1().TektonInstallerSets(), ls),
+		common.PlatformFinalizationStep(r.extension, original),
+	)
 }
 
 // ReconcileKind compares the actual state with the desired, and attempts to
@@ -576,7 +562,7 @@ func (r *Reconciler) getManifest(ctx context.Context, th *v1alpha1.TektonHub, ma
 		return nil, err
 	}
 
-	transformer := filterAndTransform(r.extension)
+	transformer := filterAndTransform(r.extension, r.payloadCache)
 	transformedManifest, err := transformer(ctx, &manifest, th)
 	if err != nil {
 		return nil, err
@@ -870,7 +856,7 @@ func addConfigMapKeyValue(configMapName, key, value string) mf.Transformer {
 	}
 }
 
-func updateApiConfigMap(th *v1alpha1.TektonHub, configMapName string) mf.Transformer {
+func updateApiConfigMap(ctx context.Context, payloadCache *common.PayloadCache, th *v1alpha1.TektonHub, configMapName string) mf.Transformer {
 	return func(u *unstructured.Unstructured) error {
 
 		kind := strings.ToLower(u.GetKind())
@@ -891,7 +877,7 @@ func updateApiConfigMap(th *v1alpha1.TektonHub, configMapName string) mf.Transfo
 		// TODO: Remove this condition in the next release
 		if th.Spec.Api.HubConfigUrl != "" {
 
-			hubUrlConfigdata, err := getConfigDataFromHubURL(th)
+			hubUrlConfigdata, err := getConfigDataFromHubURL(ctx, payloadCache, th)
 			if err != nil {
 				return err
 			}
@@ -1010,16 +996,12 @@ func getCatalogData(c v1alpha1.Catalog, th *v1alpha1.TektonHub) string {
 }
 
 // TODO: Remove this function in the next release
-func getConfigDataFromHubURL(th *v1alpha1.TektonHub) (*Data, error) {
+// getConfigDataFromHubURL reads the configuration from the hub config URL, it is downloaded once
+// into the payload cache
+func getConfigDataFromHubURL(ctx context.Context, payloadCache *common.PayloadCache, th *v1alpha1.TektonHub) (*Data, error) {
 	var data = &Data{}
 	if th.Spec.Api.HubConfigUrl != "" {
-		resp, err := http.Get(th.Spec.Api.HubConfigUrl)
-		if err != nil {
-			return nil, err
-		}
-		defer resp.Body.Close()
-
-		body, err := io.ReadAll(resp.Body)
+		body, err := common.FetchPayload(ctx, payloadCache, th.Spec.Api.HubConfigUrl, "", common.DownloadPayload)
 		if err != nil {
 			return nil, err
 		}
@@ -26,7 +26,7 @@ import (
 	"knative.dev/pkg/logging"
 )
 
-func filterAndTransform(extension common.Extension) client.FilterAndTransform {
+func filterAndTransform(extension common.Extension, payloadCache *common.PayloadCache) client.FilterAndTransform {
 	return func(ctx context.Context, manifest *mf.Manifest, comp v1alpha1.TektonComponent) (*mf.Manifest, error) {
 		logger := logging.FromContext(ctx)
 		hubCR := comp.(*v1alpha1.TektonHub)
@@ -42,7 +42,7 @@ func filterAndTransform(extension common.Extension) client.FilterAndTransform {
 			common.DeploymentImages(images),
 			common.DeploymentEnvVarKubernetesMinVersion(),
 			common.JobImages(images),
-			updateApiConfigMap(hubCR, apiConfigMapName),
+			updateApiConfigMap(ctx, payloadCache, hubCR, apiConfigMapName),
 			addConfigMapKeyValue(uiConfigMapName, "API_URL", hubCR.Status.ApiRouteUrl),
 			addConfigMapKeyValue(uiConfigMapName, "AUTH_BASE_URL", hubCR.Status.AuthRouteUrl),
 			addConfigMapKeyValue(uiConfigMapName, "API_VERSION", "v1"),
@@ -0,0 +1,157 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektoninstallerset
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"knative.dev/pkg/metrics"
+)
+
+const (
+	// slowestResourcesCount is the number of resources reported in the summary of an apply
+	slowestResourcesCount = 5
+	// slowApplyThreshold is the time above which the summary of an apply is logged at info level,
+	// it is logged at debug level otherwise as the installer sets are reconciled often
+	slowApplyThreshold = 10 * time.Second
+)
+
+var (
+	resourceApplyDuration = stats.Float64("installerset_resource_apply_duration",
+		"time to apply a resource of an installer set, including the requests to get and update it",
+		stats.UnitMilliseconds)
+	resourceKindKey           = tag.MustNewKey("kind")
+	registerApplyDurationView = sync.OnceValue(func() error {
+		return view.Register(&view.View{
+			Description: resourceApplyDuration.Description(),
+			Measure:     resourceApplyDuration,
+			Aggregation: view.Distribution(5, 10, 25, 50, 100, 250, 500, 1000, 2500, 5000, 10000, 30000),
+			TagKeys:     []tag.Key{resourceKindKey},
+		})
+	})
+)
+
+// resourceTiming is the time taken to apply a resource
+type resourceTiming struct {
+	kind      string
+	namespace string
+	name      string
+	duration  time.Duration
+}
+
+func (t resourceTiming) String() string {
+	if t.namespace == "" {
+		return fmt.Sprintf("%s/%s: %s", t.kind, t.name, t.duration.Round(time.Millisecond))
+	}
+	return fmt.Sprintf("%s/%s/%s: %s", t.kind, t.namespace, t.name, t.duration.Round(time.Millisecond))
+}
+
+// applyTimings records the time taken to apply the resources of an installer set, so that the
+// slow kinds can be identified on slow API servers
+type applyTimings struct {
+	resources []resourceTiming
+}
+
+// observe records the time taken to apply the resource in the metrics and in the summary
+func (a *applyTimings) observe(u *unstructured.Unstructured, duration time.Duration) {
+	a.resources = append(a.resources, resourceTiming{
+		kind:      u.GetKind(),
+		namespace: u.GetNamespace(),
+		name:      u.GetName(),
+		duration:  duration,
+	})
+	if err := registerApplyDurationView(); err != nil {
+		return
+	}
+	ctx, err := tag.New(context.Background(), tag.Insert(resourceKindKey, u.GetKind()))
+	if err != nil {
+		return
+	}
+	metrics.Record(ctx, resourceApplyDuration.M(float64(duration.Milliseconds())))
+}
+
+// total returns the time taken to apply all the resources
+func (a *applyTimings) total() time.Duration {
+	var total time.Duration
+	for _, r := range a.resources {
+		total += r.duration
+	}
+	return total
+}
+
+// byKind returns the time taken to apply the resources of every kind, formatted to be logged
+func (a *applyTimings) byKind() map[string]string {
+	durations := map[string]time.Duration{}
+	for _, r := range a.resources {
+		durations[r.kind] += r.duration
+	}
+	kinds := make(map[string]string, len(durations))
+	for kind, d := range durations {
+		kinds[kind] = d.Round(time.Millisecond).String()
+	}
+	return kinds
+}
+
+// slowest returns the n resources which took the longest to apply, the slowest first
+func (a *applyTimings) slowest(n int) []resourceTiming {
+	resources := make([]resourceTiming, len(a.resources))
+	copy(resources, a.resources)
+	sort.SliceStable(resources, func(i, j int) bool {
+		return resources[i].duration > resources[j].duration
+	})
+	if len(resources) > n {
+		resources = resources[:n]
+	}
+	return resources
+}
+
+// summary returns the slowest resources, formatted to be logged
+func (a *applyTimings) summary() string {
+	slowest := a.slowest(slowestResourcesCount)
+	entries := make([]string, 0, len(slowest))
+	for _, r := range slowest {
+		entries = append(entries, r.String())
+	}
+	return strings.Join(entries, ", ")
+}
+
+// logApplyTimings logs the time taken to apply the resources by kind and the slowest resources
+func (i *installer) logApplyTimings() {
+	if len(i.timings.resources) == 0 {
+		return
+	}
+	total := i.timings.total()
+	log := i.logger.Debugw
+	if total > slowApplyThreshold {
+		log = i.logger.Infow
+	}
+	log("applied the resources of the installer set",
+		"resources", len(i.timings.resources),
+		"duration", total.Round(time.Millisecond).String(),
+		"durationByKind", i.timings.byKind(),
+		"slowest", i.timings.summary(),
+	)
+}
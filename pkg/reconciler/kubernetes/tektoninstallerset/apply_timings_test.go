@@ -0,0 +1,56 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektoninstallerset
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func timedResource(kind, namespace, name string) *unstructured.Unstructured {
+	u := &unstructured.Unstructured{}
+	u.SetKind(kind)
+	u.SetNamespace(namespace)
+	u.SetName(name)
+	return u
+}
+
+func TestApplyTimings(t *testing.T) {
+	timings := applyTimings{}
+	timings.observe(timedResource("CustomResourceDefinition", "", "tasks.tekton.dev"), 900*time.Millisecond)
+	timings.observe(timedResource("ConfigMap", "tekton-pipelines", "config-defaults"), 20*time.Millisecond)
+	timings.observe(timedResource("ConfigMap", "tekton-pipelines", "feature-flags"), 30*time.Millisecond)
+	timings.observe(timedResource("Deployment", "tekton-pipelines", "tekton-pipelines-controller"), 2*time.Second)
+
+	assert.Equal(t, timings.total(), 2950*time.Millisecond)
+	assert.DeepEqual(t, timings.byKind(), map[string]string{
+		"CustomResourceDefinition": "900ms",
+		"ConfigMap":                "50ms",
+		"Deployment":               "2s",
+	})
+	slowest := timings.slowest(2)
+	assert.Equal(t, len(slowest), 2)
+	assert.Equal(t, slowest[0].name, "tekton-pipelines-controller")
+	assert.Equal(t, slowest[1].name, "tasks.tekton.dev")
+	assert.Equal(t, len(timings.slowest(10)), 4)
+	assert.Equal(t, timings.summary(),
+		"Deployment/tekton-pipelines/tekton-pipelines-controller: 2s, CustomResourceDefinition/tasks.tekton.dev: 900ms, "+
+			"ConfigMap/tekton-pipelines/feature-flags: 30ms, ConfigMap/tekton-pipelines/config-defaults: 20ms")
+}
@@ -34,6 +34,8 @@ const (
 	InstallerTypePre    = "pre"
 	InstallerTypePost   = "post"
 	InstallerTypeCustom = "custom"
+	// InstallerTypePayload is the type of the installer sets staged by the payload switchover
+	InstallerTypePayload = "payload"
 )
 
 var (
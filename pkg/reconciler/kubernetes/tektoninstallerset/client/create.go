@@ -24,6 +24,7 @@ import (
 
 	mf "github.com/manifestival/manifestival"
 	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
 	"github.com/tektoncd/operator/pkg/reconciler/shared/hash"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"knative.dev/pkg/apis"
@@ -50,13 +51,32 @@ func (i *InstallerSetClient) create(ctx context.Context, comp v1alpha1.TektonCom
 		return nil, err
 	}
 
-	iS, err = i.clientSet.Create(ctx, iS, metav1.CreateOptions{})
+	iS, err = i.submitSet(ctx, iS)
 	if err != nil {
 		return nil, err
 	}
 	return []v1alpha1.TektonInstallerSet{*iS}, nil
 }
 
+// submitSet creates the installer set, the installer sets have generated names so only the
+// requests rejected by the API server are retried
+func (i *InstallerSetClient) submitSet(ctx context.Context, set *v1alpha1.TektonInstallerSet) (*v1alpha1.TektonInstallerSet, error) {
+	var created *v1alpha1.TektonInstallerSet
+	err := common.RetryNonIdempotent(ctx, "create installer set", func() error {
+		var err error
+		created, err = i.clientSet.Create(ctx, set, metav1.CreateOptions{})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	// the installer set reconciler adopts the manifests again when this fails
+	if err := common.AdoptInstallerSetManifests(ctx, created); err != nil {
+		logging.FromContext(ctx).Warnw("failed to adopt the manifests of the installer set", "installerSet", created.GetName(), "error", err)
+	}
+	return created, nil
+}
+
 func (i *InstallerSetClient) makeMainSets(ctx context.Context, comp v1alpha1.TektonComponent, manifest *mf.Manifest) ([]v1alpha1.TektonInstallerSet, error) {
 	staticManifest := manifest.Filter(mf.Not(mf.ByKind("Deployment")), mf.Not(mf.ByKind("Service")))
 	deploymentManifest := manifest.Filter(mf.Any(mf.ByKind("Deployment"), mf.ByKind("Service")))
@@ -69,7 +89,7 @@ func (i *InstallerSetClient) makeMainSets(ctx context.Context, comp v1alpha1.Tek
 	if err != nil {
 		return nil, err
 	}
-	staticIS, err = i.clientSet.Create(ctx, staticIS, metav1.CreateOptions{})
+	staticIS, err = i.submitSet(ctx, staticIS)
 	if err != nil {
 		return nil, err
 	}
@@ -85,7 +105,7 @@ func (i *InstallerSetClient) makeMainSets(ctx context.Context, comp v1alpha1.Tek
 		return nil, err
 	}
 
-	deploymentIS, err = i.clientSet.Create(ctx, deploymentIS, metav1.CreateOptions{})
+	deploymentIS, err = i.submitSet(ctx, deploymentIS)
 	if err != nil {
 		return nil, err
 	}
@@ -101,7 +121,7 @@ func (i *InstallerSetClient) makeMainSets(ctx context.Context, comp v1alpha1.Tek
 			return nil, err
 		}
 
-		stsIS, err = i.clientSet.Create(ctx, stsIS, metav1.CreateOptions{})
+		stsIS, err = i.submitSet(ctx, stsIS)
 		if err != nil {
 			return nil, err
 		}
@@ -144,7 +164,7 @@ func (i *InstallerSetClient) makeInstallerSet(ctx context.Context, comp v1alpha1
 	}
 
 	ownerRef := *metav1.NewControllerRef(comp, v1alpha1.SchemeGroupVersion.WithKind(i.resourceKind))
-	return &v1alpha1.TektonInstallerSet{
+	set := &v1alpha1.TektonInstallerSet{
 		ObjectMeta: metav1.ObjectMeta{
 			GenerateName: isName,
 			Labels:       labels,
@@ -154,10 +174,11 @@ func (i *InstallerSetClient) makeInstallerSet(ctx context.Context, comp v1alpha1
 			},
 			OwnerReferences: []metav1.OwnerReference{ownerRef},
 		},
-		Spec: v1alpha1.TektonInstallerSetSpec{
-			Manifests: manifest.Resources(),
-		},
-	}, nil
+	}
+	if err := setManifests(ctx, set, manifest); err != nil {
+		return nil, err
+	}
+	return set, nil
 }
 
 func (i *InstallerSetClient) getDefaultLabels(isType string) map[string]string {
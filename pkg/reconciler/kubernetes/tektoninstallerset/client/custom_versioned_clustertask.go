@@ -24,7 +24,6 @@ import (
 	mf "github.com/manifestival/manifestival"
 	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
 	"github.com/tektoncd/operator/pkg/reconciler/common"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"knative.dev/pkg/logging"
 )
@@ -70,7 +69,7 @@ func (i *InstallerSetClient) VersionedTaskSet(ctx context.Context, comp v1alpha1
 		vctSet.Labels[v1alpha1.ReleaseMinorVersionKey] = getPatchVersionTrimmed(i.releaseVersion)
 		vctSet.GenerateName = fmt.Sprintf("%s-%s-", insName, getPatchVersionTrimmed(i.releaseVersion))
 
-		_, err = i.clientSet.Create(ctx, vctSet, metav1.CreateOptions{})
+		_, err = i.submitSet(ctx, vctSet)
 		if err != nil {
 			return err
 		}
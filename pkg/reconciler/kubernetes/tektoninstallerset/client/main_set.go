@@ -47,9 +47,27 @@ func (i *InstallerSetClient) MainSet(ctx context.Context, comp v1alpha1.TektonCo
 		return err
 	}
 
+	// the webhooks are served by the payload the payload switchover switched them to
+	transformed := manifestUpdated
+	payloads, err := i.payloadSets(ctx)
+	if err != nil {
+		return err
+	}
+	webhookNamespace := ""
+	if active := i.activePayload(payloads); active != nil {
+		webhookNamespace = active.GetAnnotations()[v1alpha1.TargetNamespaceKey]
+		manifestUpdated, err = servedByPayload(manifestUpdated, comp.GetSpec().GetTargetNamespace(), webhookNamespace)
+		if err != nil {
+			return err
+		}
+	}
+
 	sets, err := i.checkSet(ctx, comp, setType)
 	if err == nil {
 		logger.Debugf("%v/%v: found %v installer sets", i.resourceKind, setType, len(sets))
+		if webhookNamespaceChanged(sets, webhookNamespace) {
+			err = ErrUpdateRequired
+		}
 	}
 
 	switch err {
@@ -65,6 +83,11 @@ func (i *InstallerSetClient) MainSet(ctx context.Context, comp v1alpha1.TektonCo
 		}
 
 	case ErrInvalidState, ErrNsDifferent, ErrVersionDifferent:
+		if err == ErrVersionDifferent {
+			if staged, err := i.stagePayload(ctx, comp, sets, transformed); staged {
+				return err
+			}
+		}
 		logger.Debugf("%v/%v: installer set not in valid state : %v, cleaning up!", i.resourceKind, setType, err)
 		if err := i.CleanupMainSet(ctx); err != nil {
 			logger.Errorf("%v/%v: failed to cleanup main installer set: %v", i.resourceKind, setType, err)
@@ -91,6 +114,10 @@ func (i *InstallerSetClient) MainSet(ctx context.Context, comp v1alpha1.TektonCo
 		return v1alpha1.REQUEUE_EVENT_AFTER
 	}
 
+	if err := i.setWebhookNamespace(ctx, sets, webhookNamespace); err != nil {
+		return err
+	}
+
 	//Mark InstallerSet Available
 	comp.GetStatus().MarkInstallerSetAvailable()
 
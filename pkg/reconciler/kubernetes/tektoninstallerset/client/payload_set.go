@@ -0,0 +1,383 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	mf "github.com/manifestival/manifestival"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"knative.dev/pkg/logging"
+)
+
+const (
+	componentLabelKey = "app.kubernetes.io/component"
+	componentWebhook  = "webhook"
+)
+
+var (
+	// the API groups of the webhook configurations and the CRDs, the staged webhooks only read them
+	// until the switchover
+	webhookConfigurationAPIGroups = sets.NewString("admissionregistration.k8s.io", "apiextensions.k8s.io", "*")
+	readVerbs                     = sets.NewString("get", "list", "watch")
+)
+
+// payloadSets returns the installer sets of the payloads of the component staged by the payload
+// switchover of TektonConfig
+func (i *InstallerSetClient) payloadSets(ctx context.Context) ([]v1alpha1.TektonInstallerSet, error) {
+	list, err := i.clientSet.List(ctx, metav1.ListOptions{LabelSelector: i.getSetLabels(InstallerTypePayload)})
+	if err != nil {
+		return nil, err
+	}
+	var sets []v1alpha1.TektonInstallerSet
+	for _, set := range list.Items {
+		if set.Labels[v1alpha1.CreatedByKey] == i.resourceKind && set.Labels[v1alpha1.InstallerSetType] == InstallerTypePayload {
+			sets = append(sets, set)
+		}
+	}
+	return sets, nil
+}
+
+// activePayload returns the staged payload of the release the webhooks are switched to
+func (i *InstallerSetClient) activePayload(sets []v1alpha1.TektonInstallerSet) *v1alpha1.TektonInstallerSet {
+	for idx := range sets {
+		set := &sets[idx]
+		if set.DeletionTimestamp == nil && set.Labels[v1alpha1.ReleaseVersionKey] == i.releaseVersion &&
+			set.Annotations[v1alpha1.PayloadSwitchedKey] == "true" {
+			return set
+		}
+	}
+	return nil
+}
+
+// stagePayload stages the webhooks of the release in the staging namespace recorded on the main
+// installer sets by the payload switchover, instead of upgrading the main installer sets in place.
+// The main installer sets are replaced once the webhooks are switched to the staged payload. It
+// returns false when the main installer sets are upgraded in place.
+func (i *InstallerSetClient) stagePayload(ctx context.Context, comp v1alpha1.TektonComponent, mainSets []v1alpha1.TektonInstallerSet, manifest *mf.Manifest) (bool, error) {
+	logger := logging.FromContext(ctx).With("kind", i.resourceKind, "type", InstallerTypePayload)
+
+	var namespace, group string
+	for _, set := range mainSets {
+		if ns := set.Annotations[v1alpha1.PayloadStagingNamespaceKey]; ns != "" {
+			namespace, group = ns, set.Annotations[v1alpha1.PayloadGroupKey]
+		}
+	}
+	staged := manifest.Filter(stagedResources)
+	if namespace == "" || len(staged.Filter(webhookWorkload).Resources()) == 0 {
+		return false, nil
+	}
+
+	payloads, err := i.payloadSets(ctx)
+	if err != nil {
+		return true, err
+	}
+	var current *v1alpha1.TektonInstallerSet
+	for idx := range payloads {
+		set := &payloads[idx]
+		if set.Annotations[v1alpha1.TargetNamespaceKey] != namespace || set.DeletionTimestamp != nil {
+			continue
+		}
+		if set.Labels[v1alpha1.ReleaseVersionKey] != i.releaseVersion {
+			// left over by a release the webhooks were never switched to
+			if err := i.clientSet.Delete(ctx, set.Name, metav1.DeleteOptions{}); err != nil && !apierrs.IsNotFound(err) {
+				return true, err
+			}
+			continue
+		}
+		current = set
+	}
+
+	switch {
+	case current == nil:
+		logger.Infof("staging the payload of %s in namespace %s", i.releaseVersion, namespace)
+		clusterRBAC, err := stagedClusterRBAC(manifest, group)
+		if err != nil {
+			return true, err
+		}
+		staged, err := staged.Append(clusterRBAC).Transform(mf.InjectNamespace(namespace))
+		if err != nil {
+			return true, err
+		}
+		kind := strings.ToLower(strings.TrimPrefix(i.resourceKind, "Tekton"))
+		set, err := i.makeInstallerSet(ctx, comp, &staged, fmt.Sprintf("%s-%s-%s-", kind, InstallerTypePayload, group), InstallerTypePayload,
+			map[string]string{v1alpha1.PayloadGroupKey: group})
+		if err != nil {
+			return true, err
+		}
+		set.Annotations[v1alpha1.TargetNamespaceKey] = namespace
+		if _, err := i.submitSet(ctx, set); err != nil {
+			return true, err
+		}
+	case current.Annotations[v1alpha1.PayloadSwitchedKey] != "true":
+		logger.Debugf("waiting for the webhooks to be switched to the payload staged in namespace %s", namespace)
+	default:
+		// the webhooks are switched to the staged payload, the previous release is retired
+		logger.Infof("replacing the main installer sets, the webhooks are switched to namespace %s", namespace)
+		if err := i.CleanupMainSet(ctx); err != nil {
+			return true, err
+		}
+	}
+	markComponentStatus(comp, v1alpha1.UpgradePending)
+	return true, v1alpha1.REQUEUE_EVENT_AFTER
+}
+
+// servedByPayload drops the webhook workloads from the manifest, points the webhook
+// configurations at the services of the payload namespace, and binds the service accounts of the
+// payload webhooks to the ClusterRoles of the webhooks so that they reconcile the webhook
+// configurations
+func servedByPayload(manifest *mf.Manifest, targetNamespace, namespace string) (*mf.Manifest, error) {
+	served, err := manifest.Filter(mf.Not(webhookWorkload)).Transform(
+		webhookServiceNamespace(targetNamespace, namespace),
+		bindPayloadWebhooks(webhookServiceAccounts(manifest), targetNamespace, namespace),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &served, nil
+}
+
+// bindPayloadWebhooks adds the service accounts of the webhooks of the payload namespace to the
+// ClusterRoleBindings of the service accounts of the webhooks of the target namespace
+func bindPayloadWebhooks(serviceAccounts sets.String, targetNamespace, namespace string) mf.Transformer {
+	return func(u *unstructured.Unstructured) error {
+		if u.GetKind() != "ClusterRoleBinding" {
+			return nil
+		}
+		subjects, _, err := unstructured.NestedSlice(u.Object, "subjects")
+		if err != nil {
+			return err
+		}
+		for _, s := range webhookSubjects(subjects, serviceAccounts) {
+			subject := s.(map[string]interface{})
+			if ns, _, _ := unstructured.NestedString(subject, "namespace"); ns != targetNamespace {
+				continue
+			}
+			payload := runtime.DeepCopyJSON(subject)
+			payload["namespace"] = namespace
+			subjects = append(subjects, payload)
+		}
+		return unstructured.SetNestedSlice(u.Object, subjects, "subjects")
+	}
+}
+
+// stagedClusterRBAC returns the ClusterRoleBindings of the service accounts of the webhooks, and the
+// ClusterRoles of the manifest they bind, renamed for the group so that the ones of the live
+// webhooks are left as is. The staged ClusterRoles only read the webhook configurations and the
+// CRDs, the staged webhooks would otherwise rewrite the live webhook configurations with their own
+// caBundle, they are bound to the ClusterRoles of the live webhooks on the switchover.
+func stagedClusterRBAC(manifest *mf.Manifest, group string) (mf.Manifest, error) {
+	serviceAccounts := webhookServiceAccounts(manifest)
+	clusterRoles := manifest.Filter(mf.ByKind("ClusterRole"))
+	bound := sets.NewString()
+	var staged []unstructured.Unstructured
+	for _, crb := range manifest.Filter(mf.ByKind("ClusterRoleBinding")).Resources() {
+		subjects, _, err := unstructured.NestedSlice(crb.Object, "subjects")
+		if err != nil {
+			return mf.Manifest{}, err
+		}
+		webhookSubjects := webhookSubjects(subjects, serviceAccounts)
+		if len(webhookSubjects) == 0 {
+			continue
+		}
+		binding := crb.DeepCopy()
+		binding.SetName(fmt.Sprintf("%s-%s", crb.GetName(), group))
+		if err := unstructured.SetNestedSlice(binding.Object, webhookSubjects, "subjects"); err != nil {
+			return mf.Manifest{}, err
+		}
+		role, _, _ := unstructured.NestedString(binding.Object, "roleRef", "name")
+		if len(clusterRoles.Filter(mf.ByName(role)).Resources()) != 0 {
+			bound.Insert(role)
+			if err := unstructured.SetNestedField(binding.Object, fmt.Sprintf("%s-%s", role, group), "roleRef", "name"); err != nil {
+				return mf.Manifest{}, err
+			}
+		}
+		staged = append(staged, *binding)
+	}
+	for _, cr := range clusterRoles.Resources() {
+		if !bound.Has(cr.GetName()) {
+			continue
+		}
+		role := cr.DeepCopy()
+		role.SetName(fmt.Sprintf("%s-%s", cr.GetName(), group))
+		if err := readOnlyWebhookConfigurations(role); err != nil {
+			return mf.Manifest{}, err
+		}
+		staged = append(staged, *role)
+	}
+	return mf.ManifestFrom(mf.Slice(staged))
+}
+
+// readOnlyWebhookConfigurations drops the verbs of the rules of the ClusterRole on the webhook
+// configurations and the CRDs which do not read them
+func readOnlyWebhookConfigurations(u *unstructured.Unstructured) error {
+	rules, _, err := unstructured.NestedSlice(u.Object, "rules")
+	if err != nil {
+		return err
+	}
+	kept := make([]interface{}, 0, len(rules))
+	for _, r := range rules {
+		rule, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		groups, _, _ := unstructured.NestedStringSlice(rule, "apiGroups")
+		if !webhookConfigurationAPIGroups.HasAny(groups...) {
+			kept = append(kept, rule)
+			continue
+		}
+		verbs, _, _ := unstructured.NestedStringSlice(rule, "verbs")
+		read := sets.NewString()
+		for _, verb := range verbs {
+			if verb == "*" {
+				read.Insert(readVerbs.List()...)
+			} else if readVerbs.Has(verb) {
+				read.Insert(verb)
+			}
+		}
+		if read.Len() == 0 {
+			continue
+		}
+		if err := unstructured.SetNestedStringSlice(rule, read.List(), "verbs"); err != nil {
+			return err
+		}
+		kept = append(kept, rule)
+	}
+	return unstructured.SetNestedSlice(u.Object, kept, "rules")
+}
+
+// webhookServiceAccounts returns the service accounts of the webhook deployments of the manifest
+func webhookServiceAccounts(manifest *mf.Manifest) sets.String {
+	serviceAccounts := sets.NewString()
+	for _, u := range manifest.Filter(mf.ByKind("Deployment"), webhookWorkload).Resources() {
+		if name, _, _ := unstructured.NestedString(u.Object, "spec", "template", "spec", "serviceAccountName"); name != "" {
+			serviceAccounts.Insert(name)
+		}
+	}
+	return serviceAccounts
+}
+
+// webhookSubjects returns the subjects of a binding which are service accounts of the webhooks
+func webhookSubjects(subjects []interface{}, serviceAccounts sets.String) []interface{} {
+	var selected []interface{}
+	for _, s := range subjects {
+		subject, ok := s.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		kind, _, _ := unstructured.NestedString(subject, "kind")
+		name, _, _ := unstructured.NestedString(subject, "name")
+		if kind == "ServiceAccount" && serviceAccounts.Has(name) {
+			selected = append(selected, subject)
+		}
+	}
+	return selected
+}
+
+// webhookServiceNamespace points the webhooks served from the target namespace at the services of
+// the namespace
+func webhookServiceNamespace(targetNamespace, namespace string) mf.Transformer {
+	return func(u *unstructured.Unstructured) error {
+		if kind := u.GetKind(); kind != "ValidatingWebhookConfiguration" && kind != "MutatingWebhookConfiguration" {
+			return nil
+		}
+		webhooks, found, err := unstructured.NestedSlice(u.Object, "webhooks")
+		if err != nil || !found {
+			return err
+		}
+		for _, w := range webhooks {
+			webhook, ok := w.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if ns, _, _ := unstructured.NestedString(webhook, "clientConfig", "service", "namespace"); ns != targetNamespace {
+				continue
+			}
+			if err := unstructured.SetNestedField(webhook, namespace, "clientConfig", "service", "namespace"); err != nil {
+				return err
+			}
+		}
+		return unstructured.SetNestedSlice(u.Object, webhooks, "webhooks")
+	}
+}
+
+// webhookNamespaceChanged returns true when the webhook configurations of the main installer sets
+// do not point at the namespace
+func webhookNamespaceChanged(sets []v1alpha1.TektonInstallerSet, namespace string) bool {
+	for _, set := range sets {
+		if set.Annotations[v1alpha1.WebhookNamespaceKey] != namespace {
+			return true
+		}
+	}
+	return false
+}
+
+// setWebhookNamespace records on the main installer sets the namespace their webhook configurations
+// point at, it is removed when they point at the target namespace
+func (i *InstallerSetClient) setWebhookNamespace(ctx context.Context, sets []v1alpha1.TektonInstallerSet, namespace string) error {
+	for _, set := range sets {
+		if set.Annotations[v1alpha1.WebhookNamespaceKey] == namespace {
+			continue
+		}
+		onCluster, err := i.clientSet.Get(ctx, set.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		annotations := onCluster.GetAnnotations()
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		if namespace == "" {
+			delete(annotations, v1alpha1.WebhookNamespaceKey)
+		} else {
+			annotations[v1alpha1.WebhookNamespaceKey] = namespace
+		}
+		onCluster.SetAnnotations(annotations)
+		if _, err := i.clientSet.Update(ctx, onCluster, metav1.UpdateOptions{}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// stagedResources selects the webhook workloads and the namespaced resources they need, the
+// controllers are not staged as they would reconcile the same resources twice. The cluster
+// scoped RBAC of the webhooks is staged by stagedClusterRBAC.
+func stagedResources(u *unstructured.Unstructured) bool {
+	switch u.GetKind() {
+	case "Deployment", "Service":
+		return webhookWorkload(u)
+	case "ServiceAccount", "ConfigMap", "Secret", "Role", "RoleBinding":
+		return true
+	}
+	return false
+}
+
+func webhookWorkload(u *unstructured.Unstructured) bool {
+	switch u.GetKind() {
+	case "Deployment", "Service":
+		return u.GetLabels()[componentLabelKey] == componentWebhook
+	}
+	return false
+}
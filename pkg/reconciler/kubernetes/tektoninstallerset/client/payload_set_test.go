@@ -0,0 +1,254 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"testing"
+
+	mf "github.com/manifestival/manifestival"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	fake2 "github.com/tektoncd/operator/pkg/reconciler/kubernetes/tektoninstallerset/client/fake"
+	"gotest.tools/v3/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	testing2 "knative.dev/pkg/reconciler/testing"
+)
+
+func webhookResource(kind string) unstructured.Unstructured {
+	resource := namespacedResource("v1", kind, "test", "test-webhook")
+	resource.SetLabels(map[string]string{componentLabelKey: componentWebhook})
+	return resource
+}
+
+func webhookConfiguration(namespace string) unstructured.Unstructured {
+	resource := unstructured.Unstructured{Object: map[string]interface{}{
+		"webhooks": []interface{}{
+			map[string]interface{}{
+				"name": "validation.webhook.test",
+				"clientConfig": map[string]interface{}{
+					"service": map[string]interface{}{"name": "test-webhook", "namespace": namespace},
+				},
+			},
+		},
+	}}
+	resource.SetAPIVersion("admissionregistration.k8s.io/v1")
+	resource.SetKind("ValidatingWebhookConfiguration")
+	resource.SetName("validation.webhook.test")
+	return resource
+}
+
+func webhookDeployment() unstructured.Unstructured {
+	resource := webhookResource("Deployment")
+	_ = unstructured.SetNestedField(resource.Object, "test-webhook", "spec", "template", "spec", "serviceAccountName")
+	return resource
+}
+
+func webhookClusterRole() unstructured.Unstructured {
+	resource := unstructured.Unstructured{Object: map[string]interface{}{
+		"rules": []interface{}{
+			map[string]interface{}{
+				"apiGroups": []interface{}{"admissionregistration.k8s.io"},
+				"resources": []interface{}{"validatingwebhookconfigurations"},
+				"verbs":     []interface{}{"get", "list", "update"},
+			},
+			map[string]interface{}{
+				"apiGroups": []interface{}{"apiextensions.k8s.io"},
+				"resources": []interface{}{"customresourcedefinitions"},
+				"verbs":     []interface{}{"update"},
+			},
+			map[string]interface{}{
+				"apiGroups": []interface{}{""},
+				"resources": []interface{}{"configmaps"},
+				"verbs":     []interface{}{"get", "update"},
+			},
+		},
+	}}
+	resource.SetAPIVersion("rbac.authorization.k8s.io/v1")
+	resource.SetKind("ClusterRole")
+	resource.SetName("test-webhook-cluster-access")
+	return resource
+}
+
+func webhookClusterRoleBinding() unstructured.Unstructured {
+	resource := unstructured.Unstructured{Object: map[string]interface{}{
+		"subjects": []interface{}{
+			map[string]interface{}{"kind": "ServiceAccount", "name": "test-webhook", "namespace": "test"},
+			map[string]interface{}{"kind": "ServiceAccount", "name": "test-controller", "namespace": "test"},
+		},
+		"roleRef": map[string]interface{}{
+			"apiGroup": "rbac.authorization.k8s.io",
+			"kind":     "ClusterRole",
+			"name":     "test-webhook-cluster-access",
+		},
+	}}
+	resource.SetAPIVersion("rbac.authorization.k8s.io/v1")
+	resource.SetKind("ClusterRoleBinding")
+	resource.SetName("test-webhook-cluster-access")
+	return resource
+}
+
+func subjectNamespaces(t *testing.T, crb unstructured.Unstructured, name string) []string {
+	subjects, _, err := unstructured.NestedSlice(crb.Object, "subjects")
+	assert.NilError(t, err)
+	var namespaces []string
+	for _, s := range subjects {
+		subject := s.(map[string]interface{})
+		if subject["name"] == name {
+			namespaces = append(namespaces, subject["namespace"].(string))
+		}
+	}
+	return namespaces
+}
+
+func listSets(t *testing.T, client *InstallerSetClient, setType string) []v1alpha1.TektonInstallerSet {
+	list, err := client.clientSet.List(context.TODO(), metav1.ListOptions{LabelSelector: client.getSetLabels(setType)})
+	assert.NilError(t, err)
+	return list.Items
+}
+
+func TestInstallerSetClient_MainSet_PayloadSwitchover(t *testing.T) {
+	ctx, _ := testing2.SetupFakeContext(t)
+	manifest, err := mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{
+		serviceAccount, deployment, webhookDeployment(), webhookResource("Service"), webhookConfiguration("test"),
+		webhookClusterRole(), webhookClusterRoleBinding(),
+	}))
+	assert.NilError(t, err)
+
+	fakeClient := fake2.NewFakeISClient()
+	current := NewInstallerSetClient(fakeClient, "v1", "test-version", v1alpha1.KindTektonTrigger, &testMetrics{})
+	assert.Equal(t, current.MainSet(ctx, comp, &manifest, filterAndTransform(nil)), v1alpha1.REQUEUE_EVENT_AFTER)
+
+	// the switchover holds the main installer sets of the current version
+	for _, set := range listSets(t, current, InstallerTypeMain) {
+		is := set
+		markStatusReady(&is)
+		is.Annotations[v1alpha1.PayloadGroupKey] = v1alpha1.PayloadGroupBlue
+		is.Annotations[v1alpha1.PayloadStagingNamespaceKey] = "staging-blue"
+		_, err := fakeClient.Update(ctx, &is, metav1.UpdateOptions{})
+		assert.NilError(t, err)
+	}
+	assert.NilError(t, current.MainSet(ctx, comp, &manifest, filterAndTransform(nil)))
+
+	// the next version stages its webhooks next to the current version
+	next := NewInstallerSetClient(fakeClient, "v2", "test-version", v1alpha1.KindTektonTrigger, &testMetrics{})
+	assert.Equal(t, next.MainSet(ctx, comp, &manifest, filterAndTransform(nil)), v1alpha1.REQUEUE_EVENT_AFTER)
+	assert.Equal(t, next.MainSet(ctx, comp, &manifest, filterAndTransform(nil)), v1alpha1.REQUEUE_EVENT_AFTER)
+
+	mainSets := listSets(t, next, InstallerTypeMain)
+	assert.Equal(t, len(mainSets), 2)
+	for _, set := range mainSets {
+		assert.Equal(t, set.Labels[v1alpha1.ReleaseVersionKey], "v1")
+	}
+	payloads := listSets(t, next, InstallerTypePayload)
+	assert.Equal(t, len(payloads), 1)
+	payload := payloads[0]
+	assert.Equal(t, payload.Labels[v1alpha1.ReleaseVersionKey], "v2")
+	assert.Equal(t, payload.Labels[v1alpha1.PayloadGroupKey], v1alpha1.PayloadGroupBlue)
+	assert.Equal(t, payload.Annotations[v1alpha1.TargetNamespaceKey], "staging-blue")
+	assert.Equal(t, len(payload.Spec.Manifests), 5)
+	for _, res := range payload.Spec.Manifests {
+		assert.Assert(t, res.GetName() != "test-deployment")
+		switch res.GetKind() {
+		case "ClusterRoleBinding":
+			// the staged webhook is bound to a staged copy of its ClusterRole
+			assert.Equal(t, res.GetName(), "test-webhook-cluster-access-blue")
+			role, _, _ := unstructured.NestedString(res.Object, "roleRef", "name")
+			assert.Equal(t, role, "test-webhook-cluster-access-blue")
+			assert.DeepEqual(t, subjectNamespaces(t, res, "test-webhook"), []string{"staging-blue"})
+			assert.Equal(t, len(subjectNamespaces(t, res, "test-controller")), 0)
+		case "ClusterRole":
+			// which leaves the live webhook configurations and CRDs alone
+			assert.Equal(t, res.GetName(), "test-webhook-cluster-access-blue")
+			assert.DeepEqual(t, res.Object["rules"], []interface{}{
+				map[string]interface{}{
+					"apiGroups": []interface{}{"admissionregistration.k8s.io"},
+					"resources": []interface{}{"validatingwebhookconfigurations"},
+					"verbs":     []interface{}{"get", "list"},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{""},
+					"resources": []interface{}{"configmaps"},
+					"verbs":     []interface{}{"get", "update"},
+				},
+			})
+		default:
+			assert.Equal(t, res.GetNamespace(), "staging-blue")
+		}
+	}
+
+	// once switched the main installer sets of the previous version are retired
+	markStatusReady(&payload)
+	payload.Annotations[v1alpha1.PayloadSwitchedKey] = "true"
+	_, err = fakeClient.Update(ctx, &payload, metav1.UpdateOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, next.MainSet(ctx, comp, &manifest, filterAndTransform(nil)), v1alpha1.REQUEUE_EVENT_AFTER)
+	assert.Equal(t, len(listSets(t, next, InstallerTypeMain)), 0)
+
+	// and replaced by main installer sets serving their webhooks from the staged payload
+	assert.Equal(t, next.MainSet(ctx, comp, &manifest, filterAndTransform(nil)), v1alpha1.REQUEUE_EVENT_AFTER)
+	mainSets = listSets(t, next, InstallerTypeMain)
+	assert.Equal(t, len(mainSets), 2)
+	for _, set := range mainSets {
+		assert.Equal(t, set.Labels[v1alpha1.ReleaseVersionKey], "v2")
+		assert.Equal(t, set.Annotations[v1alpha1.WebhookNamespaceKey], "staging-blue")
+		for _, res := range set.Spec.Manifests {
+			assert.Assert(t, !webhookWorkload(&res))
+			switch res.GetKind() {
+			case "ValidatingWebhookConfiguration":
+				ns, _, _ := unstructured.NestedString(res.Object["webhooks"].([]interface{})[0].(map[string]interface{}), "clientConfig", "service", "namespace")
+				assert.Equal(t, ns, "staging-blue")
+			case "ClusterRoleBinding":
+				// the staged webhook reconciles the webhook configurations it now serves
+				assert.DeepEqual(t, subjectNamespaces(t, res, "test-webhook"), []string{"test", "staging-blue"})
+				assert.DeepEqual(t, subjectNamespaces(t, res, "test-controller"), []string{"test"})
+			}
+		}
+	}
+
+	// rolling back serves the webhooks from the target namespace again
+	payload.Annotations[v1alpha1.PayloadSwitchedKey] = ""
+	_, err = fakeClient.Update(ctx, &payload, metav1.UpdateOptions{})
+	assert.NilError(t, err)
+	_ = next.MainSet(ctx, comp, &manifest, filterAndTransform(nil))
+	for _, set := range listSets(t, next, InstallerTypeMain) {
+		_, found := set.Annotations[v1alpha1.WebhookNamespaceKey]
+		assert.Assert(t, !found)
+	}
+}
+
+func TestInstallerSetClient_MainSet_UpgradeInPlaceWithoutWebhook(t *testing.T) {
+	ctx, _ := testing2.SetupFakeContext(t)
+	manifest, err := mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{serviceAccount, deployment}))
+	assert.NilError(t, err)
+
+	fakeClient := fake2.NewFakeISClient()
+	current := NewInstallerSetClient(fakeClient, "v1", "test-version", v1alpha1.KindTektonTrigger, &testMetrics{})
+	assert.Equal(t, current.MainSet(ctx, comp, &manifest, filterAndTransform(nil)), v1alpha1.REQUEUE_EVENT_AFTER)
+	for _, set := range listSets(t, current, InstallerTypeMain) {
+		is := set
+		is.Annotations[v1alpha1.PayloadStagingNamespaceKey] = "staging-blue"
+		_, err := fakeClient.Update(ctx, &is, metav1.UpdateOptions{})
+		assert.NilError(t, err)
+	}
+
+	// there are no webhooks to stage, the main installer sets are upgraded in place
+	next := NewInstallerSetClient(fakeClient, "v2", "test-version", v1alpha1.KindTektonTrigger, &testMetrics{})
+	assert.Equal(t, next.MainSet(ctx, comp, &manifest, filterAndTransform(nil)), v1alpha1.REQUEUE_EVENT_AFTER)
+	assert.Equal(t, len(listSets(t, next, InstallerTypeMain)), 0)
+	assert.Equal(t, len(listSets(t, next, InstallerTypePayload)), 0)
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+
+	mf "github.com/manifestival/manifestival"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// renderManifests returns the manifests of an installer set. When the manifests are rendered
+// deterministically, their SHA256 is also returned.
+func renderManifests(manifest *mf.Manifest) ([]unstructured.Unstructured, string, error) {
+	if !common.DeterministicRender() {
+		return manifest.Resources(), "", nil
+	}
+	resources, err := common.RenderDeterministic(manifest.Resources())
+	if err != nil {
+		return nil, "", err
+	}
+	sum, err := common.ManifestsSHA256(resources)
+	if err != nil {
+		return nil, "", err
+	}
+	return resources, sum, nil
+}
+
+// setManifests sets the manifests of the installer set, inline or in ConfigMaps, and the
+// annotation holding their SHA256
+func setManifests(ctx context.Context, set *v1alpha1.TektonInstallerSet, manifest *mf.Manifest) error {
+	resources, sum, err := renderManifests(manifest)
+	if err != nil {
+		return err
+	}
+	if err := common.StoreInstallerSetManifests(ctx, set, resources); err != nil {
+		return err
+	}
+	annotations := set.GetAnnotations()
+	if sum == "" {
+		delete(annotations, v1alpha1.ManifestsSHA256Key)
+	} else {
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[v1alpha1.ManifestsSHA256Key] = sum
+	}
+	set.SetAnnotations(annotations)
+	return nil
+}
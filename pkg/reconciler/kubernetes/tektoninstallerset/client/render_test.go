@@ -0,0 +1,54 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"testing"
+
+	mf "github.com/manifestival/manifestival"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
+	"gotest.tools/v3/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestSetManifests(t *testing.T) {
+	manifest, err := mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{serviceAccount, deployment}))
+	assert.NilError(t, err)
+	set := &v1alpha1.TektonInstallerSet{}
+
+	assert.NilError(t, setManifests(context.TODO(), set, &manifest))
+	assert.Equal(t, len(set.Spec.Manifests), 2)
+	assert.Equal(t, set.Spec.Manifests[0].GetKind(), "ServiceAccount")
+	_, ok := set.GetAnnotations()[v1alpha1.ManifestsSHA256Key]
+	assert.Assert(t, !ok)
+
+	common.SetDeterministicRender(true)
+	defer common.SetDeterministicRender(false)
+	assert.NilError(t, setManifests(context.TODO(), set, &manifest))
+	assert.Equal(t, set.Spec.Manifests[0].GetKind(), "Deployment")
+	sum, err := common.ManifestsSHA256(set.Spec.Manifests)
+	assert.NilError(t, err)
+	assert.Equal(t, set.GetAnnotations()[v1alpha1.ManifestsSHA256Key], sum)
+
+	// the annotation is removed once the manifests are no longer rendered deterministically
+	common.SetDeterministicRender(false)
+	assert.NilError(t, setManifests(context.TODO(), set, &manifest))
+	_, ok = set.GetAnnotations()[v1alpha1.ManifestsSHA256Key]
+	assert.Assert(t, !ok)
+}
@@ -23,9 +23,9 @@ import (
 
 	mf "github.com/manifestival/manifestival"
 	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
 	"github.com/tektoncd/operator/pkg/reconciler/shared/hash"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/client-go/util/retry"
 	"knative.dev/pkg/logging"
 )
 
@@ -82,7 +82,8 @@ func (i *InstallerSetClient) updateMainSets(ctx context.Context, comp v1alpha1.T
 
 func (i *InstallerSetClient) updateSet(ctx context.Context, comp v1alpha1.TektonComponent, set v1alpha1.TektonInstallerSet, manifest *mf.Manifest) (*v1alpha1.TektonInstallerSet, error) {
 	var updatedSet *v1alpha1.TektonInstallerSet
-	retryErr := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+	var previous *v1alpha1.ManifestsReference
+	retryErr := common.Retry(ctx, "update installer set", func() error {
 		onCluster, err := i.clientSet.Get(ctx, set.GetName(), metav1.GetOptions{})
 		if err != nil {
 			return err
@@ -97,7 +98,10 @@ func (i *InstallerSetClient) updateSet(ctx context.Context, comp v1alpha1.Tekton
 		current[v1alpha1.LastAppliedHashKey] = specHash
 		onCluster.SetAnnotations(current)
 
-		onCluster.Spec.Manifests = manifest.Resources()
+		previous = onCluster.Spec.ManifestsRef
+		if err := setManifests(ctx, onCluster, manifest); err != nil {
+			return err
+		}
 
 		updatedSet, err = i.clientSet.Update(ctx, onCluster, metav1.UpdateOptions{})
 		if err != nil {
@@ -108,5 +112,11 @@ func (i *InstallerSetClient) updateSet(ctx context.Context, comp v1alpha1.Tekton
 	if retryErr != nil {
 		return nil, retryErr
 	}
+	if err := common.AdoptInstallerSetManifests(ctx, updatedSet); err != nil {
+		return nil, err
+	}
+	if err := common.ReleaseInstallerSetManifests(ctx, updatedSet, previous); err != nil {
+		return nil, err
+	}
 	return updatedSet, nil
 }
@@ -21,12 +21,15 @@ import (
 
 	mfc "github.com/manifestival/client-go-client"
 	"go.uber.org/zap"
+	kubeinformers "k8s.io/client-go/informers"
 	"k8s.io/client-go/tools/cache"
 
 	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
 	operatorclient "github.com/tektoncd/operator/pkg/client/injection/client"
+	tektonConfiginformer "github.com/tektoncd/operator/pkg/client/injection/informers/operator/v1alpha1/tektonconfig"
 	tektonInstallerinformer "github.com/tektoncd/operator/pkg/client/injection/informers/operator/v1alpha1/tektoninstallerset"
 	tektonInstallerReconciler "github.com/tektoncd/operator/pkg/client/injection/reconciler/operator/v1alpha1/tektoninstallerset"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
 	kubeclient "knative.dev/pkg/client/injection/kube/client"
 	deploymentinformer "knative.dev/pkg/client/injection/kube/informers/apps/v1/deployment"
 	statefulsetinformer "knative.dev/pkg/client/injection/kube/informers/apps/v1/statefulset"
@@ -37,6 +40,7 @@ import (
 	"knative.dev/pkg/controller"
 	"knative.dev/pkg/injection"
 	"knative.dev/pkg/logging"
+	"knative.dev/pkg/system"
 )
 
 // NewController initializes the controller and is called by the generated code
@@ -56,15 +60,17 @@ func NewExtendedController() injection.ControllerConstructor {
 		}
 
 		c := &Reconciler{
-			operatorClientSet: operatorclient.Get(ctx),
-			mfClient:          mfclient,
-			kubeClientSet:     kubeclient.Get(ctx),
+			operatorClientSet:  operatorclient.Get(ctx),
+			mfClient:           mfclient,
+			kubeClientSet:      kubeclient.Get(ctx),
+			capabilities:       common.NewCapabilitiesCache(kubeclient.Get(ctx).Discovery()),
+			tektonConfigLister: tektonConfiginformer.Get(ctx).Lister(),
 		}
 		impl := tektonInstallerReconciler.NewImpl(ctx, c)
 
 		logger.Debug("Setting up event handlers for TektonInstallerSet")
 
-		if _, err := tektonInstallerinformer.Get(ctx).Informer().AddEventHandler(controller.HandleAll(impl.Enqueue)); err != nil {
+		if _, err := tektonInstallerinformer.Get(ctx).Informer().AddEventHandler(common.HandleSpecChanges(impl.Enqueue)); err != nil {
 			logger.Panicf("Couldn't register TektonInstallerSet informer event handler: %w", err)
 		}
 
@@ -103,6 +109,22 @@ func NewExtendedController() injection.ControllerConstructor {
 			logger.Panicf("Couldn't register ServiceAccount informer event handler: %w", err)
 		}
 
+		// the installer sets are installed again when the post render patches or the TLS policy change
+		installerSetInformer := tektonInstallerinformer.Get(ctx).Informer()
+		resync := func() { impl.GlobalResync(installerSetInformer) }
+		if _, err := tektonConfiginformer.Get(ctx).Informer().AddEventHandler(postRenderChanged(resync)); err != nil {
+			logger.Panicf("Couldn't register TektonConfig informer event handler: %w", err)
+		}
+		factory := kubeinformers.NewSharedInformerFactoryWithOptions(kubeclient.Get(ctx), controller.GetResyncPeriod(ctx),
+			kubeinformers.WithNamespace(system.Namespace()))
+		if _, err := factory.Core().V1().ConfigMaps().Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+			FilterFunc: c.isPostRenderPatchesConfigMap,
+			Handler:    controller.HandleAll(func(interface{}) { resync() }),
+		}); err != nil {
+			logger.Panicf("Couldn't register ConfigMap informer event handler: %w", err)
+		}
+		factory.Start(ctx.Done())
+
 		return impl
 	}
 }
@@ -31,6 +31,7 @@ import (
 	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrs "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -57,6 +58,9 @@ type installer struct {
 	deployment      []unstructured.Unstructured
 	statefulset     []unstructured.Unstructured
 	job             []unstructured.Unstructured
+	timings         applyTimings
+	// checksums of the proxy credentials rendered in the namespaces during this reconcile
+	proxyCredentials map[string]string
 }
 
 func NewInstaller(manifest *mf.Manifest, mfClient mf.Client, kubeClientSet kubernetes.Interface, logger *zap.SugaredLogger) *installer {
@@ -78,24 +82,20 @@ func NewInstaller(manifest *mf.Manifest, mfClient mf.Client, kubeClientSet kuber
 	// non k8s core resources like openshift resources will be classified as
 	// namespace scoped
 	for _, res := range manifest.Resources() {
-		if strings.ToLower(res.GetKind()) == "customresourcedefinition" {
+		switch stage(res.GetKind()) {
+		case v1alpha1.CrdInstalled:
 			installer.crds = append(installer.crds, res)
-			continue
-		} else if res.GetKind() == "Deployment" {
+		case v1alpha1.DeploymentsAvailable:
 			installer.deployment = append(installer.deployment, res)
-			continue
-		} else if res.GetKind() == "StatefulSet" {
+		case v1alpha1.StatefulSetReady:
 			installer.statefulset = append(installer.statefulset, res)
-			continue
-		} else if res.GetKind() == "Job" {
+		case v1alpha1.JobsInstalled:
 			installer.job = append(installer.job, res)
-			continue
-		}
-		if isClusterScoped(res.GetKind()) && strings.ToLower(res.GetKind()) != "clusterrolebinding" {
+		case v1alpha1.ClustersScoped:
 			installer.clusterScoped = append(installer.clusterScoped, res)
-			continue
+		default:
+			installer.namespaceScoped = append(installer.namespaceScoped, res)
 		}
-		installer.namespaceScoped = append(installer.namespaceScoped, res)
 	}
 	return installer
 }
@@ -130,145 +130,157 @@ func isClusterScoped(kind string) bool {
 func (i *installer) ensureResources(resources []unstructured.Unstructured, installerSetName string) error {
 	needsReconcileAgain := false
 	for _, r := range resources {
-		ressourceLogger := i.logger.With(
-			"kind", r.GetKind(),
-			"namespace", r.GetNamespace(),
-			"name", r.GetName(),
-		)
-		expectedHash, err := hash.Compute(r.Object)
+		start := time.Now()
+		reconcileAgain, err := i.ensureResourceHash(&r, installerSetName)
+		i.timings.observe(&r, time.Since(start))
 		if err != nil {
-			ressourceLogger.Error("failed to compute resource hash", "error", err)
 			return err
 		}
-		ressourceLogger.Debug("fetching resource")
+		needsReconcileAgain = needsReconcileAgain || reconcileAgain
+	}
+	if needsReconcileAgain {
+		return v1alpha1.RECONCILE_AGAIN_ERR
+	}
+	return nil
+}
 
-		res, err := i.mfClient.Get(&r)
-		if err != nil {
-			if apierrs.IsNotFound(err) {
-				ressourceLogger.Debug("creating new resource")
-				// add hash on the resource of expected manifest and create
-				anno := r.GetAnnotations()
-				if anno == nil {
-					anno = map[string]string{}
-				}
-				anno[v1alpha1.LastAppliedHashKey] = expectedHash
-				r.SetAnnotations(anno)
-				err = i.mfClient.Create(&r)
-				if err != nil {
-					ressourceLogger.Error("failed to create resource", "error", err)
-					return err
-				}
-				ressourceLogger.Debug("resource created successfully")
-				continue
-			}
-			ressourceLogger.Error("failed to get resource", "error", err)
-			return err
-		}
+// ensureResourceHash creates the resource, or updates it when the hash of its manifest changed.
+// It returns true when the resource must be reconciled again once a stuck CRD is deleted.
+func (i *installer) ensureResourceHash(r *unstructured.Unstructured, installerSetName string) (bool, error) {
+	ressourceLogger := i.logger.With(
+		"kind", r.GetKind(),
+		"namespace", r.GetNamespace(),
+		"name", r.GetName(),
+	)
+	expectedHash, err := hash.Compute(r.Object)
+	if err != nil {
+		ressourceLogger.Error("failed to compute resource hash", "error", err)
+		return false, err
+	}
+	ressourceLogger.Debug("fetching resource")
 
-		if res.GetDeletionTimestamp() != nil {
-			// Check if this InstallerSet owns the resource being deleted
-			isOwnedByThisInstallerSet := false
-			for _, owner := range res.GetOwnerReferences() {
-				if owner.Kind == "TektonInstallerSet" && owner.Name == installerSetName {
-					isOwnedByThisInstallerSet = true
-					break
-				}
+	res, err := i.mfClient.Get(r)
+	if err != nil {
+		if apierrs.IsNotFound(err) {
+			ressourceLogger.Debug("creating new resource")
+			// add hash on the resource of expected manifest and create
+			anno := r.GetAnnotations()
+			if anno == nil {
+				anno = map[string]string{}
 			}
-
-			if isOwnedByThisInstallerSet {
-				// This InstallerSet owns it, wait for deletion to complete
-				ressourceLogger.Debug("our resource is being deleted, waiting for completion")
-				return v1alpha1.RECONCILE_AGAIN_ERR
+			anno[v1alpha1.LastAppliedHashKey] = expectedHash
+			r.SetAnnotations(anno)
+			err = i.mfClient.Create(r)
+			if err != nil {
+				ressourceLogger.Error("failed to create resource", "error", err)
+				return false, err
 			}
+			ressourceLogger.Debug("resource created successfully")
+			return false, nil
+		}
+		ressourceLogger.Error("failed to get resource", "error", err)
+		return false, err
+	}
 
-			// Resource is being deleted by another controller/InstallerSet
-			// Only force-remove finalizers for CRDs to break deadlock
-			// For other resources, skip and let them delete naturally
-			if res.GetKind() != "CustomResourceDefinition" {
-				ressourceLogger.Debug("resource is being deleted by another owner, skipping",
-					"kind", res.GetKind(),
-					"deletionTimestamp", res.GetDeletionTimestamp())
-				continue
+	if res.GetDeletionTimestamp() != nil {
+		// Check if this InstallerSet owns the resource being deleted
+		isOwnedByThisInstallerSet := false
+		for _, owner := range res.GetOwnerReferences() {
+			if owner.Kind == "TektonInstallerSet" && owner.Name == installerSetName {
+				isOwnedByThisInstallerSet = true
+				break
 			}
+		}
 
-			// CRD is in terminating state - check if it's stuck
-			// Normal CRD deletion takes 1-5 seconds. If it's been longer, it's stuck.
-			age := time.Since(res.GetDeletionTimestamp().Time)
-			if age < 30*time.Second {
-				// CRD recently deleted, give normal deletion process a chance
-				ressourceLogger.Debug("CRD recently entered terminating state, waiting for normal deletion",
-					"crd", res.GetName(),
-					"age", age.String())
-				continue
-			}
+		if isOwnedByThisInstallerSet {
+			// This InstallerSet owns it, wait for deletion to complete
+			ressourceLogger.Debug("our resource is being deleted, waiting for completion")
+			return false, v1alpha1.RECONCILE_AGAIN_ERR
+		}
 
-			// CRD is stuck in terminating state for >30 seconds - this causes deadlock:
-			// 1. Old InstallerSet tries to delete CRDs (during upgrade or TektonConfig deletion)
-			// 2. Kubernetes adds finalizer to protect instances (TaskRuns/PipelineRuns)
-			// 3. CRD stuck in terminating state (instances can't finish - no controller)
-			// 4. New InstallerSet can't create fresh CRD (old one exists but terminating)
-			// Solution: Force remove finalizers to break deadlock and allow new CRD creation
-			// Note: This will delete all instances (TaskRuns/PipelineRuns) but they are
-			// already orphaned since the controller was deleted with TektonConfig
-			ressourceLogger.Warn("CRD stuck in terminating state, removing finalizers to break deadlock",
-				"crd", res.GetName(),
-				"age", age.String(),
-				"deletionTimestamp", res.GetDeletionTimestamp(),
-				"finalizers", res.GetFinalizers())
-
-			// Remove finalizers to complete deletion
-			res.SetFinalizers([]string{})
-			if err := i.mfClient.Update(res); err != nil {
-				ressourceLogger.Error("failed to remove finalizers from stuck CRD", "error", err)
-				return err
-			}
+		// Resource is being deleted by another controller/InstallerSet
+		// Only force-remove finalizers for CRDs to break deadlock
+		// For other resources, skip and let them delete naturally
+		if res.GetKind() != "CustomResourceDefinition" {
+			ressourceLogger.Debug("resource is being deleted by another owner, skipping",
+				"kind", res.GetKind(),
+				"deletionTimestamp", res.GetDeletionTimestamp())
+			return false, nil
+		}
 
-			ressourceLogger.Info("removed finalizers from stuck CRD, reconciling again to create new CRD",
+		// CRD is in terminating state - check if it's stuck
+		// Normal CRD deletion takes 1-5 seconds. If it's been longer, it's stuck.
+		age := time.Since(res.GetDeletionTimestamp().Time)
+		if age < 30*time.Second {
+			// CRD recently deleted, give normal deletion process a chance
+			ressourceLogger.Debug("CRD recently entered terminating state, waiting for normal deletion",
 				"crd", res.GetName(),
 				"age", age.String())
-			// Mark that we need to reconcile again to create new resource once deletion completes,
-			// but continue processing remaining CRDs in this same pass
-			needsReconcileAgain = true
-			continue
+			return false, nil
 		}
 
-		ressourceLogger.Debug("resource exists, checking for updates")
+		// CRD is stuck in terminating state for >30 seconds - this causes deadlock:
+		// 1. Old InstallerSet tries to delete CRDs (during upgrade or TektonConfig deletion)
+		// 2. Kubernetes adds finalizer to protect instances (TaskRuns/PipelineRuns)
+		// 3. CRD stuck in terminating state (instances can't finish - no controller)
+		// 4. New InstallerSet can't create fresh CRD (old one exists but terminating)
+		// Solution: Force remove finalizers to break deadlock and allow new CRD creation
+		// Note: This will delete all instances (TaskRuns/PipelineRuns) but they are
+		// already orphaned since the controller was deleted with TektonConfig
+		ressourceLogger.Warn("CRD stuck in terminating state, removing finalizers to break deadlock",
+			"crd", res.GetName(),
+			"age", age.String(),
+			"deletionTimestamp", res.GetDeletionTimestamp(),
+			"finalizers", res.GetFinalizers())
 
-		// if resource exist then check if expected hash is different from the one
-		// on the resource
-		hashOnResource := res.GetAnnotations()[v1alpha1.LastAppliedHashKey]
-
-		if expectedHash == hashOnResource {
-			ressourceLogger.Debug("resource is up-to-date, no changes needed")
-			continue
+		// Remove finalizers to complete deletion
+		res.SetFinalizers([]string{})
+		if err := i.mfClient.Update(res); err != nil {
+			ressourceLogger.Error("failed to remove finalizers from stuck CRD", "error", err)
+			return false, err
 		}
 
-		ressourceLogger.Debug("resource needs update",
-			"currentHash", hashOnResource,
-			"expectedHash", expectedHash)
+		ressourceLogger.Info("removed finalizers from stuck CRD, reconciling again to create new CRD",
+			"crd", res.GetName(),
+			"age", age.String())
+		// Mark that we need to reconcile again to create new resource once deletion completes,
+		// but continue processing remaining CRDs in this same pass
+		return true, nil
+	}
 
-		anno := r.GetAnnotations()
-		if anno == nil {
-			anno = map[string]string{}
-		}
-		anno[v1alpha1.LastAppliedHashKey] = expectedHash
-		r.SetAnnotations(anno)
+	ressourceLogger.Debug("resource exists, checking for updates")
 
-		installManifests, err := mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{r}), mf.UseClient(i.mfClient))
-		if err != nil {
-			ressourceLogger.Error("failed to create manifest", "error", err)
-			return err
-		}
-		if err := installManifests.Apply(); err != nil {
-			ressourceLogger.Error("failed to apply manifest", "error", err)
-			return err
-		}
-		ressourceLogger.Debug("resource updated successfully")
+	// if resource exist then check if expected hash is different from the one
+	// on the resource
+	hashOnResource := res.GetAnnotations()[v1alpha1.LastAppliedHashKey]
+
+	if expectedHash == hashOnResource {
+		ressourceLogger.Debug("resource is up-to-date, no changes needed")
+		return false, nil
 	}
-	if needsReconcileAgain {
-		return v1alpha1.RECONCILE_AGAIN_ERR
+
+	ressourceLogger.Debug("resource needs update",
+		"currentHash", hashOnResource,
+		"expectedHash", expectedHash)
+
+	anno := r.GetAnnotations()
+	if anno == nil {
+		anno = map[string]string{}
 	}
-	return nil
+	anno[v1alpha1.LastAppliedHashKey] = expectedHash
+	r.SetAnnotations(anno)
+
+	installManifests, err := mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{*r}), mf.UseClient(i.mfClient))
+	if err != nil {
+		ressourceLogger.Error("failed to create manifest", "error", err)
+		return false, err
+	}
+	if err := installManifests.Apply(); err != nil {
+		ressourceLogger.Error("failed to apply manifest", "error", err)
+		return false, err
+	}
+	ressourceLogger.Debug("resource updated successfully")
+	return false, nil
 }
 
 func (i *installer) EnsureCRDs(installerSetName string) error {
@@ -285,7 +297,10 @@ func (i *installer) EnsureNamespaceScopedResources(installerSetName string) erro
 
 func (i *installer) EnsureStatefulSetResources(ctx context.Context) error {
 	for _, s := range i.statefulset {
-		if err := i.ensureResource(ctx, &s); err != nil {
+		start := time.Now()
+		err := i.ensureResource(ctx, &s)
+		i.timings.observe(&s, time.Since(start))
+		if err != nil {
 			return err
 		}
 		if err := i.isStatefulSetAvailable(&s); err != nil {
@@ -297,7 +312,10 @@ func (i *installer) EnsureStatefulSetResources(ctx context.Context) error {
 
 func (i *installer) EnsureDeploymentResources(ctx context.Context) error {
 	for _, d := range i.deployment {
-		if err := i.ensureResource(ctx, &d); err != nil {
+		start := time.Now()
+		err := i.ensureResource(ctx, &d)
+		i.timings.observe(&d, time.Since(start))
+		if err != nil {
 			return err
 		}
 	}
@@ -305,7 +323,42 @@ func (i *installer) EnsureDeploymentResources(ctx context.Context) error {
 }
 
 func (i *installer) EnsureJobResources(installerSetName string) error {
-	return i.ensureResources(i.job, installerSetName)
+	needsReconcileAgain := false
+	for _, r := range i.job {
+		start := time.Now()
+		reconcileAgain, err := i.ensureJob(&r, installerSetName)
+		i.timings.observe(&r, time.Since(start))
+		if err != nil {
+			return err
+		}
+		needsReconcileAgain = needsReconcileAgain || reconcileAgain
+	}
+	if needsReconcileAgain {
+		return v1alpha1.RECONCILE_AGAIN_ERR
+	}
+	return nil
+}
+
+// ensureProxyCredentials renders the proxy credentials once per namespace of the installer set,
+// for the deployments referencing them, and returns their checksum
+func (i *installer) ensureProxyCredentials(ctx context.Context, expected *unstructured.Unstructured) (string, error) {
+	source, err := common.ProxyCredentialsSource(expected)
+	if err != nil || source == "" {
+		return "", err
+	}
+	namespace := expected.GetNamespace()
+	if checksum, ok := i.proxyCredentials[namespace]; ok {
+		return checksum, nil
+	}
+	checksum, err := common.EnsureProxyCredentials(ctx, i.kubeClientSet, namespace, source, expected.GetOwnerReferences())
+	if err != nil {
+		return "", err
+	}
+	if i.proxyCredentials == nil {
+		i.proxyCredentials = map[string]string{}
+	}
+	i.proxyCredentials[namespace] = checksum
+	return checksum, nil
 }
 
 // list of fields should be reconciled
@@ -344,11 +397,26 @@ func (i *installer) ensureResource(ctx context.Context, expected *unstructured.U
 			return err
 		}
 
+		// render the proxy credentials referenced by the proxy settings
+		checksum, err := i.ensureProxyCredentials(ctx, expected)
+		if err != nil {
+			loggerWithContext.Errorw("failed to render proxy credentials", "error", err)
+			return err
+		}
+		if err := common.SetProxyCredentialsHash(expected, checksum); err != nil {
+			loggerWithContext.Errorw("failed to set the proxy credentials checksum", "error", err)
+			return err
+		}
+
 		// if a deployment or statefulSets managed by HPA, ignore replicas from user input(TektonConfig CR)
 		// and take replicas from HPA status(DesiredReplicas)
 
 		// lists the available HPAs
+		// clusters not serving autoscaling/v2 have no HPA managing the resource
 		hpaList, err := i.kubeClientSet.AutoscalingV2().HorizontalPodAutoscalers(expected.GetNamespace()).List(ctx, metav1.ListOptions{})
+		if apierrs.IsNotFound(err) {
+			hpaList, err = &autoscalingv2.HorizontalPodAutoscalerList{}, nil
+		}
 		if err != nil {
 			loggerWithContext.Errorw("failed to list HPAs", "error", err)
 			return err
@@ -478,6 +546,9 @@ func (i *installer) ensureResource(ctx context.Context, expected *unstructured.U
 		// If the resource doesn't exist, then create new
 		if apierrs.IsNotFound(err) {
 			loggerWithContext.Debug("resource not found, creating")
+			if err := i.recordLastApplied(expected, expected); err != nil {
+				return err
+			}
 			err = i.mfClient.Create(expected)
 			if err != nil {
 				loggerWithContext.Errorw("failed to create resource", "error", err)
@@ -528,13 +599,30 @@ func (i *installer) ensureResource(ctx context.Context, expected *unstructured.U
 			"expectedHash", expectedHashValue,
 		)
 
-		err = i.copyResourceFields(expected, existing, reconcileFields...)
+		// merge the changes since the configuration last applied, the fields are copied from the
+		// expected resource when no configuration was recorded
+		updated := existing.DeepCopy()
+		merged, err := threeWayMerge(updated, expected)
 		if err != nil {
-			loggerWithContext.Errorw("failed to copy resource fields", "error", err)
+			loggerWithContext.Errorw("failed to merge resource", "error", err)
 			return err
 		}
+		if !merged {
+			err = i.copyResourceFields(expected, updated, reconcileFields...)
+			if err != nil {
+				loggerWithContext.Errorw("failed to copy resource fields", "error", err)
+				return err
+			}
+		}
+		if err := i.recordLastApplied(updated, expected); err != nil {
+			return err
+		}
+		if equality.Semantic.DeepEqual(updated.Object, existing.Object) {
+			loggerWithContext.Debug("no changes after merging the fields added to the resource")
+			return nil
+		}
 
-		err = i.mfClient.Update(existing)
+		err = i.mfClient.Update(updated)
 		if err != nil {
 			loggerWithContext.Errorw("failed to update resource", "error", err)
 			return v1alpha1.RECONCILE_AGAIN_ERR
@@ -547,6 +635,14 @@ func (i *installer) ensureResource(ctx context.Context, expected *unstructured.U
 	return nil
 }
 
+// recordLastApplied records the configuration of the expected deployment or statefulset in the resource
+func (i *installer) recordLastApplied(u, expected *unstructured.Unstructured) error {
+	if expected.GetKind() != "Deployment" && expected.GetKind() != "StatefulSet" {
+		return nil
+	}
+	return setLastAppliedConfiguration(u, expected)
+}
+
 func (i *installer) removeExtraKeyInMap(src, dst map[string]string) map[string]string {
 	newMap := map[string]string{}
 	if len(src) == 0 {
@@ -699,6 +795,9 @@ func (i *installer) IsJobCompleted(ctx context.Context, labels map[string]string
 		}
 
 		logger := logging.FromContext(ctx)
+		if condition := jobFailed(job); condition != nil {
+			return i.jobFailedError(ctx, job, condition)
+		}
 		if !isJobCompleted(job) {
 			logger.Info("job not ready in installerset, name: %s, created-by: %s, in namespace: %s", installSetName, labels[v1alpha1.CreatedByKey], job.GetNamespace())
 			return fmt.Errorf("Job not successful")
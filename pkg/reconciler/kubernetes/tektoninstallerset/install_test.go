@@ -27,15 +27,18 @@ import (
 	mf "github.com/manifestival/manifestival"
 	"github.com/manifestival/manifestival/fake"
 	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
 	"github.com/tektoncd/operator/pkg/reconciler/shared/hash"
 	"github.com/tektoncd/pipeline/test/diff"
 	"go.uber.org/zap"
 	zapobserver "go.uber.org/zap/zaptest/observer"
 	"gotest.tools/v3/assert"
+	"gotest.tools/v3/env"
 	appsv1 "k8s.io/api/apps/v1"
 	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -616,6 +619,8 @@ func TestEnsureResourceWithHPA(t *testing.T) {
 	assert.NilError(t, err)
 	existing, err := i.mfClient.Get(unstructuredDep1)
 	assert.NilError(t, err)
+	// the configuration applied is recorded for the 3-way merge of the next update
+	assert.NilError(t, setLastAppliedConfiguration(unstructuredDep1, unstructuredDep1))
 	if d := cmp.Diff(unstructuredDep1.Object, existing.Object); d != "" {
 		t.Errorf("Diff %s", diff.PrintWantGot(d))
 	}
@@ -628,6 +633,7 @@ func TestEnsureResourceWithHPA(t *testing.T) {
 	assert.NilError(t, err)
 	existing, err = i.mfClient.Get(expectedCloned)
 	assert.NilError(t, err)
+	assert.NilError(t, setLastAppliedConfiguration(expectedCloned, expectedCloned))
 	if d := cmp.Diff(expectedCloned.Object, existing.Object); d != "" {
 		t.Errorf("Diff %s", diff.PrintWantGot(d))
 	}
@@ -841,6 +847,8 @@ func TestEnsureResourceWithHPA(t *testing.T) {
 			_expected := expectedCloned.DeepCopy()
 			err = unstructured.SetNestedField(_expected.Object, int64(test.expectedReplicas), "spec", "replicas")
 			assert.NilError(t, err)
+			// the configuration applied is recorded for the 3-way merge of the next update
+			assert.NilError(t, setLastAppliedConfiguration(_expected, _expected))
 			existing, err = i.mfClient.Get(expectedCloned)
 			assert.NilError(t, err)
 			if d := cmp.Diff(_expected.Object, existing.Object); d != "" {
@@ -853,6 +861,87 @@ func TestEnsureResourceWithHPA(t *testing.T) {
 
 }
 
+func TestEnsureResourceProxyCredentials(t *testing.T) {
+	ctx := context.TODO()
+	defer env.PatchAll(t, map[string]string{
+		"SYSTEM_NAMESPACE": "tekton-operator",
+		"HTTP_PROXY":       "http://proxy.example.com:3128",
+	})()
+	source := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "proxy-auth", Namespace: "tekton-operator"},
+		Data: map[string][]byte{
+			common.ProxyCredentialsUsernameKey: []byte("tekton"),
+			common.ProxyCredentialsPasswordKey: []byte("secret"),
+		},
+	}
+	k8sClient := k8sfake.NewSimpleClientset(source)
+	logger, err := zap.NewDevelopment()
+	assert.NilError(t, err)
+
+	owner := metav1.OwnerReference{APIVersion: "operator.tekton.dev/v1alpha1", Kind: "TektonInstallerSet", Name: "pipeline-main", UID: "pipeline-main"}
+	deployments := []unstructured.Unstructured{}
+	for _, name := range []string{"foo-1", "foo-2"} {
+		dep := getDeployment(name, "bar", 1)
+		dep.Annotations = map[string]string{common.ProxyCredentialsAnnotation: "proxy-auth"}
+		dep.OwnerReferences = []metav1.OwnerReference{owner}
+		dep.Spec.Template.Spec.Containers = []corev1.Container{{Name: name}}
+		obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(dep)
+		assert.NilError(t, err)
+		deployments = append(deployments, unstructured.Unstructured{Object: obj})
+	}
+	// a deployment of another namespace without the proxy credentials
+	plain := getDeployment("plain", "other", 1)
+	plain.Spec.Template.Spec.Containers = []corev1.Container{{Name: "plain"}}
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(plain)
+	assert.NilError(t, err)
+	deployments = append(deployments, unstructured.Unstructured{Object: obj})
+
+	hashOf := func(mfClient mf.Client, u unstructured.Unstructured) string {
+		existing, err := mfClient.Get(&u)
+		assert.NilError(t, err)
+		annotations, _, err := unstructured.NestedStringMap(existing.Object, "spec", "template", "metadata", "annotations")
+		assert.NilError(t, err)
+		return annotations[common.ProxyCredentialsHashAnnotation]
+	}
+	sourceGets := func() int {
+		count := 0
+		for _, action := range k8sClient.Actions() {
+			if action.GetVerb() == "get" && action.GetNamespace() == "tekton-operator" && action.GetResource().Resource == "secrets" {
+				count++
+			}
+		}
+		return count
+	}
+
+	mfClient := fake.New()
+	i := installer{mfClient: mfClient, kubeClientSet: k8sClient, logger: logger.Sugar(), deployment: deployments}
+
+	// the credentials are rendered once for all the deployments of the reconcile
+	assert.NilError(t, i.EnsureDeploymentResources(ctx))
+	assert.Equal(t, sourceGets(), 1)
+	checksum := hashOf(mfClient, deployments[0])
+	assert.Assert(t, checksum != "")
+	assert.Equal(t, hashOf(mfClient, deployments[1]), checksum)
+	rendered, err := k8sClient.CoreV1().Secrets("bar").Get(ctx, common.ProxyCredentialsSecretName, metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, rendered.OwnerReferences, []metav1.OwnerReference{owner})
+	// the secret is only rendered in the namespaces of the deployments referencing it
+	assert.Equal(t, hashOf(mfClient, deployments[2]), "")
+	_, err = k8sClient.CoreV1().Secrets("other").Get(ctx, common.ProxyCredentialsSecretName, metav1.GetOptions{})
+	assert.Assert(t, apierrs.IsNotFound(err))
+
+	// rotated credentials roll the deployments on the next reconcile
+	source.Data[common.ProxyCredentialsPasswordKey] = []byte("rotated")
+	_, err = k8sClient.CoreV1().Secrets("tekton-operator").Update(ctx, source, metav1.UpdateOptions{})
+	assert.NilError(t, err)
+	i = installer{mfClient: mfClient, kubeClientSet: k8sClient, logger: logger.Sugar(), deployment: deployments}
+	assert.NilError(t, i.EnsureDeploymentResources(ctx))
+	assert.Equal(t, sourceGets(), 2)
+	rotated := hashOf(mfClient, deployments[0])
+	assert.Assert(t, rotated != checksum)
+	assert.Equal(t, hashOf(mfClient, deployments[1]), rotated)
+}
+
 func TestEnsureResourceWaitingDeletion(t *testing.T) {
 	ctx := context.TODO()
 	k8sClient := k8sfake.NewSimpleClientset()
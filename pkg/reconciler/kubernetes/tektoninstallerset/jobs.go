@@ -0,0 +1,138 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektoninstallerset
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/hash"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"knative.dev/pkg/ptr"
+)
+
+const (
+	// jobLogsTailLines is the number of lines of the logs of a failed Job reported in the status
+	jobLogsTailLines = 20
+	// jobLogsTailBytes bounds the logs of a failed Job reported in the status
+	jobLogsTailBytes = 2048
+)
+
+// JobFailedError is returned when a Job of the installer set failed, with the tail of the logs
+// of its last pod
+type JobFailedError struct {
+	Namespace string
+	Name      string
+	Reason    string
+	Logs      string
+}
+
+func (e *JobFailedError) Error() string {
+	msg := fmt.Sprintf("job %s/%s failed: %s", e.Namespace, e.Name, e.Reason)
+	if e.Logs != "" {
+		msg += fmt.Sprintf(", last lines of the logs:\n%s", e.Logs)
+	}
+	return msg
+}
+
+// ensureJob creates the Job, the spec of the pods of a Job is immutable so a Job whose manifest
+// changed is deleted to be created again with the manifest, and run again. It returns true when
+// the Job is to be created again once deleted.
+func (i *installer) ensureJob(r *unstructured.Unstructured, installerSetName string) (bool, error) {
+	expectedHash, err := hash.Compute(r.Object)
+	if err != nil {
+		return false, err
+	}
+	res, err := i.mfClient.Get(r)
+	if err != nil && !apierrs.IsNotFound(err) {
+		return false, err
+	}
+	if err == nil && res.GetDeletionTimestamp() == nil && res.GetAnnotations()[v1alpha1.LastAppliedHashKey] != expectedHash {
+		i.logger.Infow("job changed, deleting it to run it again", "namespace", r.GetNamespace(), "name", r.GetName())
+		background := metav1.DeletePropagationBackground
+		if err := i.kubeClientSet.BatchV1().Jobs(r.GetNamespace()).Delete(context.TODO(), r.GetName(), metav1.DeleteOptions{
+			PropagationPolicy: &background,
+		}); err != nil && !apierrs.IsNotFound(err) {
+			return false, err
+		}
+		return true, nil
+	}
+	return i.ensureResourceHash(r, installerSetName)
+}
+
+// jobFailed returns the condition of a failed Job
+func jobFailed(job *batchv1.Job) *batchv1.JobCondition {
+	for i, c := range job.Status.Conditions {
+		if c.Type == batchv1.JobFailed && c.Status == corev1.ConditionTrue {
+			return &job.Status.Conditions[i]
+		}
+	}
+	return nil
+}
+
+// jobFailedError returns the error of a failed Job with the tail of the logs of its last pod,
+// the error is returned without logs when they cannot be read
+func (i *installer) jobFailedError(ctx context.Context, job *batchv1.Job, condition *batchv1.JobCondition) error {
+	e := &JobFailedError{Namespace: job.Namespace, Name: job.Name, Reason: condition.Reason}
+	if condition.Message != "" {
+		e.Reason = fmt.Sprintf("%s, %s", condition.Reason, condition.Message)
+	}
+	logs, err := i.jobLogsTail(ctx, job)
+	if err != nil {
+		i.logger.Debugw("failed to read the logs of the failed job", "namespace", job.Namespace, "name", job.Name, "error", err)
+	}
+	e.Logs = logs
+	return e
+}
+
+// jobLogsTail returns the last lines of the logs of the last pod of the Job
+func (i *installer) jobLogsTail(ctx context.Context, job *batchv1.Job) (string, error) {
+	if job.Spec.Selector == nil {
+		return "", nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(job.Spec.Selector)
+	if err != nil {
+		return "", err
+	}
+	pods, err := i.kubeClientSet.CoreV1().Pods(job.Namespace).List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil || len(pods.Items) == 0 {
+		return "", err
+	}
+	sort.Slice(pods.Items, func(a, b int) bool {
+		return pods.Items[b].CreationTimestamp.Before(&pods.Items[a].CreationTimestamp)
+	})
+	pod := pods.Items[0]
+	if len(pod.Spec.Containers) == 0 {
+		return "", nil
+	}
+	raw, err := i.kubeClientSet.CoreV1().Pods(job.Namespace).GetLogs(pod.Name, &corev1.PodLogOptions{
+		Container:  pod.Spec.Containers[0].Name,
+		TailLines:  ptr.Int64(jobLogsTailLines),
+		LimitBytes: ptr.Int64(jobLogsTailBytes),
+	}).DoRaw(ctx)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(raw)), nil
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektoninstallerset
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mf "github.com/manifestival/manifestival"
+	"github.com/manifestival/manifestival/fake"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"go.uber.org/zap"
+	"gotest.tools/v3/assert"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestJobFailedLogs(t *testing.T) {
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "migrate"},
+		Spec:       batchv1.JobSpec{Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"job-name": "migrate"}}},
+		Status: batchv1.JobStatus{Conditions: []batchv1.JobCondition{{
+			Type:    batchv1.JobFailed,
+			Status:  corev1.ConditionTrue,
+			Reason:  "BackoffLimitExceeded",
+			Message: "Job has reached the specified backoff limit",
+		}}},
+	}
+	pod := func(name string, created time.Time) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: name, Labels: map[string]string{"job-name": "migrate"},
+				CreationTimestamp: metav1.NewTime(created)},
+			Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "migrate"}}},
+		}
+	}
+	now := time.Now()
+	k8sClient := k8sfake.NewSimpleClientset(pod("migrate-1", now.Add(-time.Minute)), pod("migrate-2", now))
+
+	in := []unstructured.Unstructured{namespacedResource("batch/v1", "Job", "test", "migrate")}
+	client := fake.New([]runtime.Object{job}...)
+	manifest, err := mf.ManifestFrom(mf.Slice(in), mf.UseClient(client))
+	assert.NilError(t, err)
+	i := NewInstaller(&manifest, client, k8sClient, zap.NewNop().Sugar())
+
+	err = i.IsJobCompleted(context.Background(), nil, "")
+	var failed *JobFailedError
+	assert.Assert(t, errors.As(err, &failed))
+	assert.Equal(t, failed.Reason, "BackoffLimitExceeded, Job has reached the specified backoff limit")
+	// the fake client returns the same logs for all the pods
+	assert.Equal(t, failed.Logs, "fake logs")
+	assert.ErrorContains(t, err, "job test/migrate failed: BackoffLimitExceeded")
+	assert.ErrorContains(t, err, "last lines of the logs:\nfake logs")
+}
+
+func TestEnsureJobResourcesRecreatesChangedJobs(t *testing.T) {
+	expected := namespacedResource("batch/v1", "Job", "test", "migrate")
+	assert.NilError(t, unstructured.SetNestedField(expected.Object, int64(600), "spec", "activeDeadlineSeconds"))
+	existing := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{
+		Namespace:   "test",
+		Name:        "migrate",
+		Annotations: map[string]string{v1alpha1.LastAppliedHashKey: "previous"},
+	}}
+	client := fake.New([]runtime.Object{existing}...)
+	k8sClient := k8sfake.NewSimpleClientset(existing.DeepCopy())
+	manifest, err := mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{expected}), mf.UseClient(client))
+	assert.NilError(t, err)
+	i := NewInstaller(&manifest, client, k8sClient, zap.NewNop().Sugar())
+
+	// the changed Job is deleted, and created again on the next reconcile
+	assert.Equal(t, i.EnsureJobResources("set"), v1alpha1.RECONCILE_AGAIN_ERR)
+	_, err = k8sClient.BatchV1().Jobs("test").Get(context.TODO(), "migrate", metav1.GetOptions{})
+	assert.Assert(t, apierrs.IsNotFound(err))
+
+	client = fake.New()
+	manifest, err = mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{expected}), mf.UseClient(client))
+	assert.NilError(t, err)
+	i = NewInstaller(&manifest, client, k8sClient, zap.NewNop().Sugar())
+	assert.NilError(t, i.EnsureJobResources("set"))
+	created, err := client.Get(&expected)
+	assert.NilError(t, err)
+	assert.Assert(t, created.GetAnnotations()[v1alpha1.LastAppliedHashKey] != "")
+
+	// the Job is kept while its manifest is unchanged
+	i = NewInstaller(&manifest, client, k8sClient, zap.NewNop().Sugar())
+	assert.NilError(t, i.EnsureJobResources("set"))
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektoninstallerset
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+)
+
+// appliedConfiguration returns the labels, annotations and spec of the resource, the fields the
+// operator reconciles on the deployments and statefulsets
+func appliedConfiguration(u *unstructured.Unstructured) ([]byte, error) {
+	annotations := map[string]interface{}{}
+	for k, v := range u.GetAnnotations() {
+		if k != v1alpha1.LastAppliedConfigurationKey {
+			annotations[k] = v
+		}
+	}
+	labels := map[string]interface{}{}
+	for k, v := range u.GetLabels() {
+		labels[k] = v
+	}
+	spec, _, err := unstructured.NestedFieldNoCopy(u.Object, "spec")
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{"annotations": annotations, "labels": labels},
+		"spec":     spec,
+	})
+}
+
+// setLastAppliedConfiguration records the configuration of the expected resource in the resource
+func setLastAppliedConfiguration(u, expected *unstructured.Unstructured) error {
+	configuration, err := appliedConfiguration(expected)
+	if err != nil {
+		return err
+	}
+	annotations := u.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[v1alpha1.LastAppliedConfigurationKey] = string(configuration)
+	u.SetAnnotations(annotations)
+	return nil
+}
+
+// threeWayMerge updates the existing resource with the changes between the configuration last applied
+// and the expected resource, the fields added to the resource by the users or the admission webhooks,
+// e.g. sidecars injected by a service mesh, are kept. It returns false when no configuration was
+// recorded in the existing resource, the resource is then left unchanged.
+func threeWayMerge(existing, expected *unstructured.Unstructured) (bool, error) {
+	original, ok := existing.GetAnnotations()[v1alpha1.LastAppliedConfigurationKey]
+	if !ok {
+		return false, nil
+	}
+	var schema interface{}
+	switch existing.GetKind() {
+	case "Deployment":
+		schema = appsv1.Deployment{}
+	case "StatefulSet":
+		schema = appsv1.StatefulSet{}
+	default:
+		return false, nil
+	}
+	lookup, err := strategicpatch.NewPatchMetaFromStruct(schema)
+	if err != nil {
+		return false, err
+	}
+	modified, err := appliedConfiguration(expected)
+	if err != nil {
+		return false, err
+	}
+	current, err := existing.MarshalJSON()
+	if err != nil {
+		return false, err
+	}
+	patch, err := strategicpatch.CreateThreeWayMergePatch([]byte(original), modified, current, lookup, true)
+	if err != nil {
+		return false, fmt.Errorf("failed to compute the 3-way merge patch of %s %s/%s: %w",
+			existing.GetKind(), existing.GetNamespace(), existing.GetName(), err)
+	}
+	merged, err := strategicpatch.StrategicMergePatchUsingLookupPatchMeta(current, patch, lookup)
+	if err != nil {
+		return false, err
+	}
+	if err := existing.UnmarshalJSON(merged); err != nil {
+		return false, err
+	}
+	return true, nil
+}
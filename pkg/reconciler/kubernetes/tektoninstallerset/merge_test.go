@@ -0,0 +1,84 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektoninstallerset
+
+import (
+	"context"
+	"testing"
+
+	"github.com/manifestival/manifestival/fake"
+	"go.uber.org/zap"
+	"gotest.tools/v3/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+)
+
+func toUnstructured(t *testing.T, obj interface{}) *unstructured.Unstructured {
+	t.Helper()
+	data, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	assert.NilError(t, err)
+	u := &unstructured.Unstructured{Object: data}
+	u.SetAPIVersion("apps/v1")
+	return u
+}
+
+func TestEnsureResourceThreeWayMerge(t *testing.T) {
+	ctx := context.TODO()
+	mfClient := fake.New()
+	i := installer{mfClient: mfClient, kubeClientSet: k8sfake.NewSimpleClientset(), logger: zap.NewNop().Sugar()}
+
+	dep := getDeployment("controller", "tekton-pipelines", 1)
+	dep.Annotations["removed"] = "true"
+	dep.Spec.Template.Spec.Containers = []corev1.Container{{Name: "controller", Image: "controller:v1", Args: []string{"-v"}}}
+	assert.NilError(t, i.ensureResource(ctx, toUnstructured(t, dep)))
+
+	// a mesh injects a sidecar and a user labels the deployment
+	existing, err := mfClient.Get(toUnstructured(t, dep))
+	assert.NilError(t, err)
+	live := &appsv1.Deployment{}
+	assert.NilError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(existing.Object, live))
+	live.Labels["team"] = "ci"
+	live.Spec.Template.Spec.Containers = append(live.Spec.Template.Spec.Containers, corev1.Container{Name: "istio-proxy", Image: "proxy"})
+	assert.NilError(t, mfClient.Update(toUnstructured(t, live)))
+
+	// the operator updates the image and drops an annotation and the args
+	dep = getDeployment("controller", "tekton-pipelines", 1)
+	dep.Spec.Template.Spec.Containers = []corev1.Container{{Name: "controller", Image: "controller:v2"}}
+	assert.NilError(t, i.ensureResource(ctx, toUnstructured(t, dep)))
+
+	existing, err = mfClient.Get(toUnstructured(t, dep))
+	assert.NilError(t, err)
+	updated := &appsv1.Deployment{}
+	assert.NilError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(existing.Object, updated))
+	assert.Equal(t, updated.Labels["team"], "ci")
+	_, removed := updated.Annotations["removed"]
+	assert.Assert(t, !removed)
+	assert.DeepEqual(t, updated.Spec.Template.Spec.Containers, []corev1.Container{
+		{Name: "controller", Image: "controller:v2"},
+		{Name: "istio-proxy", Image: "proxy"},
+	})
+}
+
+func TestThreeWayMergeWithoutLastApplied(t *testing.T) {
+	dep := toUnstructured(t, getDeployment("controller", "tekton-pipelines", 1))
+	merged, err := threeWayMerge(dep.DeepCopy(), dep)
+	assert.NilError(t, err)
+	assert.Assert(t, !merged)
+}
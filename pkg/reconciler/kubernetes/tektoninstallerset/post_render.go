@@ -0,0 +1,119 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektoninstallerset
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"knative.dev/pkg/system"
+)
+
+// postRenderPatches returns the patches of the ConfigMap referenced by the TektonConfig, there
+// are none when the TektonConfig does not reference a ConfigMap
+func (r *Reconciler) postRenderPatches(ctx context.Context) ([]common.PostRenderPatch, error) {
+	name := r.postRenderPatchesConfigMap()
+	if name == "" {
+		return nil, nil
+	}
+	cm, err := r.kubeClientSet.CoreV1().ConfigMaps(system.Namespace()).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the post render patches ConfigMap %s: %w", name, err)
+	}
+	return common.NewPostRenderPatchesFromMap(cm.Data)
+}
+
+// postRenderPatchesConfigMap returns the name of the ConfigMap of the post render patches
+// referenced by the TektonConfig
+func (r *Reconciler) postRenderPatchesConfigMap() string {
+	tc := r.tektonConfig()
+	if tc == nil || tc.Spec.PostRenderPatches == nil {
+		return ""
+	}
+	return tc.Spec.PostRenderPatches.ConfigMap
+}
+
+// tlsPolicy returns the TLS policy of the TektonConfig applied to the rendered resources
+func (r *Reconciler) tlsPolicy() *v1alpha1.TLSPolicy {
+	tc := r.tektonConfig()
+	if tc == nil || tc.Spec.Security == nil {
+		return nil
+	}
+	return tc.Spec.Security.TLS
+}
+
+// postInstallJobs returns the configuration of the Jobs of the TektonConfig
+func (r *Reconciler) postInstallJobs() *v1alpha1.PostInstallJobs {
+	tc := r.tektonConfig()
+	if tc == nil {
+		return nil
+	}
+	return tc.Spec.PostInstallJobs
+}
+
+func (r *Reconciler) tektonConfig() *v1alpha1.TektonConfig {
+	if r.tektonConfigLister == nil {
+		return nil
+	}
+	tc, err := r.tektonConfigLister.Get(v1alpha1.ConfigResourceName)
+	if err != nil {
+		return nil
+	}
+	return tc
+}
+
+// postRenderChanged returns the handler of the TektonConfig updates calling resync when the
+// reference of the post render patches ConfigMap, the TLS policy or the configuration of the
+// post install Jobs changes
+func postRenderChanged(resync func()) cache.ResourceEventHandlerFuncs {
+	return cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldTC, ok := oldObj.(*v1alpha1.TektonConfig)
+			if !ok {
+				return
+			}
+			newTC, ok := newObj.(*v1alpha1.TektonConfig)
+			if !ok {
+				return
+			}
+			if !reflect.DeepEqual(oldTC.Spec.PostRenderPatches, newTC.Spec.PostRenderPatches) ||
+				!reflect.DeepEqual(oldTC.Spec.Security, newTC.Spec.Security) ||
+				!reflect.DeepEqual(oldTC.Spec.PostInstallJobs, newTC.Spec.PostInstallJobs) {
+				resync()
+			}
+		},
+	}
+}
+
+// isPostRenderPatchesConfigMap returns the filter of the events of the ConfigMap of the post
+// render patches, a deleted ConfigMap fails the install of the installer sets
+func (r *Reconciler) isPostRenderPatchesConfigMap(obj interface{}) bool {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	o, ok := obj.(metav1.Object)
+	if !ok {
+		return false
+	}
+	name := r.postRenderPatchesConfigMap()
+	return name != "" && o.GetNamespace() == system.Namespace() && o.GetName() == name
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektoninstallerset
+
+import (
+	"context"
+	"strings"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
+	"knative.dev/pkg/apis"
+)
+
+// stage returns the condition of the installer set marked once the resources of the kind are
+// applied. Non k8s core resources like openshift resources are classified as namespace scoped.
+func stage(kind string) apis.ConditionType {
+	switch {
+	case strings.ToLower(kind) == "customresourcedefinition":
+		return v1alpha1.CrdInstalled
+	case kind == "Deployment":
+		return v1alpha1.DeploymentsAvailable
+	case kind == "StatefulSet":
+		return v1alpha1.StatefulSetReady
+	case kind == "Job":
+		return v1alpha1.JobsInstalled
+	case isClusterScoped(kind) && strings.ToLower(kind) != "clusterrolebinding":
+		return v1alpha1.ClustersScoped
+	default:
+		return v1alpha1.NamespaceScoped
+	}
+}
+
+// Progress returns the number of applied resources of the installer set and its number of
+// resources. The resources are applied by stage, a resource is counted as applied once the
+// condition of its stage is true.
+func Progress(ctx context.Context, set *v1alpha1.TektonInstallerSet) (applied, total int, err error) {
+	manifests, err := common.InstallerSetManifests(ctx, set)
+	if err != nil {
+		return 0, 0, err
+	}
+	total = len(manifests)
+	if set.Status.IsReady() {
+		return total, total, nil
+	}
+	for _, res := range manifests {
+		if c := set.Status.GetCondition(stage(res.GetKind())); c != nil && c.IsTrue() {
+			applied++
+		}
+	}
+	return applied, total, nil
+}
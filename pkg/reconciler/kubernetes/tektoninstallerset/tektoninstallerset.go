@@ -18,15 +18,20 @@ package tektoninstallerset
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 
 	mf "github.com/manifestival/manifestival"
 	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
 	clientset "github.com/tektoncd/operator/pkg/client/clientset/versioned"
 	tektonInstallerreconciler "github.com/tektoncd/operator/pkg/client/injection/reconciler/operator/v1alpha1/tektoninstallerset"
+	listers "github.com/tektoncd/operator/pkg/client/listers/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 	"knative.dev/pkg/apis"
+	"knative.dev/pkg/controller"
 	"knative.dev/pkg/logging"
 	pkgreconciler "knative.dev/pkg/reconciler"
 )
@@ -36,6 +41,10 @@ type Reconciler struct {
 	operatorClientSet clientset.Interface
 	mfClient          mf.Client
 	kubeClientSet     kubernetes.Interface
+	// selects the API versions served by the cluster for the resources
+	capabilities *common.CapabilitiesCache
+	// gets the TektonConfig referencing the ConfigMap of the post render patches
+	tektonConfigLister listers.TektonConfigLister
 }
 
 // Reconciler implements controller.Reconciler
@@ -46,13 +55,24 @@ var _ tektonInstallerreconciler.Finalizer = (*Reconciler)(nil)
 func (r *Reconciler) FinalizeKind(ctx context.Context, installerSet *v1alpha1.TektonInstallerSet) pkgreconciler.Event {
 	logger := logging.FromContext(ctx)
 
-	deleteManifests, err := mf.ManifestFrom(installerSet.Spec.Manifests, mf.UseClient(r.mfClient))
+	resources, err := common.InstallerSetManifests(ctx, installerSet)
+	if err != nil {
+		logger.Error("Error reading the manifests: ", err)
+		installerSet.Status.MarkNotReady(fmt.Sprintf("Internal Error: failed to read the manifests: %s", err.Error()))
+		return err
+	}
+	deleteManifests, err := mf.ManifestFrom(resources, mf.UseClient(r.mfClient))
 	if err != nil {
 		logger.Error("Error creating initial manifest: ", err)
 		installerSet.Status.MarkNotReady(fmt.Sprintf("Internal Error: failed to create manifest: %s", err.Error()))
 		return err
 	}
 
+	// the resources of the kinds not served by the cluster were never created
+	if capabilities, err := r.capabilities.Get(); err == nil {
+		deleteManifests, _ = capabilities.SkipUnserved(deleteManifests, logger)
+	}
+
 	installer := NewInstaller(&deleteManifests, r.mfClient, r.kubeClientSet, logger)
 	err = installer.DeleteResources()
 	if err != nil {
@@ -77,7 +97,19 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, installerSet *v1alpha1.T
 		"resourceVersion", installerSet.ResourceVersion,
 		"status", installerSet.Status.GetCondition(apis.ConditionReady))
 
-	installManifests, err := mf.ManifestFrom(installerSet.Spec.Manifests, mf.UseClient(r.mfClient))
+	resources, err := common.InstallerSetManifests(ctx, installerSet)
+	if err != nil {
+		logger.Errorw("Failed to read the manifests", "error", err)
+		installerSet.Status.MarkNotReady(fmt.Sprintf("Internal Error: failed to read the manifests: %s", err.Error()))
+		return err
+	}
+	// the manifests stored in ConfigMaps are deleted with the installer sets referencing them
+	if err := common.AdoptInstallerSetManifests(ctx, installerSet); err != nil {
+		logger.Errorw("Failed to adopt the manifests", "error", err)
+		installerSet.Status.MarkNotReady(err.Error())
+		return err
+	}
+	installManifests, err := mf.ManifestFrom(resources, mf.UseClient(r.mfClient))
 	if err != nil {
 		msg := fmt.Sprintf("Internal Error: failed to create manifest: %s", err.Error())
 		logger.Errorw("Failed to create initial manifest", "error", err)
@@ -86,6 +118,56 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, installerSet *v1alpha1.T
 	}
 	logger.Debug("Successfully created initial manifest")
 
+	// Create the resources with the API versions served by the cluster, skipping the optional
+	// kinds it does not serve
+	capabilities, err := r.capabilities.Get()
+	if err != nil {
+		logger.Warnw("Failed to discover the APIs served by the cluster, keeping the API versions of the manifest", "error", err)
+		installerSet.Status.ClearKindsServed()
+	} else {
+		var skipped []string
+		if installManifests, skipped, err = capabilities.SelectVersions(installManifests, logger); err != nil {
+			logger.Errorw("Failed to select the API versions of the manifest", "error", err)
+			installerSet.Status.MarkNotReady(err.Error())
+			return err
+		}
+		if len(skipped) > 0 {
+			installerSet.Status.MarkKindsNotServed(fmt.Sprintf("skipped the resources of kinds not served by the cluster: %s",
+				strings.Join(skipped, ", ")))
+		} else {
+			installerSet.Status.MarkKindsServed()
+		}
+	}
+
+	// Patch the rendered resources with the post render patches of the user
+	patches, err := r.postRenderPatches(ctx)
+	if err != nil {
+		logger.Errorw("Failed to read the post render patches", "error", err)
+		installerSet.Status.MarkNotReady(err.Error())
+		return err
+	}
+	if len(patches) > 0 {
+		if installManifests, err = installManifests.Transform(common.ApplyPostRenderPatches(patches)); err != nil {
+			logger.Errorw("Failed to apply the post render patches", "error", err)
+			installerSet.Status.MarkNotReady(err.Error())
+			return controller.NewPermanentError(err)
+		}
+	}
+
+	// Enforce the TLS policy on the webhooks and the TLS proxies of the components
+	if installManifests, err = installManifests.Transform(common.ApplyTLSPolicy(r.tlsPolicy())); err != nil {
+		logger.Errorw("Failed to apply the TLS policy", "error", err)
+		installerSet.Status.MarkNotReady(err.Error())
+		return err
+	}
+
+	// Configure the Jobs run on install and upgrade
+	if installManifests, err = installManifests.Transform(common.ApplyPostInstallJobs(r.postInstallJobs())); err != nil {
+		logger.Errorw("Failed to configure the Jobs", "error", err)
+		installerSet.Status.MarkNotReady(err.Error())
+		return err
+	}
+
 	// Set owner of InstallerSet as owner of CRDs so that
 	// deleting the installer will not delete the CRDs and Namespace
 	// If installerSet has not set any owner then CRDs will
@@ -103,7 +185,15 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, installerSet *v1alpha1.T
 		return err
 	}
 
+	// Block the install of resources violating the manifest policy rules with error severity
+	if err := common.GetManifestPolicy().Enforce(installManifests.Resources()); err != nil {
+		logger.Errorw("Manifest policy check failed", "error", err)
+		installerSet.Status.MarkNotReady(err.Error())
+		return controller.NewPermanentError(err)
+	}
+
 	installer := NewInstaller(&installManifests, r.mfClient, r.kubeClientSet, logger)
+	defer installer.logApplyTimings()
 
 	// Install CRDs
 	logger.Debug("Installing CRDs")
@@ -216,6 +306,12 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, installerSet *v1alpha1.T
 	err = installer.IsJobCompleted(ctx, labels, installSetname)
 	if err != nil {
 		logger.Warnw("Jobs not completed", "error", err)
+		// the logs of a failed Job are reported in the status of the installer set, and of the
+		// component through its readiness
+		var jobFailed *JobFailedError
+		if errors.As(err, &jobFailed) {
+			installerSet.Status.MarkJobsInstallationFailed(err.Error())
+		}
 		return err
 	}
 	logger.Debug("All jobs completed successfully")
@@ -234,6 +330,20 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, installerSet *v1alpha1.T
 	installerSet.Status.MarkAllDeploymentsReady()
 	logger.Debug("All deployments are ready")
 
+	// Check the spread of the replicas across the zones, the condition is informational
+	unprotected, checked, err := installer.VerifyZoneSpread(ctx)
+	switch {
+	case err != nil:
+		logger.Warnw("Failed to verify the spread of the deployments across zones", "error", err)
+	case !checked:
+		installerSet.Status.ClearZonesSpread()
+	case len(unprotected) > 0:
+		logger.Warnw("Deployments not spread across zones", "deployments", unprotected)
+		installerSet.Status.MarkZonesSpreadDegraded(strings.Join(unprotected, "; "))
+	default:
+		installerSet.Status.MarkZonesSpread()
+	}
+
 	logger.Debugw("TektonInstallerSet reconciliation completed successfully",
 		"ready", installerSet.Status.GetCondition(apis.ConditionReady))
 
@@ -0,0 +1,140 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektoninstallerset
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// VerifyZoneSpread checks that the ready replicas of the Deployments spread across the zones by a
+// topology spread constraint run in as many zones as they can, the number of replicas bounded by
+// the number of zones of the cluster. It returns the unprotected Deployments, and false when no
+// Deployment is spread across the zones of a multi-zone cluster.
+func (i *installer) VerifyZoneSpread(ctx context.Context) ([]string, bool, error) {
+	if len(i.deployment) == 0 {
+		return nil, false, nil
+	}
+	nodes, err := i.kubeClientSet.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, false, err
+	}
+	zoneOf := map[string]string{}
+	zones, readyZones := sets.New[string](), sets.New[string]()
+	for _, node := range nodes.Items {
+		zone := node.Labels[corev1.LabelTopologyZone]
+		if zone == "" {
+			continue
+		}
+		zoneOf[node.Name] = zone
+		zones.Insert(zone)
+		if nodeReady(&node) {
+			readyZones.Insert(zone)
+		}
+	}
+	if zones.Len() < 2 {
+		return nil, false, nil
+	}
+
+	checked := false
+	var unprotected []string
+	for _, u := range i.deployment {
+		resource, err := i.mfClient.Get(&u)
+		if err != nil {
+			return nil, false, err
+		}
+		deployment := &appsv1.Deployment{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(resource.Object, deployment); err != nil {
+			return nil, false, err
+		}
+		replicas := int32(1)
+		if deployment.Spec.Replicas != nil {
+			replicas = *deployment.Spec.Replicas
+		}
+		if replicas < 2 || !spreadAcrossZones(&deployment.Spec.Template.Spec) {
+			continue
+		}
+		checked = true
+
+		selector, err := metav1.LabelSelectorAsSelector(deployment.Spec.Selector)
+		if err != nil {
+			return nil, false, err
+		}
+		pods, err := i.kubeClientSet.CoreV1().Pods(deployment.Namespace).List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+		if err != nil {
+			return nil, false, err
+		}
+		used := sets.New[string]()
+		ready := 0
+		for _, pod := range pods.Items {
+			if pod.DeletionTimestamp != nil || !podReady(&pod) {
+				continue
+			}
+			ready++
+			if zone := zoneOf[pod.Spec.NodeName]; zone != "" {
+				used.Insert(zone)
+			}
+		}
+		if used.Len() >= min(int(replicas), zones.Len()) {
+			continue
+		}
+		msg := fmt.Sprintf("deployment %s/%s has %d ready replicas in %d of %d zones (%s)", deployment.Namespace, deployment.Name,
+			ready, used.Len(), zones.Len(), strings.Join(sets.List(used), ", "))
+		if down := zones.Difference(readyZones); down.Len() > 0 {
+			msg += fmt.Sprintf(", zones without ready nodes: %s", strings.Join(sets.List(down), ", "))
+		}
+		unprotected = append(unprotected, msg)
+	}
+	sort.Strings(unprotected)
+	return unprotected, checked, nil
+}
+
+// spreadAcrossZones returns true when the pods are spread across the zones by a topology spread constraint
+func spreadAcrossZones(spec *corev1.PodSpec) bool {
+	for _, c := range spec.TopologySpreadConstraints {
+		if c.TopologyKey == corev1.LabelTopologyZone {
+			return true
+		}
+	}
+	return false
+}
+
+func nodeReady(node *corev1.Node) bool {
+	for _, c := range node.Status.Conditions {
+		if c.Type == corev1.NodeReady {
+			return c.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+func podReady(pod *corev1.Pod) bool {
+	for _, c := range pod.Status.Conditions {
+		if c.Type == corev1.PodReady {
+			return c.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektoninstallerset
+
+import (
+	"context"
+	"testing"
+
+	mf "github.com/manifestival/manifestival"
+	"github.com/manifestival/manifestival/fake"
+	"go.uber.org/zap"
+	"gotest.tools/v3/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"knative.dev/pkg/ptr"
+)
+
+func zoneNode(name, zone string, ready bool) *corev1.Node {
+	status := corev1.ConditionTrue
+	if !ready {
+		status = corev1.ConditionFalse
+	}
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{corev1.LabelTopologyZone: zone}},
+		Status:     corev1.NodeStatus{Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: status}}},
+	}
+}
+
+func webhookPod(name, node string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "tekton-pipelines", Labels: map[string]string{"app": "webhook"}},
+		Spec:       corev1.PodSpec{NodeName: node},
+		Status:     corev1.PodStatus{Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}}},
+	}
+}
+
+func spreadDeployment(replicas int32, constraints ...corev1.TopologySpreadConstraint) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+		ObjectMeta: metav1.ObjectMeta{Name: "tekton-pipelines-webhook", Namespace: "tekton-pipelines"},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.Int32(replicas),
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "webhook"}},
+			Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{TopologySpreadConstraints: constraints}},
+		},
+	}
+}
+
+func TestVerifyZoneSpread(t *testing.T) {
+	zoneSpread := corev1.TopologySpreadConstraint{MaxSkew: 1, TopologyKey: corev1.LabelTopologyZone, WhenUnsatisfiable: corev1.ScheduleAnyway}
+	hostSpread := corev1.TopologySpreadConstraint{MaxSkew: 1, TopologyKey: corev1.LabelHostname, WhenUnsatisfiable: corev1.ScheduleAnyway}
+	threeZones := []runtime.Object{zoneNode("node-a", "zone-a", true), zoneNode("node-b", "zone-b", true), zoneNode("node-c", "zone-c", false)}
+
+	tests := []struct {
+		name            string
+		objects         []runtime.Object
+		deployment      *appsv1.Deployment
+		wantChecked     bool
+		wantUnprotected []string
+	}{{
+		name:        "spread across the zones",
+		objects:     append(threeZones, webhookPod("webhook-1", "node-a"), webhookPod("webhook-2", "node-b")),
+		deployment:  spreadDeployment(2, zoneSpread),
+		wantChecked: true,
+	}, {
+		name:        "zone outage",
+		objects:     append(threeZones, webhookPod("webhook-1", "node-a"), webhookPod("webhook-2", "node-a"), webhookPod("webhook-3", "node-b")),
+		deployment:  spreadDeployment(3, zoneSpread),
+		wantChecked: true,
+		wantUnprotected: []string{"deployment tekton-pipelines/tekton-pipelines-webhook has 3 ready replicas in 2 of 3 zones (zone-a, zone-b), " +
+			"zones without ready nodes: zone-c"},
+	}, {
+		name:        "replicas in a single zone",
+		objects:     append(threeZones, webhookPod("webhook-1", "node-a"), webhookPod("webhook-2", "node-a")),
+		deployment:  spreadDeployment(2, zoneSpread),
+		wantChecked: true,
+		wantUnprotected: []string{"deployment tekton-pipelines/tekton-pipelines-webhook has 2 ready replicas in 1 of 3 zones (zone-a), " +
+			"zones without ready nodes: zone-c"},
+	}, {
+		name:       "not spread across the zones",
+		objects:    append(threeZones, webhookPod("webhook-1", "node-a"), webhookPod("webhook-2", "node-a")),
+		deployment: spreadDeployment(2, hostSpread),
+	}, {
+		name:       "single replica",
+		objects:    threeZones,
+		deployment: spreadDeployment(1, zoneSpread),
+	}, {
+		name:       "single zone cluster",
+		objects:    []runtime.Object{zoneNode("node-a", "zone-a", true), webhookPod("webhook-1", "node-a"), webhookPod("webhook-2", "node-a")},
+		deployment: spreadDeployment(2, zoneSpread),
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(test.deployment)
+			assert.NilError(t, err)
+			client := fake.New(test.deployment)
+			manifest, err := mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{{Object: u}}), mf.UseClient(client))
+			assert.NilError(t, err)
+			i := NewInstaller(&manifest, client, k8sfake.NewSimpleClientset(test.objects...), zap.NewNop().Sugar())
+
+			unprotected, checked, err := i.VerifyZoneSpread(context.TODO())
+			assert.NilError(t, err)
+			assert.Equal(t, checked, test.wantChecked)
+			assert.DeepEqual(t, unprotected, test.wantUnprotected)
+		})
+	}
+}
@@ -19,10 +19,9 @@ package tektonpipeline
 import (
 	"context"
 
-	mf "github.com/manifestival/manifestival"
 	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
 	tektonpipelinereconciler "github.com/tektoncd/operator/pkg/client/injection/reconciler/operator/v1alpha1/tektonpipeline"
-	"knative.dev/pkg/logging"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
 	pkgreconciler "knative.dev/pkg/reconciler"
 )
 
@@ -30,24 +29,12 @@ var _ tektonpipelinereconciler.Finalizer = (*Reconciler)(nil)
 
 // FinalizeKind removes all resources after deletion of a TektonPipeline.
 func (r *Reconciler) FinalizeKind(ctx context.Context, original *v1alpha1.TektonPipeline) pkgreconciler.Event {
-	logger := logging.FromContext(ctx)
-
 	// Delete CRDs before deleting rest of resources so that any instance
 	// of CRDs which has finalizer set will get deleted before we remove
-	// the controller;s deployment for it
-	if err := r.manifest.Filter(mf.CRDs).Delete(); err != nil {
-		logger.Error("Failed to delete CRDs for TektonPipeline")
-		return err
-	}
-
-	if err := r.installerSetClient.CleanupMainSet(ctx); err != nil {
-		logger.Error("failed to cleanup main installerset: ", err)
-		return err
-	}
-
-	if err := r.extension.Finalize(ctx, original); err != nil {
-		logger.Error("Failed to finalize platform resources: ", err)
-	}
-
-	return nil
+	// the controller's deployment for it
+	return common.Finalize(ctx, original, &original.Status,
+		common.CRDsFinalizationStep(r.manifest),
+		common.PlatformFinalizationStep(r.extension, original),
+		common.FinalizationStep{Name: "main installer set", Stage: common.FinalizeInstallerSets, Run: r.installerSetClient.CleanupMainSet},
+	)
 }
@@ -71,12 +71,24 @@ func filterAndTransform(extension common.Extension) client.FilterAndTransform {
 		imagesRaw := common.ToLowerCaseKeys(common.ImagesFromEnv(common.PipelinesImagePrefix))
 		images := common.ImageRegistryDomainOverride(imagesRaw)
 		instance := comp.(*v1alpha1.TektonPipeline)
+		// the pod security defaults, and the proxy of the operator when enabled for the workloads, are added to the default pod template of the pipelines
+		defaults := pipeline.Spec.OptionalPipelineProperties
+		podTemplate, err := common.DefaultPodTemplateWithPodSecurity(defaults.DefaultPodTemplate, pipeline.Spec.Config.PodSecurity)
+		if err != nil {
+			return &mf.Manifest{}, err
+		}
+		if pipeline.Spec.Config.ProxyInDefaultPodTemplate && common.WorkloadProxyEnabled(pipeline.Spec.Config) {
+			if podTemplate, err = common.DefaultPodTemplateWithProxy(podTemplate); err != nil {
+				return &mf.Manifest{}, err
+			}
+		}
+		defaults.DefaultPodTemplate = podTemplate
 		// adding extension's transformers first to run them before `extra` transformers
 		trns := extension.Transformers(instance)
 		extra := []mf.Transformer{
 			common.InjectOperandNameLabelOverwriteExisting(v1alpha1.OperandTektoncdPipeline),
 			common.AddConfigMapValues(FeatureFlag, pipeline.Spec.PipelineProperties),
-			common.AddConfigMapValues(ConfigDefaults, pipeline.Spec.OptionalPipelineProperties),
+			common.AddConfigMapValues(ConfigDefaults, defaults),
 			common.AddConfigMapValues(ConfigMetrics, pipeline.Spec.PipelineMetricsProperties),
 			addTracingConfigValues(pipeline),
 			common.AddConfigMapValues(ResolverFeatureFlag, pipeline.Spec.Resolvers),
@@ -85,6 +97,10 @@ func filterAndTransform(extension common.Extension) client.FilterAndTransform {
 			common.DeploymentEnvVarKubernetesMinVersion(),
 			common.InjectLabelOnNamespace(proxyLabel),
 			common.AddConfiguration(pipeline.Spec.Config),
+			common.ControlPlaneProxy(pipeline.Spec.Config),
+			common.WorkloadProxy(pipeline.Spec.Config),
+			common.AddMinimalFootprintResyncPeriod(pipeline.Spec.Config, pipelinesControllerDeployment, pipelinesControllerContainer),
+			common.AddMetricsTLS(*manifest, pipeline.Spec.Config.MetricsTLS),
 			common.CopyConfigMap(bundleResolverConfig, pipeline.Spec.BundlesResolverConfig),
 			common.CopyConfigMap(hubResolverConfig, pipeline.Spec.HubResolverConfig),
 			common.CopyConfigMap(clusterResolverConfig, pipeline.Spec.ClusterResolverConfig),
@@ -0,0 +1,286 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonresult
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	mf "github.com/manifestival/manifestival"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"knative.dev/pkg/logging"
+	certresources "knative.dev/pkg/webhook/certificates/resources"
+)
+
+const (
+	apiServiceName    = "tekton-results-api-service"
+	defaultServerPort = 8080
+	// tlsCAKey holds the certificate of the CA issuing the serving certificate of the Results API
+	tlsCAKey = "ca.crt"
+	// apiTLSGeneratedAnnotation marks the serving certificate generated by the operator, it is
+	// generated again before it expires. The certificates of the users are left as they are.
+	apiTLSGeneratedAnnotation = "operator.tekton.dev/generated-tls"
+	// apiTLSRotatedAnnotation restarts the pods of the Results API once their certificate is rotated
+	apiTLSRotatedAnnotation = "operator.tekton.dev/tls-rotated-at"
+
+	apiTLSCertValidity = 365 * 24 * time.Hour
+	apiTLSCertRenewal  = 30 * 24 * time.Hour
+
+	watcherAPICAVolume    = "results-api-ca"
+	watcherAPICAMountPath = "/etc/results-api-ca"
+	sslCertDirEnv         = "SSL_CERT_DIR"
+	defaultSSLCertDir     = "/etc/ssl/certs"
+)
+
+// apiTLSHandshake dials the Results API and verifies its serving certificate, it is replaced in the tests
+var apiTLSHandshake = func(ctx context.Context, address, serverName string, roots *x509.CertPool) error {
+	dialer := &tls.Dialer{
+		NetDialer: &net.Dialer{Timeout: 5 * time.Second},
+		Config:    &tls.Config{RootCAs: roots, ServerName: serverName, MinVersion: tls.VersionTLS12},
+	}
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+// ensureAPITLSSecret generates the serving certificate of the Results API on Kubernetes, issued
+// by a CA whose certificate is in the ca.crt key of the Secret. The generated certificate is
+// rotated before it expires and the Results API restarted, a certificate provided by the user is
+// not changed. On OpenShift the serving certificate is issued by the service CA.
+func (r *Reconciler) ensureAPITLSSecret(ctx context.Context, tr *v1alpha1.TektonResult) error {
+	logger := logging.FromContext(ctx)
+
+	if v1alpha1.IsOpenShiftPlatform() {
+		logger.Info("Skipping default TLS secret creation: running on OpenShift platform")
+		return nil
+	}
+	namespace := tr.Spec.TargetNamespace
+	secrets := r.kubeClientSet.CoreV1().Secrets(namespace)
+	existing, err := secrets.Get(ctx, TlsSecretName, metav1.GetOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		logger.Errorf("failed to find default TektonResult TLS secret %s in namespace %s: %v", TlsSecretName, namespace, err)
+		return err
+	}
+	found := err == nil
+	if found {
+		if !generatedAPICertificate(existing) {
+			return nil
+		}
+		if _, generated := existing.Annotations[apiTLSGeneratedAnnotation]; generated && !certificateExpiring(existing.Data[corev1.TLSCertKey]) {
+			return nil
+		}
+	}
+
+	serverKey, serverCert, caCert, err := certresources.CreateCerts(ctx, apiServiceName, namespace, time.Now().Add(apiTLSCertValidity))
+	if err != nil {
+		logger.Errorf("failed to generate default TektonResult TLS certificate: %v", err)
+		return err
+	}
+	data := map[string][]byte{
+		corev1.TLSCertKey:       serverCert,
+		corev1.TLSPrivateKeyKey: serverKey,
+		tlsCAKey:                caCert,
+	}
+	if !found {
+		logger.Infof("generating the serving certificate %s/%s of the Results API", namespace, TlsSecretName)
+		_, err = secrets.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        TlsSecretName,
+				Namespace:   namespace,
+				Annotations: map[string]string{apiTLSGeneratedAnnotation: "true"},
+			},
+			Type: corev1.SecretTypeTLS,
+			Data: data,
+		}, metav1.CreateOptions{})
+		if err != nil {
+			tr.Status.MarkDependencyMissing(fmt.Sprintf("Default TLS Secret %s creation is failing", TlsSecretName))
+		}
+		return err
+	}
+
+	logger.Infof("rotating the serving certificate %s/%s of the Results API", namespace, TlsSecretName)
+	existing = existing.DeepCopy()
+	if existing.Annotations == nil {
+		existing.Annotations = map[string]string{}
+	}
+	existing.Annotations[apiTLSGeneratedAnnotation] = "true"
+	existing.Data = data
+	if _, err := secrets.Update(ctx, existing, metav1.UpdateOptions{}); err != nil {
+		return err
+	}
+	return r.restartAPI(ctx, namespace)
+}
+
+// generatedAPICertificate returns true if the certificate of the Secret was generated by the
+// operator, including the self-signed certificates generated without a CA by earlier versions
+func generatedAPICertificate(secret *corev1.Secret) bool {
+	if _, ok := secret.Annotations[apiTLSGeneratedAnnotation]; ok {
+		return true
+	}
+	if _, ok := secret.Data[tlsCAKey]; ok {
+		return false
+	}
+	cert, err := parseCertificate(secret.Data[corev1.TLSCertKey])
+	if err != nil {
+		return false
+	}
+	legacyName := fmt.Sprintf("%s.%s.svc.cluster.local", apiServiceName, secret.Namespace)
+	return cert.Subject.CommonName == legacyName && cert.Issuer.CommonName == legacyName
+}
+
+func parseCertificate(data []byte) (*x509.Certificate, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM certificate")
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
+
+// certificateExpiring returns true if the certificate cannot be read or expires within the renewal period
+func certificateExpiring(data []byte) bool {
+	cert, err := parseCertificate(data)
+	if err != nil {
+		return true
+	}
+	return time.Until(cert.NotAfter) < apiTLSCertRenewal
+}
+
+// restartAPI restarts the pods of the Results API to serve the rotated certificate
+func (r *Reconciler) restartAPI(ctx context.Context, namespace string) error {
+	patch := fmt.Sprintf(`{"spec":{"template":{"metadata":{"annotations":{%q:%q}}}}}`, apiTLSRotatedAnnotation, time.Now().UTC().Format(time.RFC3339))
+	_, err := r.kubeClientSet.AppsV1().Deployments(namespace).Patch(ctx, resultAPIDeployment, types.StrategicMergePatchType, []byte(patch), metav1.PatchOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// addWatcherAPITrust mounts the CA of the serving certificate of the Results API in the watcher
+// and adds it to the certificate directories of the watcher, the volume is optional as the
+// certificates provided by the users may have no CA. On OpenShift the watcher trusts the service CA.
+func addWatcherAPITrust() mf.Transformer {
+	return func(u *unstructured.Unstructured) error {
+		if v1alpha1.IsOpenShiftPlatform() || u.GetKind() != "Deployment" || u.GetName() != resultWatcherDeployment {
+			return nil
+		}
+		d := &appsv1.Deployment{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, d); err != nil {
+			return err
+		}
+		pod := &d.Spec.Template.Spec
+		for _, v := range pod.Volumes {
+			if v.Name == watcherAPICAVolume {
+				return nil
+			}
+		}
+		optional := true
+		pod.Volumes = append(pod.Volumes, corev1.Volume{
+			Name: watcherAPICAVolume,
+			VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{
+				SecretName: TlsSecretName,
+				Items:      []corev1.KeyToPath{{Key: tlsCAKey, Path: tlsCAKey}},
+				Optional:   &optional,
+			}},
+		})
+		for i := range pod.Containers {
+			c := &pod.Containers[i]
+			if c.Name != watcherContainerName {
+				continue
+			}
+			c.VolumeMounts = append(c.VolumeMounts, corev1.VolumeMount{Name: watcherAPICAVolume, MountPath: watcherAPICAMountPath, ReadOnly: true})
+			found := false
+			for j := range c.Env {
+				if c.Env[j].Name == sslCertDirEnv {
+					c.Env[j].Value = strings.Join([]string{c.Env[j].Value, watcherAPICAMountPath}, ":")
+					found = true
+				}
+			}
+			if !found {
+				c.Env = append(c.Env, corev1.EnvVar{Name: sslCertDirEnv, Value: defaultSSLCertDir + ":" + watcherAPICAMountPath})
+			}
+		}
+		obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(d)
+		if err != nil {
+			return err
+		}
+		u.SetUnstructuredContent(obj)
+		return nil
+	}
+}
+
+// updateAPITLSStatus verifies the TLS handshake with the Results API once it is rolled out, with the
+// CA of its serving certificate, it does not fail the reconcile
+func (r *Reconciler) updateAPITLSStatus(ctx context.Context, tr *v1alpha1.TektonResult) {
+	logger := logging.FromContext(ctx)
+	if v1alpha1.IsOpenShiftPlatform() {
+		tr.Status.ClearAPITLSVerified()
+		return
+	}
+	if err := r.verifyAPITLS(ctx, tr); err != nil {
+		logger.Warnw("TLS handshake with the Results API failed", "error", err)
+		tr.Status.MarkAPITLSVerificationFailed(err.Error())
+		return
+	}
+	tr.Status.MarkAPITLSVerified()
+}
+
+func (r *Reconciler) verifyAPITLS(ctx context.Context, tr *v1alpha1.TektonResult) error {
+	namespace := tr.Spec.GetTargetNamespace()
+	secret, err := r.kubeClientSet.CoreV1().Secrets(namespace).Get(ctx, TlsSecretName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get the TLS secret %s/%s: %w", namespace, TlsSecretName, err)
+	}
+	// the certificates of the users may be self-signed
+	ca, ok := secret.Data[tlsCAKey]
+	if !ok {
+		ca = secret.Data[corev1.TLSCertKey]
+	}
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM(ca) {
+		return fmt.Errorf("no certificate found in the TLS secret %s/%s", namespace, TlsSecretName)
+	}
+
+	host := fmt.Sprintf("%s.%s.svc", apiServiceName, namespace)
+	port := int64(defaultServerPort)
+	if tr.Spec.ServerPort != nil {
+		port = *tr.Spec.ServerPort
+	}
+	serverName := host
+	if tr.Spec.TLSHostnameOverride != "" {
+		serverName = tr.Spec.TLSHostnameOverride
+	}
+	address := net.JoinHostPort(host, fmt.Sprint(port))
+	if err := apiTLSHandshake(ctx, address, serverName, roots); err != nil {
+		return fmt.Errorf("TLS handshake with %s failed: %w", address, err)
+	}
+	return nil
+}
@@ -0,0 +1,239 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonresult
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"gotest.tools/v3/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	"knative.dev/pkg/apis"
+	certresources "knative.dev/pkg/webhook/certificates/resources"
+)
+
+const testResultsNamespace = "tekton-pipelines"
+
+func newTestResult() *v1alpha1.TektonResult {
+	tr := &v1alpha1.TektonResult{}
+	tr.Spec.TargetNamespace = testResultsNamespace
+	return tr
+}
+
+func selfSignedCertificate(t *testing.T, commonName string) []byte {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NilError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	assert.NilError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: CertificateBlockType, Bytes: der})
+}
+
+func apiDeployment() *appsv1.Deployment {
+	return &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: resultAPIDeployment, Namespace: testResultsNamespace}}
+}
+
+func TestEnsureAPITLSSecretCreate(t *testing.T) {
+	ctx := context.Background()
+	client := kubefake.NewSimpleClientset()
+	r := &Reconciler{kubeClientSet: client}
+
+	assert.NilError(t, r.ensureAPITLSSecret(ctx, newTestResult()))
+	secret, err := client.CoreV1().Secrets(testResultsNamespace).Get(ctx, TlsSecretName, metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, secret.Type, corev1.SecretTypeTLS)
+	assert.Equal(t, secret.Annotations[apiTLSGeneratedAnnotation], "true")
+
+	// the serving certificate is issued by the CA of the secret
+	roots := x509.NewCertPool()
+	assert.Assert(t, roots.AppendCertsFromPEM(secret.Data[tlsCAKey]))
+	cert, err := parseCertificate(secret.Data[corev1.TLSCertKey])
+	assert.NilError(t, err)
+	_, err = cert.Verify(x509.VerifyOptions{DNSName: apiServiceName + "." + testResultsNamespace + ".svc", Roots: roots})
+	assert.NilError(t, err)
+
+	// a valid certificate is not rotated
+	client.ClearActions()
+	assert.NilError(t, r.ensureAPITLSSecret(ctx, newTestResult()))
+	for _, action := range client.Actions() {
+		assert.Equal(t, action.GetVerb(), "get")
+	}
+}
+
+func TestEnsureAPITLSSecretRotate(t *testing.T) {
+	ctx := context.Background()
+	key, cert, ca, err := certresources.CreateCerts(ctx, apiServiceName, testResultsNamespace, time.Now().Add(24*time.Hour))
+	assert.NilError(t, err)
+	legacyName := apiServiceName + "." + testResultsNamespace + ".svc.cluster.local"
+
+	tests := []struct {
+		name   string
+		secret *corev1.Secret
+		rotate bool
+	}{{
+		name: "generated certificate expiring",
+		secret: &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: TlsSecretName, Namespace: testResultsNamespace,
+				Annotations: map[string]string{apiTLSGeneratedAnnotation: "true"}},
+			Data: map[string][]byte{corev1.TLSCertKey: cert, corev1.TLSPrivateKeyKey: key, tlsCAKey: ca},
+		},
+		rotate: true,
+	}, {
+		name: "self-signed certificate of an earlier version",
+		secret: &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: TlsSecretName, Namespace: testResultsNamespace},
+			Data:       map[string][]byte{corev1.TLSCertKey: selfSignedCertificate(t, legacyName)},
+		},
+		rotate: true,
+	}, {
+		name: "certificate of the user",
+		secret: &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: TlsSecretName, Namespace: testResultsNamespace},
+			Data:       map[string][]byte{corev1.TLSCertKey: cert, corev1.TLSPrivateKeyKey: key, tlsCAKey: ca},
+		},
+	}, {
+		name: "self-signed certificate of the user",
+		secret: &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: TlsSecretName, Namespace: testResultsNamespace},
+			Data:       map[string][]byte{corev1.TLSCertKey: selfSignedCertificate(t, "results.example.com")},
+		},
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := kubefake.NewSimpleClientset(test.secret, apiDeployment())
+			r := &Reconciler{kubeClientSet: client}
+			assert.NilError(t, r.ensureAPITLSSecret(ctx, newTestResult()))
+
+			secret, err := client.CoreV1().Secrets(testResultsNamespace).Get(ctx, TlsSecretName, metav1.GetOptions{})
+			assert.NilError(t, err)
+			deployment, err := client.AppsV1().Deployments(testResultsNamespace).Get(ctx, resultAPIDeployment, metav1.GetOptions{})
+			assert.NilError(t, err)
+			_, restarted := deployment.Spec.Template.Annotations[apiTLSRotatedAnnotation]
+			assert.Equal(t, restarted, test.rotate)
+			if !test.rotate {
+				assert.DeepEqual(t, secret.Data, test.secret.Data)
+				return
+			}
+			assert.Equal(t, secret.Annotations[apiTLSGeneratedAnnotation], "true")
+			assert.Assert(t, !certificateExpiring(secret.Data[corev1.TLSCertKey]))
+			assert.Assert(t, len(secret.Data[tlsCAKey]) > 0)
+		})
+	}
+}
+
+func TestAddWatcherAPITrust(t *testing.T) {
+	deployment := &appsv1.Deployment{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+		ObjectMeta: metav1.ObjectMeta{Name: resultWatcherDeployment},
+		Spec: appsv1.DeploymentSpec{Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{
+				Name: watcherContainerName,
+				Env:  []corev1.EnvVar{{Name: sslCertDirEnv, Value: "/etc/tls"}},
+			}, {
+				Name: "sidecar",
+			}},
+		}}},
+	}
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(deployment)
+	assert.NilError(t, err)
+	u := &unstructured.Unstructured{Object: obj}
+
+	transformer := addWatcherAPITrust()
+	assert.NilError(t, transformer(u))
+	// the transformer is idempotent
+	assert.NilError(t, transformer(u))
+
+	got := &appsv1.Deployment{}
+	assert.NilError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, got))
+	pod := got.Spec.Template.Spec
+	assert.Equal(t, len(pod.Volumes), 1)
+	assert.Equal(t, pod.Volumes[0].Secret.SecretName, TlsSecretName)
+	assert.Assert(t, *pod.Volumes[0].Secret.Optional)
+	assert.DeepEqual(t, pod.Containers[0].VolumeMounts, []corev1.VolumeMount{{Name: watcherAPICAVolume, MountPath: watcherAPICAMountPath, ReadOnly: true}})
+	assert.DeepEqual(t, pod.Containers[0].Env, []corev1.EnvVar{{Name: sslCertDirEnv, Value: "/etc/tls:" + watcherAPICAMountPath}})
+	assert.Equal(t, len(pod.Containers[1].VolumeMounts), 0)
+	assert.Equal(t, len(pod.Containers[1].Env), 0)
+
+	deployment.Spec.Template.Spec.Containers[0].Env = nil
+	obj, err = runtime.DefaultUnstructuredConverter.ToUnstructured(deployment)
+	assert.NilError(t, err)
+	u = &unstructured.Unstructured{Object: obj}
+	assert.NilError(t, transformer(u))
+	assert.NilError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, got))
+	assert.DeepEqual(t, got.Spec.Template.Spec.Containers[0].Env, []corev1.EnvVar{{Name: sslCertDirEnv, Value: defaultSSLCertDir + ":" + watcherAPICAMountPath}})
+}
+
+func TestUpdateAPITLSStatus(t *testing.T) {
+	ctx := context.Background()
+	key, cert, ca, err := certresources.CreateCerts(ctx, apiServiceName, testResultsNamespace, time.Now().Add(time.Hour))
+	assert.NilError(t, err)
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: TlsSecretName, Namespace: testResultsNamespace},
+		Data:       map[string][]byte{corev1.TLSCertKey: cert, corev1.TLSPrivateKeyKey: key, tlsCAKey: ca},
+	}
+	handshake := apiTLSHandshake
+	defer func() { apiTLSHandshake = handshake }()
+
+	var address, serverName string
+	var handshakeErr error
+	apiTLSHandshake = func(_ context.Context, a, s string, roots *x509.CertPool) error {
+		address, serverName = a, s
+		return handshakeErr
+	}
+
+	r := &Reconciler{kubeClientSet: kubefake.NewSimpleClientset(secret)}
+	tr := newTestResult()
+	port := int64(8443)
+	tr.Spec.ServerPort = &port
+	r.updateAPITLSStatus(ctx, tr)
+	assert.Equal(t, address, "tekton-results-api-service.tekton-pipelines.svc:8443")
+	assert.Equal(t, serverName, "tekton-results-api-service.tekton-pipelines.svc")
+	assert.Equal(t, tr.Status.GetCondition(v1alpha1.APITLSVerified).Status, corev1.ConditionTrue)
+	// the condition does not affect the readiness
+	assert.Equal(t, tr.Status.GetCondition(apis.ConditionReady).IsTrue(), false)
+
+	handshakeErr = errors.New("x509: certificate signed by unknown authority")
+	tr.Spec.TLSHostnameOverride = "results.example.com"
+	r.updateAPITLSStatus(ctx, tr)
+	assert.Equal(t, serverName, "results.example.com")
+	assert.Equal(t, tr.Status.GetCondition(v1alpha1.APITLSVerified).Status, corev1.ConditionFalse)
+
+	r = &Reconciler{kubeClientSet: kubefake.NewSimpleClientset()}
+	r.updateAPITLSStatus(ctx, tr)
+	assert.Equal(t, tr.Status.GetCondition(v1alpha1.APITLSVerified).Status, corev1.ConditionFalse)
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonresult
+
+import (
+	"context"
+
+	mf "github.com/manifestival/manifestival"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const retentionPolicyRunAtKey = "runAt"
+
+// updateRetentionPolicyConfig renders the retention policy into the retention-policy-agent ConfigMap
+func updateRetentionPolicyConfig(policy *v1alpha1.RetentionPolicyProperties) mf.Transformer {
+	return func(u *unstructured.Unstructured) error {
+		if policy == nil {
+			return nil
+		}
+		return common.AddConfigMapValues(configRetentionPolicy, *policy)(u)
+	}
+}
+
+// updateRetentionPolicyStatus reports the schedule the retention-policy-agent is configured with,
+// the runs of the agent are not reported as the agent does not record them
+func (r *Reconciler) updateRetentionPolicyStatus(ctx context.Context, tr *v1alpha1.TektonResult) error {
+	cm, err := r.kubeClientSet.CoreV1().ConfigMaps(tr.Spec.GetTargetNamespace()).Get(ctx, configRetentionPolicy, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			tr.Status.RetentionPolicy = nil
+			return nil
+		}
+		return err
+	}
+	tr.Status.RetentionPolicy = &v1alpha1.RetentionPolicyStatus{RunAt: cm.Data[retentionPolicyRunAtKey]}
+	return nil
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonresult
+
+import (
+	"context"
+	"testing"
+
+	mf "github.com/manifestival/manifestival"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestUpdateRetentionPolicyConfig(t *testing.T) {
+	retention := uint(60)
+	records := uint(500)
+
+	u := &unstructured.Unstructured{}
+	u.SetAPIVersion("v1")
+	u.SetKind("ConfigMap")
+	u.SetName(configRetentionPolicy)
+	u.Object["data"] = map[string]interface{}{"runAt": "7 7 * * 7", "maxRetention": "30"}
+
+	manifest, err := mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{*u}))
+	assert.NilError(t, err)
+
+	// no policy keeps the shipped defaults
+	unchanged, err := manifest.Transform(updateRetentionPolicyConfig(nil))
+	assert.NilError(t, err)
+	cm := &corev1.ConfigMap{}
+	assert.NilError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(unchanged.Resources()[0].Object, cm))
+	assert.DeepEqual(t, cm.Data, map[string]string{"runAt": "7 7 * * 7", "maxRetention": "30"})
+
+	manifest, err = manifest.Transform(updateRetentionPolicyConfig(&v1alpha1.RetentionPolicyProperties{
+		RunAt:        "0 2 * * *",
+		MaxRetention: &retention,
+		MaxRecords:   &records,
+	}))
+	assert.NilError(t, err)
+	assert.NilError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(manifest.Resources()[0].Object, cm))
+	assert.DeepEqual(t, cm.Data, map[string]string{"runAt": "0 2 * * *", "maxRetention": "60", "maxRecords": "500"})
+}
+
+func TestUpdateRetentionPolicyStatus(t *testing.T) {
+	ctx := context.TODO()
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: configRetentionPolicy, Namespace: "tekton-pipelines"},
+		Data:       map[string]string{"runAt": "7 7 * * 7"},
+	}
+	tr := &v1alpha1.TektonResult{
+		Spec: v1alpha1.TektonResultSpec{CommonSpec: v1alpha1.CommonSpec{TargetNamespace: "tekton-pipelines"}},
+	}
+
+	// the configured schedule is reported
+	r := &Reconciler{kubeClientSet: kubefake.NewSimpleClientset(cm)}
+	assert.NilError(t, r.updateRetentionPolicyStatus(ctx, tr))
+	assert.DeepEqual(t, tr.Status.RetentionPolicy, &v1alpha1.RetentionPolicyStatus{RunAt: "7 7 * * 7"})
+
+	// no retention policy config, no status
+	r = &Reconciler{kubeClientSet: kubefake.NewSimpleClientset()}
+	assert.NilError(t, r.updateRetentionPolicyStatus(ctx, tr))
+	assert.Assert(t, tr.Status.RetentionPolicy == nil)
+}
@@ -18,17 +18,10 @@ package tektonresult
 
 import (
 	"context"
-	"crypto/ecdsa"
-	"crypto/elliptic"
 	"crypto/rand"
-	"crypto/x509"
-	"crypto/x509/pkix"
 	"encoding/base64"
-	"encoding/pem"
 	"errors"
 	"fmt"
-	"math/big"
-	"time"
 
 	"github.com/tektoncd/operator/pkg/reconciler/kubernetes/tektoninstallerset/client"
 
@@ -100,25 +93,10 @@ const createdByValue = "TektonResult"
 
 // FinalizeKind removes all resources after deletion of a TektonResult.
 func (r *Reconciler) FinalizeKind(ctx context.Context, original *v1alpha1.TektonResult) pkgreconciler.Event {
-	logger := logging.FromContext(ctx)
-
-	labelSelector, err := common.LabelSelector(ls)
-	if err != nil {
-		return err
-	}
-	if err := r.operatorClientSet.OperatorV1alpha1().TektonInstallerSets().
-		DeleteCollection(ctx, metav1.DeleteOptions{}, metav1.ListOptions{
-			LabelSelector: labelSelector,
-		}); err != nil {
-		logger.Error("Failed to delete installer set created by TektonResult", err)
-		return err
-	}
-
-	if err := r.extension.Finalize(ctx, original); err != nil {
-		logger.Error("Failed to finalize platform resources", err)
-	}
-
-	return nil
+	return common.Finalize(ctx, original, &original.Status,
+		common.InstallerSetsFinalizationStep(r.operatorClientSet.OperatorV1alpha1().TektonInstallerSets(), ls),
+		common.PlatformFinalizationStep(r.extension, original),
+	)
 }
 
 // ReconcileKind compares the actual state with the desired, and attempts to
@@ -180,7 +158,7 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, tr *v1alpha1.TektonResul
 			return err
 		}
 		logger.Debugw("Creating TLS secret for internal database")
-		if err := r.createTLSSecret(ctx, tr); err != nil {
+		if err := r.ensureAPITLSSecret(ctx, tr); err != nil {
 			logger.Errorw("Failed to create TLS secret", "error", err)
 			return err
 		}
@@ -196,7 +174,7 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, tr *v1alpha1.TektonResul
 			return err
 		}
 		logger.Debugw("Creating TLS secret for external database")
-		if err := r.createTLSSecret(ctx, tr); err != nil {
+		if err := r.ensureAPITLSSecret(ctx, tr); err != nil {
 			logger.Errorw("Failed to create TLS secret", "error", err)
 			return err
 		}
@@ -393,6 +371,9 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, tr *v1alpha1.TektonResul
 	tr.Status.MarkInstallerSetReady()
 	logger.Infow("Installer set is ready", "name", installedTIS.Name)
 
+	r.updateWorkloadIdentityStatus(ctx, tr)
+	r.updateAPITLSStatus(ctx, tr)
+
 	if err := r.extension.PostReconcile(ctx, tr); err != nil {
 		if err == v1alpha1.REQUEUE_EVENT_AFTER {
 			logger.Infow("PostReconciliation requested requeue")
@@ -409,6 +390,11 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, tr *v1alpha1.TektonResul
 	tr.Status.MarkPostReconcilerComplete()
 	r.updateTektonResultsStatus(ctx, tr, installedTIS)
 
+	if err := r.updateRetentionPolicyStatus(ctx, tr); err != nil {
+		// the retention policy status is informational, do not fail the reconcile
+		logger.Warnw("Failed to update retention policy status", "error", err)
+	}
+
 	logger.Infow("TektonResults reconciliation completed successfully",
 		"ready", tr.Status.GetCondition(apis.ConditionReady).IsTrue(),
 		"generation", tr.Status.ObservedGeneration)
@@ -416,6 +402,21 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, tr *v1alpha1.TektonResul
 	return nil
 }
 
+// updateWorkloadIdentityStatus reports whether the workload identity annotations were accepted on the
+// service account of the Results API, it does not fail the reconcile
+func (r *Reconciler) updateWorkloadIdentityStatus(ctx context.Context, tr *v1alpha1.TektonResult) {
+	if tr.Spec.WorkloadIdentity == nil {
+		tr.Status.ClearWorkloadIdentity()
+		return
+	}
+	if err := common.VerifyWorkloadIdentity(ctx, r.kubeClientSet, tr.Spec.GetTargetNamespace(), tr.Spec.WorkloadIdentity, resultAPIServiceAccount); err != nil {
+		logging.FromContext(ctx).Warnw("Workload identity not configured", "error", err)
+		tr.Status.MarkWorkloadIdentityNotReady(err.Error())
+		return
+	}
+	tr.Status.MarkWorkloadIdentityReady()
+}
+
 func (r *Reconciler) updateTektonResultsStatus(ctx context.Context, tr *v1alpha1.TektonResult, createdIs *v1alpha1.TektonInstallerSet) {
 	// update the tr with TektonInstallerSet
 	tr.Status.SetTektonInstallerSet(createdIs.Name)
@@ -485,37 +486,6 @@ func (r *Reconciler) createDBSecret(ctx context.Context, tr *v1alpha1.TektonResu
 	return nil
 }
 
-// Create default TLS certificates for the database
-func (r *Reconciler) createTLSSecret(ctx context.Context, tr *v1alpha1.TektonResult) error {
-	logger := logging.FromContext(ctx)
-
-	if v1alpha1.IsOpenShiftPlatform() {
-		logger.Info("Skipping default TLS secret creation: running on OpenShift platform")
-		return nil
-	}
-
-	_, err := r.kubeClientSet.CoreV1().Secrets(tr.Spec.TargetNamespace).Get(ctx, TlsSecretName, metav1.GetOptions{})
-	if err == nil {
-		return nil
-	}
-	if !apierrors.IsNotFound(err) {
-		logger.Errorf("failed to find default TektonResult TLS secret %s in namespace %s: %v", TlsSecretName, tr.Spec.TargetNamespace, err)
-		return err
-	}
-	certPEM, keyPEM, err := generateTLSCertificate(tr.Spec.TargetNamespace)
-	if err != nil {
-		logger.Errorf("failed to generate default TektonResult TLS certificate: %v", err)
-		return err
-	}
-	// Create Kubernetes TLS secret
-	err = r.createKubernetesTLSSecret(ctx, tr.Spec.TargetNamespace, TlsSecretName, certPEM, keyPEM, tr)
-	if err != nil {
-		logger.Errorf("failed to create TLS secret %s in namespace %s: %v", TlsSecretName, tr.Spec.TargetNamespace, err)
-
-	}
-	return nil
-}
-
 // Get an owner reference of Tekton Result
 func getOwnerRef(tr *v1alpha1.TektonResult) metav1.OwnerReference {
 	return *metav1.NewControllerRef(tr, tr.GroupVersionKind())
@@ -534,80 +504,3 @@ func generateRandomBaseString(size int) (string, error) {
 
 	return base64String, nil
 }
-
-// generateTLSCertificate generates a self-signed TLS certificate and private key.
-func generateTLSCertificate(targetNS string) (certPEM, keyPEM []byte, err error) {
-
-	// Define subject and DNS names
-	dnsName := fmt.Sprintf("tekton-results-api-service.%s.svc.cluster.local", targetNS)
-
-	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	notBefore := time.Now()
-	notAfter := notBefore.Add(365 * 24 * time.Hour)
-
-	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
-	if err != nil {
-		return nil, nil, err
-	}
-
-	template := x509.Certificate{
-		SerialNumber: serialNumber,
-		Issuer:       pkix.Name{},
-		Subject: pkix.Name{
-			CommonName: dnsName,
-		},
-		DNSNames:              []string{dnsName},
-		NotBefore:             notBefore,
-		NotAfter:              notAfter,
-		KeyUsage:              x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature,
-		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
-		BasicConstraintsValid: true,
-	}
-
-	certDER, err := x509.CreateCertificate(rand.Reader, &template, &template, &priv.PublicKey, priv)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	certPEM = pem.EncodeToMemory(&pem.Block{Type: CertificateBlockType, Bytes: certDER})
-
-	privBytes, err := x509.MarshalECPrivateKey(priv)
-	if err != nil {
-		return nil, nil, err
-	}
-	keyPEM = pem.EncodeToMemory(&pem.Block{Type: ECPrivateKeyBlockType, Bytes: privBytes})
-
-	return certPEM, keyPEM, nil
-}
-
-// createKubernetesSecret creates a Kubernetes TLS secret with the given cert and key.
-func (r *Reconciler) createKubernetesTLSSecret(ctx context.Context, namespace, secretName string, certPEM, keyPEM []byte, tr *v1alpha1.TektonResult) error {
-
-	// Define the secret
-	logger := logging.FromContext(ctx)
-	secret := &corev1.Secret{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      secretName,
-			Namespace: namespace,
-		},
-		Type: corev1.SecretTypeTLS,
-		Data: map[string][]byte{
-			corev1.TLSCertKey:       certPEM,
-			corev1.TLSPrivateKeyKey: keyPEM,
-		},
-	}
-
-	_, err := r.kubeClientSet.CoreV1().Secrets(tr.Spec.TargetNamespace).Create(ctx, secret, metav1.CreateOptions{})
-	if err != nil {
-		logger.Errorf("failed to create TLS secret %s in namespace %s: %v", secretName, namespace, err)
-		tr.Status.MarkDependencyMissing(fmt.Sprintf("Default TLS Secret %s creation is failing", secretName))
-		return err
-	}
-
-	logger.Infof("Secret '%s' created successfully in namespace '%s'\n", secretName, namespace)
-	return nil
-}
@@ -41,6 +41,7 @@ const (
 	configINFO                        = "tekton-results-info"
 	configMetrics                     = "tekton-results-config-observability"
 	configPostgresDB                  = "tekton-results-postgres"
+	configRetentionPolicy             = "tekton-results-config-results-retention-policy"
 	pvcLoggingVolume                  = "tekton-logs"
 	apiContainerName                  = "api"
 	retentionPolicyAgentContainerName = "retention-policy-agent"
@@ -59,6 +60,7 @@ const (
 	logsTypeKey                   = "LOGS_TYPE"
 
 	resultAPIDeployment                          = "tekton-results-api"
+	resultAPIServiceAccount                      = "tekton-results-api"
 	resultWatcherDeployment                      = "tekton-results-watcher"
 	resultWatcherContainer                       = "watcher"
 	tektonResultWatcherName                      = "tekton-results-watcher"
@@ -99,6 +101,7 @@ func (r *Reconciler) transform(ctx context.Context, manifest *mf.Manifest, comp
 	filterExternalDB(instance, manifest)
 	extra := []mf.Transformer{
 		common.InjectOperandNameLabelOverwriteExisting(v1alpha1.OperandTektoncdResults),
+		common.ControlPlaneProxy(instance.Spec.Config),
 		common.ApplyProxySettings,
 		common.ReplaceNamespaceInDeploymentArgs([]string{resultWatcherDeployment}, targetNs),
 		common.ReplaceNamespaceInDeploymentEnv(resultDeployementNames, targetNs),
@@ -116,6 +119,9 @@ func (r *Reconciler) transform(ctx context.Context, manifest *mf.Manifest, comp
 		common.StatefulSetImages(resultImgs),
 		common.AddConfigMapValues(tektonResultleaderElectionConfig, instance.Spec.Performance.PerformanceLeaderElectionConfig),
 		common.UpdatePerformanceFlagsInDeploymentAndLeaderConfigMap(&instance.Spec.Performance, tektonResultleaderElectionConfig, resultWatcherDeployment, resultWatcherContainer),
+		updateRetentionPolicyConfig(instance.Spec.RetentionPolicy),
+		common.AddWorkloadIdentity(instance.Spec.WorkloadIdentity, resultAPIServiceAccount),
+		addWatcherAPITrust(),
 		// Note: PostgreSQL upgrade transformer is NOT needed for Kubernetes
 	}
 
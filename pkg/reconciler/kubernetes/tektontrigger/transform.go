@@ -47,6 +47,8 @@ func filterAndTransform(extension common.Extension) client.FilterAndTransform {
 			common.DeploymentImages(triggerImages),
 			common.DeploymentEnvVarKubernetesMinVersion(),
 			common.AddConfiguration(trigger.Spec.Config),
+			common.ControlPlaneProxy(trigger.Spec.Config),
+			common.AddMetricsTLS(*manifest, trigger.Spec.Config.MetricsTLS),
 		}
 		trns = append(trns, extra...)
 		if err := common.Transform(ctx, manifest, trigger, trns...); err != nil {
@@ -31,6 +31,9 @@ const (
 	systemCAVolume = "config-trusted-system-cabundle-volume"
 	systemCAKey    = "tls-ca-bundle.pem"
 	systemCADir    = "/etc/pki/ca-trust/extracted/pem"
+
+	// SystemCABundlePath is the path of the trusted CA bundle mounted by ApplyCABundlesToDeployment
+	SystemCABundlePath = systemCADir + "/" + systemCAKey
 )
 
 // ApplyCABundlesToDeployment is a transformer that add the trustedCA volume, mount and
@@ -17,7 +17,11 @@ limitations under the License.
 package common
 
 import (
+	"fmt"
+
 	mf "github.com/manifestival/manifestival"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
 	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	v1 "k8s.io/api/core/v1"
@@ -25,6 +29,14 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+const (
+	// metricsServiceAnnotation of a ServiceMonitor is the name of the Service it scrapes
+	metricsServiceAnnotation = "operator.tekton.dev/metrics-service"
+	metricsPortName          = "http-metrics"
+	// serviceCAFile is the service CA bundle mounted in the Prometheus of the cluster monitoring
+	serviceCAFile = "/etc/prometheus/configmaps/serving-certs-ca-bundle/service-ca.crt"
+)
+
 // RemoveRunAsUser will remove RunAsUser from all container in a deployment
 func RemoveRunAsUser() mf.Transformer {
 	return func(u *unstructured.Unstructured) error {
@@ -185,6 +197,38 @@ func UpdateServiceMonitorTargetNamespace(targetNamespace string) mf.Transformer
 	}
 }
 
+// UpdateServiceMonitorMetricsTLS scrapes the TLS metrics port of the Service annotated on the
+// ServiceMonitor with operator.tekton.dev/metrics-service when the metrics TLS is enabled, the
+// serving certificates are verified with the service CA
+func UpdateServiceMonitorMetricsTLS(targetNamespace string, spec *v1alpha1.MetricsTLS) mf.Transformer {
+	return func(u *unstructured.Unstructured) error {
+		if spec == nil || !spec.Enable || u.GetKind() != "ServiceMonitor" {
+			return nil
+		}
+		service, ok := u.GetAnnotations()[metricsServiceAnnotation]
+		if !ok {
+			return nil
+		}
+		endpoints, _, err := unstructured.NestedSlice(u.Object, "spec", "endpoints")
+		if err != nil {
+			return err
+		}
+		for _, e := range endpoints {
+			endpoint, ok := e.(map[string]interface{})
+			if !ok || endpoint["port"] != metricsPortName {
+				continue
+			}
+			endpoint["port"] = common.MetricsTLSPortName
+			endpoint["scheme"] = "https"
+			endpoint["tlsConfig"] = map[string]interface{}{
+				"caFile":     serviceCAFile,
+				"serverName": fmt.Sprintf("%s.%s.svc", service, targetNamespace),
+			}
+		}
+		return unstructured.SetNestedSlice(u.Object, endpoints, "spec", "endpoints")
+	}
+}
+
 // RemoveRunAsUserForStatefulset will remove RunAsUser from all container in a statefulset
 func RemoveRunAsUserForStatefulSet(name string) mf.Transformer {
 	return func(u *unstructured.Unstructured) error {
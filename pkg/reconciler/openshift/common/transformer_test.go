@@ -22,6 +22,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	mf "github.com/manifestival/manifestival"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
 	"github.com/tektoncd/pipeline/test/diff"
 	"gotest.tools/v3/assert"
 	appsv1 "k8s.io/api/apps/v1"
@@ -166,6 +167,27 @@ func TestUpdateServiceMonitorTargetNamespace(t *testing.T) {
 	}
 }
 
+func TestUpdateServiceMonitorMetricsTLS(t *testing.T) {
+	testData := path.Join("testdata", "test-servicemonitor-metrics-tls.yaml")
+	manifest, err := mf.ManifestFrom(mf.Recursive(testData))
+	assert.NilError(t, err)
+
+	unchanged, err := manifest.Transform(UpdateServiceMonitorMetricsTLS("openshift-pipelines", nil))
+	assert.NilError(t, err)
+	assert.DeepEqual(t, unchanged.Resources(), manifest.Resources())
+
+	updatedManifest, err := manifest.Transform(UpdateServiceMonitorMetricsTLS("openshift-pipelines", &v1alpha1.MetricsTLS{Enable: true}))
+	assert.NilError(t, err)
+
+	testData = path.Join("testdata", "test-servicemonitor-metrics-tls-expected.yaml")
+	expectedManifest, err := mf.ManifestFrom(mf.Recursive(testData))
+	assert.NilError(t, err)
+
+	if d := cmp.Diff(updatedManifest.Resources(), expectedManifest.Resources()); d != "" {
+		t.Errorf("failed to update servicemonitor %s", diff.PrintWantGot(d))
+	}
+}
+
 func TestRemoveRunAsUserForStatefulSet(t *testing.T) {
 	testData := path.Join("testdata", "test-remove-runasuser-statefulset.yaml")
 	manifest, err := mf.ManifestFrom(mf.Recursive(testData))
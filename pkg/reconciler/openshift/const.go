@@ -5,4 +5,7 @@ const (
 	OperandOpenShiftPipelineAsCode  = "openshift-pipeline-as-code"
 	// NamespaceSCCAnnotation is used to set SCC for a given namespace
 	NamespaceSCCAnnotation = "operator.tekton.dev/scc"
+	// NamespaceEditSubjectsAnnotation overrides the subjects added to the openshift-pipelines-edit
+	// RoleBinding of a namespace, as a comma separated list of Kind:Name
+	NamespaceEditSubjectsAnnotation = "operator.tekton.dev/edit-subjects"
 )
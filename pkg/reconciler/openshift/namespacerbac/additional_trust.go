@@ -0,0 +1,129 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package namespacerbac
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	reconcilerCommon "github.com/tektoncd/operator/pkg/reconciler/common"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/logging"
+)
+
+const (
+	// AdditionalTrustBundleConfigMap holds the trusted CA certificates of the cluster followed by
+	// the certificates of the operator.tekton.dev/additional-trust annotation of the namespace
+	AdditionalTrustBundleConfigMap = "config-additional-trust-bundle"
+	// additionalTrustLabel marks the additional trust ConfigMaps written by the operator
+	additionalTrustLabel = "operator.tekton.dev/additional-trust"
+)
+
+// additionalTrustBundle returns the trusted CA certificates of the cluster followed by the
+// certificates of the operator.tekton.dev/additional-trust annotation of the namespace, and an
+// empty string when the namespace does not request additional trust
+func (o *Onboarder) additionalTrustBundle(ctx context.Context, ns *corev1.Namespace) (string, error) {
+	extra, err := reconcilerCommon.AdditionalTrustCertificates(ns)
+	if err != nil || extra == "" {
+		return "", err
+	}
+	platform, err := o.clients.CoreV1().ConfigMaps(PlatformTrustedCANamespace).Get(ctx, PlatformTrustedCAConfigMap, metav1.GetOptions{})
+	if err != nil {
+		return "", fmt.Errorf("failed to get the trusted CA configmap %s/%s: %w", PlatformTrustedCANamespace, PlatformTrustedCAConfigMap, err)
+	}
+	return reconcilerCommon.AppendCertificates(platform.Data[reconcilerCommon.TrustedCAKey], extra), nil
+}
+
+// ensureAdditionalTrust writes the config-additional-trust-bundle ConfigMap of the namespace
+// requesting additional trust, and deletes it once the namespace no longer requests it
+func (o *Onboarder) ensureAdditionalTrust(ctx context.Context, ns *corev1.Namespace) error {
+	logger := logging.FromContext(ctx)
+	cmClient := o.clients.CoreV1().ConfigMaps(ns.Name)
+	bundle, err := o.additionalTrustBundle(ctx, ns)
+	if err != nil {
+		return err
+	}
+	cm, err := cmClient.Get(ctx, AdditionalTrustBundleConfigMap, metav1.GetOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	exists := err == nil
+
+	switch {
+	case bundle == "" && exists && cm.Labels[additionalTrustLabel] == "true":
+		logger.Infof("deleting configmap %s/%s, the namespace no longer requests additional trust", ns.Name, AdditionalTrustBundleConfigMap)
+		if err := cmClient.Delete(ctx, AdditionalTrustBundleConfigMap, metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	case bundle == "":
+	case !exists:
+		logger.Infof("creating configmap %s in %s namespace", AdditionalTrustBundleConfigMap, ns.Name)
+		_, err := cmClient.Create(ctx, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      AdditionalTrustBundleConfigMap,
+				Namespace: ns.Name,
+				Labels: map[string]string{
+					"app.kubernetes.io/part-of": "tekton-pipelines",
+					additionalTrustLabel:        "true",
+				},
+				// No OwnerReferences
+			},
+			Data: map[string]string{reconcilerCommon.TrustedCAKey: bundle},
+		}, metav1.CreateOptions{})
+		if err != nil {
+			return err
+		}
+		o.recordCABundleCreated(ctx, ns.Name, AdditionalTrustBundleConfigMap)
+	case cm.Data[reconcilerCommon.TrustedCAKey] != bundle:
+		logger.Infof("writing the additional trust of namespace %s to configmap %s", ns.Name, AdditionalTrustBundleConfigMap)
+		if cm.Labels == nil {
+			cm.Labels = map[string]string{}
+		}
+		cm.Labels[additionalTrustLabel] = "true"
+		if cm.Data == nil {
+			cm.Data = map[string]string{}
+		}
+		cm.Data[reconcilerCommon.TrustedCAKey] = bundle
+		_, err := cmClient.Update(ctx, cm, metav1.UpdateOptions{})
+		return err
+	}
+	return nil
+}
+
+// additionalTrustOutdated returns true when the config-additional-trust-bundle ConfigMap of the
+// namespace does not hold its additional trust, or is left after the annotation was removed
+func (o *Onboarder) additionalTrustOutdated(ctx context.Context, ns *corev1.Namespace) (bool, error) {
+	cm, err := o.clients.CoreV1().ConfigMaps(ns.Name).Get(ctx, AdditionalTrustBundleConfigMap, metav1.GetOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return false, fmt.Errorf("error checking configmap %s in namespace %s: %w", AdditionalTrustBundleConfigMap, ns.Name, err)
+	}
+	if ns.Annotations[v1alpha1.NamespaceAdditionalTrustAnnotation] == "" {
+		return err == nil && cm.Labels[additionalTrustLabel] == "true", nil
+	}
+	if err != nil {
+		return true, nil
+	}
+	bundle, err := o.additionalTrustBundle(ctx, ns)
+	if err != nil {
+		// the error is reported by the reconcile of the namespace
+		return true, nil
+	}
+	return cm.Data[reconcilerCommon.TrustedCAKey] != bundle, nil
+}
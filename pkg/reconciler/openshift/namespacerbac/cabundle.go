@@ -0,0 +1,383 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package namespacerbac
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	reconcilerCommon "github.com/tektoncd/operator/pkg/reconciler/common"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	"knative.dev/pkg/logging"
+)
+
+const (
+	// TrustedCABundleConfigMap holds the trusted CA certificates of the cluster, injected by the platform
+	TrustedCABundleConfigMap = "config-trusted-cabundle"
+	// ServiceCABundleConfigMap holds the service serving certificates, injected by the platform
+	ServiceCABundleConfigMap = "config-service-cabundle"
+	// ExtraCASourceAnnotation on a config-trusted-cabundle ConfigMap holds the extra CA ConfigMap
+	// appended to the bundle, its certificates are written by the operator instead of the platform
+	ExtraCASourceAnnotation = "openshift-pipelines.tekton.dev/extra-ca-configmap"
+	// AdditionalCAsAnnotation set to "true" on a config-trusted-cabundle ConfigMap marks the
+	// additional CAs of the cluster appended to the bundle, its certificates are written by the
+	// operator instead of the platform
+	AdditionalCAsAnnotation = "openshift-pipelines.tekton.dev/additional-cas"
+	// ServiceCAInjectionAnnotation requests the injection of the service serving certificates in
+	// the service CA bundle ConfigMap
+	ServiceCAInjectionAnnotation = "service.beta.openshift.io/inject-cabundle"
+	// the trusted CA certificates of the cluster, injected by the platform in the labeled ConfigMaps
+	PlatformTrustedCANamespace = "openshift-config-managed"
+	PlatformTrustedCAConfigMap = "trusted-ca-bundle"
+)
+
+// CABundleConfigMaps are the names of the CA bundle ConfigMaps created in the namespaces, a
+// ConfigMap with an empty name is not created
+type CABundleConfigMaps struct {
+	// Trusted holds the trusted CA certificates of the cluster
+	Trusted string
+	// Service holds the service serving certificates
+	Service string
+}
+
+// DefaultCABundleConfigMaps are the CA bundle ConfigMaps created by default
+var DefaultCABundleConfigMaps = CABundleConfigMaps{Trusted: TrustedCABundleConfigMap, Service: ServiceCABundleConfigMap}
+
+// WithCABundleConfigMaps sets the names of the CA bundle ConfigMaps created in the namespaces
+func (o *Onboarder) WithCABundleConfigMaps(names CABundleConfigMaps) *Onboarder {
+	o.caBundles = names
+	return o
+}
+
+// WithAdditionalCAs sets the PEM encoded certificates appended to the trusted CA certificates of
+// all the namespaces, before the extra certificates of each namespace
+func (o *Onboarder) WithAdditionalCAs(certificates string) *Onboarder {
+	o.additionalCAs = certificates
+	return o
+}
+
+// writesTrustedCABundle returns true when the trusted CA certificates of the namespace are written
+// by the operator instead of being injected by the platform
+func (o *Onboarder) writesTrustedCABundle(ns *corev1.Namespace) bool {
+	return ns.Annotations[v1alpha1.NamespaceExtraCAAnnotation] != "" || o.additionalCAs != ""
+}
+
+// EnsureCABundles creates the CA bundle ConfigMaps of the namespace. The owner references of
+// existing ConfigMaps are removed, the extra certificates of the namespace are written to its
+// config-trusted-cabundle ConfigMap and its additional trust to its config-additional-trust-bundle
+// ConfigMap.
+func (o *Onboarder) EnsureCABundles(ctx context.Context, ns *corev1.Namespace) error {
+	if name := o.caBundles.Trusted; name != "" {
+		if err := o.ensureTrustedCABundle(ctx, ns, name); err != nil {
+			return err
+		}
+	}
+	if name := o.caBundles.Service; name != "" {
+		if err := o.ensureServiceCABundle(ctx, ns, name); err != nil {
+			return err
+		}
+	}
+	return o.ensureAdditionalTrust(ctx, ns)
+}
+
+func (o *Onboarder) ensureTrustedCABundle(ctx context.Context, ns *corev1.Namespace, name string) error {
+	logger := logging.FromContext(ctx)
+	cfgInterface := o.clients.CoreV1().ConfigMaps(ns.Name)
+
+	logger.Infof("finding configmap: %s/%s", ns.Name, name)
+	caBundleCM, getErr := cfgInterface.Get(ctx, name, metav1.GetOptions{})
+	if getErr != nil && !errors.IsNotFound(getErr) {
+		return getErr
+	}
+
+	if getErr != nil && errors.IsNotFound(getErr) {
+		logger.Infof("creating configmap %s in %s namespace", name, ns.Name)
+		var err error
+		if caBundleCM, err = createCABundleConfigMaps(ctx, cfgInterface, name, ns.Name); err != nil {
+			return err
+		}
+		o.recordCABundleCreated(ctx, ns.Name, name)
+	}
+
+	// If config map already exist then remove owner ref, the extra certificates are written to
+	// the created config map as well
+	if getErr == nil || o.writesTrustedCABundle(ns) {
+		return o.updateTrustedCABundle(ctx, ns, caBundleCM)
+	}
+	return nil
+}
+
+func (o *Onboarder) ensureServiceCABundle(ctx context.Context, ns *corev1.Namespace, name string) error {
+	logger := logging.FromContext(ctx)
+	cfgInterface := o.clients.CoreV1().ConfigMaps(ns.Name)
+
+	logger.Infof("finding configmap: %s/%s", ns.Name, name)
+	serviceCABundleCM, getErr := cfgInterface.Get(ctx, name, metav1.GetOptions{})
+	if getErr != nil && !errors.IsNotFound(getErr) {
+		return getErr
+	}
+
+	if getErr != nil && errors.IsNotFound(getErr) {
+		logger.Infof("creating configmap %s in %s namespace", name, ns.Name)
+		if _, err := createServiceCABundleConfigMap(ctx, cfgInterface, name, ns.Name); err != nil {
+			return err
+		}
+		o.recordCABundleCreated(ctx, ns.Name, name)
+		return nil
+	}
+
+	// If config map already exist then remove owner ref, and request the injection again when
+	// its annotation was removed
+	serviceCABundleCM.SetOwnerReferences(nil)
+	drifted := serviceCABundleDrifted(serviceCABundleCM)
+	if drifted {
+		logger.Infof("restoring the annotation %s of configmap %s/%s", ServiceCAInjectionAnnotation, ns.Name, name)
+		if serviceCABundleCM.Annotations == nil {
+			serviceCABundleCM.Annotations = map[string]string{}
+		}
+		serviceCABundleCM.Annotations[ServiceCAInjectionAnnotation] = "true"
+	}
+	if _, err := cfgInterface.Update(ctx, serviceCABundleCM, metav1.UpdateOptions{}); err != nil {
+		return err
+	}
+	if drifted {
+		o.recordCABundleRepaired(ctx, ns.Name, name, ServiceCAInjectionAnnotation)
+	}
+	return nil
+}
+
+// managedCABundle returns true when the CA bundle ConfigMap was created by the operator, the
+// metadata of the ConfigMaps created by the users is not repaired
+func managedCABundle(cm *corev1.ConfigMap) bool {
+	return cm.Labels["app.kubernetes.io/part-of"] == "tekton-pipelines"
+}
+
+// trustedCABundleDrifted returns true when the injection label was removed from a trusted CA
+// bundle ConfigMap created by the operator, the ConfigMap is no longer updated by the platform
+func trustedCABundleDrifted(cm *corev1.ConfigMap) bool {
+	return managedCABundle(cm) && cm.Labels[v1alpha1.TrustedCAInjectionLabel] != "true"
+}
+
+// serviceCABundleDrifted returns true when the injection annotation was removed from a service CA
+// bundle ConfigMap created by the operator
+func serviceCABundleDrifted(cm *corev1.ConfigMap) bool {
+	return managedCABundle(cm) && cm.Annotations[ServiceCAInjectionAnnotation] != "true"
+}
+
+// CABundlesOutdated returns true when the CA bundle ConfigMaps of the namespace drifted, or when
+// its trusted CA bundle ConfigMap does not hold the additional CAs of the cluster and the extra
+// certificates of the namespace, or its config-additional-trust-bundle ConfigMap does not hold
+// its additional trust
+func (o *Onboarder) CABundlesOutdated(ctx context.Context, ns *corev1.Namespace) (bool, error) {
+	drifted, outdated, err := o.CABundlesStatus(ctx, ns)
+	return drifted || outdated, err
+}
+
+// CABundlesStatus returns drifted when a CA bundle ConfigMap of the namespace is missing, or when
+// the injection label or annotation of a ConfigMap created by the operator was removed, i.e. when
+// the ConfigMaps were changed since they were reconciled. It returns outdated when the
+// certificates written by the operator changed, see CABundlesOutdated.
+func (o *Onboarder) CABundlesStatus(ctx context.Context, ns *corev1.Namespace) (drifted, outdated bool, err error) {
+	logger := logging.FromContext(ctx)
+	cmClient := o.clients.CoreV1().ConfigMaps(ns.Name)
+	var trustedCM *corev1.ConfigMap
+	for _, name := range []string{o.caBundles.Trusted, o.caBundles.Service} {
+		if name == "" {
+			continue
+		}
+		cm, err := cmClient.Get(ctx, name, metav1.GetOptions{})
+		if errors.IsNotFound(err) {
+			logger.Warnf("CA bundle configmaps missing in namespace %s despite label indicating reconciliation complete, will re-reconcile", ns.Name)
+			return true, false, nil
+		}
+		if err != nil {
+			return false, false, fmt.Errorf("error checking configmap %s in namespace %s: %w", name, ns.Name, err)
+		}
+		if name == o.caBundles.Trusted {
+			trustedCM = cm
+		} else if serviceCABundleDrifted(cm) {
+			logger.Warnf("configmap %s/%s lost its annotation %s, will re-reconcile", ns.Name, name, ServiceCAInjectionAnnotation)
+			return true, false, nil
+		}
+	}
+
+	if trustedCM != nil {
+		if !o.writesTrustedCABundle(ns) && trustedCABundleDrifted(trustedCM) {
+			logger.Warnf("configmap %s/%s lost its label %s, will re-reconcile", ns.Name, trustedCM.Name, v1alpha1.TrustedCAInjectionLabel)
+			return true, false, nil
+		}
+		if o.extraCertificatesChanged(ctx, ns, trustedCM) {
+			return false, true, nil
+		}
+	}
+	outdated, err = o.additionalTrustOutdated(ctx, ns)
+	return false, outdated, err
+}
+
+// extraCertificatesChanged returns true when the additional CAs of the cluster or the extra
+// certificates of the namespace are not written to its trusted CA bundle ConfigMap, or when the
+// ConfigMap is still written by the operator after they were removed
+func (o *Onboarder) extraCertificatesChanged(ctx context.Context, ns *corev1.Namespace, cm *corev1.ConfigMap) bool {
+	source := ns.Annotations[v1alpha1.NamespaceExtraCAAnnotation]
+	if source != cm.Annotations[ExtraCASourceAnnotation] {
+		return true
+	}
+	if (o.additionalCAs != "") != (cm.Annotations[AdditionalCAsAnnotation] == "true") {
+		return true
+	}
+	if !o.writesTrustedCABundle(ns) {
+		return false
+	}
+	bundle, err := o.trustedCABundle(ctx, ns)
+	if err != nil {
+		// the error is reported by the reconcile of the namespace
+		return true
+	}
+	return cm.Data[reconcilerCommon.TrustedCAKey] != bundle
+}
+
+// trustedCABundle returns the trusted CA certificates of the cluster followed by the additional
+// CAs of the cluster and by the extra certificates of the namespace
+func (o *Onboarder) trustedCABundle(ctx context.Context, ns *corev1.Namespace) (string, error) {
+	platform, err := o.clients.CoreV1().ConfigMaps(PlatformTrustedCANamespace).Get(ctx, PlatformTrustedCAConfigMap, metav1.GetOptions{})
+	if err != nil {
+		return "", fmt.Errorf("failed to get the trusted CA configmap %s/%s: %w", PlatformTrustedCANamespace, PlatformTrustedCAConfigMap, err)
+	}
+	extra, err := reconcilerCommon.ExtraCertificates(ctx, o.clients.CoreV1(), ns)
+	if err != nil {
+		return "", err
+	}
+	bundle := reconcilerCommon.AppendCertificates(platform.Data[reconcilerCommon.TrustedCAKey], o.additionalCAs)
+	return reconcilerCommon.AppendCertificates(bundle, extra), nil
+}
+
+// updateTrustedCABundle removes the owner references of the trusted CA bundle ConfigMap and
+// writes the additional CAs of the cluster and the extra certificates of the namespace to it. The
+// injection of the platform is disabled while the operator writes the certificates, and enabled
+// again when they are removed or when its label was removed from a ConfigMap created by the
+// operator.
+func (o *Onboarder) updateTrustedCABundle(ctx context.Context, ns *corev1.Namespace, cm *corev1.ConfigMap) error {
+	cm.SetOwnerReferences(nil)
+	source := ns.Annotations[v1alpha1.NamespaceExtraCAAnnotation]
+	written := cm.Annotations[ExtraCASourceAnnotation] != "" || cm.Annotations[AdditionalCAsAnnotation] != ""
+	repaired := false
+	switch {
+	case o.writesTrustedCABundle(ns):
+		bundle, err := o.trustedCABundle(ctx, ns)
+		if err != nil {
+			return err
+		}
+		logging.FromContext(ctx).Infof("writing the additional and extra certificates to %s/%s", ns.Name, cm.Name)
+		delete(cm.Labels, v1alpha1.TrustedCAInjectionLabel)
+		if cm.Annotations == nil {
+			cm.Annotations = map[string]string{}
+		}
+		delete(cm.Annotations, ExtraCASourceAnnotation)
+		if source != "" {
+			cm.Annotations[ExtraCASourceAnnotation] = source
+		}
+		delete(cm.Annotations, AdditionalCAsAnnotation)
+		if o.additionalCAs != "" {
+			cm.Annotations[AdditionalCAsAnnotation] = "true"
+		}
+		if cm.Data == nil {
+			cm.Data = map[string]string{}
+		}
+		cm.Data[reconcilerCommon.TrustedCAKey] = bundle
+	case written || trustedCABundleDrifted(cm):
+		repaired = !written
+		if repaired {
+			logging.FromContext(ctx).Infof("restoring the label %s of configmap %s/%s", v1alpha1.TrustedCAInjectionLabel, ns.Name, cm.Name)
+		}
+		delete(cm.Annotations, ExtraCASourceAnnotation)
+		delete(cm.Annotations, AdditionalCAsAnnotation)
+		if cm.Labels == nil {
+			cm.Labels = map[string]string{}
+		}
+		cm.Labels[v1alpha1.TrustedCAInjectionLabel] = "true"
+	}
+	if _, err := o.clients.CoreV1().ConfigMaps(ns.Name).Update(ctx, cm, metav1.UpdateOptions{}); err != nil {
+		return err
+	}
+	if repaired {
+		o.recordCABundleRepaired(ctx, ns.Name, cm.Name, v1alpha1.TrustedCAInjectionLabel)
+	}
+	return nil
+}
+
+// recordCABundleCreated reports the creation of a CA bundle ConfigMap of the namespace
+func (o *Onboarder) recordCABundleCreated(ctx context.Context, namespace, name string) {
+	o.record(ctx, "ConfigMap", "v1", namespace, name, corev1.EventTypeNormal, ReasonCABundleCreated,
+		fmt.Sprintf("created CA bundle ConfigMap %s", name))
+}
+
+// recordCABundleRepaired reports the injection label or annotation restored on a CA bundle
+// ConfigMap of the namespace
+func (o *Onboarder) recordCABundleRepaired(ctx context.Context, namespace, name, key string) {
+	o.record(ctx, "ConfigMap", "v1", namespace, name, corev1.EventTypeWarning, ReasonCABundleRepaired,
+		fmt.Sprintf("restored %s on CA bundle ConfigMap %s, it was removed", key, name))
+}
+
+func createCABundleConfigMaps(ctx context.Context, cfgInterface corev1client.ConfigMapInterface,
+	name, ns string) (*corev1.ConfigMap, error) {
+	c := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: ns,
+			Labels: map[string]string{
+				"app.kubernetes.io/part-of": "tekton-pipelines",
+				// user-provided and system CA certificates
+				"config.openshift.io/inject-trusted-cabundle": "true",
+			},
+			// No OwnerReferences
+		},
+	}
+
+	cm, err := cfgInterface.Create(ctx, c, metav1.CreateOptions{})
+	if err != nil && !errors.IsAlreadyExists(err) {
+		return nil, err
+	}
+	return cm, nil
+}
+
+func createServiceCABundleConfigMap(ctx context.Context, cfgInterface corev1client.ConfigMapInterface,
+	name, ns string) (*corev1.ConfigMap, error) {
+	c := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: ns,
+			Labels: map[string]string{
+				"app.kubernetes.io/part-of": "tekton-pipelines",
+			},
+			Annotations: map[string]string{
+				// service serving certificates (required to talk to the internal registry)
+				ServiceCAInjectionAnnotation: "true",
+			},
+			// No OwnerReferences
+		},
+	}
+
+	cm, err := cfgInterface.Create(ctx, c, metav1.CreateOptions{})
+	if err != nil && !errors.IsAlreadyExists(err) {
+		return nil, err
+	}
+	return cm, nil
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package namespacerbac
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// The reasons of the mutations of the resources of the namespaces
+const (
+	ReasonServiceAccountCreated = "ServiceAccountCreated"
+	ReasonRoleBindingCreated    = "RoleBindingCreated"
+	ReasonRoleBindingUpdated    = "RoleBindingUpdated"
+	// ReasonRoleBindingRecreated is a RoleBinding deleted and created again to change its role, the
+	// permissions it grants are missing in between
+	ReasonRoleBindingRecreated = "RoleBindingRecreated"
+	ReasonRoleBindingDeleted   = "RoleBindingDeleted"
+	ReasonCABundleCreated      = "CABundleCreated"
+	// ReasonCABundleRepaired is the injection label or annotation restored on a CA bundle
+	// ConfigMap, the ConfigMap was not updated by the platform in between
+	ReasonCABundleRepaired = "CABundleRepaired"
+)
+
+// Mutation is a change made to a resource of a namespace, reported so that the owners of the
+// namespace can audit the changes of the operator
+type Mutation struct {
+	// Object is the changed resource
+	Object corev1.ObjectReference
+	// Type is the type of the event reporting the change, corev1.EventTypeNormal or corev1.EventTypeWarning
+	Type    string
+	Reason  string
+	Message string
+}
+
+// MutationRecorder is called after each change made by the Onboarder
+type MutationRecorder func(ctx context.Context, m Mutation)
+
+// WithMutationRecorder sets the recorder of the changes made to the resources of the namespaces,
+// the changes are not recorded without a recorder
+func (o *Onboarder) WithMutationRecorder(recorder MutationRecorder) *Onboarder {
+	o.recorder = recorder
+	return o
+}
+
+// record reports a change of the resource of a namespace
+func (o *Onboarder) record(ctx context.Context, kind, apiVersion, namespace, name, eventType, reason, message string) {
+	if o.recorder == nil {
+		return
+	}
+	o.recorder(ctx, Mutation{
+		Object:  corev1.ObjectReference{Kind: kind, APIVersion: apiVersion, Namespace: namespace, Name: name},
+		Type:    eventType,
+		Reason:  reason,
+		Message: message,
+	})
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package namespacerbac
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestMutationRecorder(t *testing.T) {
+	ctx := context.TODO()
+	kubeClient := fake.NewSimpleClientset(
+		&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: EditClusterRole}},
+		&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "pipelines-edit-no-secrets"}},
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: PlatformTrustedCAConfigMap, Namespace: PlatformTrustedCANamespace}},
+	)
+	var mutations []Mutation
+	recorder := func(_ context.Context, m Mutation) { mutations = append(mutations, m) }
+	reasons := func() []string {
+		var got []string
+		for _, m := range mutations {
+			got = append(got, m.Type+" "+m.Reason+" "+m.Object.Kind+"/"+m.Object.Name)
+		}
+		mutations = nil
+		return got
+	}
+	o := New(kubeClient, installerSetRef, configRef).WithMutationRecorder(recorder)
+
+	sa, _, err := o.EnsureServiceAccount(ctx, "team-a")
+	assert.NilError(t, err)
+	assert.NilError(t, o.EnsureEditRoleBinding(ctx, sa, true))
+	assert.NilError(t, o.EnsureCABundles(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a"}}))
+	assert.DeepEqual(t, reasons(), []string{
+		"Normal ServiceAccountCreated ServiceAccount/pipeline",
+		"Normal RoleBindingCreated RoleBinding/openshift-pipelines-edit",
+		"Normal CABundleCreated ConfigMap/config-trusted-cabundle",
+		"Normal CABundleCreated ConfigMap/config-service-cabundle",
+	})
+
+	// the unchanged resources are not recorded
+	_, _, err = o.EnsureServiceAccount(ctx, "team-a")
+	assert.NilError(t, err)
+	assert.NilError(t, o.EnsureEditRoleBinding(ctx, sa, true))
+	assert.NilError(t, o.EnsureCABundles(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a"}}))
+	assert.Equal(t, len(reasons()), 0)
+
+	// the change of the role is a warning
+	o = New(kubeClient, installerSetRef, configRef).WithEditClusterRole("pipelines-edit-no-secrets").WithMutationRecorder(recorder)
+	assert.NilError(t, o.EnsureEditRoleBinding(ctx, sa, true))
+	assert.DeepEqual(t, mutations[0].Message, "recreated RoleBinding openshift-pipelines-edit to change its role from ClusterRole edit to ClusterRole pipelines-edit-no-secrets")
+	assert.DeepEqual(t, reasons(), []string{"Warning RoleBindingRecreated RoleBinding/openshift-pipelines-edit"})
+
+	assert.NilError(t, o.EnsureEditRoleBinding(ctx, sa, false))
+	assert.DeepEqual(t, reasons(), []string{"Normal RoleBindingDeleted RoleBinding/openshift-pipelines-edit"})
+
+	// the injection metadata restored on the CA bundles is a warning
+	for _, name := range []string{TrustedCABundleConfigMap, ServiceCABundleConfigMap} {
+		cm, err := kubeClient.CoreV1().ConfigMaps("team-a").Get(ctx, name, metav1.GetOptions{})
+		assert.NilError(t, err)
+		delete(cm.Labels, v1alpha1.TrustedCAInjectionLabel)
+		cm.Annotations = nil
+		_, err = kubeClient.CoreV1().ConfigMaps("team-a").Update(ctx, cm, metav1.UpdateOptions{})
+		assert.NilError(t, err)
+	}
+	assert.NilError(t, o.EnsureCABundles(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a"}}))
+	assert.DeepEqual(t, mutations[0].Message, "restored config.openshift.io/inject-trusted-cabundle on CA bundle ConfigMap config-trusted-cabundle, it was removed")
+	assert.DeepEqual(t, reasons(), []string{
+		"Warning CABundleRepaired ConfigMap/config-trusted-cabundle",
+		"Warning CABundleRepaired ConfigMap/config-service-cabundle",
+	})
+}
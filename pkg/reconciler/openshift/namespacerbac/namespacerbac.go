@@ -0,0 +1,311 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package namespacerbac onboards the namespaces of an OpenShift cluster for the pipelines: it
+// creates the pipeline ServiceAccount, binds it to the edit ClusterRole and creates the CA bundle
+// ConfigMaps mounted by the workloads. It only depends on the clients it uses, so that it can be
+// reused by other operators.
+package namespacerbac
+
+import (
+	"context"
+	"fmt"
+
+	reconcilerCommon "github.com/tektoncd/operator/pkg/reconciler/common"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	rbacv1client "k8s.io/client-go/kubernetes/typed/rbac/v1"
+	"knative.dev/pkg/logging"
+)
+
+const (
+	// PipelineServiceAccount is the default ServiceAccount created in the namespaces
+	PipelineServiceAccount = "pipeline"
+	// EditRoleBinding binds the pipeline ServiceAccount to the EditClusterRole
+	EditRoleBinding = "openshift-pipelines-edit"
+	EditClusterRole = "edit"
+)
+
+// Clients are the clients used to onboard the namespaces, they are implemented by kubernetes.Interface
+type Clients interface {
+	CoreV1() corev1client.CoreV1Interface
+	RbacV1() rbacv1client.RbacV1Interface
+}
+
+// Onboarder creates the resources of the namespaces
+type Onboarder struct {
+	clients Clients
+	// ownerRef is set on the RoleBindings
+	ownerRef metav1.OwnerReference
+	// serviceAccountOwnerRef is set on the pipeline ServiceAccounts
+	serviceAccountOwnerRef metav1.OwnerReference
+	// serviceAccountName is the name of the ServiceAccount created in the namespaces
+	serviceAccountName string
+	// editClusterRole is the ClusterRole bound by the EditRoleBinding
+	editClusterRole string
+	// recorder reports the changes made to the resources of the namespaces
+	recorder MutationRecorder
+	// caBundles are the names of the CA bundle ConfigMaps created in the namespaces
+	caBundles CABundleConfigMaps
+	// additionalCAs are appended to the trusted CA certificates of all the namespaces
+	additionalCAs string
+}
+
+func New(clients Clients, ownerRef, serviceAccountOwnerRef metav1.OwnerReference) *Onboarder {
+	return &Onboarder{clients: clients, ownerRef: ownerRef, serviceAccountOwnerRef: serviceAccountOwnerRef, serviceAccountName: PipelineServiceAccount, editClusterRole: EditClusterRole,
+		caBundles: DefaultCABundleConfigMaps}
+}
+
+// WithServiceAccountName sets the name of the ServiceAccount created in the namespaces, the
+// empty name keeps the PipelineServiceAccount
+func (o *Onboarder) WithServiceAccountName(name string) *Onboarder {
+	if name != "" {
+		o.serviceAccountName = name
+	}
+	return o
+}
+
+// WithEditClusterRole sets the ClusterRole bound by the EditRoleBinding, the empty name keeps
+// the EditClusterRole
+func (o *Onboarder) WithEditClusterRole(name string) *Onboarder {
+	if name != "" {
+		o.editClusterRole = name
+	}
+	return o
+}
+
+// EnsureServiceAccount creates the ServiceAccount in the namespace, or sets the owner
+// reference of an existing one. It returns true when the ServiceAccount was created.
+func (o *Onboarder) EnsureServiceAccount(ctx context.Context, namespace string) (*corev1.ServiceAccount, bool, error) {
+	logger := logging.FromContext(ctx)
+	logger.Infof("finding sa: %s/%s", namespace, o.serviceAccountName)
+	saInterface := o.clients.CoreV1().ServiceAccounts(namespace)
+
+	sa, err := saInterface.Get(ctx, o.serviceAccountName, metav1.GetOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, false, err
+	}
+	if err != nil && errors.IsNotFound(err) {
+		logger.Info("creating sa ", o.serviceAccountName, " ns", namespace)
+		sa, err = o.createServiceAccount(ctx, saInterface, namespace)
+		return sa, err == nil, err
+	}
+
+	sa.SetOwnerReferences([]metav1.OwnerReference{o.serviceAccountOwnerRef})
+	sa, err = saInterface.Update(ctx, sa, metav1.UpdateOptions{})
+	return sa, false, err
+}
+
+func (o *Onboarder) createServiceAccount(ctx context.Context, saInterface corev1client.ServiceAccountInterface, namespace string) (*corev1.ServiceAccount, error) {
+	sa := &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            o.serviceAccountName,
+			Namespace:       namespace,
+			OwnerReferences: []metav1.OwnerReference{o.serviceAccountOwnerRef},
+		},
+	}
+
+	var created *corev1.ServiceAccount
+	err := reconcilerCommon.Retry(ctx, "create serviceaccount", func() error {
+		var err error
+		created, err = saInterface.Create(ctx, sa, metav1.CreateOptions{})
+		return err
+	})
+	if errors.IsAlreadyExists(err) {
+		return saInterface.Get(ctx, o.serviceAccountName, metav1.GetOptions{})
+	}
+	if err != nil {
+		return nil, err
+	}
+	o.record(ctx, "ServiceAccount", "v1", namespace, o.serviceAccountName, corev1.EventTypeNormal, ReasonServiceAccountCreated,
+		fmt.Sprintf("created ServiceAccount %s for the pipelines", o.serviceAccountName))
+	return created, nil
+}
+
+// EnsureEditRoleBinding binds the ServiceAccount to the edit ClusterRole in its namespace, or
+// deletes the RoleBinding when it is not enabled. The RoleBinding is recreated when it binds
+// another ClusterRole, as its role cannot be updated.
+func (o *Onboarder) EnsureEditRoleBinding(ctx context.Context, sa *corev1.ServiceAccount, enabled bool) error {
+	logger := logging.FromContext(ctx)
+	rbacClient := o.clients.RbacV1()
+
+	editRB, err := rbacClient.RoleBindings(sa.Namespace).Get(ctx, EditRoleBinding, metav1.GetOptions{})
+
+	if !enabled && err == nil {
+		logger.Infof("Legacy Pipeline RBAC is disabled, removing existing role binding %s/%s",
+			editRB.Namespace, editRB.Name)
+		if err := rbacClient.RoleBindings(sa.Namespace).Delete(ctx, EditRoleBinding, metav1.DeleteOptions{}); err != nil {
+			return err
+		}
+		o.record(ctx, "RoleBinding", rbacv1.SchemeGroupVersion.String(), sa.Namespace, EditRoleBinding, corev1.EventTypeNormal, ReasonRoleBindingDeleted,
+			fmt.Sprintf("deleted RoleBinding %s, the legacy pipeline RBAC is disabled", EditRoleBinding))
+		return nil
+	}
+
+	if !enabled {
+		logger.Infof("Legacy Pipeline RBAC is disabled, skipping role binding creation")
+		return nil
+	}
+
+	logger.Infof("Legacy Pipeline RBAC is enabled")
+
+	roleRef := rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: o.editClusterRole}
+	if err == nil && editRB.RoleRef != roleRef {
+		logger.Infof("Need to update RoleRef in RoleBinding %s/%s, deleting and recreating...", editRB.Namespace, editRB.Name)
+		if err := rbacClient.RoleBindings(sa.Namespace).Delete(ctx, EditRoleBinding, metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+		if err := o.createEditRoleBinding(ctx, sa); err != nil {
+			return err
+		}
+		o.RecordRoleBindingRecreated(ctx, sa.Namespace, EditRoleBinding, editRB.RoleRef, roleRef)
+		return nil
+	}
+
+	if err == nil {
+		logger.Infof("Found rolebinding %s/%s, updating if needed", editRB.Namespace, editRB.Name)
+		return o.UpdateRoleBinding(ctx, editRB, sa, &roleRef)
+	}
+
+	if errors.IsNotFound(err) {
+		logger.Infof("Role binding not found, creating new one")
+		if err := o.createEditRoleBinding(ctx, sa); err != nil {
+			return err
+		}
+		o.RecordRoleBindingCreated(ctx, sa.Namespace, EditRoleBinding, roleRef)
+		return nil
+	}
+
+	return err
+}
+
+func (o *Onboarder) createEditRoleBinding(ctx context.Context, sa *corev1.ServiceAccount) error {
+	logger := logging.FromContext(ctx)
+
+	logger.Infof("create new rolebinding %s/%s", sa.Namespace, sa.Name)
+	rbacClient := o.clients.RbacV1()
+
+	logger.Infof("finding clusterrole %s", o.editClusterRole)
+	if _, err := rbacClient.ClusterRoles().Get(ctx, o.editClusterRole, metav1.GetOptions{}); err != nil {
+		logger.Errorf("%v: getting clusterRole %s failed", err, o.editClusterRole)
+		return err
+	}
+
+	rb := &rbacv1.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            EditRoleBinding,
+			Namespace:       sa.Namespace,
+			OwnerReferences: []metav1.OwnerReference{o.ownerRef},
+		},
+		RoleRef:  rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: o.editClusterRole},
+		Subjects: []rbacv1.Subject{{Kind: rbacv1.ServiceAccountKind, Name: sa.Name, Namespace: sa.Namespace}},
+	}
+
+	err := reconcilerCommon.Retry(ctx, "create rolebinding", func() error {
+		_, err := rbacClient.RoleBindings(sa.Namespace).Create(ctx, rb, metav1.CreateOptions{})
+		return err
+	})
+	if err != nil {
+		logger.Errorf("%v: failed creation of rolebinding %s/%s", err, rb.Namespace, rb.Name)
+		return err
+	}
+	return nil
+}
+
+// UpdateRoleBinding adds the ServiceAccount to the subjects of the RoleBinding and sets its role
+// and owner reference
+func (o *Onboarder) UpdateRoleBinding(ctx context.Context, rb *rbacv1.RoleBinding, sa *corev1.ServiceAccount, roleRef *rbacv1.RoleRef) error {
+	logger := logging.FromContext(ctx)
+
+	subject := rbacv1.Subject{Kind: rbacv1.ServiceAccountKind, Name: sa.Name, Namespace: sa.Namespace}
+
+	hasSubject := HasSubject(rb.Subjects, subject)
+	if !hasSubject {
+		rb.Subjects = append(rb.Subjects, subject)
+	}
+
+	rb.RoleRef = *roleRef
+
+	rbacClient := o.clients.RbacV1()
+	hasOwnerRef := HasOwnerReference(rb.GetOwnerReferences(), o.ownerRef)
+
+	ownerRef := o.OwnerReferences(rb.GetOwnerReferences())
+	rb.SetOwnerReferences(ownerRef)
+
+	// If owners are different then we need to set from o.ownerRef and update the roleBinding.
+	if !hasOwnerRef {
+		if _, err := rbacClient.RoleBindings(sa.Namespace).Update(ctx, rb, metav1.UpdateOptions{}); err != nil {
+			logger.Error(err, "failed to update edit rb")
+			return err
+		}
+	}
+
+	if hasSubject && (len(ownerRef) != 0) {
+		if !hasOwnerRef {
+			o.record(ctx, "RoleBinding", rbacv1.SchemeGroupVersion.String(), rb.Namespace, rb.Name, corev1.EventTypeNormal, ReasonRoleBindingUpdated,
+				fmt.Sprintf("set the owner of RoleBinding %s to %s %s", rb.Name, o.ownerRef.Kind, o.ownerRef.Name))
+			return nil
+		}
+		logger.Info("rolebinding is up to date ", "action ", "none")
+		return nil
+	}
+
+	logger.Infof("update existing rolebinding %s/%s", rb.Namespace, rb.Name)
+
+	_, err := rbacClient.RoleBindings(sa.Namespace).Update(ctx, rb, metav1.UpdateOptions{})
+	if err != nil {
+		logger.Errorf("%v: failed to update rolebinding %s/%s", err, rb.Namespace, rb.Name)
+		return err
+	}
+	logger.Infof("successfully updated rolebinding %s/%s", rb.Namespace, rb.Name)
+	o.record(ctx, "RoleBinding", rbacv1.SchemeGroupVersion.String(), rb.Namespace, rb.Name, corev1.EventTypeNormal, ReasonRoleBindingUpdated,
+		fmt.Sprintf("added ServiceAccount %s to the subjects of RoleBinding %s", sa.Name, rb.Name))
+	return nil
+}
+
+// RecordRoleBindingCreated reports the creation of a RoleBinding of the namespace
+func (o *Onboarder) RecordRoleBindingCreated(ctx context.Context, namespace, name string, roleRef rbacv1.RoleRef) {
+	o.record(ctx, "RoleBinding", rbacv1.SchemeGroupVersion.String(), namespace, name, corev1.EventTypeNormal, ReasonRoleBindingCreated,
+		fmt.Sprintf("created RoleBinding %s to %s %s", name, roleRef.Kind, roleRef.Name))
+}
+
+// RecordRoleBindingRecreated reports a RoleBinding of the namespace deleted and created again to
+// change its role, as a warning since the permissions it grants were missing in between
+func (o *Onboarder) RecordRoleBindingRecreated(ctx context.Context, namespace, name string, previous, roleRef rbacv1.RoleRef) {
+	o.record(ctx, "RoleBinding", rbacv1.SchemeGroupVersion.String(), namespace, name, corev1.EventTypeWarning, ReasonRoleBindingRecreated,
+		fmt.Sprintf("recreated RoleBinding %s to change its role from %s %s to %s %s", name, previous.Kind, previous.Name, roleRef.Kind, roleRef.Name))
+}
+
+// OwnerReferences returns the owner references with the owner reference of the Onboarder,
+// replacing a different owner
+func (o *Onboarder) OwnerReferences(ownerRef []metav1.OwnerReference) []metav1.OwnerReference {
+	if len(ownerRef) == 0 {
+		return []metav1.OwnerReference{o.ownerRef}
+	}
+
+	for i, ref := range ownerRef {
+		if ref.APIVersion != o.ownerRef.APIVersion || ref.Kind != o.ownerRef.Kind || ref.Name != o.ownerRef.Name {
+			// if owner reference are different remove the existing one and override with o.ownerRef
+			refs := append(ownerRef[:i], ownerRef[i+1:]...)
+			return append(refs, o.ownerRef)
+		}
+	}
+
+	return ownerRef
+}
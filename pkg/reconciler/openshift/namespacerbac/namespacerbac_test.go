@@ -0,0 +1,381 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package namespacerbac
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	reconcilerCommon "github.com/tektoncd/operator/pkg/reconciler/common"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+var (
+	installerSetRef = metav1.OwnerReference{APIVersion: "operator.tekton.dev/v1alpha1", Kind: "TektonInstallerSet", Name: "rhosp-rbac-abcde"}
+	configRef       = metav1.OwnerReference{APIVersion: "operator.tekton.dev/v1alpha1", Kind: "TektonConfig", Name: "config"}
+)
+
+func TestEnsureServiceAccount(t *testing.T) {
+	ctx := context.TODO()
+	kubeClient := fake.NewSimpleClientset()
+	o := New(kubeClient, installerSetRef, configRef)
+
+	sa, created, err := o.EnsureServiceAccount(ctx, "team-a")
+	assert.NilError(t, err)
+	assert.Assert(t, created)
+	assert.Equal(t, sa.Name, PipelineServiceAccount)
+	assert.DeepEqual(t, sa.OwnerReferences, []metav1.OwnerReference{configRef})
+
+	// the owner reference of an existing ServiceAccount is replaced
+	sa.OwnerReferences = []metav1.OwnerReference{installerSetRef}
+	_, err = kubeClient.CoreV1().ServiceAccounts("team-a").Update(ctx, sa, metav1.UpdateOptions{})
+	assert.NilError(t, err)
+	sa, created, err = o.EnsureServiceAccount(ctx, "team-a")
+	assert.NilError(t, err)
+	assert.Assert(t, !created)
+	assert.DeepEqual(t, sa.OwnerReferences, []metav1.OwnerReference{configRef})
+}
+
+func TestEnsureServiceAccountWithName(t *testing.T) {
+	ctx := context.TODO()
+	kubeClient := fake.NewSimpleClientset()
+	o := New(kubeClient, installerSetRef, configRef).WithServiceAccountName("ci-builder")
+
+	sa, created, err := o.EnsureServiceAccount(ctx, "team-a")
+	assert.NilError(t, err)
+	assert.Assert(t, created)
+	assert.Equal(t, sa.Name, "ci-builder")
+	_, err = kubeClient.CoreV1().ServiceAccounts("team-a").Get(ctx, PipelineServiceAccount, metav1.GetOptions{})
+	assert.Assert(t, apierrors.IsNotFound(err))
+}
+
+func TestEnsureEditRoleBinding(t *testing.T) {
+	ctx := context.TODO()
+	sa := &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: PipelineServiceAccount, Namespace: "team-a"}}
+	kubeClient := fake.NewSimpleClientset()
+	o := New(kubeClient, installerSetRef, configRef)
+
+	// the edit ClusterRole is missing
+	err := o.EnsureEditRoleBinding(ctx, sa, true)
+	assert.Assert(t, apierrors.IsNotFound(err))
+
+	_, err = kubeClient.RbacV1().ClusterRoles().Create(ctx, &rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: EditClusterRole}}, metav1.CreateOptions{})
+	assert.NilError(t, err)
+	assert.NilError(t, o.EnsureEditRoleBinding(ctx, sa, true))
+	rb, err := kubeClient.RbacV1().RoleBindings("team-a").Get(ctx, EditRoleBinding, metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, rb.RoleRef.Name, EditClusterRole)
+	assert.DeepEqual(t, rb.OwnerReferences, []metav1.OwnerReference{installerSetRef})
+	serviceAccount := rbacv1.Subject{Kind: rbacv1.ServiceAccountKind, Name: PipelineServiceAccount, Namespace: "team-a"}
+	assert.DeepEqual(t, rb.Subjects, []rbacv1.Subject{serviceAccount})
+
+	// the ServiceAccount is added to the subjects of an existing RoleBinding
+	user := rbacv1.Subject{Kind: rbacv1.UserKind, Name: "alice"}
+	rb.Subjects = []rbacv1.Subject{user}
+	_, err = kubeClient.RbacV1().RoleBindings("team-a").Update(ctx, rb, metav1.UpdateOptions{})
+	assert.NilError(t, err)
+	assert.NilError(t, o.EnsureEditRoleBinding(ctx, sa, true))
+	rb, err = kubeClient.RbacV1().RoleBindings("team-a").Get(ctx, EditRoleBinding, metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, rb.Subjects, []rbacv1.Subject{user, serviceAccount})
+
+	// the RoleBinding is deleted when it is disabled
+	assert.NilError(t, o.EnsureEditRoleBinding(ctx, sa, false))
+	_, err = kubeClient.RbacV1().RoleBindings("team-a").Get(ctx, EditRoleBinding, metav1.GetOptions{})
+	assert.Assert(t, apierrors.IsNotFound(err))
+	assert.NilError(t, o.EnsureEditRoleBinding(ctx, sa, false))
+}
+
+func TestEnsureEditRoleBindingWithClusterRole(t *testing.T) {
+	ctx := context.TODO()
+	sa := &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: PipelineServiceAccount, Namespace: "team-a"}}
+	kubeClient := fake.NewSimpleClientset(
+		&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: EditClusterRole}},
+		&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "pipelines-edit-no-secrets"}},
+	)
+	assert.NilError(t, New(kubeClient, installerSetRef, configRef).EnsureEditRoleBinding(ctx, sa, true))
+
+	// the RoleBinding is recreated with the custom ClusterRole
+	o := New(kubeClient, installerSetRef, configRef).WithEditClusterRole("pipelines-edit-no-secrets")
+	assert.NilError(t, o.EnsureEditRoleBinding(ctx, sa, true))
+	rb, err := kubeClient.RbacV1().RoleBindings("team-a").Get(ctx, EditRoleBinding, metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, rb.RoleRef.Name, "pipelines-edit-no-secrets")
+	assert.DeepEqual(t, rb.Subjects, []rbacv1.Subject{{Kind: rbacv1.ServiceAccountKind, Name: PipelineServiceAccount, Namespace: "team-a"}})
+
+	// the custom ClusterRole must exist
+	o = New(kubeClient, installerSetRef, configRef).WithEditClusterRole("missing")
+	assert.Assert(t, apierrors.IsNotFound(o.EnsureEditRoleBinding(ctx, sa, true)))
+}
+
+func TestEnsureCABundles(t *testing.T) {
+	ctx := context.TODO()
+	platformCerts := "-----BEGIN CERTIFICATE-----\nplatform\n-----END CERTIFICATE-----\n"
+	extraCerts := "-----BEGIN CERTIFICATE-----\nteam\n-----END CERTIFICATE-----\n"
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a"}}
+	kubeClient := fake.NewSimpleClientset(ns,
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: PlatformTrustedCAConfigMap, Namespace: PlatformTrustedCANamespace},
+			Data:       map[string]string{reconcilerCommon.TrustedCAKey: platformCerts},
+		},
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "team-ca", Namespace: "team-a"},
+			Data:       map[string]string{"ca.crt": extraCerts},
+		},
+	)
+	o := New(kubeClient, installerSetRef, configRef)
+
+	outdated, err := o.CABundlesOutdated(ctx, ns)
+	assert.NilError(t, err)
+	assert.Assert(t, outdated)
+	assert.NilError(t, o.EnsureCABundles(ctx, ns))
+	trusted, err := kubeClient.CoreV1().ConfigMaps("team-a").Get(ctx, TrustedCABundleConfigMap, metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, trusted.Labels[v1alpha1.TrustedCAInjectionLabel], "true")
+	assert.Equal(t, len(trusted.OwnerReferences), 0)
+	service, err := kubeClient.CoreV1().ConfigMaps("team-a").Get(ctx, ServiceCABundleConfigMap, metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, service.Annotations["service.beta.openshift.io/inject-cabundle"], "true")
+	outdated, err = o.CABundlesOutdated(ctx, ns)
+	assert.NilError(t, err)
+	assert.Assert(t, !outdated)
+
+	// the extra certificates of the namespace are written by the operator
+	ns.Annotations = map[string]string{v1alpha1.NamespaceExtraCAAnnotation: "team-ca"}
+	outdated, err = o.CABundlesOutdated(ctx, ns)
+	assert.NilError(t, err)
+	assert.Assert(t, outdated)
+	assert.NilError(t, o.EnsureCABundles(ctx, ns))
+	trusted, err = kubeClient.CoreV1().ConfigMaps("team-a").Get(ctx, TrustedCABundleConfigMap, metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, trusted.Data[reconcilerCommon.TrustedCAKey], platformCerts+extraCerts)
+	assert.Equal(t, trusted.Annotations[ExtraCASourceAnnotation], "team-ca")
+	_, injected := trusted.Labels[v1alpha1.TrustedCAInjectionLabel]
+	assert.Assert(t, !injected)
+	outdated, err = o.CABundlesOutdated(ctx, ns)
+	assert.NilError(t, err)
+	assert.Assert(t, !outdated)
+
+	// the injection of the platform is enabled again when the extra certificates are removed
+	ns.Annotations = nil
+	assert.NilError(t, o.EnsureCABundles(ctx, ns))
+	trusted, err = kubeClient.CoreV1().ConfigMaps("team-a").Get(ctx, TrustedCABundleConfigMap, metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, trusted.Labels[v1alpha1.TrustedCAInjectionLabel], "true")
+	assert.Equal(t, trusted.Annotations[ExtraCASourceAnnotation], "")
+}
+
+func testCertificate(t *testing.T) string {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NilError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "registry.team-a.example.com"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NilError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func TestEnsureCABundlesConfigMapNames(t *testing.T) {
+	ctx := context.TODO()
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a"}}
+	kubeClient := fake.NewSimpleClientset(ns)
+	o := New(kubeClient, installerSetRef, configRef).
+		WithCABundleConfigMaps(CABundleConfigMaps{Trusted: "team-trusted-cabundle"})
+	assert.NilError(t, o.EnsureCABundles(ctx, ns))
+
+	_, err := kubeClient.CoreV1().ConfigMaps("team-a").Get(ctx, "team-trusted-cabundle", metav1.GetOptions{})
+	assert.NilError(t, err)
+	for _, name := range []string{TrustedCABundleConfigMap, ServiceCABundleConfigMap} {
+		_, err := kubeClient.CoreV1().ConfigMaps("team-a").Get(ctx, name, metav1.GetOptions{})
+		assert.Assert(t, apierrors.IsNotFound(err), name)
+	}
+	outdated, err := o.CABundlesOutdated(ctx, ns)
+	assert.NilError(t, err)
+	assert.Assert(t, !outdated)
+
+	// a renamed ConfigMap is created under its new name
+	o.WithCABundleConfigMaps(CABundleConfigMaps{Trusted: "team-trusted-cabundle", Service: "team-service-cabundle"})
+	outdated, err = o.CABundlesOutdated(ctx, ns)
+	assert.NilError(t, err)
+	assert.Assert(t, outdated)
+	assert.NilError(t, o.EnsureCABundles(ctx, ns))
+	_, err = kubeClient.CoreV1().ConfigMaps("team-a").Get(ctx, "team-service-cabundle", metav1.GetOptions{})
+	assert.NilError(t, err)
+}
+
+func TestEnsureCABundlesAdditionalCAs(t *testing.T) {
+	ctx := context.TODO()
+	platformCerts := "-----BEGIN CERTIFICATE-----\nplatform\n-----END CERTIFICATE-----\n"
+	clusterCert := testCertificate(t)
+	teamCert := testCertificate(t)
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a"}}
+	kubeClient := fake.NewSimpleClientset(ns,
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: PlatformTrustedCAConfigMap, Namespace: PlatformTrustedCANamespace},
+			Data:       map[string]string{reconcilerCommon.TrustedCAKey: platformCerts},
+		},
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "team-ca", Namespace: "team-a"},
+			Data:       map[string]string{"ca.crt": teamCert},
+		},
+	)
+	o := New(kubeClient, installerSetRef, configRef)
+	assert.NilError(t, o.EnsureCABundles(ctx, ns))
+
+	// the additional CAs are written to the trusted CA bundle of the namespace
+	o.WithAdditionalCAs(clusterCert)
+	outdated, err := o.CABundlesOutdated(ctx, ns)
+	assert.NilError(t, err)
+	assert.Assert(t, outdated)
+	assert.NilError(t, o.EnsureCABundles(ctx, ns))
+	trusted, err := kubeClient.CoreV1().ConfigMaps("team-a").Get(ctx, TrustedCABundleConfigMap, metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, trusted.Data[reconcilerCommon.TrustedCAKey], platformCerts+clusterCert)
+	assert.Equal(t, trusted.Annotations[AdditionalCAsAnnotation], "true")
+	_, injected := trusted.Labels[v1alpha1.TrustedCAInjectionLabel]
+	assert.Assert(t, !injected)
+	outdated, err = o.CABundlesOutdated(ctx, ns)
+	assert.NilError(t, err)
+	assert.Assert(t, !outdated)
+
+	// the extra certificates of the namespace follow the additional CAs
+	ns.Annotations = map[string]string{v1alpha1.NamespaceExtraCAAnnotation: "team-ca"}
+	assert.NilError(t, o.EnsureCABundles(ctx, ns))
+	trusted, err = kubeClient.CoreV1().ConfigMaps("team-a").Get(ctx, TrustedCABundleConfigMap, metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, trusted.Data[reconcilerCommon.TrustedCAKey], platformCerts+clusterCert+teamCert)
+
+	// the bundle is written again when the additional CAs change
+	o.WithAdditionalCAs(teamCert)
+	outdated, err = o.CABundlesOutdated(ctx, ns)
+	assert.NilError(t, err)
+	assert.Assert(t, outdated)
+
+	// the injection of the platform is enabled again when the additional CAs are removed
+	ns.Annotations = nil
+	o.WithAdditionalCAs("")
+	outdated, err = o.CABundlesOutdated(ctx, ns)
+	assert.NilError(t, err)
+	assert.Assert(t, outdated)
+	assert.NilError(t, o.EnsureCABundles(ctx, ns))
+	trusted, err = kubeClient.CoreV1().ConfigMaps("team-a").Get(ctx, TrustedCABundleConfigMap, metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, trusted.Labels[v1alpha1.TrustedCAInjectionLabel], "true")
+	assert.Equal(t, trusted.Annotations[AdditionalCAsAnnotation], "")
+}
+
+func TestEnsureAdditionalTrust(t *testing.T) {
+	ctx := context.TODO()
+	platformCerts := "-----BEGIN CERTIFICATE-----\nplatform\n-----END CERTIFICATE-----\n"
+	teamCert := testCertificate(t)
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a"}}
+	kubeClient := fake.NewSimpleClientset(ns,
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: PlatformTrustedCAConfigMap, Namespace: PlatformTrustedCANamespace},
+			Data:       map[string]string{reconcilerCommon.TrustedCAKey: platformCerts},
+		},
+	)
+	o := New(kubeClient, installerSetRef, configRef)
+	assert.NilError(t, o.EnsureCABundles(ctx, ns))
+	_, err := kubeClient.CoreV1().ConfigMaps("team-a").Get(ctx, AdditionalTrustBundleConfigMap, metav1.GetOptions{})
+	assert.Assert(t, apierrors.IsNotFound(err))
+
+	// the additional trust is appended to the trusted CA certificates of the cluster
+	ns.Annotations = map[string]string{v1alpha1.NamespaceAdditionalTrustAnnotation: "\n" + teamCert}
+	outdated, err := o.CABundlesOutdated(ctx, ns)
+	assert.NilError(t, err)
+	assert.Assert(t, outdated)
+	assert.NilError(t, o.EnsureCABundles(ctx, ns))
+	cm, err := kubeClient.CoreV1().ConfigMaps("team-a").Get(ctx, AdditionalTrustBundleConfigMap, metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, cm.Data[reconcilerCommon.TrustedCAKey], platformCerts+teamCert)
+	assert.Equal(t, len(cm.OwnerReferences), 0)
+	outdated, err = o.CABundlesOutdated(ctx, ns)
+	assert.NilError(t, err)
+	assert.Assert(t, !outdated)
+
+	// the bundle is written again when the certificates of the cluster change
+	platform, err := kubeClient.CoreV1().ConfigMaps(PlatformTrustedCANamespace).Get(ctx, PlatformTrustedCAConfigMap, metav1.GetOptions{})
+	assert.NilError(t, err)
+	platform.Data[reconcilerCommon.TrustedCAKey] = teamCert
+	_, err = kubeClient.CoreV1().ConfigMaps(PlatformTrustedCANamespace).Update(ctx, platform, metav1.UpdateOptions{})
+	assert.NilError(t, err)
+	outdated, err = o.CABundlesOutdated(ctx, ns)
+	assert.NilError(t, err)
+	assert.Assert(t, outdated)
+	assert.NilError(t, o.EnsureCABundles(ctx, ns))
+	cm, err = kubeClient.CoreV1().ConfigMaps("team-a").Get(ctx, AdditionalTrustBundleConfigMap, metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, cm.Data[reconcilerCommon.TrustedCAKey], teamCert+teamCert)
+
+	// an annotation holding anything else than certificates is rejected
+	ns.Annotations[v1alpha1.NamespaceAdditionalTrustAnnotation] = platformCerts
+	assert.ErrorContains(t, o.EnsureCABundles(ctx, ns), "invalid annotation operator.tekton.dev/additional-trust of namespace team-a")
+	ns.Annotations[v1alpha1.NamespaceAdditionalTrustAnnotation] = "registry.team-a.example.com"
+	assert.ErrorContains(t, o.EnsureCABundles(ctx, ns), "not a PEM block")
+
+	// the ConfigMap is deleted once the namespace no longer requests additional trust
+	ns.Annotations = nil
+	outdated, err = o.CABundlesOutdated(ctx, ns)
+	assert.NilError(t, err)
+	assert.Assert(t, outdated)
+	assert.NilError(t, o.EnsureCABundles(ctx, ns))
+	_, err = kubeClient.CoreV1().ConfigMaps("team-a").Get(ctx, AdditionalTrustBundleConfigMap, metav1.GetOptions{})
+	assert.Assert(t, apierrors.IsNotFound(err))
+	outdated, err = o.CABundlesOutdated(ctx, ns)
+	assert.NilError(t, err)
+	assert.Assert(t, !outdated)
+}
+
+func TestOwnerReferences(t *testing.T) {
+	o := New(fake.NewSimpleClientset(), installerSetRef, configRef)
+	assert.DeepEqual(t, o.OwnerReferences(nil), []metav1.OwnerReference{installerSetRef})
+	assert.DeepEqual(t, o.OwnerReferences([]metav1.OwnerReference{installerSetRef}), []metav1.OwnerReference{installerSetRef})
+	assert.DeepEqual(t, o.OwnerReferences([]metav1.OwnerReference{configRef}), []metav1.OwnerReference{installerSetRef})
+}
+
+func TestSubjects(t *testing.T) {
+	alice := rbacv1.Subject{Kind: rbacv1.UserKind, Name: "alice"}
+	bob := rbacv1.Subject{Kind: rbacv1.UserKind, Name: "bob"}
+	pipeline := rbacv1.Subject{Kind: rbacv1.ServiceAccountKind, Name: PipelineServiceAccount, Namespace: "team-a"}
+
+	assert.Assert(t, HasSubject([]rbacv1.Subject{alice, pipeline}, pipeline))
+	assert.Assert(t, !HasSubject([]rbacv1.Subject{alice}, bob))
+	assert.Assert(t, CompareSubjects([]rbacv1.Subject{alice, bob}, []rbacv1.Subject{bob, alice}))
+	assert.Assert(t, !CompareSubjects([]rbacv1.Subject{alice, bob}, []rbacv1.Subject{alice, pipeline}))
+	assert.DeepEqual(t, MergeSubjects([]rbacv1.Subject{alice, bob}, []rbacv1.Subject{pipeline, alice}),
+		[]rbacv1.Subject{alice, bob, pipeline})
+}
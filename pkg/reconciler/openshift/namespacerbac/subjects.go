@@ -0,0 +1,103 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package namespacerbac
+
+import (
+	"fmt"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// HasSubject returns true when the subjects contain the subject
+func HasSubject(subjects []rbacv1.Subject, x rbacv1.Subject) bool {
+	for _, v := range subjects {
+		if v.Name == x.Name && v.Kind == x.Kind && v.Namespace == x.Namespace {
+			return true
+		}
+	}
+	return false
+}
+
+// CompareSubjects compares two slices of rbacv1.Subject, ignoring order
+func CompareSubjects(list1, list2 []rbacv1.Subject) bool {
+	// Check if lengths are different
+	if len(list1) != len(list2) {
+		return false
+	}
+	// Create sets (maps) for both lists
+	set1 := make(map[string]struct{})
+	set2 := make(map[string]struct{})
+
+	// Populate set1 with subjects from list1
+	for _, subject := range list1 {
+		set1[subjectKey(subject)] = struct{}{}
+	}
+	// Populate set2 with subjects from list2
+	for _, subject := range list2 {
+		set2[subjectKey(subject)] = struct{}{}
+	}
+
+	// Compare the sets
+	if len(set1) != len(set2) {
+		return false
+	}
+
+	// Check if all elements in set1 are in set2
+	for key := range set1 {
+		if _, exists := set2[key]; !exists {
+			return false
+		}
+	}
+	return true
+}
+
+// MergeSubjects appends the subjects of x missing in subjects
+func MergeSubjects(subjects []rbacv1.Subject, x []rbacv1.Subject) []rbacv1.Subject {
+	// Map to track subjects in the existing list
+	existingSubjects := make(map[string]struct{})
+	for _, subject := range subjects {
+		existingSubjects[subjectKey(subject)] = struct{}{}
+	}
+
+	// Final list to store the merged subjects
+	var finalSubjects []rbacv1.Subject
+	finalSubjects = append(finalSubjects, subjects...)
+
+	// Append subjects from `x` that are not in `existingSubjects`
+	for _, subject := range x {
+		if _, found := existingSubjects[subjectKey(subject)]; !found {
+			finalSubjects = append(finalSubjects, subject)
+		}
+	}
+
+	return finalSubjects
+}
+
+// HasOwnerReference returns true when the owner references contain a reference to the owner of new
+func HasOwnerReference(old []metav1.OwnerReference, new metav1.OwnerReference) bool {
+	for _, v := range old {
+		if v.APIVersion == new.APIVersion && v.Kind == new.Kind && v.Name == new.Name {
+			return true
+		}
+	}
+	return false
+}
+
+func subjectKey(subject rbacv1.Subject) string {
+	return fmt.Sprintf("%s/%s/%s", subject.Kind, subject.Name, subject.Namespace)
+}
@@ -20,6 +20,9 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"os"
+	"slices"
+	"strings"
 
 	mf "github.com/manifestival/manifestival"
 	pacSettings "github.com/openshift-pipelines/pipelines-as-code/pkg/params/settings"
@@ -39,8 +42,13 @@ import (
 const (
 	pipelinesAsCodeCM                 = "pipelines-as-code"
 	additionalPACControllerNameSuffix = "-pac-controller"
+
+	gitSSLCAInfoEnv = "GIT_SSL_CAINFO"
 )
 
+// gitProviderDeployments are the deployments of Pipelines-as-Code calling the Git providers
+var gitProviderDeployments = []string{"pipelines-as-code-controller", "pipelines-as-code-watcher"}
+
 func filterAndTransform(extension common.Extension) client.FilterAndTransform {
 	return func(ctx context.Context, manifest *mf.Manifest, comp v1alpha1.TektonComponent) (*mf.Manifest, error) {
 		pac := comp.(*v1alpha1.OpenShiftPipelinesAsCode)
@@ -57,7 +65,9 @@ func filterAndTransform(extension common.Extension) client.FilterAndTransform {
 			common.DeploymentImages(images),
 			common.DeploymentEnvVarKubernetesMinVersion(),
 			common.AddConfiguration(pac.Spec.Config),
+			common.ControlPlaneProxy(pac.Spec.Config),
 			occommon.ApplyCABundlesToDeployment,
+			applyGitProxySettings,
 			common.CopyConfigMap(pipelinesAsCodeCM, pac.Spec.Settings),
 			occommon.UpdateServiceMonitorTargetNamespace(pac.Spec.TargetNamespace),
 		}
@@ -89,7 +99,9 @@ func additionalControllerTransform(extension common.Extension, name string) clie
 			common.InjectOperandNameLabelOverwriteExisting(openshift.OperandOpenShiftPipelineAsCode),
 			common.DeploymentImages(images),
 			common.AddConfiguration(pac.Spec.Config),
+			common.ControlPlaneProxy(pac.Spec.Config),
 			occommon.ApplyCABundlesToDeployment,
+			applyGitProxySettings,
 			occommon.UpdateServiceMonitorTargetNamespace(pac.Spec.TargetNamespace),
 			updateAdditionControllerDeployment(additionalPACControllerConfig, name),
 			updateAdditionControllerService(name),
@@ -113,6 +125,50 @@ func additionalControllerTransform(extension common.Extension, name string) clie
 	}
 }
 
+// applyGitProxySettings configures the deployments calling the Git providers to work behind a
+// proxy intercepting TLS: git trusts the CA bundle of the cluster, and the in-cluster services,
+// e.g. the API server and the webhooks of the cluster, are not called through the proxy.
+func applyGitProxySettings(u *unstructured.Unstructured) error {
+	if u.GetKind() != "Deployment" || !slices.Contains(gitProviderDeployments, u.GetName()) {
+		return nil
+	}
+
+	// the NO_PROXY of the deployment is set with the proxy settings during the install
+	annotations := u.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[common.NoProxyAnnotation] = strings.Join(inClusterNoProxy(), ",")
+	u.SetAnnotations(annotations)
+
+	d := &appsv1.Deployment{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, d); err != nil {
+		return err
+	}
+	for i := range d.Spec.Template.Spec.Containers {
+		d.Spec.Template.Spec.Containers[i].Env = common.AddOrReplaceInList(
+			d.Spec.Template.Spec.Containers[i].Env,
+			corev1.EnvVar{Name: gitSSLCAInfoEnv, Value: occommon.SystemCABundlePath},
+			func(e corev1.EnvVar) string { return e.Name },
+		)
+	}
+	unstrObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(d)
+	if err != nil {
+		return err
+	}
+	u.SetUnstructuredContent(unstrObj)
+	return nil
+}
+
+// inClusterNoProxy returns the hosts of the cluster which are never called through the proxy
+func inClusterNoProxy() []string {
+	hosts := []string{"localhost", "127.0.0.1", ".svc", ".cluster.local"}
+	if host := os.Getenv("KUBERNETES_SERVICE_HOST"); host != "" {
+		hosts = append(hosts, host)
+	}
+	return hosts
+}
+
 // This returns all resources to deploy for the additional PACController
 func filterAdditionalControllerManifest(manifest mf.Manifest) mf.Manifest {
 	// filter deployment
@@ -18,12 +18,15 @@ package openshiftpipelinesascode
 
 import (
 	"path"
+	"slices"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	mf "github.com/manifestival/manifestival"
 	routev1 "github.com/openshift/api/route/v1"
 	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
+	occommon "github.com/tektoncd/operator/pkg/reconciler/openshift/common"
 	"github.com/tektoncd/pipeline/test/diff"
 	"gotest.tools/v3/assert"
 	appsv1 "k8s.io/api/apps/v1"
@@ -185,3 +188,27 @@ func TestUpdateAdditionControllerConfigMap(t *testing.T) {
 		t.Errorf("failed to update additional pac controller route %s", diff.PrintWantGot(d))
 	}
 }
+
+func TestApplyGitProxySettings(t *testing.T) {
+	t.Setenv("KUBERNETES_SERVICE_HOST", "172.30.0.1")
+	testData := path.Join("testdata", "test-filter-manifest.yaml")
+	manifest, err := mf.ManifestFrom(mf.Recursive(testData))
+	assert.NilError(t, err)
+	manifest = manifest.Filter(mf.ByKind("Deployment"))
+
+	updatedManifest, err := manifest.Transform(applyGitProxySettings)
+	assert.NilError(t, err)
+	for _, u := range updatedManifest.Resources() {
+		d := &appsv1.Deployment{}
+		assert.NilError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, d))
+		if !slices.Contains(gitProviderDeployments, d.Name) {
+			_, ok := d.Annotations[common.NoProxyAnnotation]
+			assert.Assert(t, !ok, d.Name)
+			continue
+		}
+		assert.Equal(t, d.Annotations[common.NoProxyAnnotation], "localhost,127.0.0.1,.svc,.cluster.local,172.30.0.1")
+		for _, c := range d.Spec.Template.Spec.Containers {
+			assert.Assert(t, slices.Contains(c.Env, corev1.EnvVar{Name: gitSSLCAInfoEnv, Value: occommon.SystemCABundlePath}), d.Name)
+		}
+	}
+}
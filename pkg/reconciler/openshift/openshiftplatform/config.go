@@ -17,6 +17,8 @@ limitations under the License.
 package openshiftplatform
 
 import (
+	"slices"
+
 	k8sInstallerSet "github.com/tektoncd/operator/pkg/reconciler/kubernetes/tektoninstallerset"
 	openshiftManualApprovalGate "github.com/tektoncd/operator/pkg/reconciler/openshift/manualapprovalgate"
 	"github.com/tektoncd/operator/pkg/reconciler/openshift/openshiftpipelinesascode"
@@ -32,6 +34,10 @@ import (
 	openshiftScheduler "github.com/tektoncd/operator/pkg/reconciler/openshift/tektonscheduler"
 	openshiftTrigger "github.com/tektoncd/operator/pkg/reconciler/openshift/tektontrigger"
 	"github.com/tektoncd/operator/pkg/reconciler/platform"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/componentaction"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/gitsource"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/secretrotation"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/watchdog"
 	"knative.dev/pkg/injection"
 )
 
@@ -102,5 +108,21 @@ var (
 			Name:                  string(platform.ControllerSyncerService),
 			ControllerConstructor: openshiftSyncerService.NewController,
 		},
+		platform.ControllerComponentAction: injection.NamedControllerConstructor{
+			Name:                  string(platform.ControllerComponentAction),
+			ControllerConstructor: componentaction.NewExtendedController(slices.Concat(componentaction.CommonKinds, []componentaction.Kind{componentaction.AddonKind, componentaction.PipelinesAsCodeKind})...),
+		},
+		platform.ControllerGitSource: injection.NamedControllerConstructor{
+			Name:                  string(platform.ControllerGitSource),
+			ControllerConstructor: gitsource.NewController,
+		},
+		platform.ControllerSecretRotation: injection.NamedControllerConstructor{
+			Name:                  string(platform.ControllerSecretRotation),
+			ControllerConstructor: secretrotation.NewExtendedController(secretrotation.ChainsCredentials, secretrotation.PipelinesAsCodeCredentials),
+		},
+		platform.ControllerWatchdog: injection.NamedControllerConstructor{
+			Name:                  string(platform.ControllerWatchdog),
+			ControllerConstructor: watchdog.NewController,
+		},
 	}
 )
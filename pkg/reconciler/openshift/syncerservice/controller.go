@@ -71,13 +71,13 @@ func NewController(ctx context.Context, cmw configmap.Watcher) *controller.Impl
 
 	logger.Debug("Setting up event handlers for syncer-service")
 
-	if _, err := syncerServiceInformer.Get(ctx).Informer().AddEventHandler(controller.HandleAll(impl.Enqueue)); err != nil {
+	if _, err := syncerServiceInformer.Get(ctx).Informer().AddEventHandler(common.HandleSpecChanges(impl.Enqueue)); err != nil {
 		logger.Panicf("Couldn't register SyncerService informer event handler: %w", err)
 	}
 
 	if _, err := tektonInstallerinformer.Get(ctx).Informer().AddEventHandler(cache.FilteringResourceEventHandler{
 		FilterFunc: controller.FilterController(&v1alpha1.SyncerService{}),
-		Handler:    controller.HandleAll(impl.EnqueueControllerOf),
+		Handler:    common.HandleReadinessChanges(impl.EnqueueControllerOf),
 	}); err != nil {
 		logger.Panicf("Couldn't register TektonInstallerSet informer event handler: %w", err)
 	}
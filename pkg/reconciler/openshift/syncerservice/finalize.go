@@ -22,8 +22,6 @@ import (
 	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
 	syncerservicereconciler "github.com/tektoncd/operator/pkg/client/injection/reconciler/operator/v1alpha1/syncerservice"
 	"github.com/tektoncd/operator/pkg/reconciler/common"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"knative.dev/pkg/logging"
 	pkgreconciler "knative.dev/pkg/reconciler"
 )
 
@@ -31,23 +29,8 @@ var _ syncerservicereconciler.Finalizer = (*Reconciler)(nil)
 
 // FinalizeKind removes all resources after deletion of a SyncerService.
 func (r *Reconciler) FinalizeKind(ctx context.Context, original *v1alpha1.SyncerService) pkgreconciler.Event {
-	logger := logging.FromContext(ctx)
-
-	labelSelector, err := common.LabelSelector(ls)
-	if err != nil {
-		return err
-	}
-	if err := r.operatorClientSet.OperatorV1alpha1().TektonInstallerSets().
-		DeleteCollection(ctx, metav1.DeleteOptions{}, metav1.ListOptions{
-			LabelSelector: labelSelector,
-		}); err != nil {
-		logger.Error("Failed to delete installer set created by SyncerService", err)
-		return err
-	}
-
-	if err := r.extension.Finalize(ctx, original); err != nil {
-		logger.Error("Failed to finalize platform resources", err)
-	}
-
-	return nil
+	return common.Finalize(ctx, original, &original.Status,
+		common.InstallerSetsFinalizationStep(r.operatorClientSet.OperatorV1alpha1().TektonInstallerSets(), ls),
+		common.PlatformFinalizationStep(r.extension, original),
+	)
 }
@@ -33,6 +33,7 @@ func (r *Reconciler) transform(ctx context.Context, manifest *mf.Manifest, ss *v
 
 	extra := []mf.Transformer{
 		common.InjectOperandNameLabelOverwriteExisting(v1alpha1.OperandSyncerService),
+		common.ControlPlaneProxy(ss.Spec.Config),
 		common.ApplyProxySettings,
 		common.AddDeploymentRestrictedPSA(),
 		common.AddConfiguration(ss.Spec.Config),
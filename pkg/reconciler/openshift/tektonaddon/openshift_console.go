@@ -83,6 +83,7 @@ func filterAndTransformOCPResources() client.FilterAndTransform {
 		tfs := []mf.Transformer{
 			common.DeploymentImages(images),
 			common.AddConfiguration(addon.Spec.Config),
+			common.ControlPlaneProxy(addon.Spec.Config),
 		}
 		if err := transformers(ctx, manifest, addon, tfs...); err != nil {
 			return nil, err
@@ -74,12 +74,9 @@ var _ tektonaddonreconciler.Finalizer = (*Reconciler)(nil)
 
 // FinalizeKind removes all resources after deletion of a TektonTriggers.
 func (r *Reconciler) FinalizeKind(ctx context.Context, original *v1alpha1.TektonAddon) pkgreconciler.Event {
-	logger := logging.FromContext(ctx)
-	if err := r.installerSetClient.CleanupAllCustomSet(ctx); err != nil {
-		logger.Errorf("failed to cleanup custom set: %v", err)
-		return err
-	}
-	return nil
+	return common.Finalize(ctx, original, &original.Status,
+		common.FinalizationStep{Name: "custom installer sets", Stage: common.FinalizeInstallerSets, Run: r.installerSetClient.CleanupAllCustomSet},
+	)
 }
 
 // ReconcileKind compares the actual state with the desired, and attempts to
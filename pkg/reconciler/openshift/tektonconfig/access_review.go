@@ -0,0 +1,83 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonconfig
+
+import (
+	"context"
+
+	"github.com/tektoncd/operator/pkg/reconciler/shared/permissions"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"knative.dev/pkg/logging"
+)
+
+// pipelineAccessRules are the permissions the pipelines need in their namespace, granted to the
+// ServiceAccount of the namespace by the edit RoleBinding and the SCC RoleBinding. The SCC is not
+// reviewed when an empty scc is given.
+func pipelineAccessRules(scc string) []rbacv1.PolicyRule {
+	rules := []rbacv1.PolicyRule{
+		{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"get", "list", "create", "delete"}},
+		{APIGroups: []string{""}, Resources: []string{"configmaps"}, Verbs: []string{"get"}},
+		{APIGroups: []string{"tekton.dev"}, Resources: []string{"pipelineruns", "taskruns"}, Verbs: []string{"get", "create"}},
+	}
+	if scc != "" {
+		rules = append(rules, rbacv1.PolicyRule{
+			APIGroups: []string{"security.openshift.io"}, Resources: []string{"securitycontextconstraints"}, ResourceNames: []string{scc}, Verbs: []string{"use"},
+		})
+	}
+	return rules
+}
+
+// reviewPipelineAccess reviews the permissions of the pipelines for the ServiceAccount of the
+// namespaces whose RBAC was reconciled, and records the permissions which are denied although the
+// bindings are present, e.g. by a deny policy or because the bound ClusterRole does not exist. The
+// reviews are informational, the errors are logged without failing the namespaces.
+func (r *rbac) reviewPipelineAccess(ctx context.Context, workers int, namespaces []corev1.Namespace, onboarding *namespaceOnboarding) {
+	if !r.subsystemEnabled(ctx, permissions.SubsystemAccessReview) {
+		return
+	}
+	logger := logging.FromContext(ctx)
+	sccEnabled := r.subsystemEnabled(ctx, permissions.SubsystemSCC)
+
+	var reviewed []corev1.Namespace
+	for _, ns := range namespaces {
+		if _, ok := onboarding.rbac[ns.Name]; ok {
+			reviewed = append(reviewed, ns)
+		}
+	}
+	denied := make([][]string, len(reviewed))
+	errs := processNamespaces(ctx, workers, reviewed, false, func(ctx context.Context, i int, ns corev1.Namespace) error {
+		scc := ""
+		if sccEnabled {
+			scc = r.namespaceSCC(ns)
+		}
+		var err error
+		denied[i], err = permissions.MissingServiceAccountPermissions(ctx, r.kubeClientSet, ns.Name, r.serviceAccountName(), pipelineAccessRules(scc))
+		return err
+	})
+	for i, ns := range reviewed {
+		if errs[i] != nil {
+			logger.Errorf("failed to review the access of the ServiceAccount of namespace %s: %v", ns.Name, errs[i])
+			continue
+		}
+		if len(denied[i]) > 0 {
+			logger.Warnw("the ServiceAccount of the namespace is denied permissions of the pipelines although it is bound to them",
+				"namespace", ns.Name, "serviceAccount", r.serviceAccountName(), "denied", denied[i])
+		}
+		onboarding.access[ns.Name] = denied[i]
+	}
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonconfig
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"gotest.tools/v3/assert"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestReviewPipelineAccess(t *testing.T) {
+	kubeClient := kubefake.NewSimpleClientset()
+	kubeClient.PrependReactor("create", "subjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SubjectAccessReview)
+		attrs := review.Spec.ResourceAttributes
+		switch {
+		case attrs.Namespace == "ns-error":
+			return true, nil, errors.New("connection refused")
+		case !strings.HasPrefix(review.Spec.User, "system:serviceaccount:"+attrs.Namespace+":pipeline"):
+			return true, nil, errors.New("unexpected user " + review.Spec.User)
+		}
+		// a deny policy in ns-b rejects the pods created by the pipelines
+		review.Status.Allowed = !(attrs.Namespace == "ns-b" && attrs.Resource == "pods" && attrs.Verb == "create")
+		return true, review, nil
+	})
+	tc := &v1alpha1.TektonConfig{ObjectMeta: metav1.ObjectMeta{Name: "config"}}
+	tc.Spec.Platforms.OpenShift.SCC = &v1alpha1.SCC{Default: "pipelines-scc"}
+	r := &rbac{kubeClientSet: kubeClient, tektonConfig: tc}
+
+	namespaces := []corev1.Namespace{
+		{ObjectMeta: metav1.ObjectMeta{Name: "ns-a"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "ns-b"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "ns-error"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "ns-failed"}},
+	}
+	failures := &namespaceFailures{processed: map[string]bool{}, failed: map[string]error{}}
+	assert.NilError(t, failures.record("ns-failed", errors.New("forbidden")))
+	onboarding := newNamespaceOnboarding()
+	for _, ns := range namespaces {
+		onboarding.rbacReconciled(ns, failures, r.namespaceSCC(ns))
+	}
+	r.reviewPipelineAccess(context.TODO(), 2, namespaces, onboarding)
+
+	// the namespaces which failed or could not be reviewed are not reported
+	assert.DeepEqual(t, onboarding.access, map[string][]string{
+		"ns-a": nil,
+		"ns-b": {"create pods"},
+	})
+}
+
+func TestPipelineAccessRules(t *testing.T) {
+	rules := pipelineAccessRules("")
+	for _, rule := range rules {
+		assert.Assert(t, rule.APIGroups[0] != "security.openshift.io")
+	}
+	withSCC := pipelineAccessRules("restricted-v2")
+	assert.Equal(t, len(withSCC), len(rules)+1)
+	assert.DeepEqual(t, withSCC[len(rules)].ResourceNames, []string{"restricted-v2"})
+}
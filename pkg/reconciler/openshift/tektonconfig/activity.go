@@ -0,0 +1,196 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonconfig
+
+import (
+	"context"
+	goerrors "errors"
+	"fmt"
+	"sync"
+	"time"
+
+	reconcilerCommon "github.com/tektoncd/operator/pkg/reconciler/common"
+	pipelinev1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	pipelineversioned "github.com/tektoncd/pipeline/pkg/client/clientset/versioned"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	nsV1 "k8s.io/client-go/informers/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"knative.dev/pkg/logging"
+)
+
+// namespaceActivity reports the last time Tekton workloads were created in a namespace
+type namespaceActivity interface {
+	// Start starts watching Tekton workloads, it is safe to call it multiple times
+	Start()
+	// HasSynced returns true once the underlying caches have been populated
+	HasSynced() bool
+	// LastActivity returns the creation time of the newest PipelineRun or TaskRun
+	// in the namespace, or the zero time if there is none
+	LastActivity(namespace string) time.Time
+}
+
+// activityTracker keeps a metadata-only cache of PipelineRuns and TaskRuns,
+// which is used by the RBAC reaper to find namespaces without Tekton activity.
+// It also re-provisions reaped namespaces as soon as a PipelineRun is created in them.
+type activityTracker struct {
+	ctx           context.Context
+	startOnce     sync.Once
+	pipelineRuns  cache.SharedIndexInformer
+	taskRuns      cache.SharedIndexInformer
+	kubeClientSet kubernetes.Interface
+	nsInformer    nsV1.NamespaceInformer
+}
+
+var _ namespaceActivity = (*activityTracker)(nil)
+
+// newActivityTracker creates the PipelineRun and TaskRun informers, they are only
+// started once the reaper is enabled and stopped when the context is done
+func newActivityTracker(ctx context.Context, kc kubernetes.Interface, pc pipelineversioned.Interface, nsInformer nsV1.NamespaceInformer) *activityTracker {
+	logger := logging.FromContext(ctx)
+
+	a := &activityTracker{
+		ctx:           ctx,
+		kubeClientSet: kc,
+		nsInformer:    nsInformer,
+		pipelineRuns: newRunInformer(&cache.ListWatch{
+			ListFunc: func(opts metav1.ListOptions) (runtime.Object, error) {
+				return pc.TektonV1().PipelineRuns(metav1.NamespaceAll).List(ctx, opts)
+			},
+			WatchFunc: func(opts metav1.ListOptions) (watch.Interface, error) {
+				return pc.TektonV1().PipelineRuns(metav1.NamespaceAll).Watch(ctx, opts)
+			},
+		}, &pipelinev1.PipelineRun{}),
+		taskRuns: newRunInformer(&cache.ListWatch{
+			ListFunc: func(opts metav1.ListOptions) (runtime.Object, error) {
+				return pc.TektonV1().TaskRuns(metav1.NamespaceAll).List(ctx, opts)
+			},
+			WatchFunc: func(opts metav1.ListOptions) (watch.Interface, error) {
+				return pc.TektonV1().TaskRuns(metav1.NamespaceAll).Watch(ctx, opts)
+			},
+		}, &pipelinev1.TaskRun{}),
+	}
+
+	if _, err := a.pipelineRuns.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) { a.reprovisionNamespaceOf(ctx, obj) },
+	}); err != nil {
+		logger.Panicf("Couldn't register PipelineRun informer event handler: %w", err)
+	}
+	return a
+}
+
+// Start implements namespaceActivity
+func (a *activityTracker) Start() {
+	a.startOnce.Do(func() {
+		go a.pipelineRuns.Run(a.ctx.Done())
+		go a.taskRuns.Run(a.ctx.Done())
+	})
+}
+
+// newRunInformer returns an informer which only keeps the object metadata in
+// its cache, since that is all we need to detect activity
+func newRunInformer(lw cache.ListerWatcher, obj runtime.Object) cache.SharedIndexInformer {
+	informer := cache.NewSharedIndexInformer(lw, obj, 0, cache.Indexers{
+		cache.NamespaceIndex: cache.MetaNamespaceIndexFunc,
+	})
+	_ = informer.SetTransform(func(in interface{}) (interface{}, error) {
+		accessor, err := metaAccessor(in)
+		if err != nil {
+			// tombstones and unknown objects are passed as is
+			return in, nil
+		}
+		return &metav1.PartialObjectMetadata{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              accessor.GetName(),
+				Namespace:         accessor.GetNamespace(),
+				CreationTimestamp: accessor.GetCreationTimestamp(),
+			},
+		}, nil
+	})
+	return informer
+}
+
+func metaAccessor(obj interface{}) (metav1.Object, error) {
+	accessor, ok := obj.(metav1.Object)
+	if !ok {
+		return nil, fmt.Errorf("object %T does not implement metav1.Object", obj)
+	}
+	return accessor, nil
+}
+
+// HasSynced implements namespaceActivity
+func (a *activityTracker) HasSynced() bool {
+	return a.pipelineRuns.HasSynced() && a.taskRuns.HasSynced()
+}
+
+// LastActivity implements namespaceActivity
+func (a *activityTracker) LastActivity(namespace string) time.Time {
+	var last time.Time
+	for _, informer := range []cache.SharedIndexInformer{a.pipelineRuns, a.taskRuns} {
+		objs, err := informer.GetIndexer().ByIndex(cache.NamespaceIndex, namespace)
+		if err != nil {
+			continue
+		}
+		for _, obj := range objs {
+			accessor, err := metaAccessor(obj)
+			if err != nil {
+				continue
+			}
+			if created := accessor.GetCreationTimestamp().Time; created.After(last) {
+				last = created
+			}
+		}
+	}
+	return last
+}
+
+// reprovisionNamespaceOf removes the reaped annotation from the namespace of a newly
+// created PipelineRun, the namespace event then triggers a TektonConfig reconcile
+// which creates the RBAC resources and CA bundles again
+func (a *activityTracker) reprovisionNamespaceOf(ctx context.Context, obj interface{}) {
+	logger := logging.FromContext(ctx)
+
+	accessor, err := metaAccessor(obj)
+	if err != nil {
+		return
+	}
+	ns, err := a.nsInformer.Lister().Get(accessor.GetNamespace())
+	if err != nil {
+		return
+	}
+	reapedAt, ok := namespaceReapedAt(ns)
+	if !ok {
+		return
+	}
+	// the initial list of the informer replays PipelineRuns created before the
+	// namespace was reaped, those must not bring the RBAC resources back. Both times
+	// have a precision of a second, a PipelineRun created in the second the namespace
+	// was reaped brings them back.
+	if accessor.GetCreationTimestamp().Time.Before(reapedAt) {
+		return
+	}
+
+	logger.Infof("PipelineRun %s/%s created in reaped namespace, re-provisioning RBAC resources", accessor.GetNamespace(), accessor.GetName())
+	err = reconcilerCommon.PatchNamespaceMetadata(ctx, a.kubeClientSet, ns.Name, reconcilerCommon.NamespaceMetadataPatch{
+		RemoveAnnotations: []string{namespaceRBACReapedAnnotation},
+	})
+	if err != nil && !goerrors.Is(err, reconcilerCommon.ErrNamespaceTerminating) {
+		logger.Errorf("failed to remove annotation %s from namespace %s: %v", namespaceRBACReapedAnnotation, ns.Name, err)
+	}
+}
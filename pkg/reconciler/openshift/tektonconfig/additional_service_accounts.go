@@ -0,0 +1,150 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonconfig
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/tektoncd/operator/pkg/reconciler/common"
+	"github.com/tektoncd/operator/pkg/reconciler/openshift/namespacerbac"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/hash"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/logging"
+)
+
+const (
+	// additionalServiceAccountsManagedAnnotation holds the additional ServiceAccounts added to the
+	// SCC and edit RoleBindings by the operator, the other subjects of the RoleBindings are never removed
+	additionalServiceAccountsManagedAnnotation = "openshift-pipelines.tekton.dev/managed-service-accounts"
+	// additionalServiceAccountsHashAnnotation holds the hash of the additional ServiceAccounts bound in
+	// a namespace, the namespace is reconciled again when they change
+	additionalServiceAccountsHashAnnotation = "openshift-pipelines.tekton.dev/additional-service-accounts-hash"
+)
+
+// additionalServiceAccounts returns the sorted names of the additional ServiceAccounts, without
+// duplicates and without the ServiceAccount created by the operator
+func (r *rbac) additionalServiceAccounts() []string {
+	rbac := r.tektonConfig.Spec.Platforms.OpenShift.RBAC
+	if rbac == nil {
+		return nil
+	}
+	names := []string{}
+	for _, name := range rbac.AdditionalServiceAccounts {
+		if name != "" && name != r.serviceAccountName() && !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	return names
+}
+
+// additionalServiceAccountsHash returns the hash of the additional ServiceAccounts, it is empty
+// if there is none
+func (r *rbac) additionalServiceAccountsHash() (string, error) {
+	names := r.additionalServiceAccounts()
+	if len(names) == 0 {
+		return "", nil
+	}
+	return hash.Compute(names)
+}
+
+// ensureAdditionalServiceAccounts binds the additional ServiceAccounts to the SCC and edit roles
+// through the RoleBindings of the ServiceAccount created by the operator, and removes the
+// ServiceAccounts it added before which are no longer configured
+func (r *rbac) ensureAdditionalServiceAccounts(ctx context.Context, namespace string) error {
+	logger := logging.FromContext(ctx)
+
+	names := r.additionalServiceAccounts()
+	desired := make([]rbacv1.Subject, 0, len(names))
+	for _, name := range names {
+		desired = append(desired, rbacv1.Subject{Kind: rbacv1.ServiceAccountKind, Name: name, Namespace: namespace})
+	}
+	for _, rbName := range []string{pipelinesSCCRoleBinding, PipelineRoleBinding} {
+		// the rolebinding is read again on conflicts, as other subjects may be added concurrently
+		updated := false
+		err := common.Retry(ctx, "update rolebinding service accounts", func() error {
+			var err error
+			updated, err = r.updateAdditionalServiceAccounts(ctx, namespace, rbName, desired)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		if updated {
+			logger.Infof("updated the additional service accounts of rolebinding %s/%s", namespace, rbName)
+		}
+	}
+	return nil
+}
+
+// updateAdditionalServiceAccounts updates the ServiceAccounts of the RoleBinding, it returns true
+// when the RoleBinding is updated. A missing RoleBinding, e.g. the edit RoleBinding when the legacy
+// RBAC is disabled, is skipped.
+func (r *rbac) updateAdditionalServiceAccounts(ctx context.Context, namespace, name string, desired []rbacv1.Subject) (bool, error) {
+	rbClient := r.kubeClientSet.RbacV1().RoleBindings(namespace)
+
+	rb, err := rbClient.Get(ctx, name, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	previous := []rbacv1.Subject{}
+	for _, sa := range strings.Split(rb.Annotations[additionalServiceAccountsManagedAnnotation], ",") {
+		if sa != "" {
+			previous = append(previous, rbacv1.Subject{Kind: rbacv1.ServiceAccountKind, Name: sa, Namespace: namespace})
+		}
+	}
+
+	subjects := []rbacv1.Subject{}
+	for _, s := range rb.Subjects {
+		if namespacerbac.HasSubject(previous, s) && !namespacerbac.HasSubject(desired, s) {
+			continue
+		}
+		subjects = append(subjects, s)
+	}
+	subjects = namespacerbac.MergeSubjects(subjects, desired)
+
+	managed := make([]string, 0, len(desired))
+	for _, s := range desired {
+		managed = append(managed, s.Name)
+	}
+	managedValue := strings.Join(managed, ",")
+	if namespacerbac.CompareSubjects(subjects, rb.Subjects) && rb.Annotations[additionalServiceAccountsManagedAnnotation] == managedValue {
+		return false, nil
+	}
+	rb = rb.DeepCopy()
+	rb.Subjects = subjects
+	if managedValue == "" {
+		delete(rb.Annotations, additionalServiceAccountsManagedAnnotation)
+	} else {
+		if rb.Annotations == nil {
+			rb.Annotations = map[string]string{}
+		}
+		rb.Annotations[additionalServiceAccountsManagedAnnotation] = managedValue
+	}
+	if _, err := rbClient.Update(ctx, rb, metav1.UpdateOptions{}); err != nil {
+		return false, fmt.Errorf("failed to update the service accounts of rolebinding %s/%s: %w", namespace, name, err)
+	}
+	return true, nil
+}
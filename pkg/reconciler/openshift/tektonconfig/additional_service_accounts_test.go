@@ -0,0 +1,108 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonconfig
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"gotest.tools/v3/assert"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+)
+
+func additionalServiceAccountsRBAC(serviceAccounts ...string) *rbac {
+	return &rbac{
+		kubeClientSet: kubefake.NewSimpleClientset(&rbacv1.RoleBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: pipelinesSCCRoleBinding, Namespace: "ci"},
+			RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: "pipelines-scc-clusterrole"},
+			Subjects: []rbacv1.Subject{
+				{Kind: rbacv1.ServiceAccountKind, Name: pipelineSA, Namespace: "ci"},
+				{Kind: rbacv1.ServiceAccountKind, Name: "added-by-hand", Namespace: "ci"},
+			},
+		}),
+		tektonConfig: &v1alpha1.TektonConfig{
+			Spec: v1alpha1.TektonConfigSpec{
+				Platforms: v1alpha1.Platforms{
+					OpenShift: v1alpha1.OpenShift{
+						RBAC: &v1alpha1.RBAC{AdditionalServiceAccounts: serviceAccounts},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestEnsureAdditionalServiceAccounts(t *testing.T) {
+	ctx := context.TODO()
+	r := additionalServiceAccountsRBAC("deployer", pipelineSA, "builder", "deployer")
+	rbClient := r.kubeClientSet.RbacV1().RoleBindings("ci")
+
+	// the edit RoleBinding is missing, it is skipped
+	assert.NilError(t, r.ensureAdditionalServiceAccounts(ctx, "ci"))
+	rb, err := rbClient.Get(ctx, pipelinesSCCRoleBinding, metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, rb.Subjects, []rbacv1.Subject{
+		{Kind: rbacv1.ServiceAccountKind, Name: pipelineSA, Namespace: "ci"},
+		{Kind: rbacv1.ServiceAccountKind, Name: "added-by-hand", Namespace: "ci"},
+		{Kind: rbacv1.ServiceAccountKind, Name: "builder", Namespace: "ci"},
+		{Kind: rbacv1.ServiceAccountKind, Name: "deployer", Namespace: "ci"},
+	})
+	assert.Equal(t, rb.Annotations[additionalServiceAccountsManagedAnnotation], "builder,deployer")
+
+	// the ServiceAccounts which are removed from the configuration are unbound,
+	// the ones added by hand are kept
+	r.tektonConfig.Spec.Platforms.OpenShift.RBAC.AdditionalServiceAccounts = []string{"deployer", "added-by-hand"}
+	assert.NilError(t, r.ensureAdditionalServiceAccounts(ctx, "ci"))
+	rb, err = rbClient.Get(ctx, pipelinesSCCRoleBinding, metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, rb.Subjects, []rbacv1.Subject{
+		{Kind: rbacv1.ServiceAccountKind, Name: pipelineSA, Namespace: "ci"},
+		{Kind: rbacv1.ServiceAccountKind, Name: "added-by-hand", Namespace: "ci"},
+		{Kind: rbacv1.ServiceAccountKind, Name: "deployer", Namespace: "ci"},
+	})
+	assert.Equal(t, rb.Annotations[additionalServiceAccountsManagedAnnotation], "added-by-hand,deployer")
+
+	r.tektonConfig.Spec.Platforms.OpenShift.RBAC.AdditionalServiceAccounts = nil
+	assert.NilError(t, r.ensureAdditionalServiceAccounts(ctx, "ci"))
+	rb, err = rbClient.Get(ctx, pipelinesSCCRoleBinding, metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, rb.Subjects, []rbacv1.Subject{
+		{Kind: rbacv1.ServiceAccountKind, Name: pipelineSA, Namespace: "ci"},
+	})
+	_, found := rb.Annotations[additionalServiceAccountsManagedAnnotation]
+	assert.Assert(t, !found)
+}
+
+func TestAdditionalServiceAccountsHash(t *testing.T) {
+	r := additionalServiceAccountsRBAC()
+	h, err := r.additionalServiceAccountsHash()
+	assert.NilError(t, err)
+	assert.Equal(t, h, "")
+
+	r = additionalServiceAccountsRBAC("deployer", "builder")
+	h1, err := r.additionalServiceAccountsHash()
+	assert.NilError(t, err)
+	assert.Assert(t, h1 != "")
+	// the order and the duplicates do not change the hash
+	r = additionalServiceAccountsRBAC("builder", "deployer", "builder")
+	h2, err := r.additionalServiceAccountsHash()
+	assert.NilError(t, err)
+	assert.Equal(t, h1, h2)
+}
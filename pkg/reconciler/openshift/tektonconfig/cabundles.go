@@ -0,0 +1,95 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonconfig
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	reconcilerCommon "github.com/tektoncd/operator/pkg/reconciler/common"
+	"github.com/tektoncd/operator/pkg/reconciler/openshift/namespacerbac"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// caBundleConfigMaps returns the names of the CA bundle ConfigMaps created in the namespaces, the
+// default names unless they are renamed, and an empty name for the disabled ConfigMaps
+func caBundleConfigMaps(tc *v1alpha1.TektonConfig) namespacerbac.CABundleConfigMaps {
+	names := namespacerbac.DefaultCABundleConfigMaps
+	if tc == nil || tc.Spec.Platforms.OpenShift.CABundles == nil {
+		return names
+	}
+	spec := tc.Spec.Platforms.OpenShift.CABundles
+	if spec.Trusted.Name != "" {
+		names.Trusted = spec.Trusted.Name
+	}
+	if spec.Trusted.Disabled {
+		names.Trusted = ""
+	}
+	if spec.Service.Name != "" {
+		names.Service = spec.Service.Name
+	}
+	if spec.Service.Disabled {
+		names.Service = ""
+	}
+	return names
+}
+
+// loadAdditionalCAs reads the additional CAs of the TektonConfig from the ConfigMaps and Secrets
+// of the operator namespace, in the order of the sources. It returns an error when a source or
+// its key is missing or holds anything else than PEM encoded certificates.
+func (r *rbac) loadAdditionalCAs(ctx context.Context) (string, error) {
+	if r.tektonConfig == nil || r.tektonConfig.Spec.Platforms.OpenShift.CABundles == nil {
+		return "", nil
+	}
+	var certificates string
+	for _, source := range r.tektonConfig.Spec.Platforms.OpenShift.CABundles.Additional {
+		var data, ref string
+		switch {
+		case source.ConfigMap != nil:
+			ref = fmt.Sprintf("configmap %s/%s key %s", r.operatorNamespace, source.ConfigMap.Name, source.ConfigMap.Key)
+			cm, err := r.kubeClientSet.CoreV1().ConfigMaps(r.operatorNamespace).Get(ctx, source.ConfigMap.Name, metav1.GetOptions{})
+			if err != nil {
+				return "", fmt.Errorf("failed to get the additional CAs of %s: %w", ref, err)
+			}
+			value, ok := cm.Data[source.ConfigMap.Key]
+			if !ok {
+				return "", fmt.Errorf("the additional CAs of %s are missing", ref)
+			}
+			data = value
+		case source.Secret != nil:
+			ref = fmt.Sprintf("secret %s/%s key %s", r.operatorNamespace, source.Secret.Name, source.Secret.Key)
+			secret, err := r.kubeClientSet.CoreV1().Secrets(r.operatorNamespace).Get(ctx, source.Secret.Name, metav1.GetOptions{})
+			if err != nil {
+				return "", fmt.Errorf("failed to get the additional CAs of %s: %w", ref, err)
+			}
+			value, ok := secret.Data[source.Secret.Key]
+			if !ok {
+				return "", fmt.Errorf("the additional CAs of %s are missing", ref)
+			}
+			data = string(value)
+		default:
+			continue
+		}
+		pem, err := reconcilerCommon.PEMCertificates(data)
+		if err != nil {
+			return "", fmt.Errorf("invalid additional CAs of %s: %w", ref, err)
+		}
+		certificates = reconcilerCommon.AppendCertificates(certificates, pem)
+	}
+	return certificates, nil
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonconfig
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/openshift/namespacerbac"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+)
+
+func testCACertificate(t *testing.T) string {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NilError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "proxy.example.com"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NilError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func TestCABundleConfigMaps(t *testing.T) {
+	assert.Equal(t, caBundleConfigMaps(nil), namespacerbac.DefaultCABundleConfigMaps)
+
+	tc := &v1alpha1.TektonConfig{}
+	assert.Equal(t, caBundleConfigMaps(tc), namespacerbac.DefaultCABundleConfigMaps)
+
+	tc.Spec.Platforms.OpenShift.CABundles = &v1alpha1.CABundles{
+		Trusted: v1alpha1.CABundleConfigMap{Name: "proxy-cabundle"},
+		Service: v1alpha1.CABundleConfigMap{Name: "service-cabundle", Disabled: true},
+	}
+	assert.Equal(t, caBundleConfigMaps(tc), namespacerbac.CABundleConfigMaps{Trusted: "proxy-cabundle"})
+}
+
+func TestLoadAdditionalCAs(t *testing.T) {
+	proxyCert := testCACertificate(t)
+	registryCert := testCACertificate(t)
+	kubeClient := kubefake.NewSimpleClientset(
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "proxy-ca", Namespace: "openshift-operators"},
+			Data:       map[string]string{"ca.crt": proxyCert, "invalid": "proxy.example.com"},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "registry-ca", Namespace: "openshift-operators"},
+			Data:       map[string][]byte{"ca.crt": []byte(registryCert)},
+		},
+	)
+	tc := &v1alpha1.TektonConfig{}
+	r := &rbac{kubeClientSet: kubeClient, tektonConfig: tc, operatorNamespace: "openshift-operators"}
+
+	certificates, err := r.loadAdditionalCAs(context.TODO())
+	assert.NilError(t, err)
+	assert.Equal(t, certificates, "")
+
+	// the certificates are appended in the order of the sources
+	tc.Spec.Platforms.OpenShift.CABundles = &v1alpha1.CABundles{Additional: []v1alpha1.CABundleSource{
+		{Secret: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "registry-ca"}, Key: "ca.crt"}},
+		{ConfigMap: &corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "proxy-ca"}, Key: "ca.crt"}},
+	}}
+	certificates, err = r.loadAdditionalCAs(context.TODO())
+	assert.NilError(t, err)
+	assert.Equal(t, certificates, registryCert+proxyCert)
+
+	// the sources which are missing or hold anything else than certificates fail the reconcile
+	tc.Spec.Platforms.OpenShift.CABundles.Additional[1].ConfigMap.Key = "invalid"
+	_, err = r.loadAdditionalCAs(context.TODO())
+	assert.ErrorContains(t, err, "invalid additional CAs of configmap openshift-operators/proxy-ca key invalid: not a PEM block")
+
+	tc.Spec.Platforms.OpenShift.CABundles.Additional[1].ConfigMap.Key = "tls.crt"
+	_, err = r.loadAdditionalCAs(context.TODO())
+	assert.ErrorContains(t, err, "the additional CAs of configmap openshift-operators/proxy-ca key tls.crt are missing")
+
+	tc.Spec.Platforms.OpenShift.CABundles.Additional[0].Secret.Name = "missing-ca"
+	_, err = r.loadAdditionalCAs(context.TODO())
+	assert.ErrorContains(t, err, "failed to get the additional CAs of secret openshift-operators/missing-ca key ca.crt")
+}
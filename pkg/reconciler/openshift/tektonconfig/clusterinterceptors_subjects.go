@@ -0,0 +1,144 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonconfig
+
+import (
+	"context"
+	"strings"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"knative.dev/pkg/logging"
+)
+
+const (
+	clusterInterceptorsSubjectsParamName = v1alpha1.ClusterInterceptorsSubjectsParam
+
+	// clusterInterceptorsServiceAccounts binds the pipeline ServiceAccount of each namespace
+	clusterInterceptorsServiceAccounts = "serviceAccounts"
+	// clusterInterceptorsNamespaceGroups binds the group of the ServiceAccounts of each namespace
+	clusterInterceptorsNamespaceGroups = "namespaceGroups"
+	// clusterInterceptorsAllServiceAccounts binds the group of all the ServiceAccounts of the
+	// cluster, the ClusterRoleBinding is not updated when namespaces are created or deleted
+	clusterInterceptorsAllServiceAccounts = "allServiceAccounts"
+
+	// serviceAccountsGroup is the group of all the ServiceAccounts, followed by ":<namespace>"
+	// it is the group of the ServiceAccounts of a namespace
+	serviceAccountsGroup = "system:serviceaccounts"
+)
+
+// clusterInterceptorsSubjectsMode returns how the namespaces are bound to the
+// openshift-pipelines-clusterinterceptors ClusterRole
+func (r *rbac) clusterInterceptorsSubjectsMode() string {
+	for _, v := range r.tektonConfig.Spec.Params {
+		if v.Name == clusterInterceptorsSubjectsParamName && v.Value != "" {
+			return v.Value
+		}
+	}
+	return clusterInterceptorsServiceAccounts
+}
+
+// clusterInterceptorsSubject returns the subject binding the namespace to the
+// openshift-pipelines-clusterinterceptors ClusterRole
+func clusterInterceptorsSubject(mode, namespace, serviceAccount string) rbacv1.Subject {
+	switch mode {
+	case clusterInterceptorsNamespaceGroups:
+		return rbacv1.Subject{Kind: rbacv1.GroupKind, APIGroup: rbacv1.GroupName, Name: serviceAccountsGroup + ":" + namespace}
+	case clusterInterceptorsAllServiceAccounts:
+		return rbacv1.Subject{Kind: rbacv1.GroupKind, APIGroup: rbacv1.GroupName, Name: serviceAccountsGroup}
+	default:
+		return rbacv1.Subject{Kind: rbacv1.ServiceAccountKind, Name: serviceAccount, Namespace: namespace}
+	}
+}
+
+// isClusterInterceptorsSubject checks whether the subject is one created in the mode
+func isClusterInterceptorsSubject(mode string, s rbacv1.Subject) bool {
+	switch mode {
+	case clusterInterceptorsNamespaceGroups:
+		return s.Kind == rbacv1.GroupKind && strings.HasPrefix(s.Name, serviceAccountsGroup+":")
+	case clusterInterceptorsAllServiceAccounts:
+		return s.Kind == rbacv1.GroupKind && s.Name == serviceAccountsGroup
+	default:
+		return s.Kind == rbacv1.ServiceAccountKind
+	}
+}
+
+// subjectNamespace returns the namespace bound by the subject, it is empty for the
+// group of all the ServiceAccounts
+func subjectNamespace(s rbacv1.Subject) string {
+	if s.Kind == rbacv1.GroupKind {
+		return strings.TrimPrefix(strings.TrimPrefix(s.Name, serviceAccountsGroup), ":")
+	}
+	return s.Namespace
+}
+
+// ensureClusterInterceptorsSubjectsMode rewrites the subjects of the
+// openshift-pipelines-clusterinterceptors ClusterRoleBinding when they were created in
+// another mode, from the namespaces which are already reconciled
+func (r *rbac) ensureClusterInterceptorsSubjectsMode(ctx context.Context) error {
+	logger := logging.FromContext(ctx)
+	mode := r.clusterInterceptorsSubjectsMode()
+
+	rb, err := r.rbacInformer.Lister().Get(clusterInterceptors)
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	upToDate := true
+	for _, s := range rb.Subjects {
+		if !isClusterInterceptorsSubject(mode, s) {
+			upToDate = false
+			break
+		}
+	}
+	if mode == clusterInterceptorsAllServiceAccounts {
+		upToDate = upToDate && len(rb.Subjects) == 1
+	}
+	if upToDate {
+		return nil
+	}
+
+	var subjects []rbacv1.Subject
+	if mode == clusterInterceptorsAllServiceAccounts {
+		subjects = []rbacv1.Subject{clusterInterceptorsSubject(mode, "", "")}
+	} else {
+		req, err := labels.NewRequirement(namespaceVersionLabel, selection.Equals, []string{r.version})
+		if err != nil {
+			return err
+		}
+		namespaces, err := r.nsInformer.Lister().List(labels.NewSelector().Add(*req))
+		if err != nil {
+			return err
+		}
+		for _, ns := range namespaces {
+			subjects = append(subjects, clusterInterceptorsSubject(mode, ns.Name, r.serviceAccountName()))
+		}
+	}
+
+	logger.Infof("binding %s to %d subjects in mode %s", clusterInterceptors, len(subjects), mode)
+	rb = rb.DeepCopy()
+	rb.Subjects = subjects
+	_, err = r.kubeClientSet.RbacV1().ClusterRoleBindings().Update(ctx, rb, metav1.UpdateOptions{})
+	return err
+}
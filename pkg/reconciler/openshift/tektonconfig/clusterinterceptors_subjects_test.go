@@ -0,0 +1,116 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonconfig
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubeinformers "k8s.io/client-go/informers"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestEnsureClusterInterceptorsSubjectsMode(t *testing.T) {
+	saSubject := func(ns string) rbacv1.Subject {
+		return rbacv1.Subject{Kind: rbacv1.ServiceAccountKind, Name: pipelineSA, Namespace: ns}
+	}
+	groupSubject := func(name string) rbacv1.Subject {
+		return rbacv1.Subject{Kind: rbacv1.GroupKind, APIGroup: rbacv1.GroupName, Name: name}
+	}
+	tests := []struct {
+		name     string
+		mode     string
+		subjects []rbacv1.Subject
+		want     []rbacv1.Subject
+	}{
+		{
+			name:     "service accounts are up to date",
+			mode:     clusterInterceptorsServiceAccounts,
+			subjects: []rbacv1.Subject{saSubject("ns-1")},
+			want:     []rbacv1.Subject{saSubject("ns-1")},
+		},
+		{
+			name:     "service accounts replaced by namespace groups",
+			mode:     clusterInterceptorsNamespaceGroups,
+			subjects: []rbacv1.Subject{saSubject("ns-1"), saSubject("ns-2")},
+			want:     []rbacv1.Subject{groupSubject("system:serviceaccounts:ns-1"), groupSubject("system:serviceaccounts:ns-2")},
+		},
+		{
+			name:     "namespace groups replaced by all service accounts",
+			mode:     clusterInterceptorsAllServiceAccounts,
+			subjects: []rbacv1.Subject{groupSubject("system:serviceaccounts:ns-1")},
+			want:     []rbacv1.Subject{groupSubject("system:serviceaccounts")},
+		},
+		{
+			name:     "all service accounts replaced by service accounts",
+			mode:     clusterInterceptorsServiceAccounts,
+			subjects: []rbacv1.Subject{groupSubject("system:serviceaccounts")},
+			want:     []rbacv1.Subject{saSubject("ns-1"), saSubject("ns-2")},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := context.TODO()
+			crb := &rbacv1.ClusterRoleBinding{
+				ObjectMeta: metav1.ObjectMeta{Name: clusterInterceptors},
+				RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: clusterInterceptors},
+				Subjects:   test.subjects,
+			}
+			kubeClient := kubefake.NewSimpleClientset(crb)
+			informers := kubeinformers.NewSharedInformerFactory(kubeClient, 0)
+			rbacInformer := informers.Rbac().V1().ClusterRoleBindings()
+			nsInformer := informers.Core().V1().Namespaces()
+			assert.NilError(t, rbacInformer.Informer().GetIndexer().Add(crb))
+			for _, name := range []string{"ns-1", "ns-2", "ns-3"} {
+				ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}}
+				// only the namespaces which are reconciled are bound
+				if name != "ns-3" {
+					ns.Labels = map[string]string{namespaceVersionLabel: "test-version"}
+				}
+				assert.NilError(t, nsInformer.Informer().GetIndexer().Add(ns))
+			}
+
+			r := &rbac{
+				kubeClientSet: kubeClient,
+				rbacInformer:  rbacInformer,
+				nsInformer:    nsInformer,
+				version:       "test-version",
+				tektonConfig: &v1alpha1.TektonConfig{
+					Spec: v1alpha1.TektonConfigSpec{
+						Params: []v1alpha1.Param{{Name: v1alpha1.ClusterInterceptorsSubjectsParam, Value: test.mode}},
+					},
+				},
+			}
+			assert.NilError(t, r.ensureClusterInterceptorsSubjectsMode(ctx))
+
+			got, err := kubeClient.RbacV1().ClusterRoleBindings().Get(ctx, clusterInterceptors, metav1.GetOptions{})
+			assert.NilError(t, err)
+			assert.Assert(t, CompareSubjects(got.Subjects, test.want), "got subjects %v", got.Subjects)
+		})
+	}
+}
+
+func TestSubjectNamespace(t *testing.T) {
+	assert.Equal(t, subjectNamespace(rbacv1.Subject{Kind: rbacv1.ServiceAccountKind, Name: pipelineSA, Namespace: "ns-1"}), "ns-1")
+	assert.Equal(t, subjectNamespace(rbacv1.Subject{Kind: rbacv1.GroupKind, Name: "system:serviceaccounts:ns-1"}), "ns-1")
+	assert.Equal(t, subjectNamespace(rbacv1.Subject{Kind: rbacv1.GroupKind, Name: "system:serviceaccounts"}), "")
+}
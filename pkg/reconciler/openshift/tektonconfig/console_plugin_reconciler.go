@@ -118,7 +118,11 @@ func (cpr *consolePluginReconciler) reconcile(ctx context.Context, tektonConfigC
 
 	if !doCreateInstallerSet {
 		// compute hash from the deployed installerSet
-		deployedHash, err := cpr.getHash(deployedInstallerSet.Spec.Manifests)
+		deployedManifests, err := common.InstallerSetManifests(ctx, &deployedInstallerSet)
+		if err != nil {
+			return err
+		}
+		deployedHash, err := cpr.getHash(deployedManifests)
 		if err != nil {
 			return err
 		}
@@ -207,6 +211,7 @@ func (cpr *consolePluginReconciler) transform(ctx context.Context, manifest *mf.
 		common.ReplaceNamespace(tektonConfigCR.Spec.TargetNamespace),
 		cpr.transformerConsolePlugin(tektonConfigCR.Spec.TargetNamespace),
 		common.AddConfiguration(tektonConfigCR.Spec.Config),
+		common.ControlPlaneProxy(tektonConfigCR.Spec.Config),
 	}
 
 	if cpr.pipelinesConsolePluginImage != "" {
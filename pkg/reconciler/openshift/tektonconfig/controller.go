@@ -18,11 +18,15 @@ package tektonconfig
 
 import (
 	"context"
+	"time"
 
 	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
 	openshiftpipelinesascodeinformer "github.com/tektoncd/operator/pkg/client/injection/informers/operator/v1alpha1/openshiftpipelinesascode"
 	tektonAddoninformer "github.com/tektoncd/operator/pkg/client/injection/informers/operator/v1alpha1/tektonaddon"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
 	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/cache"
 	"knative.dev/pkg/configmap"
 	"knative.dev/pkg/controller"
@@ -33,18 +37,27 @@ import (
 // Registers eventhandlers to enqueue events
 func NewController(ctx context.Context, cmw configmap.Watcher) *controller.Impl {
 	logger := logging.FromContext(ctx)
-	ctrl := tektonconfig.NewExtensibleController(OpenShiftExtension)(ctx, cmw)
+	ext := newOpenShiftExtension(ctx)
+	ctrl := tektonconfig.NewExtensibleController(func(context.Context) common.Extension { return ext })(ctx, cmw)
 	if _, err := tektonAddoninformer.Get(ctx).Informer().AddEventHandler(cache.FilteringResourceEventHandler{
 		FilterFunc: controller.FilterController(&v1alpha1.TektonConfig{}),
-		Handler:    controller.HandleAll(ctrl.EnqueueControllerOf),
+		Handler:    common.HandleReadinessChanges(ctrl.EnqueueControllerOf),
 	}); err != nil {
 		logger.Panicf("Couldn't register TektonAddon informer event handler: %w", err)
 	}
 	if _, err := openshiftpipelinesascodeinformer.Get(ctx).Informer().AddEventHandler(cache.FilteringResourceEventHandler{
 		FilterFunc: controller.FilterController(&v1alpha1.TektonConfig{}),
-		Handler:    controller.HandleAll(ctrl.EnqueueControllerOf),
+		Handler:    common.HandleReadinessChanges(ctrl.EnqueueControllerOf),
 	}); err != nil {
 		logger.Panicf("Couldn't register OpenShiftPipelinesAsCode informer event handler: %w", err)
 	}
+	// the namespaces and the workloads are reconciled again when the trusted CA certificates of the cluster rotate
+	watchClusterTrustBundle(ctx, ext.kubeClientSet, ext.namespaces, func(delay time.Duration) {
+		ctrl.EnqueueKeyAfter(types.NamespacedName{Name: v1alpha1.ConfigResourceName}, delay)
+	})
+	// the reconciles between the events of the namespaces run the full passes over the namespaces
+	go wait.Until(func() {
+		ctrl.EnqueueKey(types.NamespacedName{Name: v1alpha1.ConfigResourceName})
+	}, namespaceResyncInterval, ctx.Done())
 	return ctrl
 }
@@ -0,0 +1,178 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonconfig
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/tektoncd/operator/pkg/reconciler/common"
+	"github.com/tektoncd/operator/pkg/reconciler/openshift"
+	"github.com/tektoncd/operator/pkg/reconciler/openshift/namespacerbac"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/hash"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/logging"
+)
+
+const (
+	// editSubjectsManagedAnnotation holds the subjects added to the openshift-pipelines-edit
+	// RoleBinding by the operator, the other subjects of the RoleBinding are never removed
+	editSubjectsManagedAnnotation = "openshift-pipelines.tekton.dev/managed-subjects"
+	// editSubjectsHashAnnotation holds the hash of the subjects added to the openshift-pipelines-edit
+	// RoleBinding of a namespace, the namespace is reconciled again when they change
+	editSubjectsHashAnnotation = "openshift-pipelines.tekton.dev/edit-subjects-hash"
+)
+
+// editSubjectsInNamespace returns the groups and users to bind to the edit ClusterRole in the
+// namespace, the annotation of the namespace overrides the subjects of the TektonConfig
+func (r *rbac) editSubjectsInNamespace(ns *corev1.Namespace) ([]rbacv1.Subject, error) {
+	if value, ok := ns.Annotations[openshift.NamespaceEditSubjectsAnnotation]; ok {
+		subjects, err := parseEditSubjects(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid annotation %s on namespace %s: %w", openshift.NamespaceEditSubjectsAnnotation, ns.Name, err)
+		}
+		return subjects, nil
+	}
+	editRB := r.tektonConfig.Spec.Platforms.OpenShift.EditRoleBinding
+	if editRB == nil {
+		return nil, nil
+	}
+	subjects := make([]rbacv1.Subject, 0, len(editRB.Subjects))
+	for _, s := range editRB.Subjects {
+		subjects = append(subjects, rbacv1.Subject{APIGroup: rbacv1.GroupName, Kind: s.Kind, Name: s.Name})
+	}
+	return subjects, nil
+}
+
+// editSubjectsHash returns the hash of the subjects added to the openshift-pipelines-edit
+// RoleBinding of the namespace, it is empty if no subject is added
+func (r *rbac) editSubjectsHash(ns *corev1.Namespace) (string, error) {
+	subjects, err := r.editSubjectsInNamespace(ns)
+	if err != nil || len(subjects) == 0 {
+		return "", err
+	}
+	return hash.Compute(formatEditSubjects(subjects))
+}
+
+// ensureEditRoleBindingSubjects adds the configured groups and users to the openshift-pipelines-edit
+// RoleBinding of the namespace, and removes the subjects it added before which are no longer
+// configured. The subjects added by other means are kept.
+func (r *rbac) ensureEditRoleBindingSubjects(ctx context.Context, ns *corev1.Namespace) error {
+	logger := logging.FromContext(ctx)
+
+	desired, err := r.editSubjectsInNamespace(ns)
+	if err != nil {
+		return err
+	}
+	// the rolebinding is read again on conflicts, as other subjects may be added concurrently
+	updated := false
+	err = common.Retry(ctx, "update rolebinding subjects", func() error {
+		var err error
+		updated, err = r.updateEditRoleBindingSubjects(ctx, ns, desired)
+		return err
+	})
+	if err != nil || !updated {
+		return err
+	}
+	logger.Infof("updated the subjects of rolebinding %s/%s", ns.Name, PipelineRoleBinding)
+	return nil
+}
+
+// updateEditRoleBindingSubjects updates the subjects of the openshift-pipelines-edit RoleBinding,
+// it returns true when the RoleBinding is updated
+func (r *rbac) updateEditRoleBindingSubjects(ctx context.Context, ns *corev1.Namespace, desired []rbacv1.Subject) (bool, error) {
+	logger := logging.FromContext(ctx)
+	rbClient := r.kubeClientSet.RbacV1().RoleBindings(ns.Name)
+
+	rb, err := rbClient.Get(ctx, PipelineRoleBinding, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	previous, err := parseEditSubjects(rb.Annotations[editSubjectsManagedAnnotation])
+	if err != nil {
+		logger.Warnf("ignoring invalid annotation %s on rolebinding %s/%s: %v", editSubjectsManagedAnnotation, ns.Name, PipelineRoleBinding, err)
+		previous = nil
+	}
+
+	subjects := []rbacv1.Subject{}
+	for _, s := range rb.Subjects {
+		if namespacerbac.HasSubject(previous, s) && !namespacerbac.HasSubject(desired, s) {
+			logger.Infof("removing %s %s from rolebinding %s/%s", s.Kind, s.Name, ns.Name, PipelineRoleBinding)
+			continue
+		}
+		subjects = append(subjects, s)
+	}
+	subjects = namespacerbac.MergeSubjects(subjects, desired)
+
+	managed := formatEditSubjects(desired)
+	if namespacerbac.CompareSubjects(subjects, rb.Subjects) && rb.Annotations[editSubjectsManagedAnnotation] == managed {
+		return false, nil
+	}
+	rb = rb.DeepCopy()
+	rb.Subjects = subjects
+	if managed == "" {
+		delete(rb.Annotations, editSubjectsManagedAnnotation)
+	} else {
+		if rb.Annotations == nil {
+			rb.Annotations = map[string]string{}
+		}
+		rb.Annotations[editSubjectsManagedAnnotation] = managed
+	}
+	if _, err := rbClient.Update(ctx, rb, metav1.UpdateOptions{}); err != nil {
+		return false, fmt.Errorf("failed to update the subjects of rolebinding %s/%s: %w", ns.Name, PipelineRoleBinding, err)
+	}
+	return true, nil
+}
+
+// parseEditSubjects parses a comma separated list of Kind:Name, the kind is Group or User
+func parseEditSubjects(value string) ([]rbacv1.Subject, error) {
+	subjects := []rbacv1.Subject{}
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		kind, name, ok := strings.Cut(entry, ":")
+		kind, name = strings.TrimSpace(kind), strings.TrimSpace(name)
+		if !ok || name == "" || (kind != rbacv1.GroupKind && kind != rbacv1.UserKind) {
+			return nil, fmt.Errorf("invalid subject %q, must be %s:<name> or %s:<name>", entry, rbacv1.GroupKind, rbacv1.UserKind)
+		}
+		subject := rbacv1.Subject{APIGroup: rbacv1.GroupName, Kind: kind, Name: name}
+		if !namespacerbac.HasSubject(subjects, subject) {
+			subjects = append(subjects, subject)
+		}
+	}
+	return subjects, nil
+}
+
+// formatEditSubjects returns the subjects as a sorted comma separated list of Kind:Name
+func formatEditSubjects(subjects []rbacv1.Subject) string {
+	entries := make([]string, 0, len(subjects))
+	for _, s := range subjects {
+		entries = append(entries, s.Kind+":"+s.Name)
+	}
+	sort.Strings(entries)
+	return strings.Join(entries, ",")
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonconfig
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/openshift"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+)
+
+func editSubjectsRBAC(subjects ...v1alpha1.EditRoleBindingSubject) *rbac {
+	return &rbac{
+		kubeClientSet: kubefake.NewSimpleClientset(&rbacv1.RoleBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: PipelineRoleBinding, Namespace: "ci"},
+			RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: "edit"},
+			Subjects: []rbacv1.Subject{
+				{Kind: rbacv1.ServiceAccountKind, Name: pipelineSA, Namespace: "ci"},
+				{APIGroup: rbacv1.GroupName, Kind: rbacv1.UserKind, Name: "added-by-hand"},
+			},
+		}),
+		tektonConfig: &v1alpha1.TektonConfig{
+			Spec: v1alpha1.TektonConfigSpec{
+				Platforms: v1alpha1.Platforms{
+					OpenShift: v1alpha1.OpenShift{
+						EditRoleBinding: &v1alpha1.EditRoleBinding{Subjects: subjects},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestEnsureEditRoleBindingSubjects(t *testing.T) {
+	ctx := context.TODO()
+	r := editSubjectsRBAC(
+		v1alpha1.EditRoleBindingSubject{Kind: rbacv1.GroupKind, Name: "developers"},
+		v1alpha1.EditRoleBindingSubject{Kind: rbacv1.UserKind, Name: "alice"},
+	)
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ci"}}
+	rbClient := r.kubeClientSet.RbacV1().RoleBindings("ci")
+	sa := rbacv1.Subject{Kind: rbacv1.ServiceAccountKind, Name: pipelineSA, Namespace: "ci"}
+	byHand := rbacv1.Subject{APIGroup: rbacv1.GroupName, Kind: rbacv1.UserKind, Name: "added-by-hand"}
+	developers := rbacv1.Subject{APIGroup: rbacv1.GroupName, Kind: rbacv1.GroupKind, Name: "developers"}
+	alice := rbacv1.Subject{APIGroup: rbacv1.GroupName, Kind: rbacv1.UserKind, Name: "alice"}
+
+	assert.NilError(t, r.ensureEditRoleBindingSubjects(ctx, ns))
+	rb, err := rbClient.Get(ctx, PipelineRoleBinding, metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, rb.Subjects, []rbacv1.Subject{sa, byHand, developers, alice})
+	assert.Equal(t, rb.Annotations[editSubjectsManagedAnnotation], "Group:developers,User:alice")
+
+	// a subject removed from the TektonConfig is removed, the subjects added by hand are kept
+	r.tektonConfig.Spec.Platforms.OpenShift.EditRoleBinding.Subjects = r.tektonConfig.Spec.Platforms.OpenShift.EditRoleBinding.Subjects[:1]
+	assert.NilError(t, r.ensureEditRoleBindingSubjects(ctx, ns))
+	rb, err = rbClient.Get(ctx, PipelineRoleBinding, metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, rb.Subjects, []rbacv1.Subject{sa, byHand, developers})
+	assert.Equal(t, rb.Annotations[editSubjectsManagedAnnotation], "Group:developers")
+
+	// the namespace annotation overrides the subjects of the TektonConfig
+	ns.Annotations = map[string]string{openshift.NamespaceEditSubjectsAnnotation: "Group:team-ci, User:bob"}
+	assert.NilError(t, r.ensureEditRoleBindingSubjects(ctx, ns))
+	rb, err = rbClient.Get(ctx, PipelineRoleBinding, metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, rb.Subjects, []rbacv1.Subject{sa, byHand,
+		{APIGroup: rbacv1.GroupName, Kind: rbacv1.GroupKind, Name: "team-ci"},
+		{APIGroup: rbacv1.GroupName, Kind: rbacv1.UserKind, Name: "bob"},
+	})
+
+	// an empty annotation removes all the managed subjects in the namespace
+	ns.Annotations[openshift.NamespaceEditSubjectsAnnotation] = ""
+	assert.NilError(t, r.ensureEditRoleBindingSubjects(ctx, ns))
+	rb, err = rbClient.Get(ctx, PipelineRoleBinding, metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, rb.Subjects, []rbacv1.Subject{sa, byHand})
+	_, found := rb.Annotations[editSubjectsManagedAnnotation]
+	assert.Assert(t, !found)
+
+	ns.Annotations[openshift.NamespaceEditSubjectsAnnotation] = "ServiceAccount:default"
+	assert.ErrorContains(t, r.ensureEditRoleBindingSubjects(ctx, ns), `invalid subject "ServiceAccount:default"`)
+}
+
+func TestNeedsRBACForEditSubjects(t *testing.T) {
+	ctx := context.TODO()
+	r := editSubjectsRBAC(v1alpha1.EditRoleBindingSubject{Kind: rbacv1.GroupKind, Name: "developers"})
+	r.version = "v1"
+	_, err := r.kubeClientSet.RbacV1().RoleBindings("ci").Create(ctx, &rbacv1.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: pipelinesSCCRoleBinding, Namespace: "ci"},
+		RoleRef:    rbacv1.RoleRef{Kind: "ClusterRole", Name: pipelinesSCCClusterRole},
+	}, metav1.CreateOptions{})
+	assert.NilError(t, err)
+
+	ns := corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+		Name:   "ci",
+		Labels: map[string]string{namespaceVersionLabel: "v1"},
+	}}
+	needed, err := r.needsRBAC(ctx, ns, true)
+	assert.NilError(t, err)
+	assert.Assert(t, needed, "namespace without the hash annotation must be reconciled")
+
+	desiredHash, err := r.editSubjectsHash(&ns)
+	assert.NilError(t, err)
+	ns.Annotations = map[string]string{editSubjectsHashAnnotation: desiredHash}
+	needed, err = r.needsRBAC(ctx, ns, true)
+	assert.NilError(t, err)
+	assert.Assert(t, !needed)
+
+	// overriding the subjects in the namespace reconciles it again
+	ns.Annotations[openshift.NamespaceEditSubjectsAnnotation] = "User:alice"
+	needed, err = r.needsRBAC(ctx, ns, true)
+	assert.NilError(t, err)
+	assert.Assert(t, needed)
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonconfig
+
+import (
+	"context"
+
+	reconcilerCommon "github.com/tektoncd/operator/pkg/reconciler/common"
+	"github.com/tektoncd/operator/pkg/reconciler/openshift/namespacerbac"
+	"knative.dev/pkg/logging"
+)
+
+// operatorEventLabel marks the events emitted by the operator in the namespaces, with their reason
+const operatorEventLabel = reconcilerCommon.OperatorEventLabel
+
+// namespaceEvents returns the events of the reconcile, limited by the burst configured through
+// the TektonConfig params
+func (r *rbac) namespaceEvents(ctx context.Context) *reconcilerCommon.Events {
+	if r.events == nil {
+		burst, _ := reconcilerCommon.EventsConfig(ctx, r.tektonConfig)
+		r.events = reconcilerCommon.NewEvents(r.kubeClientSet, burst)
+	}
+	return r.events
+}
+
+// cleanupStaleEvents deletes the events of the operator which did not occur within the TTL
+// configured through the TektonConfig params
+func (r *rbac) cleanupStaleEvents(ctx context.Context) error {
+	_, ttl := reconcilerCommon.EventsConfig(ctx, r.tektonConfig)
+	return reconcilerCommon.CleanupStaleEvents(ctx, r.kubeClientSet, ttl)
+}
+
+// recordMutation emits an event in the namespace for a change of its resources made by the
+// operator, so that the owners of the namespace can audit them. The events are informational,
+// the errors are logged without failing the namespace.
+func (r *rbac) recordMutation(ctx context.Context, m namespacerbac.Mutation) {
+	err := r.namespaceEvents(ctx).Emit(ctx, reconcilerCommon.Event{
+		Object:              m.Object,
+		Owner:               &r.ownerRef,
+		Type:                m.Type,
+		Reason:              m.Reason,
+		Action:              m.Reason,
+		Message:             m.Message,
+		ReportingController: "openshift-pipelines-operator",
+		ReportingInstance:   r.ownerRef.Name,
+	})
+	if err != nil {
+		logging.FromContext(ctx).Errorf("failed to create event %s in namespace %s: %v", m.Reason, m.Object.Namespace, err)
+	}
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonconfig
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/openshift/namespacerbac"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestSCCFailureEventsAggregation(t *testing.T) {
+	ctx := context.TODO()
+	kubeClient := fake.NewSimpleClientset()
+	// the fake clientset does not generate the names
+	generated := 0
+	kubeClient.PrependReactor("create", "events", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		event := action.(k8stesting.CreateAction).GetObject().(*corev1.Event)
+		generated++
+		event.Name = fmt.Sprintf("%s%d", event.GenerateName, generated)
+		return false, nil, nil
+	})
+	r := &rbac{kubeClientSet: kubeClient, tektonConfig: &v1alpha1.TektonConfig{
+		Spec: v1alpha1.TektonConfigSpec{Params: []v1alpha1.Param{{Name: v1alpha1.OperatorEventBurstParam, Value: "3"}}},
+	}}
+
+	// the repeated failure increments the count of the event
+	assert.NilError(t, r.createSCCFailureEventInNamespace(ctx, "team-a", "restricted-v3"))
+	assert.NilError(t, r.createSCCFailureEventInNamespace(ctx, "team-a", "restricted-v3"))
+	assert.NilError(t, r.createSCCFailureEventInNamespace(ctx, "team-a", "anyuid-v2"))
+	events, err := kubeClient.CoreV1().Events("team-a").List(ctx, metav1.ListOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, len(events.Items), 2)
+	counts := map[string]int32{}
+	for _, e := range events.Items {
+		assert.Equal(t, e.Labels[operatorEventLabel], "RequestedSCCNotFound")
+		counts[e.Message] = e.Count
+	}
+	assert.Equal(t, counts["SCC 'restricted-v3' requested in annotation 'operator.tekton.dev/scc' not found, SCC not updated in the namespace"], int32(2))
+
+	// the events above the burst are dropped
+	assert.NilError(t, r.createSCCFailureEventInNamespace(ctx, "team-b", "restricted-v3"))
+	events, err = kubeClient.CoreV1().Events("team-b").List(ctx, metav1.ListOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, len(events.Items), 0)
+}
+
+func TestRecordMutation(t *testing.T) {
+	ctx := context.TODO()
+	kubeClient := fake.NewSimpleClientset()
+	r := &rbac{
+		kubeClientSet: kubeClient,
+		tektonConfig:  &v1alpha1.TektonConfig{},
+		ownerRef:      metav1.OwnerReference{APIVersion: "operator.tekton.dev/v1alpha1", Kind: "TektonInstallerSet", Name: "rhosp-rbac-abcde"},
+	}
+
+	r.recordMutation(ctx, namespacerbac.Mutation{
+		Object:  corev1.ObjectReference{Kind: "RoleBinding", APIVersion: "rbac.authorization.k8s.io/v1", Namespace: "team-a", Name: "openshift-pipelines-edit"},
+		Type:    corev1.EventTypeWarning,
+		Reason:  namespacerbac.ReasonRoleBindingRecreated,
+		Message: "recreated RoleBinding openshift-pipelines-edit to change its role from ClusterRole edit to ClusterRole view",
+	})
+	events, err := kubeClient.CoreV1().Events("team-a").List(ctx, metav1.ListOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, len(events.Items), 1)
+	e := events.Items[0]
+	assert.Equal(t, e.Type, corev1.EventTypeWarning)
+	assert.Equal(t, e.Reason, "RoleBindingRecreated")
+	assert.Equal(t, e.Labels[operatorEventLabel], "RoleBindingRecreated")
+	assert.Equal(t, e.InvolvedObject.Name, "openshift-pipelines-edit")
+	assert.Equal(t, e.OwnerReferences[0].Name, "rhosp-rbac-abcde")
+}
+
+func TestCleanupStaleEvents(t *testing.T) {
+	ctx := context.TODO()
+	event := func(name string, last time.Time, labels map[string]string) *corev1.Event {
+		return &corev1.Event{
+			ObjectMeta:    metav1.ObjectMeta{Name: name, Namespace: "team-a", Labels: labels},
+			LastTimestamp: metav1.NewTime(last),
+		}
+	}
+	operatorLabels := map[string]string{operatorEventLabel: "RequestedSCCNotFound"}
+	kubeClient := fake.NewSimpleClientset(
+		event("stale", time.Now().Add(-2*time.Hour), operatorLabels),
+		event("recent", time.Now().Add(-10*time.Minute), operatorLabels),
+		event("not-operator", time.Now().Add(-2*time.Hour), nil),
+	)
+	r := &rbac{kubeClientSet: kubeClient, tektonConfig: &v1alpha1.TektonConfig{
+		Spec: v1alpha1.TektonConfigSpec{Params: []v1alpha1.Param{{Name: v1alpha1.OperatorEventTTLParam, Value: "1h"}}},
+	}}
+
+	assert.NilError(t, r.cleanupStaleEvents(ctx))
+	events, err := kubeClient.CoreV1().Events("team-a").List(ctx, metav1.ListOptions{})
+	assert.NilError(t, err)
+	names := []string{}
+	for _, e := range events.Items {
+		names = append(names, e.Name)
+	}
+	assert.DeepEqual(t, names, []string{"not-operator", "recent"})
+}
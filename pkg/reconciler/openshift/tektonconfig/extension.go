@@ -21,15 +21,21 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"time"
 
 	mf "github.com/manifestival/manifestival"
 	security "github.com/openshift/client-go/security/clientset/versioned"
 	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
 	"github.com/tektoncd/operator/pkg/client/clientset/versioned"
+	operatorinformers "github.com/tektoncd/operator/pkg/client/informers/externalversions/operator/v1alpha1"
 	operatorclient "github.com/tektoncd/operator/pkg/client/injection/client"
+	namespacestatusinformer "github.com/tektoncd/operator/pkg/client/injection/informers/operator/v1alpha1/tektonnamespacestatus"
 	pkgCommon "github.com/tektoncd/operator/pkg/common"
 	"github.com/tektoncd/operator/pkg/reconciler/common"
 	"github.com/tektoncd/operator/pkg/reconciler/openshift/tektonconfig/extension"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/permissions"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/dependency"
+	pipelineversioned "github.com/tektoncd/pipeline/pkg/client/clientset/versioned"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	nsV1 "k8s.io/client-go/informers/core/v1"
@@ -38,7 +44,9 @@ import (
 	kubeclient "knative.dev/pkg/client/injection/kube/client"
 	namespaceinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/namespace"
 	rbacInformer "knative.dev/pkg/client/injection/kube/informers/rbac/v1/clusterrolebinding"
+	"knative.dev/pkg/injection"
 	"knative.dev/pkg/logging"
+	"knative.dev/pkg/system"
 )
 
 const (
@@ -46,6 +54,10 @@ const (
 )
 
 func OpenShiftExtension(ctx context.Context) common.Extension {
+	return newOpenShiftExtension(ctx)
+}
+
+func newOpenShiftExtension(ctx context.Context) openshiftExtension {
 	logger := logging.FromContext(ctx)
 	operatorVer, err := common.OperatorVersion(ctx)
 	if err != nil {
@@ -57,9 +69,22 @@ func OpenShiftExtension(ctx context.Context) common.Extension {
 		kubeClientSet:     kubeclient.Get(ctx),
 		rbacInformer:      rbacInformer.Get(ctx),
 		nsInformer:        namespaceinformer.Get(ctx),
+		nsStatusInformer:  namespacestatusinformer.Get(ctx),
 		securityClientSet: pkgCommon.GetSecurityClient(ctx),
 		operatorVersion:   operatorVer,
+		namespaces:        newNamespaceTracker(),
+		subjectsGC:        newSubjectsGarbageCollector(),
+		capabilities:      permissions.NewCapabilityProbe(kubeclient.Get(ctx), subsystems...),
+		trustBundle:       newTrustBundleRotation(kubeclient.Get(ctx)),
 	}
+	// the subsystems missing permissions are reported on startup
+	ext.capabilities.Capabilities(ctx)
+
+	pipelineClientSet, err := pipelineversioned.NewForConfig(injection.GetConfig(ctx))
+	if err != nil {
+		logger.Fatal(err)
+	}
+	ext.activity = newActivityTracker(ctx, ext.kubeClientSet, pipelineClientSet, ext.nsInformer)
 
 	ext.consolePluginReconciler = &consolePluginReconciler{
 		resourcesYamlDirectory: filepath.Join(common.ComponentBaseDir(), consolePluginReconcileYamlDirectory),
@@ -76,7 +101,17 @@ type openshiftExtension struct {
 	kubeClientSet           kubernetes.Interface
 	rbacInformer            rbacV1.ClusterRoleBindingInformer
 	nsInformer              nsV1.NamespaceInformer
+	nsStatusInformer        operatorinformers.TektonNamespaceStatusInformer
 	consolePluginReconciler *consolePluginReconciler
+	activity                *activityTracker
+	// namespaces tracks the namespaces changed since the last reconcile
+	namespaces *namespaceTracker
+	// subjectsGC schedules the removal of the subjects of the deleted namespaces
+	subjectsGC *subjectsGarbageCollector
+	// capabilities probes the subsystems enabled with the permissions of the operator
+	capabilities *permissions.CapabilityProbe
+	// trustBundle restarts the workloads when the trusted CA certificates of the cluster rotate
+	trustBundle *trustBundleRotation
 
 	// OpenShift clientsets are a bit... special, we need to get each
 	// clientset separately
@@ -93,6 +128,11 @@ func (oe openshiftExtension) Transformers(comp v1alpha1.TektonComponent) []mf.Tr
 	}
 }
 
+// NamespaceChanged records the namespaces changed since the last reconcile
+func (oe openshiftExtension) NamespaceChanged(obj interface{}) {
+	oe.namespaces.NamespaceChanged(obj)
+}
+
 func (oe openshiftExtension) PreReconcile(ctx context.Context, tc v1alpha1.TektonComponent) error {
 	config := tc.(*v1alpha1.TektonConfig)
 	r := rbac{
@@ -101,9 +141,16 @@ func (oe openshiftExtension) PreReconcile(ctx context.Context, tc v1alpha1.Tekto
 		securityClientSet: oe.securityClientSet,
 		rbacInformer:      oe.rbacInformer,
 		nsInformer:        oe.nsInformer,
+		nsStatusInformer:  oe.nsStatusInformer,
 		version:           os.Getenv(versionKey),
 		tektonConfig:      config,
+		activity:          oe.activity,
+		namespaces:        oe.namespaces,
+		subjectsGC:        oe.subjectsGC,
+		capabilities:      oe.capabilities.Capabilities(ctx),
+		operatorNamespace: system.Namespace(),
 	}
+	r.markSubsystems()
 
 	// set openshift specific defaults
 	r.setDefault()
@@ -158,23 +205,56 @@ func (oe openshiftExtension) PreReconcile(ctx context.Context, tc v1alpha1.Tekto
 		}
 	}
 
-	// TODO: Remove this after v0.55.0 release, by following a depreciation notice
-	// --------------------
-	if err := r.cleanUpRBACNameChange(ctx); err != nil {
+	// remove the artifacts left by older releases, the RBAC resources are created with the
+	// remaining artifacts reported in the status
+	if err := r.migrateLegacyArtifacts(ctx); err != nil {
+		logging.FromContext(ctx).Errorf("failed to migrate the legacy artifacts: %v", err)
+	}
+
+	if err := r.createResources(ctx); err != nil {
 		return err
 	}
-	// --------------------
 
-	return r.createResources(ctx)
+	// the workloads are restarted by the next reconciles when they fail to be restarted
+	if err := oe.trustBundle.reconcile(ctx, config); err != nil {
+		logging.FromContext(ctx).Errorf("failed to restart the workloads with the rotated trusted CA certificates: %v", err)
+	}
+
+	if !r.subsystemEnabled(ctx, permissions.SubsystemSCC) {
+		return nil
+	}
+
+	// the namespaces are migrated to the SCC annotation once reconciled, the progress is reported in the status
+	if r.subsystemEnabled(ctx, permissions.SubsystemNamespacePatching) {
+		if err := r.migrateSCCAnnotations(ctx); err != nil {
+			logging.FromContext(ctx).Errorf("failed to migrate the namespaces to the SCC annotation: %v", err)
+		}
+	}
+
+	// the report is informational, failing to generate it does not fail the reconcile
+	if err := r.reportSCCUsage(ctx, time.Now()); err != nil {
+		logging.FromContext(ctx).Errorf("failed to report the SCC usage: %v", err)
+	}
+	return nil
 }
 
 func (oe openshiftExtension) PostReconcile(ctx context.Context, comp v1alpha1.TektonComponent) error {
 	configInstance := comp.(*v1alpha1.TektonConfig)
 
+	// the TektonAddon waiting on its dependencies is skipped, the other components are still
+	// ensured and the reconcile is requeued once they are
+	waitingOnDependencies := false
 	if configInstance.Spec.Profile == v1alpha1.ProfileAll {
-		if _, err := extension.EnsureTektonAddonExists(ctx, oe.operatorClientSet.OperatorV1alpha1().TektonAddons(), configInstance, oe.operatorVersion); err != nil {
-			configInstance.Status.MarkComponentNotReady(fmt.Sprintf("TektonAddon: %s", err.Error()))
-			return v1alpha1.REQUEUE_EVENT_AFTER
+		switch err := dependency.Wait(ctx, oe.operatorClientSet, configInstance, dependency.Addon); {
+		case err == v1alpha1.REQUEUE_EVENT_AFTER:
+			waitingOnDependencies = true
+		case err != nil:
+			return err
+		default:
+			if _, err := extension.EnsureTektonAddonExists(ctx, oe.operatorClientSet.OperatorV1alpha1().TektonAddons(), configInstance, oe.operatorVersion); err != nil {
+				configInstance.Status.MarkComponentNotReady(fmt.Sprintf("TektonAddon: %s", err.Error()))
+				return v1alpha1.REQUEUE_EVENT_AFTER
+			}
 		}
 	}
 	if configInstance.Spec.Profile == v1alpha1.ProfileLite || configInstance.Spec.Profile == v1alpha1.ProfileBasic {
@@ -196,7 +276,13 @@ func (oe openshiftExtension) PostReconcile(ctx context.Context, comp v1alpha1.Te
 	}
 
 	// execute console plugin reconciler
-	return oe.consolePluginReconciler.reconcile(ctx, configInstance)
+	if err := oe.consolePluginReconciler.reconcile(ctx, configInstance); err != nil {
+		return err
+	}
+	if waitingOnDependencies {
+		return v1alpha1.REQUEUE_EVENT_AFTER
+	}
+	return nil
 }
 
 func (oe openshiftExtension) Finalize(ctx context.Context, comp v1alpha1.TektonComponent) error {
@@ -215,8 +301,9 @@ func (oe openshiftExtension) Finalize(ctx context.Context, comp v1alpha1.TektonC
 	r := rbac{
 		kubeClientSet: oe.kubeClientSet,
 		version:       os.Getenv(versionKey),
+		tektonConfig:  configInstance,
 	}
-	return r.cleanUp(ctx)
+	return r.finalizeNamespaces(ctx)
 }
 
 // configOwnerRef returns owner reference pointing to passed instance
@@ -234,8 +321,9 @@ func changeOwnerRefOfPreExistingSA(ctx context.Context, kc kubernetes.Interface,
 	if err != nil {
 		return err
 	}
+	saName := serviceAccountName(&tc)
 	for _, sa := range allSAs.Items {
-		if sa.Name == "pipeline" && !nsRegex.MatchString(sa.Namespace) {
+		if sa.Name == saName && !nsRegex.MatchString(sa.Namespace) {
 			// set tektonconfig ownerRef
 			tcOwnerRef := tektonConfigOwnerRef(tc)
 			sa.SetOwnerReferences([]metav1.OwnerReference{tcOwnerRef})
@@ -0,0 +1,223 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonconfig
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/logging"
+)
+
+const (
+	namespaceFailurePolicyParamName    = v1alpha1.NamespaceFailurePolicyParam
+	namespaceFailureThresholdParamName = v1alpha1.NamespaceFailureThresholdParam
+
+	// namespaceFailurePolicyContinue logs per-namespace failures and carries on with the others
+	namespaceFailurePolicyContinue = "continue"
+	// namespaceFailurePolicyFailFast fails the reconcile on the first per-namespace failure
+	namespaceFailurePolicyFailFast = "failFast"
+	// namespaceFailurePolicyThreshold fails the reconcile once the percentage of
+	// failed namespaces, out of all the namespaces in scope, exceeds the threshold
+	namespaceFailurePolicyThreshold = "threshold"
+
+	defaultNamespaceFailureThreshold = 10
+
+	// maxReportedNamespaceFailures limits the namespaces listed in the condition message
+	maxReportedNamespaceFailures = 5
+	// maxRBACStatusNamespaces limits the failed namespaces listed in the status
+	maxRBACStatusNamespaces = 50
+)
+
+// namespaceFailures tracks the outcome of the per-namespace reconciliation for a failure policy
+type namespaceFailures struct {
+	policy    string
+	threshold int
+	processed map[string]bool
+	failed    map[string]error
+	// inScope is the number of namespaces managed by the operator, the passes between the
+	// full passes only process the changed and the failed ones
+	inScope int
+	// earlier are the errors of all the namespaces which failed before this pass, the
+	// failures of the namespaces not processed by this pass still count
+	earlier map[string]string
+}
+
+// namespaceFailurePolicy returns the failure policy configured through the TektonConfig params
+func (r *rbac) namespaceFailurePolicy(ctx context.Context) *namespaceFailures {
+	logger := logging.FromContext(ctx)
+
+	f := &namespaceFailures{
+		policy:    namespaceFailurePolicyContinue,
+		threshold: defaultNamespaceFailureThreshold,
+		processed: map[string]bool{},
+		failed:    map[string]error{},
+		earlier:   r.namespaces.failures(),
+	}
+	for _, v := range r.tektonConfig.Spec.Params {
+		switch v.Name {
+		case namespaceFailurePolicyParamName:
+			switch v.Value {
+			case namespaceFailurePolicyContinue, namespaceFailurePolicyFailFast, namespaceFailurePolicyThreshold:
+				f.policy = v.Value
+			default:
+				logger.Warnf("invalid value %q for param %s, using %s", v.Value, namespaceFailurePolicyParamName, namespaceFailurePolicyContinue)
+			}
+		case namespaceFailureThresholdParamName:
+			threshold, err := strconv.Atoi(strings.TrimSuffix(v.Value, "%"))
+			if err != nil || threshold < 0 || threshold > 100 {
+				logger.Warnf("invalid value %q for param %s, using default %d%%", v.Value, namespaceFailureThresholdParamName, defaultNamespaceFailureThreshold)
+				continue
+			}
+			f.threshold = threshold
+		}
+	}
+	return f
+}
+
+// process records a namespace as being reconciled
+func (f *namespaceFailures) process(namespace string) {
+	f.processed[namespace] = true
+}
+
+// record records a failure in a namespace, with the failFast policy the
+// returned error stops the reconcile
+func (f *namespaceFailures) record(namespace string, err error) error {
+	f.processed[namespace] = true
+	if _, ok := f.failed[namespace]; !ok {
+		f.failed[namespace] = err
+	}
+	if f.policy == namespaceFailurePolicyFailFast {
+		return fmt.Errorf("failed reconciling namespace %s: %w", namespace, err)
+	}
+	return nil
+}
+
+// exceeded returns an error if the failures exceed the configured policy
+func (f *namespaceFailures) exceeded() error {
+	switch f.policy {
+	case namespaceFailurePolicyFailFast:
+		if len(f.failed) > 0 {
+			return fmt.Errorf("%s", f.summary())
+		}
+	case namespaceFailurePolicyThreshold:
+		failed := f.failedCount()
+		total := max(f.inScope, len(f.processed))
+		if failed*100 > f.threshold*total {
+			return fmt.Errorf("%s, %d failed namespaces exceed the threshold of %d%% of %d namespaces", f.summary(), failed, f.threshold, total)
+		}
+	}
+	return nil
+}
+
+// failedCount returns the number of failed namespaces, including the earlier failures of the
+// namespaces not processed by this pass
+func (f *namespaceFailures) failedCount() int {
+	count := len(f.failed)
+	for ns := range f.earlier {
+		if !f.processed[ns] {
+			count++
+		}
+	}
+	return count
+}
+
+// summary describes the failed namespaces
+func (f *namespaceFailures) summary() string {
+	if len(f.failed) == 0 {
+		return fmt.Sprintf("%d namespaces reconciled", len(f.processed))
+	}
+	names := make([]string, 0, len(f.failed))
+	for ns := range f.failed {
+		names = append(names, ns)
+	}
+	sort.Strings(names)
+
+	details := []string{}
+	for i, ns := range names {
+		if i == maxReportedNamespaceFailures {
+			details = append(details, fmt.Sprintf("and %d more", len(names)-i))
+			break
+		}
+		details = append(details, fmt.Sprintf("%s: %v", ns, f.failed[ns]))
+	}
+	return fmt.Sprintf("failed reconciling %d of %d namespaces (%s)", len(f.failed), len(f.processed), strings.Join(details, "; "))
+}
+
+// rbacStatus returns the namespaces which failed to be reconciled. The failures of the namespaces
+// which were not processed by the last reconcile, e.g. skipped by the failFast policy, are kept
+// until the namespaces are reconciled or deleted. They are taken from the index of the namespaces,
+// which holds all of them, and from the status, which only lists the first ones, after a restart.
+func (r *rbac) rbacStatus(failures *namespaceFailures) *v1alpha1.RBACStatus {
+	since := map[string]metav1.Time{}
+	failed := map[string]string{}
+	if previous := r.tektonConfig.Status.RBAC; previous != nil {
+		for _, f := range previous.FailedNamespaces {
+			since[f.Namespace] = f.Since
+			if !failures.processed[f.Namespace] && r.namespaceExists(f.Namespace) {
+				failed[f.Namespace] = f.Reason
+			}
+		}
+	}
+	for ns, reason := range failures.earlier {
+		if !failures.processed[ns] && r.namespaceExists(ns) {
+			failed[ns] = reason
+		}
+	}
+	for ns, err := range failures.failed {
+		failed[ns] = err.Error()
+	}
+	if len(failed) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(failed))
+	for ns := range failed {
+		names = append(names, ns)
+	}
+	sort.Strings(names)
+
+	status := &v1alpha1.RBACStatus{FailedCount: len(names)}
+	now := metav1.Now()
+	for _, ns := range names[:min(len(names), maxRBACStatusNamespaces)] {
+		first, ok := since[ns]
+		if !ok {
+			first = now
+		}
+		status.FailedNamespaces = append(status.FailedNamespaces, v1alpha1.NamespaceFailure{
+			Namespace: ns,
+			Reason:    failed[ns],
+			Since:     first,
+		})
+	}
+	return status
+}
+
+// namespaceExists returns false once the namespace is deleted
+func (r *rbac) namespaceExists(name string) bool {
+	if r.nsInformer == nil {
+		return true
+	}
+	_, err := r.nsInformer.Lister().Get(name)
+	return !apierrors.IsNotFound(err)
+}
@@ -0,0 +1,211 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonconfig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubeinformers "k8s.io/client-go/informers"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestNamespaceFailurePolicy(t *testing.T) {
+	tests := []struct {
+		name          string
+		params        []v1alpha1.Param
+		wantPolicy    string
+		wantThreshold int
+	}{
+		{
+			name:          "continue by default",
+			wantPolicy:    namespaceFailurePolicyContinue,
+			wantThreshold: defaultNamespaceFailureThreshold,
+		},
+		{
+			name: "threshold with percentage",
+			params: []v1alpha1.Param{
+				{Name: namespaceFailurePolicyParamName, Value: namespaceFailurePolicyThreshold},
+				{Name: namespaceFailureThresholdParamName, Value: "25%"},
+			},
+			wantPolicy:    namespaceFailurePolicyThreshold,
+			wantThreshold: 25,
+		},
+		{
+			name: "invalid values fall back to defaults",
+			params: []v1alpha1.Param{
+				{Name: namespaceFailurePolicyParamName, Value: "strict"},
+				{Name: namespaceFailureThresholdParamName, Value: "120"},
+			},
+			wantPolicy:    namespaceFailurePolicyContinue,
+			wantThreshold: defaultNamespaceFailureThreshold,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &rbac{tektonConfig: &v1alpha1.TektonConfig{Spec: v1alpha1.TektonConfigSpec{Params: tt.params}}}
+			f := r.namespaceFailurePolicy(context.TODO())
+			assert.Equal(t, f.policy, tt.wantPolicy)
+			assert.Equal(t, f.threshold, tt.wantThreshold)
+		})
+	}
+}
+
+func TestNamespaceFailures(t *testing.T) {
+	newFailures := func(policy string, threshold int) *namespaceFailures {
+		return &namespaceFailures{policy: policy, threshold: threshold, processed: map[string]bool{}, failed: map[string]error{}}
+	}
+	errFailed := errors.New("forbidden")
+
+	// continue never fails the reconcile
+	f := newFailures(namespaceFailurePolicyContinue, 0)
+	for i := 0; i < 10; i++ {
+		assert.NilError(t, f.record(fmt.Sprintf("ns-%d", i), errFailed))
+	}
+	assert.NilError(t, f.exceeded())
+	assert.Equal(t, f.summary(), "failed reconciling 10 of 10 namespaces (ns-0: forbidden; ns-1: forbidden; ns-2: forbidden; ns-3: forbidden; ns-4: forbidden; and 5 more)")
+
+	// failFast stops on the first failure
+	f = newFailures(namespaceFailurePolicyFailFast, 0)
+	f.process("ns-a")
+	assert.ErrorContains(t, f.record("ns-b", errFailed), "failed reconciling namespace ns-b: forbidden")
+
+	// threshold fails once the failed percentage exceeds it
+	f = newFailures(namespaceFailurePolicyThreshold, 20)
+	for i := 0; i < 8; i++ {
+		f.process(fmt.Sprintf("ns-%d", i))
+	}
+	assert.NilError(t, f.record("ns-8", errFailed))
+	assert.NilError(t, f.record("ns-9", errFailed))
+	assert.NilError(t, f.exceeded())
+	// a namespace failing twice is counted once
+	assert.NilError(t, f.record("ns-9", errFailed))
+	assert.NilError(t, f.exceeded())
+	assert.NilError(t, f.record("ns-0", errFailed))
+	assert.ErrorContains(t, f.exceeded(), "failed reconciling 3 of 10 namespaces")
+
+	// the threshold is a percentage of the namespaces in scope, not of the ones processed by a pass
+	// over the changed namespaces
+	f = newFailures(namespaceFailurePolicyThreshold, 10)
+	f.inScope = 100
+	f.process("ns-a")
+	f.process("ns-b")
+	assert.NilError(t, f.record("ns-c", errFailed))
+	assert.NilError(t, f.exceeded())
+	for i := 0; i < 10; i++ {
+		assert.NilError(t, f.record(fmt.Sprintf("ns-%d", i), errFailed))
+	}
+	assert.ErrorContains(t, f.exceeded(), "11 failed namespaces exceed the threshold of 10% of 100 namespaces")
+
+	// the earlier failures of the namespaces not processed by a pass still count
+	f = newFailures(namespaceFailurePolicyThreshold, 10)
+	f.inScope = 100
+	f.earlier = map[string]string{"ns-a": "forbidden"}
+	for i := 0; i < 10; i++ {
+		f.earlier[fmt.Sprintf("ns-%d", i)] = "forbidden"
+	}
+	// ns-a is reconciled by the pass
+	f.process("ns-a")
+	assert.NilError(t, f.exceeded())
+	assert.NilError(t, f.record("ns-b", errFailed))
+	assert.ErrorContains(t, f.exceeded(), "11 failed namespaces exceed the threshold of 10% of 100 namespaces")
+}
+
+func TestMarkNamespacesOutcome(t *testing.T) {
+	tc := &v1alpha1.TektonConfig{}
+	r := &rbac{tektonConfig: tc}
+	f := &namespaceFailures{policy: namespaceFailurePolicyContinue, processed: map[string]bool{}, failed: map[string]error{}}
+
+	f.process("ns-a")
+	r.markNamespacesOutcome(f)
+	assert.Equal(t, tc.Status.GetCondition(v1alpha1.NamespacesReconciled).Status, corev1.ConditionTrue)
+
+	assert.NilError(t, f.record("ns-b", errors.New("forbidden")))
+	r.markNamespacesOutcome(f)
+	condition := tc.Status.GetCondition(v1alpha1.NamespacesReconciled)
+	assert.Equal(t, condition.Status, corev1.ConditionFalse)
+	assert.Equal(t, condition.Message, "failed reconciling 1 of 2 namespaces (ns-b: forbidden)")
+}
+
+func TestRBACStatus(t *testing.T) {
+	informers := kubeinformers.NewSharedInformerFactory(kubefake.NewSimpleClientset(), 0)
+	nsInformer := informers.Core().V1().Namespaces()
+	for _, name := range []string{"ns-a", "ns-b", "ns-c"} {
+		assert.NilError(t, nsInformer.Informer().GetIndexer().Add(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}}))
+	}
+	tc := &v1alpha1.TektonConfig{}
+	r := &rbac{tektonConfig: tc, nsInformer: nsInformer}
+	newFailures := func() *namespaceFailures {
+		return &namespaceFailures{policy: namespaceFailurePolicyFailFast, processed: map[string]bool{}, failed: map[string]error{}}
+	}
+
+	f := newFailures()
+	f.process("ns-a")
+	_ = f.record("ns-c", errors.New("forbidden"))
+	_ = f.record("ns-b", errors.New("conflict"))
+	r.markNamespacesOutcome(f)
+	assert.Equal(t, tc.Status.RBAC.FailedCount, 2)
+	failed := tc.Status.RBAC.FailedNamespaces
+	assert.Equal(t, len(failed), 2)
+	assert.Equal(t, failed[0].Namespace, "ns-b")
+	assert.Equal(t, failed[0].Reason, "conflict")
+	assert.Equal(t, failed[1].Namespace, "ns-c")
+	since := failed[1].Since
+
+	// the failure of a namespace not processed is kept, with the time of its first failure
+	f = newFailures()
+	f.process("ns-b")
+	r.markNamespacesOutcome(f)
+	assert.Equal(t, tc.Status.RBAC.FailedCount, 1)
+	assert.DeepEqual(t, tc.Status.RBAC.FailedNamespaces, []v1alpha1.NamespaceFailure{{Namespace: "ns-c", Reason: "forbidden", Since: since}})
+
+	// the failures of the namespaces not listed in the status are kept from the index of the namespaces
+	for i := 0; i < maxRBACStatusNamespaces+10; i++ {
+		assert.NilError(t, nsInformer.Informer().GetIndexer().Add(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("ns-x%03d", i)}}))
+	}
+	f = newFailures()
+	for i := 0; i < maxRBACStatusNamespaces+10; i++ {
+		_ = f.record(fmt.Sprintf("ns-x%03d", i), errors.New("forbidden"))
+	}
+	r.markNamespacesOutcome(f)
+	assert.Equal(t, tc.Status.RBAC.FailedCount, maxRBACStatusNamespaces+11)
+	assert.Equal(t, len(tc.Status.RBAC.FailedNamespaces), maxRBACStatusNamespaces)
+	earlier := map[string]string{}
+	for ns, err := range f.failed {
+		earlier[ns] = err.Error()
+	}
+	earlier["ns-c"] = "forbidden"
+	f = newFailures()
+	f.earlier = earlier
+	f.process("ns-b")
+	r.markNamespacesOutcome(f)
+	assert.Equal(t, tc.Status.RBAC.FailedCount, maxRBACStatusNamespaces+11)
+	for i := 0; i < maxRBACStatusNamespaces+10; i++ {
+		assert.NilError(t, nsInformer.Informer().GetIndexer().Delete(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("ns-x%03d", i)}}))
+	}
+
+	// the failures of the deleted namespaces are dropped
+	assert.NilError(t, nsInformer.Informer().GetIndexer().Delete(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ns-c"}}))
+	r.markNamespacesOutcome(newFailures())
+	assert.Assert(t, tc.Status.RBAC == nil)
+}
@@ -0,0 +1,285 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonconfig
+
+import (
+	"context"
+	goerrors "errors"
+	"fmt"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	reconcilerCommon "github.com/tektoncd/operator/pkg/reconciler/common"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"knative.dev/pkg/logging"
+)
+
+const (
+	// migrationBatchSize is the maximum number of legacy artifacts removed by a reconcile, the
+	// status update of TektonConfig triggers the reconcile removing the next batch
+	migrationBatchSize = 50
+	// migrationListedArtifacts is the maximum number of artifacts listed by migration in the status
+	migrationListedArtifacts = 10
+)
+
+// legacyArtifact is a resource, or a label of a resource, left by an older release of the operator
+type legacyArtifact struct {
+	kind      string
+	namespace string
+	name      string
+}
+
+func (a legacyArtifact) String() string {
+	if a.namespace == "" {
+		return fmt.Sprintf("%s %s", a.kind, a.name)
+	}
+	return fmt.Sprintf("%s %s/%s", a.kind, a.namespace, a.name)
+}
+
+// legacyMigration finds the artifacts of older releases of the operator and migrates them
+type legacyMigration struct {
+	name        string
+	description string
+	// find returns the artifacts not migrated yet
+	find func(ctx context.Context) ([]legacyArtifact, error)
+	// migrate migrates an artifact returned by find
+	migrate func(ctx context.Context, artifact legacyArtifact) error
+}
+
+func (r *rbac) legacyMigrations() []legacyMigration {
+	return []legacyMigration{{
+		name:        "rbac-installerset-name",
+		description: fmt.Sprintf("TektonInstallerSet %s replaced by the %s installer sets", rbacInstallerSetNameOld, rbacInstallerSetNamePrefix),
+		find:        r.findObsoleteRBACInstallerSet,
+		migrate:     r.removeObsoleteRBACInstallerSet,
+	}, {
+		name: "edit-rolebinding",
+		description: fmt.Sprintf("RoleBindings %s binding the %s ServiceAccount or owned by a TektonInstallerSet, replaced by %s",
+			pipelineRoleBindingOld, pipelineSA, PipelineRoleBinding),
+		find:    r.findLegacyEditRoleBindings,
+		migrate: r.migrateLegacyEditRoleBinding,
+	}, {
+		name:        "namespace-labels",
+		description: "namespace labels of the RBAC resources or CA bundle ConfigMaps which are no longer created",
+		find:        r.findStaleNamespaceLabels,
+		migrate:     r.removeStaleNamespaceLabel,
+	}}
+}
+
+// migrateLegacyArtifacts removes at most migrationBatchSize legacy artifacts and reports the
+// remaining ones in the status of TektonConfig
+func (r *rbac) migrateLegacyArtifacts(ctx context.Context) error {
+	logger := logging.FromContext(ctx)
+	status := &v1alpha1.LegacyMigrationStatus{}
+	if previous := r.tektonConfig.Status.LegacyMigration; previous != nil {
+		status.Removed = previous.Removed
+	}
+
+	var errs []error
+	budget := migrationBatchSize
+	for _, m := range r.legacyMigrations() {
+		artifacts, err := m.find(ctx)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to find the legacy artifacts of migration %s: %w", m.name, err))
+			continue
+		}
+		remaining := artifacts[:0:0]
+		for _, artifact := range artifacts {
+			if budget == 0 {
+				remaining = append(remaining, artifact)
+				continue
+			}
+			budget--
+			logger.Infof("migration %s: removing legacy %s", m.name, artifact)
+			if err := m.migrate(ctx, artifact); err != nil {
+				errs = append(errs, fmt.Errorf("migration %s failed on %s: %w", m.name, artifact, err))
+				remaining = append(remaining, artifact)
+				continue
+			}
+			status.Removed++
+		}
+		if len(remaining) == 0 {
+			continue
+		}
+		migration := v1alpha1.LegacyMigration{Name: m.name, Description: m.description, Remaining: len(remaining)}
+		for i := 0; i < len(remaining) && i < migrationListedArtifacts; i++ {
+			migration.Artifacts = append(migration.Artifacts, remaining[i].String())
+		}
+		status.Migrations = append(status.Migrations, migration)
+		status.Remaining += len(remaining)
+	}
+
+	err := goerrors.Join(errs...)
+	if err != nil {
+		status.Error = err.Error()
+	}
+	if status.Removed == 0 && status.Remaining == 0 && err == nil {
+		// no legacy artifact was ever found
+		r.tektonConfig.Status.LegacyMigration = nil
+		return nil
+	}
+	if status.Remaining > 0 {
+		logger.Infof("%d legacy artifacts remaining to be removed", status.Remaining)
+	}
+	r.tektonConfig.Status.LegacyMigration = status
+	return err
+}
+
+func (r *rbac) findObsoleteRBACInstallerSet(ctx context.Context) ([]legacyArtifact, error) {
+	_, err := r.operatorClientSet.OperatorV1alpha1().TektonInstallerSets().Get(ctx, rbacInstallerSetNameOld, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return []legacyArtifact{{kind: "TektonInstallerSet", name: rbacInstallerSetNameOld}}, nil
+}
+
+func (r *rbac) removeObsoleteRBACInstallerSet(ctx context.Context, artifact legacyArtifact) error {
+	isClient := r.operatorClientSet.OperatorV1alpha1().TektonInstallerSets()
+	err := isClient.Delete(ctx, artifact.name, metav1.DeleteOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// findLegacyEditRoleBindings returns the 'edit' RoleBindings of the namespaces binding the
+// 'pipeline' ServiceAccount or owned by the RBAC TektonInstallerSet, the 'pipeline'
+// ServiceAccount is bound by the 'openshift-pipelines-edit' RoleBinding
+func (r *rbac) findLegacyEditRoleBindings(ctx context.Context) ([]legacyArtifact, error) {
+	rbs, err := r.kubeClientSet.RbacV1().RoleBindings(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("metadata.name", pipelineRoleBindingOld).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	var artifacts []legacyArtifact
+	for i := range rbs.Items {
+		rb := &rbs.Items[i]
+		// ignore ns with name passing regex `^(openshift|kube)-`
+		if rb.Name != pipelineRoleBindingOld || nsRegex.MatchString(rb.Namespace) {
+			continue
+		}
+		if pipelineSubjectIndex(rb) >= 0 || installerSetOwnerIndex(rb) >= 0 {
+			artifacts = append(artifacts, legacyArtifact{kind: "RoleBinding", namespace: rb.Namespace, name: rb.Name})
+		}
+	}
+	return artifacts, nil
+}
+
+// migrateLegacyEditRoleBinding removes the 'pipeline' ServiceAccount from the subjects of the
+// 'edit' RoleBinding and its TektonInstallerSet owner reference, the RoleBinding is deleted
+// when the 'pipeline' ServiceAccount was its only subject
+func (r *rbac) migrateLegacyEditRoleBinding(ctx context.Context, artifact legacyArtifact) error {
+	rbacClient := r.kubeClientSet.RbacV1().RoleBindings(artifact.namespace)
+	editRB, err := rbacClient.Get(ctx, artifact.name, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	subIdx := pipelineSubjectIndex(editRB)
+	if subIdx >= 0 {
+		editRB.Subjects = append(editRB.Subjects[:subIdx], editRB.Subjects[subIdx+1:]...)
+	}
+	// nobody else is using the RoleBinding
+	if len(editRB.Subjects) == 0 {
+		err := rbacClient.Delete(ctx, editRB.GetName(), metav1.DeleteOptions{})
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	ownerRefIdx := installerSetOwnerIndex(editRB)
+	if ownerRefIdx >= 0 {
+		ownerRefs := editRB.GetOwnerReferences()
+		editRB.SetOwnerReferences(append(ownerRefs[:ownerRefIdx], ownerRefs[ownerRefIdx+1:]...))
+	}
+	if ownerRefIdx < 0 && subIdx < 0 {
+		return nil
+	}
+	_, err = rbacClient.Update(ctx, editRB, metav1.UpdateOptions{})
+	return err
+}
+
+func pipelineSubjectIndex(rb *rbacv1.RoleBinding) int {
+	depSub := rbacv1.Subject{Kind: rbacv1.ServiceAccountKind, Name: pipelineSA, Namespace: rb.Namespace}
+	for i, s := range rb.Subjects {
+		if s.Name == depSub.Name && s.Kind == depSub.Kind && s.Namespace == depSub.Namespace {
+			return i
+		}
+	}
+	return -1
+}
+
+func installerSetOwnerIndex(rb *rbacv1.RoleBinding) int {
+	for i, ownerRef := range rb.GetOwnerReferences() {
+		if ownerRef.Kind == "TektonInstallerSet" {
+			return i
+		}
+	}
+	return -1
+}
+
+// staleNamespaceLabels returns the labels of the namespaces which are no longer reconciled, the
+// reconcile version label when the RBAC resources are not created and the trusted configmaps
+// version label when the CA bundle ConfigMaps are not created
+func (r *rbac) staleNamespaceLabels() []string {
+	var stale []string
+	for _, v := range r.tektonConfig.Spec.Params {
+		if v.Name == rbacParamName && v.Value == "false" {
+			stale = append(stale, namespaceVersionLabel)
+		}
+		if v.Name == trustedCABundleParamName && v.Value == "false" {
+			stale = append(stale, namespaceTrustedConfigLabel)
+		}
+	}
+	return stale
+}
+
+func (r *rbac) findStaleNamespaceLabels(ctx context.Context) ([]legacyArtifact, error) {
+	var artifacts []legacyArtifact
+	for _, label := range r.staleNamespaceLabels() {
+		namespaces, err := r.kubeClientSet.CoreV1().Namespaces().List(ctx, metav1.ListOptions{LabelSelector: label})
+		if err != nil {
+			return nil, err
+		}
+		for _, ns := range namespaces.Items {
+			if _, ok := ns.Labels[label]; ok {
+				artifacts = append(artifacts, legacyArtifact{kind: "NamespaceLabel", namespace: ns.Name, name: label})
+			}
+		}
+	}
+	return artifacts, nil
+}
+
+func (r *rbac) removeStaleNamespaceLabel(ctx context.Context, artifact legacyArtifact) error {
+	err := reconcilerCommon.PatchNamespaceMetadata(ctx, r.kubeClientSet, artifact.namespace, reconcilerCommon.NamespaceMetadataPatch{
+		RemoveLabels: []string{artifact.name},
+	})
+	if errors.IsNotFound(err) || goerrors.Is(err, reconcilerCommon.ErrNamespaceTerminating) {
+		return nil
+	}
+	return err
+}
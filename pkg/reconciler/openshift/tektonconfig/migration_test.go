@@ -0,0 +1,130 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonconfig
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	operatorfake "github.com/tektoncd/operator/pkg/client/clientset/versioned/fake"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+)
+
+func legacyEditRoleBinding(namespace string, subjects ...rbacv1.Subject) *rbacv1.RoleBinding {
+	return &rbacv1.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: pipelineRoleBindingOld, Namespace: namespace,
+			OwnerReferences: []metav1.OwnerReference{{Kind: "TektonInstallerSet", Name: rbacInstallerSetNameOld}}},
+		RoleRef:  rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: "edit"},
+		Subjects: subjects,
+	}
+}
+
+func TestMigrateLegacyArtifacts(t *testing.T) {
+	ctx := context.TODO()
+	pipeline := func(ns string) rbacv1.Subject {
+		return rbacv1.Subject{Kind: rbacv1.ServiceAccountKind, Name: pipelineSA, Namespace: ns}
+	}
+	alice := rbacv1.Subject{Kind: rbacv1.UserKind, Name: "alice"}
+	kubeClient := kubefake.NewSimpleClientset(
+		legacyEditRoleBinding("team-a", pipeline("team-a")),
+		legacyEditRoleBinding("team-b", pipeline("team-b"), alice),
+		legacyEditRoleBinding("openshift-config", pipeline("openshift-config")),
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-c",
+			Labels: map[string]string{namespaceTrustedConfigLabel: "v0.50.0"}}},
+	)
+	operatorClient := operatorfake.NewSimpleClientset(
+		&v1alpha1.TektonInstallerSet{ObjectMeta: metav1.ObjectMeta{Name: rbacInstallerSetNameOld}},
+	)
+	r := &rbac{
+		kubeClientSet:     kubeClient,
+		operatorClientSet: operatorClient,
+		tektonConfig: &v1alpha1.TektonConfig{Spec: v1alpha1.TektonConfigSpec{Params: []v1alpha1.Param{
+			{Name: trustedCABundleParamName, Value: "false"},
+		}}},
+	}
+
+	assert.NilError(t, r.migrateLegacyArtifacts(ctx))
+	assert.DeepEqual(t, r.tektonConfig.Status.LegacyMigration, &v1alpha1.LegacyMigrationStatus{Removed: 4})
+	_, err := operatorClient.OperatorV1alpha1().TektonInstallerSets().Get(ctx, rbacInstallerSetNameOld, metav1.GetOptions{})
+	assert.Assert(t, errors.IsNotFound(err))
+	// the 'pipeline' ServiceAccount was the only subject
+	_, err = kubeClient.RbacV1().RoleBindings("team-a").Get(ctx, pipelineRoleBindingOld, metav1.GetOptions{})
+	assert.Assert(t, errors.IsNotFound(err))
+	rb, err := kubeClient.RbacV1().RoleBindings("team-b").Get(ctx, pipelineRoleBindingOld, metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, rb.Subjects, []rbacv1.Subject{alice})
+	assert.Equal(t, len(rb.OwnerReferences), 0)
+	// the namespaces ignored by the operator are not migrated
+	_, err = kubeClient.RbacV1().RoleBindings("openshift-config").Get(ctx, pipelineRoleBindingOld, metav1.GetOptions{})
+	assert.NilError(t, err)
+	ns, err := kubeClient.CoreV1().Namespaces().Get(ctx, "team-c", metav1.GetOptions{})
+	assert.NilError(t, err)
+	_, stale := ns.Labels[namespaceTrustedConfigLabel]
+	assert.Assert(t, !stale)
+
+	// the status is kept once the migrations are complete
+	assert.NilError(t, r.migrateLegacyArtifacts(ctx))
+	assert.DeepEqual(t, r.tektonConfig.Status.LegacyMigration, &v1alpha1.LegacyMigrationStatus{Removed: 4})
+}
+
+func TestMigrateLegacyArtifactsInBatches(t *testing.T) {
+	ctx := context.TODO()
+	var objects []runtime.Object
+	for i := 0; i < migrationBatchSize+5; i++ {
+		objects = append(objects, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+			Name:   "team-" + string(rune('a'+i/26)) + string(rune('a'+i%26)),
+			Labels: map[string]string{namespaceVersionLabel: "v0.50.0"},
+		}})
+	}
+	kubeClient := kubefake.NewSimpleClientset(objects...)
+	r := &rbac{
+		kubeClientSet:     kubeClient,
+		operatorClientSet: operatorfake.NewSimpleClientset(),
+		tektonConfig: &v1alpha1.TektonConfig{Spec: v1alpha1.TektonConfigSpec{Params: []v1alpha1.Param{
+			{Name: rbacParamName, Value: "false"},
+		}}},
+	}
+
+	assert.NilError(t, r.migrateLegacyArtifacts(ctx))
+	status := r.tektonConfig.Status.LegacyMigration
+	assert.Equal(t, status.Removed, migrationBatchSize)
+	assert.Equal(t, status.Remaining, 5)
+	assert.Equal(t, len(status.Migrations), 1)
+	assert.Equal(t, status.Migrations[0].Name, "namespace-labels")
+	assert.Equal(t, status.Migrations[0].Remaining, 5)
+	assert.Equal(t, len(status.Migrations[0].Artifacts), 5)
+
+	assert.NilError(t, r.migrateLegacyArtifacts(ctx))
+	assert.DeepEqual(t, r.tektonConfig.Status.LegacyMigration, &v1alpha1.LegacyMigrationStatus{Removed: migrationBatchSize + 5})
+}
+
+func TestMigrateLegacyArtifactsNothingToMigrate(t *testing.T) {
+	r := &rbac{
+		kubeClientSet:     kubefake.NewSimpleClientset(),
+		operatorClientSet: operatorfake.NewSimpleClientset(),
+		tektonConfig:      &v1alpha1.TektonConfig{},
+	}
+	assert.NilError(t, r.migrateLegacyArtifacts(context.TODO()))
+	assert.Assert(t, r.tektonConfig.Status.LegacyMigration == nil)
+}
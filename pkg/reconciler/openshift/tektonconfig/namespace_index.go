@@ -0,0 +1,132 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonconfig
+
+import (
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// namespaceIndexEntry is the reconcile status of a namespace, the versions of its labels and the
+// error of its last reconcile
+type namespaceIndexEntry struct {
+	rbacVersion string
+	caVersion   string
+	lastError   string
+}
+
+// namespaceIndex holds the reconcile status of the namespaces in memory. It is updated by the
+// events of the namespaces informer and by the outcome of the reconciles, and rebuilt from the
+// informer on the full passes, so that the passes in between only look up the changed and the
+// failed namespaces.
+type namespaceIndex struct {
+	mutex   sync.Mutex
+	entries map[string]namespaceIndexEntry
+}
+
+func newNamespaceIndex() *namespaceIndex {
+	return &namespaceIndex{entries: map[string]namespaceIndexEntry{}}
+}
+
+// observe records the versions of the labels of the namespace, keeping the error of its last
+// reconcile
+func (i *namespaceIndex) observe(ns *corev1.Namespace) {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+	i.observeLocked(ns)
+}
+
+func (i *namespaceIndex) observeLocked(ns *corev1.Namespace) {
+	entry := i.entries[ns.Name]
+	entry.rbacVersion = ns.Labels[namespaceVersionLabel]
+	entry.caVersion = ns.Labels[namespaceTrustedConfigLabel]
+	i.entries[ns.Name] = entry
+}
+
+// remove forgets a deleted namespace
+func (i *namespaceIndex) remove(name string) {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+	delete(i.entries, name)
+}
+
+// rebuild replaces the entries with the namespaces listed by the informer, the errors of the
+// namespaces which still exist are kept
+func (i *namespaceIndex) rebuild(namespaces []*corev1.Namespace) {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+	previous := i.entries
+	i.entries = make(map[string]namespaceIndexEntry, len(namespaces))
+	for _, ns := range namespaces {
+		i.entries[ns.Name] = namespaceIndexEntry{lastError: previous[ns.Name].lastError}
+		i.observeLocked(ns)
+	}
+}
+
+// recordOutcome records the errors of the failed namespaces and clears the errors of the
+// namespaces reconciled successfully
+func (i *namespaceIndex) recordOutcome(failures *namespaceFailures) {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+	for name := range failures.processed {
+		entry, ok := i.entries[name]
+		if !ok {
+			continue
+		}
+		entry.lastError = ""
+		if err := failures.failed[name]; err != nil {
+			entry.lastError = err.Error()
+		}
+		i.entries[name] = entry
+	}
+}
+
+// failed returns the namespaces whose last reconcile failed
+func (i *namespaceIndex) failed() []string {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+	var names []string
+	for name, entry := range i.entries {
+		if entry.lastError != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// failures returns the errors of the namespaces whose last reconcile failed
+func (i *namespaceIndex) failures() map[string]string {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+	failures := map[string]string{}
+	for name, entry := range i.entries {
+		if entry.lastError != "" {
+			failures[name] = entry.lastError
+		}
+	}
+	return failures
+}
+
+// reconciled returns true when the RBAC resources and the CA bundles of the namespace were
+// reconciled by the version, and its last reconcile did not fail
+func (i *namespaceIndex) reconciled(name, version string) bool {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+	entry, ok := i.entries[name]
+	return ok && entry.rbacVersion == version && entry.caVersion == version && entry.lastError == ""
+}
@@ -0,0 +1,172 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonconfig
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"golang.org/x/time/rate"
+	corev1 "k8s.io/api/core/v1"
+	"knative.dev/pkg/logging"
+)
+
+const (
+	defaultNamespacePatchConcurrency           = 10
+	defaultNamespacePatchQPS                   = 20
+	defaultNamespacePatchBurst                 = 20
+	defaultNamespacePatchConfirmationThreshold = 1000
+)
+
+// namespacePatches configures the patches of the reconciled namespaces
+type namespacePatches struct {
+	// concurrency is the number of namespaces patched at once, the namespaces are patched in
+	// batches of this size
+	concurrency int
+	// qps and burst limit the rate of the patches, so that relabeling every namespace on an
+	// upgrade does not get the operator throttled by the API server. The patches are not
+	// limited without qps.
+	qps   int
+	burst int
+	// threshold is the number of namespaces above which the patches wait for the confirmation
+	threshold int
+	confirmed bool
+}
+
+// namespacePatchConfig returns the configuration of the namespace patches from the TektonConfig params
+func (r *rbac) namespacePatchConfig(ctx context.Context) namespacePatches {
+	logger := logging.FromContext(ctx)
+
+	p := namespacePatches{
+		concurrency: defaultNamespacePatchConcurrency,
+		qps:         defaultNamespacePatchQPS,
+		burst:       defaultNamespacePatchBurst,
+		threshold:   defaultNamespacePatchConfirmationThreshold,
+	}
+	for _, v := range r.tektonConfig.Spec.Params {
+		switch v.Name {
+		case v1alpha1.NamespacePatchConcurrencyParam:
+			n, err := strconv.Atoi(v.Value)
+			if err != nil || n <= 0 {
+				logger.Warnf("invalid value %q for param %s, using default %d", v.Value, v.Name, defaultNamespacePatchConcurrency)
+				continue
+			}
+			p.concurrency = n
+		case v1alpha1.NamespacePatchQPSParam:
+			n, err := strconv.Atoi(v.Value)
+			if err != nil || n <= 0 {
+				logger.Warnf("invalid value %q for param %s, using default %d", v.Value, v.Name, defaultNamespacePatchQPS)
+				continue
+			}
+			p.qps = n
+		case v1alpha1.NamespacePatchBurstParam:
+			n, err := strconv.Atoi(v.Value)
+			if err != nil || n <= 0 {
+				logger.Warnf("invalid value %q for param %s, using default %d", v.Value, v.Name, defaultNamespacePatchBurst)
+				continue
+			}
+			p.burst = n
+		case v1alpha1.NamespacePatchConfirmationThresholdParam:
+			n, err := strconv.Atoi(v.Value)
+			if err != nil || n <= 0 {
+				logger.Warnf("invalid value %q for param %s, using default %d", v.Value, v.Name, defaultNamespacePatchConfirmationThreshold)
+				continue
+			}
+			p.threshold = n
+		case v1alpha1.ConfirmNamespacePatchesParam:
+			p.confirmed = v.Value == "true"
+		}
+	}
+	return p
+}
+
+// pendingConfirmation returns the message reporting the namespaces to be patched when they
+// exceed the threshold without confirmation, the patches proceed when it is empty
+func (p namespacePatches) pendingConfirmation(count int) string {
+	if count <= p.threshold || p.confirmed {
+		return ""
+	}
+	return fmt.Sprintf("%d namespaces will be patched, more than the threshold of %d, set the param %s to true to proceed",
+		count, p.threshold, v1alpha1.ConfirmNamespacePatchesParam)
+}
+
+// countNamespacesToPatch counts the distinct namespaces whose labels will be patched
+func countNamespacesToPatch(namespaces *NamespacesToReconcile, rbac, caBundles bool) int {
+	names := map[string]bool{}
+	if rbac {
+		for _, ns := range namespaces.RBACNamespaces {
+			names[ns.Name] = true
+		}
+	}
+	if caBundles {
+		for _, ns := range namespaces.CANamespaces {
+			names[ns.Name] = true
+		}
+	}
+	return len(names)
+}
+
+// limiter returns the rate limiter of the patches
+func (p namespacePatches) limiter() *rate.Limiter {
+	if p.qps <= 0 {
+		return rate.NewLimiter(rate.Inf, 0)
+	}
+	return rate.NewLimiter(rate.Limit(p.qps), max(p.burst, 1))
+}
+
+// patch runs the patch of the namespaces in batches of concurrent patches, rate limited across
+// the batches, the failures are recorded once a batch completes. The error of the failure policy
+// stops the remaining batches.
+func (p namespacePatches) patch(ctx context.Context, namespaces []corev1.Namespace, failures *namespaceFailures,
+	patch func(context.Context, corev1.Namespace) error) error {
+	logger := logging.FromContext(ctx)
+	limiter := p.limiter()
+
+	for start := 0; start < len(namespaces); start += p.concurrency {
+		end := min(start+p.concurrency, len(namespaces))
+		batch := namespaces[start:end]
+		errs := make([]error, len(batch))
+		var wg sync.WaitGroup
+		for i := range batch {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				if err := limiter.Wait(ctx); err != nil {
+					errs[i] = err
+					return
+				}
+				errs[i] = patch(ctx, batch[i])
+			}(i)
+		}
+		wg.Wait()
+		logger.Debugf("patched namespaces %d to %d of %d", start+1, end, len(namespaces))
+
+		for i, err := range errs {
+			if err == nil {
+				continue
+			}
+			logger.Errorf("failed patching namespace %s: %v", batch[i].Name, err)
+			if err := failures.record(batch[i].Name, err); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
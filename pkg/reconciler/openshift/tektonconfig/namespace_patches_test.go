@@ -0,0 +1,133 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonconfig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNamespacePatchConfig(t *testing.T) {
+	r := &rbac{tektonConfig: &v1alpha1.TektonConfig{}}
+	p := r.namespacePatchConfig(context.TODO())
+	assert.Equal(t, p, namespacePatches{
+		concurrency: defaultNamespacePatchConcurrency,
+		qps:         defaultNamespacePatchQPS,
+		burst:       defaultNamespacePatchBurst,
+		threshold:   defaultNamespacePatchConfirmationThreshold,
+	})
+
+	r.tektonConfig.Spec.Params = []v1alpha1.Param{
+		{Name: v1alpha1.NamespacePatchConcurrencyParam, Value: "0"},
+		{Name: v1alpha1.NamespacePatchQPSParam, Value: "5"},
+		{Name: v1alpha1.NamespacePatchBurstParam, Value: "many"},
+		{Name: v1alpha1.NamespacePatchConfirmationThresholdParam, Value: "200"},
+		{Name: v1alpha1.ConfirmNamespacePatchesParam, Value: "true"},
+	}
+	p = r.namespacePatchConfig(context.TODO())
+	assert.Equal(t, p, namespacePatches{concurrency: defaultNamespacePatchConcurrency, qps: 5, burst: defaultNamespacePatchBurst, threshold: 200, confirmed: true})
+}
+
+func TestNamespacePatchesPendingConfirmation(t *testing.T) {
+	namespaces := &NamespacesToReconcile{
+		RBACNamespaces: []corev1.Namespace{{ObjectMeta: metav1.ObjectMeta{Name: "ns-a"}}, {ObjectMeta: metav1.ObjectMeta{Name: "ns-b"}}},
+		CANamespaces:   []corev1.Namespace{{ObjectMeta: metav1.ObjectMeta{Name: "ns-b"}}, {ObjectMeta: metav1.ObjectMeta{Name: "ns-c"}}},
+	}
+	assert.Equal(t, countNamespacesToPatch(namespaces, true, true), 3)
+	assert.Equal(t, countNamespacesToPatch(namespaces, false, true), 2)
+
+	p := namespacePatches{concurrency: 1, threshold: 2}
+	assert.Equal(t, p.pendingConfirmation(2), "")
+	assert.Equal(t, p.pendingConfirmation(3), "3 namespaces will be patched, more than the threshold of 2, set the param confirmNamespacePatches to true to proceed")
+	p.confirmed = true
+	assert.Equal(t, p.pendingConfirmation(3), "")
+}
+
+func TestNamespacePatchesBatches(t *testing.T) {
+	var namespaces []corev1.Namespace
+	for i := 0; i < 7; i++ {
+		namespaces = append(namespaces, corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("ns-%d", i)}})
+	}
+	var running, maxRunning int32
+	var mu sync.Mutex
+	patched := map[string]bool{}
+	patch := func(_ context.Context, ns corev1.Namespace) error {
+		n := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		mu.Lock()
+		defer mu.Unlock()
+		if n > maxRunning {
+			maxRunning = n
+		}
+		patched[ns.Name] = true
+		if ns.Name == "ns-4" {
+			return errors.New("conflict")
+		}
+		return nil
+	}
+
+	p := namespacePatches{concurrency: 3}
+	f := &namespaceFailures{policy: namespaceFailurePolicyContinue, processed: map[string]bool{}, failed: map[string]error{}}
+	assert.NilError(t, p.patch(context.TODO(), namespaces, f, patch))
+	assert.Equal(t, len(patched), 7)
+	assert.Assert(t, maxRunning <= 3)
+	assert.ErrorContains(t, f.failed["ns-4"], "conflict")
+
+	// failFast stops after the batch of the failure
+	patched = map[string]bool{}
+	f = &namespaceFailures{policy: namespaceFailurePolicyFailFast, processed: map[string]bool{}, failed: map[string]error{}}
+	assert.ErrorContains(t, p.patch(context.TODO(), namespaces, f, patch), "failed reconciling namespace ns-4: conflict")
+	assert.Equal(t, len(patched), 6)
+}
+
+func TestNamespacePatchesRateLimit(t *testing.T) {
+	var namespaces []corev1.Namespace
+	for i := 0; i < 6; i++ {
+		namespaces = append(namespaces, corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("ns-%d", i)}})
+	}
+	var patched int32
+	patch := func(context.Context, corev1.Namespace) error {
+		atomic.AddInt32(&patched, 1)
+		return nil
+	}
+
+	// the burst is sent at once, the other patches at the QPS
+	p := namespacePatches{concurrency: 3, qps: 10, burst: 2}
+	f := &namespaceFailures{policy: namespaceFailurePolicyContinue, processed: map[string]bool{}, failed: map[string]error{}}
+	start := time.Now()
+	assert.NilError(t, p.patch(context.TODO(), namespaces, f, patch))
+	assert.Equal(t, patched, int32(6))
+	assert.Assert(t, time.Since(start) >= 350*time.Millisecond)
+
+	// the patches waiting for the limiter fail once the context is done
+	ctx, cancel := context.WithCancel(context.TODO())
+	cancel()
+	p = namespacePatches{concurrency: 3, qps: 1, burst: 1}
+	f = &namespaceFailures{policy: namespaceFailurePolicyContinue, processed: map[string]bool{}, failed: map[string]error{}}
+	assert.NilError(t, p.patch(ctx, namespaces, f, patch))
+	assert.Assert(t, len(f.failed) > 0)
+}
@@ -0,0 +1,185 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonconfig
+
+import (
+	"context"
+	"sort"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/openshift"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"knative.dev/pkg/logging"
+)
+
+// namespaceOnboarding collects the namespaces reconciled during a reconcile, to report their
+// onboarding state in the TektonNamespaceStatus named after them
+type namespaceOnboarding struct {
+	// rbac holds the SCC of the namespaces whose RBAC was reconciled
+	rbac map[string]string
+	ca   map[string]bool
+	// access holds the permissions denied to the ServiceAccount of the namespaces whose access was reviewed
+	access map[string][]string
+}
+
+func newNamespaceOnboarding() *namespaceOnboarding {
+	return &namespaceOnboarding{rbac: map[string]string{}, ca: map[string]bool{}, access: map[string][]string{}}
+}
+
+// rbacReconciled records the RBAC of the namespace as reconciled, unless the namespace failed
+func (o *namespaceOnboarding) rbacReconciled(ns corev1.Namespace, failures *namespaceFailures, scc string) {
+	if _, failed := failures.failed[ns.Name]; !failed {
+		o.rbac[ns.Name] = scc
+	}
+}
+
+// caReconciled records the CA bundles of the namespace as reconciled, unless the namespace failed
+func (o *namespaceOnboarding) caReconciled(ns corev1.Namespace, failures *namespaceFailures) {
+	if _, failed := failures.failed[ns.Name]; !failed {
+		o.ca[ns.Name] = true
+	}
+}
+
+// namespaceSCC returns the SCC used by the pipelines of the namespace
+func (r *rbac) namespaceSCC(ns corev1.Namespace) string {
+	if scc := ns.Annotations[openshift.NamespaceSCCAnnotation]; scc != "" {
+		return scc
+	}
+	if scc := r.tektonConfig.Spec.Platforms.OpenShift.SCC.Default; scc != "" {
+		return scc
+	}
+	return v1alpha1.PipelinesSCC
+}
+
+// updateNamespaceStatuses reports the onboarding state of the namespaces reconciled or failed
+// during the reconcile, and deletes the TektonNamespaceStatus of the deleted namespaces. The
+// statuses are compared with the informer cache, so that only the changed states are written.
+// The statuses are informational, the errors are logged without failing the reconcile.
+func (r *rbac) updateNamespaceStatuses(ctx context.Context, onboarding *namespaceOnboarding, failures *namespaceFailures) {
+	if r.nsStatusInformer == nil {
+		return
+	}
+	logger := logging.FromContext(ctx)
+	client := r.operatorClientSet.OperatorV1alpha1().TektonNamespaceStatuses()
+	lister := r.nsStatusInformer.Lister()
+
+	names := map[string]bool{}
+	for ns := range onboarding.rbac {
+		names[ns] = true
+	}
+	for ns := range onboarding.ca {
+		names[ns] = true
+	}
+	if failures != nil {
+		for ns := range failures.failed {
+			names[ns] = true
+		}
+	}
+	sorted := make([]string, 0, len(names))
+	for ns := range names {
+		sorted = append(sorted, ns)
+	}
+	sort.Strings(sorted)
+
+	now := metav1.Now()
+	for _, ns := range sorted {
+		existing, err := lister.Get(ns)
+		if err != nil && !errors.IsNotFound(err) {
+			logger.Errorf("failed to get the TektonNamespaceStatus of namespace %s: %v", ns, err)
+			continue
+		}
+		var current v1alpha1.NamespaceOnboardingStatus
+		if existing != nil {
+			current = existing.Status
+		}
+		desired := *current.DeepCopy()
+		if scc, ok := onboarding.rbac[ns]; ok {
+			desired.RBACVersion = r.version
+			desired.SCC = scc
+		}
+		if onboarding.ca[ns] {
+			desired.CAVersion = r.version
+		}
+		if denied, ok := onboarding.access[ns]; ok {
+			desired.IneffectivePermissions = denied
+		}
+		var failed error
+		if failures != nil {
+			failed = failures.failed[ns]
+		}
+		switch {
+		case failed == nil:
+			desired.LastError = ""
+			desired.LastErrorTime = nil
+		case failed.Error() != current.LastError:
+			desired.LastError = failed.Error()
+			desired.LastErrorTime = &now
+		}
+		if existing != nil && equality.Semantic.DeepEqual(desired, current) {
+			continue
+		}
+		desired.LastUpdateTime = now
+
+		if existing == nil {
+			status := &v1alpha1.TektonNamespaceStatus{
+				ObjectMeta: metav1.ObjectMeta{Name: ns},
+				Status:     desired,
+			}
+			if r.tektonConfig != nil {
+				status.OwnerReferences = []metav1.OwnerReference{tektonConfigOwnerRef(*r.tektonConfig)}
+			}
+			if _, err := client.Create(ctx, status, metav1.CreateOptions{}); err != nil {
+				logger.Errorf("failed to create the TektonNamespaceStatus of namespace %s: %v", ns, err)
+			}
+			continue
+		}
+		status := existing.DeepCopy()
+		status.Status = desired
+		if _, err := client.Update(ctx, status, metav1.UpdateOptions{}); err != nil {
+			logger.Errorf("failed to update the TektonNamespaceStatus of namespace %s: %v", ns, err)
+		}
+	}
+
+	r.deleteStaleNamespaceStatuses(ctx)
+}
+
+// deleteStaleNamespaceStatuses deletes the TektonNamespaceStatus of the namespaces which no
+// longer exist
+func (r *rbac) deleteStaleNamespaceStatuses(ctx context.Context) {
+	if r.nsInformer == nil {
+		return
+	}
+	logger := logging.FromContext(ctx)
+	statuses, err := r.nsStatusInformer.Lister().List(labels.Everything())
+	if err != nil {
+		logger.Errorf("failed to list the TektonNamespaceStatuses: %v", err)
+		return
+	}
+	for _, status := range statuses {
+		if _, err := r.nsInformer.Lister().Get(status.Name); !errors.IsNotFound(err) {
+			continue
+		}
+		err := r.operatorClientSet.OperatorV1alpha1().TektonNamespaceStatuses().Delete(ctx, status.Name, metav1.DeleteOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			logger.Errorf("failed to delete the TektonNamespaceStatus of deleted namespace %s: %v", status.Name, err)
+		}
+	}
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonconfig
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	operatorfake "github.com/tektoncd/operator/pkg/client/clientset/versioned/fake"
+	operatorinformers "github.com/tektoncd/operator/pkg/client/informers/externalversions"
+	"github.com/tektoncd/operator/pkg/reconciler/openshift"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubeinformers "k8s.io/client-go/informers"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestUpdateNamespaceStatuses(t *testing.T) {
+	ctx := context.TODO()
+	namespaces := []corev1.Namespace{
+		{ObjectMeta: metav1.ObjectMeta{Name: "ns-a"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "ns-b", Annotations: map[string]string{openshift.NamespaceSCCAnnotation: "restricted-v2"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "ns-c"}},
+	}
+	kubeClient := kubefake.NewSimpleClientset()
+	nsInformer := kubeinformers.NewSharedInformerFactory(kubeClient, 0).Core().V1().Namespaces()
+	for i := range namespaces {
+		assert.NilError(t, nsInformer.Informer().GetIndexer().Add(&namespaces[i]))
+	}
+	// the status of a deleted namespace
+	stale := &v1alpha1.TektonNamespaceStatus{ObjectMeta: metav1.ObjectMeta{Name: "ns-deleted"}}
+	operatorClient := operatorfake.NewSimpleClientset(stale)
+	nsStatusInformer := operatorinformers.NewSharedInformerFactory(operatorClient, 0).Operator().V1alpha1().TektonNamespaceStatuses()
+	assert.NilError(t, nsStatusInformer.Informer().GetIndexer().Add(stale))
+
+	tc := &v1alpha1.TektonConfig{ObjectMeta: metav1.ObjectMeta{Name: "config"}}
+	tc.Spec.Platforms.OpenShift.SCC = &v1alpha1.SCC{Default: "pipelines-scc"}
+	r := &rbac{
+		kubeClientSet:     kubeClient,
+		operatorClientSet: operatorClient,
+		nsInformer:        nsInformer,
+		nsStatusInformer:  nsStatusInformer,
+		version:           "v1",
+		tektonConfig:      tc,
+	}
+
+	failures := &namespaceFailures{processed: map[string]bool{}, failed: map[string]error{}}
+	assert.NilError(t, failures.record("ns-c", errors.New("forbidden")))
+	onboarding := newNamespaceOnboarding()
+	for _, ns := range namespaces {
+		onboarding.rbacReconciled(ns, failures, r.namespaceSCC(ns))
+	}
+	onboarding.caReconciled(namespaces[0], failures)
+	onboarding.access["ns-b"] = []string{"create pods"}
+	r.updateNamespaceStatuses(ctx, onboarding, failures)
+
+	get := func(name string) v1alpha1.NamespaceOnboardingStatus {
+		t.Helper()
+		status, err := operatorClient.OperatorV1alpha1().TektonNamespaceStatuses().Get(ctx, name, metav1.GetOptions{})
+		assert.NilError(t, err)
+		assert.Equal(t, status.OwnerReferences[0].Name, "config")
+		return status.Status
+	}
+	a := get("ns-a")
+	assert.Equal(t, a.RBACVersion, "v1")
+	assert.Equal(t, a.CAVersion, "v1")
+	assert.Equal(t, a.SCC, "pipelines-scc")
+	assert.Equal(t, a.LastError, "")
+	b := get("ns-b")
+	assert.Equal(t, b.RBACVersion, "v1")
+	assert.Equal(t, b.CAVersion, "")
+	assert.Equal(t, b.SCC, "restricted-v2")
+	assert.DeepEqual(t, b.IneffectivePermissions, []string{"create pods"})
+	c := get("ns-c")
+	assert.Equal(t, c.RBACVersion, "")
+	assert.Equal(t, c.LastError, "forbidden")
+	assert.Assert(t, c.LastErrorTime != nil)
+
+	_, err := operatorClient.OperatorV1alpha1().TektonNamespaceStatuses().Get(ctx, "ns-deleted", metav1.GetOptions{})
+	assert.Assert(t, apierrors.IsNotFound(err))
+
+	// an unchanged state is not written again
+	for _, name := range []string{"ns-a", "ns-b", "ns-c"} {
+		status, err := operatorClient.OperatorV1alpha1().TektonNamespaceStatuses().Get(ctx, name, metav1.GetOptions{})
+		assert.NilError(t, err)
+		assert.NilError(t, nsStatusInformer.Informer().GetIndexer().Add(status))
+	}
+	assert.NilError(t, nsStatusInformer.Informer().GetIndexer().Delete(stale))
+	operatorClient.ClearActions()
+	r.updateNamespaceStatuses(ctx, onboarding, failures)
+	assert.Equal(t, len(operatorClient.Actions()), 0)
+
+	// the error is cleared once the namespace is reconciled
+	recovered := &namespaceFailures{processed: map[string]bool{}, failed: map[string]error{}}
+	onboarding = newNamespaceOnboarding()
+	onboarding.rbacReconciled(namespaces[2], recovered, r.namespaceSCC(namespaces[2]))
+	r.updateNamespaceStatuses(ctx, onboarding, recovered)
+	c = get("ns-c")
+	assert.Equal(t, c.RBACVersion, "v1")
+	assert.Equal(t, c.LastError, "")
+	assert.Assert(t, c.LastErrorTime == nil)
+}
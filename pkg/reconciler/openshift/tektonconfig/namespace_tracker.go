@@ -0,0 +1,159 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonconfig
+
+import (
+	"sync"
+	"time"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"knative.dev/pkg/kmeta"
+)
+
+// namespaceResyncInterval is the interval of the full passes over the namespaces, they repair the
+// resources of the namespaces which changed without an event of the namespace, e.g. a deleted
+// ConfigMap or new certificates of the cluster
+const namespaceResyncInterval = 10 * time.Minute
+
+// namespaceTracker records the namespaces changed since the last reconcile, so that a reconcile
+// only evaluates them instead of all the namespaces. All the namespaces are evaluated on the first
+// reconcile, when the spec of TektonConfig changed, after a failed reconcile, and once per resync
+// interval. The failed namespaces are evaluated on every reconcile until they succeed.
+type namespaceTracker struct {
+	now func() time.Time
+
+	mutex   sync.Mutex
+	changed map[string]bool
+	// full requires a pass over all the namespaces
+	full bool
+	// lastFullPass and generation are the time and the TektonConfig generation of the last full pass
+	lastFullPass time.Time
+	generation   int64
+	// index holds the reconcile status of the namespaces
+	index *namespaceIndex
+}
+
+func newNamespaceTracker() *namespaceTracker {
+	return &namespaceTracker{now: time.Now, changed: map[string]bool{}, full: true, index: newNamespaceIndex()}
+}
+
+// NamespaceChanged records the namespace of an event of the namespaces informer
+func (t *namespaceTracker) NamespaceChanged(obj interface{}) {
+	object, err := kmeta.DeletionHandlingAccessor(obj)
+	if err != nil {
+		return
+	}
+	if ns, ok := obj.(*corev1.Namespace); ok {
+		t.index.observe(ns)
+	} else {
+		// the final state of the namespace is unknown, it is looked up again by the next reconcile
+		t.index.remove(object.GetName())
+	}
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.changed[object.GetName()] = true
+}
+
+// next returns true when all the namespaces are to be evaluated, and the namespaces changed since
+// the last call and the failed namespaces otherwise. Without a tracker all the namespaces are
+// evaluated.
+func (t *namespaceTracker) next(tc *v1alpha1.TektonConfig) (bool, map[string]bool) {
+	if t == nil {
+		return true, nil
+	}
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	changed := t.changed
+	t.changed = map[string]bool{}
+	now := t.now()
+	if t.full || tc.Generation != t.generation || now.Sub(t.lastFullPass) >= namespaceResyncInterval {
+		t.full = false
+		t.lastFullPass = now
+		t.generation = tc.Generation
+		return true, nil
+	}
+	for _, name := range t.index.failed() {
+		changed[name] = true
+	}
+	return false, changed
+}
+
+// recordOutcome records the failed namespaces of a reconcile, they are evaluated again on the next
+// reconciles until they succeed
+func (t *namespaceTracker) recordOutcome(failures *namespaceFailures) {
+	if t == nil {
+		return
+	}
+	t.index.recordOutcome(failures)
+}
+
+// failures returns the errors of all the namespaces whose last reconcile failed, without a tracker
+// the failures are not known
+func (t *namespaceTracker) failures() map[string]string {
+	if t == nil {
+		return nil
+	}
+	return t.index.failures()
+}
+
+// rebuild rebuilds the index of the namespaces from the namespaces listed by a full pass
+func (t *namespaceTracker) rebuild(namespaces []*corev1.Namespace) {
+	if t == nil {
+		return
+	}
+	t.index.rebuild(namespaces)
+}
+
+// forget removes a namespace which no longer exists from the index
+func (t *namespaceTracker) forget(name string) {
+	if t == nil {
+		return
+	}
+	t.index.remove(name)
+}
+
+// reconciled returns true when the namespace is up to date with the version according to the
+// index, without a tracker the namespaces are never known to be up to date
+func (t *namespaceTracker) reconciled(name, version string) bool {
+	if t == nil {
+		return false
+	}
+	return t.index.reconciled(name, version)
+}
+
+// retryAll evaluates all the namespaces on the next reconcile, after a reconcile which did not
+// complete
+func (t *namespaceTracker) retryAll() {
+	if t == nil {
+		return
+	}
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.full = true
+}
+
+// resync evaluates all the namespaces on the next reconcile, after a change which updates the
+// resources of the namespaces without an event of the namespaces, e.g. new certificates of the cluster
+func (t *namespaceTracker) resync() {
+	if t == nil {
+		return
+	}
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.full = true
+}
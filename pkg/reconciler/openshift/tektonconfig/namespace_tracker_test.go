@@ -0,0 +1,187 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonconfig
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubeinformers "k8s.io/client-go/informers"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+)
+
+func TestNamespaceTracker(t *testing.T) {
+	now := time.Now()
+	tracker := newNamespaceTracker()
+	tracker.now = func() time.Time { return now }
+	tc := &v1alpha1.TektonConfig{ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.ConfigResourceName, Generation: 1}}
+	namespace := func(name string) *corev1.Namespace {
+		return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	}
+
+	// the first reconcile evaluates all the namespaces
+	tracker.NamespaceChanged(namespace("foo"))
+	all, _ := tracker.next(tc)
+	assert.Assert(t, all)
+	all, changed := tracker.next(tc)
+	assert.Assert(t, !all)
+	assert.Equal(t, len(changed), 0)
+
+	// the changed namespaces are evaluated once, including the deleted namespaces
+	tracker.NamespaceChanged(namespace("foo"))
+	tracker.NamespaceChanged(cache.DeletedFinalStateUnknown{Key: "bar", Obj: namespace("bar")})
+	all, changed = tracker.next(tc)
+	assert.Assert(t, !all)
+	assert.DeepEqual(t, changed, map[string]bool{"foo": true, "bar": true})
+
+	// the failed namespaces are evaluated until they succeed
+	failures := &namespaceFailures{processed: map[string]bool{}, failed: map[string]error{}}
+	assert.NilError(t, failures.record("foo", errors.New("forbidden")))
+	tracker.recordOutcome(failures)
+	for i := 0; i < 2; i++ {
+		_, changed = tracker.next(tc)
+		assert.DeepEqual(t, changed, map[string]bool{"foo": true})
+	}
+	failures = &namespaceFailures{processed: map[string]bool{}, failed: map[string]error{}}
+	failures.process("foo")
+	tracker.recordOutcome(failures)
+	_, changed = tracker.next(tc)
+	assert.Equal(t, len(changed), 0)
+
+	// a full pass runs after a failed reconcile, a change of TektonConfig and the resync interval
+	tracker.retryAll()
+	all, _ = tracker.next(tc)
+	assert.Assert(t, all)
+	tc.Generation = 2
+	all, _ = tracker.next(tc)
+	assert.Assert(t, all)
+	now = now.Add(namespaceResyncInterval)
+	all, _ = tracker.next(tc)
+	assert.Assert(t, all)
+	all, _ = tracker.next(tc)
+	assert.Assert(t, !all)
+
+	// without a tracker all the namespaces are evaluated
+	var none *namespaceTracker
+	none.retryAll()
+	all, _ = none.next(tc)
+	assert.Assert(t, all)
+}
+
+func TestGetNamespacesToBeReconciledChanged(t *testing.T) {
+	ctx := context.Background()
+	kubeClient := kubefake.NewSimpleClientset()
+	informers := kubeinformers.NewSharedInformerFactory(kubeClient, 0)
+	nsInformer := informers.Core().V1().Namespaces()
+	for _, name := range []string{"foo", "bar", "openshift-monitoring"} {
+		assert.NilError(t, nsInformer.Informer().GetIndexer().Add(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}}))
+	}
+	r := &rbac{
+		kubeClientSet: kubeClient,
+		nsInformer:    nsInformer,
+		tektonConfig:  &v1alpha1.TektonConfig{ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.ConfigResourceName}},
+		version:       "test-version",
+		namespaces:    newNamespaceTracker(),
+	}
+	names := func(namespaces []corev1.Namespace) []string {
+		result := []string{}
+		for _, ns := range namespaces {
+			result = append(result, ns.Name)
+		}
+		return result
+	}
+
+	result, err := r.getNamespacesToBeReconciled(ctx)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, names(result.RBACNamespaces), []string{"bar", "foo"})
+	assert.Equal(t, result.InScope, 2)
+
+	// only the changed namespaces are evaluated until the next full pass, all the namespaces
+	// are still in scope
+	result, err = r.getNamespacesToBeReconciled(ctx)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, names(result.RBACNamespaces), []string{})
+	assert.Equal(t, result.InScope, 2)
+	r.namespaces.NamespaceChanged(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "foo"}})
+	result, err = r.getNamespacesToBeReconciled(ctx)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, names(result.RBACNamespaces), []string{"foo"})
+	assert.DeepEqual(t, names(result.CANamespaces), []string{"foo"})
+
+	// the changed namespaces which are up to date are not verified between the full passes, the
+	// deleted namespaces are removed from the index
+	reconciled := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "foo", Labels: map[string]string{
+		namespaceVersionLabel:       "test-version",
+		namespaceTrustedConfigLabel: "test-version",
+	}}}
+	assert.NilError(t, nsInformer.Informer().GetIndexer().Update(reconciled))
+	assert.NilError(t, nsInformer.Informer().GetIndexer().Delete(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "bar"}}))
+	r.namespaces.NamespaceChanged(reconciled)
+	r.namespaces.NamespaceChanged(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "bar"}})
+	result, err = r.getNamespacesToBeReconciled(ctx)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, names(result.RBACNamespaces), []string{})
+	assert.Equal(t, result.InScope, 1)
+	assert.Assert(t, r.namespaces.reconciled("foo", "test-version"))
+	_, indexed := r.namespaces.index.entries["bar"]
+	assert.Assert(t, !indexed)
+
+	// the full passes verify the SCC RoleBinding of the namespaces
+	r.namespaces.retryAll()
+	result, err = r.getNamespacesToBeReconciled(ctx)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, names(result.RBACNamespaces), []string{"foo"})
+}
+
+func TestNamespaceIndex(t *testing.T) {
+	index := newNamespaceIndex()
+	index.rebuild([]*corev1.Namespace{
+		{ObjectMeta: metav1.ObjectMeta{Name: "foo", Labels: map[string]string{namespaceVersionLabel: "v1", namespaceTrustedConfigLabel: "v1"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "bar", Labels: map[string]string{namespaceVersionLabel: "v1"}}},
+	})
+	assert.Assert(t, index.reconciled("foo", "v1"))
+	assert.Assert(t, !index.reconciled("foo", "v2"))
+	assert.Assert(t, !index.reconciled("bar", "v1"))
+	assert.Assert(t, !index.reconciled("baz", "v1"))
+
+	// the errors are kept by the events and the rebuilds until the namespace succeeds
+	failures := &namespaceFailures{processed: map[string]bool{}, failed: map[string]error{}}
+	assert.NilError(t, failures.record("foo", errors.New("forbidden")))
+	index.recordOutcome(failures)
+	assert.DeepEqual(t, index.failed(), []string{"foo"})
+	assert.DeepEqual(t, index.failures(), map[string]string{"foo": "forbidden"})
+	assert.Assert(t, !index.reconciled("foo", "v1"))
+	index.observe(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "foo"}})
+	index.rebuild([]*corev1.Namespace{{ObjectMeta: metav1.ObjectMeta{Name: "foo"}}})
+	assert.Equal(t, index.entries["foo"].lastError, "forbidden")
+	_, ok := index.entries["bar"]
+	assert.Assert(t, !ok)
+
+	failures = &namespaceFailures{processed: map[string]bool{}, failed: map[string]error{}}
+	failures.process("foo")
+	index.recordOutcome(failures)
+	assert.Equal(t, len(index.failed()), 0)
+	index.remove("foo")
+	assert.Equal(t, len(index.entries), 0)
+}
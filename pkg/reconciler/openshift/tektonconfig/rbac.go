@@ -18,18 +18,20 @@ package tektonconfig
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"math"
 	"regexp"
+	"sort"
 	"time"
 
 	security "github.com/openshift/client-go/security/clientset/versioned"
 	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
 	clientset "github.com/tektoncd/operator/pkg/client/clientset/versioned"
+	operatorinformers "github.com/tektoncd/operator/pkg/client/informers/externalversions/operator/v1alpha1"
 	"github.com/tektoncd/operator/pkg/common"
 	reconcilerCommon "github.com/tektoncd/operator/pkg/reconciler/common"
 	"github.com/tektoncd/operator/pkg/reconciler/openshift"
+	"github.com/tektoncd/operator/pkg/reconciler/openshift/namespacerbac"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/permissions"
 
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
@@ -37,11 +39,9 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/selection"
-	"k8s.io/apimachinery/pkg/types"
 	nsV1 "k8s.io/client-go/informers/core/v1"
 	rbacV1 "k8s.io/client-go/informers/rbac/v1"
 	"k8s.io/client-go/kubernetes"
-	v1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	"knative.dev/pkg/logging"
 )
 
@@ -49,16 +49,16 @@ const (
 	pipelinesSCCRole        = "pipelines-scc-role"
 	pipelinesSCCClusterRole = "pipelines-scc-clusterrole"
 	pipelinesSCCRoleBinding = "pipelines-scc-rolebinding"
-	pipelineSA              = "pipeline"
-	PipelineRoleBinding     = "openshift-pipelines-edit"
+	pipelineSA              = namespacerbac.PipelineServiceAccount
+	PipelineRoleBinding     = namespacerbac.EditRoleBinding
 
 	// TODO: Remove this after v0.55.0 release, by following a depreciation notice
 	// --------------------
 	pipelineRoleBindingOld  = "edit"
 	rbacInstallerSetNameOld = "rbac-resources"
 	// --------------------
-	serviceCABundleConfigMap    = "config-service-cabundle"
-	trustedCABundleConfigMap    = "config-trusted-cabundle"
+	serviceCABundleConfigMap    = namespacerbac.ServiceCABundleConfigMap
+	trustedCABundleConfigMap    = namespacerbac.TrustedCABundleConfigMap
 	clusterInterceptors         = "openshift-pipelines-clusterinterceptors"
 	namespaceVersionLabel       = "openshift-pipelines.tekton.dev/namespace-reconcile-version"
 	namespaceTrustedConfigLabel = "openshift-pipelines.tekton.dev/namespace-trusted-configmaps-version"
@@ -66,11 +66,14 @@ const (
 	componentNameRBAC           = "rhosp-rbac"
 	rbacInstallerSetType        = "rhosp-rbac"
 	rbacInstallerSetNamePrefix  = "rhosp-rbac-"
-	rbacParamName               = "createRbacResource"
-	trustedCABundleParamName    = "createCABundleConfigMaps"
-	legacyPipelineRbacParamName = "legacyPipelineRbac"
+	rbacParamName               = v1alpha1.CreateRbacResourceParam
+	trustedCABundleParamName    = v1alpha1.CreateCABundleConfigMapsParam
+	legacyPipelineRbacParamName = v1alpha1.LegacyPipelineRbacParam
 	legacyPipelineRbac          = "true"
 	serviceAccountCreationLabel = "openshift-pipelines.tekton.dev/sa-created"
+	extraCASourceAnnotation     = namespacerbac.ExtraCASourceAnnotation
+	platformTrustedCANamespace  = namespacerbac.PlatformTrustedCANamespace
+	platformTrustedCAConfigMap  = namespacerbac.PlatformTrustedCAConfigMap
 )
 
 var (
@@ -91,9 +94,26 @@ type rbac struct {
 	securityClientSet security.Interface
 	rbacInformer      rbacV1.ClusterRoleBindingInformer
 	nsInformer        nsV1.NamespaceInformer
-	ownerRef          metav1.OwnerReference
-	version           string
-	tektonConfig      *v1alpha1.TektonConfig
+	// nsStatusInformer caches the TektonNamespaceStatus reporting the onboarding state of the namespaces
+	nsStatusInformer operatorinformers.TektonNamespaceStatusInformer
+	ownerRef         metav1.OwnerReference
+	version          string
+	tektonConfig     *v1alpha1.TektonConfig
+	// activity is used by the reaper to find namespaces without Tekton activity
+	activity namespaceActivity
+	// namespaces tracks the namespaces changed since the last reconcile
+	namespaces *namespaceTracker
+	// subjectsGC schedules the removal of the subjects of the deleted namespaces
+	subjectsGC *subjectsGarbageCollector
+	// capabilities are the subsystems enabled with the permissions of the operator, all the
+	// subsystems are enabled when they are not known
+	capabilities *permissions.Capabilities
+	// events emitted in the namespaces during the reconcile
+	events *reconcilerCommon.Events
+	// operatorNamespace holds the sources of the additional CAs
+	operatorNamespace string
+	// additionalCAs are appended to the trusted CA bundle of every namespace
+	additionalCAs string
 }
 
 type NamespaceServiceAccount struct {
@@ -105,6 +125,9 @@ type NamespaceServiceAccount struct {
 type NamespacesToReconcile struct {
 	RBACNamespaces []corev1.Namespace
 	CANamespaces   []corev1.Namespace
+	// InScope is the number of namespaces managed by the operator, including the ones
+	// not evaluated by this pass
+	InScope int
 }
 
 func (r *rbac) cleanUp(ctx context.Context) error {
@@ -131,9 +154,6 @@ func (r *rbac) cleanUp(ctx context.Context) error {
 }
 
 func (r *rbac) EnsureRBACInstallerSet(ctx context.Context) (*v1alpha1.TektonInstallerSet, error) {
-	if err := r.removeObsoleteRBACInstallerSet(ctx); err != nil {
-		return nil, err
-	}
 
 	rbacISet, err := checkIfInstallerSetExist(ctx, r.operatorClientSet, r.version, r.tektonConfig)
 	if err != nil {
@@ -160,56 +180,10 @@ func (r *rbac) EnsureRBACInstallerSet(ctx context.Context) (*v1alpha1.TektonInst
 	return nil, v1alpha1.RECONCILE_AGAIN_ERR
 }
 
+// setDefault adds the OpenShift specific params missing in TektonConfig, the values of
+// the params are validated by the webhook
 func (r *rbac) setDefault() {
-	var rbacParamFound, legacyParamFound, caBundleParamFound bool
-	var createRbacResourceValue string
-
-	for i, v := range r.tektonConfig.Spec.Params {
-		if v.Name == rbacParamName {
-			rbacParamFound = true
-			createRbacResourceValue = v.Value
-			if v.Value != "false" && v.Value != "true" {
-				r.tektonConfig.Spec.Params[i].Value = "true"
-			}
-		}
-		if v.Name == legacyPipelineRbacParamName {
-			legacyParamFound = true
-			if v.Value != "false" && v.Value != "true" {
-				r.tektonConfig.Spec.Params[i].Value = "true"
-			}
-		}
-		if v.Name == trustedCABundleParamName {
-			caBundleParamFound = true
-			if v.Value != "false" && v.Value != "true" {
-				r.tektonConfig.Spec.Params[i].Value = "true"
-			}
-		}
-	}
-	if !rbacParamFound {
-		r.tektonConfig.Spec.Params = append(r.tektonConfig.Spec.Params, v1alpha1.Param{
-			Name:  rbacParamName,
-			Value: "true",
-		})
-	}
-	if !legacyParamFound {
-		r.tektonConfig.Spec.Params = append(r.tektonConfig.Spec.Params, v1alpha1.Param{
-			Name:  legacyPipelineRbacParamName,
-			Value: "true",
-		})
-	}
-
-	// TODO: Remove this upgrade workaround after version 1.22.
-	// This logic is only needed to preserve backward compatibility for users upgrading to 1.21
-	// who had createRbacResource=false and no createCABundleConfigMaps param set.
-	if !caBundleParamFound {
-		defaultVal := "true"
-		if rbacParamFound && createRbacResourceValue == "false" {
-			defaultVal = "false"
-		}
-		r.tektonConfig.Spec.Params = append(r.tektonConfig.Spec.Params,
-			v1alpha1.Param{Name: trustedCABundleParamName, Value: defaultVal},
-		)
-	}
+	r.tektonConfig.SetOpenShiftParamDefaults()
 }
 
 // ensurePreRequisites validates the resources before creation
@@ -222,41 +196,18 @@ func (r *rbac) ensurePreRequisites(ctx context.Context) error {
 	}
 	r.ownerRef = configOwnerRef(*rbacISet)
 
-	// make sure default SCC is in place
-	defaultSCC := r.tektonConfig.Spec.Platforms.OpenShift.SCC.Default
-	if defaultSCC == "" {
-		// Should not really happen due to defaulting, but okay...
-		return fmt.Errorf("tektonConfig.Spec.Platforms.OpenShift.SCC.Default cannot be empty")
-	}
-	logger.Debugf("default SCC set to: %s", defaultSCC)
-	if err := common.VerifySCCExists(ctx, defaultSCC, r.securityClientSet); err != nil {
-		return fmt.Errorf("failed to verify scc %s exists, %w", defaultSCC, err)
+	// the SCCs and the pipelines-scc-clusterrole are not managed without the permissions
+	if !r.subsystemEnabled(ctx, permissions.SubsystemSCC) || !r.subsystemEnabled(ctx, permissions.SubsystemClusterRoles) {
+		return nil
 	}
 
-	prioritizedSCCList, err := common.GetSCCRestrictiveList(ctx, r.securityClientSet)
-	if err != nil {
+	// make sure the default and maxAllowed SCCs are in place, they are validated when TektonConfig
+	// is applied and can be deleted later
+	scc := r.tektonConfig.Spec.Platforms.OpenShift.SCC
+	if err := common.ValidateSCCConfig(ctx, r.securityClientSet, scc.Default, scc.MaxAllowed); err != nil {
 		return err
 	}
-
-	// validate maxAllowed SCC
-	maxAllowedSCC := r.tektonConfig.Spec.Platforms.OpenShift.SCC.MaxAllowed
-	if maxAllowedSCC != "" {
-		if err := common.VerifySCCExists(ctx, maxAllowedSCC, r.securityClientSet); err != nil {
-			return fmt.Errorf("failed to verify scc %s exists, %w", maxAllowedSCC, err)
-		}
-
-		isPriority, err := common.SCCAMoreRestrictiveThanB(prioritizedSCCList, defaultSCC, maxAllowedSCC)
-		if err != nil {
-			return err
-		}
-		logger.Infof("Is maxAllowed SCC: %s less restrictive than default SCC: %s? %t", maxAllowedSCC, defaultSCC, isPriority)
-		if !isPriority {
-			return fmt.Errorf("maxAllowed SCC: %s must be less restrictive than the default SCC: %s", maxAllowedSCC, defaultSCC)
-		}
-		logger.Debugf("maxAllowed SCC set to: %s", maxAllowedSCC)
-	} else {
-		logger.Debug("No maxAllowed SCC set in TektonConfig")
-	}
+	logger.Debugf("default SCC set to: %s, maxAllowed SCC set to: %q", scc.Default, scc.MaxAllowed)
 
 	// Maintaining a separate cluster role for the scc declaration.
 	// to assist us in managing this the scc association in a
@@ -282,8 +233,9 @@ func shouldIgnoreNamespace(ns corev1.Namespace) bool {
 	return false
 }
 
-// needsRBAC checks whether the given namespace requires RBAC reconciliation.
-func (r *rbac) needsRBAC(ctx context.Context, ns corev1.Namespace) (bool, error) {
+// needsRBAC checks whether the given namespace requires RBAC reconciliation. The SCC RoleBinding
+// of the namespace is only verified with selfHeal, the other checks do not call the API.
+func (r *rbac) needsRBAC(ctx context.Context, ns corev1.Namespace, selfHeal bool) (bool, error) {
 	logger := logging.FromContext(ctx)
 
 	// We want to monitor namespaces with the SCC annotation set
@@ -294,9 +246,44 @@ func (r *rbac) needsRBAC(ctx context.Context, ns corev1.Namespace) (bool, error)
 	if ns.Labels[namespaceVersionLabel] != r.version {
 		return true, nil
 	}
+	// Reconcile namespaces where the SCCs granted to additional ServiceAccounts changed
+	sccServiceAccountsHash, err := r.sccServiceAccountsHash(ns.Name)
+	if err != nil {
+		return false, err
+	}
+	if ns.Annotations[sccServiceAccountsHashAnnotation] != sccServiceAccountsHash {
+		return true, nil
+	}
+	// Reconcile namespaces where the subjects of the edit RoleBinding changed
+	editSubjectsHash, err := r.editSubjectsHash(&ns)
+	if err != nil {
+		return false, err
+	}
+	if ns.Annotations[editSubjectsHashAnnotation] != editSubjectsHash {
+		return true, nil
+	}
+	// Reconcile namespaces where the additional ServiceAccounts changed
+	additionalServiceAccountsHash, err := r.additionalServiceAccountsHash()
+	if err != nil {
+		return false, err
+	}
+	if ns.Annotations[additionalServiceAccountsHashAnnotation] != additionalServiceAccountsHash {
+		return true, nil
+	}
+	// Reconcile namespaces where the name of the ServiceAccount changed
+	if ns.Annotations[serviceAccountNameAnnotation] != r.customServiceAccountName() {
+		return true, nil
+	}
+	// Reconcile namespaces where the ClusterRole of the edit RoleBinding changed
+	if ns.Annotations[pipelineClusterRoleAnnotation] != r.customPipelineClusterRole() {
+		return true, nil
+	}
 
 	// Now we're left with namespaces that have already been reconciled.
 	// We must make sure that the default SCC is in force via the ClusterRole.
+	if !selfHeal {
+		return false, nil
+	}
 	sccRoleBinding, err := r.kubeClientSet.RbacV1().RoleBindings(ns.Name).Get(ctx, pipelinesSCCRoleBinding, metav1.GetOptions{})
 	if err != nil {
 		if errors.IsNotFound(err) {
@@ -317,49 +304,90 @@ func (r *rbac) needsRBAC(ctx context.Context, ns corev1.Namespace) (bool, error)
 func (r *rbac) needsCABundle(ctx context.Context, ns corev1.Namespace) (bool, error) {
 	logger := logging.FromContext(ctx)
 
-	if ns.Labels[namespaceTrustedConfigLabel] != r.version {
-		return true, nil
+	if reconcilerCommon.CABundleOptedOut(&ns) {
+		logger.Debugf("namespace %s opted out of the CA bundle configmaps", ns.Name)
+		return false, nil
 	}
 
-	// Self-healing: verify configmaps exist even when label matches
-	cmClient := r.kubeClientSet.CoreV1().ConfigMaps(ns.Name)
-	_, err1 := cmClient.Get(ctx, trustedCABundleConfigMap, metav1.GetOptions{})
-	_, err2 := cmClient.Get(ctx, serviceCABundleConfigMap, metav1.GetOptions{})
-	if errors.IsNotFound(err1) || errors.IsNotFound(err2) {
-		logger.Warnf("CA bundle configmaps missing in namespace %s despite label indicating reconciliation complete, will re-reconcile", ns.Name)
+	if ns.Labels[namespaceTrustedConfigLabel] != r.version {
 		return true, nil
 	}
-	if err1 != nil {
-		return false, fmt.Errorf("error checking configmap %s in namespace %s: %w", trustedCABundleConfigMap, ns.Name, err1)
+
+	// Self-healing: verify configmaps exist and keep their metadata even when label matches
+	drifted, outdated, err := r.onboarder().CABundlesStatus(ctx, &ns)
+	if err != nil {
+		return false, err
 	}
-	if err2 != nil {
-		return false, fmt.Errorf("error checking configmap %s in namespace %s: %w", serviceCABundleConfigMap, ns.Name, err2)
+	if drifted {
+		r.bumpCABundleVersion(ctx, ns)
 	}
+	return drifted || outdated, nil
+}
 
-	return false, nil
+// bumpCABundleVersion removes the CA bundle version label of a namespace whose CA bundle
+// ConfigMaps drifted, the namespace is no longer reported as reconciled and is reconciled again
+// by the next reconciles until its ConfigMaps are repaired, even when this reconcile does not get
+// to it. Failures are logged, the namespace is reconciled by this reconcile anyway.
+func (r *rbac) bumpCABundleVersion(ctx context.Context, ns corev1.Namespace) {
+	logger := logging.FromContext(ctx)
+	recordCABundleDrift()
+	if !r.subsystemEnabled(ctx, permissions.SubsystemNamespacePatching) {
+		return
+	}
+	err := reconcilerCommon.PatchNamespaceMetadata(ctx, r.kubeClientSet, ns.Name, reconcilerCommon.NamespaceMetadataPatch{
+		RemoveLabels: []string{namespaceTrustedConfigLabel},
+	})
+	if reconcilerCommon.NamespaceGoingAway(ctx, r.kubeClientSet, ns.Name, err) {
+		return
+	}
+	if err != nil {
+		logger.Errorf("failed to remove the label %s of namespace %s: %v", namespaceTrustedConfigLabel, ns.Name, err)
+		return
+	}
+	logger.Infof("the CA bundle configmaps of namespace %s drifted, removed its label %s", ns.Name, namespaceTrustedConfigLabel)
 }
 
 func (r *rbac) getNamespacesToBeReconciled(ctx context.Context) (*NamespacesToReconcile, error) {
 	logger := logging.FromContext(ctx)
 
-	// fetch the list of all namespaces
-	allNamespaces, err := r.kubeClientSet.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+	// only the namespaces changed since the last reconcile and the failed namespaces are looked
+	// up between the full passes
+	all, changed := r.namespaces.next(r.tektonConfig)
+	namespaces, err := r.namespacesToEvaluate(all, changed)
 	if err != nil {
 		return nil, err
 	}
+	if !all {
+		logger.Debugf("Evaluating %d changed namespaces", len(namespaces))
+	}
+	// the namespaces are processed in the order of their names, as listed by the API
+	sort.Slice(namespaces, func(i, j int) bool { return namespaces[i].Name < namespaces[j].Name })
 
 	result := &NamespacesToReconcile{
 		RBACNamespaces: []corev1.Namespace{},
 		CANamespaces:   []corev1.Namespace{},
 	}
 
-	for _, ns := range allNamespaces.Items {
+	reaperEnabled, _ := r.reaperConfig(ctx)
+	for _, cached := range namespaces {
+		ns := *cached.DeepCopy()
 		if shouldIgnoreNamespace(ns) {
 			logger.Debugf("Ignoring namespace: %s", ns.GetName())
 			continue
 		}
+		// reaped namespaces are provisioned again on their first PipelineRun
+		if _, reaped := namespaceReapedAt(&ns); reaped && reaperEnabled {
+			logger.Debugf("Ignoring reaped namespace: %s", ns.GetName())
+			continue
+		}
+		if all {
+			result.InScope++
+		}
 
-		reconcileRBAC, err := r.needsRBAC(ctx, ns)
+		// the resources of the namespaces are verified by the full passes, and between them for
+		// the namespaces which are not known to be up to date
+		selfHeal := all || !r.namespaces.reconciled(ns.Name, r.version)
+		reconcileRBAC, err := r.needsRBAC(ctx, ns, selfHeal)
 		if err != nil {
 			return nil, err
 		}
@@ -378,9 +406,60 @@ func (r *rbac) getNamespacesToBeReconciled(ctx context.Context) (*NamespacesToRe
 		}
 	}
 
+	// the passes in between only evaluate some of the namespaces, the others are counted from the informer
+	if !all {
+		if result.InScope, err = r.namespacesInScope(reaperEnabled); err != nil {
+			return nil, err
+		}
+	}
 	return result, nil
 }
 
+// namespacesInScope returns the number of namespaces managed by the operator
+func (r *rbac) namespacesInScope(reaperEnabled bool) (int, error) {
+	namespaces, err := r.nsInformer.Lister().List(labels.Everything())
+	if err != nil {
+		return 0, err
+	}
+	count := 0
+	for _, ns := range namespaces {
+		if shouldIgnoreNamespace(*ns) {
+			continue
+		}
+		if _, reaped := namespaceReapedAt(ns); reaped && reaperEnabled {
+			continue
+		}
+		count++
+	}
+	return count, nil
+}
+
+// namespacesToEvaluate returns all the namespaces of the informer on the full passes, the index of
+// the namespaces is rebuilt from them, and the changed namespaces which still exist otherwise
+func (r *rbac) namespacesToEvaluate(all bool, changed map[string]bool) ([]*corev1.Namespace, error) {
+	if all {
+		namespaces, err := r.nsInformer.Lister().List(labels.Everything())
+		if err != nil {
+			return nil, err
+		}
+		r.namespaces.rebuild(namespaces)
+		return namespaces, nil
+	}
+	namespaces := make([]*corev1.Namespace, 0, len(changed))
+	for name := range changed {
+		ns, err := r.nsInformer.Lister().Get(name)
+		if errors.IsNotFound(err) {
+			r.namespaces.forget(name)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		namespaces = append(namespaces, ns)
+	}
+	return namespaces, nil
+}
+
 func (r *rbac) getSCCRoleInNamespace(ns *corev1.Namespace) *rbacv1.RoleRef {
 	nsAnnotations := ns.GetAnnotations()
 	nsSCC := nsAnnotations[openshift.NamespaceSCCAnnotation]
@@ -437,6 +516,7 @@ func (r *rbac) handleSCCInNamespace(ctx context.Context, ns *corev1.Namespace) e
 	// Make sure that SCC exists on cluster
 	if err := common.VerifySCCExists(ctx, nsSCC, r.securityClientSet); err != nil {
 		logger.Error(err)
+		recordSCCValidationFailure(sccValidationNotFound)
 
 		// Create an event in the namespace if the SCC does not exist
 		eventErr := r.createSCCFailureEventInNamespace(ctx, nsName, nsSCC)
@@ -461,6 +541,7 @@ func (r *rbac) handleSCCInNamespace(ctx context.Context, ns *corev1.Namespace) e
 		}
 		logger.Infof("Is maxAllowed SCC: %s less restrictive than namespace SCC: %s? %t", maxAllowedSCC, nsSCC, isPriority)
 		if !isPriority {
+			recordSCCValidationFailure(sccValidationNotAllowed)
 			return fmt.Errorf("namespace: %s has requested SCC: %s, but it is less restrictive than the 'maxAllowed' SCC: %s", nsName, nsSCC, maxAllowedSCC)
 		}
 	}
@@ -473,43 +554,65 @@ func (r *rbac) handleSCCInNamespace(ctx context.Context, ns *corev1.Namespace) e
 	return nil
 }
 
-// processRBAC encapsulates the logic for processing RBAC in a single namespace.
-func (r *rbac) processRBAC(ctx context.Context, ns corev1.Namespace) (*NamespaceServiceAccount, error) {
+// processRBAC encapsulates the logic for processing RBAC in a single namespace. It also returns
+// whether the ServiceAccount was created, even when a later step failed, the namespaces are
+// processed concurrently and the TektonConfig is labelled once they are all processed.
+func (r *rbac) processRBAC(ctx context.Context, ns corev1.Namespace) (*NamespaceServiceAccount, bool, error) {
 	logger := logging.FromContext(ctx)
 	logger.Infof("Processing RBAC for namespace %s", ns.GetName())
 
 	// Create or update ServiceAccount
-	sa, err := r.ensureSA(ctx, &ns)
+	sa, created, err := r.ensureSA(ctx, &ns)
 	if err != nil {
-		return nil, fmt.Errorf("failed to ensure ServiceAccount in namespace %s: %v", ns.Name, err)
+		return nil, false, fmt.Errorf("failed to ensure ServiceAccount in namespace %s: %v", ns.Name, err)
 	}
 
 	if sa == nil {
-		return nil, fmt.Errorf("ServiceAccount is nil for namespace %s", ns.Name)
+		return nil, created, fmt.Errorf("ServiceAccount is nil for namespace %s", ns.Name)
 	}
 
-	// Handle SCC in namespace
-	if err := r.handleSCCInNamespace(ctx, &ns); err != nil {
-		return nil, fmt.Errorf("failed to handle SCC in namespace %s: %v", ns.Name, err)
-	}
+	sccEnabled := r.subsystemEnabled(ctx, permissions.SubsystemSCC)
+	if sccEnabled {
+		// Handle SCC in namespace
+		if err := r.handleSCCInNamespace(ctx, &ns); err != nil {
+			return nil, created, fmt.Errorf("failed to handle SCC in namespace %s: %v", ns.Name, err)
+		}
 
-	// Get and apply role reference
-	roleRef := r.getSCCRoleInNamespace(&ns)
-	if roleRef != nil {
-		if err := r.ensurePipelinesSCCRoleBinding(ctx, sa, roleRef); err != nil {
-			return nil, fmt.Errorf("failed to ensure pipelines SCC role binding in namespace %s: %v", ns.Name, err)
+		// Get and apply role reference
+		roleRef := r.getSCCRoleInNamespace(&ns)
+		if roleRef != nil {
+			if err := r.ensurePipelinesSCCRoleBinding(ctx, sa, roleRef); err != nil {
+				return nil, created, fmt.Errorf("failed to ensure pipelines SCC role binding in namespace %s: %v", ns.Name, err)
+			}
 		}
 	}
 
 	// Ensure role bindings
 	if err := r.ensureRoleBindings(ctx, sa); err != nil {
-		return nil, fmt.Errorf("failed to ensure role bindings in namespace %s: %v", ns.Name, err)
+		return nil, created, fmt.Errorf("failed to ensure role bindings in namespace %s: %v", ns.Name, err)
+	}
+
+	// Add the configured groups and users to the edit RoleBinding
+	if err := r.ensureEditRoleBindingSubjects(ctx, &ns); err != nil {
+		return nil, created, fmt.Errorf("failed to ensure the subjects of the edit role binding in namespace %s: %v", ns.Name, err)
+	}
+
+	// Grant SCCs to additional ServiceAccounts
+	if sccEnabled {
+		if err := r.ensureSCCServiceAccounts(ctx, ns.Name); err != nil {
+			return nil, created, fmt.Errorf("failed to grant SCCs to additional ServiceAccounts in namespace %s: %v", ns.Name, err)
+		}
+	}
+
+	// Bind the additional ServiceAccounts next to the ServiceAccount of the operator
+	if err := r.ensureAdditionalServiceAccounts(ctx, ns.Name); err != nil {
+		return nil, created, fmt.Errorf("failed to bind the additional ServiceAccounts in namespace %s: %v", ns.Name, err)
 	}
 
 	return &NamespaceServiceAccount{
 		ServiceAccount: sa,
 		Namespace:      ns,
-	}, nil
+	}, created, nil
 }
 
 // patch namespace with reconciled label
@@ -518,23 +621,61 @@ func (r *rbac) patchNamespaceLabel(ctx context.Context, ns corev1.Namespace) err
 
 	logger.Infof("add label namespace-reconcile-version to mark namespace '%s' as reconciled", ns.Name)
 
-	// Prepare a patch to add/update just one label without overwriting others
-	patch := map[string]interface{}{
-		"metadata": map[string]interface{}{
-			"labels": map[string]interface{}{
-				namespaceVersionLabel: r.version,
-			},
-		},
+	// add/update just one label without overwriting others,
+	// a namespace which was reaped earlier is no longer marked as such
+	patch := reconcilerCommon.NamespaceMetadataPatch{
+		SetLabels:         map[string]string{namespaceVersionLabel: r.version},
+		RemoveAnnotations: []string{namespaceRBACReapedAnnotation},
 	}
-
-	patchPayload, err := json.Marshal(patch)
+	// record the SCCs granted to additional ServiceAccounts
+	sccServiceAccountsHash, err := r.sccServiceAccountsHash(ns.Name)
 	if err != nil {
-		logger.Errorf("failed to marshal patch payload: %v", err)
-		return fmt.Errorf("failed to marshal label patch for namespace %s: %w", ns.Name, err)
+		return err
 	}
-
-	// Use PATCH to update just the target label
-	if _, err := r.kubeClientSet.CoreV1().Namespaces().Patch(ctx, ns.Name, types.StrategicMergePatchType, patchPayload, metav1.PatchOptions{}); err != nil {
+	patch.SetAnnotations = map[string]string{}
+	if sccServiceAccountsHash == "" {
+		patch.RemoveAnnotations = append(patch.RemoveAnnotations, sccServiceAccountsHashAnnotation)
+	} else {
+		patch.SetAnnotations[sccServiceAccountsHashAnnotation] = sccServiceAccountsHash
+	}
+	// record the subjects added to the edit RoleBinding
+	editSubjectsHash, err := r.editSubjectsHash(&ns)
+	if err != nil {
+		return err
+	}
+	if editSubjectsHash == "" {
+		patch.RemoveAnnotations = append(patch.RemoveAnnotations, editSubjectsHashAnnotation)
+	} else {
+		patch.SetAnnotations[editSubjectsHashAnnotation] = editSubjectsHash
+	}
+	// record the additional ServiceAccounts bound in the namespace
+	additionalServiceAccountsHash, err := r.additionalServiceAccountsHash()
+	if err != nil {
+		return err
+	}
+	if additionalServiceAccountsHash == "" {
+		patch.RemoveAnnotations = append(patch.RemoveAnnotations, additionalServiceAccountsHashAnnotation)
+	} else {
+		patch.SetAnnotations[additionalServiceAccountsHashAnnotation] = additionalServiceAccountsHash
+	}
+	// record the name of the ServiceAccount
+	if name := r.customServiceAccountName(); name == "" {
+		patch.RemoveAnnotations = append(patch.RemoveAnnotations, serviceAccountNameAnnotation)
+	} else {
+		patch.SetAnnotations[serviceAccountNameAnnotation] = name
+	}
+	// record the ClusterRole of the edit RoleBinding
+	if name := r.customPipelineClusterRole(); name == "" {
+		patch.RemoveAnnotations = append(patch.RemoveAnnotations, pipelineClusterRoleAnnotation)
+	} else {
+		patch.SetAnnotations[pipelineClusterRoleAnnotation] = name
+	}
+	err = reconcilerCommon.PatchNamespaceMetadata(ctx, r.kubeClientSet, ns.Name, patch)
+	if reconcilerCommon.NamespaceGoingAway(ctx, r.kubeClientSet, ns.Name, err) {
+		logger.Infof("namespace '%s' is terminating, skipping label %q", ns.Name, namespaceVersionLabel)
+		return nil
+	}
+	if err != nil {
 		logger.Errorf("failed to patch namespace %s: %v", ns.Name, err)
 		return fmt.Errorf("failed to patch namespace %s: %w", ns.Name, err)
 	}
@@ -546,9 +687,21 @@ func (r *rbac) patchNamespaceLabel(ctx context.Context, ns corev1.Namespace) err
 // createResources handles the reconciliation of RBAC resources and CA bundle configmaps
 // across namespaces. It processes each feature independently based on their respective
 // configuration flags and only reconciles namespaces that need updates.
-func (r *rbac) createResources(ctx context.Context) error {
+func (r *rbac) createResources(ctx context.Context) (err error) {
 	logger := logging.FromContext(ctx)
 
+	// the namespaces which were not reconciled are evaluated again on the next reconcile
+	var failures *namespaceFailures
+	defer func() {
+		if err != nil {
+			r.namespaces.retryAll()
+			return
+		}
+		if failures != nil {
+			r.namespaces.recordOutcome(failures)
+		}
+	}()
+
 	// Step 1: Check feature flags
 	createCABundles := true
 	createRBACResource := true
@@ -565,6 +718,11 @@ func (r *rbac) createResources(ctx context.Context) error {
 		}
 	}
 
+	// Step 1a: Delete the events of the operator which no longer occur
+	if err := r.cleanupStaleEvents(ctx); err != nil {
+		logger.Errorf("failed to delete the stale events: %v", err)
+	}
+
 	// If both features are disabled, nothing to do
 	if !createCABundles && !createRBACResource {
 		logger.Info("Both CA bundle and RBAC creation are disabled, nothing to do")
@@ -579,7 +737,45 @@ func (r *rbac) createResources(ctx context.Context) error {
 		}
 	}
 
-	// Step 3: Get namespaces to be reconciled for both RBAC and CA bundles
+	clusterRolesEnabled := r.subsystemEnabled(ctx, permissions.SubsystemClusterRoles)
+	namespacePatchingEnabled := r.subsystemEnabled(ctx, permissions.SubsystemNamespacePatching)
+
+	// Step 2a: Rewrite the subjects bound to the clusterinterceptors ClusterRole when their mode changed
+	if createRBACResource && clusterRolesEnabled {
+		if err := r.ensureClusterInterceptorsSubjectsMode(ctx); err != nil {
+			logger.Errorf("failed to update the subjects of %s: %v", clusterInterceptors, err)
+			return err
+		}
+	}
+
+	// Step 3: Remove RBAC resources from namespaces without Tekton activity (opt-in)
+	if reaperEnabled, retention := r.reaperConfig(ctx); reaperEnabled && createRBACResource && namespacePatchingEnabled {
+		if err := r.reapInactiveNamespaces(ctx, retention); err != nil {
+			logger.Errorf("failed to reap inactive namespaces: %v", err)
+			return err
+		}
+	}
+
+	// Step 3a: Remove the subjects of the deleted namespaces from the bindings of the operator
+	if createRBACResource && clusterRolesEnabled {
+		if err := r.collectStaleSubjects(ctx); err != nil {
+			logger.Errorf("failed to remove the subjects of the deleted namespaces: %v", err)
+		}
+	}
+
+	// the onboarding state of the namespaces reconciled below is reported once they are processed
+	onboarding := newNamespaceOnboarding()
+	defer func() { r.updateNamespaceStatuses(ctx, onboarding, failures) }()
+
+	// Step 3b: Load the additional CAs, the trusted CA bundles of the namespaces are compared to them
+	if createCABundles {
+		if r.additionalCAs, err = r.loadAdditionalCAs(ctx); err != nil {
+			logger.Error(err)
+			return err
+		}
+	}
+
+	// Step 4: Get namespaces to be reconciled for both RBAC and CA bundles
 	namespacesToReconcile, err := r.getNamespacesToBeReconciled(ctx)
 	if err != nil {
 		logger.Error(err)
@@ -589,10 +785,38 @@ func (r *rbac) createResources(ctx context.Context) error {
 	// Early return if no namespaces need reconciliation for either feature
 	if len(namespacesToReconcile.RBACNamespaces) == 0 && len(namespacesToReconcile.CANamespaces) == 0 {
 		logger.Debug("No namespaces need reconciliation for either RBAC or CA bundles")
+		r.markNamespacesOutcome(&namespaceFailures{earlier: r.namespaces.failures()})
+		recordRBACCycle(0, 0)
+		return nil
+	}
+
+	// Step 4a: Count the namespaces to be patched before touching them, a large count waits for the confirmation
+	patches := r.namespacePatchConfig(ctx)
+	count := countNamespacesToPatch(namespacesToReconcile, createRBACResource, createCABundles)
+	if msg := patches.pendingConfirmation(count); msg != "" {
+		logger.Warn(msg)
+		r.tektonConfig.Status.MarkNamespacePatchesPendingConfirmation(msg)
+		r.namespaces.retryAll()
+		recordRBACCycle(0, count)
 		return nil
 	}
+	logger.Infof("%d namespaces will be reconciled", count)
 
-	// Step 4: Handle RBAC if enabled
+	// per-namespace failures are handled according to the failure policy
+	failures = r.namespaceFailurePolicy(ctx)
+	failures.inScope = namespacesToReconcile.InScope
+	defer func() {
+		reconciled := len(failures.processed) - len(failures.failed)
+		recordRBACCycle(reconciled, max(count-reconciled, 0))
+	}()
+	failFast := failures.policy == namespaceFailurePolicyFailFast
+
+	// the namespaces are processed by a pool of workers, sharing the events emitted in the namespaces
+	workers := r.rbacWorkerCount(ctx)
+	r.namespaceEvents(ctx)
+	logger.Debugf("processing the namespaces with %d workers", workers)
+
+	// Step 5: Handle RBAC if enabled
 	if createRBACResource {
 		if len(namespacesToReconcile.RBACNamespaces) == 0 {
 			logger.Debug("No namespaces need RBAC reconciliation")
@@ -600,92 +824,173 @@ func (r *rbac) createResources(ctx context.Context) error {
 			logger.Debugf("Found %d namespaces to be reconciled for RBAC", len(namespacesToReconcile.RBACNamespaces))
 
 			// Remove and update namespaces from Cluster Interceptors
-			if err := r.removeAndUpdateNSFromCI(ctx); err != nil {
-				logger.Error(err)
-				return err
+			if clusterRolesEnabled {
+				if err := r.removeAndUpdateNSFromCI(ctx); err != nil {
+					logger.Error(err)
+					return err
+				}
 			}
 
 			var namespacesToUpdate []NamespaceServiceAccount
-			// Process each namespace for RBAC
-			for _, ns := range namespacesToReconcile.RBACNamespaces {
+			// Process the namespaces for RBAC concurrently, the outcomes are recorded in their order
+			rbacNamespaces := namespacesToReconcile.RBACNamespaces
+			serviceAccounts := make([]*NamespaceServiceAccount, len(rbacNamespaces))
+			created := make([]bool, len(rbacNamespaces))
+			errs := processNamespaces(ctx, workers, rbacNamespaces, failFast, func(ctx context.Context, i int, ns corev1.Namespace) error {
 				logger.Infof("Processing namespace %s for RBAC", ns.Name)
-				nsSA, err := r.processRBAC(ctx, ns)
+				start := time.Now()
+				var err error
+				serviceAccounts[i], created[i], err = r.processRBAC(ctx, ns)
+				observeNamespaceReconcile(rbacStageRBAC, time.Since(start), err)
+				return err
+			})
+			// the TektonConfig is labelled once the workers are done, they share it
+			for _, c := range created {
+				if c {
+					r.markServiceAccountCreated()
+					break
+				}
+			}
+			for i, ns := range rbacNamespaces {
+				nsSA, err := serviceAccounts[i], errs[i]
+				if err == errNamespaceSkipped {
+					continue
+				}
+				if reconcilerCommon.NamespaceGoingAway(ctx, r.kubeClientSet, ns.Name, err) {
+					logger.Infof("namespace %s is terminating, skipping RBAC", ns.Name)
+					continue
+				}
 				if err != nil {
 					logger.Errorf("failed processing namespace %s: %v", ns.Name, err)
+					if err := failures.record(ns.Name, err); err != nil {
+						r.markNamespacesOutcome(failures)
+						return err
+					}
 					continue
 				}
+				failures.process(ns.Name)
 				namespacesToUpdate = append(namespacesToUpdate, *nsSA)
 			}
 
 			// Bulk update ClusterRoleBinding
-			if len(namespacesToUpdate) > 0 {
+			if len(namespacesToUpdate) > 0 && clusterRolesEnabled {
 				if err := r.handleClusterRoleBinding(ctx, namespacesToUpdate); err != nil {
 					logger.Errorf("failed to ensure clusterrolebinding update: %v", err)
 					return err
 				}
 				logger.Info("Successfully updated cluster role bindings")
+			}
+			if len(namespacesToUpdate) > 0 {
 
 				// Patch namespace labels for RBAC
+				namespaces := make([]corev1.Namespace, 0, len(namespacesToUpdate))
 				for _, nsSA := range namespacesToUpdate {
-					logger.Infof("Reconciling namespace %s for RBAC", nsSA.Namespace.Name)
-					if err := r.patchNamespaceLabel(ctx, nsSA.Namespace); err != nil {
-						logger.Errorf("failed reconciling namespace %s: %v", nsSA.Namespace.Name, err)
-					}
+					namespaces = append(namespaces, nsSA.Namespace)
+				}
+				// without the permission to patch them, the namespaces are evaluated again on every pass
+				var err error
+				if namespacePatchingEnabled {
+					err = patches.patch(ctx, namespaces, failures, r.patchNamespaceLabel)
 				}
+				for _, ns := range namespaces {
+					onboarding.rbacReconciled(ns, failures, r.namespaceSCC(ns))
+				}
+				if err != nil {
+					r.markNamespacesOutcome(failures)
+					return err
+				}
+
+				// Verify that the bindings of the reconciled namespaces grant the permissions of the pipelines
+				r.reviewPipelineAccess(ctx, workers, namespaces, onboarding)
 			}
 		}
 	}
 
-	// Step 5: Handle CA bundles if enabled
+	// Step 6: Handle CA bundles if enabled
 	if createCABundles {
 		if len(namespacesToReconcile.CANamespaces) == 0 {
 			logger.Debug("No namespaces need CA bundle reconciliation")
 		} else {
 			logger.Debugf("Found %d namespaces to be reconciled for CA bundles", len(namespacesToReconcile.CANamespaces))
 
-			for _, ns := range namespacesToReconcile.CANamespaces {
+			var namespacesToPatch []corev1.Namespace
+			errs := processNamespaces(ctx, workers, namespacesToReconcile.CANamespaces, failFast, func(ctx context.Context, _ int, ns corev1.Namespace) error {
 				logger.Infof("Processing namespace %s for CA bundles", ns.Name)
-				if err := r.ensureCABundlesInNamespace(ctx, &ns); err != nil {
-					logger.Errorf("failed to ensure CA bundles in namespace %s: %v", ns.Name, err)
+				start := time.Now()
+				err := r.ensureCABundlesInNamespace(ctx, &ns)
+				observeNamespaceReconcile(rbacStageCABundles, time.Since(start), err)
+				return err
+			})
+			for i, ns := range namespacesToReconcile.CANamespaces {
+				err := errs[i]
+				if err == errNamespaceSkipped {
 					continue
 				}
-				// Patch namespace with trusted configmaps label
-				if err := r.patchNamespaceTrustedConfigLabel(ctx, ns); err != nil {
-					logger.Errorf("failed to patch trusted config label for namespace %s: %v", ns.Name, err)
+				if reconcilerCommon.NamespaceGoingAway(ctx, r.kubeClientSet, ns.Name, err) {
+					logger.Infof("namespace %s is terminating, skipping CA bundles", ns.Name)
+					continue
 				}
+				if err != nil {
+					logger.Errorf("failed to ensure CA bundles in namespace %s: %v", ns.Name, err)
+					if err := failures.record(ns.Name, err); err != nil {
+						r.markNamespacesOutcome(failures)
+						return err
+					}
+					continue
+				}
+				failures.process(ns.Name)
+				namespacesToPatch = append(namespacesToPatch, ns)
+			}
+			// Patch namespaces with trusted configmaps label
+			var err error
+			if namespacePatchingEnabled {
+				err = patches.patch(ctx, namespacesToPatch, failures, r.patchNamespaceTrustedConfigLabel)
+			}
+			for _, ns := range namespacesToPatch {
+				onboarding.caReconciled(ns, failures)
+			}
+			if err != nil {
+				r.markNamespacesOutcome(failures)
+				return err
 			}
 		}
 	}
 
-	return nil
+	r.markNamespacesOutcome(failures)
+	return failures.exceeded()
+}
+
+// markNamespacesOutcome reflects the aggregate outcome of the per-namespace reconciliation
+// in the NamespacesReconciled condition of TektonConfig, and the failed namespaces in its status
+func (r *rbac) markNamespacesOutcome(failures *namespaceFailures) {
+	r.tektonConfig.Status.RBAC = r.rbacStatus(failures)
+	if len(failures.failed) == 0 {
+		r.tektonConfig.Status.MarkNamespacesReconciled()
+		return
+	}
+	r.tektonConfig.Status.MarkNamespacesReconcileFailed(failures.summary())
 }
 
 func (r *rbac) createSCCFailureEventInNamespace(ctx context.Context, namespace string, scc string) error {
 	logger := logging.FromContext(ctx)
 
-	failureEvent := corev1.Event{
-		ObjectMeta: metav1.ObjectMeta{
-			GenerateName:    "pipelines-scc-failure-",
-			Namespace:       namespace,
-			OwnerReferences: []metav1.OwnerReference{r.ownerRef},
+	logger.Infof("Creating SCC failure event in namespace: %s", namespace)
+	err := r.namespaceEvents(ctx).Emit(ctx, reconcilerCommon.Event{
+		Object: corev1.ObjectReference{
+			Kind:       "Namespace",
+			Name:       namespace,
+			APIVersion: "v1",
+			Namespace:  namespace,
 		},
-		EventTime:           metav1.NewMicroTime(time.Now()),
+		GenerateName:        "pipelines-scc-failure-",
+		Owner:               &r.ownerRef,
 		Reason:              "RequestedSCCNotFound",
-		Type:                "Warning",
+		Type:                corev1.EventTypeWarning,
 		Action:              "SCCNotUpdated",
 		Message:             fmt.Sprintf("SCC '%s' requested in annotation '%s' not found, SCC not updated in the namespace", scc, openshift.NamespaceSCCAnnotation),
 		ReportingController: "openshift-pipelines-operator",
 		ReportingInstance:   r.ownerRef.Name,
-		InvolvedObject: corev1.ObjectReference{
-			Kind:       "Namespace",
-			Name:       namespace,
-			APIVersion: "v1",
-			Namespace:  namespace,
-		},
-	}
-
-	logger.Infof("Creating SCC failure event in namespace: %s", namespace)
-	_, err := r.kubeClientSet.CoreV1().Events(namespace).Create(ctx, &failureEvent, metav1.CreateOptions{})
+	})
 	if err != nil {
 		return fmt.Errorf("failed to create failure event in namespace %s, %w", namespace, err)
 	}
@@ -693,147 +998,79 @@ func (r *rbac) createSCCFailureEventInNamespace(ctx context.Context, namespace s
 	return nil
 }
 
-func (r *rbac) ensureCABundles(ctx context.Context, ns *corev1.Namespace) error {
-	logger := logging.FromContext(ctx)
-	cfgInterface := r.kubeClientSet.CoreV1().ConfigMaps(ns.Name)
-
-	// Ensure trusted CA bundle
-	logger.Infof("finding configmap: %s/%s", ns.Name, trustedCABundleConfigMap)
-	caBundleCM, getErr := cfgInterface.Get(ctx, trustedCABundleConfigMap, metav1.GetOptions{})
-	if getErr != nil && !errors.IsNotFound(getErr) {
-		return getErr
-	}
-
-	if getErr != nil && errors.IsNotFound(getErr) {
-		logger.Infof("creating configmap %s in %s namespace", trustedCABundleConfigMap, ns.Name)
-		var err error
-		if caBundleCM, err = createCABundleConfigMaps(ctx, cfgInterface, trustedCABundleConfigMap, ns.Name); err != nil {
-			return err
-		}
-	}
-
-	// If config map already exist then remove owner ref
-	if getErr == nil {
-		caBundleCM.SetOwnerReferences(nil)
-		if _, err := cfgInterface.Update(ctx, caBundleCM, metav1.UpdateOptions{}); err != nil {
-			return err
-		}
-	}
-
-	// Ensure service CA bundle
-	logger.Infof("finding configmap: %s/%s", ns.Name, serviceCABundleConfigMap)
-	serviceCABundleCM, getErr := cfgInterface.Get(ctx, serviceCABundleConfigMap, metav1.GetOptions{})
-	if getErr != nil && !errors.IsNotFound(getErr) {
-		return getErr
-	}
-
-	if getErr != nil && errors.IsNotFound(getErr) {
-		logger.Infof("creating configmap %s in %s namespace", serviceCABundleConfigMap, ns.Name)
-		var err error
-		if serviceCABundleCM, err = createServiceCABundleConfigMap(ctx, cfgInterface, serviceCABundleConfigMap, ns.Name); err != nil {
-			return err
-		}
-	}
-
-	// If config map already exist then remove owner ref
-	if getErr == nil {
-		serviceCABundleCM.SetOwnerReferences(nil)
-		if _, err := cfgInterface.Update(ctx, serviceCABundleCM, metav1.UpdateOptions{}); err != nil {
-			return err
-		}
-	}
-
-	return nil
+// onboarder creates the ServiceAccount, RoleBinding and CA bundle ConfigMaps of the namespaces
+func (r *rbac) onboarder() *namespacerbac.Onboarder {
+	var saOwnerRef metav1.OwnerReference
+	if r.tektonConfig != nil {
+		saOwnerRef = tektonConfigOwnerRef(*r.tektonConfig)
+	}
+	return namespacerbac.New(r.kubeClientSet, r.ownerRef, saOwnerRef).
+		WithServiceAccountName(r.serviceAccountName()).
+		WithEditClusterRole(r.customPipelineClusterRole()).
+		WithCABundleConfigMaps(caBundleConfigMaps(r.tektonConfig)).
+		WithAdditionalCAs(r.additionalCAs).
+		WithMutationRecorder(r.recordMutation)
 }
 
-func createCABundleConfigMaps(ctx context.Context, cfgInterface v1.ConfigMapInterface,
-	name, ns string) (*corev1.ConfigMap, error) {
-	c := &corev1.ConfigMap{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      name,
-			Namespace: ns,
-			Labels: map[string]string{
-				"app.kubernetes.io/part-of": "tekton-pipelines",
-				// user-provided and system CA certificates
-				"config.openshift.io/inject-trusted-cabundle": "true",
-			},
-			// No OwnerReferences
-		},
-	}
+// serviceAccountName returns the name of the ServiceAccount created in the namespaces
+func (r *rbac) serviceAccountName() string {
+	return serviceAccountName(r.tektonConfig)
+}
 
-	cm, err := cfgInterface.Create(ctx, c, metav1.CreateOptions{})
-	if err != nil && !errors.IsAlreadyExists(err) {
-		return nil, err
+// serviceAccountName returns the ServiceAccount name of the TektonConfig, the pipeline
+// ServiceAccount by default
+func serviceAccountName(tc *v1alpha1.TektonConfig) string {
+	if tc != nil && tc.Spec.Platforms.OpenShift.RBAC != nil && tc.Spec.Platforms.OpenShift.RBAC.ServiceAccountName != "" {
+		return tc.Spec.Platforms.OpenShift.RBAC.ServiceAccountName
 	}
-	return cm, nil
+	return pipelineSA
 }
 
-func createServiceCABundleConfigMap(ctx context.Context, cfgInterface v1.ConfigMapInterface,
-	name, ns string) (*corev1.ConfigMap, error) {
-	c := &corev1.ConfigMap{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      name,
-			Namespace: ns,
-			Labels: map[string]string{
-				"app.kubernetes.io/part-of": "tekton-pipelines",
-			},
-			Annotations: map[string]string{
-				// service serving certificates (required to talk to the internal registry)
-				"service.beta.openshift.io/inject-cabundle": "true",
-			},
-			// No OwnerReferences
-		},
-	}
+// serviceAccountNameAnnotation holds the name of the ServiceAccount created in a namespace when it
+// is not the pipeline ServiceAccount, the namespace is reconciled again when the name changes
+const serviceAccountNameAnnotation = "openshift-pipelines.tekton.dev/service-account-name"
 
-	cm, err := cfgInterface.Create(ctx, c, metav1.CreateOptions{})
-	if err != nil && !errors.IsAlreadyExists(err) {
-		return nil, err
+// customServiceAccountName returns the name of the ServiceAccount created in the namespaces when it
+// is not the pipeline ServiceAccount, it is recorded on the reconciled namespaces
+func (r *rbac) customServiceAccountName() string {
+	if name := r.serviceAccountName(); name != pipelineSA {
+		return name
 	}
-	return cm, nil
+	return ""
 }
 
-func (r *rbac) ensureSA(ctx context.Context, ns *corev1.Namespace) (*corev1.ServiceAccount, error) {
-	logger := logging.FromContext(ctx)
-	logger.Infof("finding sa: %s/%s", ns.Name, "pipeline")
-	saInterface := r.kubeClientSet.CoreV1().ServiceAccounts(ns.Name)
+// pipelineClusterRoleAnnotation holds the ClusterRole of the edit RoleBinding in a namespace when
+// it is not the edit ClusterRole, the namespace is reconciled again when it changes
+const pipelineClusterRoleAnnotation = "openshift-pipelines.tekton.dev/pipeline-cluster-role"
 
-	sa, err := saInterface.Get(ctx, pipelineSA, metav1.GetOptions{})
-	if err != nil && !errors.IsNotFound(err) {
-		return nil, err
+// customPipelineClusterRole returns the ClusterRole bound by the edit RoleBinding when it is not
+// the edit ClusterRole, it is recorded on the reconciled namespaces
+func (r *rbac) customPipelineClusterRole() string {
+	if r.tektonConfig == nil || r.tektonConfig.Spec.Platforms.OpenShift.RBAC == nil {
+		return ""
 	}
-	if err != nil && errors.IsNotFound(err) {
-		logger.Info("creating sa ", pipelineSA, " ns", ns.Name)
-		return createSA(ctx, saInterface, ns.Name, *r.tektonConfig)
+	if name := r.tektonConfig.Spec.Platforms.OpenShift.RBAC.PipelineClusterRole; name != namespacerbac.EditClusterRole {
+		return name
 	}
-
-	// set tektonConfig ownerRef
-	tcOwnerRef := tektonConfigOwnerRef(*r.tektonConfig)
-	sa.SetOwnerReferences([]metav1.OwnerReference{tcOwnerRef})
-
-	return saInterface.Update(ctx, sa, metav1.UpdateOptions{})
+	return ""
 }
 
-func createSA(ctx context.Context, saInterface v1.ServiceAccountInterface, ns string, tc v1alpha1.TektonConfig) (*corev1.ServiceAccount, error) {
-	tcOwnerRef := tektonConfigOwnerRef(tc)
-	sa := &corev1.ServiceAccount{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:            pipelineSA,
-			Namespace:       ns,
-			OwnerReferences: []metav1.OwnerReference{tcOwnerRef},
-		},
-	}
+func (r *rbac) ensureCABundles(ctx context.Context, ns *corev1.Namespace) error {
+	return r.onboarder().EnsureCABundles(ctx, ns)
+}
 
-	sa, err := saInterface.Create(ctx, sa, metav1.CreateOptions{})
-	if err != nil && !errors.IsAlreadyExists(err) {
-		return nil, err
-	}
+// ensureSA ensures the ServiceAccount of the namespace and returns whether it was created, it runs
+// on the workers of the namespaces and does not change the TektonConfig
+func (r *rbac) ensureSA(ctx context.Context, ns *corev1.Namespace) (*corev1.ServiceAccount, bool, error) {
+	return r.onboarder().EnsureServiceAccount(ctx, ns.Name)
+}
 
-	// Initialize labels map if it doesn't exist
-	if tc.Labels == nil {
-		tc.Labels = make(map[string]string)
+// markServiceAccountCreated labels the TektonConfig once the operator created a ServiceAccount
+func (r *rbac) markServiceAccountCreated() {
+	if r.tektonConfig.Labels == nil {
+		r.tektonConfig.Labels = make(map[string]string)
 	}
-	tc.Labels[serviceAccountCreationLabel] = "true"
-	return sa, nil
+	r.tektonConfig.Labels[serviceAccountCreationLabel] = "true"
 }
 
 // ensureSCCRoleInNamespace ensures that the SCC role exists in the namespace
@@ -870,13 +1107,18 @@ func (r *rbac) ensureSCCRoleInNamespace(ctx context.Context, namespace string, s
 	if _, err := rbacClient.Roles(namespace).Get(ctx, pipelinesSCCRole, metav1.GetOptions{}); err != nil {
 		// If the role does not exist, then create it and exit
 		if errors.IsNotFound(err) {
-			_, err = rbacClient.Roles(namespace).Create(ctx, sccRole, metav1.CreateOptions{})
+			err = reconcilerCommon.Retry(ctx, "create role", func() error {
+				_, err := rbacClient.Roles(namespace).Create(ctx, sccRole, metav1.CreateOptions{})
+				return err
+			})
 		}
 		return err
 	}
 	// Update the role if it already exists
-	_, err := rbacClient.Roles(namespace).Update(ctx, sccRole, metav1.UpdateOptions{})
-	return err
+	return reconcilerCommon.Retry(ctx, "update role", func() error {
+		_, err := rbacClient.Roles(namespace).Update(ctx, sccRole, metav1.UpdateOptions{})
+		return err
+	})
 }
 
 // ensurePipelinesSCClusterRole ensures that `pipelines-scc` ClusterRole exists
@@ -913,12 +1155,17 @@ func (r *rbac) ensurePipelinesSCClusterRole(ctx context.Context) error {
 	rbacClient := r.kubeClientSet.RbacV1()
 	if _, err := rbacClient.ClusterRoles().Get(ctx, pipelinesSCCClusterRole, metav1.GetOptions{}); err != nil {
 		if errors.IsNotFound(err) {
-			_, err = rbacClient.ClusterRoles().Create(ctx, clusterRole, metav1.CreateOptions{})
+			err = reconcilerCommon.Retry(ctx, "create clusterrole", func() error {
+				_, err := rbacClient.ClusterRoles().Create(ctx, clusterRole, metav1.CreateOptions{})
+				return err
+			})
 		}
 		return err
 	}
-	_, err := rbacClient.ClusterRoles().Update(ctx, clusterRole, metav1.UpdateOptions{})
-	return err
+	return reconcilerCommon.Retry(ctx, "update clusterrole", func() error {
+		_, err := rbacClient.ClusterRoles().Update(ctx, clusterRole, metav1.UpdateOptions{})
+		return err
+	})
 }
 
 func (r *rbac) ensurePipelinesSCCRoleBinding(ctx context.Context, sa *corev1.ServiceAccount, roleRef *rbacv1.RoleRef) error {
@@ -951,7 +1198,11 @@ func (r *rbac) ensurePipelinesSCCRoleBinding(ctx context.Context, sa *corev1.Ser
 	}
 
 	if rbErr != nil && errors.IsNotFound(rbErr) {
-		return r.createSCCRoleBinding(ctx, sa, roleRef)
+		if err := r.createSCCRoleBinding(ctx, sa, roleRef); err != nil {
+			return err
+		}
+		r.onboarder().RecordRoleBindingCreated(ctx, sa.Namespace, pipelinesSCCRoleBinding, *roleRef)
+		return nil
 	}
 
 	// We cannot update RoleRef in a RoleBinding, we need to delete and
@@ -962,11 +1213,15 @@ func (r *rbac) ensurePipelinesSCCRoleBinding(ctx context.Context, sa *corev1.Ser
 		if err != nil {
 			return err
 		}
-		return r.createSCCRoleBinding(ctx, sa, roleRef)
+		if err := r.createSCCRoleBinding(ctx, sa, roleRef); err != nil {
+			return err
+		}
+		r.onboarder().RecordRoleBindingRecreated(ctx, sa.Namespace, pipelinesSCCRoleBinding, pipelineRB.RoleRef, *roleRef)
+		return nil
 	}
 
 	logger.Info("found rbac", "subjects", pipelineRB.Subjects)
-	return r.updateRoleBinding(ctx, pipelineRB, sa, roleRef)
+	return r.onboarder().UpdateRoleBinding(ctx, pipelineRB, sa, roleRef)
 }
 
 func (r *rbac) createSCCRoleBinding(ctx context.Context, sa *corev1.ServiceAccount, roleRef *rbacv1.RoleRef) error {
@@ -984,133 +1239,21 @@ func (r *rbac) createSCCRoleBinding(ctx context.Context, sa *corev1.ServiceAccou
 		Subjects: []rbacv1.Subject{{Kind: rbacv1.ServiceAccountKind, Name: sa.Name, Namespace: sa.Namespace}},
 	}
 
-	_, err := rbacClient.RoleBindings(sa.Namespace).Create(ctx, rb, metav1.CreateOptions{})
+	err := reconcilerCommon.Retry(ctx, "create rolebinding", func() error {
+		_, err := rbacClient.RoleBindings(sa.Namespace).Create(ctx, rb, metav1.CreateOptions{})
+		return err
+	})
 	if err != nil {
 		logger.Error(err, "creation of rolebinding failed:", pipelinesSCCRoleBinding)
 	}
 	return err
 }
 
-func (r *rbac) updateRoleBinding(ctx context.Context, rb *rbacv1.RoleBinding, sa *corev1.ServiceAccount, roleRef *rbacv1.RoleRef) error {
-	logger := logging.FromContext(ctx)
-
-	subject := rbacv1.Subject{Kind: rbacv1.ServiceAccountKind, Name: sa.Name, Namespace: sa.Namespace}
-
-	hasSubject := hasSubject(rb.Subjects, subject)
-	if !hasSubject {
-		rb.Subjects = append(rb.Subjects, subject)
-	}
-
-	rb.RoleRef = *roleRef
-
-	rbacClient := r.kubeClientSet.RbacV1()
-	hasOwnerRef := hasOwnerRefernce(rb.GetOwnerReferences(), r.ownerRef)
-
-	ownerRef := r.updateOwnerRefs(rb.GetOwnerReferences())
-	rb.SetOwnerReferences(ownerRef)
-
-	// If owners are different then we need to set from r.ownerRef and update the roleBinding.
-	if !hasOwnerRef {
-		if _, err := rbacClient.RoleBindings(sa.Namespace).Update(ctx, rb, metav1.UpdateOptions{}); err != nil {
-			logger.Error(err, "failed to update edit rb")
-			return err
-		}
-	}
-
-	if hasSubject && (len(ownerRef) != 0) {
-		logger.Info("rolebinding is up to date ", "action ", "none")
-		return nil
-	}
-
-	logger.Infof("update existing rolebinding %s/%s", rb.Namespace, rb.Name)
-
-	_, err := rbacClient.RoleBindings(sa.Namespace).Update(ctx, rb, metav1.UpdateOptions{})
-	if err != nil {
-		logger.Errorf("%v: failed to update rolebinding %s/%s", err, rb.Namespace, rb.Name)
-		return err
-	}
-	logger.Infof("successfully updated rolebinding %s/%s", rb.Namespace, rb.Name)
-	return nil
-}
-
-func hasSubject(subjects []rbacv1.Subject, x rbacv1.Subject) bool {
-	for _, v := range subjects {
-		if v.Name == x.Name && v.Kind == x.Kind && v.Namespace == x.Namespace {
-			return true
-		}
-	}
-	return false
-}
-
-// CompareLists compares two slices of rbacv1.Subject, ignoring order
+// CompareSubjects compares two slices of rbacv1.Subject, ignoring order
+//
+// Deprecated: use namespacerbac.CompareSubjects
 func CompareSubjects(list1, list2 []rbacv1.Subject) bool {
-	// Check if lengths are different
-	if len(list1) != len(list2) {
-		return false
-	}
-	// Create sets (maps) for both lists
-	set1 := make(map[string]struct{})
-	set2 := make(map[string]struct{})
-
-	// Populate set1 with subjects from list1
-	for _, subject := range list1 {
-		key := fmt.Sprintf("%s/%s/%s", subject.Kind, subject.Name, subject.Namespace)
-		set1[key] = struct{}{}
-	}
-	// Populate set2 with subjects from list2
-	for _, subject := range list2 {
-		key := fmt.Sprintf("%s/%s/%s", subject.Kind, subject.Name, subject.Namespace)
-		set2[key] = struct{}{}
-	}
-
-	// Compare the sets
-	if len(set1) != len(set2) {
-		return false
-	}
-
-	// Check if all elements in set1 are in set2
-	for key := range set1 {
-		if _, exists := set2[key]; !exists {
-			return false
-		}
-	}
-	return true
-}
-
-func mergeSubjects(subjects []rbacv1.Subject, x []rbacv1.Subject) []rbacv1.Subject {
-	// Map to track subjects in the existing list
-	existingSubjects := make(map[string]struct{})
-
-	// Iterate over `subjects` and track each unique combination of Kind, Name, and Namespace
-	for _, subject := range subjects {
-		key := fmt.Sprintf("%s/%s/%s", subject.Kind, subject.Name, subject.Namespace)
-		existingSubjects[key] = struct{}{}
-	}
-
-	// Final list to store the merged subjects
-	var finalSubjects []rbacv1.Subject
-
-	// Add all subjects from the original list (list1)
-	finalSubjects = append(finalSubjects, subjects...)
-
-	// Append subjects from `x` (list2) that are not in `existingSubjects`
-	for _, subject := range x {
-		key := fmt.Sprintf("%s/%s/%s", subject.Kind, subject.Name, subject.Namespace)
-		if _, found := existingSubjects[key]; !found {
-			finalSubjects = append(finalSubjects, subject)
-		}
-	}
-
-	return finalSubjects
-}
-
-func hasOwnerRefernce(old []metav1.OwnerReference, new metav1.OwnerReference) bool {
-	for _, v := range old {
-		if v.APIVersion == new.APIVersion && v.Kind == new.Kind && v.Name == new.Name {
-			return true
-		}
-	}
-	return false
+	return namespacerbac.CompareSubjects(list1, list2)
 }
 
 func (r *rbac) isLegacyRBACEnabled() bool {
@@ -1123,74 +1266,16 @@ func (r *rbac) isLegacyRBACEnabled() bool {
 }
 
 func (r *rbac) ensureRoleBindings(ctx context.Context, sa *corev1.ServiceAccount) error {
-	logger := logging.FromContext(ctx)
-	rbacClient := r.kubeClientSet.RbacV1()
-
-	legacyEnabled := r.isLegacyRBACEnabled()
-
-	editRB, err := rbacClient.RoleBindings(sa.Namespace).Get(ctx, PipelineRoleBinding, metav1.GetOptions{})
-
-	if !legacyEnabled && err == nil {
-		logger.Infof("Legacy Pipeline RBAC is disabled, removing existing role binding %s/%s",
-			editRB.Namespace, editRB.Name)
-		return rbacClient.RoleBindings(sa.Namespace).Delete(ctx, PipelineRoleBinding, metav1.DeleteOptions{})
-	}
-
-	if !legacyEnabled {
-		logger.Infof("Legacy Pipeline RBAC is disabled, skipping role binding creation")
-		return nil
-	}
-
-	logger.Infof("Legacy Pipeline RBAC is enabled")
-
-	if err == nil {
-		logger.Infof("Found rolebinding %s/%s, updating if needed", editRB.Namespace, editRB.Name)
-		return r.updateRoleBinding(ctx, editRB, sa, &rbacv1.RoleRef{
-			APIGroup: rbacv1.GroupName,
-			Kind:     "ClusterRole",
-			Name:     "edit",
-		})
-	}
-
-	if errors.IsNotFound(err) {
-		logger.Infof("Role binding not found, creating new one")
-		return r.createRoleBinding(ctx, sa)
-	}
-
-	return err
+	return r.onboarder().EnsureEditRoleBinding(ctx, sa, r.isLegacyRBACEnabled())
 }
 
-func (r *rbac) createRoleBinding(ctx context.Context, sa *corev1.ServiceAccount) error {
+func (r *rbac) removeAndUpdateNSFromCI(ctx context.Context) error {
 	logger := logging.FromContext(ctx)
 
-	logger.Infof("create new rolebinding %s/%s", sa.Namespace, sa.Name)
-	rbacClient := r.kubeClientSet.RbacV1()
-
-	logger.Info("finding clusterrole edit")
-	if _, err := rbacClient.ClusterRoles().Get(ctx, "edit", metav1.GetOptions{}); err != nil {
-		logger.Error(err, "getting clusterRole 'edit' failed")
-		return err
-	}
-
-	rb := &rbacv1.RoleBinding{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:            PipelineRoleBinding,
-			Namespace:       sa.Namespace,
-			OwnerReferences: []metav1.OwnerReference{r.ownerRef},
-		},
-		RoleRef:  rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: "edit"},
-		Subjects: []rbacv1.Subject{{Kind: rbacv1.ServiceAccountKind, Name: sa.Name, Namespace: sa.Namespace}},
-	}
-
-	if _, err := rbacClient.RoleBindings(sa.Namespace).Create(ctx, rb, metav1.CreateOptions{}); err != nil {
-		logger.Errorf("%v: failed creation of rolebinding %s/%s", err, rb.Namespace, rb.Name)
-		return err
+	// the group of all the ServiceAccounts does not change with the namespaces
+	if r.clusterInterceptorsSubjectsMode() == clusterInterceptorsAllServiceAccounts {
+		return nil
 	}
-	return nil
-}
-
-func (r *rbac) removeAndUpdateNSFromCI(ctx context.Context) error {
-	logger := logging.FromContext(ctx)
 
 	rbacClient := r.kubeClientSet.RbacV1()
 	rb, err := r.rbacInformer.Lister().Get(clusterInterceptors)
@@ -1222,7 +1307,7 @@ func (r *rbac) removeAndUpdateNSFromCI(ctx context.Context) error {
 	var update bool
 	for i := 0; i <= len(rb.Subjects)-1; i++ {
 		if len(nsMap) != len(rb.Subjects) {
-			if _, ok := nsMap[rb.Subjects[i].Namespace]; !ok {
+			if _, ok := nsMap[subjectNamespace(rb.Subjects[i])]; !ok {
 				rb.Subjects = removeIndex(rb.Subjects, i)
 				update = true
 			}
@@ -1255,6 +1340,7 @@ func (r *rbac) handleClusterRoleBinding(ctx context.Context, namespacesToUpdate
 
 	// Prepare a list of Subjects from the namespacesToUpdate
 	var subjects []rbacv1.Subject
+	mode := r.clusterInterceptorsSubjectsMode()
 
 	for _, nsSA := range namespacesToUpdate {
 		sa := nsSA.ServiceAccount
@@ -1263,14 +1349,12 @@ func (r *rbac) handleClusterRoleBinding(ctx context.Context, namespacesToUpdate
 		logger.Infof("Processing Subject for ServiceAccount %s in Namespace %s", sa.Name, ns.Name)
 
 		// Create the Subject for the ClusterRoleBinding
-		subject := rbacv1.Subject{
-			Kind:      rbacv1.ServiceAccountKind,
-			Name:      sa.Name,
-			Namespace: sa.Namespace,
-		}
+		subject := clusterInterceptorsSubject(mode, sa.Namespace, sa.Name)
 
 		// Append the subject to the list
-		subjects = append(subjects, subject)
+		if !namespacerbac.HasSubject(subjects, subject) {
+			subjects = append(subjects, subject)
+		}
 	}
 
 	logger.Info("finding cluster-role-binding ", clusterInterceptors)
@@ -1296,11 +1380,11 @@ func (r *rbac) bulkUpdateClusterRoleBinding(ctx context.Context, rb *rbacv1.Clus
 
 	hasSubject := CompareSubjects(rb.Subjects, subjectlist)
 	if !hasSubject {
-		rb.Subjects = mergeSubjects(rb.Subjects, subjectlist)
+		rb.Subjects = namespacerbac.MergeSubjects(rb.Subjects, subjectlist)
 	}
 
 	rbacClient := r.kubeClientSet.RbacV1()
-	hasOwnerRef := hasOwnerRefernce(rb.GetOwnerReferences(), r.ownerRef)
+	hasOwnerRef := namespacerbac.HasOwnerReference(rb.GetOwnerReferences(), r.ownerRef)
 
 	ownerRef := r.updateOwnerRefs(rb.GetOwnerReferences())
 	rb.SetOwnerReferences(ownerRef)
@@ -1350,7 +1434,11 @@ func (r *rbac) bulkCreateClusterRoleBinding(ctx context.Context, subjectlist []r
 		Subjects: subjectlist,
 	}
 
-	if _, err := rbacClient.ClusterRoleBindings().Create(ctx, crb, metav1.CreateOptions{}); err != nil {
+	err := reconcilerCommon.Retry(ctx, "create clusterrolebinding", func() error {
+		_, err := rbacClient.ClusterRoleBindings().Create(ctx, crb, metav1.CreateOptions{})
+		return err
+	})
+	if err != nil {
 		logger.Error(err, " creation of "+clusterInterceptors+" failed")
 		return err
 	}
@@ -1375,131 +1463,19 @@ func (r *rbac) createClusterRole(ctx context.Context) error {
 		}},
 	}
 
-	if _, err := rbacClient.ClusterRoles().Create(ctx, cr, metav1.CreateOptions{}); err != nil {
-		logger.Error(err, "creation of "+clusterInterceptors+" clusterrole failed")
+	err := reconcilerCommon.Retry(ctx, "create clusterrole", func() error {
+		_, err := rbacClient.ClusterRoles().Create(ctx, cr, metav1.CreateOptions{})
 		return err
-	}
-	return nil
-}
-
-func (r *rbac) updateOwnerRefs(ownerRef []metav1.OwnerReference) []metav1.OwnerReference {
-	if len(ownerRef) == 0 {
-		return []metav1.OwnerReference{r.ownerRef}
-	}
-
-	for i, ref := range ownerRef {
-		if ref.APIVersion != r.ownerRef.APIVersion || ref.Kind != r.ownerRef.Kind || ref.Name != r.ownerRef.Name {
-			// if owner reference are different remove the existing oand override with r.ownerRef
-			return r.removeAndUpdate(ownerRef, i)
-		}
-	}
-
-	return ownerRef
-}
-
-func (r *rbac) removeAndUpdate(slice []metav1.OwnerReference, s int) []metav1.OwnerReference {
-	ownerRef := append(slice[:s], slice[s+1:]...)
-	ownerRef = append(ownerRef, r.ownerRef)
-	return ownerRef
-}
-
-// TODO: Remove this after v0.55.0 release, by following a depreciation notice
-// --------------------
-// cleanUpRBACNameChange will check remove ownerReference: RBAC installerset from
-// 'edit' rolebindings from all relevant namespaces
-// it will also remove 'pipeline' sa from subject list as
-// the new 'openshift-pipelines-edit' rolebinding
-func (r *rbac) cleanUpRBACNameChange(ctx context.Context) error {
-	rbacClient := r.kubeClientSet.RbacV1()
-
-	// fetch the list of all namespaces
-	namespaces, err := r.kubeClientSet.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+	})
 	if err != nil {
+		logger.Error(err, "creation of "+clusterInterceptors+" clusterrole failed")
 		return err
 	}
-
-	for _, ns := range namespaces.Items {
-		nsName := ns.GetName()
-
-		// filter namespaces:
-		// ignore ns with name passing regex `^(openshift|kube)-`
-		if ignore := nsRegex.MatchString(nsName); ignore {
-			continue
-		}
-
-		// check if "edit" rolebinding exists in "ns" namespace
-		editRB, err := rbacClient.RoleBindings(ns.GetName()).
-			Get(ctx, pipelineRoleBindingOld, metav1.GetOptions{})
-		if err != nil {
-			// if "edit" rolebinding does not exists in "ns" namesapce, then do nothing
-			if errors.IsNotFound(err) {
-				continue
-			}
-			return err
-		}
-
-		// check if 'pipeline' serviceaccount is listed as a subject in 'edit' rolebinding
-		depSub := rbacv1.Subject{Kind: rbacv1.ServiceAccountKind, Name: pipelineSA, Namespace: nsName}
-		subIdx := math.MinInt16
-		for i, s := range editRB.Subjects {
-			if s.Name == depSub.Name && s.Kind == depSub.Kind && s.Namespace == depSub.Namespace {
-				subIdx = i
-				break
-			}
-		}
-
-		// if 'pipeline' serviceaccount is listed as a subject in 'edit' rolebinding
-		// remove 'pipeline' serviceaccount from subject list
-		if subIdx >= 0 {
-			editRB.Subjects = append(editRB.Subjects[:subIdx], editRB.Subjects[subIdx+1:]...)
-		}
-
-		// if 'pipeline' serviceaccount was the only item in the subject list of 'edit' rolebinding,
-		// then we can delete 'edit' rolebinding as nobody else is using it
-		if len(editRB.Subjects) == 0 {
-			if err := rbacClient.RoleBindings(nsName).Delete(ctx, editRB.GetName(), metav1.DeleteOptions{}); err != nil {
-				return err
-			}
-			continue
-		}
-
-		// remove TektonInstallerSet ownerReferece from "edit" rolebinding
-		ownerRefs := editRB.GetOwnerReferences()
-		ownerRefIdx := math.MinInt16
-		for i, ownerRef := range ownerRefs {
-			if ownerRef.Kind == "TektonInstallerSet" {
-				ownerRefIdx = i
-				break
-			}
-		}
-		if ownerRefIdx >= 0 {
-			ownerRefs := append(ownerRefs[:ownerRefIdx], ownerRefs[ownerRefIdx+1:]...)
-			editRB.SetOwnerReferences(ownerRefs)
-
-		}
-
-		// if ownerReference or subject was updated, then update editRB resource on cluster
-		if ownerRefIdx < 0 && subIdx < 0 {
-			continue
-		}
-		if _, err := rbacClient.RoleBindings(nsName).Update(ctx, editRB, metav1.UpdateOptions{}); err != nil {
-			return err
-		}
-	}
 	return nil
 }
 
-// TODO: Remove this after v0.55.0 release, by following a depreciation notice
-// --------------------
-func (r *rbac) removeObsoleteRBACInstallerSet(ctx context.Context) error {
-	isClient := r.operatorClientSet.OperatorV1alpha1().TektonInstallerSets()
-	err := isClient.Delete(ctx, rbacInstallerSetNameOld, metav1.DeleteOptions{})
-	if err != nil {
-		if !errors.IsNotFound(err) {
-			return err
-		}
-	}
-	return nil
+func (r *rbac) updateOwnerRefs(ownerRef []metav1.OwnerReference) []metav1.OwnerReference {
+	return r.onboarder().OwnerReferences(ownerRef)
 }
 
 func (r *rbac) ensureCABundlesInNamespace(ctx context.Context, ns *corev1.Namespace) error {
@@ -1514,23 +1490,15 @@ func (r *rbac) patchNamespaceTrustedConfigLabel(ctx context.Context, ns corev1.N
 
 	logger.Infof("add label namespace-trusted-configmaps-version to mark namespace '%s' as reconciled", ns.Name)
 
-	// Prepare a patch to add/update just one label without overwriting others
-	patch := map[string]interface{}{
-		"metadata": map[string]interface{}{
-			"labels": map[string]interface{}{
-				namespaceTrustedConfigLabel: r.version,
-			},
-		},
+	// add/update just one label without overwriting others
+	err := reconcilerCommon.PatchNamespaceMetadata(ctx, r.kubeClientSet, ns.Name, reconcilerCommon.NamespaceMetadataPatch{
+		SetLabels: map[string]string{namespaceTrustedConfigLabel: r.version},
+	})
+	if reconcilerCommon.NamespaceGoingAway(ctx, r.kubeClientSet, ns.Name, err) {
+		logger.Infof("namespace '%s' is terminating, skipping label %q", ns.Name, namespaceTrustedConfigLabel)
+		return nil
 	}
-
-	patchPayload, err := json.Marshal(patch)
 	if err != nil {
-		logger.Errorf("failed to marshal patch payload: %v", err)
-		return fmt.Errorf("failed to marshal label patch for namespace %s: %w", ns.Name, err)
-	}
-
-	// Use PATCH to update just the target label
-	if _, err := r.kubeClientSet.CoreV1().Namespaces().Patch(ctx, ns.Name, types.StrategicMergePatchType, patchPayload, metav1.PatchOptions{}); err != nil {
 		logger.Errorf("failed to patch namespace %s: %v", ns.Name, err)
 		return fmt.Errorf("failed to patch namespace %s: %w", ns.Name, err)
 	}
@@ -0,0 +1,194 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonconfig
+
+import (
+	"context"
+	goerrors "errors"
+	"fmt"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	reconcilerCommon "github.com/tektoncd/operator/pkg/reconciler/common"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/logging"
+)
+
+// cleanupOnDelete returns whether the resources created in the namespaces are deleted along
+// with the TektonConfig, they are by default
+func cleanupOnDelete(tc *v1alpha1.TektonConfig) bool {
+	rbac := tc.Spec.Platforms.OpenShift.RBAC
+	return rbac == nil || rbac.CleanupOnDelete == nil || *rbac.CleanupOnDelete
+}
+
+// finalizeNamespaces deletes the ServiceAccounts, Roles, RoleBindings and CA bundle ConfigMaps
+// created in the reconciled namespaces, or keeps them without the owner references to the
+// TektonConfig and the RBAC installer set, which would garbage collect them once the TektonConfig
+// is deleted. The reconcile labels are removed from the namespaces in both cases.
+func (r *rbac) finalizeNamespaces(ctx context.Context) error {
+	logger := logging.FromContext(ctx)
+
+	namespaces, err := r.kubeClientSet.CoreV1().Namespaces().List(ctx, metav1.ListOptions{
+		LabelSelector: namespaceVersionLabel,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to retrieve the reconciled namespaces: %w", err)
+	}
+
+	cleanup := cleanupOnDelete(r.tektonConfig)
+	errs := processNamespaces(ctx, r.rbacWorkerCount(ctx), namespaces.Items, false, func(ctx context.Context, _ int, ns corev1.Namespace) error {
+		var err error
+		if cleanup {
+			err = r.deleteNamespaceResources(ctx, ns.Name)
+			if err == nil {
+				err = r.deleteSCCServiceAccountResources(ctx, ns.Name)
+			}
+		} else {
+			err = r.retainNamespaceResources(ctx, ns.Name)
+		}
+		if err != nil {
+			return fmt.Errorf("namespace %s: %w", ns.Name, err)
+		}
+		err = reconcilerCommon.PatchNamespaceMetadata(ctx, r.kubeClientSet, ns.Name, reconcilerCommon.NamespaceMetadataPatch{
+			RemoveLabels: []string{namespaceVersionLabel, namespaceTrustedConfigLabel},
+		})
+		if err != nil && !goerrors.Is(err, reconcilerCommon.ErrNamespaceTerminating) {
+			return fmt.Errorf("failed to patch namespace %s: %w", ns.Name, err)
+		}
+		return nil
+	})
+	if err := goerrors.Join(errs...); err != nil {
+		return err
+	}
+	if cleanup {
+		logger.Infof("Deleted the RBAC resources and CA bundles of %d namespaces", len(namespaces.Items))
+	} else {
+		logger.Infof("Retained the RBAC resources and CA bundles of %d namespaces", len(namespaces.Items))
+	}
+	return nil
+}
+
+// deleteSCCServiceAccountResources deletes the Roles and RoleBindings granting the SCCs to the
+// additional ServiceAccounts
+func (r *rbac) deleteSCCServiceAccountResources(ctx context.Context, namespace string) error {
+	rbacClient := r.kubeClientSet.RbacV1()
+	selector := metav1.ListOptions{LabelSelector: sccServiceAccountLabel}
+	roleBindings, err := rbacClient.RoleBindings(namespace).List(ctx, selector)
+	if err != nil {
+		return err
+	}
+	for _, rb := range roleBindings.Items {
+		if err := rbacClient.RoleBindings(namespace).Delete(ctx, rb.Name, metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete rolebinding %s: %w", rb.Name, err)
+		}
+	}
+	roles, err := rbacClient.Roles(namespace).List(ctx, selector)
+	if err != nil {
+		return err
+	}
+	for _, role := range roles.Items {
+		if err := rbacClient.Roles(namespace).Delete(ctx, role.Name, metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete role %s: %w", role.Name, err)
+		}
+	}
+	return nil
+}
+
+// retainNamespaceResources removes the owner references to the operator resources from the
+// ServiceAccount, Roles and RoleBindings of a namespace, the CA bundle ConfigMaps have none
+func (r *rbac) retainNamespaceResources(ctx context.Context, namespace string) error {
+	rbacClient := r.kubeClientSet.RbacV1()
+	sccSelector := metav1.ListOptions{LabelSelector: sccServiceAccountLabel}
+
+	roleBindings, err := rbacClient.RoleBindings(namespace).List(ctx, sccSelector)
+	if err != nil {
+		return err
+	}
+	for _, name := range []string{pipelinesSCCRoleBinding, PipelineRoleBinding} {
+		rb, err := rbacClient.RoleBindings(namespace).Get(ctx, name, metav1.GetOptions{})
+		if errors.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return err
+		}
+		roleBindings.Items = append(roleBindings.Items, *rb)
+	}
+	for i := range roleBindings.Items {
+		rb := &roleBindings.Items[i]
+		refs, changed := withoutOperatorOwners(rb.GetOwnerReferences())
+		if !changed {
+			continue
+		}
+		rb.SetOwnerReferences(refs)
+		if _, err := rbacClient.RoleBindings(namespace).Update(ctx, rb, metav1.UpdateOptions{}); err != nil {
+			return fmt.Errorf("failed to update rolebinding %s: %w", rb.Name, err)
+		}
+	}
+
+	roles, err := rbacClient.Roles(namespace).List(ctx, sccSelector)
+	if err != nil {
+		return err
+	}
+	role, err := rbacClient.Roles(namespace).Get(ctx, pipelinesSCCRole, metav1.GetOptions{})
+	if err == nil {
+		roles.Items = append(roles.Items, *role)
+	} else if !errors.IsNotFound(err) {
+		return err
+	}
+	for i := range roles.Items {
+		role := &roles.Items[i]
+		refs, changed := withoutOperatorOwners(role.GetOwnerReferences())
+		if !changed {
+			continue
+		}
+		role.SetOwnerReferences(refs)
+		if _, err := rbacClient.Roles(namespace).Update(ctx, role, metav1.UpdateOptions{}); err != nil {
+			return fmt.Errorf("failed to update role %s: %w", role.Name, err)
+		}
+	}
+
+	saClient := r.kubeClientSet.CoreV1().ServiceAccounts(namespace)
+	sa, err := saClient.Get(ctx, r.serviceAccountName(), metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	refs, changed := withoutOperatorOwners(sa.GetOwnerReferences())
+	if !changed {
+		return nil
+	}
+	sa.SetOwnerReferences(refs)
+	if _, err := saClient.Update(ctx, sa, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("failed to update serviceaccount %s: %w", sa.Name, err)
+	}
+	return nil
+}
+
+// withoutOperatorOwners returns the owner references without the operator resources, and whether
+// any was removed
+func withoutOperatorOwners(refs []metav1.OwnerReference) ([]metav1.OwnerReference, bool) {
+	kept := make([]metav1.OwnerReference, 0, len(refs))
+	for _, ref := range refs {
+		if ref.APIVersion == v1alpha1.SchemeGroupVersion.String() {
+			continue
+		}
+		kept = append(kept, ref)
+	}
+	return kept, len(kept) != len(refs)
+}
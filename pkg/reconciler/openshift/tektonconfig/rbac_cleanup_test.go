@@ -0,0 +1,137 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonconfig
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	"knative.dev/pkg/ptr"
+)
+
+func TestFinalizeNamespaces(t *testing.T) {
+	tc := &v1alpha1.TektonConfig{
+		TypeMeta:   metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: v1alpha1.KindTektonConfig},
+		ObjectMeta: metav1.ObjectMeta{Name: "config", UID: "tc-uid"},
+	}
+	installerSetRef := metav1.OwnerReference{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: "TektonInstallerSet", Name: "rhosp-rbac-abcde"}
+	userRef := metav1.OwnerReference{APIVersion: "v1", Kind: "ConfigMap", Name: "user"}
+
+	setup := func(t *testing.T) *kubefake.Clientset {
+		ctx := context.TODO()
+		kubeClient := kubefake.NewSimpleClientset()
+		for _, name := range []string{"reconciled", "unmanaged"} {
+			ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}}
+			if name == "reconciled" {
+				ns.Labels = map[string]string{namespaceVersionLabel: "old-version", namespaceTrustedConfigLabel: "old-version"}
+			}
+			_, err := kubeClient.CoreV1().Namespaces().Create(ctx, ns, metav1.CreateOptions{})
+			assert.NilError(t, err)
+
+			_, err = kubeClient.CoreV1().ServiceAccounts(name).Create(ctx, &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{
+				Name: pipelineSA, Namespace: name, OwnerReferences: []metav1.OwnerReference{tektonConfigOwnerRef(*tc)},
+			}}, metav1.CreateOptions{})
+			assert.NilError(t, err)
+			for _, rb := range []rbacv1.RoleBinding{
+				{ObjectMeta: metav1.ObjectMeta{Name: PipelineRoleBinding, OwnerReferences: []metav1.OwnerReference{installerSetRef, userRef}}},
+				{ObjectMeta: metav1.ObjectMeta{Name: pipelinesSCCRoleBinding, OwnerReferences: []metav1.OwnerReference{installerSetRef}}},
+				{ObjectMeta: metav1.ObjectMeta{Name: sccServiceAccountRolePrefix + "builder", Labels: map[string]string{sccServiceAccountLabel: "builder"}, OwnerReferences: []metav1.OwnerReference{installerSetRef}}},
+			} {
+				rb.Namespace = name
+				_, err = kubeClient.RbacV1().RoleBindings(name).Create(ctx, &rb, metav1.CreateOptions{})
+				assert.NilError(t, err)
+			}
+			_, err = kubeClient.RbacV1().Roles(name).Create(ctx, &rbacv1.Role{ObjectMeta: metav1.ObjectMeta{
+				Name: pipelinesSCCRole, Namespace: name, OwnerReferences: []metav1.OwnerReference{installerSetRef},
+			}}, metav1.CreateOptions{})
+			assert.NilError(t, err)
+			_, err = kubeClient.CoreV1().ConfigMaps(name).Create(ctx, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+				Name: trustedCABundleConfigMap, Namespace: name, Labels: map[string]string{"app.kubernetes.io/part-of": "tekton-pipelines"},
+			}}, metav1.CreateOptions{})
+			assert.NilError(t, err)
+		}
+		return kubeClient
+	}
+
+	assertUnmanagedKept := func(t *testing.T, kubeClient *kubefake.Clientset) {
+		ctx := context.TODO()
+		rb, err := kubeClient.RbacV1().RoleBindings("unmanaged").Get(ctx, PipelineRoleBinding, metav1.GetOptions{})
+		assert.NilError(t, err)
+		assert.Equal(t, len(rb.OwnerReferences), 2)
+		_, err = kubeClient.CoreV1().ConfigMaps("unmanaged").Get(ctx, trustedCABundleConfigMap, metav1.GetOptions{})
+		assert.NilError(t, err)
+		ns, err := kubeClient.CoreV1().Namespaces().Get(ctx, "reconciled", metav1.GetOptions{})
+		assert.NilError(t, err)
+		_, labeled := ns.Labels[namespaceVersionLabel]
+		assert.Assert(t, !labeled)
+	}
+
+	t.Run("cleanup", func(t *testing.T) {
+		ctx := context.TODO()
+		kubeClient := setup(t)
+		r := &rbac{kubeClientSet: kubeClient, tektonConfig: tc}
+		assert.NilError(t, r.finalizeNamespaces(ctx))
+
+		for _, name := range []string{PipelineRoleBinding, pipelinesSCCRoleBinding, sccServiceAccountRolePrefix + "builder"} {
+			_, err := kubeClient.RbacV1().RoleBindings("reconciled").Get(ctx, name, metav1.GetOptions{})
+			assert.Assert(t, errors.IsNotFound(err), "rolebinding %s should be deleted", name)
+		}
+		_, err := kubeClient.RbacV1().Roles("reconciled").Get(ctx, pipelinesSCCRole, metav1.GetOptions{})
+		assert.Assert(t, errors.IsNotFound(err))
+		_, err = kubeClient.CoreV1().ConfigMaps("reconciled").Get(ctx, trustedCABundleConfigMap, metav1.GetOptions{})
+		assert.Assert(t, errors.IsNotFound(err))
+		_, err = kubeClient.CoreV1().ServiceAccounts("reconciled").Get(ctx, pipelineSA, metav1.GetOptions{})
+		assert.Assert(t, errors.IsNotFound(err))
+		assertUnmanagedKept(t, kubeClient)
+	})
+
+	t.Run("retain", func(t *testing.T) {
+		ctx := context.TODO()
+		kubeClient := setup(t)
+		retain := tc.DeepCopy()
+		retain.Spec.Platforms.OpenShift.RBAC = &v1alpha1.RBAC{CleanupOnDelete: ptr.Bool(false)}
+		r := &rbac{kubeClientSet: kubeClient, tektonConfig: retain}
+		assert.NilError(t, r.finalizeNamespaces(ctx))
+
+		for _, name := range []string{PipelineRoleBinding, pipelinesSCCRoleBinding, sccServiceAccountRolePrefix + "builder"} {
+			rb, err := kubeClient.RbacV1().RoleBindings("reconciled").Get(ctx, name, metav1.GetOptions{})
+			assert.NilError(t, err)
+			for _, ref := range rb.OwnerReferences {
+				assert.Assert(t, ref.APIVersion != v1alpha1.SchemeGroupVersion.String(), "rolebinding %s is still owned by %s", name, ref.Name)
+			}
+		}
+		rb, err := kubeClient.RbacV1().RoleBindings("reconciled").Get(ctx, PipelineRoleBinding, metav1.GetOptions{})
+		assert.NilError(t, err)
+		assert.DeepEqual(t, rb.OwnerReferences, []metav1.OwnerReference{userRef})
+		role, err := kubeClient.RbacV1().Roles("reconciled").Get(ctx, pipelinesSCCRole, metav1.GetOptions{})
+		assert.NilError(t, err)
+		assert.Equal(t, len(role.OwnerReferences), 0)
+		sa, err := kubeClient.CoreV1().ServiceAccounts("reconciled").Get(ctx, pipelineSA, metav1.GetOptions{})
+		assert.NilError(t, err)
+		assert.Equal(t, len(sa.OwnerReferences), 0)
+		_, err = kubeClient.CoreV1().ConfigMaps("reconciled").Get(ctx, trustedCABundleConfigMap, metav1.GetOptions{})
+		assert.NilError(t, err)
+		assertUnmanagedKept(t, kubeClient)
+	})
+}
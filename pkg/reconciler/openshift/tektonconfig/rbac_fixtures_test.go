@@ -0,0 +1,85 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonconfig
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	operatorfake "github.com/tektoncd/operator/pkg/client/clientset/versioned/fake"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
+	"github.com/tektoncd/operator/pkg/reconciler/common/testing/fixtures"
+	"gotest.tools/v3/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubeinformers "k8s.io/client-go/informers"
+)
+
+// TestCreateResourcesFromFixtures reconciles the RBAC of the namespaces of a cluster snapshotted
+// with the fixtures command of the operator tool
+func TestCreateResourcesFromFixtures(t *testing.T) {
+	t.Setenv(common.KoEnvKey, "testdata")
+	ctx := context.Background()
+
+	bundle, err := fixtures.Load("testdata/fixtures/cluster.yaml")
+	assert.NilError(t, err)
+	kubeClient := bundle.KubeClient()
+	securityClient, err := bundle.SecurityClient()
+	assert.NilError(t, err)
+	operatorClient := operatorfake.NewSimpleClientset(&v1alpha1.TektonInstallerSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        rbacInstallerSetNamePrefix + "fixtures",
+			Labels:      rbacInstallerSetSelector.MatchLabels,
+			Annotations: map[string]string{v1alpha1.ReleaseVersionKey: "test-version"},
+		},
+	})
+
+	informers := kubeinformers.NewSharedInformerFactory(kubeClient, 0)
+	nsInformer := informers.Core().V1().Namespaces()
+	for i := range bundle.Namespaces {
+		assert.NilError(t, nsInformer.Informer().GetIndexer().Add(&bundle.Namespaces[i]))
+	}
+
+	r := &rbac{
+		kubeClientSet:     kubeClient,
+		operatorClientSet: operatorClient,
+		securityClientSet: securityClient,
+		rbacInformer:      informers.Rbac().V1().ClusterRoleBindings(),
+		nsInformer:        nsInformer,
+		tektonConfig: &v1alpha1.TektonConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.ConfigResourceName},
+			Spec: v1alpha1.TektonConfigSpec{
+				Params: []v1alpha1.Param{
+					{Name: v1alpha1.CreateRbacResourceParam, Value: "true"},
+					{Name: v1alpha1.CreateCABundleConfigMapsParam, Value: "false"},
+				},
+				Platforms: v1alpha1.Platforms{OpenShift: v1alpha1.OpenShift{SCC: &v1alpha1.SCC{Default: "pipelines-scc"}}},
+			},
+		},
+		version: "test-version",
+	}
+	assert.NilError(t, r.createResources(ctx))
+
+	// the ServiceAccount of the snapshot is kept, the missing one is created
+	for _, ns := range bundle.Namespaces {
+		_, err := kubeClient.CoreV1().ServiceAccounts(ns.Name).Get(ctx, pipelineSA, metav1.GetOptions{})
+		assert.NilError(t, err, "namespace %s", ns.Name)
+		updated, err := kubeClient.CoreV1().Namespaces().Get(ctx, ns.Name, metav1.GetOptions{})
+		assert.NilError(t, err)
+		assert.Equal(t, updated.Labels[namespaceVersionLabel], r.version)
+	}
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonconfig
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+	"knative.dev/pkg/metrics"
+)
+
+const (
+	// rbacStageRBAC is the reconcile of the RBAC resources of a namespace
+	rbacStageRBAC = "rbac"
+	// rbacStageCABundles is the reconcile of the CA bundles of a namespace
+	rbacStageCABundles = "cabundles"
+
+	// sccValidationNotFound is an SCC requested in a namespace which does not exist
+	sccValidationNotFound = "not_found"
+	// sccValidationNotAllowed is an SCC requested in a namespace less restrictive than maxAllowed
+	sccValidationNotAllowed = "not_allowed"
+)
+
+var (
+	rbacNamespacesReconciled = stats.Int64("rbac_namespaces_reconciled",
+		"number of namespaces reconciled by the last cycle of the rbac reconciler",
+		stats.UnitDimensionless)
+	rbacNamespacesPending = stats.Int64("rbac_namespaces_pending",
+		"number of namespaces left to reconcile by the last cycle of the rbac reconciler",
+		stats.UnitDimensionless)
+	rbacNamespaceLatency = stats.Float64("rbac_namespace_reconcile_latency",
+		"time to reconcile the RBAC resources or the CA bundles of a namespace",
+		stats.UnitMilliseconds)
+	rbacSCCValidationFailures = stats.Int64("rbac_scc_validation_failures",
+		"number of namespaces requesting an SCC which does not exist or is not allowed",
+		stats.UnitDimensionless)
+	rbacCABundleDrift = stats.Int64("rbac_ca_bundle_drift",
+		"number of namespaces whose CA bundle configmaps were missing or lost their injection metadata",
+		stats.UnitDimensionless)
+
+	rbacStageTagKey   = tag.MustNewKey("stage")
+	rbacSuccessTagKey = tag.MustNewKey("success")
+	rbacReasonTagKey  = tag.MustNewKey("reason")
+
+	registerRBACViews = sync.OnceValue(func() error {
+		return view.Register(
+			&view.View{
+				Description: rbacNamespacesReconciled.Description(),
+				Measure:     rbacNamespacesReconciled,
+				Aggregation: view.LastValue(),
+			},
+			&view.View{
+				Description: rbacNamespacesPending.Description(),
+				Measure:     rbacNamespacesPending,
+				Aggregation: view.LastValue(),
+			},
+			&view.View{
+				Description: rbacNamespaceLatency.Description(),
+				Measure:     rbacNamespaceLatency,
+				Aggregation: view.Distribution(10, 50, 100, 250, 500, 1000, 2500, 5000, 10000, 30000),
+				TagKeys:     []tag.Key{rbacStageTagKey, rbacSuccessTagKey},
+			},
+			&view.View{
+				Description: rbacSCCValidationFailures.Description(),
+				Measure:     rbacSCCValidationFailures,
+				Aggregation: view.Count(),
+				TagKeys:     []tag.Key{rbacReasonTagKey},
+			},
+			&view.View{
+				Description: rbacCABundleDrift.Description(),
+				Measure:     rbacCABundleDrift,
+				Aggregation: view.Count(),
+			},
+		)
+	})
+)
+
+// recordRBACCycle records the namespaces reconciled by a cycle of the rbac reconciler, and the
+// ones which are left to be reconciled by the next cycles, e.g. the failed namespaces or all the
+// namespaces while the patches wait for the confirmation
+func recordRBACCycle(reconciled, pending int) {
+	if err := registerRBACViews(); err != nil {
+		return
+	}
+	metrics.Record(context.Background(), rbacNamespacesReconciled.M(int64(reconciled)))
+	metrics.Record(context.Background(), rbacNamespacesPending.M(int64(pending)))
+}
+
+// observeNamespaceReconcile records the time taken by a stage of the reconcile of a namespace
+func observeNamespaceReconcile(stage string, duration time.Duration, reconcileErr error) {
+	if err := registerRBACViews(); err != nil {
+		return
+	}
+	ctx, err := tag.New(context.Background(),
+		tag.Insert(rbacStageTagKey, stage),
+		tag.Insert(rbacSuccessTagKey, strconv.FormatBool(reconcileErr == nil)))
+	if err != nil {
+		return
+	}
+	metrics.Record(ctx, rbacNamespaceLatency.M(float64(duration.Milliseconds())))
+}
+
+// recordSCCValidationFailure records a namespace requesting an SCC which is rejected
+func recordSCCValidationFailure(reason string) {
+	if err := registerRBACViews(); err != nil {
+		return
+	}
+	ctx, err := tag.New(context.Background(), tag.Insert(rbacReasonTagKey, reason))
+	if err != nil {
+		return
+	}
+	metrics.Record(ctx, rbacSCCValidationFailures.M(1))
+}
+
+// recordCABundleDrift records a namespace whose CA bundle configmaps drifted
+func recordCABundleDrift() {
+	if err := registerRBACViews(); err != nil {
+		return
+	}
+	metrics.Record(context.Background(), rbacCABundleDrift.M(1))
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonconfig
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"go.opencensus.io/stats/view"
+	"gotest.tools/v3/assert"
+	"knative.dev/pkg/metrics/metricstest"
+	_ "knative.dev/pkg/metrics/testing"
+)
+
+// rbacMetricCount returns the number of measures of the view with the tags, the other tests of
+// the package record the same views
+func rbacMetricCount(t *testing.T, name string, tags map[string]string) int64 {
+	t.Helper()
+	rows, err := view.RetrieveData(name)
+	assert.NilError(t, err)
+	for _, row := range rows {
+		matched := len(row.Tags) == len(tags)
+		for _, tag := range row.Tags {
+			matched = matched && tags[tag.Key.Name()] == tag.Value
+		}
+		if !matched {
+			continue
+		}
+		switch data := row.Data.(type) {
+		case *view.DistributionData:
+			return data.Count
+		case *view.CountData:
+			return data.Value
+		}
+	}
+	return 0
+}
+
+func TestRBACMetrics(t *testing.T) {
+	recordRBACCycle(40, 2)
+	metricstest.CheckLastValueData(t, "rbac_namespaces_reconciled", map[string]string{}, 40)
+	metricstest.CheckLastValueData(t, "rbac_namespaces_pending", map[string]string{}, 2)
+	recordRBACCycle(0, 0)
+	metricstest.CheckLastValueData(t, "rbac_namespaces_pending", map[string]string{}, 0)
+
+	succeeded := map[string]string{"stage": rbacStageRBAC, "success": "true"}
+	failed := map[string]string{"stage": rbacStageRBAC, "success": "false"}
+	before := [2]int64{rbacMetricCount(t, "rbac_namespace_reconcile_latency", succeeded), rbacMetricCount(t, "rbac_namespace_reconcile_latency", failed)}
+	observeNamespaceReconcile(rbacStageRBAC, 120*time.Millisecond, nil)
+	observeNamespaceReconcile(rbacStageRBAC, 80*time.Millisecond, errors.New("conflict"))
+	assert.Equal(t, rbacMetricCount(t, "rbac_namespace_reconcile_latency", succeeded), before[0]+1)
+	assert.Equal(t, rbacMetricCount(t, "rbac_namespace_reconcile_latency", failed), before[1]+1)
+
+	notFound := map[string]string{"reason": sccValidationNotFound}
+	notAllowed := map[string]string{"reason": sccValidationNotAllowed}
+	before = [2]int64{rbacMetricCount(t, "rbac_scc_validation_failures", notFound), rbacMetricCount(t, "rbac_scc_validation_failures", notAllowed)}
+	recordSCCValidationFailure(sccValidationNotFound)
+	recordSCCValidationFailure(sccValidationNotFound)
+	recordSCCValidationFailure(sccValidationNotAllowed)
+	assert.Equal(t, rbacMetricCount(t, "rbac_scc_validation_failures", notFound), before[0]+2)
+	assert.Equal(t, rbacMetricCount(t, "rbac_scc_validation_failures", notAllowed), before[1]+1)
+}
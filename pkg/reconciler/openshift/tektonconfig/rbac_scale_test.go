@@ -0,0 +1,149 @@
+//go:build scale
+// +build scale
+
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonconfig
+
+import (
+	"context"
+	"flag"
+	"os"
+	"testing"
+	"time"
+
+	securityv1 "github.com/openshift/api/security/v1"
+	fakesecurity "github.com/openshift/client-go/security/clientset/versioned/fake"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	operatorfake "github.com/tektoncd/operator/pkg/client/clientset/versioned/fake"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
+	"github.com/tektoncd/operator/pkg/reconciler/common/testing/scale"
+	"go.uber.org/zap"
+	"gotest.tools/v3/assert"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubeinformers "k8s.io/client-go/informers"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	"knative.dev/pkg/logging"
+)
+
+var (
+	scaleNamespaces        = flag.Int("scale.namespaces", 500, "number of synthetic namespaces")
+	scaleReport            = flag.String("scale.report", "", "path of the JSON report written by the scale test")
+	scaleBaseline          = flag.String("scale.baseline", "", "path of the JSON report the scale test is compared to")
+	scaleCallsTolerance    = flag.Int("scale.calls-tolerance", 10, "growth of the API calls per namespace allowed over the baseline, in percents")
+	scaleDurationTolerance = flag.Int("scale.duration-tolerance", 0, "growth of the duration per namespace allowed over the baseline, in percents, 0 skips the comparison")
+	scaleRBACWorkerCount   = flag.String("scale.workers", "10", "value of the rbacWorkerCount param")
+	scalePatchConcurrency  = flag.String("scale.patch-concurrency", "10", "value of the namespacePatchConcurrency param")
+	scalePatchQPS          = flag.String("scale.patch-qps", "100000", "value of the namespacePatchQPS and namespacePatchBurst params, high enough not to throttle the passes")
+)
+
+// TestRBACScale reconciles the RBAC and CA bundles of synthetic namespaces, the first pass creates
+// the resources of all the namespaces, the resync pass finds them up to date and the upgrade pass
+// reconciles all the namespaces again for a new version of the operator
+func TestRBACScale(t *testing.T) {
+	os.Setenv(common.KoEnvKey, "testdata")
+	// the logs of the namespaces are dropped, they would dominate the duration
+	ctx := logging.WithLogger(context.Background(), zap.NewNop().Sugar())
+
+	kubeClient := kubefake.NewSimpleClientset(&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "edit"}})
+	installerSet := &v1alpha1.TektonInstallerSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        rbacInstallerSetNamePrefix + "scale",
+			Labels:      rbacInstallerSetSelector.MatchLabels,
+			Annotations: map[string]string{v1alpha1.ReleaseVersionKey: "scale"},
+		},
+	}
+	operatorClient := operatorfake.NewSimpleClientset(installerSet)
+	securityClient := fakesecurity.NewSimpleClientset()
+	_, err := securityClient.SecurityV1().SecurityContextConstraints().Create(ctx,
+		&securityv1.SecurityContextConstraints{ObjectMeta: metav1.ObjectMeta{Name: "pipelines-scc"}}, metav1.CreateOptions{})
+	assert.NilError(t, err)
+
+	informers := kubeinformers.NewSharedInformerFactory(kubeClient, 0)
+	nsInformer := informers.Core().V1().Namespaces()
+	for _, ns := range scale.Namespaces(*scaleNamespaces) {
+		created, err := kubeClient.CoreV1().Namespaces().Create(ctx, &ns, metav1.CreateOptions{})
+		assert.NilError(t, err)
+		assert.NilError(t, nsInformer.Informer().GetIndexer().Add(created))
+	}
+
+	calls := &scale.APICalls{}
+	calls.Count(kubeClient, operatorClient, securityClient)
+
+	tc := &v1alpha1.TektonConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.ConfigResourceName},
+		Spec: v1alpha1.TektonConfigSpec{
+			Params: []v1alpha1.Param{
+				{Name: v1alpha1.CreateRbacResourceParam, Value: "true"},
+				{Name: v1alpha1.CreateCABundleConfigMapsParam, Value: "true"},
+				{Name: v1alpha1.RBACWorkerCountParam, Value: *scaleRBACWorkerCount},
+				{Name: v1alpha1.NamespacePatchConcurrencyParam, Value: *scalePatchConcurrency},
+				{Name: v1alpha1.NamespacePatchQPSParam, Value: *scalePatchQPS},
+				{Name: v1alpha1.NamespacePatchBurstParam, Value: *scalePatchQPS},
+				{Name: v1alpha1.ConfirmNamespacePatchesParam, Value: "true"},
+			},
+			Platforms: v1alpha1.Platforms{OpenShift: v1alpha1.OpenShift{SCC: &v1alpha1.SCC{Default: "pipelines-scc"}}},
+		},
+	}
+
+	report := &scale.Report{Namespaces: *scaleNamespaces}
+	for _, pass := range []struct{ name, version string }{
+		{name: "initial", version: "scale"},
+		{name: "resync", version: "scale"},
+		{name: "upgrade", version: "scale-next"},
+	} {
+		r := &rbac{
+			kubeClientSet:     kubeClient,
+			operatorClientSet: operatorClient,
+			securityClientSet: securityClient,
+			rbacInformer:      informers.Rbac().V1().ClusterRoleBindings(),
+			nsInformer:        nsInformer,
+			tektonConfig:      tc,
+			version:           pass.version,
+		}
+		// the installer set of the RBAC is upgraded before the namespaces
+		installerSet.Annotations[v1alpha1.ReleaseVersionKey] = pass.version
+		_, err := operatorClient.OperatorV1alpha1().TektonInstallerSets().Update(ctx, installerSet, metav1.UpdateOptions{})
+		assert.NilError(t, err)
+
+		calls.Reset()
+		start := time.Now()
+		assert.NilError(t, r.createResources(ctx), "pass %s", pass.name)
+		p := scale.NewPass(pass.name, start, calls.Reset())
+		t.Logf("pass %s: %d namespaces in %dms with %d API calls", p.Name, report.Namespaces, p.Duration, p.APICalls)
+		report.Passes = append(report.Passes, p)
+
+		// the namespaces patched by the pass are seen by the next pass
+		namespaces, err := kubeClient.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+		assert.NilError(t, err)
+		for i := range namespaces.Items {
+			assert.NilError(t, nsInformer.Informer().GetIndexer().Update(&namespaces.Items[i]))
+		}
+	}
+
+	if *scaleReport != "" {
+		assert.NilError(t, report.Write(*scaleReport))
+	}
+	if *scaleBaseline != "" {
+		baseline, err := scale.Load(*scaleBaseline)
+		assert.NilError(t, err)
+		for _, regression := range report.Regressions(baseline, scale.Tolerance{APICalls: *scaleCallsTolerance, Duration: *scaleDurationTolerance}) {
+			t.Error(regression)
+		}
+	}
+}
@@ -11,6 +11,7 @@ import (
 	operatorfake "github.com/tektoncd/operator/pkg/client/clientset/versioned/fake"
 	"github.com/tektoncd/operator/pkg/reconciler/common"
 	"github.com/tektoncd/operator/pkg/reconciler/openshift"
+	"github.com/tektoncd/operator/pkg/reconciler/openshift/namespacerbac"
 	"gotest.tools/v3/assert"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
@@ -436,10 +437,11 @@ func TestCreateResources(t *testing.T) {
 			nsInformer := informers.Core().V1().Namespaces()
 			rbacInformer := informers.Rbac().V1().ClusterRoleBindings()
 
-			// Add existing resources to the fake clients
+			// Add existing resources to the fake clients, the namespaces are listed from the informer
 			for _, ns := range tt.existingNamespaces {
-				_, err := kubeClient.CoreV1().Namespaces().Create(ctx, &ns, metav1.CreateOptions{})
+				created, err := kubeClient.CoreV1().Namespaces().Create(ctx, &ns, metav1.CreateOptions{})
 				assert.NilError(t, err)
+				assert.NilError(t, nsInformer.Informer().GetIndexer().Add(created))
 			}
 
 			for _, sa := range tt.existingSAs {
@@ -567,7 +569,7 @@ func TestSetDefault(t *testing.T) {
 			},
 		},
 		{
-			name: "Invalid values - should set to true",
+			name: "Invalid values - left for the webhook to reject",
 			tektonConfig: &v1alpha1.TektonConfig{
 				ObjectMeta: metav1.ObjectMeta{Name: "config"},
 				Spec: v1alpha1.TektonConfigSpec{
@@ -579,9 +581,9 @@ func TestSetDefault(t *testing.T) {
 				},
 			},
 			want: []v1alpha1.Param{
-				{Name: rbacParamName, Value: "true"},
-				{Name: trustedCABundleParamName, Value: "true"},
-				{Name: legacyPipelineRbacParamName, Value: "true"},
+				{Name: rbacParamName, Value: "invalid"},
+				{Name: trustedCABundleParamName, Value: "maybe"},
+				{Name: legacyPipelineRbacParamName, Value: "unknown"},
 			},
 		},
 		{
@@ -663,7 +665,7 @@ func TestSetDefault(t *testing.T) {
 			},
 			want: []v1alpha1.Param{
 				{Name: rbacParamName, Value: "true"},
-				{Name: legacyPipelineRbacParamName, Value: "true"},
+				{Name: legacyPipelineRbacParamName, Value: "invalid"},
 				{Name: trustedCABundleParamName, Value: "true"},
 			},
 		},
@@ -696,3 +698,207 @@ func TestSetDefault(t *testing.T) {
 		})
 	}
 }
+
+func TestCABundleNamespaceAnnotations(t *testing.T) {
+	ctx := context.Background()
+	platformCerts := "-----BEGIN CERTIFICATE-----\nplatform\n-----END CERTIFICATE-----\n"
+	extraCerts := "-----BEGIN CERTIFICATE-----\nteam\n-----END CERTIFICATE-----\n"
+	optedOut := corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "opted-out",
+		Annotations: map[string]string{v1alpha1.NamespaceCABundleOptOutAnnotation: "true"}}}
+	withExtra := corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team",
+		Labels:      map[string]string{namespaceTrustedConfigLabel: "test-version"},
+		Annotations: map[string]string{v1alpha1.NamespaceExtraCAAnnotation: "team-ca"}}}
+	kubeClient := kubefake.NewSimpleClientset(&optedOut, &withExtra,
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: platformTrustedCAConfigMap, Namespace: platformTrustedCANamespace},
+			Data:       map[string]string{common.TrustedCAKey: platformCerts},
+		},
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "team-ca", Namespace: "team"},
+			Data:       map[string]string{"ca.crt": extraCerts},
+		},
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: trustedCABundleConfigMap, Namespace: "team",
+				Labels: map[string]string{v1alpha1.TrustedCAInjectionLabel: "true"}},
+			Data: map[string]string{common.TrustedCAKey: platformCerts},
+		},
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: serviceCABundleConfigMap, Namespace: "team"}},
+	)
+	r := &rbac{kubeClientSet: kubeClient, version: "test-version"}
+
+	needed, err := r.needsCABundle(ctx, optedOut)
+	assert.NilError(t, err)
+	assert.Assert(t, !needed)
+	// the extra certificates are not written yet
+	needed, err = r.needsCABundle(ctx, withExtra)
+	assert.NilError(t, err)
+	assert.Assert(t, needed)
+
+	assert.NilError(t, r.ensureCABundles(ctx, &withExtra))
+	cm, err := kubeClient.CoreV1().ConfigMaps("team").Get(ctx, trustedCABundleConfigMap, metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, cm.Data[common.TrustedCAKey], platformCerts+extraCerts)
+	_, injected := cm.Labels[v1alpha1.TrustedCAInjectionLabel]
+	assert.Assert(t, !injected)
+	needed, err = r.needsCABundle(ctx, withExtra)
+	assert.NilError(t, err)
+	assert.Assert(t, !needed)
+
+	// the rotated extra certificates are written again
+	_, err = kubeClient.CoreV1().ConfigMaps("team").Update(ctx, &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "team-ca", Namespace: "team"},
+		Data:       map[string]string{"ca.crt": extraCerts + extraCerts},
+	}, metav1.UpdateOptions{})
+	assert.NilError(t, err)
+	needed, err = r.needsCABundle(ctx, withExtra)
+	assert.NilError(t, err)
+	assert.Assert(t, needed)
+
+	// the injection of the platform is enabled again when the annotation is removed
+	withExtra.Annotations = nil
+	needed, err = r.needsCABundle(ctx, withExtra)
+	assert.NilError(t, err)
+	assert.Assert(t, needed)
+	assert.NilError(t, r.ensureCABundles(ctx, &withExtra))
+	cm, err = kubeClient.CoreV1().ConfigMaps("team").Get(ctx, trustedCABundleConfigMap, metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, cm.Labels[v1alpha1.TrustedCAInjectionLabel], "true")
+	assert.Equal(t, cm.Annotations[extraCASourceAnnotation], "")
+}
+
+func TestCABundleDrift(t *testing.T) {
+	ctx := context.Background()
+	ns := corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team",
+		Labels: map[string]string{namespaceTrustedConfigLabel: "test-version"}}}
+	managed := map[string]string{"app.kubernetes.io/part-of": "tekton-pipelines"}
+	kubeClient := kubefake.NewSimpleClientset(&ns,
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: trustedCABundleConfigMap, Namespace: "team", Labels: managed}},
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: serviceCABundleConfigMap, Namespace: "team", Labels: managed,
+			Annotations: map[string]string{namespacerbac.ServiceCAInjectionAnnotation: "true"}}},
+	)
+	r := &rbac{kubeClientSet: kubeClient, version: "test-version"}
+	before := rbacMetricCount(t, "rbac_ca_bundle_drift", map[string]string{})
+
+	// the injection label was removed from the trusted CA bundle, the version label of the
+	// namespace is removed until the ConfigMap is repaired
+	needed, err := r.needsCABundle(ctx, ns)
+	assert.NilError(t, err)
+	assert.Assert(t, needed)
+	assert.Equal(t, rbacMetricCount(t, "rbac_ca_bundle_drift", map[string]string{}), before+1)
+	patched, err := kubeClient.CoreV1().Namespaces().Get(ctx, "team", metav1.GetOptions{})
+	assert.NilError(t, err)
+	_, labeled := patched.Labels[namespaceTrustedConfigLabel]
+	assert.Assert(t, !labeled)
+
+	assert.NilError(t, r.ensureCABundles(ctx, &ns))
+	cm, err := kubeClient.CoreV1().ConfigMaps("team").Get(ctx, trustedCABundleConfigMap, metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, cm.Labels[v1alpha1.TrustedCAInjectionLabel], "true")
+	needed, err = r.needsCABundle(ctx, ns)
+	assert.NilError(t, err)
+	assert.Assert(t, !needed)
+
+	// the injection annotation was removed from the service CA bundle
+	cm, err = kubeClient.CoreV1().ConfigMaps("team").Get(ctx, serviceCABundleConfigMap, metav1.GetOptions{})
+	assert.NilError(t, err)
+	cm.Annotations = nil
+	_, err = kubeClient.CoreV1().ConfigMaps("team").Update(ctx, cm, metav1.UpdateOptions{})
+	assert.NilError(t, err)
+	needed, err = r.needsCABundle(ctx, ns)
+	assert.NilError(t, err)
+	assert.Assert(t, needed)
+	assert.NilError(t, r.ensureCABundles(ctx, &ns))
+	cm, err = kubeClient.CoreV1().ConfigMaps("team").Get(ctx, serviceCABundleConfigMap, metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, cm.Annotations[namespacerbac.ServiceCAInjectionAnnotation], "true")
+
+	// the ConfigMaps which were not created by the operator are left alone
+	cm.Labels = nil
+	cm.Annotations = nil
+	_, err = kubeClient.CoreV1().ConfigMaps("team").Update(ctx, cm, metav1.UpdateOptions{})
+	assert.NilError(t, err)
+	needed, err = r.needsCABundle(ctx, ns)
+	assert.NilError(t, err)
+	assert.Assert(t, !needed)
+}
+
+func TestPatchNamespaceLabelNamespaceGone(t *testing.T) {
+	ctx := context.Background()
+	now := metav1.Now()
+	r := &rbac{
+		kubeClientSet: kubefake.NewSimpleClientset(&corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{Name: "terminating", DeletionTimestamp: &now, Finalizers: []string{"kubernetes"}},
+		}),
+		tektonConfig: &v1alpha1.TektonConfig{},
+		version:      "test-version",
+	}
+
+	// the namespaces were deleted or entered Terminating after they were listed
+	for _, name := range []string{"deleted", "terminating"} {
+		assert.NilError(t, r.patchNamespaceLabel(ctx, corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}}))
+		assert.NilError(t, r.patchNamespaceTrustedConfigLabel(ctx, corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}}))
+	}
+}
+
+func TestServiceAccountName(t *testing.T) {
+	ctx := context.Background()
+	ns := corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team", Labels: map[string]string{namespaceVersionLabel: "test-version"}}}
+	kubeClient := kubefake.NewSimpleClientset(&ns, &rbacv1.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: pipelinesSCCRoleBinding, Namespace: "team"},
+		RoleRef:    rbacv1.RoleRef{Kind: "ClusterRole", Name: pipelinesSCCClusterRole},
+	})
+	r := &rbac{kubeClientSet: kubeClient, version: "test-version", tektonConfig: &v1alpha1.TektonConfig{}}
+
+	// the namespaces reconciled with the pipeline ServiceAccount are up to date
+	assert.Equal(t, r.serviceAccountName(), pipelineSA)
+	needed, err := r.needsRBAC(ctx, ns, true)
+	assert.NilError(t, err)
+	assert.Assert(t, !needed)
+
+	// the namespaces are reconciled again when the name changes
+	r.tektonConfig.Spec.Platforms.OpenShift.RBAC = &v1alpha1.RBAC{ServiceAccountName: "ci-builder"}
+	needed, err = r.needsRBAC(ctx, ns, true)
+	assert.NilError(t, err)
+	assert.Assert(t, needed)
+
+	sa, _, err := r.ensureSA(ctx, &ns)
+	assert.NilError(t, err)
+	assert.Equal(t, sa.Name, "ci-builder")
+	assert.NilError(t, r.patchNamespaceLabel(ctx, ns))
+	patched, err := kubeClient.CoreV1().Namespaces().Get(ctx, "team", metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, patched.Annotations[serviceAccountNameAnnotation], "ci-builder")
+	needed, err = r.needsRBAC(ctx, *patched, true)
+	assert.NilError(t, err)
+	assert.Assert(t, !needed)
+}
+
+func TestPipelineClusterRole(t *testing.T) {
+	ctx := context.Background()
+	ns := corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team", Labels: map[string]string{namespaceVersionLabel: "test-version"}}}
+	kubeClient := kubefake.NewSimpleClientset(&ns, &rbacv1.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: pipelinesSCCRoleBinding, Namespace: "team"},
+		RoleRef:    rbacv1.RoleRef{Kind: "ClusterRole", Name: pipelinesSCCClusterRole},
+	})
+	r := &rbac{kubeClientSet: kubeClient, version: "test-version", tektonConfig: &v1alpha1.TektonConfig{}}
+
+	// the edit ClusterRole is not recorded
+	r.tektonConfig.Spec.Platforms.OpenShift.RBAC = &v1alpha1.RBAC{PipelineClusterRole: "edit"}
+	needed, err := r.needsRBAC(ctx, ns, true)
+	assert.NilError(t, err)
+	assert.Assert(t, !needed)
+
+	// the namespaces are reconciled again when the ClusterRole changes
+	r.tektonConfig.Spec.Platforms.OpenShift.RBAC.PipelineClusterRole = "pipelines-edit-no-secrets"
+	needed, err = r.needsRBAC(ctx, ns, true)
+	assert.NilError(t, err)
+	assert.Assert(t, needed)
+
+	assert.NilError(t, r.patchNamespaceLabel(ctx, ns))
+	patched, err := kubeClient.CoreV1().Namespaces().Get(ctx, "team", metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, patched.Annotations[pipelineClusterRoleAnnotation], "pipelines-edit-no-secrets")
+	needed, err = r.needsRBAC(ctx, *patched, true)
+	assert.NilError(t, err)
+	assert.Assert(t, !needed)
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonconfig
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"knative.dev/pkg/logging"
+)
+
+const defaultRBACWorkerCount = 10
+
+// errNamespaceSkipped is the outcome of the namespaces not processed after a failure with the failFast policy
+var errNamespaceSkipped = errors.New("namespace skipped after a failure")
+
+// rbacWorkerCount returns the number of namespaces reconciled concurrently from the TektonConfig params
+func (r *rbac) rbacWorkerCount(ctx context.Context) int {
+	logger := logging.FromContext(ctx)
+
+	for _, v := range r.tektonConfig.Spec.Params {
+		if v.Name != v1alpha1.RBACWorkerCountParam {
+			continue
+		}
+		n, err := strconv.Atoi(v.Value)
+		if err != nil || n <= 0 {
+			logger.Warnf("invalid value %q for param %s, using default %d", v.Value, v.Name, defaultRBACWorkerCount)
+			return defaultRBACWorkerCount
+		}
+		return n
+	}
+	return defaultRBACWorkerCount
+}
+
+// processNamespaces runs process on the namespaces with a pool of workers, the namespaces are
+// started in their order and the returned errors are in the same order. With failFast, the
+// namespaces not started after a failure are skipped and their error is errNamespaceSkipped.
+func processNamespaces(ctx context.Context, workers int, namespaces []corev1.Namespace, failFast bool,
+	process func(ctx context.Context, i int, ns corev1.Namespace) error) []error {
+	errs := make([]error, len(namespaces))
+	indexes := make(chan int)
+	var (
+		wg     sync.WaitGroup
+		mutex  sync.Mutex
+		failed bool
+	)
+	for range min(workers, len(namespaces)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				mutex.Lock()
+				skip := failFast && failed
+				mutex.Unlock()
+				if skip {
+					errs[i] = errNamespaceSkipped
+					continue
+				}
+				if errs[i] = process(ctx, i, namespaces[i]); errs[i] != nil {
+					mutex.Lock()
+					failed = true
+					mutex.Unlock()
+				}
+			}
+		}()
+	}
+	for i := range namespaces {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+	return errs
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonconfig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	securityv1 "github.com/openshift/api/security/v1"
+	fakesecurity "github.com/openshift/client-go/security/clientset/versioned/fake"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	operatorfake "github.com/tektoncd/operator/pkg/client/clientset/versioned/fake"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubeinformers "k8s.io/client-go/informers"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestRBACWorkerCount(t *testing.T) {
+	r := &rbac{tektonConfig: &v1alpha1.TektonConfig{}}
+	assert.Equal(t, r.rbacWorkerCount(context.TODO()), defaultRBACWorkerCount)
+
+	r.tektonConfig.Spec.Params = []v1alpha1.Param{{Name: v1alpha1.RBACWorkerCountParam, Value: "25"}}
+	assert.Equal(t, r.rbacWorkerCount(context.TODO()), 25)
+
+	r.tektonConfig.Spec.Params = []v1alpha1.Param{{Name: v1alpha1.RBACWorkerCountParam, Value: "0"}}
+	assert.Equal(t, r.rbacWorkerCount(context.TODO()), defaultRBACWorkerCount)
+}
+
+func TestProcessNamespaces(t *testing.T) {
+	var namespaces []corev1.Namespace
+	for i := 0; i < 9; i++ {
+		namespaces = append(namespaces, corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("ns-%d", i)}})
+	}
+	var running, maxRunning int32
+	process := func(_ context.Context, i int, ns corev1.Namespace) error {
+		n := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		for {
+			m := atomic.LoadInt32(&maxRunning)
+			if n <= m || atomic.CompareAndSwapInt32(&maxRunning, m, n) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		if ns.Name == "ns-4" {
+			return errors.New("forbidden")
+		}
+		return nil
+	}
+
+	errs := processNamespaces(context.TODO(), 3, namespaces, false, process)
+	assert.Equal(t, len(errs), len(namespaces))
+	for i, err := range errs {
+		if i == 4 {
+			assert.ErrorContains(t, err, "forbidden")
+			continue
+		}
+		assert.NilError(t, err)
+	}
+	assert.Assert(t, maxRunning <= 3, "%d namespaces processed at once", maxRunning)
+
+	// with failFast, the namespaces after the failure are skipped once it is known
+	errs = processNamespaces(context.TODO(), 1, namespaces, true, process)
+	assert.ErrorContains(t, errs[4], "forbidden")
+	for _, err := range errs[5:] {
+		assert.Equal(t, err, errNamespaceSkipped)
+	}
+}
+
+// TestCreateResourcesConcurrentServiceAccounts creates the ServiceAccounts of several namespaces
+// with several workers, the TektonConfig is labelled once they are done, run it with -race
+func TestCreateResourcesConcurrentServiceAccounts(t *testing.T) {
+	t.Setenv(common.KoEnvKey, "testdata")
+	ctx := context.Background()
+
+	kubeClient := kubefake.NewSimpleClientset(&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "edit"}})
+	securityClient := fakesecurity.NewSimpleClientset()
+	_, err := securityClient.SecurityV1().SecurityContextConstraints().Create(ctx,
+		&securityv1.SecurityContextConstraints{ObjectMeta: metav1.ObjectMeta{Name: "pipelines-scc"}}, metav1.CreateOptions{})
+	assert.NilError(t, err)
+	operatorClient := operatorfake.NewSimpleClientset(&v1alpha1.TektonInstallerSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        rbacInstallerSetNamePrefix + "workers",
+			Labels:      rbacInstallerSetSelector.MatchLabels,
+			Annotations: map[string]string{v1alpha1.ReleaseVersionKey: "test-version"},
+		},
+	})
+
+	informers := kubeinformers.NewSharedInformerFactory(kubeClient, 0)
+	nsInformer := informers.Core().V1().Namespaces()
+	for i := 0; i < 12; i++ {
+		created, err := kubeClient.CoreV1().Namespaces().Create(ctx,
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("ns-%d", i)}}, metav1.CreateOptions{})
+		assert.NilError(t, err)
+		assert.NilError(t, nsInformer.Informer().GetIndexer().Add(created))
+	}
+
+	r := &rbac{
+		kubeClientSet:     kubeClient,
+		operatorClientSet: operatorClient,
+		securityClientSet: securityClient,
+		rbacInformer:      informers.Rbac().V1().ClusterRoleBindings(),
+		nsInformer:        nsInformer,
+		tektonConfig: &v1alpha1.TektonConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.ConfigResourceName},
+			Spec: v1alpha1.TektonConfigSpec{
+				Params: []v1alpha1.Param{
+					{Name: v1alpha1.CreateRbacResourceParam, Value: "true"},
+					{Name: v1alpha1.CreateCABundleConfigMapsParam, Value: "false"},
+					{Name: v1alpha1.RBACWorkerCountParam, Value: "4"},
+				},
+				Platforms: v1alpha1.Platforms{OpenShift: v1alpha1.OpenShift{SCC: &v1alpha1.SCC{Default: "pipelines-scc"}}},
+			},
+		},
+		version: "test-version",
+	}
+	assert.NilError(t, r.createResources(ctx))
+
+	for i := 0; i < 12; i++ {
+		_, err := kubeClient.CoreV1().ServiceAccounts(fmt.Sprintf("ns-%d", i)).Get(ctx, pipelineSA, metav1.GetOptions{})
+		assert.NilError(t, err)
+	}
+	assert.Equal(t, r.tektonConfig.Labels[serviceAccountCreationLabel], "true")
+}
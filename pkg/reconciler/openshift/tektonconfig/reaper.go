@@ -0,0 +1,244 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonconfig
+
+import (
+	"context"
+	goerrors "errors"
+	"fmt"
+	"time"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	reconcilerCommon "github.com/tektoncd/operator/pkg/reconciler/common"
+	"github.com/tektoncd/operator/pkg/reconciler/openshift/namespacerbac"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/logging"
+)
+
+const (
+	rbacReaperParamName          = v1alpha1.ReapInactiveNamespaceRBACParam
+	rbacReaperRetentionParamName = v1alpha1.InactiveNamespaceRBACRetentionParam
+	defaultRBACReaperRetention   = 30 * 24 * time.Hour
+
+	// namespaceRBACReapedAnnotation holds the time at which the operator removed
+	// the RBAC resources and CA bundles from an inactive namespace
+	namespaceRBACReapedAnnotation = "openshift-pipelines.tekton.dev/rbac-reaped-at"
+)
+
+// reaperConfig returns whether the reaper is enabled and the period of
+// inactivity after which the resources of a namespace are removed
+func (r *rbac) reaperConfig(ctx context.Context) (bool, time.Duration) {
+	logger := logging.FromContext(ctx)
+
+	enabled := false
+	retention := defaultRBACReaperRetention
+	for _, v := range r.tektonConfig.Spec.Params {
+		switch v.Name {
+		case rbacReaperParamName:
+			enabled = v.Value == "true"
+		case rbacReaperRetentionParamName:
+			d, err := time.ParseDuration(v.Value)
+			if err != nil || d <= 0 {
+				logger.Warnf("invalid value %q for param %s, using default %s", v.Value, rbacReaperRetentionParamName, defaultRBACReaperRetention)
+				continue
+			}
+			retention = d
+		}
+	}
+	return enabled, retention
+}
+
+// namespaceReapedAt returns the time at which the namespace was reaped, if it was
+func namespaceReapedAt(ns *corev1.Namespace) (time.Time, bool) {
+	value, ok := ns.GetAnnotations()[namespaceRBACReapedAnnotation]
+	if !ok {
+		return time.Time{}, false
+	}
+	reapedAt, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		// an unparsable value still means the namespace was reaped, treat
+		// any PipelineRun as newer than it
+		return time.Time{}, true
+	}
+	return reapedAt, true
+}
+
+// isReapCandidate checks whether a namespace was provisioned by the operator and has
+// no Tekton activity for longer than the retention period
+func (r *rbac) isReapCandidate(ns corev1.Namespace, retention time.Duration, now time.Time) bool {
+	if shouldIgnoreNamespace(ns) || ns.Name == r.tektonConfig.Spec.TargetNamespace {
+		return false
+	}
+	if _, reaped := namespaceReapedAt(&ns); reaped {
+		return false
+	}
+	if _, provisioned := ns.Labels[namespaceVersionLabel]; !provisioned {
+		return false
+	}
+	cutoff := now.Add(-retention)
+	// give new namespaces the full retention period before reaping them
+	if ns.CreationTimestamp.Time.After(cutoff) {
+		return false
+	}
+	return !r.activity.LastActivity(ns.Name).After(cutoff)
+}
+
+// reapInactiveNamespaces removes the operator created ServiceAccount, RoleBindings and
+// CA bundle ConfigMaps from namespaces without Tekton activity for the retention period.
+// The resources are created again on the first PipelineRun in the namespace.
+func (r *rbac) reapInactiveNamespaces(ctx context.Context, retention time.Duration) error {
+	logger := logging.FromContext(ctx)
+
+	if r.activity == nil {
+		return nil
+	}
+	r.activity.Start()
+	if !r.activity.HasSynced() {
+		logger.Debug("Tekton activity is not known yet, skipping reaping of inactive namespaces")
+		return nil
+	}
+
+	namespaces, err := r.kubeClientSet.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	reaped := map[string]bool{}
+	for _, ns := range namespaces.Items {
+		if !r.isReapCandidate(ns, retention, now) {
+			continue
+		}
+		logger.Infof("Namespace %s has no Tekton activity since %s, removing RBAC resources", ns.Name, retention)
+		if err := r.reapNamespace(ctx, ns, now); err != nil {
+			logger.Errorf("failed to reap namespace %s: %v", ns.Name, err)
+			continue
+		}
+		reaped[ns.Name] = true
+	}
+
+	if len(reaped) == 0 {
+		return nil
+	}
+	logger.Infof("Reaped RBAC resources from %d inactive namespaces", len(reaped))
+	// drop the service accounts of the reaped namespaces from the clusterinterceptors binding, the
+	// namespaces informer has not seen their labels removed yet
+	return r.removeSubjectsFromCI(ctx, reaped)
+}
+
+// removeSubjectsFromCI removes the subjects of the given namespaces from the clusterinterceptors
+// ClusterRoleBinding
+func (r *rbac) removeSubjectsFromCI(ctx context.Context, namespaces map[string]bool) error {
+	logger := logging.FromContext(ctx)
+
+	// the group of all the ServiceAccounts does not change with the namespaces
+	if r.clusterInterceptorsSubjectsMode() == clusterInterceptorsAllServiceAccounts {
+		return nil
+	}
+	crb, err := r.kubeClientSet.RbacV1().ClusterRoleBindings().Get(ctx, clusterInterceptors, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	subjects := make([]rbacv1.Subject, 0, len(crb.Subjects))
+	for _, s := range crb.Subjects {
+		if !namespaces[subjectNamespace(s)] {
+			subjects = append(subjects, s)
+		}
+	}
+	if len(subjects) == len(crb.Subjects) {
+		return nil
+	}
+	crb.Subjects = subjects
+	if _, err := r.kubeClientSet.RbacV1().ClusterRoleBindings().Update(ctx, crb, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("failed to update %s: %w", clusterInterceptors, err)
+	}
+	logger.Infof("removed the subjects of %d reaped namespaces from %s", len(namespaces), clusterInterceptors)
+	return nil
+}
+
+func (r *rbac) reapNamespace(ctx context.Context, ns corev1.Namespace, now time.Time) error {
+	if err := r.deleteNamespaceResources(ctx, ns.Name); err != nil {
+		return err
+	}
+
+	// remove the reconcile labels so that the namespace is provisioned again once
+	// the reaped annotation is removed
+	err := reconcilerCommon.PatchNamespaceMetadata(ctx, r.kubeClientSet, ns.Name, reconcilerCommon.NamespaceMetadataPatch{
+		RemoveLabels:   []string{namespaceVersionLabel, namespaceTrustedConfigLabel},
+		SetAnnotations: map[string]string{namespaceRBACReapedAnnotation: now.UTC().Format(time.RFC3339)},
+	})
+	if err != nil && !goerrors.Is(err, reconcilerCommon.ErrNamespaceTerminating) {
+		return fmt.Errorf("failed to patch namespace %s: %w", ns.Name, err)
+	}
+	return nil
+}
+
+// deleteNamespaceResources deletes the operator created ServiceAccount, RoleBindings and CA
+// bundle ConfigMaps of a namespace
+func (r *rbac) deleteNamespaceResources(ctx context.Context, namespace string) error {
+	rbacClient := r.kubeClientSet.RbacV1()
+	for _, name := range []string{pipelinesSCCRoleBinding, PipelineRoleBinding} {
+		if err := rbacClient.RoleBindings(namespace).Delete(ctx, name, metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete rolebinding %s: %w", name, err)
+		}
+	}
+	if err := rbacClient.Roles(namespace).Delete(ctx, pipelinesSCCRole, metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete role %s: %w", pipelinesSCCRole, err)
+	}
+
+	cmClient := r.kubeClientSet.CoreV1().ConfigMaps(namespace)
+	names := caBundleConfigMaps(r.tektonConfig)
+	for _, name := range []string{names.Trusted, names.Service, namespacerbac.AdditionalTrustBundleConfigMap} {
+		if name == "" {
+			continue
+		}
+		cm, err := cmClient.Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+		// leave configmaps which were not created by the operator alone
+		if cm.Labels["app.kubernetes.io/part-of"] != "tekton-pipelines" {
+			continue
+		}
+		if err := cmClient.Delete(ctx, name, metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete configmap %s: %w", name, err)
+		}
+	}
+
+	saName := r.serviceAccountName()
+	saClient := r.kubeClientSet.CoreV1().ServiceAccounts(namespace)
+	sa, err := saClient.Get(ctx, saName, metav1.GetOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	// only delete the service account if it is managed by the operator
+	if err == nil && namespacerbac.HasOwnerReference(sa.GetOwnerReferences(), tektonConfigOwnerRef(*r.tektonConfig)) {
+		if err := saClient.Delete(ctx, saName, metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete serviceaccount %s: %w", saName, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,332 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonconfig
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubeinformers "k8s.io/client-go/informers"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+)
+
+type fakeActivity struct {
+	synced bool
+	last   map[string]time.Time
+}
+
+func (f fakeActivity) Start() {}
+
+func (f fakeActivity) HasSynced() bool { return f.synced }
+
+func (f fakeActivity) LastActivity(namespace string) time.Time { return f.last[namespace] }
+
+func TestReaperConfig(t *testing.T) {
+	tests := []struct {
+		name          string
+		params        []v1alpha1.Param
+		wantEnabled   bool
+		wantRetention time.Duration
+	}{
+		{
+			name:          "disabled by default",
+			wantEnabled:   false,
+			wantRetention: defaultRBACReaperRetention,
+		},
+		{
+			name: "enabled with custom retention",
+			params: []v1alpha1.Param{
+				{Name: rbacReaperParamName, Value: "true"},
+				{Name: rbacReaperRetentionParamName, Value: "72h"},
+			},
+			wantEnabled:   true,
+			wantRetention: 72 * time.Hour,
+		},
+		{
+			name: "invalid retention falls back to default",
+			params: []v1alpha1.Param{
+				{Name: rbacReaperParamName, Value: "true"},
+				{Name: rbacReaperRetentionParamName, Value: "a month"},
+			},
+			wantEnabled:   true,
+			wantRetention: defaultRBACReaperRetention,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &rbac{tektonConfig: &v1alpha1.TektonConfig{Spec: v1alpha1.TektonConfigSpec{Params: tt.params}}}
+			enabled, retention := r.reaperConfig(context.TODO())
+			assert.Equal(t, enabled, tt.wantEnabled)
+			assert.Equal(t, retention, tt.wantRetention)
+		})
+	}
+}
+
+func TestIsReapCandidate(t *testing.T) {
+	now := time.Now()
+	old := metav1.NewTime(now.Add(-60 * 24 * time.Hour))
+	retention := 30 * 24 * time.Hour
+
+	newNamespace := func(name string, created metav1.Time, labels, annotations map[string]string) corev1.Namespace {
+		return corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			CreationTimestamp: created,
+			Labels:            labels,
+			Annotations:       annotations,
+		}}
+	}
+	provisioned := map[string]string{namespaceVersionLabel: "test-version"}
+
+	tests := []struct {
+		name string
+		ns   corev1.Namespace
+		want bool
+	}{
+		{
+			name: "inactive provisioned namespace",
+			ns:   newNamespace("inactive", old, provisioned, nil),
+			want: true,
+		},
+		{
+			name: "recently active namespace",
+			ns:   newNamespace("active", old, provisioned, nil),
+			want: false,
+		},
+		{
+			name: "namespace younger than retention",
+			ns:   newNamespace("young", metav1.NewTime(now.Add(-time.Hour)), provisioned, nil),
+			want: false,
+		},
+		{
+			name: "namespace not provisioned by the operator",
+			ns:   newNamespace("unmanaged", old, nil, nil),
+			want: false,
+		},
+		{
+			name: "already reaped namespace",
+			ns:   newNamespace("reaped", old, provisioned, map[string]string{namespaceRBACReapedAnnotation: now.Format(time.RFC3339)}),
+			want: false,
+		},
+		{
+			name: "ignored namespace",
+			ns:   newNamespace("openshift-monitoring", old, provisioned, nil),
+			want: false,
+		},
+		{
+			name: "target namespace",
+			ns:   newNamespace("openshift-pipelines", old, provisioned, nil),
+			want: false,
+		},
+	}
+
+	r := &rbac{
+		tektonConfig: &v1alpha1.TektonConfig{Spec: v1alpha1.TektonConfigSpec{
+			CommonSpec: v1alpha1.CommonSpec{TargetNamespace: "openshift-pipelines"},
+		}},
+		activity: fakeActivity{synced: true, last: map[string]time.Time{
+			"active": now.Add(-time.Hour),
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, r.isReapCandidate(tt.ns, retention, now), tt.want)
+		})
+	}
+}
+
+func TestReapInactiveNamespaces(t *testing.T) {
+	ctx := context.TODO()
+	old := metav1.NewTime(time.Now().Add(-60 * 24 * time.Hour))
+	tc := &v1alpha1.TektonConfig{
+		TypeMeta:   metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: v1alpha1.KindTektonConfig},
+		ObjectMeta: metav1.ObjectMeta{Name: "config", UID: "tc-uid"},
+	}
+	ownerRef := tektonConfigOwnerRef(*tc)
+
+	objs := []struct {
+		ns          string
+		withOwnerSA bool
+	}{
+		{ns: "inactive", withOwnerSA: true},
+		{ns: "active", withOwnerSA: true},
+		{ns: "user-sa", withOwnerSA: false},
+	}
+
+	kubeClient := kubefake.NewSimpleClientset()
+	for _, o := range objs {
+		_, err := kubeClient.CoreV1().Namespaces().Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+			Name:              o.ns,
+			CreationTimestamp: old,
+			Labels: map[string]string{
+				namespaceVersionLabel:       "test-version",
+				namespaceTrustedConfigLabel: "test-version",
+			},
+		}}, metav1.CreateOptions{})
+		assert.NilError(t, err)
+
+		sa := &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: pipelineSA, Namespace: o.ns}}
+		if o.withOwnerSA {
+			sa.OwnerReferences = []metav1.OwnerReference{ownerRef}
+		}
+		_, err = kubeClient.CoreV1().ServiceAccounts(o.ns).Create(ctx, sa, metav1.CreateOptions{})
+		assert.NilError(t, err)
+
+		for _, name := range []string{pipelinesSCCRoleBinding, PipelineRoleBinding} {
+			_, err = kubeClient.RbacV1().RoleBindings(o.ns).Create(ctx, &rbacv1.RoleBinding{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: o.ns}}, metav1.CreateOptions{})
+			assert.NilError(t, err)
+		}
+		_, err = kubeClient.RbacV1().Roles(o.ns).Create(ctx, &rbacv1.Role{ObjectMeta: metav1.ObjectMeta{Name: pipelinesSCCRole, Namespace: o.ns}}, metav1.CreateOptions{})
+		assert.NilError(t, err)
+
+		_, err = kubeClient.CoreV1().ConfigMaps(o.ns).Create(ctx, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+			Name:      trustedCABundleConfigMap,
+			Namespace: o.ns,
+			Labels:    map[string]string{"app.kubernetes.io/part-of": "tekton-pipelines"},
+		}}, metav1.CreateOptions{})
+		assert.NilError(t, err)
+		// a configmap with the same name created by the user must be kept
+		_, err = kubeClient.CoreV1().ConfigMaps(o.ns).Create(ctx, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+			Name:      serviceCABundleConfigMap,
+			Namespace: o.ns,
+		}}, metav1.CreateOptions{})
+		assert.NilError(t, err)
+	}
+
+	// the service accounts of the namespaces are bound to the clusterinterceptors ClusterRole
+	crb := &rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: clusterInterceptors}}
+	for _, o := range objs {
+		crb.Subjects = append(crb.Subjects, rbacv1.Subject{Kind: rbacv1.ServiceAccountKind, Name: pipelineSA, Namespace: o.ns})
+	}
+	_, err := kubeClient.RbacV1().ClusterRoleBindings().Create(ctx, crb, metav1.CreateOptions{})
+	assert.NilError(t, err)
+
+	informers := kubeinformers.NewSharedInformerFactory(kubeClient, 0)
+	r := &rbac{
+		kubeClientSet: kubeClient,
+		rbacInformer:  informers.Rbac().V1().ClusterRoleBindings(),
+		nsInformer:    informers.Core().V1().Namespaces(),
+		tektonConfig:  tc,
+		version:       "test-version",
+		activity: fakeActivity{synced: true, last: map[string]time.Time{
+			"active": time.Now().Add(-time.Hour),
+		}},
+	}
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	informers.Start(stopCh)
+	informers.WaitForCacheSync(stopCh)
+
+	assert.NilError(t, r.reapInactiveNamespaces(ctx, defaultRBACReaperRetention))
+
+	for _, o := range objs {
+		ns, err := kubeClient.CoreV1().Namespaces().Get(ctx, o.ns, metav1.GetOptions{})
+		assert.NilError(t, err)
+		_, reaped := namespaceReapedAt(ns)
+		_, err = kubeClient.RbacV1().RoleBindings(o.ns).Get(ctx, PipelineRoleBinding, metav1.GetOptions{})
+		rbDeleted := errors.IsNotFound(err)
+		_, err = kubeClient.CoreV1().ConfigMaps(o.ns).Get(ctx, trustedCABundleConfigMap, metav1.GetOptions{})
+		cmDeleted := errors.IsNotFound(err)
+		_, err = kubeClient.CoreV1().ConfigMaps(o.ns).Get(ctx, serviceCABundleConfigMap, metav1.GetOptions{})
+		assert.NilError(t, err, "user configmap must not be deleted in %s", o.ns)
+		_, err = kubeClient.CoreV1().ServiceAccounts(o.ns).Get(ctx, pipelineSA, metav1.GetOptions{})
+		saDeleted := errors.IsNotFound(err)
+
+		if o.ns == "active" {
+			assert.Assert(t, !reaped)
+			assert.Assert(t, !rbDeleted && !cmDeleted && !saDeleted)
+			assert.Equal(t, ns.Labels[namespaceVersionLabel], "test-version")
+			continue
+		}
+		assert.Assert(t, reaped, "namespace %s should be reaped", o.ns)
+		assert.Assert(t, rbDeleted && cmDeleted)
+		_, hasLabel := ns.Labels[namespaceVersionLabel]
+		assert.Assert(t, !hasLabel)
+		// service accounts not owned by the operator are kept
+		assert.Equal(t, saDeleted, o.withOwnerSA)
+	}
+
+	// the reaped namespaces are removed from the clusterinterceptors ClusterRoleBinding
+	crb, err = kubeClient.RbacV1().ClusterRoleBindings().Get(ctx, clusterInterceptors, metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, crb.Subjects, []rbacv1.Subject{{Kind: rbacv1.ServiceAccountKind, Name: pipelineSA, Namespace: "active"}})
+}
+
+func TestReprovisionNamespaceOf(t *testing.T) {
+	ctx := context.TODO()
+	reapedAt := time.Now().UTC().Truncate(time.Second)
+	kubeClient := kubefake.NewSimpleClientset()
+	informers := kubeinformers.NewSharedInformerFactory(kubeClient, 0)
+	nsInformer := informers.Core().V1().Namespaces()
+	a := &activityTracker{kubeClientSet: kubeClient, nsInformer: nsInformer}
+
+	for _, test := range []struct {
+		name        string
+		created     time.Time
+		reprovision bool
+	}{
+		{name: "before", created: reapedAt.Add(-time.Second), reprovision: false},
+		{name: "same-second", created: reapedAt, reprovision: true},
+		{name: "after", created: reapedAt.Add(time.Second), reprovision: true},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+				Name:        test.name,
+				Annotations: map[string]string{namespaceRBACReapedAnnotation: reapedAt.Format(time.RFC3339)},
+			}}
+			_, err := kubeClient.CoreV1().Namespaces().Create(ctx, ns, metav1.CreateOptions{})
+			assert.NilError(t, err)
+			assert.NilError(t, nsInformer.Informer().GetIndexer().Add(ns))
+
+			a.reprovisionNamespaceOf(ctx, &metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{
+				Name:              "run",
+				Namespace:         test.name,
+				CreationTimestamp: metav1.NewTime(test.created),
+			}})
+
+			ns, err = kubeClient.CoreV1().Namespaces().Get(ctx, test.name, metav1.GetOptions{})
+			assert.NilError(t, err)
+			_, reaped := namespaceReapedAt(ns)
+			assert.Equal(t, reaped, !test.reprovision)
+		})
+	}
+}
+
+func TestReapInactiveNamespacesNotSynced(t *testing.T) {
+	ctx := context.TODO()
+	kubeClient := kubefake.NewSimpleClientset(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+		Name:   "inactive",
+		Labels: map[string]string{namespaceVersionLabel: "test-version"},
+	}})
+	r := &rbac{
+		kubeClientSet: kubeClient,
+		tektonConfig:  &v1alpha1.TektonConfig{},
+		activity:      fakeActivity{synced: false},
+	}
+	assert.NilError(t, r.reapInactiveNamespaces(ctx, time.Nanosecond))
+
+	ns, err := kubeClient.CoreV1().Namespaces().Get(ctx, "inactive", metav1.GetOptions{})
+	assert.NilError(t, err)
+	_, reaped := namespaceReapedAt(ns)
+	assert.Assert(t, !reaped)
+}
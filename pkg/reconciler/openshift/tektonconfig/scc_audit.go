@@ -0,0 +1,292 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonconfig
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/openshift"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/logging"
+)
+
+const (
+	// sccAuditConfigMap is the ConfigMap in the target namespace holding the SCC usage report
+	sccAuditConfigMap = "pipelines-scc-audit"
+	// sccAuditReportKey holds the SCC granted to each ServiceAccount, as a JSON list
+	sccAuditReportKey = "report.json"
+	// sccAuditSummaryKey holds the number of namespaces per SCC and per source, as JSON
+	sccAuditSummaryKey = "summary.json"
+	// sccAuditGeneratedAtAnnotation holds the time at which the report was generated
+	sccAuditGeneratedAtAnnotation = "openshift-pipelines.tekton.dev/scc-audit-generated-at"
+
+	sccAuditIntervalParamName = v1alpha1.SCCAuditIntervalParam
+	defaultSCCAuditInterval   = time.Hour
+
+	// sccSourceAnnotation is an SCC requested with the namespace annotation
+	sccSourceAnnotation = "annotation"
+	// sccSourceDefault is the default SCC of the TektonConfig
+	sccSourceDefault = "default"
+	// sccSourceServiceAccounts is an SCC granted to an additional ServiceAccount
+	sccSourceServiceAccounts = "serviceAccounts"
+)
+
+// sccGrant is the SCC granted to a ServiceAccount in a namespace
+type sccGrant struct {
+	Namespace      string `json:"namespace"`
+	ServiceAccount string `json:"serviceAccount"`
+	SCC            string `json:"scc"`
+	Source         string `json:"source"`
+	// Role is the Role or ClusterRole granting the SCC, as Kind/Name
+	Role string `json:"role"`
+	// RequestedSCC is the value of the namespace annotation, when it differs from the granted SCC
+	// the namespace has not been reconciled yet or the requested SCC was rejected
+	RequestedSCC string `json:"requestedSCC,omitempty"`
+}
+
+// sccAuditSummary counts the namespaces per granted SCC and per source of the SCC
+type sccAuditSummary struct {
+	Namespaces int            `json:"namespaces"`
+	BySCC      map[string]int `json:"bySCC"`
+	BySource   map[string]int `json:"bySource"`
+}
+
+// sccAuditInterval returns the minimum time between two SCC usage reports
+func (r *rbac) sccAuditInterval(ctx context.Context) time.Duration {
+	for _, v := range r.tektonConfig.Spec.Params {
+		if v.Name != sccAuditIntervalParamName {
+			continue
+		}
+		d, err := time.ParseDuration(v.Value)
+		if err != nil || d <= 0 {
+			logging.FromContext(ctx).Warnf("invalid value %q for param %s, using default %s", v.Value, sccAuditIntervalParamName, defaultSCCAuditInterval)
+			break
+		}
+		return d
+	}
+	return defaultSCCAuditInterval
+}
+
+// reportSCCUsage writes the SCC granted in every namespace to the pipelines-scc-audit
+// ConfigMap, the report is generated again once the audit interval has passed
+func (r *rbac) reportSCCUsage(ctx context.Context, now time.Time) error {
+	targetNamespace := r.tektonConfig.Spec.GetTargetNamespace()
+	cmClient := r.kubeClientSet.CoreV1().ConfigMaps(targetNamespace)
+
+	existing, err := cmClient.Get(ctx, sccAuditConfigMap, metav1.GetOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	found := err == nil
+	if found {
+		generatedAt, err := time.Parse(time.RFC3339, existing.Annotations[sccAuditGeneratedAtAnnotation])
+		if err == nil && now.Sub(generatedAt) < r.sccAuditInterval(ctx) {
+			return nil
+		}
+	}
+
+	grants, err := r.sccGrants(ctx)
+	if err != nil {
+		return err
+	}
+	data, err := sccAuditData(grants)
+	if err != nil {
+		return err
+	}
+	annotations := map[string]string{sccAuditGeneratedAtAnnotation: now.UTC().Format(time.RFC3339)}
+
+	if !found {
+		_, err = cmClient.Create(ctx, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            sccAuditConfigMap,
+				Namespace:       targetNamespace,
+				Annotations:     annotations,
+				OwnerReferences: []metav1.OwnerReference{tektonConfigOwnerRef(*r.tektonConfig)},
+			},
+			Data: data,
+		}, metav1.CreateOptions{})
+		return err
+	}
+
+	if !reflect.DeepEqual(existing.Data, data) {
+		logging.FromContext(ctx).Infof("SCC usage changed, %d ServiceAccounts are granted an SCC", len(grants))
+	}
+	existing = existing.DeepCopy()
+	if existing.Annotations == nil {
+		existing.Annotations = map[string]string{}
+	}
+	existing.Annotations[sccAuditGeneratedAtAnnotation] = annotations[sccAuditGeneratedAtAnnotation]
+	existing.Data = data
+	_, err = cmClient.Update(ctx, existing, metav1.UpdateOptions{})
+	return err
+}
+
+// sccGrants returns the SCCs granted by the operator in the namespaces, read from the
+// RoleBindings in the cluster rather than from the TektonConfig
+func (r *rbac) sccGrants(ctx context.Context) ([]sccGrant, error) {
+	rbacClient := r.kubeClientSet.RbacV1()
+
+	namespaces, err := r.kubeClientSet.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	var defaultSCCRules []rbacv1.PolicyRule
+	clusterRole, err := rbacClient.ClusterRoles().Get(ctx, pipelinesSCCClusterRole, metav1.GetOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, err
+	}
+	if err == nil {
+		defaultSCCRules = clusterRole.Rules
+	}
+
+	grants := []sccGrant{}
+	for _, ns := range namespaces.Items {
+		if shouldIgnoreNamespace(ns) {
+			continue
+		}
+		rb, err := rbacClient.RoleBindings(ns.Name).Get(ctx, pipelinesSCCRoleBinding, metav1.GetOptions{})
+		if errors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		grant := sccGrant{
+			Namespace:      ns.Name,
+			ServiceAccount: r.serviceAccountName(),
+			Role:           rb.RoleRef.Kind + "/" + rb.RoleRef.Name,
+		}
+		for _, s := range rb.Subjects {
+			if s.Kind == rbacv1.ServiceAccountKind {
+				grant.ServiceAccount = s.Name
+				break
+			}
+		}
+		switch {
+		case rb.RoleRef.Kind == "Role":
+			grant.Source = sccSourceAnnotation
+			role, err := rbacClient.Roles(ns.Name).Get(ctx, rb.RoleRef.Name, metav1.GetOptions{})
+			if err != nil && !errors.IsNotFound(err) {
+				return nil, err
+			}
+			if err == nil {
+				grant.SCC = sccFromRules(role.Rules)
+			}
+		case rb.RoleRef.Name == pipelinesSCCClusterRole:
+			grant.Source = sccSourceDefault
+			grant.SCC = sccFromRules(defaultSCCRules)
+		default:
+			continue
+		}
+		if requested := ns.Annotations[openshift.NamespaceSCCAnnotation]; requested != "" && requested != grant.SCC {
+			grant.RequestedSCC = requested
+		}
+		grants = append(grants, grant)
+	}
+
+	saGrants, err := r.sccServiceAccountGrants(ctx)
+	if err != nil {
+		return nil, err
+	}
+	grants = append(grants, saGrants...)
+
+	sort.SliceStable(grants, func(i, j int) bool {
+		if grants[i].Namespace != grants[j].Namespace {
+			return grants[i].Namespace < grants[j].Namespace
+		}
+		return grants[i].ServiceAccount < grants[j].ServiceAccount
+	})
+	return grants, nil
+}
+
+// sccServiceAccountGrants returns the SCCs granted to the additional ServiceAccounts
+func (r *rbac) sccServiceAccountGrants(ctx context.Context) ([]sccGrant, error) {
+	rbacClient := r.kubeClientSet.RbacV1()
+	listOptions := metav1.ListOptions{LabelSelector: sccServiceAccountLabel}
+
+	roles, err := rbacClient.Roles(metav1.NamespaceAll).List(ctx, listOptions)
+	if err != nil {
+		return nil, err
+	}
+	sccs := map[string]string{}
+	for _, role := range roles.Items {
+		sccs[role.Namespace+"/"+role.Name] = sccFromRules(role.Rules)
+	}
+
+	rbs, err := rbacClient.RoleBindings(metav1.NamespaceAll).List(ctx, listOptions)
+	if err != nil {
+		return nil, err
+	}
+	grants := []sccGrant{}
+	for _, rb := range rbs.Items {
+		grants = append(grants, sccGrant{
+			Namespace:      rb.Namespace,
+			ServiceAccount: rb.Labels[sccServiceAccountLabel],
+			SCC:            sccs[rb.Namespace+"/"+rb.RoleRef.Name],
+			Source:         sccSourceServiceAccounts,
+			Role:           rb.RoleRef.Kind + "/" + rb.RoleRef.Name,
+		})
+	}
+	return grants, nil
+}
+
+// sccFromRules returns the SCCs allowed to be used by the rules, comma separated
+func sccFromRules(rules []rbacv1.PolicyRule) string {
+	sccs := []string{}
+	for _, rule := range rules {
+		if slices.Contains(rule.Resources, "securitycontextconstraints") && slices.Contains(rule.Verbs, "use") {
+			sccs = append(sccs, rule.ResourceNames...)
+		}
+	}
+	return strings.Join(sccs, ",")
+}
+
+func sccAuditData(grants []sccGrant) (map[string]string, error) {
+	summary := sccAuditSummary{BySCC: map[string]int{}, BySource: map[string]int{}}
+	namespaces := map[string]bool{}
+	for _, grant := range grants {
+		namespaces[grant.Namespace] = true
+		summary.BySCC[grant.SCC]++
+		summary.BySource[grant.Source]++
+	}
+	summary.Namespaces = len(namespaces)
+
+	report, err := json.MarshalIndent(grants, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal the SCC usage report: %w", err)
+	}
+	summaryJSON, err := json.MarshalIndent(summary, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal the SCC usage summary: %w", err)
+	}
+	return map[string]string{
+		sccAuditReportKey:  string(report),
+		sccAuditSummaryKey: string(summaryJSON),
+	}, nil
+}
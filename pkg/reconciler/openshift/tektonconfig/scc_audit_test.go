@@ -0,0 +1,131 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonconfig
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/openshift"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestReportSCCUsage(t *testing.T) {
+	ctx := context.TODO()
+	sccRule := func(scc string) []rbacv1.PolicyRule {
+		return []rbacv1.PolicyRule{{
+			APIGroups:     []string{"security.openshift.io"},
+			Resources:     []string{"securitycontextconstraints"},
+			ResourceNames: []string{scc},
+			Verbs:         []string{"use"},
+		}}
+	}
+	namespace := func(name, scc string) *corev1.Namespace {
+		ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}}
+		if scc != "" {
+			ns.Annotations = map[string]string{openshift.NamespaceSCCAnnotation: scc}
+		}
+		return ns
+	}
+	sccRoleBinding := func(namespace, kind, role string) *rbacv1.RoleBinding {
+		return &rbacv1.RoleBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: pipelinesSCCRoleBinding, Namespace: namespace},
+			RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: kind, Name: role},
+			Subjects:   []rbacv1.Subject{{Kind: rbacv1.ServiceAccountKind, Name: pipelineSA, Namespace: namespace}},
+		}
+	}
+	objects := []runtime.Object{
+		namespace("default-ns", ""),
+		namespace("annotated", "anyuid"),
+		namespace("pending", "privileged"),
+		namespace("no-rbac", ""),
+		namespace("openshift-monitoring", ""),
+		&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: pipelinesSCCClusterRole}, Rules: sccRule("pipelines-scc")},
+		sccRoleBinding("default-ns", "ClusterRole", pipelinesSCCClusterRole),
+		&rbacv1.Role{ObjectMeta: metav1.ObjectMeta{Name: pipelinesSCCRole, Namespace: "annotated"}, Rules: sccRule("anyuid")},
+		sccRoleBinding("annotated", "Role", pipelinesSCCRole),
+		// the namespace requests another SCC than the one it is granted
+		sccRoleBinding("pending", "ClusterRole", pipelinesSCCClusterRole),
+		sccRoleBinding("openshift-monitoring", "ClusterRole", pipelinesSCCClusterRole),
+		&rbacv1.Role{
+			ObjectMeta: metav1.ObjectMeta{Name: "pipelines-scc-sa-buildah", Namespace: "default-ns", Labels: map[string]string{sccServiceAccountLabel: "buildah"}},
+			Rules:      sccRule("anyuid"),
+		},
+		&rbacv1.RoleBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "pipelines-scc-sa-buildah", Namespace: "default-ns", Labels: map[string]string{sccServiceAccountLabel: "buildah"}},
+			RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "Role", Name: "pipelines-scc-sa-buildah"},
+		},
+	}
+
+	r := &rbac{
+		kubeClientSet: kubefake.NewSimpleClientset(objects...),
+		tektonConfig: &v1alpha1.TektonConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.ConfigResourceName},
+			Spec: v1alpha1.TektonConfigSpec{
+				CommonSpec: v1alpha1.CommonSpec{TargetNamespace: "openshift-pipelines"},
+				Params:     []v1alpha1.Param{{Name: v1alpha1.SCCAuditIntervalParam, Value: "30m"}},
+			},
+		},
+	}
+
+	now := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	assert.NilError(t, r.reportSCCUsage(ctx, now))
+
+	cm, err := r.kubeClientSet.CoreV1().ConfigMaps("openshift-pipelines").Get(ctx, sccAuditConfigMap, metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, cm.Annotations[sccAuditGeneratedAtAnnotation], "2026-01-01T10:00:00Z")
+
+	grants := []sccGrant{}
+	assert.NilError(t, json.Unmarshal([]byte(cm.Data[sccAuditReportKey]), &grants))
+	assert.DeepEqual(t, grants, []sccGrant{
+		{Namespace: "annotated", ServiceAccount: pipelineSA, SCC: "anyuid", Source: sccSourceAnnotation, Role: "Role/pipelines-scc-role"},
+		{Namespace: "default-ns", ServiceAccount: "buildah", SCC: "anyuid", Source: sccSourceServiceAccounts, Role: "Role/pipelines-scc-sa-buildah"},
+		{Namespace: "default-ns", ServiceAccount: pipelineSA, SCC: "pipelines-scc", Source: sccSourceDefault, Role: "ClusterRole/pipelines-scc-clusterrole"},
+		{Namespace: "pending", ServiceAccount: pipelineSA, SCC: "pipelines-scc", Source: sccSourceDefault, Role: "ClusterRole/pipelines-scc-clusterrole", RequestedSCC: "privileged"},
+	})
+
+	summary := sccAuditSummary{}
+	assert.NilError(t, json.Unmarshal([]byte(cm.Data[sccAuditSummaryKey]), &summary))
+	assert.DeepEqual(t, summary, sccAuditSummary{
+		Namespaces: 3,
+		BySCC:      map[string]int{"anyuid": 2, "pipelines-scc": 2},
+		BySource:   map[string]int{sccSourceAnnotation: 1, sccSourceDefault: 2, sccSourceServiceAccounts: 1},
+	})
+
+	// the report is not generated again before the interval has passed
+	_, err = r.kubeClientSet.RbacV1().RoleBindings("no-rbac").Create(ctx, sccRoleBinding("no-rbac", "ClusterRole", pipelinesSCCClusterRole), metav1.CreateOptions{})
+	assert.NilError(t, err)
+	assert.NilError(t, r.reportSCCUsage(ctx, now.Add(10*time.Minute)))
+	cm, err = r.kubeClientSet.CoreV1().ConfigMaps("openshift-pipelines").Get(ctx, sccAuditConfigMap, metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, cm.Annotations[sccAuditGeneratedAtAnnotation], "2026-01-01T10:00:00Z")
+
+	assert.NilError(t, r.reportSCCUsage(ctx, now.Add(time.Hour)))
+	cm, err = r.kubeClientSet.CoreV1().ConfigMaps("openshift-pipelines").Get(ctx, sccAuditConfigMap, metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, cm.Annotations[sccAuditGeneratedAtAnnotation], "2026-01-01T11:00:00Z")
+	assert.NilError(t, json.Unmarshal([]byte(cm.Data[sccAuditReportKey]), &grants))
+	assert.Equal(t, len(grants), 5)
+}
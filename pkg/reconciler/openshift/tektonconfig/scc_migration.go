@@ -0,0 +1,244 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonconfig
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/common"
+	"github.com/tektoncd/operator/pkg/reconciler/openshift"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"knative.dev/pkg/logging"
+)
+
+const (
+	// sccMigratedAnnotation holds the SCC annotated in the namespace by the migration, the
+	// rollback only removes the SCC annotations added by the migration
+	sccMigratedAnnotation = "openshift-pipelines.tekton.dev/scc-migrated"
+
+	sccMigrationNone     = "none"
+	sccMigrationReport   = "report"
+	sccMigrationApply    = "apply"
+	sccMigrationRollback = "rollback"
+
+	defaultSCCMigrationBatchSize = 50
+	// sccMigrationListedNamespaces is the maximum number of changing namespaces listed in the status
+	sccMigrationListedNamespaces = 10
+)
+
+// sccMigration configures the migration of the namespaces using the default SCC to the SCC
+// namespace annotation
+type sccMigration struct {
+	mode      string
+	scc       string
+	batchSize int
+}
+
+// sccMigrationConfig returns the configuration of the migration from the TektonConfig params
+func (r *rbac) sccMigrationConfig(ctx context.Context) sccMigration {
+	logger := logging.FromContext(ctx)
+
+	m := sccMigration{mode: sccMigrationNone, batchSize: defaultSCCMigrationBatchSize}
+	for _, v := range r.tektonConfig.Spec.Params {
+		switch v.Name {
+		case v1alpha1.SCCAnnotationMigrationParam:
+			m.mode = v.Value
+		case v1alpha1.SCCAnnotationMigrationSCCParam:
+			m.scc = v.Value
+		case v1alpha1.SCCAnnotationMigrationBatchSizeParam:
+			n, err := strconv.Atoi(v.Value)
+			if err != nil || n <= 0 {
+				logger.Warnf("invalid value %q for param %s, using default %d", v.Value, v.Name, defaultSCCMigrationBatchSize)
+				continue
+			}
+			m.batchSize = n
+		}
+	}
+	if m.scc == "" {
+		m.scc = r.tektonConfig.Spec.Platforms.OpenShift.SCC.Default
+	}
+	return m
+}
+
+// migrateSCCAnnotations reports the namespaces using the default SCC with the SCC they would be
+// granted once annotated. With the apply mode, at most a batch of the namespaces is annotated
+// per reconcile, the next batch waits for the migrated namespaces to be reconciled without
+// failure. With the rollback mode, at most a batch of the annotations added by the migration is
+// removed per reconcile.
+func (r *rbac) migrateSCCAnnotations(ctx context.Context) error {
+	m := r.sccMigrationConfig(ctx)
+	if m.mode == sccMigrationNone || m.mode == "" {
+		r.tektonConfig.Status.SCCMigration = nil
+		return nil
+	}
+	status := &v1alpha1.SCCAnnotationMigrationStatus{Mode: m.mode, SCC: m.scc}
+	r.tektonConfig.Status.SCCMigration = status
+
+	err := r.runSCCMigration(ctx, m, status)
+	if err != nil {
+		status.Error = err.Error()
+	}
+	return err
+}
+
+func (r *rbac) runSCCMigration(ctx context.Context, m sccMigration, status *v1alpha1.SCCAnnotationMigrationStatus) error {
+	logger := logging.FromContext(ctx)
+
+	namespaces, err := r.nsInformer.Lister().List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	sort.Slice(namespaces, func(i, j int) bool { return namespaces[i].Name < namespaces[j].Name })
+	var migrated []*corev1.Namespace
+	annotated := map[string]bool{}
+	for _, ns := range namespaces {
+		annotated[ns.Name] = ns.Annotations[openshift.NamespaceSCCAnnotation] != ""
+		if _, ok := ns.Annotations[sccMigratedAnnotation]; ok && !shouldIgnoreNamespace(*ns) {
+			migrated = append(migrated, ns)
+		}
+	}
+	status.Migrated = len(migrated)
+
+	if m.mode == sccMigrationRollback {
+		for i := 0; i < len(migrated) && i < m.batchSize; i++ {
+			logger.Infof("SCC migration: removing the SCC annotation of namespace %s", migrated[i].Name)
+			if err := r.rollbackSCCAnnotation(ctx, migrated[i]); err != nil {
+				return fmt.Errorf("failed to roll back the SCC annotation of namespace %s: %w", migrated[i].Name, err)
+			}
+			status.Migrated--
+		}
+		return nil
+	}
+
+	grants, err := r.sccGrants(ctx)
+	if err != nil {
+		return err
+	}
+	var pending []string
+	for _, grant := range grants {
+		// the annotated namespaces are granted the annotated SCC once reconciled
+		if grant.Source != sccSourceDefault || grant.RequestedSCC != "" || annotated[grant.Namespace] {
+			continue
+		}
+		pending = append(pending, grant.Namespace)
+		if grant.SCC != m.scc {
+			status.ChangingCount++
+			if len(status.Changing) < sccMigrationListedNamespaces {
+				status.Changing = append(status.Changing, fmt.Sprintf("%s: %s -> %s", grant.Namespace, grant.SCC, m.scc))
+			}
+		}
+	}
+	status.Pending = len(pending)
+	if m.mode != sccMigrationApply || len(pending) == 0 {
+		return nil
+	}
+
+	if err := r.verifyMigrationSCC(ctx, m.scc); err != nil {
+		return err
+	}
+	// the next batch waits for the migrated namespaces to be reconciled
+	if failed := r.failedMigratedNamespaces(migrated); len(failed) > 0 {
+		return fmt.Errorf("the migration is paused, %d migrated namespaces failed to be reconciled: %v", len(failed), failed)
+	}
+	for i := 0; i < len(pending) && i < m.batchSize; i++ {
+		logger.Infof("SCC migration: annotating namespace %s with SCC %s", pending[i], m.scc)
+		if err := r.patchNamespaceAnnotations(ctx, pending[i], map[string]interface{}{
+			openshift.NamespaceSCCAnnotation: m.scc,
+			sccMigratedAnnotation:            m.scc,
+		}); err != nil {
+			return fmt.Errorf("failed to annotate namespace %s with SCC %s: %w", pending[i], m.scc, err)
+		}
+		status.Pending--
+		status.Migrated++
+	}
+	return nil
+}
+
+// verifyMigrationSCC checks the SCC annotated by the migration exists and is not less
+// restrictive than the maxAllowed SCC, otherwise the annotated namespaces would be rejected
+func (r *rbac) verifyMigrationSCC(ctx context.Context, scc string) error {
+	if scc == "" {
+		return fmt.Errorf("the SCC annotated in the namespaces is empty")
+	}
+	if err := common.VerifySCCExists(ctx, scc, r.securityClientSet); err != nil {
+		return fmt.Errorf("failed to verify scc %s exists, %w", scc, err)
+	}
+	maxAllowedSCC := r.tektonConfig.Spec.Platforms.OpenShift.SCC.MaxAllowed
+	if maxAllowedSCC == "" {
+		return nil
+	}
+	prioritizedSCCList, err := common.GetSCCRestrictiveList(ctx, r.securityClientSet)
+	if err != nil {
+		return err
+	}
+	isPriority, err := common.SCCAMoreRestrictiveThanB(prioritizedSCCList, scc, maxAllowedSCC)
+	if err != nil {
+		return err
+	}
+	if !isPriority {
+		return fmt.Errorf("SCC %s is less restrictive than the 'maxAllowed' SCC: %s", scc, maxAllowedSCC)
+	}
+	return nil
+}
+
+// failedMigratedNamespaces returns the migrated namespaces listed as failed in the RBAC status
+func (r *rbac) failedMigratedNamespaces(migrated []*corev1.Namespace) []string {
+	if r.tektonConfig.Status.RBAC == nil {
+		return nil
+	}
+	names := map[string]bool{}
+	for _, ns := range migrated {
+		names[ns.Name] = true
+	}
+	var failed []string
+	for _, f := range r.tektonConfig.Status.RBAC.FailedNamespaces {
+		if names[f.Namespace] {
+			failed = append(failed, f.Namespace)
+		}
+	}
+	return failed
+}
+
+// rollbackSCCAnnotation removes the annotations added by the migration, the SCC annotation is
+// kept when it was changed since the migration
+func (r *rbac) rollbackSCCAnnotation(ctx context.Context, ns *corev1.Namespace) error {
+	annotations := map[string]interface{}{sccMigratedAnnotation: nil}
+	if ns.Annotations[openshift.NamespaceSCCAnnotation] == ns.Annotations[sccMigratedAnnotation] {
+		annotations[openshift.NamespaceSCCAnnotation] = nil
+	}
+	return r.patchNamespaceAnnotations(ctx, ns.Name, annotations)
+}
+
+// patchNamespaceAnnotations sets the annotations of the namespace, the nil values remove them
+func (r *rbac) patchNamespaceAnnotations(ctx context.Context, namespace string, annotations map[string]interface{}) error {
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{"annotations": annotations},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = r.kubeClientSet.CoreV1().Namespaces().Patch(ctx, namespace, types.MergePatchType, patch, metav1.PatchOptions{})
+	return err
+}
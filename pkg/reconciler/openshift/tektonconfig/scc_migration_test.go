@@ -0,0 +1,154 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonconfig
+
+import (
+	"context"
+	"testing"
+
+	securityv1 "github.com/openshift/api/security/v1"
+	fakesecurity "github.com/openshift/client-go/security/clientset/versioned/fake"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/openshift"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubeinformers "k8s.io/client-go/informers"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestMigrateSCCAnnotations(t *testing.T) {
+	ctx := context.TODO()
+	objects := []runtime.Object{
+		&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: pipelinesSCCClusterRole}, Rules: []rbacv1.PolicyRule{{
+			APIGroups:     []string{"security.openshift.io"},
+			Resources:     []string{"securitycontextconstraints"},
+			ResourceNames: []string{"pipelines-scc"},
+			Verbs:         []string{"use"},
+		}}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "annotated", Annotations: map[string]string{openshift.NamespaceSCCAnnotation: "anyuid"}}},
+	}
+	for _, name := range []string{"team-a", "team-b", "team-c", "annotated"} {
+		if name != "annotated" {
+			objects = append(objects, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}})
+		}
+		objects = append(objects, &rbacv1.RoleBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: pipelinesSCCRoleBinding, Namespace: name},
+			RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: pipelinesSCCClusterRole},
+		})
+	}
+	kubeClient := kubefake.NewSimpleClientset(objects...)
+	nsInformer := kubeinformers.NewSharedInformerFactory(kubeClient, 0).Core().V1().Namespaces()
+	syncNamespaces := func() {
+		t.Helper()
+		namespaces, err := kubeClient.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+		assert.NilError(t, err)
+		for i := range namespaces.Items {
+			assert.NilError(t, nsInformer.Informer().GetIndexer().Update(&namespaces.Items[i]))
+		}
+	}
+	syncNamespaces()
+
+	tc := &v1alpha1.TektonConfig{ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.ConfigResourceName}}
+	tc.Spec.Platforms.OpenShift.SCC = &v1alpha1.SCC{Default: "pipelines-scc"}
+	securityClient := fakesecurity.NewSimpleClientset()
+	_, err := securityClient.SecurityV1().SecurityContextConstraints().Create(ctx,
+		&securityv1.SecurityContextConstraints{ObjectMeta: metav1.ObjectMeta{Name: "restricted-v2"}}, metav1.CreateOptions{})
+	assert.NilError(t, err)
+	r := &rbac{
+		kubeClientSet:     kubeClient,
+		securityClientSet: securityClient,
+		nsInformer:        nsInformer,
+		tektonConfig:      tc,
+	}
+	setParams := func(mode string) {
+		tc.Spec.Params = []v1alpha1.Param{
+			{Name: v1alpha1.SCCAnnotationMigrationParam, Value: mode},
+			{Name: v1alpha1.SCCAnnotationMigrationSCCParam, Value: "restricted-v2"},
+			{Name: v1alpha1.SCCAnnotationMigrationBatchSizeParam, Value: "2"},
+		}
+	}
+	annotations := func(name string) map[string]string {
+		t.Helper()
+		ns, err := kubeClient.CoreV1().Namespaces().Get(ctx, name, metav1.GetOptions{})
+		assert.NilError(t, err)
+		return ns.Annotations
+	}
+
+	// the report lists the namespaces changing SCC without annotating them
+	setParams(sccMigrationReport)
+	assert.NilError(t, r.migrateSCCAnnotations(ctx))
+	assert.DeepEqual(t, tc.Status.SCCMigration, &v1alpha1.SCCAnnotationMigrationStatus{
+		Mode:          sccMigrationReport,
+		SCC:           "restricted-v2",
+		Pending:       3,
+		ChangingCount: 3,
+		Changing: []string{
+			"team-a: pipelines-scc -> restricted-v2",
+			"team-b: pipelines-scc -> restricted-v2",
+			"team-c: pipelines-scc -> restricted-v2",
+		},
+	})
+	assert.Equal(t, len(annotations("team-a")), 0)
+
+	// the namespaces are annotated by batches
+	setParams(sccMigrationApply)
+	assert.NilError(t, r.migrateSCCAnnotations(ctx))
+	assert.Equal(t, tc.Status.SCCMigration.Pending, 1)
+	assert.Equal(t, tc.Status.SCCMigration.Migrated, 2)
+	assert.DeepEqual(t, annotations("team-a"), map[string]string{openshift.NamespaceSCCAnnotation: "restricted-v2", sccMigratedAnnotation: "restricted-v2"})
+	assert.Equal(t, annotations("team-c")[openshift.NamespaceSCCAnnotation], "")
+	syncNamespaces()
+
+	// the next batch waits for the migrated namespaces to be reconciled
+	tc.Status.RBAC = &v1alpha1.RBACStatus{FailedCount: 1, FailedNamespaces: []v1alpha1.NamespaceFailure{{Namespace: "team-b", Reason: "forbidden"}}}
+	assert.ErrorContains(t, r.migrateSCCAnnotations(ctx), "the migration is paused, 1 migrated namespaces failed to be reconciled: [team-b]")
+	assert.Equal(t, tc.Status.SCCMigration.Error, "the migration is paused, 1 migrated namespaces failed to be reconciled: [team-b]")
+	assert.Equal(t, annotations("team-c")[openshift.NamespaceSCCAnnotation], "")
+
+	tc.Status.RBAC = nil
+	assert.NilError(t, r.migrateSCCAnnotations(ctx))
+	assert.Equal(t, tc.Status.SCCMigration.Pending, 0)
+	assert.Equal(t, tc.Status.SCCMigration.Migrated, 3)
+	assert.Equal(t, annotations("team-c")[openshift.NamespaceSCCAnnotation], "restricted-v2")
+	assert.DeepEqual(t, annotations("annotated"), map[string]string{openshift.NamespaceSCCAnnotation: "anyuid"})
+
+	// the rollback keeps the SCC annotations changed since the migration
+	ns, err := kubeClient.CoreV1().Namespaces().Get(ctx, "team-b", metav1.GetOptions{})
+	assert.NilError(t, err)
+	ns.Annotations[openshift.NamespaceSCCAnnotation] = "anyuid"
+	_, err = kubeClient.CoreV1().Namespaces().Update(ctx, ns, metav1.UpdateOptions{})
+	assert.NilError(t, err)
+	syncNamespaces()
+
+	setParams(sccMigrationRollback)
+	assert.NilError(t, r.migrateSCCAnnotations(ctx))
+	assert.Equal(t, tc.Status.SCCMigration.Migrated, 1)
+	assert.Equal(t, len(annotations("team-a")), 0)
+	assert.DeepEqual(t, annotations("team-b"), map[string]string{openshift.NamespaceSCCAnnotation: "anyuid"})
+	syncNamespaces()
+	assert.NilError(t, r.migrateSCCAnnotations(ctx))
+	assert.Equal(t, tc.Status.SCCMigration.Migrated, 0)
+	assert.Equal(t, len(annotations("team-c")), 0)
+
+	// the status is removed without migration
+	tc.Spec.Params = nil
+	assert.NilError(t, r.migrateSCCAnnotations(ctx))
+	assert.Assert(t, tc.Status.SCCMigration == nil)
+}
@@ -0,0 +1,227 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonconfig
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	securityv1 "github.com/openshift/api/security/v1"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/common"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/hash"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/logging"
+)
+
+const (
+	// sccServiceAccountRolePrefix prefixes the Role and RoleBinding granting an SCC to an additional ServiceAccount
+	sccServiceAccountRolePrefix = "pipelines-scc-sa-"
+	// sccServiceAccountLabel marks the Roles and RoleBindings granting an SCC to an additional ServiceAccount,
+	// the value is the name of the ServiceAccount
+	sccServiceAccountLabel = "openshift-pipelines.tekton.dev/scc-service-account"
+	// sccServiceAccountsHashAnnotation holds the hash of the SCCs granted to additional ServiceAccounts in
+	// a namespace, the namespace is reconciled again when the configuration changes
+	sccServiceAccountsHashAnnotation = "openshift-pipelines.tekton.dev/scc-service-accounts-hash"
+)
+
+// sccServiceAccountsInNamespace returns the additional ServiceAccounts which are granted an SCC in the namespace
+func (r *rbac) sccServiceAccountsInNamespace(namespace string) []v1alpha1.SCCServiceAccount {
+	scc := r.tektonConfig.Spec.Platforms.OpenShift.SCC
+	if scc == nil {
+		return nil
+	}
+	serviceAccounts := []v1alpha1.SCCServiceAccount{}
+	for _, sa := range scc.ServiceAccounts {
+		if len(sa.Namespaces) == 0 || slices.Contains(sa.Namespaces, namespace) {
+			serviceAccounts = append(serviceAccounts, sa)
+		}
+	}
+	return serviceAccounts
+}
+
+// sccServiceAccountsHash returns the hash of the SCCs granted to additional ServiceAccounts
+// in the namespace, it is empty if no ServiceAccount is granted an SCC
+func (r *rbac) sccServiceAccountsHash(namespace string) (string, error) {
+	serviceAccounts := r.sccServiceAccountsInNamespace(namespace)
+	if len(serviceAccounts) == 0 {
+		return "", nil
+	}
+	// the namespaces are not part of the hash, they only select the entries
+	grants := make([]v1alpha1.SCCServiceAccount, 0, len(serviceAccounts))
+	for _, sa := range serviceAccounts {
+		grants = append(grants, v1alpha1.SCCServiceAccount{Name: sa.Name, SCC: sa.SCC})
+	}
+	return hash.Compute(grants)
+}
+
+// ensureSCCServiceAccounts grants the configured SCCs to the additional ServiceAccounts in the
+// namespace through a Role and a RoleBinding per ServiceAccount, and removes the grants which
+// are no longer configured
+func (r *rbac) ensureSCCServiceAccounts(ctx context.Context, namespace string) error {
+	logger := logging.FromContext(ctx)
+
+	serviceAccounts := r.sccServiceAccountsInNamespace(namespace)
+	maxAllowedSCC := ""
+	if scc := r.tektonConfig.Spec.Platforms.OpenShift.SCC; scc != nil {
+		maxAllowedSCC = scc.MaxAllowed
+	}
+
+	var prioritizedSCCList []*securityv1.SecurityContextConstraints
+	if len(serviceAccounts) > 0 && maxAllowedSCC != "" {
+		var err error
+		if prioritizedSCCList, err = common.GetSCCRestrictiveList(ctx, r.securityClientSet); err != nil {
+			return err
+		}
+	}
+
+	desired := map[string]bool{}
+	for _, sa := range serviceAccounts {
+		desired[sa.Name] = true
+		if err := common.VerifySCCExists(ctx, sa.SCC, r.securityClientSet); err != nil {
+			return fmt.Errorf("SCC %s requested for ServiceAccount %s: %w", sa.SCC, sa.Name, err)
+		}
+		if maxAllowedSCC != "" {
+			isPriority, err := common.SCCAMoreRestrictiveThanB(prioritizedSCCList, sa.SCC, maxAllowedSCC)
+			if err != nil {
+				return err
+			}
+			if !isPriority {
+				return fmt.Errorf("ServiceAccount: %s has requested SCC: %s, but it is less restrictive than the 'maxAllowed' SCC: %s", sa.Name, sa.SCC, maxAllowedSCC)
+			}
+		}
+		logger.Infof("granting SCC: %s to ServiceAccount: %s in namespace: %s", sa.SCC, sa.Name, namespace)
+		if err := r.ensureSCCServiceAccountRole(ctx, namespace, sa); err != nil {
+			return err
+		}
+		if err := r.ensureSCCServiceAccountRoleBinding(ctx, namespace, sa); err != nil {
+			return err
+		}
+	}
+
+	return r.removeStaleSCCServiceAccounts(ctx, namespace, desired)
+}
+
+func (r *rbac) sccServiceAccountObjectMeta(namespace string, sa v1alpha1.SCCServiceAccount) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:            sccServiceAccountRolePrefix + sa.Name,
+		Namespace:       namespace,
+		Labels:          map[string]string{sccServiceAccountLabel: sa.Name},
+		OwnerReferences: []metav1.OwnerReference{r.ownerRef},
+	}
+}
+
+func (r *rbac) ensureSCCServiceAccountRole(ctx context.Context, namespace string, sa v1alpha1.SCCServiceAccount) error {
+	role := &rbacv1.Role{
+		ObjectMeta: r.sccServiceAccountObjectMeta(namespace, sa),
+		Rules: []rbacv1.PolicyRule{
+			{
+				APIGroups:     []string{"security.openshift.io"},
+				ResourceNames: []string{sa.SCC},
+				Resources:     []string{"securitycontextconstraints"},
+				Verbs:         []string{"use"},
+			},
+		},
+	}
+
+	rbacClient := r.kubeClientSet.RbacV1()
+	if _, err := rbacClient.Roles(namespace).Get(ctx, role.Name, metav1.GetOptions{}); err != nil {
+		if errors.IsNotFound(err) {
+			_, err = rbacClient.Roles(namespace).Create(ctx, role, metav1.CreateOptions{})
+		}
+		return err
+	}
+	_, err := rbacClient.Roles(namespace).Update(ctx, role, metav1.UpdateOptions{})
+	return err
+}
+
+func (r *rbac) ensureSCCServiceAccountRoleBinding(ctx context.Context, namespace string, sa v1alpha1.SCCServiceAccount) error {
+	logger := logging.FromContext(ctx)
+
+	rb := &rbacv1.RoleBinding{
+		ObjectMeta: r.sccServiceAccountObjectMeta(namespace, sa),
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "Role",
+			Name:     sccServiceAccountRolePrefix + sa.Name,
+		},
+		Subjects: []rbacv1.Subject{{Kind: rbacv1.ServiceAccountKind, Name: sa.Name, Namespace: namespace}},
+	}
+
+	rbacClient := r.kubeClientSet.RbacV1()
+	existing, err := rbacClient.RoleBindings(namespace).Get(ctx, rb.Name, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			_, err = rbacClient.RoleBindings(namespace).Create(ctx, rb, metav1.CreateOptions{})
+		}
+		return err
+	}
+
+	// We cannot update RoleRef in a RoleBinding, we need to delete and
+	// recreate the binding in that case
+	if existing.RoleRef != rb.RoleRef {
+		logger.Infof("Need to update RoleRef in RoleBinding %s in namespace: %s, deleting and recreating...", rb.Name, namespace)
+		if err := rbacClient.RoleBindings(namespace).Delete(ctx, rb.Name, metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+		_, err = rbacClient.RoleBindings(namespace).Create(ctx, rb, metav1.CreateOptions{})
+		return err
+	}
+	_, err = rbacClient.RoleBindings(namespace).Update(ctx, rb, metav1.UpdateOptions{})
+	return err
+}
+
+// removeStaleSCCServiceAccounts deletes the Roles and RoleBindings granting an SCC to
+// ServiceAccounts which are no longer configured in the namespace
+func (r *rbac) removeStaleSCCServiceAccounts(ctx context.Context, namespace string, desired map[string]bool) error {
+	logger := logging.FromContext(ctx)
+
+	rbacClient := r.kubeClientSet.RbacV1()
+	listOptions := metav1.ListOptions{LabelSelector: sccServiceAccountLabel}
+
+	rbs, err := rbacClient.RoleBindings(namespace).List(ctx, listOptions)
+	if err != nil {
+		return err
+	}
+	for _, rb := range rbs.Items {
+		if desired[rb.Labels[sccServiceAccountLabel]] {
+			continue
+		}
+		logger.Infof("removing SCC RoleBinding: %s of ServiceAccount: %s in namespace: %s", rb.Name, rb.Labels[sccServiceAccountLabel], namespace)
+		if err := rbacClient.RoleBindings(namespace).Delete(ctx, rb.Name, metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	roles, err := rbacClient.Roles(namespace).List(ctx, listOptions)
+	if err != nil {
+		return err
+	}
+	for _, role := range roles.Items {
+		if desired[role.Labels[sccServiceAccountLabel]] {
+			continue
+		}
+		logger.Infof("removing SCC Role: %s of ServiceAccount: %s in namespace: %s", role.Name, role.Labels[sccServiceAccountLabel], namespace)
+		if err := rbacClient.Roles(namespace).Delete(ctx, role.Name, metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
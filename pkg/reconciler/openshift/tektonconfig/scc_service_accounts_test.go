@@ -0,0 +1,129 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonconfig
+
+import (
+	"context"
+	"testing"
+
+	securityv1 "github.com/openshift/api/security/v1"
+	fakesecurity "github.com/openshift/client-go/security/clientset/versioned/fake"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+)
+
+func sccServiceAccountsRBAC(t *testing.T, serviceAccounts ...v1alpha1.SCCServiceAccount) *rbac {
+	t.Helper()
+	securityClient := fakesecurity.NewSimpleClientset()
+	for _, name := range []string{"anyuid", "privileged"} {
+		scc := &securityv1.SecurityContextConstraints{ObjectMeta: metav1.ObjectMeta{Name: name}}
+		_, err := securityClient.SecurityV1().SecurityContextConstraints().Create(context.TODO(), scc, metav1.CreateOptions{})
+		assert.NilError(t, err)
+	}
+	return &rbac{
+		kubeClientSet:     kubefake.NewSimpleClientset(),
+		securityClientSet: securityClient,
+		tektonConfig: &v1alpha1.TektonConfig{
+			Spec: v1alpha1.TektonConfigSpec{
+				Platforms: v1alpha1.Platforms{
+					OpenShift: v1alpha1.OpenShift{
+						SCC: &v1alpha1.SCC{ServiceAccounts: serviceAccounts},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestEnsureSCCServiceAccounts(t *testing.T) {
+	ctx := context.TODO()
+	r := sccServiceAccountsRBAC(t,
+		v1alpha1.SCCServiceAccount{Name: "buildah-sa", SCC: "anyuid"},
+		v1alpha1.SCCServiceAccount{Name: "dind-sa", SCC: "privileged", Namespaces: []string{"ci"}},
+	)
+	rbacClient := r.kubeClientSet.RbacV1()
+
+	assert.NilError(t, r.ensureSCCServiceAccounts(ctx, "ci"))
+	role, err := rbacClient.Roles("ci").Get(ctx, "pipelines-scc-sa-buildah-sa", metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, role.Rules[0].ResourceNames, []string{"anyuid"})
+	rb, err := rbacClient.RoleBindings("ci").Get(ctx, "pipelines-scc-sa-dind-sa", metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, rb.Subjects, []rbacv1.Subject{{Kind: rbacv1.ServiceAccountKind, Name: "dind-sa", Namespace: "ci"}})
+	assert.Equal(t, rb.RoleRef.Name, "pipelines-scc-sa-dind-sa")
+
+	// only the ServiceAccounts selecting the namespace are granted an SCC
+	assert.NilError(t, r.ensureSCCServiceAccounts(ctx, "dev"))
+	roles, err := rbacClient.Roles("dev").List(ctx, metav1.ListOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, len(roles.Items), 1)
+	assert.Equal(t, roles.Items[0].Name, "pipelines-scc-sa-buildah-sa")
+
+	// grants which are no longer configured are removed
+	r.tektonConfig.Spec.Platforms.OpenShift.SCC.ServiceAccounts = []v1alpha1.SCCServiceAccount{{Name: "buildah-sa", SCC: "privileged"}}
+	assert.NilError(t, r.ensureSCCServiceAccounts(ctx, "ci"))
+	_, err = rbacClient.Roles("ci").Get(ctx, "pipelines-scc-sa-dind-sa", metav1.GetOptions{})
+	assert.Assert(t, errors.IsNotFound(err))
+	_, err = rbacClient.RoleBindings("ci").Get(ctx, "pipelines-scc-sa-dind-sa", metav1.GetOptions{})
+	assert.Assert(t, errors.IsNotFound(err))
+	role, err = rbacClient.Roles("ci").Get(ctx, "pipelines-scc-sa-buildah-sa", metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, role.Rules[0].ResourceNames, []string{"privileged"})
+}
+
+func TestEnsureSCCServiceAccountsMissingSCC(t *testing.T) {
+	r := sccServiceAccountsRBAC(t, v1alpha1.SCCServiceAccount{Name: "buildah-sa", SCC: "missing"})
+	err := r.ensureSCCServiceAccounts(context.TODO(), "ci")
+	assert.ErrorContains(t, err, "SCC missing requested for ServiceAccount buildah-sa")
+}
+
+func TestNeedsRBACForSCCServiceAccounts(t *testing.T) {
+	ctx := context.TODO()
+	r := sccServiceAccountsRBAC(t, v1alpha1.SCCServiceAccount{Name: "buildah-sa", SCC: "anyuid"})
+	r.version = "v1"
+	_, err := r.kubeClientSet.RbacV1().RoleBindings("ci").Create(ctx, &rbacv1.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: pipelinesSCCRoleBinding, Namespace: "ci"},
+		RoleRef:    rbacv1.RoleRef{Kind: "ClusterRole", Name: pipelinesSCCClusterRole},
+	}, metav1.CreateOptions{})
+	assert.NilError(t, err)
+
+	ns := corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+		Name:   "ci",
+		Labels: map[string]string{namespaceVersionLabel: "v1"},
+	}}
+	needed, err := r.needsRBAC(ctx, ns, true)
+	assert.NilError(t, err)
+	assert.Assert(t, needed, "namespace without the hash annotation must be reconciled")
+
+	desiredHash, err := r.sccServiceAccountsHash("ci")
+	assert.NilError(t, err)
+	ns.Annotations = map[string]string{sccServiceAccountsHashAnnotation: desiredHash}
+	needed, err = r.needsRBAC(ctx, ns, true)
+	assert.NilError(t, err)
+	assert.Assert(t, !needed)
+
+	// a configuration change reconciles the namespace again
+	r.tektonConfig.Spec.Platforms.OpenShift.SCC.ServiceAccounts[0].SCC = "privileged"
+	needed, err = r.needsRBAC(ctx, ns, true)
+	assert.NilError(t, err)
+	assert.Assert(t, needed)
+}
@@ -0,0 +1,182 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonconfig
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"knative.dev/pkg/logging"
+)
+
+// subjectsGCInterval is the interval between two garbage collections of the subjects of the
+// deleted namespaces
+const subjectsGCInterval = time.Hour
+
+// subjectsGarbageCollector schedules the removal of the subjects referencing deleted namespaces
+// from the bindings managed by the operator. The clusterinterceptors ClusterRoleBinding is pruned
+// on every reconcile, the other bindings are only pruned once per interval as all the bindings
+// of the cluster are listed.
+type subjectsGarbageCollector struct {
+	now func() time.Time
+
+	mutex   sync.Mutex
+	lastRun time.Time
+}
+
+func newSubjectsGarbageCollector() *subjectsGarbageCollector {
+	return &subjectsGarbageCollector{now: time.Now}
+}
+
+// due returns true when the garbage collection is to be run, and records the run. Without a
+// garbage collector the subjects are not collected.
+func (g *subjectsGarbageCollector) due() bool {
+	if g == nil {
+		return false
+	}
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	now := g.now()
+	if !g.lastRun.IsZero() && now.Sub(g.lastRun) < subjectsGCInterval {
+		return false
+	}
+	g.lastRun = now
+	return true
+}
+
+// retry runs the garbage collection again on the next reconcile, after a failed run
+func (g *subjectsGarbageCollector) retry() {
+	if g == nil {
+		return
+	}
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	g.lastRun = time.Time{}
+}
+
+// isOperatorManaged returns true when the binding is owned by a resource of the operator, e.g.
+// the TektonConfig or an installer set
+func isOperatorManaged(meta metav1.Object) bool {
+	for _, ref := range meta.GetOwnerReferences() {
+		if gv, err := schema.ParseGroupVersion(ref.APIVersion); err == nil && gv.Group == v1alpha1.SchemeGroupVersion.Group {
+			return true
+		}
+	}
+	return false
+}
+
+// staleSubjectNamespace returns the namespace referenced by a ServiceAccount subject, or by the
+// group of the ServiceAccounts of a namespace, and false for the other subjects
+func staleSubjectNamespace(s rbacv1.Subject) (string, bool) {
+	switch s.Kind {
+	case rbacv1.ServiceAccountKind:
+		return s.Namespace, s.Namespace != ""
+	case rbacv1.GroupKind:
+		namespace, found := strings.CutPrefix(s.Name, serviceAccountsGroup+":")
+		return namespace, found && namespace != ""
+	}
+	return "", false
+}
+
+// pruneSubjects returns the subjects without the ones referencing namespaces which do not exist,
+// and true when subjects were removed
+func pruneSubjects(subjects []rbacv1.Subject, namespaces map[string]bool) ([]rbacv1.Subject, bool) {
+	pruned := make([]rbacv1.Subject, 0, len(subjects))
+	for _, s := range subjects {
+		if namespace, ok := staleSubjectNamespace(s); ok && !namespaces[namespace] {
+			continue
+		}
+		pruned = append(pruned, s)
+	}
+	return pruned, len(pruned) != len(subjects)
+}
+
+// collectStaleSubjects removes the subjects referencing deleted namespaces from all the
+// ClusterRoleBindings and RoleBindings managed by the operator, once per interval
+func (r *rbac) collectStaleSubjects(ctx context.Context) error {
+	if !r.subjectsGC.due() {
+		return nil
+	}
+	if err := r.removeStaleSubjects(ctx); err != nil {
+		r.subjectsGC.retry()
+		return err
+	}
+	return nil
+}
+
+func (r *rbac) removeStaleSubjects(ctx context.Context) error {
+	logger := logging.FromContext(ctx)
+	rbacClient := r.kubeClientSet.RbacV1()
+
+	nsList, err := r.kubeClientSet.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	namespaces := make(map[string]bool, len(nsList.Items))
+	for _, ns := range nsList.Items {
+		namespaces[ns.Name] = true
+	}
+
+	crbs, err := rbacClient.ClusterRoleBindings().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	for _, crb := range crbs.Items {
+		if !isOperatorManaged(&crb) {
+			continue
+		}
+		subjects, pruned := pruneSubjects(crb.Subjects, namespaces)
+		if !pruned {
+			continue
+		}
+		logger.Infof("removing %d subjects of deleted namespaces from clusterrolebinding %s", len(crb.Subjects)-len(subjects), crb.Name)
+		crb.Subjects = subjects
+		// a binding changed or deleted since it was listed is pruned on the next run
+		_, err := rbacClient.ClusterRoleBindings().Update(ctx, &crb, metav1.UpdateOptions{})
+		if err != nil && !errors.IsNotFound(err) && !errors.IsConflict(err) {
+			return err
+		}
+	}
+
+	rbs, err := rbacClient.RoleBindings(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	for _, rb := range rbs.Items {
+		if !isOperatorManaged(&rb) || !namespaces[rb.Namespace] {
+			continue
+		}
+		subjects, pruned := pruneSubjects(rb.Subjects, namespaces)
+		if !pruned {
+			continue
+		}
+		logger.Infof("removing %d subjects of deleted namespaces from rolebinding %s/%s", len(rb.Subjects)-len(subjects), rb.Namespace, rb.Name)
+		rb.Subjects = subjects
+		_, err := rbacClient.RoleBindings(rb.Namespace).Update(ctx, &rb, metav1.UpdateOptions{})
+		if err != nil && !errors.IsNotFound(err) && !errors.IsConflict(err) {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonconfig
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestCollectStaleSubjects(t *testing.T) {
+	ctx := context.TODO()
+	owner := []metav1.OwnerReference{{APIVersion: "operator.tekton.dev/v1alpha1", Kind: "TektonInstallerSet", Name: "rbac-resources"}}
+	live := rbacv1.Subject{Kind: rbacv1.ServiceAccountKind, Name: pipelineSA, Namespace: "team-a"}
+	deleted := rbacv1.Subject{Kind: rbacv1.ServiceAccountKind, Name: pipelineSA, Namespace: "team-b"}
+	deletedGroup := rbacv1.Subject{APIGroup: rbacv1.GroupName, Kind: rbacv1.GroupKind, Name: "system:serviceaccounts:team-b"}
+	otherGroup := rbacv1.Subject{APIGroup: rbacv1.GroupName, Kind: rbacv1.GroupKind, Name: "system:authenticated"}
+	user := rbacv1.Subject{APIGroup: rbacv1.GroupName, Kind: rbacv1.UserKind, Name: "alice"}
+
+	kubeClient := kubefake.NewSimpleClientset(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a"}},
+		&rbacv1.ClusterRoleBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: clusterInterceptors, OwnerReferences: owner},
+			Subjects:   []rbacv1.Subject{live, deleted, deletedGroup, otherGroup},
+		},
+		&rbacv1.ClusterRoleBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "not-managed"},
+			Subjects:   []rbacv1.Subject{live, deleted},
+		},
+		&rbacv1.RoleBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: PipelineRoleBinding, Namespace: "team-a", OwnerReferences: owner},
+			Subjects:   []rbacv1.Subject{live, deleted, user},
+		},
+	)
+	now := time.Date(2026, 10, 14, 10, 0, 0, 0, time.UTC)
+	gc := newSubjectsGarbageCollector()
+	gc.now = func() time.Time { return now }
+	r := &rbac{kubeClientSet: kubeClient, subjectsGC: gc}
+
+	assert.NilError(t, r.collectStaleSubjects(ctx))
+	crb, err := kubeClient.RbacV1().ClusterRoleBindings().Get(ctx, clusterInterceptors, metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, crb.Subjects, []rbacv1.Subject{live, otherGroup})
+	crb, err = kubeClient.RbacV1().ClusterRoleBindings().Get(ctx, "not-managed", metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, crb.Subjects, []rbacv1.Subject{live, deleted})
+	rb, err := kubeClient.RbacV1().RoleBindings("team-a").Get(ctx, PipelineRoleBinding, metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, rb.Subjects, []rbacv1.Subject{live, user})
+
+	// the bindings are only collected once per interval
+	rb.Subjects = append(rb.Subjects, deleted)
+	_, err = kubeClient.RbacV1().RoleBindings("team-a").Update(ctx, rb, metav1.UpdateOptions{})
+	assert.NilError(t, err)
+	now = now.Add(subjectsGCInterval / 2)
+	assert.NilError(t, r.collectStaleSubjects(ctx))
+	rb, err = kubeClient.RbacV1().RoleBindings("team-a").Get(ctx, PipelineRoleBinding, metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, len(rb.Subjects), 3)
+
+	now = now.Add(subjectsGCInterval)
+	assert.NilError(t, r.collectStaleSubjects(ctx))
+	rb, err = kubeClient.RbacV1().RoleBindings("team-a").Get(ctx, PipelineRoleBinding, metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, rb.Subjects, []rbacv1.Subject{live, user})
+}
@@ -0,0 +1,52 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonconfig
+
+import (
+	"context"
+
+	"github.com/tektoncd/operator/pkg/reconciler/shared/permissions"
+	"knative.dev/pkg/logging"
+)
+
+// subsystems are the subsystems of the OpenShift reconciler disabled when the operator is not
+// granted their permissions
+var subsystems = []permissions.Subsystem{
+	permissions.SubsystemSCC,
+	permissions.SubsystemClusterRoles,
+	permissions.SubsystemNamespacePatching,
+	permissions.SubsystemAccessReview,
+}
+
+// subsystemEnabled returns true when the operator is granted the permissions of the subsystem
+func (r *rbac) subsystemEnabled(ctx context.Context, s permissions.Subsystem) bool {
+	if r.capabilities.Enabled(s) {
+		return true
+	}
+	logging.FromContext(ctx).Debugf("%s is disabled, the operator is not granted its permissions", s)
+	return false
+}
+
+// markSubsystems reports the disabled subsystems and their missing permissions in the
+// SubsystemsEnabled condition of TektonConfig
+func (r *rbac) markSubsystems() {
+	if report := r.capabilities.Report(); report != "" {
+		r.tektonConfig.Status.MarkSubsystemsDisabled(report)
+		return
+	}
+	r.tektonConfig.Status.MarkSubsystemsEnabled()
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonconfig
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/permissions"
+	"gotest.tools/v3/assert"
+	is "gotest.tools/v3/assert/cmp"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestProcessRBACWithoutSCCManagement(t *testing.T) {
+	ctx := context.TODO()
+	ns := corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team"}}
+	kubeClient := kubefake.NewSimpleClientset(&ns, &rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "edit"}})
+	kubeClient.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview)
+		review.Status.Allowed = review.Spec.ResourceAttributes.Resource != "securitycontextconstraints"
+		return true, review, nil
+	})
+	r := &rbac{
+		kubeClientSet: kubeClient,
+		tektonConfig:  &v1alpha1.TektonConfig{},
+		capabilities:  permissions.NewCapabilityProbe(kubeClient, subsystems...).Capabilities(ctx),
+	}
+
+	// the ServiceAccount and the edit RoleBinding are created without the SCC RoleBinding, no SCC
+	// client is needed
+	nsSA, _, err := r.processRBAC(ctx, ns)
+	assert.NilError(t, err)
+	assert.Equal(t, nsSA.ServiceAccount.Name, pipelineSA)
+	_, err = kubeClient.RbacV1().RoleBindings("team").Get(ctx, PipelineRoleBinding, metav1.GetOptions{})
+	assert.NilError(t, err)
+	_, err = kubeClient.RbacV1().RoleBindings("team").Get(ctx, pipelinesSCCRoleBinding, metav1.GetOptions{})
+	assert.Assert(t, errors.IsNotFound(err))
+
+	r.markSubsystems()
+	condition := r.tektonConfig.Status.GetCondition(v1alpha1.SubsystemsEnabled)
+	assert.Assert(t, condition.IsFalse())
+	assert.Equal(t, condition.Reason, "SubsystemsDisabled")
+	assert.Assert(t, is.Contains(condition.Message, "SCCManagement is disabled, the operator is not allowed to get security.openshift.io/securitycontextconstraints"))
+
+	// all the subsystems are enabled when the capabilities are not known
+	r.capabilities = nil
+	r.markSubsystems()
+	assert.Assert(t, r.tektonConfig.Status.GetCondition(v1alpha1.SubsystemsEnabled).IsTrue())
+}
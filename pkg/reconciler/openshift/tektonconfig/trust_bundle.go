@@ -0,0 +1,235 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonconfig
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	reconcilerCommon "github.com/tektoncd/operator/pkg/reconciler/common"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/hash"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubeinformers "k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/logging"
+)
+
+const (
+	// TrustedCABundleRotatedReason is the reason of the event recorded on TektonConfig when the
+	// workloads are restarted with the rotated trusted CA certificates of the cluster
+	TrustedCABundleRotatedReason = "TrustedCABundleRotated"
+	// trustBundlePropagationDelay is the delay after a rotation of the trusted CA certificates of
+	// the cluster after which TektonConfig is reconciled again, the platform injects them in the
+	// CA bundle ConfigMaps of the namespaces in the meantime
+	trustBundlePropagationDelay = 30 * time.Second
+)
+
+// watchClusterTrustBundle watches the trusted CA certificates of the cluster, rendered by the
+// platform from the trustedCA of the cluster Proxy. When they rotate, all the namespaces are
+// evaluated by the next reconciles and TektonConfig is enqueued, then enqueued again once the
+// certificates are expected to be injected in the namespaces.
+func watchClusterTrustBundle(ctx context.Context, kubeClient kubernetes.Interface, tracker *namespaceTracker, enqueue func(delay time.Duration)) {
+	logger := logging.FromContext(ctx)
+	factory := kubeinformers.NewSharedInformerFactoryWithOptions(kubeClient, controller.GetResyncPeriod(ctx),
+		kubeinformers.WithNamespace(platformTrustedCANamespace),
+		kubeinformers.WithTweakListOptions(func(o *metav1.ListOptions) {
+			o.FieldSelector = "metadata.name=" + platformTrustedCAConfigMap
+		}))
+	if _, err := factory.Core().V1().ConfigMaps().Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			if !trustBundleRotated(oldObj, newObj) {
+				return
+			}
+			logger.Infof("the trusted CA certificates of configmap %s/%s rotated", platformTrustedCANamespace, platformTrustedCAConfigMap)
+			tracker.resync()
+			enqueue(0)
+			enqueue(trustBundlePropagationDelay)
+		},
+	}); err != nil {
+		logger.Panicf("Couldn't register %s configmap informer event handler: %w", platformTrustedCAConfigMap, err)
+	}
+	factory.Start(ctx.Done())
+}
+
+// trustBundleRotated returns true when the certificates of the trusted CA ConfigMap changed
+func trustBundleRotated(oldObj, newObj interface{}) bool {
+	oldCM, ok := oldObj.(*corev1.ConfigMap)
+	if !ok {
+		return false
+	}
+	newCM, ok := newObj.(*corev1.ConfigMap)
+	if !ok {
+		return false
+	}
+	return oldCM.Data[reconcilerCommon.TrustedCAKey] != newCM.Data[reconcilerCommon.TrustedCAKey]
+}
+
+// trustBundleRotation restarts the workloads of the target namespace mounting the trusted CA
+// certificates of the cluster when they rotate. The certificates are mounted with a subPath which
+// is not updated in the running pods, and the workloads load them on startup.
+type trustBundleRotation struct {
+	kubeClientSet kubernetes.Interface
+	now           func() time.Time
+}
+
+func newTrustBundleRotation(kubeClientSet kubernetes.Interface) *trustBundleRotation {
+	return &trustBundleRotation{kubeClientSet: kubeClientSet, now: time.Now}
+}
+
+// reconcile compares the trusted CA certificates of the cluster with the certificates recorded in
+// the status. When they changed, and once the platform injected them in the CA bundle ConfigMap of
+// the target namespace, the pods of the workloads mounting the ConfigMap are deleted and the
+// rotation is recorded in the status along with an event on TektonConfig. The first certificates
+// observed are recorded without restarting the workloads.
+func (t *trustBundleRotation) reconcile(ctx context.Context, tc *v1alpha1.TektonConfig) error {
+	logger := logging.FromContext(ctx)
+	platform, err := t.kubeClientSet.CoreV1().ConfigMaps(platformTrustedCANamespace).Get(ctx, platformTrustedCAConfigMap, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get the trusted CA configmap %s/%s: %w", platformTrustedCANamespace, platformTrustedCAConfigMap, err)
+	}
+	bundle := platform.Data[reconcilerCommon.TrustedCAKey]
+	bundleHash, err := hash.Compute(bundle)
+	if err != nil {
+		return err
+	}
+	previous := tc.Status.TrustedCABundle
+	if previous == nil {
+		tc.Status.TrustedCABundle = &v1alpha1.TrustedCABundleStatus{Hash: bundleHash}
+		return nil
+	}
+	if previous.Hash == bundleHash {
+		return nil
+	}
+
+	namespace := tc.Spec.TargetNamespace
+	injected, err := t.injected(ctx, namespace, bundle)
+	if err != nil {
+		return err
+	}
+	if !injected {
+		// the workloads would load the previous certificates, they are restarted by a next reconcile
+		logger.Infof("waiting for the rotated trusted CA certificates in configmap %s/%s", namespace, reconcilerCommon.TrustedCAConfigMapName)
+		return nil
+	}
+	workloads, err := t.restartWorkloads(ctx, namespace)
+	if err != nil {
+		return err
+	}
+	now := metav1.NewTime(t.now())
+	tc.Status.TrustedCABundle = &v1alpha1.TrustedCABundleStatus{
+		Hash:               bundleHash,
+		LastRotationTime:   &now,
+		RestartedWorkloads: workloads,
+	}
+
+	message := "the trusted CA certificates of the cluster rotated"
+	if len(workloads) > 0 {
+		message += fmt.Sprintf(": restarted %s in namespace %s", strings.Join(workloads, ", "), namespace)
+	}
+	logger.Info(message)
+	recorder := controller.GetEventRecorder(ctx)
+	if recorder == nil {
+		logger.Debug("no event recorder in the context to report the rotation of the trusted CA certificates")
+		return nil
+	}
+	recorder.Event(tc, corev1.EventTypeNormal, TrustedCABundleRotatedReason, message)
+	return nil
+}
+
+// injected returns true when the CA bundle ConfigMap of the namespace holds the trusted CA
+// certificates of the cluster, followed by the certificates appended by the operator if any. A
+// missing ConfigMap is not waited for, the workloads mounting it cannot start.
+func (t *trustBundleRotation) injected(ctx context.Context, namespace, bundle string) (bool, error) {
+	cm, err := t.kubeClientSet.CoreV1().ConfigMaps(namespace).Get(ctx, reconcilerCommon.TrustedCAConfigMapName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return strings.HasPrefix(cm.Data[reconcilerCommon.TrustedCAKey], bundle), nil
+}
+
+// restartWorkloads deletes the pods of the Deployments and StatefulSets of the namespace mounting
+// the CA bundle ConfigMap, the pod templates are not changed as the installer sets would revert
+// them. It returns the restarted workloads as Kind/name, in the order of their names.
+func (t *trustBundleRotation) restartWorkloads(ctx context.Context, namespace string) ([]string, error) {
+	selectors := map[string]*metav1.LabelSelector{}
+	deployments, err := t.kubeClientSet.AppsV1().Deployments(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range deployments.Items {
+		if mountsTrustedCABundle(d.Spec.Template.Spec) {
+			selectors["Deployment/"+d.Name] = d.Spec.Selector
+		}
+	}
+	statefulSets, err := t.kubeClientSet.AppsV1().StatefulSets(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range statefulSets.Items {
+		if mountsTrustedCABundle(s.Spec.Template.Spec) {
+			selectors["StatefulSet/"+s.Name] = s.Spec.Selector
+		}
+	}
+
+	workloads := make([]string, 0, len(selectors))
+	for workload := range selectors {
+		workloads = append(workloads, workload)
+	}
+	sort.Strings(workloads)
+	logger := logging.FromContext(ctx)
+	for _, workload := range workloads {
+		selector, err := metav1.LabelSelectorAsSelector(selectors[workload])
+		if err != nil {
+			return nil, err
+		}
+		pods, err := t.kubeClientSet.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+		if err != nil {
+			return nil, err
+		}
+		logger.Infof("restarting %s in namespace %s, deleting %d pods", workload, namespace, len(pods.Items))
+		for _, pod := range pods.Items {
+			if err := t.kubeClientSet.CoreV1().Pods(namespace).Delete(ctx, pod.Name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+				return nil, err
+			}
+		}
+	}
+	return workloads, nil
+}
+
+// mountsTrustedCABundle returns true when the pods mount the CA bundle ConfigMap
+func mountsTrustedCABundle(spec corev1.PodSpec) bool {
+	for _, v := range spec.Volumes {
+		if v.ConfigMap != nil && v.ConfigMap.Name == reconcilerCommon.TrustedCAConfigMapName {
+			return true
+		}
+	}
+	return false
+}
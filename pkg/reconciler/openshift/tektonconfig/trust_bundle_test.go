@@ -0,0 +1,146 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonconfig
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	reconcilerCommon "github.com/tektoncd/operator/pkg/reconciler/common"
+	"gotest.tools/v3/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/record"
+	"knative.dev/pkg/controller"
+)
+
+const targetNamespace = "openshift-pipelines"
+
+func trustedCAConfigMap(namespace, name, bundle string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Data:       map[string]string{reconcilerCommon.TrustedCAKey: bundle},
+	}
+}
+
+func deploymentWithPod(name string, volumes ...corev1.Volume) (*appsv1.Deployment, *corev1.Pod) {
+	labels := map[string]string{"app": name}
+	d := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: targetNamespace},
+		Spec: appsv1.DeploymentSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: labels},
+			Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{Volumes: volumes}},
+		},
+	}
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name + "-pod", Namespace: targetNamespace, Labels: labels}}
+	return d, pod
+}
+
+func TestTrustBundleRotation(t *testing.T) {
+	trustedCAVolume := reconcilerCommon.NewVolumeWithConfigMap(reconcilerCommon.TrustedCAConfigMapVolume,
+		reconcilerCommon.TrustedCAConfigMapName, reconcilerCommon.TrustedCAKey, reconcilerCommon.TrustedCAKey)
+	controllerDeployment, controllerPod := deploymentWithPod("tekton-pipelines-controller", trustedCAVolume)
+	webhookDeployment, webhookPod := deploymentWithPod("tekton-pipelines-webhook")
+	client := fake.NewSimpleClientset(
+		trustedCAConfigMap(platformTrustedCANamespace, platformTrustedCAConfigMap, "old"),
+		trustedCAConfigMap(targetNamespace, reconcilerCommon.TrustedCAConfigMapName, "old"),
+		controllerDeployment, controllerPod, webhookDeployment, webhookPod,
+	)
+	recorder := record.NewFakeRecorder(10)
+	ctx := controller.WithEventRecorder(context.TODO(), recorder)
+	rotation := newTrustBundleRotation(client)
+	now := time.Date(2026, 10, 15, 8, 0, 0, 0, time.UTC)
+	rotation.now = func() time.Time { return now }
+	tc := &v1alpha1.TektonConfig{}
+	tc.Spec.TargetNamespace = targetNamespace
+
+	// the first certificates are recorded without restarting the workloads
+	assert.NilError(t, rotation.reconcile(ctx, tc))
+	assert.Assert(t, tc.Status.TrustedCABundle != nil)
+	initial := tc.Status.TrustedCABundle.Hash
+	assert.Assert(t, initial != "")
+	assert.Assert(t, tc.Status.TrustedCABundle.LastRotationTime == nil)
+
+	// the workloads are not restarted before the rotated certificates are injected
+	_, err := client.CoreV1().ConfigMaps(platformTrustedCANamespace).Update(ctx,
+		trustedCAConfigMap(platformTrustedCANamespace, platformTrustedCAConfigMap, "new"), metav1.UpdateOptions{})
+	assert.NilError(t, err)
+	assert.NilError(t, rotation.reconcile(ctx, tc))
+	assert.Equal(t, tc.Status.TrustedCABundle.Hash, initial)
+	pods, err := client.CoreV1().Pods(targetNamespace).List(ctx, metav1.ListOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, len(pods.Items), 2)
+
+	// the workloads mounting the CA bundle are restarted once the certificates are injected,
+	// followed by the certificates appended by the operator
+	_, err = client.CoreV1().ConfigMaps(targetNamespace).Update(ctx,
+		trustedCAConfigMap(targetNamespace, reconcilerCommon.TrustedCAConfigMapName, "new\nadditional\n"), metav1.UpdateOptions{})
+	assert.NilError(t, err)
+	assert.NilError(t, rotation.reconcile(ctx, tc))
+	assert.Assert(t, tc.Status.TrustedCABundle.Hash != initial)
+	assert.DeepEqual(t, tc.Status.TrustedCABundle.LastRotationTime.Time, now)
+	assert.DeepEqual(t, tc.Status.TrustedCABundle.RestartedWorkloads, []string{"Deployment/tekton-pipelines-controller"})
+	pods, err = client.CoreV1().Pods(targetNamespace).List(ctx, metav1.ListOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, len(pods.Items), 1)
+	assert.Equal(t, pods.Items[0].Name, webhookPod.Name)
+	assert.Equal(t, <-recorder.Events, "Normal TrustedCABundleRotated the trusted CA certificates of the cluster rotated: "+
+		"restarted Deployment/tekton-pipelines-controller in namespace openshift-pipelines")
+
+	// the workloads are restarted once per rotation
+	assert.NilError(t, client.CoreV1().Pods(targetNamespace).Delete(ctx, webhookPod.Name, metav1.DeleteOptions{}))
+	_, err = client.CoreV1().Pods(targetNamespace).Create(ctx, controllerPod, metav1.CreateOptions{})
+	assert.NilError(t, err)
+	assert.NilError(t, rotation.reconcile(ctx, tc))
+	pods, err = client.CoreV1().Pods(targetNamespace).List(ctx, metav1.ListOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, len(pods.Items), 1)
+	assert.Equal(t, len(recorder.Events), 0)
+}
+
+func TestTrustBundleRotationWithoutPlatformBundle(t *testing.T) {
+	rotation := newTrustBundleRotation(fake.NewSimpleClientset())
+	tc := &v1alpha1.TektonConfig{}
+	assert.NilError(t, rotation.reconcile(context.TODO(), tc))
+	assert.Assert(t, tc.Status.TrustedCABundle == nil)
+}
+
+func TestTrustBundleRotated(t *testing.T) {
+	old := trustedCAConfigMap(platformTrustedCANamespace, platformTrustedCAConfigMap, "old")
+	relabeled := old.DeepCopy()
+	relabeled.Labels = map[string]string{"key": "value"}
+	assert.Assert(t, !trustBundleRotated(old, relabeled))
+	assert.Assert(t, trustBundleRotated(old, trustedCAConfigMap(platformTrustedCANamespace, platformTrustedCAConfigMap, "new")))
+	assert.Assert(t, !trustBundleRotated(old, "not a configmap"))
+}
+
+func TestNamespaceTrackerResync(t *testing.T) {
+	tracker := newNamespaceTracker()
+	tc := &v1alpha1.TektonConfig{}
+	all, _ := tracker.next(tc)
+	assert.Assert(t, all)
+	all, _ = tracker.next(tc)
+	assert.Assert(t, !all)
+
+	tracker.resync()
+	all, _ = tracker.next(tc)
+	assert.Assert(t, all)
+}
@@ -183,6 +183,7 @@ func filterAndTransformMonitoring(comp v1alpha1.TektonComponent) client.FilterAn
 		// This fixes hardcoded namespace in openshift-monitoring ServiceMonitors
 		tfs := []mf.Transformer{
 			occommon.UpdateServiceMonitorTargetNamespace(comp.GetSpec().GetTargetNamespace()),
+			occommon.UpdateServiceMonitorMetricsTLS(comp.GetSpec().GetTargetNamespace(), comp.(*v1alpha1.TektonPipeline).Spec.Config.MetricsTLS),
 		}
 		if err := common.Transform(ctx, manifest, comp, tfs...); err != nil {
 			return nil, err
@@ -0,0 +1,108 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alerting
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"strconv"
+	"strings"
+
+	mf "github.com/manifestival/manifestival"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/discovery"
+	"knative.dev/pkg/logging"
+)
+
+const (
+	// ConfigKey of the controllers ConfigMap enables the alerting rules of the operator
+	ConfigKey = "alerting-rules"
+
+	prometheusRuleGroupVersion = "monitoring.coreos.com/v1"
+	prometheusRuleKind         = "PrometheusRule"
+)
+
+// rules alert on the metrics recorded by the reconcilers of the operator
+//
+//go:embed alerting-rules.yaml
+var rules string
+
+// EnabledFromMap reads whether the alerting rules are enabled from the data of the
+// controllers ConfigMap, they are disabled by default
+func EnabledFromMap(data map[string]string) (bool, error) {
+	value, ok := data[ConfigKey]
+	if !ok {
+		return false, nil
+	}
+	enabled, err := strconv.ParseBool(strings.TrimSpace(value))
+	if err != nil {
+		return false, fmt.Errorf("invalid value %q for %s, must be true or false", value, ConfigKey)
+	}
+	return enabled, nil
+}
+
+// Manifest returns the alerting rules in the namespace
+func Manifest(client mf.Client, namespace string) (mf.Manifest, error) {
+	manifest, err := mf.ManifestFrom(mf.Reader(strings.NewReader(rules)), mf.UseClient(client))
+	if err != nil {
+		return mf.Manifest{}, err
+	}
+	return manifest.Transform(mf.InjectNamespace(namespace))
+}
+
+// Reconcile creates or updates the PrometheusRule of the operator in the namespace when the
+// alerting rules are enabled, and deletes it when they are disabled. An error is returned
+// when the rules are enabled on a cluster without the Prometheus operator.
+func Reconcile(ctx context.Context, client mf.Client, discoveryClient discovery.DiscoveryInterface, namespace string, enabled bool) error {
+	logger := logging.FromContext(ctx)
+	served, err := prometheusRuleServed(discoveryClient)
+	if err != nil {
+		return err
+	}
+	if !served {
+		if enabled {
+			return fmt.Errorf("the alerting rules are enabled but %s %s is not served by the cluster", prometheusRuleGroupVersion, prometheusRuleKind)
+		}
+		return nil
+	}
+	manifest, err := Manifest(client, namespace)
+	if err != nil {
+		return err
+	}
+	if !enabled {
+		return manifest.Delete(mf.IgnoreNotFound(true))
+	}
+	logger.Infof("applying the alerting rules of the operator in namespace %s", namespace)
+	return manifest.Apply()
+}
+
+func prometheusRuleServed(discoveryClient discovery.DiscoveryInterface) (bool, error) {
+	resources, err := discoveryClient.ServerResourcesForGroupVersion(prometheusRuleGroupVersion)
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	for _, r := range resources.APIResources {
+		if r.Kind == prometheusRuleKind {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alerting
+
+import (
+	"context"
+	"testing"
+
+	mffake "github.com/manifestival/manifestival/fake"
+	"gotest.tools/v3/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestEnabledFromMap(t *testing.T) {
+	enabled, err := EnabledFromMap(map[string]string{})
+	assert.NilError(t, err)
+	assert.Assert(t, !enabled)
+
+	enabled, err = EnabledFromMap(map[string]string{ConfigKey: "true"})
+	assert.NilError(t, err)
+	assert.Assert(t, enabled)
+
+	_, err = EnabledFromMap(map[string]string{ConfigKey: "yes please"})
+	assert.ErrorContains(t, err, "invalid value")
+}
+
+func TestManifest(t *testing.T) {
+	manifest, err := Manifest(mffake.New(), "tekton-operator")
+	assert.NilError(t, err)
+	assert.Equal(t, len(manifest.Resources()), 1)
+	rule := manifest.Resources()[0]
+	assert.Equal(t, rule.GetKind(), prometheusRuleKind)
+	assert.Equal(t, rule.GetNamespace(), "tekton-operator")
+}
+
+func TestReconcile(t *testing.T) {
+	ctx := context.TODO()
+	discoveryClient := fake.NewSimpleClientset().Discovery().(*fakediscovery.FakeDiscovery)
+	client := mffake.New()
+
+	// without the Prometheus operator the rules can not be applied
+	assert.ErrorContains(t, Reconcile(ctx, client, discoveryClient, "tekton-operator", true), "is not served")
+	assert.NilError(t, Reconcile(ctx, client, discoveryClient, "tekton-operator", false))
+
+	discoveryClient.Resources = []*metav1.APIResourceList{{
+		GroupVersion: prometheusRuleGroupVersion,
+		APIResources: []metav1.APIResource{{Name: "prometheusrules", Kind: prometheusRuleKind, Namespaced: true}},
+	}}
+	manifest, err := Manifest(client, "tekton-operator")
+	assert.NilError(t, err)
+	rule := manifest.Resources()[0]
+
+	assert.NilError(t, Reconcile(ctx, client, discoveryClient, "tekton-operator", true))
+	_, err = client.Get(&rule)
+	assert.NilError(t, err)
+
+	assert.NilError(t, Reconcile(ctx, client, discoveryClient, "tekton-operator", false))
+	_, err = client.Get(&rule)
+	assert.Assert(t, apierrors.IsNotFound(err))
+}
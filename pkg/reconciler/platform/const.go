@@ -30,6 +30,10 @@ const (
 	ControllerTektonScheduler      ControllerName = "tektonscheduler"
 	ControllerMulticlusterProxyAAE ControllerName = "tektonmulticlusterproxyaae"
 	ControllerSyncerService        ControllerName = "syncerservice"
+	ControllerComponentAction      ControllerName = "componentaction"
+	ControllerGitSource            ControllerName = "gitsource"
+	ControllerSecretRotation       ControllerName = "secretrotation"
+	ControllerWatchdog             ControllerName = "watchdog"
 	EnvControllerNames             string         = "CONTROLLER_NAMES"
 	EnvSharedMainName              string         = "UNIQUE_PROCESS_NAME"
 )
@@ -0,0 +1,112 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package platform
+
+import (
+	"context"
+	"os"
+
+	"github.com/tektoncd/operator/pkg/reconciler/common"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/handover"
+	kubeclient "knative.dev/pkg/client/injection/kube/client"
+	"knative.dev/pkg/configmap"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/injection"
+	"knative.dev/pkg/logging"
+	pkgreconciler "knative.dev/pkg/reconciler"
+	"knative.dev/pkg/system"
+)
+
+// fencedReconciler does not reconcile while a newer version of the operator holds the handover
+// Lease, the keys are requeued until the Lease expires in case the upgrade is rolled back
+type fencedReconciler struct {
+	controller.Reconciler
+	name     string
+	handover *handover.Handover
+}
+
+// leaderAwareFencedReconciler keeps the leader election of the reconcilers which take part in it
+type leaderAwareFencedReconciler struct {
+	*fencedReconciler
+	pkgreconciler.LeaderAware
+}
+
+func (r *fencedReconciler) Reconcile(ctx context.Context, key string) error {
+	if version, fenced := r.handover.Fenced(); fenced {
+		logging.FromContext(ctx).Debugf("reconciler %s is fenced by the operator %s, requeuing %s", r.name, version, key)
+		return controller.NewRequeueAfter(handover.LeaseDuration)
+	}
+	return r.Reconciler.Reconcile(ctx, key)
+}
+
+// fence wraps the reconciler of the controller to stop it while it is fenced
+func fence(name string, impl *controller.Impl, h *handover.Handover) {
+	r := &fencedReconciler{Reconciler: impl.Reconciler, name: name, handover: h}
+	if la, ok := impl.Reconciler.(pkgreconciler.LeaderAware); ok {
+		impl.Reconciler = &leaderAwareFencedReconciler{fencedReconciler: r, LeaderAware: la}
+		return
+	}
+	impl.Reconciler = r
+}
+
+// withHandover wraps the constructors of the controllers to fence their reconcilers during the
+// upgrades to a newer version of the operator
+func (cm ControllerMap) withHandover(h *handover.Handover) ControllerMap {
+	if h == nil {
+		return cm
+	}
+	result := ControllerMap{}
+	for name, namedCtrl := range cm {
+		if namedCtrl.ControllerConstructor == nil {
+			result[name] = namedCtrl
+			continue
+		}
+		constructor := namedCtrl.ControllerConstructor
+		result[name] = injection.NamedControllerConstructor{
+			Name: namedCtrl.Name,
+			ControllerConstructor: func(ctx context.Context, cmw configmap.Watcher) *controller.Impl {
+				impl := constructor(ctx, cmw)
+				fence(string(name), impl, h)
+				return impl
+			},
+		}
+	}
+	return result
+}
+
+// startHandover starts the handover of the replica, it returns nil when the version of the
+// operator is unknown or the handover Lease cannot be watched, the replica is then never fenced
+func startHandover(ctx context.Context) *handover.Handover {
+	logger := logging.FromContext(ctx)
+	version, err := common.OperatorVersion(ctx)
+	if err != nil {
+		logger.Warnw("the operator replicas are not fenced during the upgrades", "error", err)
+		return nil
+	}
+	// the replicas are identified by their pod name, as for the leader election
+	identity, err := os.Hostname()
+	if err != nil {
+		logger.Warnw("the operator replicas are not fenced during the upgrades", "error", err)
+		return nil
+	}
+	h := handover.New(kubeclient.Get(ctx), system.Namespace(), identity, version)
+	if err := h.Start(ctx); err != nil {
+		logger.Warnw("the operator replicas are not fenced during the upgrades", "error", err)
+		return nil
+	}
+	return h
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package platform
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/tektoncd/operator/pkg/reconciler/shared/handover"
+	"go.uber.org/zap"
+	"gotest.tools/v3/assert"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"knative.dev/pkg/configmap"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/injection"
+	pkgreconciler "knative.dev/pkg/reconciler"
+)
+
+func startedHandover(t *testing.T, leaseVersion string) *handover.Handover {
+	t.Helper()
+	renewed := metav1.NewMicroTime(time.Now())
+	client := fake.NewSimpleClientset(&coordinationv1.Lease{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        handover.LeaseName,
+			Namespace:   "tekton-operator",
+			Annotations: map[string]string{handover.VersionAnnotation: leaseVersion},
+		},
+		Spec: coordinationv1.LeaseSpec{RenewTime: &renewed},
+	})
+	ctx, cancel := context.WithCancel(context.TODO())
+	t.Cleanup(cancel)
+	h := handover.New(client, "tekton-operator", "operator", "0.76.0")
+	assert.NilError(t, h.Start(ctx))
+	return h
+}
+
+func TestFencedReconciler(t *testing.T) {
+	failed := errors.New("failed")
+	newImpl := func() *controller.Impl {
+		return controller.NewContext(context.TODO(), &fakeReconciler{errs: []error{failed}}, controller.ControllerOptions{WorkQueueName: "test", Logger: zap.NewNop().Sugar()})
+	}
+
+	// the keys are requeued while a newer version holds the handover Lease
+	impl := newImpl()
+	fence("tektonconfig", impl, startedHandover(t, "0.77.0"))
+	requeued, delay := controller.IsRequeueKey(impl.Reconciler.Reconcile(context.TODO(), "config"))
+	assert.Assert(t, requeued)
+	assert.Equal(t, delay, handover.LeaseDuration)
+
+	// the reconcilers are not fenced by the same version
+	impl = newImpl()
+	fence("tektonconfig", impl, startedHandover(t, "0.76.0"))
+	assert.Equal(t, impl.Reconciler.Reconcile(context.TODO(), "config"), failed)
+}
+
+func TestFenceKeepsLeaderElection(t *testing.T) {
+	impl := controller.NewContext(context.TODO(), &fakeLeaderAwareReconciler{}, controller.ControllerOptions{WorkQueueName: "test", Logger: zap.NewNop().Sugar()})
+	fence("tektonpipeline", impl, nil)
+	_, ok := impl.Reconciler.(pkgreconciler.LeaderAware)
+	assert.Assert(t, ok)
+}
+
+func TestControllerMapWithHandover(t *testing.T) {
+	ctrls := ControllerMap{
+		ControllerTektonConfig: injection.NamedControllerConstructor{
+			Name: "tektonconfig",
+			ControllerConstructor: func(ctx context.Context, _ configmap.Watcher) *controller.Impl {
+				return controller.NewContext(ctx, &fakeReconciler{}, controller.ControllerOptions{WorkQueueName: "test", Logger: zap.NewNop().Sugar()})
+			},
+		},
+	}
+	// the reconcilers are not fenced without handover
+	impl := ctrls.withHandover(nil)[ControllerTektonConfig].ControllerConstructor(context.TODO(), nil)
+	_, ok := impl.Reconciler.(*fencedReconciler)
+	assert.Assert(t, !ok)
+
+	result := ctrls.withHandover(handover.New(fake.NewSimpleClientset(), "tekton-operator", "operator", "0.76.0"))
+	assert.Equal(t, result[ControllerTektonConfig].Name, "tektonconfig")
+	impl = result[ControllerTektonConfig].ControllerConstructor(context.TODO(), nil)
+	fenced, ok := impl.Reconciler.(*fencedReconciler)
+	assert.Assert(t, ok)
+	assert.Equal(t, fenced.name, string(ControllerTektonConfig))
+}
@@ -22,10 +22,20 @@ import (
 	"log"
 	"strings"
 
+	mfc "github.com/manifestival/client-go-client"
+	operatorclient "github.com/tektoncd/operator/pkg/client/injection/client"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
+	"github.com/tektoncd/operator/pkg/reconciler/platform/alerting"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/handover"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/readiness"
 	installer "github.com/tektoncd/operator/pkg/reconciler/shared/tektoninstallerset"
+	"k8s.io/client-go/rest"
+	kubeclient "knative.dev/pkg/client/injection/kube/client"
 	"knative.dev/pkg/injection"
 	"knative.dev/pkg/injection/sharedmain"
+	"knative.dev/pkg/logging"
 	"knative.dev/pkg/signals"
+	"knative.dev/pkg/system"
 )
 
 // validateControllerNamesOrDie ensures that the list of controller names to be enabled
@@ -109,13 +119,45 @@ func startMain(p Platform, ctrls ControllerMap) {
 	ctx, _ := injection.EnableInjectionOrDie(signals.NewContext(), cfg)
 	ctx = contextWithPlatformName(ctx, pParams.Name)
 	installer.InitTektonInstallerSetClient(ctx)
+	ctrlsConfig := controllersConfigOrDie(ctx, kubeclient.Get(ctx), p.AllSupportedControllers())
+	common.SetManifestPolicy(ctrlsConfig.ManifestPolicy)
+	common.SetDeterministicRender(ctrlsConfig.DeterministicRender)
+	common.SetManifestsStore(common.NewManifestsStore(kubeclient.Get(ctx), system.Namespace(), ctrlsConfig.ManifestsStorage))
+	if err := common.RefreshArchPlacement(ctx, kubeclient.Get(ctx)); err != nil {
+		logging.FromContext(ctx).Errorw("failed to select the node architectures of the workloads", "error", err)
+	}
+	// the payload is checked by the process installing the resources
+	if _, ok := ctrls[ControllerTektonInstallerSet]; ok {
+		go ctrlsConfig.ManifestPolicy.LintPayload(logging.FromContext(ctx))
+	}
+	// the install health is reported by the process reconciling TektonConfig
+	if _, ok := ctrls[ControllerTektonConfig]; ok {
+		go readiness.Serve(ctx, operatorclient.Get(ctx), logging.FromContext(ctx))
+		go reconcileAlertingRules(ctx, cfg, ctrlsConfig.AlertingRules)
+	}
+	h := startHandover(ctx)
+	ctx = handover.WithHandover(ctx, h)
 	sharedmain.MainWithConfig(ctx,
 		pParams.SharedMainName,
 		cfg,
-		ctrls.ControllerConstructors()...,
+		ctrls.withWorkers(ctrlsConfig.Workers).withHandover(h).withSelfMetrics().ControllerConstructors()...,
 	)
 }
 
+// reconcileAlertingRules applies or deletes the alerting rules of the operator in the operator
+// namespace, the rules are read from the controllers ConfigMap on startup
+func reconcileAlertingRules(ctx context.Context, cfg *rest.Config, enabled bool) {
+	logger := logging.FromContext(ctx)
+	mfClient, err := mfc.NewClient(cfg)
+	if err != nil {
+		logger.Errorw("failed to create the client of the alerting rules", "error", err)
+		return
+	}
+	if err := alerting.Reconcile(ctx, mfClient, kubeclient.Get(ctx).Discovery(), system.Namespace(), enabled); err != nil {
+		logger.Errorw("failed to reconcile the alerting rules of the operator", "error", err)
+	}
+}
+
 // StartMainWithAllControllers calls startMain with all controllers
 // supported by a platform
 func StartMainWithAllControllers(p Platform) {
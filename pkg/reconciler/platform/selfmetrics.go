@@ -0,0 +1,175 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package platform
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+	"knative.dev/pkg/configmap"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/injection"
+	"knative.dev/pkg/logging"
+	"knative.dev/pkg/metrics"
+	pkgreconciler "knative.dev/pkg/reconciler"
+)
+
+var (
+	reconcilerQueueDepth = stats.Int64("reconciler_queue_depth",
+		"number of keys waiting in the work queue of the reconciler",
+		stats.UnitDimensionless)
+	reconcilerKeyLatency = stats.Float64("reconciler_key_latency",
+		"time taken by the reconciler to process a key",
+		stats.UnitMilliseconds)
+	reconcilerKeyFailures = stats.Int64("reconciler_key_consecutive_failures",
+		"number of consecutive failed reconciles of a key, reset when the key is reconciled",
+		stats.UnitDimensionless)
+
+	reconcilerTagKey = tag.MustNewKey("reconciler")
+	keyTagKey        = tag.MustNewKey("key")
+	successTagKey    = tag.MustNewKey("success")
+
+	registerSelfMetricsViews = sync.OnceValue(func() error {
+		return view.Register(
+			&view.View{
+				Description: reconcilerQueueDepth.Description(),
+				Measure:     reconcilerQueueDepth,
+				Aggregation: view.LastValue(),
+				TagKeys:     []tag.Key{reconcilerTagKey},
+			},
+			&view.View{
+				Description: reconcilerKeyLatency.Description(),
+				Measure:     reconcilerKeyLatency,
+				Aggregation: view.Distribution(10, 50, 100, 500, 1000, 5000, 10000, 30000, 60000, 120000, 300000),
+				TagKeys:     []tag.Key{reconcilerTagKey, keyTagKey, successTagKey},
+			},
+			&view.View{
+				Description: reconcilerKeyFailures.Description(),
+				Measure:     reconcilerKeyFailures,
+				Aggregation: view.LastValue(),
+				TagKeys:     []tag.Key{reconcilerTagKey, keyTagKey},
+			},
+		)
+	})
+)
+
+// instrumentedReconciler records the queue depth of the controller, the latency of each
+// key and the number of consecutive failures of each key. A requeue is not a failure and
+// does not reset the failures of the key, a skipped key is a success.
+type instrumentedReconciler struct {
+	controller.Reconciler
+	name  string
+	depth func() int
+
+	mutex    sync.Mutex
+	failures map[string]int64
+}
+
+// leaderAwareInstrumentedReconciler keeps the leader election of the reconcilers which
+// take part in it, knative only elects leaders for reconcilers implementing LeaderAware
+type leaderAwareInstrumentedReconciler struct {
+	*instrumentedReconciler
+	pkgreconciler.LeaderAware
+}
+
+func (r *instrumentedReconciler) Reconcile(ctx context.Context, key string) error {
+	start := time.Now()
+	err := r.Reconciler.Reconcile(ctx, key)
+	elapsed := time.Since(start)
+
+	requeued, _ := controller.IsRequeueKey(err)
+	failed := err != nil && !requeued && !controller.IsSkipKey(err)
+	r.mutex.Lock()
+	switch {
+	case failed:
+		r.failures[key]++
+	case !requeued:
+		delete(r.failures, key)
+	}
+	failures := r.failures[key]
+	r.mutex.Unlock()
+
+	if err := r.record(key, !failed, elapsed, failures); err != nil {
+		logging.FromContext(ctx).Debugf("failed to record the metrics of reconciler %s: %v", r.name, err)
+	}
+	return err
+}
+
+func (r *instrumentedReconciler) record(key string, success bool, elapsed time.Duration, failures int64) error {
+	if err := registerSelfMetricsViews(); err != nil {
+		return err
+	}
+	ctx, err := tag.New(context.Background(), tag.Insert(reconcilerTagKey, r.name))
+	if err != nil {
+		return err
+	}
+	metrics.Record(ctx, reconcilerQueueDepth.M(int64(r.depth())))
+
+	keyCtx, err := tag.New(ctx, tag.Insert(keyTagKey, key))
+	if err != nil {
+		return err
+	}
+	metrics.Record(keyCtx, reconcilerKeyFailures.M(failures))
+
+	latencyCtx, err := tag.New(keyCtx, tag.Insert(successTagKey, strconv.FormatBool(success)))
+	if err != nil {
+		return err
+	}
+	metrics.Record(latencyCtx, reconcilerKeyLatency.M(float64(elapsed.Milliseconds())))
+	return nil
+}
+
+// instrument wraps the reconciler of the controller to record its metrics
+func instrument(name string, impl *controller.Impl) {
+	r := &instrumentedReconciler{
+		Reconciler: impl.Reconciler,
+		name:       name,
+		depth:      impl.WorkQueue().Len,
+		failures:   map[string]int64{},
+	}
+	if la, ok := impl.Reconciler.(pkgreconciler.LeaderAware); ok {
+		impl.Reconciler = &leaderAwareInstrumentedReconciler{instrumentedReconciler: r, LeaderAware: la}
+		return
+	}
+	impl.Reconciler = r
+}
+
+// withSelfMetrics wraps the constructors of the controllers to record the metrics of their reconcilers
+func (cm ControllerMap) withSelfMetrics() ControllerMap {
+	result := ControllerMap{}
+	for name, namedCtrl := range cm {
+		if namedCtrl.ControllerConstructor == nil {
+			result[name] = namedCtrl
+			continue
+		}
+		constructor := namedCtrl.ControllerConstructor
+		result[name] = injection.NamedControllerConstructor{
+			Name: namedCtrl.Name,
+			ControllerConstructor: func(ctx context.Context, cmw configmap.Watcher) *controller.Impl {
+				impl := constructor(ctx, cmw)
+				instrument(string(name), impl)
+				return impl
+			},
+		}
+	}
+	return result
+}
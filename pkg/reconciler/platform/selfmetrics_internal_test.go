@@ -0,0 +1,102 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package platform
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	"gotest.tools/v3/assert"
+	"knative.dev/pkg/configmap"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/injection"
+	"knative.dev/pkg/metrics/metricstest"
+	_ "knative.dev/pkg/metrics/testing"
+	pkgreconciler "knative.dev/pkg/reconciler"
+)
+
+type fakeReconciler struct {
+	errs []error
+}
+
+func (r *fakeReconciler) Reconcile(context.Context, string) error {
+	err := r.errs[0]
+	r.errs = r.errs[1:]
+	return err
+}
+
+type fakeLeaderAwareReconciler struct {
+	fakeReconciler
+	pkgreconciler.LeaderAwareFuncs
+}
+
+func TestInstrumentedReconciler(t *testing.T) {
+	r := &fakeReconciler{errs: []error{
+		errors.New("failed"),
+		errors.New("failed"),
+		controller.NewRequeueAfter(time.Second),
+		errors.New("failed"),
+		nil,
+	}}
+	impl := controller.NewContext(context.TODO(), r, controller.ControllerOptions{WorkQueueName: "test", Logger: zap.NewNop().Sugar()})
+	instrument("tektonconfig", impl)
+	instrumented := impl.Reconciler.(*instrumentedReconciler)
+	tags := map[string]string{"reconciler": "tektonconfig", "key": "config"}
+
+	for _, want := range []int64{1, 2, 2, 3} {
+		assert.Assert(t, impl.Reconciler.Reconcile(context.TODO(), "config") != nil)
+		assert.Equal(t, instrumented.failures["config"], want)
+		metricstest.CheckLastValueData(t, "reconciler_key_consecutive_failures", tags, float64(want))
+	}
+	assert.NilError(t, impl.Reconciler.Reconcile(context.TODO(), "config"))
+	assert.Equal(t, len(instrumented.failures), 0)
+	metricstest.CheckLastValueData(t, "reconciler_key_consecutive_failures", tags, 0)
+	metricstest.CheckLastValueData(t, "reconciler_queue_depth", map[string]string{"reconciler": "tektonconfig"}, 0)
+	metricstest.CheckStatsReported(t, "reconciler_key_latency")
+}
+
+func TestInstrumentKeepsLeaderElection(t *testing.T) {
+	impl := controller.NewContext(context.TODO(), &fakeLeaderAwareReconciler{}, controller.ControllerOptions{WorkQueueName: "test", Logger: zap.NewNop().Sugar()})
+	instrument("tektonpipeline", impl)
+	_, ok := impl.Reconciler.(pkgreconciler.LeaderAware)
+	assert.Assert(t, ok)
+
+	impl = controller.NewContext(context.TODO(), &fakeReconciler{}, controller.ControllerOptions{WorkQueueName: "test", Logger: zap.NewNop().Sugar()})
+	instrument("tektonpipeline", impl)
+	_, ok = impl.Reconciler.(pkgreconciler.LeaderAware)
+	assert.Assert(t, !ok)
+}
+
+func TestControllerMapWithSelfMetrics(t *testing.T) {
+	ctrls := ControllerMap{
+		ControllerTektonConfig: injection.NamedControllerConstructor{
+			Name: "tektonconfig",
+			ControllerConstructor: func(ctx context.Context, _ configmap.Watcher) *controller.Impl {
+				return controller.NewContext(ctx, &fakeReconciler{}, controller.ControllerOptions{WorkQueueName: "test", Logger: zap.NewNop().Sugar()})
+			},
+		},
+	}
+	result := ctrls.withSelfMetrics()
+	assert.Equal(t, result[ControllerTektonConfig].Name, "tektonconfig")
+	impl := result[ControllerTektonConfig].ControllerConstructor(context.TODO(), nil)
+	instrumented, ok := impl.Reconciler.(*instrumentedReconciler)
+	assert.Assert(t, ok)
+	assert.Equal(t, instrumented.name, string(ControllerTektonConfig))
+}
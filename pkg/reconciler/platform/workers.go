@@ -0,0 +1,188 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package platform
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/tektoncd/operator/pkg/reconciler/common"
+	"github.com/tektoncd/operator/pkg/reconciler/platform/alerting"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"knative.dev/pkg/configmap"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/injection"
+	"knative.dev/pkg/system"
+)
+
+const (
+	// EnvConfigControllersName is the environment variable holding the name of the
+	// ConfigMap which configures the controllers
+	EnvConfigControllersName     string = "CONFIG_CONTROLLERS_NAME"
+	DefaultConfigControllersName string = "tekton-operator-controller-config-controllers"
+
+	// workersKey sets the number of workers of all controllers, workersKeyPrefix
+	// followed by a controller name sets the workers of that controller
+	workersKey       = "workers"
+	workersKeyPrefix = "workers."
+)
+
+// WorkersConfig holds the number of workers processing the work queue of the controllers
+type WorkersConfig struct {
+	// Default applies to the controllers without an explicit number of workers,
+	// zero keeps the knative default
+	Default     int
+	Controllers map[ControllerName]int
+}
+
+// Workers returns the number of workers for a controller, zero keeps the knative default
+func (wc WorkersConfig) Workers(name ControllerName) int {
+	if n, ok := wc.Controllers[name]; ok {
+		return n
+	}
+	return wc.Default
+}
+
+// NewWorkersConfigFromMap creates a WorkersConfig from the data of the controllers ConfigMap
+func NewWorkersConfigFromMap(data map[string]string) (WorkersConfig, error) {
+	wc := WorkersConfig{Controllers: map[ControllerName]int{}}
+	for key, value := range data {
+		if key != workersKey && !strings.HasPrefix(key, workersKeyPrefix) {
+			continue
+		}
+		n, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil || n < 1 {
+			return WorkersConfig{}, fmt.Errorf("invalid value %q for %s, must be a positive integer", value, key)
+		}
+		if key == workersKey {
+			wc.Default = n
+			continue
+		}
+		wc.Controllers[ControllerName(strings.TrimPrefix(key, workersKeyPrefix))] = n
+	}
+	return wc, nil
+}
+
+// validate returns an error when workers are set for a controller which is not supported
+func (wc WorkersConfig) validate(supportedCtrls ControllerMap) error {
+	names := make([]ControllerName, 0, len(wc.Controllers))
+	for name := range wc.Controllers {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool { return names[i] < names[j] })
+	if invalid := invalidNames(supportedCtrls, names); invalid != "" {
+		return fmt.Errorf("invalid %s keys: %w", workersKeyPrefix+"<name>", ErrorControllerNames(invalid, supportedCtrls.ControllerNames()))
+	}
+	return nil
+}
+
+// controllersConfig reads the data of the controllers ConfigMap in the operator
+// namespace, a missing ConfigMap keeps the defaults
+func controllersConfig(ctx context.Context, kubeClient kubernetes.Interface) (map[string]string, error) {
+	name := os.Getenv(EnvConfigControllersName)
+	if name == "" {
+		name = DefaultConfigControllersName
+	}
+	cm, err := kubeClient.CoreV1().ConfigMaps(system.Namespace()).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return map[string]string{}, nil
+		}
+		return nil, err
+	}
+	return cm.Data, nil
+}
+
+// withWorkers wraps the constructors of the controllers to set their number of workers
+func (cm ControllerMap) withWorkers(wc WorkersConfig) ControllerMap {
+	result := ControllerMap{}
+	for name, namedCtrl := range cm {
+		workers := wc.Workers(name)
+		if workers == 0 || namedCtrl.ControllerConstructor == nil {
+			result[name] = namedCtrl
+			continue
+		}
+		constructor := namedCtrl.ControllerConstructor
+		result[name] = injection.NamedControllerConstructor{
+			Name: namedCtrl.Name,
+			ControllerConstructor: func(ctx context.Context, cmw configmap.Watcher) *controller.Impl {
+				impl := constructor(ctx, cmw)
+				impl.Concurrency = workers
+				return impl
+			},
+		}
+	}
+	return result
+}
+
+// ControllersConfig holds the configuration of the controllers read from the controllers ConfigMap
+type ControllersConfig struct {
+	Workers        WorkersConfig
+	ManifestPolicy *common.ManifestPolicy
+	// AlertingRules enables the alerting rules of the operator
+	AlertingRules bool
+	// DeterministicRender enables the deterministic rendering of the manifests of the installer sets
+	DeterministicRender bool
+	// ManifestsStorage selects where the manifests of the installer sets are stored
+	ManifestsStorage string
+}
+
+// controllersConfigOrDie reads the controllers ConfigMap, the workers are checked against the
+// controllers supported by the platform, this function exits on error
+func controllersConfigOrDie(ctx context.Context, kubeClient kubernetes.Interface, supportedCtrls ControllerMap) ControllersConfig {
+	data, err := controllersConfig(ctx, kubeClient)
+	if err != nil {
+		log.Fatalf("error reading controllers configuration: %v", err)
+	}
+	wc, err := NewWorkersConfigFromMap(data)
+	if err != nil {
+		log.Fatalf("error reading controllers configuration: %v", err)
+	}
+	if err := wc.validate(supportedCtrls); err != nil {
+		log.Fatalf("error reading controllers configuration: %v", err)
+	}
+	policy, err := common.NewManifestPolicyFromMap(data)
+	if err != nil {
+		log.Fatalf("error reading controllers configuration: %v", err)
+	}
+	alertingRules, err := alerting.EnabledFromMap(data)
+	if err != nil {
+		log.Fatalf("error reading controllers configuration: %v", err)
+	}
+	deterministicRender, err := common.NewDeterministicRenderFromMap(data)
+	if err != nil {
+		log.Fatalf("error reading controllers configuration: %v", err)
+	}
+	manifestsStorage, err := common.NewManifestsStorageFromMap(data)
+	if err != nil {
+		log.Fatalf("error reading controllers configuration: %v", err)
+	}
+	return ControllersConfig{
+		Workers:             wc,
+		ManifestPolicy:      policy,
+		AlertingRules:       alertingRules,
+		DeterministicRender: deterministicRender,
+		ManifestsStorage:    manifestsStorage,
+	}
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package platform
+
+import (
+	"context"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"knative.dev/pkg/configmap"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/injection"
+)
+
+func TestNewWorkersConfigFromMap(t *testing.T) {
+	tests := []struct {
+		description string
+		data        map[string]string
+		expected    WorkersConfig
+		wantErr     bool
+	}{
+		{
+			description: "empty config keeps the knative defaults",
+			data:        map[string]string{"_example": "workers: 4"},
+			expected:    WorkersConfig{Controllers: map[ControllerName]int{}},
+		},
+		{
+			description: "default and per controller workers",
+			data: map[string]string{
+				"workers":                    "4",
+				"workers.tektoninstallerset": "16",
+			},
+			expected: WorkersConfig{Default: 4, Controllers: map[ControllerName]int{ControllerTektonInstallerSet: 16}},
+		},
+		{
+			description: "invalid workers",
+			data:        map[string]string{"workers.tektonconfig": "0"},
+			wantErr:     true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			wc, err := NewWorkersConfigFromMap(test.data)
+			if test.wantErr {
+				assert.ErrorContains(t, err, "must be a positive integer")
+				return
+			}
+			assert.NilError(t, err)
+			assert.DeepEqual(t, wc, test.expected)
+		})
+	}
+}
+
+func TestWorkersConfigValidate(t *testing.T) {
+	supported := ControllerMap{
+		ControllerTektonConfig:       injection.NamedControllerConstructor{Name: string(ControllerTektonConfig)},
+		ControllerTektonInstallerSet: injection.NamedControllerConstructor{Name: string(ControllerTektonInstallerSet)},
+	}
+	wc, err := NewWorkersConfigFromMap(map[string]string{"workers": "4", "workers.tektoninstallerset": "16"})
+	assert.NilError(t, err)
+	assert.NilError(t, wc.validate(supported))
+
+	// a typo in a controller name is reported instead of being ignored
+	wc, err = NewWorkersConfigFromMap(map[string]string{"workers.tektoninstalerset": "16", "workers.tektonconfig": "2"})
+	assert.NilError(t, err)
+	assert.ErrorContains(t, wc.validate(supported), "invalid workers.<name> keys: un-identified controller names: tektoninstalerset")
+}
+
+func TestControllersConfig(t *testing.T) {
+	t.Setenv("SYSTEM_NAMESPACE", "tekton-operator")
+	ctx := context.TODO()
+
+	// a missing ConfigMap keeps the knative defaults
+	data, err := controllersConfig(ctx, fake.NewSimpleClientset())
+	assert.NilError(t, err)
+	wc, err := NewWorkersConfigFromMap(data)
+	assert.NilError(t, err)
+	assert.Equal(t, wc.Workers(ControllerTektonConfig), 0)
+
+	kubeClient := fake.NewSimpleClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: DefaultConfigControllersName, Namespace: "tekton-operator"},
+		Data:       map[string]string{"workers": "4", "workers.tektonconfig": "1"},
+	})
+	data, err = controllersConfig(ctx, kubeClient)
+	assert.NilError(t, err)
+	wc, err = NewWorkersConfigFromMap(data)
+	assert.NilError(t, err)
+	assert.Equal(t, wc.Workers(ControllerTektonConfig), 1)
+	assert.Equal(t, wc.Workers(ControllerTektonPipeline), 4)
+}
+
+func TestControllerMapWithWorkers(t *testing.T) {
+	newCtrl := func(name string) injection.NamedControllerConstructor {
+		return injection.NamedControllerConstructor{
+			Name: name,
+			ControllerConstructor: func(context.Context, configmap.Watcher) *controller.Impl {
+				return &controller.Impl{Concurrency: controller.DefaultThreadsPerController}
+			},
+		}
+	}
+	ctrls := ControllerMap{
+		ControllerTektonConfig:       newCtrl("tektonconfig"),
+		ControllerTektonInstallerSet: newCtrl("tektoninstallerset"),
+	}
+	wc := WorkersConfig{Controllers: map[ControllerName]int{ControllerTektonInstallerSet: 16}}
+
+	result := ctrls.withWorkers(wc)
+	assert.Equal(t, result[ControllerTektonInstallerSet].Name, "tektoninstallerset")
+	assert.Equal(t, result[ControllerTektonInstallerSet].ControllerConstructor(context.TODO(), nil).Concurrency, 16)
+	assert.Equal(t, result[ControllerTektonConfig].ControllerConstructor(context.TODO(), nil).Concurrency, controller.DefaultThreadsPerController)
+}
@@ -288,18 +288,21 @@ func roundTripPatch(bytes []byte, unmarshalled interface{}) (duck.JSONPatch, err
 func setDefaults(client kubernetes.Interface, ctx context.Context, patches duck.JSONPatch, pod corev1.Pod) (duck.JSONPatch, error) {
 	before, after := pod.DeepCopyObject(), pod
 
+	// proxy credentials are meant for the components only, they are never
+	// injected into taskrun pods
 	var proxyEnv = []corev1.EnvVar{{
 		Name:  "HTTPS_PROXY",
-		Value: os.Getenv("HTTPS_PROXY"),
+		Value: common.StripProxyCredentials(os.Getenv("HTTPS_PROXY")),
 	}, {
 		Name:  "HTTP_PROXY",
-		Value: os.Getenv("HTTP_PROXY"),
+		Value: common.StripProxyCredentials(os.Getenv("HTTP_PROXY")),
 	}, {
 		Name:  "NO_PROXY",
 		Value: os.Getenv("NO_PROXY"),
 	}}
 
-	if after.Spec.Containers != nil {
+	// the proxy is not injected when the workload proxy is disabled, only the CA bundles are
+	if after.Spec.Containers != nil && !strings.EqualFold(os.Getenv(common.WorkloadProxyEnvKey), "false") {
 		for i, container := range after.Spec.Containers {
 			newEnvs := updateAndMergeEnv(container.Env, proxyEnv)
 			after.Spec.Containers[i].Env = newEnvs
@@ -0,0 +1,206 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package componentaction
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	mf "github.com/manifestival/manifestival"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/client/clientset/versioned"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"knative.dev/pkg/logging"
+)
+
+const (
+	// ActionAnnotation set on a component requests an action, it is removed once the action is done
+	ActionAnnotation = "operator.tekton.dev/action"
+	// LastActionAnnotation records the last action done on a component and when it was done
+	LastActionAnnotation = "operator.tekton.dev/last-action"
+	// resyncAnnotation is set on the installer sets to reconcile them when a resync is requested
+	resyncAnnotation = "operator.tekton.dev/resync-requested-at"
+
+	// ActionRestart deletes the pods of the Deployments and StatefulSets of the component
+	ActionRestart = "restart"
+	// ActionResync re-applies all the resources of the payload of the component
+	ActionResync = "resync"
+	// ActionReset deletes the installer sets of the component, its payload is reinstalled from the defaults
+	ActionReset = "reset"
+)
+
+// SupportedActions are the actions which can be requested on a component
+var SupportedActions = []string{ActionRestart, ActionResync, ActionReset}
+
+// Kind is a component custom resource on which actions can be requested
+type Kind struct {
+	Kind     string
+	Informer func(ctx context.Context) cache.SharedIndexInformer
+	Get      func(ctx context.Context, client versioned.Interface, name string) (v1alpha1.TektonComponent, error)
+	Patch    func(ctx context.Context, client versioned.Interface, name string, patch []byte) error
+}
+
+// actions runs the actions on the payload of a component
+type actions struct {
+	kubeClientSet     kubernetes.Interface
+	operatorClientSet versioned.Interface
+	mfClient          mf.Client
+	now               func() time.Time
+}
+
+// run runs the action on the installer sets owned by the component
+func (a *actions) run(ctx context.Context, action string, component metav1.Object) error {
+	sets, err := a.installerSets(ctx, component)
+	if err != nil {
+		return err
+	}
+	switch action {
+	case ActionRestart:
+		return a.restart(ctx, sets)
+	case ActionResync:
+		return a.resync(ctx, sets)
+	case ActionReset:
+		return a.reset(ctx, sets)
+	default:
+		return fmt.Errorf("unsupported action %q", action)
+	}
+}
+
+func (a *actions) installerSets(ctx context.Context, component metav1.Object) ([]v1alpha1.TektonInstallerSet, error) {
+	list, err := a.operatorClientSet.OperatorV1alpha1().TektonInstallerSets().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	sets := []v1alpha1.TektonInstallerSet{}
+	for _, set := range list.Items {
+		if metav1.IsControlledBy(&set, component) {
+			sets = append(sets, set)
+		}
+	}
+	return sets, nil
+}
+
+// restart deletes the pods of the workloads, the pod template is not changed as the installer
+// set would revert it
+func (a *actions) restart(ctx context.Context, sets []v1alpha1.TektonInstallerSet) error {
+	logger := logging.FromContext(ctx)
+	for _, set := range sets {
+		resources, err := common.InstallerSetManifests(ctx, &set)
+		if err != nil {
+			return err
+		}
+		manifest, err := mf.ManifestFrom(resources)
+		if err != nil {
+			return err
+		}
+		for _, r := range manifest.Filter(mf.Any(mf.ByKind("Deployment"), mf.ByKind("StatefulSet"))).Resources() {
+			var selector *metav1.LabelSelector
+			if r.GetKind() == "Deployment" {
+				d, err := a.kubeClientSet.AppsV1().Deployments(r.GetNamespace()).Get(ctx, r.GetName(), metav1.GetOptions{})
+				if apierrors.IsNotFound(err) {
+					continue
+				}
+				if err != nil {
+					return err
+				}
+				selector = d.Spec.Selector
+			} else {
+				s, err := a.kubeClientSet.AppsV1().StatefulSets(r.GetNamespace()).Get(ctx, r.GetName(), metav1.GetOptions{})
+				if apierrors.IsNotFound(err) {
+					continue
+				}
+				if err != nil {
+					return err
+				}
+				selector = s.Spec.Selector
+			}
+			labelSelector, err := metav1.LabelSelectorAsSelector(selector)
+			if err != nil {
+				return err
+			}
+			pods, err := a.kubeClientSet.CoreV1().Pods(r.GetNamespace()).List(ctx, metav1.ListOptions{LabelSelector: labelSelector.String()})
+			if err != nil {
+				return err
+			}
+			logger.Infof("restarting %s %s/%s, deleting %d pods", r.GetKind(), r.GetNamespace(), r.GetName(), len(pods.Items))
+			for _, pod := range pods.Items {
+				if err := a.kubeClientSet.CoreV1().Pods(pod.Namespace).Delete(ctx, pod.Name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// resync removes the hash of the last applied manifest from the resources, and reconciles the
+// installer sets so that they apply all their resources again
+func (a *actions) resync(ctx context.Context, sets []v1alpha1.TektonInstallerSet) error {
+	now := a.now().UTC().Format(time.RFC3339)
+	for _, set := range sets {
+		resources, err := common.InstallerSetManifests(ctx, &set)
+		if err != nil {
+			return err
+		}
+		manifest, err := mf.ManifestFrom(resources)
+		if err != nil {
+			return err
+		}
+		for _, r := range manifest.Resources() {
+			live, err := a.mfClient.Get(&r)
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			annotations := live.GetAnnotations()
+			if _, ok := annotations[v1alpha1.LastAppliedHashKey]; !ok {
+				continue
+			}
+			delete(annotations, v1alpha1.LastAppliedHashKey)
+			live.SetAnnotations(annotations)
+			if err := a.mfClient.Update(live); err != nil {
+				return err
+			}
+		}
+
+		patch := fmt.Sprintf(`{"metadata":{"annotations":{%q:%q}}}`, resyncAnnotation, now)
+		if _, err := a.operatorClientSet.OperatorV1alpha1().TektonInstallerSets().Patch(ctx, set.Name, types.MergePatchType, []byte(patch), metav1.PatchOptions{}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// reset deletes the installer sets, the component creates them again from its spec
+func (a *actions) reset(ctx context.Context, sets []v1alpha1.TektonInstallerSet) error {
+	logger := logging.FromContext(ctx)
+	for _, set := range sets {
+		logger.Infof("resetting installer set %s", set.Name)
+		if err := a.operatorClientSet.OperatorV1alpha1().TektonInstallerSets().Delete(ctx, set.Name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,296 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package componentaction
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	mfc "github.com/manifestival/client-go-client"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/client/clientset/versioned"
+	operatorclient "github.com/tektoncd/operator/pkg/client/injection/client"
+	manualapprovalgateinformer "github.com/tektoncd/operator/pkg/client/injection/informers/operator/v1alpha1/manualapprovalgate"
+	openshiftpipelinesascodeinformer "github.com/tektoncd/operator/pkg/client/injection/informers/operator/v1alpha1/openshiftpipelinesascode"
+	tektonaddoninformer "github.com/tektoncd/operator/pkg/client/injection/informers/operator/v1alpha1/tektonaddon"
+	tektonchaininformer "github.com/tektoncd/operator/pkg/client/injection/informers/operator/v1alpha1/tektonchain"
+	tektondashboardinformer "github.com/tektoncd/operator/pkg/client/injection/informers/operator/v1alpha1/tektondashboard"
+	tektonhubinformer "github.com/tektoncd/operator/pkg/client/injection/informers/operator/v1alpha1/tektonhub"
+	tektonpipelineinformer "github.com/tektoncd/operator/pkg/client/injection/informers/operator/v1alpha1/tektonpipeline"
+	tektonprunerinformer "github.com/tektoncd/operator/pkg/client/injection/informers/operator/v1alpha1/tektonpruner"
+	tektonresultinformer "github.com/tektoncd/operator/pkg/client/injection/informers/operator/v1alpha1/tektonresult"
+	tektontriggerinformer "github.com/tektoncd/operator/pkg/client/injection/informers/operator/v1alpha1/tektontrigger"
+	"go.uber.org/zap"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+	kubeclient "knative.dev/pkg/client/injection/kube/client"
+	"knative.dev/pkg/configmap"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/injection"
+	"knative.dev/pkg/logging"
+	pkgreconciler "knative.dev/pkg/reconciler"
+)
+
+// CommonKinds are the components supported on all platforms
+var CommonKinds = []Kind{
+	{
+		Kind:     "TektonPipeline",
+		Informer: func(ctx context.Context) cache.SharedIndexInformer { return tektonpipelineinformer.Get(ctx).Informer() },
+		Get: func(ctx context.Context, c versioned.Interface, name string) (v1alpha1.TektonComponent, error) {
+			return c.OperatorV1alpha1().TektonPipelines().Get(ctx, name, metav1.GetOptions{})
+		},
+		Patch: func(ctx context.Context, c versioned.Interface, name string, patch []byte) error {
+			_, err := c.OperatorV1alpha1().TektonPipelines().Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+			return err
+		},
+	},
+	{
+		Kind:     "TektonTrigger",
+		Informer: func(ctx context.Context) cache.SharedIndexInformer { return tektontriggerinformer.Get(ctx).Informer() },
+		Get: func(ctx context.Context, c versioned.Interface, name string) (v1alpha1.TektonComponent, error) {
+			return c.OperatorV1alpha1().TektonTriggers().Get(ctx, name, metav1.GetOptions{})
+		},
+		Patch: func(ctx context.Context, c versioned.Interface, name string, patch []byte) error {
+			_, err := c.OperatorV1alpha1().TektonTriggers().Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+			return err
+		},
+	},
+	{
+		Kind:     "TektonChain",
+		Informer: func(ctx context.Context) cache.SharedIndexInformer { return tektonchaininformer.Get(ctx).Informer() },
+		Get: func(ctx context.Context, c versioned.Interface, name string) (v1alpha1.TektonComponent, error) {
+			return c.OperatorV1alpha1().TektonChains().Get(ctx, name, metav1.GetOptions{})
+		},
+		Patch: func(ctx context.Context, c versioned.Interface, name string, patch []byte) error {
+			_, err := c.OperatorV1alpha1().TektonChains().Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+			return err
+		},
+	},
+	{
+		Kind:     "TektonResult",
+		Informer: func(ctx context.Context) cache.SharedIndexInformer { return tektonresultinformer.Get(ctx).Informer() },
+		Get: func(ctx context.Context, c versioned.Interface, name string) (v1alpha1.TektonComponent, error) {
+			return c.OperatorV1alpha1().TektonResults().Get(ctx, name, metav1.GetOptions{})
+		},
+		Patch: func(ctx context.Context, c versioned.Interface, name string, patch []byte) error {
+			_, err := c.OperatorV1alpha1().TektonResults().Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+			return err
+		},
+	},
+	{
+		Kind:     "TektonHub",
+		Informer: func(ctx context.Context) cache.SharedIndexInformer { return tektonhubinformer.Get(ctx).Informer() },
+		Get: func(ctx context.Context, c versioned.Interface, name string) (v1alpha1.TektonComponent, error) {
+			return c.OperatorV1alpha1().TektonHubs().Get(ctx, name, metav1.GetOptions{})
+		},
+		Patch: func(ctx context.Context, c versioned.Interface, name string, patch []byte) error {
+			_, err := c.OperatorV1alpha1().TektonHubs().Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+			return err
+		},
+	},
+	{
+		Kind:     "TektonPruner",
+		Informer: func(ctx context.Context) cache.SharedIndexInformer { return tektonprunerinformer.Get(ctx).Informer() },
+		Get: func(ctx context.Context, c versioned.Interface, name string) (v1alpha1.TektonComponent, error) {
+			return c.OperatorV1alpha1().TektonPruners().Get(ctx, name, metav1.GetOptions{})
+		},
+		Patch: func(ctx context.Context, c versioned.Interface, name string, patch []byte) error {
+			_, err := c.OperatorV1alpha1().TektonPruners().Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+			return err
+		},
+	},
+	{
+		Kind: "ManualApprovalGate",
+		Informer: func(ctx context.Context) cache.SharedIndexInformer {
+			return manualapprovalgateinformer.Get(ctx).Informer()
+		},
+		Get: func(ctx context.Context, c versioned.Interface, name string) (v1alpha1.TektonComponent, error) {
+			return c.OperatorV1alpha1().ManualApprovalGates().Get(ctx, name, metav1.GetOptions{})
+		},
+		Patch: func(ctx context.Context, c versioned.Interface, name string, patch []byte) error {
+			_, err := c.OperatorV1alpha1().ManualApprovalGates().Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+			return err
+		},
+	},
+}
+
+// DashboardKind is the TektonDashboard component, supported on Kubernetes
+var DashboardKind = Kind{
+	Kind: "TektonDashboard",
+	Informer: func(ctx context.Context) cache.SharedIndexInformer {
+		return tektondashboardinformer.Get(ctx).Informer()
+	},
+	Get: func(ctx context.Context, c versioned.Interface, name string) (v1alpha1.TektonComponent, error) {
+		return c.OperatorV1alpha1().TektonDashboards().Get(ctx, name, metav1.GetOptions{})
+	},
+	Patch: func(ctx context.Context, c versioned.Interface, name string, patch []byte) error {
+		_, err := c.OperatorV1alpha1().TektonDashboards().Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+		return err
+	},
+}
+
+// AddonKind is the TektonAddon component, supported on OpenShift
+var AddonKind = Kind{
+	Kind:     "TektonAddon",
+	Informer: func(ctx context.Context) cache.SharedIndexInformer { return tektonaddoninformer.Get(ctx).Informer() },
+	Get: func(ctx context.Context, c versioned.Interface, name string) (v1alpha1.TektonComponent, error) {
+		return c.OperatorV1alpha1().TektonAddons().Get(ctx, name, metav1.GetOptions{})
+	},
+	Patch: func(ctx context.Context, c versioned.Interface, name string, patch []byte) error {
+		_, err := c.OperatorV1alpha1().TektonAddons().Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+		return err
+	},
+}
+
+// PipelinesAsCodeKind is the OpenShiftPipelinesAsCode component, supported on OpenShift
+var PipelinesAsCodeKind = Kind{
+	Kind: "OpenShiftPipelinesAsCode",
+	Informer: func(ctx context.Context) cache.SharedIndexInformer {
+		return openshiftpipelinesascodeinformer.Get(ctx).Informer()
+	},
+	Get: func(ctx context.Context, c versioned.Interface, name string) (v1alpha1.TektonComponent, error) {
+		return c.OperatorV1alpha1().OpenShiftPipelinesAsCodes().Get(ctx, name, metav1.GetOptions{})
+	},
+	Patch: func(ctx context.Context, c versioned.Interface, name string, patch []byte) error {
+		_, err := c.OperatorV1alpha1().OpenShiftPipelinesAsCodes().Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+		return err
+	},
+}
+
+// Reconciler runs the actions requested on the components with the operator.tekton.dev/action
+// annotation. The components are cluster scoped, the namespace of the keys holds their kind.
+type Reconciler struct {
+	pkgreconciler.LeaderAwareFuncs
+
+	operatorClientSet versioned.Interface
+	kinds             map[string]Kind
+	actions           *actions
+}
+
+var _ controller.Reconciler = (*Reconciler)(nil)
+
+// NewExtendedController returns the action controller for the components of a platform
+func NewExtendedController(kinds ...Kind) injection.ControllerConstructor {
+	return func(ctx context.Context, cmw configmap.Watcher) *controller.Impl {
+		logger := logging.FromContext(ctx)
+
+		mfclient, err := mfc.NewClient(injection.GetConfig(ctx))
+		if err != nil {
+			logger.Fatalw("Error creating client from injected config", zap.Error(err))
+		}
+
+		r := &Reconciler{
+			operatorClientSet: operatorclient.Get(ctx),
+			kinds:             map[string]Kind{},
+			actions: &actions{
+				kubeClientSet:     kubeclient.Get(ctx),
+				operatorClientSet: operatorclient.Get(ctx),
+				mfClient:          mfclient,
+				now:               time.Now,
+			},
+		}
+		impl := controller.NewContext(ctx, r, controller.ControllerOptions{WorkQueueName: "ComponentAction", Logger: logger})
+
+		informers := map[string]cache.SharedIndexInformer{}
+		for _, kind := range kinds {
+			r.kinds[kind.Kind] = kind
+			informer := kind.Informer(ctx)
+			informers[kind.Kind] = informer
+			if _, err := informer.AddEventHandler(cache.FilteringResourceEventHandler{
+				FilterFunc: hasActionAnnotation,
+				Handler:    controller.HandleAll(enqueueWithKind(impl, kind.Kind)),
+			}); err != nil {
+				logger.Panicf("Couldn't register %s informer event handler: %w", kind.Kind, err)
+			}
+		}
+
+		// the requested actions are run by the leader, enqueue them on promotion
+		r.PromoteFunc = func(bkt pkgreconciler.Bucket, enq func(pkgreconciler.Bucket, types.NamespacedName)) error {
+			for kind, informer := range informers {
+				for _, obj := range informer.GetStore().List() {
+					if o, ok := obj.(metav1.Object); ok && hasActionAnnotation(obj) {
+						enq(bkt, types.NamespacedName{Namespace: kind, Name: o.GetName()})
+					}
+				}
+			}
+			return nil
+		}
+		return impl
+	}
+}
+
+func hasActionAnnotation(obj interface{}) bool {
+	o, ok := obj.(metav1.Object)
+	return ok && o.GetAnnotations()[ActionAnnotation] != ""
+}
+
+func enqueueWithKind(impl *controller.Impl, kind string) func(obj interface{}) {
+	return func(obj interface{}) {
+		if o, ok := obj.(metav1.Object); ok {
+			impl.EnqueueKey(types.NamespacedName{Namespace: kind, Name: o.GetName()})
+		}
+	}
+}
+
+// Reconcile runs the action requested on a component and replaces the annotation requesting
+// it with the annotation recording the last action. Unsupported actions are dropped.
+func (r *Reconciler) Reconcile(ctx context.Context, key string) error {
+	logger := logging.FromContext(ctx)
+	kindName, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		logger.Errorf("invalid resource key: %s", key)
+		return nil
+	}
+	if !r.IsLeaderFor(types.NamespacedName{Namespace: kindName, Name: name}) {
+		return nil
+	}
+	kind, ok := r.kinds[kindName]
+	if !ok {
+		return nil
+	}
+
+	component, err := kind.Get(ctx, r.operatorClientSet, name)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	action := component.GetAnnotations()[ActionAnnotation]
+	if action == "" {
+		return nil
+	}
+
+	lastAction := fmt.Sprintf("%s@%s", action, r.actions.now().UTC().Format(time.RFC3339))
+	if !slices.Contains(SupportedActions, action) {
+		logger.Errorf("dropping unsupported action %q on %s %s, must be one of %s", action, kindName, name, strings.Join(SupportedActions, ", "))
+		lastAction += ": unsupported action"
+	} else {
+		logger.Infof("running action %s on %s %s", action, kindName, name)
+		if err := r.actions.run(ctx, action, component); err != nil {
+			return fmt.Errorf("failed to %s %s %s: %w", action, kindName, name, err)
+		}
+	}
+
+	patch := fmt.Sprintf(`{"metadata":{"annotations":{%q:null,%q:%q}}}`, ActionAnnotation, LastActionAnnotation, lastAction)
+	return kind.Patch(ctx, r.operatorClientSet, name, []byte(patch))
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package componentaction
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mf "github.com/manifestival/manifestival"
+	mffake "github.com/manifestival/manifestival/fake"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	operatorfake "github.com/tektoncd/operator/pkg/client/clientset/versioned/fake"
+	"gotest.tools/v3/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	pkgreconciler "knative.dev/pkg/reconciler"
+)
+
+var testTime = time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+func resource(apiVersion, kind, name string) unstructured.Unstructured {
+	return unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": apiVersion,
+		"kind":       kind,
+		"metadata": map[string]interface{}{
+			"name":        name,
+			"namespace":   "tekton-pipelines",
+			"annotations": map[string]interface{}{v1alpha1.LastAppliedHashKey: "hash"},
+		},
+	}}
+}
+
+type fixture struct {
+	reconciler     *Reconciler
+	kubeClient     *kubefake.Clientset
+	operatorClient *operatorfake.Clientset
+	mfClient       mf.Client
+}
+
+func newFixture(t *testing.T, action string) *fixture {
+	t.Helper()
+	tp := &v1alpha1.TektonPipeline{
+		TypeMeta: metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: "TektonPipeline"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        v1alpha1.PipelineResourceName,
+			UID:         "pipeline-uid",
+			Annotations: map[string]string{ActionAnnotation: action},
+		},
+	}
+	set := &v1alpha1.TektonInstallerSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "pipeline-main-deployment",
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(tp, tp.GroupVersionKind())},
+		},
+		Spec: v1alpha1.TektonInstallerSetSpec{Manifests: mf.Slice{
+			resource("apps/v1", "Deployment", "tekton-pipelines-controller"),
+			resource("v1", "ConfigMap", "config-defaults"),
+		}},
+	}
+	other := &v1alpha1.TektonInstallerSet{ObjectMeta: metav1.ObjectMeta{Name: "trigger-main-deployment"}}
+
+	selector := &metav1.LabelSelector{MatchLabels: map[string]string{"app": "controller"}}
+	kubeClient := kubefake.NewSimpleClientset(
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "tekton-pipelines-controller", Namespace: "tekton-pipelines"},
+			Spec:       appsv1.DeploymentSpec{Selector: selector},
+		},
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "controller-1", Namespace: "tekton-pipelines", Labels: map[string]string{"app": "controller"}}},
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "webhook-1", Namespace: "tekton-pipelines", Labels: map[string]string{"app": "webhook"}}},
+	)
+	operatorClient := operatorfake.NewSimpleClientset(tp, set, other)
+	cm := resource("v1", "ConfigMap", "config-defaults")
+	mfClient := mffake.New(&cm)
+
+	r := &Reconciler{
+		operatorClientSet: operatorClient,
+		kinds:             map[string]Kind{CommonKinds[0].Kind: CommonKinds[0]},
+		actions: &actions{
+			kubeClientSet:     kubeClient,
+			operatorClientSet: operatorClient,
+			mfClient:          mfClient,
+			now:               func() time.Time { return testTime },
+		},
+	}
+	assert.NilError(t, r.Promote(pkgreconciler.UniversalBucket(), func(pkgreconciler.Bucket, types.NamespacedName) {}))
+	return &fixture{reconciler: r, kubeClient: kubeClient, operatorClient: operatorClient, mfClient: mfClient}
+}
+
+func (f *fixture) reconcile(t *testing.T) *v1alpha1.TektonPipeline {
+	t.Helper()
+	ctx := context.TODO()
+	assert.NilError(t, f.reconciler.Reconcile(ctx, "TektonPipeline/"+v1alpha1.PipelineResourceName))
+	tp, err := f.operatorClient.OperatorV1alpha1().TektonPipelines().Get(ctx, v1alpha1.PipelineResourceName, metav1.GetOptions{})
+	assert.NilError(t, err)
+	_, found := tp.Annotations[ActionAnnotation]
+	assert.Assert(t, !found, "the action annotation should be removed")
+	return tp
+}
+
+func TestReconcileRestart(t *testing.T) {
+	f := newFixture(t, ActionRestart)
+	tp := f.reconcile(t)
+	assert.Equal(t, tp.Annotations[LastActionAnnotation], "restart@2026-01-02T03:04:05Z")
+
+	pods, err := f.kubeClient.CoreV1().Pods("tekton-pipelines").List(context.TODO(), metav1.ListOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, len(pods.Items), 1)
+	assert.Equal(t, pods.Items[0].Name, "webhook-1")
+}
+
+func TestReconcileResync(t *testing.T) {
+	f := newFixture(t, ActionResync)
+	f.reconcile(t)
+
+	cm := resource("v1", "ConfigMap", "config-defaults")
+	live, err := f.mfClient.Get(&cm)
+	assert.NilError(t, err)
+	_, found := live.GetAnnotations()[v1alpha1.LastAppliedHashKey]
+	assert.Assert(t, !found)
+
+	set, err := f.operatorClient.OperatorV1alpha1().TektonInstallerSets().Get(context.TODO(), "pipeline-main-deployment", metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, set.Annotations[resyncAnnotation], "2026-01-02T03:04:05Z")
+}
+
+func TestReconcileReset(t *testing.T) {
+	f := newFixture(t, ActionReset)
+	f.reconcile(t)
+
+	_, err := f.operatorClient.OperatorV1alpha1().TektonInstallerSets().Get(context.TODO(), "pipeline-main-deployment", metav1.GetOptions{})
+	assert.Assert(t, apierrors.IsNotFound(err))
+	// installer sets of other components are kept
+	_, err = f.operatorClient.OperatorV1alpha1().TektonInstallerSets().Get(context.TODO(), "trigger-main-deployment", metav1.GetOptions{})
+	assert.NilError(t, err)
+}
+
+func TestReconcileUnsupportedAction(t *testing.T) {
+	f := newFixture(t, "reboot")
+	tp := f.reconcile(t)
+	assert.Equal(t, tp.Annotations[LastActionAnnotation], "reboot@2026-01-02T03:04:05Z: unsupported action")
+
+	pods, err := f.kubeClient.CoreV1().Pods("tekton-pipelines").List(context.TODO(), metav1.ListOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, len(pods.Items), 2)
+}
@@ -0,0 +1,222 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitsource
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	mfc "github.com/manifestival/client-go-client"
+	mf "github.com/manifestival/manifestival"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
+	"go.uber.org/zap"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	kubeclient "knative.dev/pkg/client/injection/kube/client"
+	"knative.dev/pkg/configmap"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/injection"
+	"knative.dev/pkg/logging"
+	pkgreconciler "knative.dev/pkg/reconciler"
+	"knative.dev/pkg/system"
+)
+
+const (
+	// CommitAnnotation is set on the resources applied from the Git source, and on the
+	// ConfigMap configuring it, to the commit last applied
+	CommitAnnotation = "operator.tekton.dev/git-source-commit"
+	// SyncedAtAnnotation is set on the ConfigMap configuring the Git source to the time of the last sync
+	SyncedAtAnnotation = "operator.tekton.dev/git-source-synced-at"
+	// ErrorAnnotation is set on the ConfigMap configuring the Git source when the last sync failed
+	ErrorAnnotation = "operator.tekton.dev/git-source-error"
+
+	httpTimeout = 30 * time.Second
+)
+
+// Reconciler periodically applies the TektonConfig and the component custom resources
+// defined in a Git repository, the resources are applied again on each sync so that
+// changes done in the cluster are reverted
+type Reconciler struct {
+	pkgreconciler.LeaderAwareFuncs
+
+	kubeClientSet kubernetes.Interface
+	mfClient      mf.Client
+	httpClient    *http.Client
+	now           func() time.Time
+}
+
+var _ controller.Reconciler = (*Reconciler)(nil)
+
+// NewController returns the controller syncing the resources from the Git source, there is a
+// single key which is requeued after the sync interval
+func NewController(ctx context.Context, cmw configmap.Watcher) *controller.Impl {
+	logger := logging.FromContext(ctx)
+
+	mfclient, err := mfc.NewClient(injection.GetConfig(ctx))
+	if err != nil {
+		logger.Fatalw("Error creating client from injected config", zap.Error(err))
+	}
+
+	r := &Reconciler{
+		kubeClientSet: kubeclient.Get(ctx),
+		mfClient:      mfclient,
+		httpClient:    &http.Client{Timeout: httpTimeout},
+		now:           time.Now,
+	}
+	impl := controller.NewContext(ctx, r, controller.ControllerOptions{WorkQueueName: "GitSource", Logger: logger})
+
+	// the sync runs on the leader, it is started on promotion
+	r.PromoteFunc = func(bkt pkgreconciler.Bucket, enq func(pkgreconciler.Bucket, types.NamespacedName)) error {
+		enq(bkt, types.NamespacedName{Namespace: system.Namespace(), Name: ConfigMapName})
+		return nil
+	}
+	return impl
+}
+
+// Reconcile syncs the resources from the Git source configured in the ConfigMap, and records
+// the outcome in the annotations of the ConfigMap
+func (r *Reconciler) Reconcile(ctx context.Context, key string) error {
+	logger := logging.FromContext(ctx)
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		logger.Errorf("invalid resource key: %s", key)
+		return nil
+	}
+	if !r.IsLeaderFor(types.NamespacedName{Namespace: namespace, Name: name}) {
+		return nil
+	}
+
+	cm, err := r.kubeClientSet.CoreV1().ConfigMaps(namespace).Get(ctx, name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		// the Git source is disabled, check again later whether it was configured
+		return controller.NewRequeueAfter(defaultInterval)
+	}
+	if err != nil {
+		return err
+	}
+
+	interval := defaultInterval
+	config, err := ParseConfig(cm)
+	commit := cm.Annotations[CommitAnnotation]
+	if err == nil {
+		interval = config.Interval
+		var synced string
+		if synced, err = r.sync(ctx, namespace, config); err == nil {
+			commit = synced
+		}
+	}
+	if err != nil {
+		logger.Errorf("failed to sync the resources from the Git source: %v", err)
+	}
+	if err := r.recordStatus(ctx, namespace, commit, err); err != nil {
+		return err
+	}
+	return controller.NewRequeueAfter(interval)
+}
+
+// sync applies the resources of the file at the commit of the ref and returns the commit
+func (r *Reconciler) sync(ctx context.Context, namespace string, config *Config) (string, error) {
+	f := &fetcher{client: r.httpClient}
+	if config.SecretName != "" {
+		secret, err := r.kubeClientSet.CoreV1().Secrets(namespace).Get(ctx, config.SecretName, metav1.GetOptions{})
+		if err != nil {
+			return "", fmt.Errorf("failed to get the credentials of the Git source: %w", err)
+		}
+		f.credentials = credentialsFromSecret(secret)
+	}
+
+	commit, err := f.resolveCommit(ctx, config.URL, config.Ref)
+	if err != nil {
+		return "", err
+	}
+	content, err := r.fileAt(ctx, f, namespace, config, commit)
+	if err != nil {
+		return "", err
+	}
+
+	manifest, err := mf.ManifestFrom(mf.Reader(bytes.NewReader(content)), mf.UseClient(r.mfClient))
+	if err != nil {
+		return "", fmt.Errorf("failed to parse %s at %s: %w", config.Path, commit, err)
+	}
+	for _, res := range manifest.Resources() {
+		if res.GroupVersionKind().Group != v1alpha1.SchemeGroupVersion.Group || res.GetNamespace() != "" {
+			return "", fmt.Errorf("%s at %s: only the cluster scoped %s resources are supported, found %s %s",
+				config.Path, commit, v1alpha1.SchemeGroupVersion.Group, res.GetKind(), res.GetName())
+		}
+	}
+	manifest, err = manifest.Transform(annotateCommit(commit))
+	if err != nil {
+		return "", err
+	}
+	if err := manifest.Apply(); err != nil {
+		return "", err
+	}
+	logging.FromContext(ctx).Debugf("applied %d resources from %s at %s", len(manifest.Resources()), config.Path, commit)
+	return commit, nil
+}
+
+// fileAt returns the content of the file at the commit, it is downloaded once into the payload
+// cache of the operator namespace
+func (r *Reconciler) fileAt(ctx context.Context, f *fetcher, namespace string, config *Config, commit string) ([]byte, error) {
+	fileURL := strings.NewReplacer("{commit}", commit, "{path}", config.Path).Replace(config.RawURL)
+	return common.FetchPayload(ctx, common.NewPayloadCache(r.kubeClientSet, namespace), fileURL, "", f.get)
+}
+
+func annotateCommit(commit string) mf.Transformer {
+	return func(u *unstructured.Unstructured) error {
+		annotations := u.GetAnnotations()
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[CommitAnnotation] = commit
+		u.SetAnnotations(annotations)
+		return nil
+	}
+}
+
+// recordStatus sets the commit applied, the time of the sync and the error of the sync on the ConfigMap
+func (r *Reconciler) recordStatus(ctx context.Context, namespace, commit string, syncErr error) error {
+	annotations := map[string]interface{}{
+		SyncedAtAnnotation: r.now().UTC().Format(time.RFC3339),
+		ErrorAnnotation:    nil,
+	}
+	if commit != "" {
+		annotations[CommitAnnotation] = commit
+	}
+	if syncErr != nil {
+		annotations[ErrorAnnotation] = syncErr.Error()
+	}
+	patch, err := json.Marshal(map[string]interface{}{"metadata": map[string]interface{}{"annotations": annotations}})
+	if err != nil {
+		return err
+	}
+	_, err = r.kubeClientSet.CoreV1().ConfigMaps(namespace).Patch(ctx, ConfigMapName, types.MergePatchType, patch, metav1.PatchOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
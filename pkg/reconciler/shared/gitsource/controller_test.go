@@ -0,0 +1,274 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitsource
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/manifestival/manifestival/fake"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	"knative.dev/pkg/controller"
+	pkgreconciler "knative.dev/pkg/reconciler"
+)
+
+const (
+	mainCommit = "1111111111111111111111111111111111111111"
+	tagCommit  = "2222222222222222222222222222222222222222"
+)
+
+// pktLine encodes a line of the Git smart HTTP protocol
+func pktLine(s string) string {
+	return fmt.Sprintf("%04x%s", len(s)+4, s)
+}
+
+var advertisedRefs = pktLine("# service=git-upload-pack\n") + "0000" +
+	pktLine(mainCommit+" HEAD\x00multi_ack side-band-64k\n") +
+	pktLine(mainCommit+" refs/heads/main\n") +
+	pktLine("3333333333333333333333333333333333333333 refs/tags/v1.0\n") +
+	pktLine(tagCommit+" refs/tags/v1.0^{}\n") +
+	"0000"
+
+func TestParseAdvertisedRefs(t *testing.T) {
+	refs, err := parseAdvertisedRefs([]byte(advertisedRefs))
+	assert.NilError(t, err)
+	assert.DeepEqual(t, refs, map[string]string{
+		"HEAD":              mainCommit,
+		"refs/heads/main":   mainCommit,
+		"refs/tags/v1.0":    "3333333333333333333333333333333333333333",
+		"refs/tags/v1.0^{}": tagCommit,
+	})
+
+	_, err = parseAdvertisedRefs([]byte("zzzz"))
+	assert.ErrorContains(t, err, "invalid ref advertisement")
+}
+
+func TestParseConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    map[string]string
+		want    *Config
+		wantErr string
+	}{
+		{
+			name: "defaults for a github repository",
+			data: map[string]string{"url": "https://github.com/org/config.git"},
+			want: &Config{
+				URL:      "https://github.com/org/config.git",
+				Ref:      "main",
+				Path:     "tektonconfig.yaml",
+				RawURL:   "https://raw.githubusercontent.com/org/config/{commit}/{path}",
+				Interval: 5 * time.Minute,
+			},
+		},
+		{
+			name: "self hosted repository",
+			data: map[string]string{
+				"url":        "https://git.example.com/org/config",
+				"ref":        "v1.0",
+				"path":       "/clusters/prod/tekton.yaml",
+				"rawURL":     "https://git.example.com/org/config/raw/{commit}/{path}",
+				"interval":   "10m",
+				"secretName": "git-credentials",
+			},
+			want: &Config{
+				URL:        "https://git.example.com/org/config",
+				Ref:        "v1.0",
+				Path:       "clusters/prod/tekton.yaml",
+				RawURL:     "https://git.example.com/org/config/raw/{commit}/{path}",
+				Interval:   10 * time.Minute,
+				SecretName: "git-credentials",
+			},
+		},
+		{
+			name:    "missing url",
+			data:    map[string]string{},
+			wantErr: "url is required",
+		},
+		{
+			name:    "raw url required for unknown hosts",
+			data:    map[string]string{"url": "https://git.example.com/org/config"},
+			wantErr: "rawURL is required for repositories hosted on git.example.com",
+		},
+		{
+			name:    "interval too short",
+			data:    map[string]string{"url": "https://github.com/org/config", "interval": "1s"},
+			wantErr: `interval must be a duration of at least 30s: "1s"`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			config, err := ParseConfig(&corev1.ConfigMap{Data: test.data})
+			if test.wantErr != "" {
+				assert.Error(t, err, test.wantErr)
+				return
+			}
+			assert.NilError(t, err)
+			assert.DeepEqual(t, config, test.want)
+		})
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	ctx := context.TODO()
+	content := `apiVersion: operator.tekton.dev/v1alpha1
+kind: TektonConfig
+metadata:
+  name: config
+spec:
+  profile: basic
+  targetNamespace: tekton-pipelines
+`
+	fetches := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		user, password, _ := req.BasicAuth()
+		if user != "git" || password != "secret-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch {
+		case req.URL.Path == "/org/config/info/refs" && req.URL.Query().Get("service") == "git-upload-pack":
+			_, _ = w.Write([]byte(advertisedRefs))
+		case req.URL.Path == "/raw/"+mainCommit+"/tekton/config.yaml" || req.URL.Path == "/raw/"+tagCommit+"/tekton/config.yaml":
+			fetches++
+			_, _ = w.Write([]byte(content))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	kubeClient := kubefake.NewSimpleClientset(
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: ConfigMapName, Namespace: "tekton-operator"},
+			Data: map[string]string{
+				"url":        server.URL + "/org/config",
+				"path":       "tekton/config.yaml",
+				"rawURL":     server.URL + "/raw/{commit}/{path}",
+				"secretName": "git-credentials",
+			},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "git-credentials", Namespace: "tekton-operator"},
+			Data:       map[string][]byte{"token": []byte("secret-token")},
+		},
+	)
+	mfClient := fake.New()
+	r := &Reconciler{
+		kubeClientSet: kubeClient,
+		mfClient:      mfClient,
+		httpClient:    server.Client(),
+		now:           func() time.Time { return time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC) },
+	}
+	assert.NilError(t, r.Promote(pkgreconciler.UniversalBucket(), func(pkgreconciler.Bucket, types.NamespacedName) {}))
+
+	key := "tekton-operator/" + ConfigMapName
+	err := r.Reconcile(ctx, key)
+	requeue, _ := controller.IsRequeueKey(err)
+	assert.Assert(t, requeue)
+
+	tc := &unstructured.Unstructured{}
+	tc.SetGroupVersionKind(schema.GroupVersionKind{Group: "operator.tekton.dev", Version: "v1alpha1", Kind: "TektonConfig"})
+	tc.SetName("config")
+	applied, err := mfClient.Get(tc)
+	assert.NilError(t, err)
+	assert.Equal(t, applied.GetAnnotations()[CommitAnnotation], mainCommit)
+	profile, _, _ := unstructured.NestedString(applied.Object, "spec", "profile")
+	assert.Equal(t, profile, "basic")
+
+	cm, err := kubeClient.CoreV1().ConfigMaps("tekton-operator").Get(ctx, ConfigMapName, metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, cm.Annotations[CommitAnnotation], mainCommit)
+	assert.Equal(t, cm.Annotations[SyncedAtAnnotation], "2026-01-01T00:00:00Z")
+	_, hasError := cm.Annotations[ErrorAnnotation]
+	assert.Assert(t, !hasError)
+
+	// changes done in the cluster are reverted, the file is not fetched again for the same commit
+	assert.NilError(t, unstructured.SetNestedField(applied.Object, "all", "spec", "profile"))
+	assert.NilError(t, mfClient.Update(applied))
+	err = r.Reconcile(ctx, key)
+	requeue, _ = controller.IsRequeueKey(err)
+	assert.Assert(t, requeue)
+	applied, err = mfClient.Get(tc)
+	assert.NilError(t, err)
+	profile, _, _ = unstructured.NestedString(applied.Object, "spec", "profile")
+	assert.Equal(t, profile, "basic")
+	assert.Equal(t, fetches, 1)
+
+	// a restarted operator reads the file from the payload cache
+	restarted := &Reconciler{kubeClientSet: kubeClient, mfClient: mfClient, httpClient: server.Client(), now: r.now}
+	assert.NilError(t, restarted.Promote(pkgreconciler.UniversalBucket(), func(pkgreconciler.Bucket, types.NamespacedName) {}))
+	err = restarted.Reconcile(ctx, key)
+	requeue, _ = controller.IsRequeueKey(err)
+	assert.Assert(t, requeue)
+	assert.Equal(t, fetches, 1)
+
+	// resources outside of the operator API are rejected and the error is recorded
+	content = "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: other\n  namespace: default\n"
+	cm.Data["ref"] = "v1.0"
+	_, err = kubeClient.CoreV1().ConfigMaps("tekton-operator").Update(ctx, cm, metav1.UpdateOptions{})
+	assert.NilError(t, err)
+	err = r.Reconcile(ctx, key)
+	requeue, _ = controller.IsRequeueKey(err)
+	assert.Assert(t, requeue)
+	cm, err = kubeClient.CoreV1().ConfigMaps("tekton-operator").Get(ctx, ConfigMapName, metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Assert(t, strings.Contains(cm.Annotations[ErrorAnnotation], "only the cluster scoped operator.tekton.dev resources are supported, found ConfigMap other"))
+	// the commit last applied is kept
+	assert.Equal(t, cm.Annotations[CommitAnnotation], mainCommit)
+}
+
+func TestReconcileDisconnected(t *testing.T) {
+	ctx := context.TODO()
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+
+	kubeClient := kubefake.NewSimpleClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: ConfigMapName, Namespace: "tekton-operator"},
+		Data: map[string]string{
+			"url":    server.URL + "/org/config",
+			"ref":    mainCommit,
+			"rawURL": server.URL + "/raw/{commit}/{path}",
+		},
+	})
+	r := &Reconciler{
+		kubeClientSet: kubeClient,
+		mfClient:      fake.New(),
+		httpClient:    server.Client(),
+		now:           func() time.Time { return time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC) },
+	}
+	assert.NilError(t, r.Promote(pkgreconciler.UniversalBucket(), func(pkgreconciler.Bucket, types.NamespacedName) {}))
+
+	// the file at the pinned commit is neither cached nor downloadable
+	err := r.Reconcile(ctx, "tekton-operator/"+ConfigMapName)
+	requeue, _ := controller.IsRequeueKey(err)
+	assert.Assert(t, requeue)
+	cm, err := kubeClient.CoreV1().ConfigMaps("tekton-operator").Get(ctx, ConfigMapName, metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Assert(t, strings.Contains(cm.Annotations[ErrorAnnotation], "payload missing from cache"), cm.Annotations[ErrorAnnotation])
+}
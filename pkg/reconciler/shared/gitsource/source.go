@@ -0,0 +1,231 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitsource
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// ConfigMapName is the ConfigMap in the operator namespace configuring the Git source
+	ConfigMapName = "tekton-config-git-source"
+
+	urlKey        = "url"
+	refKey        = "ref"
+	pathKey       = "path"
+	rawURLKey     = "rawURL"
+	intervalKey   = "interval"
+	secretNameKey = "secretName"
+
+	defaultRef      = "main"
+	defaultPath     = "tektonconfig.yaml"
+	defaultInterval = 5 * time.Minute
+	minInterval     = 30 * time.Second
+
+	// the keys of the Secret holding the credentials of the repository
+	usernameKey     = "username"
+	passwordKey     = "password"
+	tokenKey        = "token"
+	defaultUsername = "git"
+)
+
+var commitRegex = regexp.MustCompile(`^[0-9a-f]{40}$`)
+
+// Config is the Git repository and the path of the file holding the TektonConfig and the
+// component custom resources
+type Config struct {
+	URL string
+	Ref string
+	// Path of the file in the repository, it can hold several YAML documents
+	Path string
+	// RawURL is the URL of the file at a commit, {commit} and {path} are replaced by the
+	// commit and the path. It is computed for github.com and gitlab.com repositories.
+	RawURL   string
+	Interval time.Duration
+	// SecretName is the Secret in the operator namespace holding the credentials
+	SecretName string
+}
+
+// ParseConfig reads the Git source configuration from the ConfigMap
+func ParseConfig(cm *corev1.ConfigMap) (*Config, error) {
+	config := &Config{
+		URL:        strings.TrimSuffix(strings.TrimSpace(cm.Data[urlKey]), "/"),
+		Ref:        defaultRef,
+		Path:       defaultPath,
+		RawURL:     strings.TrimSpace(cm.Data[rawURLKey]),
+		Interval:   defaultInterval,
+		SecretName: strings.TrimSpace(cm.Data[secretNameKey]),
+	}
+	if config.URL == "" {
+		return nil, fmt.Errorf("%s is required", urlKey)
+	}
+	u, err := url.Parse(config.URL)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		return nil, fmt.Errorf("%s must be the http(s) URL of a Git repository: %q", urlKey, config.URL)
+	}
+	if v := strings.TrimSpace(cm.Data[refKey]); v != "" {
+		config.Ref = v
+	}
+	if v := strings.Trim(strings.TrimSpace(cm.Data[pathKey]), "/"); v != "" {
+		config.Path = v
+	}
+	if v := strings.TrimSpace(cm.Data[intervalKey]); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d < minInterval {
+			return nil, fmt.Errorf("%s must be a duration of at least %s: %q", intervalKey, minInterval, v)
+		}
+		config.Interval = d
+	}
+	if config.RawURL == "" {
+		if config.RawURL, err = defaultRawURL(u); err != nil {
+			return nil, err
+		}
+	}
+	return config, nil
+}
+
+// defaultRawURL returns the URL of the files at a commit for the known Git hosts
+func defaultRawURL(u *url.URL) (string, error) {
+	repoPath := strings.TrimSuffix(strings.Trim(u.Path, "/"), ".git")
+	switch u.Host {
+	case "github.com":
+		return "https://raw.githubusercontent.com/" + repoPath + "/{commit}/{path}", nil
+	case "gitlab.com":
+		return "https://gitlab.com/" + repoPath + "/-/raw/{commit}/{path}", nil
+	default:
+		return "", fmt.Errorf("%s is required for repositories hosted on %s", rawURLKey, u.Host)
+	}
+}
+
+// credentials are used with basic authentication for the repository and the raw files
+type credentials struct {
+	username string
+	password string
+}
+
+func credentialsFromSecret(secret *corev1.Secret) *credentials {
+	c := &credentials{username: string(secret.Data[usernameKey]), password: string(secret.Data[passwordKey])}
+	if c.password == "" {
+		c.password = string(secret.Data[tokenKey])
+	}
+	if c.username == "" {
+		c.username = defaultUsername
+	}
+	return c
+}
+
+// fetcher reads the commit of a ref and the files of a Git repository over HTTP
+type fetcher struct {
+	client      *http.Client
+	credentials *credentials
+}
+
+func (f *fetcher) get(ctx context.Context, rawURL string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	if f.credentials != nil {
+		req.SetBasicAuth(f.credentials.username, f.credentials.password)
+	}
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %s: %s", rawURL, resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// resolveCommit returns the commit of the ref, from the refs advertised by the repository
+// over the Git smart HTTP protocol. A ref which is already a commit is returned as it is.
+func (f *fetcher) resolveCommit(ctx context.Context, repoURL, ref string) (string, error) {
+	if commitRegex.MatchString(ref) {
+		return ref, nil
+	}
+	body, err := f.get(ctx, repoURL+"/info/refs?service=git-upload-pack")
+	if err != nil {
+		return "", err
+	}
+	refs, err := parseAdvertisedRefs(body)
+	if err != nil {
+		return "", err
+	}
+	for _, name := range []string{ref, "refs/heads/" + ref, "refs/tags/" + ref} {
+		// the commit of an annotated tag is advertised as the peeled ref
+		if commit, ok := refs[name+"^{}"]; ok {
+			return commit, nil
+		}
+		if commit, ok := refs[name]; ok {
+			return commit, nil
+		}
+	}
+	return "", fmt.Errorf("ref %s not found in %s", ref, repoURL)
+}
+
+// parseAdvertisedRefs parses the pkt-lines of a git-upload-pack ref advertisement
+func parseAdvertisedRefs(body []byte) (map[string]string, error) {
+	refs := map[string]string{}
+	r := bufio.NewReader(bytes.NewReader(body))
+	for {
+		length := make([]byte, 4)
+		if _, err := io.ReadFull(r, length); err != nil {
+			if err == io.EOF {
+				return refs, nil
+			}
+			return nil, fmt.Errorf("invalid ref advertisement: %w", err)
+		}
+		n, err := strconv.ParseUint(string(length), 16, 16)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ref advertisement: pkt-line length %q", length)
+		}
+		// flush-pkt
+		if n == 0 {
+			continue
+		}
+		if n < 4 {
+			return nil, fmt.Errorf("invalid ref advertisement: pkt-line length %d", n)
+		}
+		line := make([]byte, n-4)
+		if _, err := io.ReadFull(r, line); err != nil {
+			return nil, fmt.Errorf("invalid ref advertisement: %w", err)
+		}
+		// the capabilities follow the first ref after a NUL byte
+		content, _, _ := strings.Cut(strings.TrimSuffix(string(line), "\n"), "\x00")
+		if strings.HasPrefix(content, "#") {
+			continue
+		}
+		commit, name, ok := strings.Cut(content, " ")
+		if ok && commitRegex.MatchString(commit) {
+			refs[name] = commit
+		}
+	}
+}
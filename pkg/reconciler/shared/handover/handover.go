@@ -0,0 +1,238 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package handover coordinates the replicas of different versions of the operator during its
+// upgrades. The replicas of the new version claim the handover Lease before they start the
+// migrations, and renew it. The replicas of older versions are fenced while the Lease is renewed:
+// they stop reconciling, so that they cannot revert the migrations of the new version.
+package handover
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"golang.org/x/mod/semver"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubeinformers "k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	coordinationlisters "k8s.io/client-go/listers/coordination/v1"
+	"knative.dev/pkg/logging"
+)
+
+const (
+	// LeaseName is the Lease of the operator namespace claimed by the version of the operator
+	// running the migrations
+	LeaseName = "tekton-operator-handover"
+	// VersionAnnotation on the Lease holds the version of the operator which claimed it
+	VersionAnnotation = "operator.tekton.dev/operator-version"
+
+	// LeaseDuration is the duration after which a Lease which is not renewed no longer fences
+	// the older versions, e.g. after a rollback of the upgrade
+	LeaseDuration = 60 * time.Second
+	// renewInterval is the interval at which the replicas of the version of the Lease renew it
+	renewInterval = LeaseDuration / 3
+	// GracePeriod is the time given to the replicas of the previous version to observe the
+	// claim of the Lease, before the migrations start
+	GracePeriod = 10 * time.Second
+)
+
+// Handover claims, renews and observes the handover Lease for a replica of the operator
+type Handover struct {
+	kubeClientSet kubernetes.Interface
+	namespace     string
+	identity      string
+	version       string
+	now           func() time.Time
+	lister        coordinationlisters.LeaseNamespaceLister
+}
+
+// New returns the Handover of the replica identity of the operator version, the Lease is in the
+// namespace of the operator
+func New(kubeClientSet kubernetes.Interface, namespace, identity, version string) *Handover {
+	return &Handover{
+		kubeClientSet: kubeClientSet,
+		namespace:     namespace,
+		identity:      identity,
+		version:       version,
+		now:           time.Now,
+	}
+}
+
+// Start watches the Lease, waits for its cache to be synced, and renews the Lease while it is
+// claimed by the version of the replica until the context is done
+func (h *Handover) Start(ctx context.Context) error {
+	factory := kubeinformers.NewSharedInformerFactoryWithOptions(h.kubeClientSet, 0,
+		kubeinformers.WithNamespace(h.namespace),
+		kubeinformers.WithTweakListOptions(func(o *metav1.ListOptions) {
+			o.FieldSelector = "metadata.name=" + LeaseName
+		}))
+	informer := factory.Coordination().V1().Leases()
+	h.lister = informer.Lister().Leases(h.namespace)
+	informer.Informer()
+	factory.Start(ctx.Done())
+	for t, synced := range factory.WaitForCacheSync(ctx.Done()) {
+		if !synced {
+			return fmt.Errorf("failed to sync the cache of %s", t)
+		}
+	}
+	go h.renew(ctx)
+	return nil
+}
+
+func (h *Handover) renew(ctx context.Context) {
+	logger := logging.FromContext(ctx)
+	ticker := time.NewTicker(renewInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		lease, err := h.lister.Get(LeaseName)
+		if err != nil || lease.Annotations[VersionAnnotation] != h.version {
+			continue
+		}
+		// the replicas of the version keep the Lease alive when the replica which claimed it stops
+		lease = lease.DeepCopy()
+		now := metav1.NewMicroTime(h.now())
+		lease.Spec.HolderIdentity = &h.identity
+		lease.Spec.RenewTime = &now
+		if _, err := h.kubeClientSet.CoordinationV1().Leases(h.namespace).Update(ctx, lease, metav1.UpdateOptions{}); err != nil {
+			logger.Debugf("failed to renew the handover lease %s/%s: %v", h.namespace, LeaseName, err)
+		}
+	}
+}
+
+// Fenced returns the newer version of the operator which claimed the Lease and renews it, the
+// replicas of the older versions must not reconcile. A Handover which was not started, or nil,
+// is never fenced.
+func (h *Handover) Fenced() (string, bool) {
+	if h == nil || h.lister == nil {
+		return "", false
+	}
+	lease, err := h.lister.Get(LeaseName)
+	if err != nil {
+		return "", false
+	}
+	version := lease.Annotations[VersionAnnotation]
+	if !Newer(version, h.version) || expired(lease, h.now()) {
+		return "", false
+	}
+	return version, true
+}
+
+// Claim claims the Lease for the version of the replica before it starts the migrations. It
+// returns true once the replicas of the previous version had the grace period to observe the
+// claim, and an error when the Lease is claimed and renewed by a newer version.
+func (h *Handover) Claim(ctx context.Context) (bool, error) {
+	if h == nil {
+		return true, nil
+	}
+	logger := logging.FromContext(ctx)
+	leases := h.kubeClientSet.CoordinationV1().Leases(h.namespace)
+	now := metav1.NewMicroTime(h.now())
+	lease, err := leases.Get(ctx, LeaseName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		// the replicas of the versions which do not know the Lease are not waited for
+		_, err := leases.Create(ctx, h.lease(&coordinationv1.Lease{}, now), metav1.CreateOptions{})
+		return err == nil, err
+	}
+	if err != nil {
+		return false, err
+	}
+
+	version := lease.Annotations[VersionAnnotation]
+	switch {
+	case version == h.version:
+		return !h.now().Before(lease.Spec.AcquireTime.Add(GracePeriod)), nil
+	case Newer(version, h.version) && !expired(lease, h.now()):
+		return false, fmt.Errorf("the operator %s is fenced, the handover lease is claimed by the operator %s", h.version, version)
+	}
+	logger.Infof("claiming the handover lease %s/%s from the operator %s for the operator %s", h.namespace, LeaseName, version, h.version)
+	if _, err := leases.Update(ctx, h.lease(lease.DeepCopy(), now), metav1.UpdateOptions{}); err != nil {
+		return false, err
+	}
+	return false, nil
+}
+
+// lease sets the claim of the replica on the Lease
+func (h *Handover) lease(lease *coordinationv1.Lease, now metav1.MicroTime) *coordinationv1.Lease {
+	lease.Name = LeaseName
+	lease.Namespace = h.namespace
+	if lease.Annotations == nil {
+		lease.Annotations = map[string]string{}
+	}
+	lease.Annotations[VersionAnnotation] = h.version
+	duration := int32(LeaseDuration.Seconds())
+	lease.Spec = coordinationv1.LeaseSpec{
+		HolderIdentity:       &h.identity,
+		LeaseDurationSeconds: &duration,
+		AcquireTime:          &now,
+		RenewTime:            &now,
+	}
+	return lease
+}
+
+// expired returns true when the Lease was not renewed within its duration
+func expired(lease *coordinationv1.Lease, now time.Time) bool {
+	renewed := lease.Spec.RenewTime
+	if renewed == nil {
+		renewed = lease.Spec.AcquireTime
+	}
+	if renewed == nil {
+		return true
+	}
+	duration := LeaseDuration
+	if lease.Spec.LeaseDurationSeconds != nil {
+		duration = time.Duration(*lease.Spec.LeaseDurationSeconds) * time.Second
+	}
+	return now.After(renewed.Add(duration))
+}
+
+// Newer returns true when the version is a newer semantic version than the other version, the
+// versions which are not semantic versions, e.g. devel, are never newer
+func Newer(version, other string) bool {
+	v, o := canonical(version), canonical(other)
+	if !semver.IsValid(v) || !semver.IsValid(o) {
+		return false
+	}
+	return semver.Compare(v, o) > 0
+}
+
+func canonical(version string) string {
+	if version != "" && !strings.HasPrefix(version, "v") {
+		return "v" + version
+	}
+	return version
+}
+
+type handoverKey struct{}
+
+// WithHandover returns a context holding the Handover of the replica
+func WithHandover(ctx context.Context, h *Handover) context.Context {
+	return context.WithValue(ctx, handoverKey{}, h)
+}
+
+// FromContext returns the Handover of the replica, nil when there is none
+func FromContext(ctx context.Context) *Handover {
+	h, _ := ctx.Value(handoverKey{}).(*Handover)
+	return h
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handover
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	coordinationlisters "k8s.io/client-go/listers/coordination/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+const namespace = "tekton-operator"
+
+func newHandover(t *testing.T, client *fake.Clientset, version string, now *time.Time) *Handover {
+	t.Helper()
+	h := New(client, namespace, "operator-"+version, version)
+	h.now = func() time.Time { return *now }
+	return h
+}
+
+func getLease(t *testing.T, client *fake.Clientset) *coordinationv1.Lease {
+	t.Helper()
+	lease, err := client.CoordinationV1().Leases(namespace).Get(context.TODO(), LeaseName, metav1.GetOptions{})
+	assert.NilError(t, err)
+	return lease
+}
+
+func TestClaim(t *testing.T) {
+	ctx := context.TODO()
+	client := fake.NewSimpleClientset()
+	now := time.Date(2026, 10, 15, 8, 0, 0, 0, time.UTC)
+	previous := newHandover(t, client, "0.76.0", &now)
+	current := newHandover(t, client, "v0.77.0", &now)
+
+	// the first claim of the Lease is ready
+	ready, err := previous.Claim(ctx)
+	assert.NilError(t, err)
+	assert.Assert(t, ready)
+	assert.Equal(t, getLease(t, client).Annotations[VersionAnnotation], "0.76.0")
+
+	// the newer version takes the Lease over, and waits for the grace period
+	ready, err = current.Claim(ctx)
+	assert.NilError(t, err)
+	assert.Assert(t, !ready)
+	lease := getLease(t, client)
+	assert.Equal(t, lease.Annotations[VersionAnnotation], "v0.77.0")
+	assert.Equal(t, *lease.Spec.HolderIdentity, "operator-v0.77.0")
+	ready, err = current.Claim(ctx)
+	assert.NilError(t, err)
+	assert.Assert(t, !ready)
+	now = now.Add(GracePeriod)
+	ready, err = current.Claim(ctx)
+	assert.NilError(t, err)
+	assert.Assert(t, ready)
+
+	// the previous version cannot claim the Lease while it is renewed
+	_, err = previous.Claim(ctx)
+	assert.ErrorContains(t, err, "the operator 0.76.0 is fenced, the handover lease is claimed by the operator v0.77.0")
+
+	// the previous version claims the Lease once it expired, e.g. after a rollback
+	now = now.Add(2 * LeaseDuration)
+	ready, err = previous.Claim(ctx)
+	assert.NilError(t, err)
+	assert.Assert(t, !ready)
+	assert.Equal(t, getLease(t, client).Annotations[VersionAnnotation], "0.76.0")
+}
+
+func TestClaimWithoutHandover(t *testing.T) {
+	var h *Handover
+	ready, err := h.Claim(context.TODO())
+	assert.NilError(t, err)
+	assert.Assert(t, ready)
+	_, fenced := h.Fenced()
+	assert.Assert(t, !fenced)
+}
+
+func TestFenced(t *testing.T) {
+	now := time.Date(2026, 10, 15, 8, 0, 0, 0, time.UTC)
+	renewed := metav1.NewMicroTime(now)
+	duration := int32(LeaseDuration.Seconds())
+	lease := func(version string) *coordinationv1.Lease {
+		return &coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        LeaseName,
+				Namespace:   namespace,
+				Annotations: map[string]string{VersionAnnotation: version},
+			},
+			Spec: coordinationv1.LeaseSpec{RenewTime: &renewed, LeaseDurationSeconds: &duration},
+		}
+	}
+	tests := []struct {
+		name    string
+		lease   *coordinationv1.Lease
+		elapsed time.Duration
+		fenced  bool
+	}{
+		{name: "no lease"},
+		{name: "same version", lease: lease("0.76.0")},
+		{name: "older version", lease: lease("0.75.1")},
+		{name: "newer version", lease: lease("0.77.0"), fenced: true},
+		{name: "newer version expired", lease: lease("0.77.0"), elapsed: LeaseDuration + time.Second},
+		{name: "devel version", lease: lease("devel")},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+			if test.lease != nil {
+				assert.NilError(t, indexer.Add(test.lease))
+			}
+			h := New(fake.NewSimpleClientset(), namespace, "operator", "0.76.0")
+			h.now = func() time.Time { return now.Add(test.elapsed) }
+			h.lister = coordinationlisters.NewLeaseLister(indexer).Leases(namespace)
+			version, fenced := h.Fenced()
+			assert.Equal(t, fenced, test.fenced)
+			if test.fenced {
+				assert.Equal(t, version, test.lease.Annotations[VersionAnnotation])
+			}
+		})
+	}
+}
+
+func TestNewer(t *testing.T) {
+	assert.Assert(t, Newer("0.77.0", "v0.76.2"))
+	assert.Assert(t, Newer("v1.0.0", "0.77.0"))
+	assert.Assert(t, !Newer("0.76.2", "0.76.2"))
+	assert.Assert(t, !Newer("0.76.0", "0.77.0"))
+	assert.Assert(t, !Newer("devel", "0.77.0"))
+	assert.Assert(t, !Newer("0.77.0", "devel"))
+	assert.Assert(t, !Newer("", "0.77.0"))
+}
+
+func TestContext(t *testing.T) {
+	assert.Assert(t, FromContext(context.TODO()) == nil)
+	h := New(fake.NewSimpleClientset(), namespace, "operator", "0.76.0")
+	assert.Equal(t, FromContext(WithHandover(context.TODO(), h)), h)
+}
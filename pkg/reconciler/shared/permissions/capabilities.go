@@ -0,0 +1,146 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package permissions
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/client-go/kubernetes"
+	"knative.dev/pkg/logging"
+)
+
+// Subsystem is a part of the operator which is disabled when the operator is not granted its
+// permissions, e.g. on managed clusters where the operator does not run as cluster-admin
+type Subsystem string
+
+const (
+	// SubsystemSCC manages the SCCs of the namespaces and the pipelines-scc ClusterRole
+	SubsystemSCC Subsystem = "SCCManagement"
+	// SubsystemClusterRoles creates the ClusterRoles and ClusterRoleBindings of the namespaces,
+	// e.g. the clusterinterceptors ClusterRoleBinding
+	SubsystemClusterRoles Subsystem = "ClusterRoleManagement"
+	// SubsystemNamespacePatching records the reconciled namespaces in their labels and annotations
+	SubsystemNamespacePatching Subsystem = "NamespacePatching"
+	// SubsystemAccessReview verifies that the ServiceAccounts created in the namespaces are granted
+	// the permissions of the pipelines
+	SubsystemAccessReview Subsystem = "AccessReview"
+)
+
+// SubsystemRules are the permissions needed by each subsystem
+var SubsystemRules = map[Subsystem][]rbacv1.PolicyRule{
+	SubsystemSCC: {
+		{APIGroups: []string{"security.openshift.io"}, Resources: []string{"securitycontextconstraints"}, Verbs: []string{"get", "list", "use"}},
+	},
+	SubsystemClusterRoles: {
+		{APIGroups: []string{"rbac.authorization.k8s.io"}, Resources: []string{"clusterroles", "clusterrolebindings"}, Verbs: []string{"get", "list", "create", "update", "delete"}},
+	},
+	SubsystemNamespacePatching: {
+		{APIGroups: []string{""}, Resources: []string{"namespaces"}, Verbs: []string{"patch"}},
+	},
+	SubsystemAccessReview: {
+		{APIGroups: []string{"authorization.k8s.io"}, Resources: []string{"subjectaccessreviews"}, Verbs: []string{"create"}},
+	},
+}
+
+// Capabilities are the subsystems which can be run with the permissions of the operator
+type Capabilities struct {
+	missing map[Subsystem][]string
+}
+
+// Enabled returns true when the operator is granted the permissions of the subsystem. All the
+// subsystems are enabled when the capabilities are not known.
+func (c *Capabilities) Enabled(s Subsystem) bool {
+	return c == nil || len(c.missing[s]) == 0
+}
+
+// Disabled returns the subsystems which are disabled, sorted by name
+func (c *Capabilities) Disabled() []Subsystem {
+	if c == nil {
+		return nil
+	}
+	var disabled []Subsystem
+	for s, missing := range c.missing {
+		if len(missing) > 0 {
+			disabled = append(disabled, s)
+		}
+	}
+	sort.Slice(disabled, func(i, j int) bool { return disabled[i] < disabled[j] })
+	return disabled
+}
+
+// Report returns the disabled subsystems with the permissions they are missing, it is empty when
+// all the subsystems are enabled
+func (c *Capabilities) Report() string {
+	var entries []string
+	for _, s := range c.Disabled() {
+		entries = append(entries, fmt.Sprintf("%s is disabled, the operator is not allowed to %s", s, strings.Join(c.missing[s], ", ")))
+	}
+	return strings.Join(entries, "; ")
+}
+
+// CapabilityProbe reviews the permissions of the subsystems. The capabilities are probed on
+// startup, and again once per checkInterval while subsystems are disabled, so that granting the
+// permissions enables the subsystems without restarting the operator.
+type CapabilityProbe struct {
+	kubeClientSet kubernetes.Interface
+	subsystems    []Subsystem
+	now           func() time.Time
+
+	mutex        sync.Mutex
+	probed       time.Time
+	capabilities *Capabilities
+}
+
+func NewCapabilityProbe(kubeClientSet kubernetes.Interface, subsystems ...Subsystem) *CapabilityProbe {
+	return &CapabilityProbe{kubeClientSet: kubeClientSet, subsystems: subsystems, now: time.Now}
+}
+
+// Capabilities returns the capabilities of the operator, probing them when they are not known or
+// when subsystems were disabled by the last probe more than checkInterval ago. The subsystems are
+// all enabled when the permissions cannot be reviewed, they fail with the errors of the API server.
+func (p *CapabilityProbe) Capabilities(ctx context.Context) *Capabilities {
+	if p == nil {
+		return nil
+	}
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	logger := logging.FromContext(ctx)
+
+	if p.capabilities != nil && (len(p.capabilities.Disabled()) == 0 || p.now().Sub(p.probed) < checkInterval) {
+		return p.capabilities
+	}
+	capabilities := &Capabilities{missing: map[Subsystem][]string{}}
+	for _, s := range p.subsystems {
+		missing, err := missingPermissions(ctx, p.kubeClientSet, SubsystemRules[s])
+		if err != nil {
+			logger.Errorf("failed to probe the permissions of %s: %v", s, err)
+			return p.capabilities
+		}
+		capabilities.missing[s] = missing
+	}
+	if report := capabilities.Report(); report != "" {
+		logger.Warnw("the operator is running without the permissions of some subsystems", "report", report)
+	}
+	p.probed, p.capabilities = p.now(), capabilities
+	return p.capabilities
+}
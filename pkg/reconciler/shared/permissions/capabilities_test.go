@@ -0,0 +1,84 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package permissions
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestCapabilityProbe(t *testing.T) {
+	kubeClient := fake.NewSimpleClientset()
+	denied := map[string]bool{"use security.openshift.io/securitycontextconstraints": true, "patch namespaces": true}
+	reviews := 0
+	kubeClient.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview)
+		reviews++
+		a := attributes{resource: review.Spec.ResourceAttributes, nonResource: review.Spec.NonResourceAttributes}
+		review.Status.Allowed = !denied[a.String()]
+		return true, review, nil
+	})
+	now := time.Now()
+	p := NewCapabilityProbe(kubeClient, SubsystemSCC, SubsystemClusterRoles, SubsystemNamespacePatching)
+	p.now = func() time.Time { return now }
+
+	c := p.Capabilities(context.TODO())
+	assert.Equal(t, reviews, 14)
+	assert.Assert(t, !c.Enabled(SubsystemSCC))
+	assert.Assert(t, c.Enabled(SubsystemClusterRoles))
+	assert.Assert(t, !c.Enabled(SubsystemNamespacePatching))
+	assert.DeepEqual(t, c.Disabled(), []Subsystem{SubsystemNamespacePatching, SubsystemSCC})
+	assert.Equal(t, c.Report(), "NamespacePatching is disabled, the operator is not allowed to patch namespaces; "+
+		"SCCManagement is disabled, the operator is not allowed to use security.openshift.io/securitycontextconstraints")
+
+	// the disabled subsystems are probed again once the interval elapsed
+	denied = map[string]bool{}
+	assert.Equal(t, p.Capabilities(context.TODO()), c)
+	assert.Equal(t, reviews, 14)
+	now = now.Add(checkInterval)
+	c = p.Capabilities(context.TODO())
+	assert.Equal(t, reviews, 28)
+	assert.Equal(t, len(c.Disabled()), 0)
+	assert.Equal(t, c.Report(), "")
+
+	// the enabled subsystems are not probed again
+	now = now.Add(checkInterval)
+	p.Capabilities(context.TODO())
+	assert.Equal(t, reviews, 28)
+}
+
+func TestCapabilityProbeFailure(t *testing.T) {
+	kubeClient := fake.NewSimpleClientset()
+	kubeClient.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.New("forbidden")
+	})
+	p := NewCapabilityProbe(kubeClient, SubsystemSCC)
+
+	// the subsystems are all enabled when the permissions cannot be reviewed
+	c := p.Capabilities(context.TODO())
+	assert.Assert(t, c == nil)
+	assert.Assert(t, c.Enabled(SubsystemSCC))
+	assert.Equal(t, c.Report(), "")
+}
@@ -0,0 +1,226 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package permissions
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	mf "github.com/manifestival/manifestival"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
+	"github.com/tektoncd/operator/pkg/reconciler/platform"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"knative.dev/pkg/logging"
+)
+
+const (
+	// checkInterval is how often the permissions are checked, the rules only change with the operator
+	// version but the roles of the operator can be edited
+	checkInterval = time.Hour
+	// maxReported is the number of missing permissions listed in the condition
+	maxReported = 20
+)
+
+// Checker checks that the operator is granted the permissions it needs and reports the missing
+// permissions in the PermissionsGranted condition of TektonConfig, instead of failing with
+// forbidden errors in the middle of a reconcile
+type Checker struct {
+	kubeClientSet kubernetes.Interface
+	rules         func() ([]rbacv1.PolicyRule, error)
+	now           func() time.Time
+
+	mutex   sync.Mutex
+	checked time.Time
+	missing []string
+}
+
+func New(kubeClientSet kubernetes.Interface, rules func() ([]rbacv1.PolicyRule, error)) *Checker {
+	return &Checker{kubeClientSet: kubeClientSet, rules: rules, now: time.Now}
+}
+
+// PayloadRules returns the rules of the payload manifests of the kodata directory and of the
+// controllers of the process, all the controllers when they are not selected
+func PayloadRules() ([]rbacv1.PolicyRule, error) {
+	manifest, err := mf.ManifestFrom(mf.Recursive(common.ComponentBaseDir()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the payload manifests: %w", err)
+	}
+	var controllers []platform.ControllerName
+	for _, name := range strings.Split(os.Getenv(platform.EnvControllerNames), ",") {
+		if name != "" {
+			controllers = append(controllers, platform.ControllerName(name))
+		}
+	}
+	if len(controllers) == 0 {
+		for name := range ControllerRules {
+			controllers = append(controllers, name)
+		}
+	}
+	return Rules(v1alpha1.IsOpenShiftPlatform(), manifest, controllers...)
+}
+
+// Reconcile marks the PermissionsGranted condition, the permissions are checked at most once per
+// checkInterval. The condition is kept as it is when the permissions cannot be checked.
+func (c *Checker) Reconcile(ctx context.Context, tc *v1alpha1.TektonConfig) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	logger := logging.FromContext(ctx)
+	if c.checked.IsZero() || c.now().Sub(c.checked) >= checkInterval {
+		missing, err := c.Missing(ctx)
+		if err != nil {
+			logger.Errorf("failed to check the permissions of the operator: %v", err)
+			return
+		}
+		if len(missing) > 0 {
+			logger.Errorw("the operator is missing permissions", "permissions", missing)
+		}
+		c.checked, c.missing = c.now(), missing
+	}
+
+	if len(c.missing) == 0 {
+		tc.Status.MarkPermissionsGranted()
+		return
+	}
+	reported := c.missing
+	more := ""
+	if len(reported) > maxReported {
+		reported, more = reported[:maxReported], fmt.Sprintf(" and %d more", len(c.missing)-maxReported)
+	}
+	tc.Status.MarkMissingPermissions(fmt.Sprintf("the operator is not allowed to %s%s", strings.Join(reported, ", "), more))
+}
+
+// Missing returns the permissions of the rules not granted to the operator, e.g. "create apps/deployments"
+func (c *Checker) Missing(ctx context.Context) ([]string, error) {
+	rules, err := c.rules()
+	if err != nil {
+		return nil, err
+	}
+	return missingPermissions(ctx, c.kubeClientSet, rules)
+}
+
+// missingPermissions reviews the access of the operator to each verb and resource of the rules
+func missingPermissions(ctx context.Context, kubeClientSet kubernetes.Interface, rules []rbacv1.PolicyRule) ([]string, error) {
+	return reviewPermissions(rules, func(a attributes) (bool, error) {
+		review, err := kubeClientSet.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, &authorizationv1.SelfSubjectAccessReview{
+			Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+				ResourceAttributes:    a.resource,
+				NonResourceAttributes: a.nonResource,
+			},
+		}, metav1.CreateOptions{})
+		if err != nil {
+			return false, err
+		}
+		return review.Status.Allowed, nil
+	})
+}
+
+// MissingServiceAccountPermissions reviews the access of a ServiceAccount to each verb and resource
+// of the rules in its namespace, as the API server authorizes the requests of the ServiceAccount,
+// and returns the permissions denied to the ServiceAccount, e.g. "create pods"
+func MissingServiceAccountPermissions(ctx context.Context, kubeClientSet kubernetes.Interface, namespace, name string, rules []rbacv1.PolicyRule) ([]string, error) {
+	user := fmt.Sprintf("system:serviceaccount:%s:%s", namespace, name)
+	groups := []string{"system:serviceaccounts", "system:serviceaccounts:" + namespace, "system:authenticated"}
+	return reviewPermissions(rules, func(a attributes) (bool, error) {
+		if a.resource != nil {
+			a.resource.Namespace = namespace
+		}
+		review, err := kubeClientSet.AuthorizationV1().SubjectAccessReviews().Create(ctx, &authorizationv1.SubjectAccessReview{
+			Spec: authorizationv1.SubjectAccessReviewSpec{
+				ResourceAttributes:    a.resource,
+				NonResourceAttributes: a.nonResource,
+				User:                  user,
+				Groups:                groups,
+			},
+		}, metav1.CreateOptions{})
+		if err != nil {
+			return false, err
+		}
+		return review.Status.Allowed, nil
+	})
+}
+
+// reviewPermissions returns the permissions of the rules which are not allowed
+func reviewPermissions(rules []rbacv1.PolicyRule, allowed func(attributes) (bool, error)) ([]string, error) {
+	var missing []string
+	for _, rule := range rules {
+		for _, a := range accessAttributes(rule) {
+			ok, err := allowed(a)
+			if err != nil {
+				return nil, fmt.Errorf("failed to review the access to %s: %w", a, err)
+			}
+			if !ok {
+				missing = append(missing, a.String())
+			}
+		}
+	}
+	return missing, nil
+}
+
+type attributes struct {
+	resource    *authorizationv1.ResourceAttributes
+	nonResource *authorizationv1.NonResourceAttributes
+}
+
+func (a attributes) String() string {
+	if a.nonResource != nil {
+		return fmt.Sprintf("%s %s", a.nonResource.Verb, a.nonResource.Path)
+	}
+	resource := a.resource.Resource
+	if a.resource.Subresource != "" {
+		resource += "/" + a.resource.Subresource
+	}
+	if a.resource.Group != "" {
+		resource = a.resource.Group + "/" + resource
+	}
+	if a.resource.Name != "" {
+		resource += " " + a.resource.Name
+	}
+	return fmt.Sprintf("%s %s", a.resource.Verb, resource)
+}
+
+// accessAttributes returns the attributes reviewed for each verb and resource of the rule
+func accessAttributes(rule rbacv1.PolicyRule) []attributes {
+	var result []attributes
+	for _, verb := range rule.Verbs {
+		for _, path := range rule.NonResourceURLs {
+			result = append(result, attributes{nonResource: &authorizationv1.NonResourceAttributes{Path: path, Verb: verb}})
+		}
+		names := rule.ResourceNames
+		if len(names) == 0 {
+			names = []string{""}
+		}
+		for _, group := range rule.APIGroups {
+			for _, resource := range rule.Resources {
+				resource, subresource, _ := strings.Cut(resource, "/")
+				for _, name := range names {
+					result = append(result, attributes{resource: &authorizationv1.ResourceAttributes{
+						Group: group, Resource: resource, Subresource: subresource, Name: name, Verb: verb,
+					}})
+				}
+			}
+		}
+	}
+	return result
+}
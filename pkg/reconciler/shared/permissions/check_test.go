@@ -0,0 +1,96 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package permissions
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"gotest.tools/v3/assert"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestReconcile(t *testing.T) {
+	kubeClient := fake.NewSimpleClientset()
+	denied := map[string]bool{"delete apps/deployments": true, "get /metrics": true}
+	reviews := 0
+	kubeClient.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview)
+		reviews++
+		a := attributes{resource: review.Spec.ResourceAttributes, nonResource: review.Spec.NonResourceAttributes}
+		review.Status.Allowed = !denied[a.String()]
+		return true, review, nil
+	})
+	rules := []rbacv1.PolicyRule{
+		{APIGroups: []string{"apps"}, Resources: []string{"deployments", "deployments/scale"}, Verbs: []string{"get", "delete"}},
+		{APIGroups: []string{""}, Resources: []string{"configmaps"}, ResourceNames: []string{"config"}, Verbs: []string{"get"}},
+		{NonResourceURLs: []string{"/metrics"}, Verbs: []string{"get"}},
+	}
+	now := time.Now()
+	c := New(kubeClient, func() ([]rbacv1.PolicyRule, error) { return rules, nil })
+	c.now = func() time.Time { return now }
+	tc := &v1alpha1.TektonConfig{}
+
+	c.Reconcile(context.TODO(), tc)
+	assert.Equal(t, reviews, 6)
+	condition := tc.Status.GetCondition(v1alpha1.PermissionsGranted)
+	assert.Equal(t, condition.IsFalse(), true)
+	assert.Equal(t, condition.Reason, "MissingPermissions")
+	assert.Equal(t, condition.Message, "the operator is not allowed to delete apps/deployments, get /metrics")
+
+	// the permissions are not checked again until the interval elapsed
+	denied = map[string]bool{}
+	c.Reconcile(context.TODO(), tc)
+	assert.Equal(t, reviews, 6)
+	now = now.Add(checkInterval)
+	c.Reconcile(context.TODO(), tc)
+	assert.Equal(t, reviews, 12)
+	assert.Equal(t, tc.Status.GetCondition(v1alpha1.PermissionsGranted).IsTrue(), true)
+}
+
+func TestAccessAttributes(t *testing.T) {
+	var got []string
+	for _, a := range accessAttributes(rbacv1.PolicyRule{
+		APIGroups: []string{""}, Resources: []string{"pods/log"}, ResourceNames: []string{"a", "b"}, Verbs: []string{"get"},
+	}) {
+		got = append(got, a.String())
+	}
+	assert.DeepEqual(t, got, []string{"get pods/log a", "get pods/log b"})
+}
+
+func TestMissingServiceAccountPermissions(t *testing.T) {
+	kubeClient := fake.NewSimpleClientset()
+	kubeClient.PrependReactor("create", "subjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SubjectAccessReview)
+		assert.Equal(t, review.Spec.User, "system:serviceaccount:team-a:pipeline")
+		assert.DeepEqual(t, review.Spec.Groups, []string{"system:serviceaccounts", "system:serviceaccounts:team-a", "system:authenticated"})
+		assert.Equal(t, review.Spec.ResourceAttributes.Namespace, "team-a")
+		review.Status.Allowed = review.Spec.ResourceAttributes.Verb != "create"
+		return true, review, nil
+	})
+	missing, err := MissingServiceAccountPermissions(context.TODO(), kubeClient, "team-a", "pipeline", []rbacv1.PolicyRule{
+		{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"get", "create"}},
+	})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, missing, []string{"create pods"})
+}
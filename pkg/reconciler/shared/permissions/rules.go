@@ -0,0 +1,210 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package permissions
+
+import (
+	"sort"
+	"strings"
+
+	mf "github.com/manifestival/manifestival"
+	"github.com/tektoncd/operator/pkg/reconciler/platform"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+var (
+	readVerbs  = []string{"get", "list", "watch"}
+	writeVerbs = []string{"get", "list", "watch", "create", "update", "patch", "delete"}
+
+	// operatorRules are the API calls made by all the reconcilers: reading and updating the
+	// operator resources, recording events, electing the leaders and checking their own permissions
+	operatorRules = []rbacv1.PolicyRule{
+		{APIGroups: []string{"operator.tekton.dev"}, Resources: []string{"*"}, Verbs: writeVerbs},
+		{APIGroups: []string{""}, Resources: []string{"events"}, Verbs: []string{"create", "patch"}},
+		{APIGroups: []string{"coordination.k8s.io"}, Resources: []string{"leases"}, Verbs: writeVerbs},
+		{APIGroups: []string{""}, Resources: []string{"configmaps"}, Verbs: readVerbs},
+		{APIGroups: []string{"authorization.k8s.io"}, Resources: []string{"selfsubjectaccessreviews"}, Verbs: []string{"create"}},
+	}
+
+	// ControllerRules are the API calls made by the reconcilers themselves, by controller, on top of the
+	// resources of the payload manifests they apply through the installer sets
+	ControllerRules = map[platform.ControllerName][]rbacv1.PolicyRule{
+		platform.ControllerTektonConfig: {
+			{APIGroups: []string{""}, Resources: []string{"namespaces"}, Verbs: []string{"get", "list", "watch", "create", "update", "patch"}},
+			{APIGroups: []string{""}, Resources: []string{"nodes"}, Verbs: []string{"list"}},
+			{APIGroups: []string{""}, Resources: []string{"configmaps", "secrets", "serviceaccounts", "resourcequotas", "events"}, Verbs: writeVerbs},
+			{APIGroups: []string{"rbac.authorization.k8s.io"}, Resources: []string{"roles", "rolebindings", "clusterroles", "clusterrolebindings"}, Verbs: writeVerbs},
+			{APIGroups: []string{"apiextensions.k8s.io"}, Resources: []string{"customresourcedefinitions"}, Verbs: readVerbs},
+			{APIGroups: []string{"trust.cert-manager.io"}, Resources: []string{"bundles"}, Verbs: writeVerbs},
+			{APIGroups: []string{"admissionregistration.k8s.io"}, Resources: []string{"validatingadmissionpolicies", "validatingadmissionpolicybindings"}, Verbs: writeVerbs},
+			{APIGroups: []string{"apps"}, Resources: []string{"deployments", "statefulsets"}, Verbs: readVerbs},
+			{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"list", "delete"}},
+		},
+		platform.ControllerTektonInstallerSet: {
+			{APIGroups: []string{"apps"}, Resources: []string{"deployments", "statefulsets"}, Verbs: writeVerbs},
+			{APIGroups: []string{"apiextensions.k8s.io"}, Resources: []string{"customresourcedefinitions"}, Verbs: writeVerbs},
+			{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: readVerbs},
+			{APIGroups: []string{""}, Resources: []string{"nodes"}, Verbs: []string{"list"}},
+			{APIGroups: []string{"kyverno.io"}, Resources: []string{"clusterpolicies"}, Verbs: writeVerbs},
+			{APIGroups: []string{"templates.gatekeeper.sh", "constraints.gatekeeper.sh"}, Resources: []string{"*"}, Verbs: writeVerbs},
+		},
+		platform.ControllerComponentAction: {
+			{APIGroups: []string{"apps"}, Resources: []string{"deployments"}, Verbs: []string{"get", "list", "watch", "patch"}},
+		},
+		platform.ControllerGitSource: {
+			{APIGroups: []string{""}, Resources: []string{"secrets"}, Verbs: readVerbs},
+		},
+		platform.ControllerSecretRotation: {
+			{APIGroups: []string{""}, Resources: []string{"secrets"}, Verbs: readVerbs},
+			{APIGroups: []string{"apps"}, Resources: []string{"deployments"}, Verbs: []string{"get", "list", "watch", "patch"}},
+		},
+		platform.ControllerWatchdog: {
+			{APIGroups: []string{"apps"}, Resources: []string{"deployments"}, Verbs: readVerbs},
+			{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"get", "list", "watch", "delete"}},
+		},
+		platform.ControllerTektonResult: {
+			{APIGroups: []string{""}, Resources: []string{"secrets"}, Verbs: writeVerbs},
+		},
+	}
+
+	// openShiftRules are the API calls made by the reconcilers on OpenShift only
+	openShiftRules = []rbacv1.PolicyRule{
+		{APIGroups: []string{"security.openshift.io"}, Resources: []string{"securitycontextconstraints"}, Verbs: []string{"get", "list", "watch", "create", "update", "use"}},
+		{APIGroups: []string{"config.openshift.io"}, Resources: []string{"clusterversions", "proxies", "apiservers"}, Verbs: readVerbs},
+		{APIGroups: []string{"console.openshift.io"}, Resources: []string{"consoleclidownloads", "consolequickstarts", "consoleyamlsamples"}, Verbs: writeVerbs},
+		{APIGroups: []string{"monitoring.coreos.com"}, Resources: []string{"servicemonitors", "prometheusrules"}, Verbs: writeVerbs},
+		{APIGroups: []string{"authorization.k8s.io"}, Resources: []string{"subjectaccessreviews"}, Verbs: []string{"create"}},
+	}
+)
+
+// Rules returns the rules of the reconcilers of the controllers on the platform, and of the resources
+// of the payload manifests. The operator needs full access to the resources it applies, and all the
+// rules of the roles it applies as Kubernetes prevents granting permissions not held.
+func Rules(openShift bool, manifest mf.Manifest, controllers ...platform.ControllerName) ([]rbacv1.PolicyRule, error) {
+	rules := append([]rbacv1.PolicyRule{}, operatorRules...)
+	for _, name := range controllers {
+		rules = append(rules, ControllerRules[name]...)
+	}
+	if openShift {
+		rules = append(rules, openShiftRules...)
+	}
+	manifestRules, err := ManifestRules(manifest)
+	if err != nil {
+		return nil, err
+	}
+	return Merge(append(rules, manifestRules...)), nil
+}
+
+// ManifestRules returns the rules needed to apply the resources of the manifest
+func ManifestRules(manifest mf.Manifest) ([]rbacv1.PolicyRule, error) {
+	var rules []rbacv1.PolicyRule
+	for _, u := range manifest.Resources() {
+		gvk := u.GroupVersionKind()
+		resource, _ := meta.UnsafeGuessKindToResource(gvk)
+		rules = append(rules, rbacv1.PolicyRule{APIGroups: []string{gvk.Group}, Resources: []string{resource.Resource}, Verbs: writeVerbs})
+		if gvk.Group != rbacv1.GroupName || (gvk.Kind != "ClusterRole" && gvk.Kind != "Role") {
+			continue
+		}
+		role := &rbacv1.ClusterRole{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, role); err != nil {
+			return nil, err
+		}
+		rules = append(rules, role.Rules...)
+	}
+	return rules, nil
+}
+
+// ruleKey identifies the resources, or the non resource URLs, a rule applies to
+type ruleKey struct {
+	group, resource, resourceNames, nonResourceURL string
+}
+
+// Merge returns the minimal sorted rules granting the same permissions as the rules, the verbs of the
+// rules applying to the same resources are merged and the resources with the same verbs grouped
+func Merge(rules []rbacv1.PolicyRule) []rbacv1.PolicyRule {
+	verbs := map[ruleKey]sets.Set[string]{}
+	add := func(key ruleKey, rule rbacv1.PolicyRule) {
+		if verbs[key] == nil {
+			verbs[key] = sets.New[string]()
+		}
+		verbs[key].Insert(rule.Verbs...)
+	}
+	for _, rule := range rules {
+		names := strings.Join(sets.List(sets.New(rule.ResourceNames...)), ",")
+		for _, url := range rule.NonResourceURLs {
+			add(ruleKey{nonResourceURL: url}, rule)
+		}
+		for _, group := range rule.APIGroups {
+			for _, resource := range rule.Resources {
+				add(ruleKey{group: group, resource: resource, resourceNames: names}, rule)
+			}
+		}
+	}
+
+	// group the resources of each API group with the same verbs and resource names
+	type groupKey struct {
+		group, resourceNames, nonResourceURL, verbs string
+	}
+	grouped := map[groupKey][]string{}
+	for key, v := range verbs {
+		if v.Has(rbacv1.VerbAll) {
+			v = sets.New(rbacv1.VerbAll)
+		}
+		gk := groupKey{group: key.group, resourceNames: key.resourceNames, nonResourceURL: key.nonResourceURL, verbs: strings.Join(sets.List(v), ",")}
+		grouped[gk] = append(grouped[gk], key.resource)
+	}
+	merged := make([]rbacv1.PolicyRule, 0, len(grouped))
+	for gk, resources := range grouped {
+		rule := rbacv1.PolicyRule{Verbs: strings.Split(gk.verbs, ",")}
+		if gk.nonResourceURL != "" {
+			rule.NonResourceURLs = []string{gk.nonResourceURL}
+		} else {
+			sort.Strings(resources)
+			rule.APIGroups = []string{gk.group}
+			rule.Resources = resources
+		}
+		if gk.resourceNames != "" {
+			rule.ResourceNames = strings.Split(gk.resourceNames, ",")
+		}
+		merged = append(merged, rule)
+	}
+	// the rules of the resources first, then the rules of the non resource URLs
+	sort.Slice(merged, func(i, j int) bool {
+		if (len(merged[i].NonResourceURLs) == 0) != (len(merged[j].NonResourceURLs) == 0) {
+			return len(merged[i].NonResourceURLs) == 0
+		}
+		return ruleSortKey(merged[i]) < ruleSortKey(merged[j])
+	})
+	return merged
+}
+
+func ruleSortKey(rule rbacv1.PolicyRule) string {
+	return strings.Join([]string{strings.Join(rule.NonResourceURLs, ","), strings.Join(rule.APIGroups, ","),
+		strings.Join(rule.Resources, ","), strings.Join(rule.ResourceNames, ","), strings.Join(rule.Verbs, ",")}, "/")
+}
+
+// ClusterRole returns the ClusterRole granting the rules
+func ClusterRole(name string, rules []rbacv1.PolicyRule) *rbacv1.ClusterRole {
+	return &rbacv1.ClusterRole{
+		TypeMeta:   metav1.TypeMeta{APIVersion: rbacv1.SchemeGroupVersion.String(), Kind: "ClusterRole"},
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Rules:      rules,
+	}
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package permissions
+
+import (
+	"testing"
+
+	mf "github.com/manifestival/manifestival"
+	"gotest.tools/v3/assert"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func resource(apiVersion, kind string, fields map[string]interface{}) unstructured.Unstructured {
+	u := unstructured.Unstructured{Object: map[string]interface{}{"apiVersion": apiVersion, "kind": kind}}
+	for k, v := range fields {
+		u.Object[k] = v
+	}
+	u.SetName("test")
+	return u
+}
+
+func TestManifestRules(t *testing.T) {
+	manifest, err := mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{
+		resource("apps/v1", "Deployment", nil),
+		resource("networking.k8s.io/v1", "NetworkPolicy", nil),
+		resource("rbac.authorization.k8s.io/v1", "ClusterRole", map[string]interface{}{
+			"rules": []interface{}{
+				map[string]interface{}{"apiGroups": []interface{}{"tekton.dev"}, "resources": []interface{}{"pipelineruns", "taskruns"}, "verbs": []interface{}{"get", "list"}},
+				map[string]interface{}{"nonResourceURLs": []interface{}{"/metrics"}, "verbs": []interface{}{"get"}},
+			},
+		}),
+	}))
+	assert.NilError(t, err)
+	rules, err := ManifestRules(manifest)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, Merge(rules), []rbacv1.PolicyRule{
+		{APIGroups: []string{"apps"}, Resources: []string{"deployments"}, Verbs: []string{"create", "delete", "get", "list", "patch", "update", "watch"}},
+		{APIGroups: []string{"networking.k8s.io"}, Resources: []string{"networkpolicies"}, Verbs: []string{"create", "delete", "get", "list", "patch", "update", "watch"}},
+		{APIGroups: []string{"rbac.authorization.k8s.io"}, Resources: []string{"clusterroles"}, Verbs: []string{"create", "delete", "get", "list", "patch", "update", "watch"}},
+		{APIGroups: []string{"tekton.dev"}, Resources: []string{"pipelineruns", "taskruns"}, Verbs: []string{"get", "list"}},
+		{NonResourceURLs: []string{"/metrics"}, Verbs: []string{"get"}},
+	})
+}
+
+func TestMerge(t *testing.T) {
+	merged := Merge([]rbacv1.PolicyRule{
+		{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"get"}},
+		{APIGroups: []string{""}, Resources: []string{"pods", "services"}, Verbs: []string{"list", "get"}},
+		{APIGroups: []string{""}, Resources: []string{"configmaps"}, ResourceNames: []string{"config"}, Verbs: []string{"get"}},
+		{APIGroups: []string{"", "apps"}, Resources: []string{"secrets"}, Verbs: []string{"watch"}},
+		{APIGroups: []string{"apps"}, Resources: []string{"secrets"}, Verbs: []string{"*"}},
+	})
+	assert.DeepEqual(t, merged, []rbacv1.PolicyRule{
+		{APIGroups: []string{""}, Resources: []string{"configmaps"}, ResourceNames: []string{"config"}, Verbs: []string{"get"}},
+		{APIGroups: []string{""}, Resources: []string{"pods", "services"}, Verbs: []string{"get", "list"}},
+		{APIGroups: []string{""}, Resources: []string{"secrets"}, Verbs: []string{"watch"}},
+		{APIGroups: []string{"apps"}, Resources: []string{"secrets"}, Verbs: []string{"*"}},
+	})
+}
+
+func TestRules(t *testing.T) {
+	kubernetes, err := Rules(false, mf.Manifest{}, "tektonconfig")
+	assert.NilError(t, err)
+	openShift, err := Rules(true, mf.Manifest{}, "tektonconfig")
+	assert.NilError(t, err)
+	grants := func(rules []rbacv1.PolicyRule, group, resource string) bool {
+		for _, rule := range rules {
+			for _, r := range rule.Resources {
+				if rule.APIGroups[0] == group && r == resource {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	assert.Assert(t, grants(kubernetes, "operator.tekton.dev", "*"))
+	assert.Assert(t, grants(kubernetes, "", "namespaces"))
+	assert.Assert(t, !grants(kubernetes, "security.openshift.io", "securitycontextconstraints"))
+	assert.Assert(t, grants(openShift, "security.openshift.io", "securitycontextconstraints"))
+}
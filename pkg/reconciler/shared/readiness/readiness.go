@@ -0,0 +1,184 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package readiness
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	clientset "github.com/tektoncd/operator/pkg/client/clientset/versioned"
+	"go.uber.org/zap"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+)
+
+const (
+	// PortEnvKey is the environment variable holding the port of the readiness endpoint
+	PortEnvKey  = "READINESS_PORT"
+	DefaultPort = "8081"
+	// Path is the path of the readiness endpoint
+	Path = "/readiness"
+
+	checkTimeout = 10 * time.Second
+)
+
+// ComponentStatus is the health of an installed component
+type ComponentStatus struct {
+	Kind    string `json:"kind"`
+	Name    string `json:"name"`
+	Ready   bool   `json:"ready"`
+	Version string `json:"version,omitempty"`
+	Reason  string `json:"reason,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// Report is the health of the install, it is ready when TektonConfig and all the
+// installed components are ready
+type Report struct {
+	Ready      bool              `json:"ready"`
+	Components []ComponentStatus `json:"components"`
+}
+
+type component struct {
+	kind string
+	name string
+	// required components are reported as not ready when they do not exist
+	required bool
+	get      func(ctx context.Context, client clientset.Interface, name string) (v1alpha1.TektonComponent, error)
+}
+
+// components are checked in this order, components which are not installed are skipped
+var components = []component{
+	{kind: "TektonConfig", name: v1alpha1.ConfigResourceName, required: true, get: func(ctx context.Context, c clientset.Interface, name string) (v1alpha1.TektonComponent, error) {
+		return c.OperatorV1alpha1().TektonConfigs().Get(ctx, name, metav1.GetOptions{})
+	}},
+	{kind: "TektonPipeline", name: v1alpha1.PipelineResourceName, required: true, get: func(ctx context.Context, c clientset.Interface, name string) (v1alpha1.TektonComponent, error) {
+		return c.OperatorV1alpha1().TektonPipelines().Get(ctx, name, metav1.GetOptions{})
+	}},
+	{kind: "TektonTrigger", name: v1alpha1.TriggerResourceName, get: func(ctx context.Context, c clientset.Interface, name string) (v1alpha1.TektonComponent, error) {
+		return c.OperatorV1alpha1().TektonTriggers().Get(ctx, name, metav1.GetOptions{})
+	}},
+	{kind: "TektonChain", name: v1alpha1.ChainResourceName, get: func(ctx context.Context, c clientset.Interface, name string) (v1alpha1.TektonComponent, error) {
+		return c.OperatorV1alpha1().TektonChains().Get(ctx, name, metav1.GetOptions{})
+	}},
+	{kind: "TektonResult", name: v1alpha1.ResultResourceName, get: func(ctx context.Context, c clientset.Interface, name string) (v1alpha1.TektonComponent, error) {
+		return c.OperatorV1alpha1().TektonResults().Get(ctx, name, metav1.GetOptions{})
+	}},
+	{kind: "TektonDashboard", name: v1alpha1.DashboardResourceName, get: func(ctx context.Context, c clientset.Interface, name string) (v1alpha1.TektonComponent, error) {
+		return c.OperatorV1alpha1().TektonDashboards().Get(ctx, name, metav1.GetOptions{})
+	}},
+	{kind: "TektonHub", name: v1alpha1.HubResourceName, get: func(ctx context.Context, c clientset.Interface, name string) (v1alpha1.TektonComponent, error) {
+		return c.OperatorV1alpha1().TektonHubs().Get(ctx, name, metav1.GetOptions{})
+	}},
+	{kind: "TektonPruner", name: v1alpha1.TektonPrunerResourceName, get: func(ctx context.Context, c clientset.Interface, name string) (v1alpha1.TektonComponent, error) {
+		return c.OperatorV1alpha1().TektonPruners().Get(ctx, name, metav1.GetOptions{})
+	}},
+	{kind: "ManualApprovalGate", name: v1alpha1.ManualApprovalGates, get: func(ctx context.Context, c clientset.Interface, name string) (v1alpha1.TektonComponent, error) {
+		return c.OperatorV1alpha1().ManualApprovalGates().Get(ctx, name, metav1.GetOptions{})
+	}},
+	{kind: "TektonAddon", name: v1alpha1.AddonResourceName, get: func(ctx context.Context, c clientset.Interface, name string) (v1alpha1.TektonComponent, error) {
+		return c.OperatorV1alpha1().TektonAddons().Get(ctx, name, metav1.GetOptions{})
+	}},
+	{kind: "OpenShiftPipelinesAsCode", name: v1alpha1.OpenShiftPipelinesAsCodeName, get: func(ctx context.Context, c clientset.Interface, name string) (v1alpha1.TektonComponent, error) {
+		return c.OperatorV1alpha1().OpenShiftPipelinesAsCodes().Get(ctx, name, metav1.GetOptions{})
+	}},
+}
+
+// Check returns the health of TektonConfig and of the installed components
+func Check(ctx context.Context, client clientset.Interface) Report {
+	report := Report{Ready: true, Components: []ComponentStatus{}}
+	for _, c := range components {
+		status := ComponentStatus{Kind: c.kind, Name: c.name}
+		obj, err := c.get(ctx, client, c.name)
+		switch {
+		case apierrors.IsNotFound(err):
+			if !c.required {
+				continue
+			}
+			status.Reason = "NotFound"
+			status.Message = err.Error()
+		case err != nil:
+			status.Reason = "Error"
+			status.Message = err.Error()
+		default:
+			componentStatus := obj.GetStatus()
+			status.Ready = componentStatus.IsReady()
+			status.Version = componentStatus.GetVersion()
+			if cond := componentStatus.GetCondition(apis.ConditionReady); cond != nil && !status.Ready {
+				status.Reason = cond.Reason
+				status.Message = cond.Message
+			}
+		}
+		report.Ready = report.Ready && status.Ready
+		report.Components = append(report.Components, status)
+	}
+	return report
+}
+
+// Handler serves the Report as JSON, with status 200 when the install is ready and 503 otherwise
+func Handler(client clientset.Interface, logger *zap.SugaredLogger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), checkTimeout)
+		defer cancel()
+
+		report := Check(ctx, client)
+		w.Header().Set("Content-Type", "application/json")
+		if report.Ready {
+			w.WriteHeader(http.StatusOK)
+		} else {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		if err := json.NewEncoder(w).Encode(report); err != nil {
+			logger.Errorw("failed to write the readiness report", "error", err)
+		}
+	})
+}
+
+// Serve serves the readiness endpoint on the port set in READINESS_PORT until the context is done
+func Serve(ctx context.Context, client clientset.Interface, logger *zap.SugaredLogger) {
+	port := os.Getenv(PortEnvKey)
+	if port == "" {
+		port = DefaultPort
+	}
+	mux := http.NewServeMux()
+	mux.Handle(Path, Handler(client, logger))
+	server := &http.Server{
+		Addr:              ":" + port,
+		Handler:           mux,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			logger.Errorw("failed to shut down the readiness endpoint", "error", err)
+		}
+	}()
+
+	logger.Infof("serving the readiness endpoint on :%s%s", port, Path)
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		logger.Errorw("readiness endpoint failed", "error", err)
+	}
+}
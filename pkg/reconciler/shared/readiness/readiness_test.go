@@ -0,0 +1,99 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package readiness
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/client/clientset/versioned/fake"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/logging"
+)
+
+func readyStatus(ready bool, reason string) duckv1.Status {
+	status := corev1.ConditionTrue
+	if !ready {
+		status = corev1.ConditionFalse
+	}
+	return duckv1.Status{Conditions: duckv1.Conditions{{Type: apis.ConditionReady, Status: status, Reason: reason, Message: reason}}}
+}
+
+func TestCheck(t *testing.T) {
+	ctx := context.TODO()
+
+	tc := &v1alpha1.TektonConfig{ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.ConfigResourceName}}
+	tc.Status.Status = readyStatus(true, "")
+	tc.Status.SetVersion("v0.70.0")
+	tp := &v1alpha1.TektonPipeline{ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.PipelineResourceName}}
+	tp.Status.Status = readyStatus(true, "")
+	tt := &v1alpha1.TektonTrigger{ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.TriggerResourceName}}
+	tt.Status.Status = readyStatus(false, "InstallerSetNotReady")
+	client := fake.NewSimpleClientset(tc, tp, tt)
+
+	report := Check(ctx, client)
+	assert.Equal(t, report.Ready, false)
+	assert.DeepEqual(t, report.Components, []ComponentStatus{
+		{Kind: "TektonConfig", Name: v1alpha1.ConfigResourceName, Ready: true, Version: "v0.70.0"},
+		{Kind: "TektonPipeline", Name: v1alpha1.PipelineResourceName, Ready: true},
+		{Kind: "TektonTrigger", Name: v1alpha1.TriggerResourceName, Reason: "InstallerSetNotReady", Message: "InstallerSetNotReady"},
+	})
+
+	// components which are not installed are skipped
+	assert.NilError(t, client.OperatorV1alpha1().TektonTriggers().Delete(ctx, v1alpha1.TriggerResourceName, metav1.DeleteOptions{}))
+	report = Check(ctx, client)
+	assert.Equal(t, report.Ready, true)
+	assert.Equal(t, len(report.Components), 2)
+}
+
+func TestCheckMissingTektonConfig(t *testing.T) {
+	report := Check(context.TODO(), fake.NewSimpleClientset())
+	assert.Equal(t, report.Ready, false)
+	assert.Equal(t, len(report.Components), 2)
+	assert.Equal(t, report.Components[0].Reason, "NotFound")
+}
+
+func TestHandler(t *testing.T) {
+	tc := &v1alpha1.TektonConfig{ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.ConfigResourceName}}
+	tc.Status.Status = readyStatus(true, "")
+	tp := &v1alpha1.TektonPipeline{ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.PipelineResourceName}}
+	client := fake.NewSimpleClientset(tc, tp)
+	handler := Handler(client, logging.FromContext(context.TODO()))
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, Path, nil))
+	assert.Equal(t, recorder.Code, http.StatusServiceUnavailable)
+	assert.Equal(t, recorder.Header().Get("Content-Type"), "application/json")
+
+	tp.Status.Status = readyStatus(true, "")
+	_, err := client.OperatorV1alpha1().TektonPipelines().UpdateStatus(context.TODO(), tp, metav1.UpdateOptions{})
+	assert.NilError(t, err)
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, Path, nil))
+	assert.Equal(t, recorder.Code, http.StatusOK)
+	report := Report{}
+	assert.NilError(t, json.Unmarshal(recorder.Body.Bytes(), &report))
+	assert.Equal(t, report.Ready, true)
+}
@@ -0,0 +1,236 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretrotation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/client/clientset/versioned"
+	operatorclient "github.com/tektoncd/operator/pkg/client/injection/client"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/componentaction"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/hash"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	kubeinformers "k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	kubeclient "knative.dev/pkg/client/injection/kube/client"
+	"knative.dev/pkg/configmap"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/injection"
+	"knative.dev/pkg/logging"
+	pkgreconciler "knative.dev/pkg/reconciler"
+)
+
+const (
+	// HashAnnotation is set on the credentials secrets to the hash of their data when they were last verified
+	HashAnnotation = "operator.tekton.dev/credentials-hash"
+)
+
+// Credentials is a secret holding external credentials of a component, in the target
+// namespace of the component
+type Credentials struct {
+	Kind       string
+	SecretName string
+	// Validate probes the credentials of the secret
+	Validate func(secret *corev1.Secret) error
+	// Get returns the component custom resource
+	Get func(ctx context.Context, client versioned.Interface) (v1alpha1.TektonComponent, error)
+	// Patch patches the component custom resource
+	Patch func(ctx context.Context, client versioned.Interface, patch []byte) error
+	// MarkVerified sets the CredentialsVerified condition of the component, verifyErr is the
+	// error of the validation of the credentials
+	MarkVerified func(ctx context.Context, client versioned.Interface, component v1alpha1.TektonComponent, verifyErr error) error
+}
+
+// ChainsCredentials is the signing secret of Chains
+var ChainsCredentials = Credentials{
+	Kind:       v1alpha1.KindTektonChain,
+	SecretName: "signing-secrets",
+	Validate:   validateChainsSigningSecret,
+	Get: func(ctx context.Context, c versioned.Interface) (v1alpha1.TektonComponent, error) {
+		return c.OperatorV1alpha1().TektonChains().Get(ctx, v1alpha1.ChainResourceName, metav1.GetOptions{})
+	},
+	Patch: func(ctx context.Context, c versioned.Interface, patch []byte) error {
+		_, err := c.OperatorV1alpha1().TektonChains().Patch(ctx, v1alpha1.ChainResourceName, types.MergePatchType, patch, metav1.PatchOptions{})
+		return err
+	},
+	MarkVerified: func(ctx context.Context, c versioned.Interface, component v1alpha1.TektonComponent, verifyErr error) error {
+		tc := component.(*v1alpha1.TektonChain).DeepCopy()
+		if verifyErr != nil {
+			tc.Status.MarkCredentialsNotVerified(verifyErr.Error())
+		} else {
+			tc.Status.MarkCredentialsVerified()
+		}
+		_, err := c.OperatorV1alpha1().TektonChains().UpdateStatus(ctx, tc, metav1.UpdateOptions{})
+		return err
+	},
+}
+
+// PipelinesAsCodeCredentials is the GitHub App secret of Pipelines-as-Code
+var PipelinesAsCodeCredentials = Credentials{
+	Kind:       v1alpha1.KindOpenShiftPipelinesAsCode,
+	SecretName: "pipelines-as-code-secret",
+	Validate:   validatePipelinesAsCodeSecret,
+	Get: func(ctx context.Context, c versioned.Interface) (v1alpha1.TektonComponent, error) {
+		return c.OperatorV1alpha1().OpenShiftPipelinesAsCodes().Get(ctx, v1alpha1.OpenShiftPipelinesAsCodeName, metav1.GetOptions{})
+	},
+	Patch: func(ctx context.Context, c versioned.Interface, patch []byte) error {
+		_, err := c.OperatorV1alpha1().OpenShiftPipelinesAsCodes().Patch(ctx, v1alpha1.OpenShiftPipelinesAsCodeName, types.MergePatchType, patch, metav1.PatchOptions{})
+		return err
+	},
+	MarkVerified: func(ctx context.Context, c versioned.Interface, component v1alpha1.TektonComponent, verifyErr error) error {
+		pac := component.(*v1alpha1.OpenShiftPipelinesAsCode).DeepCopy()
+		if verifyErr != nil {
+			pac.Status.MarkCredentialsNotVerified(verifyErr.Error())
+		} else {
+			pac.Status.MarkCredentialsVerified()
+		}
+		_, err := c.OperatorV1alpha1().OpenShiftPipelinesAsCodes().UpdateStatus(ctx, pac, metav1.UpdateOptions{})
+		return err
+	},
+}
+
+// Reconciler verifies the credentials secrets of the components when they are rotated, and
+// restarts the workloads of the component once the new credentials are verified
+type Reconciler struct {
+	pkgreconciler.LeaderAwareFuncs
+
+	kubeClientSet     kubernetes.Interface
+	operatorClientSet versioned.Interface
+	credentials       map[string]Credentials
+}
+
+var _ controller.Reconciler = (*Reconciler)(nil)
+
+// NewExtendedController returns the secret rotation controller for the credentials of a platform
+func NewExtendedController(credentials ...Credentials) injection.ControllerConstructor {
+	return func(ctx context.Context, cmw configmap.Watcher) *controller.Impl {
+		logger := logging.FromContext(ctx)
+		kubeClient := kubeclient.Get(ctx)
+
+		r := &Reconciler{
+			kubeClientSet:     kubeClient,
+			operatorClientSet: operatorclient.Get(ctx),
+			credentials:       map[string]Credentials{},
+		}
+		impl := controller.NewContext(ctx, r, controller.ControllerOptions{WorkQueueName: "SecretRotation", Logger: logger})
+
+		// only the credentials secrets are watched, in all the namespaces as the target
+		// namespace of the components can change
+		informers := []cache.SharedIndexInformer{}
+		for _, c := range credentials {
+			r.credentials[c.SecretName] = c
+			factory := kubeinformers.NewSharedInformerFactoryWithOptions(kubeClient, controller.GetResyncPeriod(ctx),
+				kubeinformers.WithTweakListOptions(func(o *metav1.ListOptions) {
+					o.FieldSelector = "metadata.name=" + c.SecretName
+				}))
+			informer := factory.Core().V1().Secrets().Informer()
+			if _, err := informer.AddEventHandler(controller.HandleAll(impl.Enqueue)); err != nil {
+				logger.Panicf("Couldn't register %s secret informer event handler: %w", c.SecretName, err)
+			}
+			informers = append(informers, informer)
+			factory.Start(ctx.Done())
+		}
+
+		// the secrets are verified by the leader, enqueue them on promotion
+		r.PromoteFunc = func(bkt pkgreconciler.Bucket, enq func(pkgreconciler.Bucket, types.NamespacedName)) error {
+			for _, informer := range informers {
+				for _, obj := range informer.GetStore().List() {
+					if o, ok := obj.(metav1.Object); ok {
+						enq(bkt, types.NamespacedName{Namespace: o.GetNamespace(), Name: o.GetName()})
+					}
+				}
+			}
+			return nil
+		}
+		return impl
+	}
+}
+
+// Reconcile verifies a credentials secret when its data changed. Once verified, the workloads
+// of the component are restarted with the restart action so that they use the new credentials.
+// Credentials which cannot be verified are reported in the CredentialsVerified condition and
+// the workloads keep running with the previous credentials.
+func (r *Reconciler) Reconcile(ctx context.Context, key string) error {
+	logger := logging.FromContext(ctx)
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		logger.Errorf("invalid resource key: %s", key)
+		return nil
+	}
+	if !r.IsLeaderFor(types.NamespacedName{Namespace: namespace, Name: name}) {
+		return nil
+	}
+	credentials, ok := r.credentials[name]
+	if !ok {
+		return nil
+	}
+
+	component, err := credentials.Get(ctx, r.operatorClientSet)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	// a secret of the same name in another namespace is not used by the component
+	if component.GetSpec().GetTargetNamespace() != namespace {
+		return nil
+	}
+
+	secret, err := r.kubeClientSet.CoreV1().Secrets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	dataHash, err := hash.Compute(secret.Data)
+	if err != nil {
+		return err
+	}
+	previous, verified := secret.Annotations[HashAnnotation]
+	if previous == dataHash {
+		return nil
+	}
+
+	verifyErr := credentials.Validate(secret)
+	if verifyErr != nil {
+		logger.Errorf("the credentials in secret %s/%s of %s cannot be verified: %v", namespace, name, credentials.Kind, verifyErr)
+	}
+	if err := credentials.MarkVerified(ctx, r.operatorClientSet, component, verifyErr); err != nil {
+		return err
+	}
+	if verifyErr == nil && verified {
+		// the workloads loaded the previous credentials, restart them
+		logger.Infof("credentials in secret %s/%s of %s rotated, restarting its workloads", namespace, name, credentials.Kind)
+		patch := fmt.Sprintf(`{"metadata":{"annotations":{%q:%q}}}`, componentaction.ActionAnnotation, componentaction.ActionRestart)
+		if err := credentials.Patch(ctx, r.operatorClientSet, []byte(patch)); err != nil {
+			return err
+		}
+	}
+
+	// the secret is verified again only when its data changes
+	patch := fmt.Sprintf(`{"metadata":{"annotations":{%q:%q}}}`, HashAnnotation, dataHash)
+	_, err = r.kubeClientSet.CoreV1().Secrets(namespace).Patch(ctx, name, types.MergePatchType, []byte(patch), metav1.PatchOptions{})
+	return err
+}
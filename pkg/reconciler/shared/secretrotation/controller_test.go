@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretrotation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/client/clientset/versioned/fake"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/componentaction"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	pkgreconciler "knative.dev/pkg/reconciler"
+)
+
+func TestReconcile(t *testing.T) {
+	ctx := context.TODO()
+	keys := cosignKeys(t, "pass")
+	chain := &v1alpha1.TektonChain{
+		ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.ChainResourceName},
+		Spec: v1alpha1.TektonChainSpec{
+			CommonSpec: v1alpha1.CommonSpec{TargetNamespace: "tekton-pipelines"},
+		},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "signing-secrets", Namespace: "tekton-pipelines"},
+		Data:       map[string][]byte{cosignKey: keys.PrivateBytes, cosignPubKey: keys.PublicBytes, cosignPasswordKey: []byte("pass")},
+	}
+	kubeClient := kubefake.NewSimpleClientset(secret)
+	operatorClient := fake.NewSimpleClientset(chain)
+	r := &Reconciler{
+		kubeClientSet:     kubeClient,
+		operatorClientSet: operatorClient,
+		credentials:       map[string]Credentials{ChainsCredentials.SecretName: ChainsCredentials},
+	}
+	assert.NilError(t, r.Promote(pkgreconciler.UniversalBucket(), func(pkgreconciler.Bucket, types.NamespacedName) {}))
+
+	getChain := func() *v1alpha1.TektonChain {
+		tc, err := operatorClient.OperatorV1alpha1().TektonChains().Get(ctx, v1alpha1.ChainResourceName, metav1.GetOptions{})
+		assert.NilError(t, err)
+		return tc
+	}
+	getSecret := func() *corev1.Secret {
+		s, err := kubeClient.CoreV1().Secrets("tekton-pipelines").Get(ctx, "signing-secrets", metav1.GetOptions{})
+		assert.NilError(t, err)
+		return s
+	}
+	key := "tekton-pipelines/signing-secrets"
+
+	// the credentials first observed are verified, the workloads already use them
+	assert.NilError(t, r.Reconcile(ctx, key))
+	tc := getChain()
+	assert.Assert(t, tc.Status.GetCondition(v1alpha1.CredentialsVerified).IsTrue())
+	_, restarted := tc.Annotations[componentaction.ActionAnnotation]
+	assert.Assert(t, !restarted)
+	firstHash := getSecret().Annotations[HashAnnotation]
+	assert.Assert(t, firstHash != "")
+
+	// invalid credentials are reported and the workloads are not restarted
+	s := getSecret()
+	s.Data[cosignPasswordKey] = []byte("wrong")
+	_, err := kubeClient.CoreV1().Secrets("tekton-pipelines").Update(ctx, s, metav1.UpdateOptions{})
+	assert.NilError(t, err)
+	assert.NilError(t, r.Reconcile(ctx, key))
+	tc = getChain()
+	condition := tc.Status.GetCondition(v1alpha1.CredentialsVerified)
+	assert.Assert(t, condition.IsFalse())
+	assert.Assert(t, len(condition.Message) > 0)
+	_, restarted = tc.Annotations[componentaction.ActionAnnotation]
+	assert.Assert(t, !restarted)
+
+	// rotated credentials are verified and the workloads are restarted
+	rotated := cosignKeys(t, "new")
+	s = getSecret()
+	s.Data = map[string][]byte{cosignKey: rotated.PrivateBytes, cosignPubKey: rotated.PublicBytes, cosignPasswordKey: []byte("new")}
+	_, err = kubeClient.CoreV1().Secrets("tekton-pipelines").Update(ctx, s, metav1.UpdateOptions{})
+	assert.NilError(t, err)
+	assert.NilError(t, r.Reconcile(ctx, key))
+	tc = getChain()
+	assert.Assert(t, tc.Status.GetCondition(v1alpha1.CredentialsVerified).IsTrue())
+	assert.Equal(t, tc.Annotations[componentaction.ActionAnnotation], componentaction.ActionRestart)
+	assert.Assert(t, getSecret().Annotations[HashAnnotation] != firstHash)
+
+	// a secret of the same name outside of the target namespace is ignored
+	_, err = kubeClient.CoreV1().Secrets("default").Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "signing-secrets", Namespace: "default"},
+		Data:       map[string][]byte{x509Key: []byte("invalid")},
+	}, metav1.CreateOptions{})
+	assert.NilError(t, err)
+	assert.NilError(t, r.Reconcile(ctx, "default/signing-secrets"))
+	assert.Assert(t, getChain().Status.GetCondition(v1alpha1.CredentialsVerified).IsTrue())
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretrotation
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/sigstore/cosign/v2/pkg/cosign"
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+	"github.com/sigstore/sigstore/pkg/signature"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// the keys of the Chains signing secret
+	cosignKey         = "cosign.key"
+	cosignPubKey      = "cosign.pub"
+	cosignPasswordKey = "cosign.password"
+	x509Key           = "x509.pem"
+
+	// the keys of the Pipelines-as-Code GitHub App secret
+	githubPrivateKey    = "github-private-key"
+	githubApplicationID = "github-application-id"
+)
+
+// validateChainsSigningSecret checks that the cosign key can be decrypted with its password
+// and matches its public key, and that the x509 key can be parsed. A secret without keys is
+// valid, signing is not configured.
+func validateChainsSigningSecret(secret *corev1.Secret) error {
+	var errs []error
+	if key := secret.Data[cosignKey]; len(key) > 0 {
+		signer, err := cosign.LoadPrivateKey(key, secret.Data[cosignPasswordKey], nil)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s cannot be loaded with %s: %w", cosignKey, cosignPasswordKey, err))
+		} else if pub := secret.Data[cosignPubKey]; len(pub) > 0 {
+			if err := matchPublicKey(signer, pub); err != nil {
+				errs = append(errs, fmt.Errorf("%s does not match %s: %w", cosignPubKey, cosignKey, err))
+			}
+		}
+	}
+	if key := secret.Data[x509Key]; len(key) > 0 {
+		if _, err := parsePrivateKey(key); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", x509Key, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// validatePipelinesAsCodeSecret checks that the GitHub App is configured with a numeric
+// application id and a parsable private key. A secret without GitHub App is valid, e.g. it
+// only holds a webhook secret.
+func validatePipelinesAsCodeSecret(secret *corev1.Secret) error {
+	key, id := secret.Data[githubPrivateKey], strings.TrimSpace(string(secret.Data[githubApplicationID]))
+	if len(key) == 0 && id == "" {
+		return nil
+	}
+	var errs []error
+	if id == "" {
+		errs = append(errs, fmt.Errorf("%s is required with %s", githubApplicationID, githubPrivateKey))
+	} else if n, err := strconv.ParseInt(id, 10, 64); err != nil || n <= 0 {
+		errs = append(errs, fmt.Errorf("%s must be a positive integer: %q", githubApplicationID, id))
+	}
+	if len(key) == 0 {
+		errs = append(errs, fmt.Errorf("%s is required with %s", githubPrivateKey, githubApplicationID))
+	} else if _, err := parsePrivateKey(key); err != nil {
+		errs = append(errs, fmt.Errorf("%s: %w", githubPrivateKey, err))
+	}
+	return errors.Join(errs...)
+}
+
+func matchPublicKey(signer signature.PublicKeyProvider, pub []byte) error {
+	signerKey, err := signer.PublicKey()
+	if err != nil {
+		return err
+	}
+	expected, err := cryptoutils.UnmarshalPEMToPublicKey(pub)
+	if err != nil {
+		return err
+	}
+	return cryptoutils.EqualKeys(signerKey, expected)
+}
+
+// parsePrivateKey parses a PEM encoded PKCS#8, PKCS#1 or EC private key
+func parsePrivateKey(data []byte) (interface{}, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM encoded private key")
+	}
+	if key, err := x509.ParsePKCS8PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	if key, err := x509.ParseECPrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	return nil, fmt.Errorf("unsupported private key in PEM block %s", block.Type)
+}
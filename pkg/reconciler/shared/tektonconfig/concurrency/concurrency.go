@@ -0,0 +1,156 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package concurrency
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	"knative.dev/pkg/logging"
+)
+
+const (
+	// quotaLabel marks the ResourceQuotas created by the operator to limit the PipelineRuns
+	quotaLabel = "operator.tekton.dev/pipelinerun-quota"
+	// pipelineRunsResource is the object count quota of the PipelineRuns
+	pipelineRunsResource corev1.ResourceName = "count/pipelineruns.tekton.dev"
+)
+
+// Quotas limits the PipelineRuns of the namespaces with ResourceQuotas, the quota admission of
+// the API server rejects the PipelineRuns created over the limit of a namespace
+type Quotas struct {
+	kubeClientSet kubernetes.Interface
+}
+
+func New(kubeClientSet kubernetes.Interface) *Quotas {
+	return &Quotas{kubeClientSet: kubeClientSet}
+}
+
+// Reconcile creates or updates the ResourceQuota of the selected namespaces, with the limit of
+// TektonConfig or of the operator.tekton.dev/max-pipelineruns annotation of the namespace. The
+// ResourceQuotas of the namespaces which are no longer limited are removed.
+func (q *Quotas) Reconcile(ctx context.Context, tc *v1alpha1.TektonConfig) error {
+	logger := logging.FromContext(ctx).Named("concurrency")
+	spec := tc.Spec.Concurrency
+
+	// the limit expected in each namespace
+	desired := map[string]int64{}
+	var errs []error
+	if spec != nil && spec.MaxPipelineRunsPerNamespace > 0 {
+		selector := labels.Everything()
+		if spec.NamespaceSelector != nil {
+			var err error
+			if selector, err = metav1.LabelSelectorAsSelector(spec.NamespaceSelector); err != nil {
+				return fmt.Errorf("invalid namespace selector of the concurrency limits: %w", err)
+			}
+		}
+		namespaces, err := q.kubeClientSet.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return err
+		}
+		ownerRef := *metav1.NewControllerRef(tc, tc.GetGroupVersionKind())
+		ignorePattern := regexp.MustCompile(common.NamespaceIgnorePattern)
+		for _, ns := range namespaces.Items {
+			if ignorePattern.MatchString(ns.Name) || ns.Status.Phase == corev1.NamespaceTerminating ||
+				!selector.Matches(labels.Set(ns.Labels)) {
+				continue
+			}
+			limit := spec.MaxPipelineRunsPerNamespace
+			if value, ok := ns.Annotations[v1alpha1.NamespaceMaxPipelineRunsAnnotation]; ok {
+				if limit, err = strconv.ParseInt(value, 10, 64); err != nil || limit < 0 {
+					errs = append(errs, fmt.Errorf("invalid annotation %s of namespace %s: %q is not a non-negative integer",
+						v1alpha1.NamespaceMaxPipelineRunsAnnotation, ns.Name, value))
+					continue
+				}
+			}
+			if limit == 0 {
+				continue
+			}
+			desired[ns.Name] = limit
+			err := q.ensureQuota(ctx, ns.Name, limit, ownerRef)
+			if common.NamespaceGoingAway(ctx, q.kubeClientSet, ns.Name, err) {
+				logger.Infof("namespace %s is terminating, skipping the pipelinerun quota", ns.Name)
+				continue
+			}
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed to limit the pipelineruns of namespace %s: %w", ns.Name, err))
+			}
+		}
+	}
+
+	quotas, err := q.kubeClientSet.CoreV1().ResourceQuotas(metav1.NamespaceAll).List(ctx, metav1.ListOptions{LabelSelector: quotaLabel})
+	if err != nil {
+		return errors.Join(append(errs, err)...)
+	}
+	for _, rq := range quotas.Items {
+		if _, ok := desired[rq.Namespace]; ok && rq.Name == v1alpha1.PipelineRunQuotaName {
+			continue
+		}
+		logger.Infof("removing resourcequota %s/%s, the pipelineruns of the namespace are no longer limited", rq.Namespace, rq.Name)
+		if err := q.kubeClientSet.CoreV1().ResourceQuotas(rq.Namespace).Delete(ctx, rq.Name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("failed to remove resourcequota %s/%s: %w", rq.Namespace, rq.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ensureQuota creates the ResourceQuota of the namespace or updates its limit
+func (q *Quotas) ensureQuota(ctx context.Context, namespace string, limit int64, ownerRef metav1.OwnerReference) error {
+	rqClient := q.kubeClientSet.CoreV1().ResourceQuotas(namespace)
+	hard := corev1.ResourceList{pipelineRunsResource: *resource.NewQuantity(limit, resource.DecimalSI)}
+	existing, err := rqClient.Get(ctx, v1alpha1.PipelineRunQuotaName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		logging.FromContext(ctx).Infof("limiting the pipelineruns of namespace %s to %d", namespace, limit)
+		_, err = rqClient.Create(ctx, &corev1.ResourceQuota{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            v1alpha1.PipelineRunQuotaName,
+				Namespace:       namespace,
+				Labels:          map[string]string{quotaLabel: "true"},
+				OwnerReferences: []metav1.OwnerReference{ownerRef},
+			},
+			Spec: corev1.ResourceQuotaSpec{Hard: hard},
+		}, metav1.CreateOptions{})
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	if current, ok := existing.Spec.Hard[pipelineRunsResource]; ok && current.Value() == limit &&
+		len(existing.Spec.Hard) == 1 && existing.Labels[quotaLabel] == "true" {
+		return nil
+	}
+	logging.FromContext(ctx).Infof("limiting the pipelineruns of namespace %s to %d", namespace, limit)
+	existing = existing.DeepCopy()
+	if existing.Labels == nil {
+		existing.Labels = map[string]string{}
+	}
+	existing.Labels[quotaLabel] = "true"
+	existing.Spec.Hard = hard
+	_, err = rqClient.Update(ctx, existing, metav1.UpdateOptions{})
+	return err
+}
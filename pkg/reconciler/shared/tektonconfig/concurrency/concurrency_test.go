@@ -0,0 +1,119 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package concurrency
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func namespace(name string, labels, annotations map[string]string) *corev1.Namespace {
+	return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels, Annotations: annotations}}
+}
+
+func limit(t *testing.T, kubeClient *fake.Clientset, ns string) int64 {
+	t.Helper()
+	rq, err := kubeClient.CoreV1().ResourceQuotas(ns).Get(context.TODO(), v1alpha1.PipelineRunQuotaName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return 0
+	}
+	assert.NilError(t, err)
+	assert.Equal(t, rq.Labels[quotaLabel], "true")
+	hard := rq.Spec.Hard[pipelineRunsResource]
+	return hard.Value()
+}
+
+func TestReconcile(t *testing.T) {
+	kubeClient := fake.NewSimpleClientset(
+		namespace("team-a", map[string]string{"tier": "shared"}, nil),
+		namespace("team-b", map[string]string{"tier": "shared"}, map[string]string{v1alpha1.NamespaceMaxPipelineRunsAnnotation: "50"}),
+		namespace("team-c", map[string]string{"tier": "shared"}, map[string]string{v1alpha1.NamespaceMaxPipelineRunsAnnotation: "0"}),
+		namespace("team-d", nil, nil),
+		namespace("kube-system", map[string]string{"tier": "shared"}, nil),
+	)
+	tc := &v1alpha1.TektonConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.ConfigResourceName},
+		Spec: v1alpha1.TektonConfigSpec{Concurrency: &v1alpha1.Concurrency{
+			MaxPipelineRunsPerNamespace: 10,
+			NamespaceSelector:           &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "shared"}},
+		}},
+	}
+	q := New(kubeClient)
+
+	assert.NilError(t, q.Reconcile(context.TODO(), tc))
+	for ns, want := range map[string]int64{"team-a": 10, "team-b": 50, "team-c": 0, "team-d": 0, "kube-system": 0} {
+		assert.Equal(t, limit(t, kubeClient, ns), want, "unexpected limit of namespace %s", ns)
+	}
+
+	// the limit is updated
+	tc.Spec.Concurrency.MaxPipelineRunsPerNamespace = 20
+	assert.NilError(t, q.Reconcile(context.TODO(), tc))
+	assert.Equal(t, limit(t, kubeClient, "team-a"), int64(20))
+
+	// the quotas are removed when the limits are disabled
+	tc.Spec.Concurrency.MaxPipelineRunsPerNamespace = 0
+	assert.NilError(t, q.Reconcile(context.TODO(), tc))
+	for _, ns := range []string{"team-a", "team-b"} {
+		assert.Equal(t, limit(t, kubeClient, ns), int64(0), "unexpected limit of namespace %s", ns)
+	}
+}
+
+func TestReconcileInvalidAnnotation(t *testing.T) {
+	kubeClient := fake.NewSimpleClientset(
+		namespace("team-a", nil, map[string]string{v1alpha1.NamespaceMaxPipelineRunsAnnotation: "unlimited"}),
+		namespace("team-b", nil, nil),
+	)
+	tc := &v1alpha1.TektonConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.ConfigResourceName},
+		Spec:       v1alpha1.TektonConfigSpec{Concurrency: &v1alpha1.Concurrency{MaxPipelineRunsPerNamespace: 10}},
+	}
+	err := New(kubeClient).Reconcile(context.TODO(), tc)
+	assert.ErrorContains(t, err, `invalid annotation operator.tekton.dev/max-pipelineruns of namespace team-a: "unlimited" is not a non-negative integer`)
+	assert.Equal(t, limit(t, kubeClient, "team-b"), int64(10))
+}
+
+func TestReconcileTerminatingNamespace(t *testing.T) {
+	kubeClient := fake.NewSimpleClientset(namespace("team-a", nil, nil), namespace("team-b", nil, nil))
+	// team-b enters Terminating after it was listed
+	kubeClient.PrependReactor("create", "resourcequotas", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if action.GetNamespace() != "team-b" {
+			return false, nil, nil
+		}
+		err := apierrors.NewForbidden(schema.GroupResource{Resource: "resourcequotas"}, v1alpha1.PipelineRunQuotaName,
+			errors.New("unable to create new content in namespace team-b because it is being terminated"))
+		err.ErrStatus.Details.Causes = []metav1.StatusCause{{Type: corev1.NamespaceTerminatingCause, Field: "team-b"}}
+		return true, nil, err
+	})
+	tc := &v1alpha1.TektonConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.ConfigResourceName},
+		Spec:       v1alpha1.TektonConfigSpec{Concurrency: &v1alpha1.Concurrency{MaxPipelineRunsPerNamespace: 10}},
+	}
+
+	assert.NilError(t, New(kubeClient).Reconcile(context.TODO(), tc))
+	assert.Equal(t, limit(t, kubeClient, "team-a"), int64(10))
+}
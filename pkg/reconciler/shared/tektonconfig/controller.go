@@ -20,6 +20,7 @@ import (
 	"context"
 	"os"
 	"regexp"
+	"sync"
 
 	"github.com/go-logr/zapr"
 	mfc "github.com/manifestival/client-go-client"
@@ -34,9 +35,28 @@ import (
 	tektonTriggerinformer "github.com/tektoncd/operator/pkg/client/injection/informers/operator/v1alpha1/tektontrigger"
 	tektonConfigreconciler "github.com/tektoncd/operator/pkg/client/injection/reconciler/operator/v1alpha1/tektonconfig"
 	"github.com/tektoncd/operator/pkg/reconciler/common"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/handover"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/permissions"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/concurrency"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/deprecation"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/drift"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/notifications"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/onboarding"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/orphanedpvc"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/outputs"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/paramsfrom"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/policies"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/progress"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/propagation"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/proxyrotation"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/switchover"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/tlspolicy"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/trustedca"
 	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/upgrade"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/vulnerability"
 	"go.uber.org/zap"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/tools/cache"
 	kubeclient "knative.dev/pkg/client/injection/kube/client"
 	namespaceinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/namespace"
@@ -45,6 +65,7 @@ import (
 	"knative.dev/pkg/injection"
 	"knative.dev/pkg/kmeta"
 	"knative.dev/pkg/logging"
+	"knative.dev/pkg/system"
 )
 
 // NewExtensibleController returns a controller extended to a specific platform
@@ -75,52 +96,84 @@ func NewExtensibleController(generator common.ExtensionGenerator) injection.Cont
 			manifest:          manifest,
 			operatorVersion:   operatorVer,
 		}
-		c.upgrade = upgrade.New(operatorVer, c.kubeClientSet, c.operatorClientSet, injection.GetConfig(ctx))
+		c.upgrade = upgrade.New(operatorVer, c.kubeClientSet, c.operatorClientSet, injection.GetConfig(ctx)).WithHandover(handover.FromContext(ctx))
+		c.switchover = switchover.New(operatorVer, c.kubeClientSet, c.operatorClientSet)
+		c.onboarding = onboarding.New(c.kubeClientSet, manifest.Client)
+		c.outputs = outputs.New(operatorVer, c.kubeClientSet, c.operatorClientSet)
+		c.vulnerability = vulnerability.New(c.kubeClientSet)
+		c.trustedCA = trustedca.New(c.kubeClientSet, manifest.Client, system.Namespace())
+		c.concurrency = concurrency.New(c.kubeClientSet)
+		c.orphanedPVCs = orphanedpvc.New(c.kubeClientSet, dynamic.NewForConfigOrDie(injection.GetConfig(ctx)))
+		c.policies = policies.New(c.kubeClientSet)
+		c.policyBundle = policies.NewBundle(c.kubeClientSet, c.operatorClientSet, operatorVer)
+		c.propagation = propagation.New(c.operatorClientSet)
+		c.deprecation = deprecation.New(c.operatorClientSet)
+		c.notifier = notifications.New(c.kubeClientSet, system.Namespace(), operatorVer)
+		c.progress = progress.New(c.operatorClientSet)
+		c.proxy = proxyrotation.New(c.operatorClientSet)
+		c.permissions = permissions.New(c.kubeClientSet, sync.OnceValues(permissions.PayloadRules))
+		c.tlsPolicy = tlspolicy.New(c.kubeClientSet)
+		c.drift = drift.New(c.operatorClientSet)
+		c.paramsFrom = paramsfrom.New(c.kubeClientSet, system.Namespace())
 
 		impl := tektonConfigreconciler.NewImpl(ctx, c)
 
 		logger.Debug("Setting up event handlers for TektonConfig")
 
-		if _, err := tektonConfiginformer.Get(ctx).Informer().AddEventHandler(controller.HandleAll(impl.Enqueue)); err != nil {
+		if _, err := tektonConfiginformer.Get(ctx).Informer().AddEventHandler(common.HandleSpecChanges(impl.Enqueue)); err != nil {
 			logger.Panicf("Couldn't register TektonConfig informer event handler: %w", err)
 		}
 
 		if _, err := tektonPipelineinformer.Get(ctx).Informer().AddEventHandler(cache.FilteringResourceEventHandler{
 			FilterFunc: controller.FilterController(&v1alpha1.TektonConfig{}),
-			Handler:    controller.HandleAll(impl.EnqueueControllerOf),
+			Handler:    common.HandleReadinessChanges(impl.EnqueueControllerOf),
 		}); err != nil {
 			logger.Panicf("Couldn't register TektonPipeline informer event handler: %w", err)
 		}
 
 		if _, err := tektonTriggerinformer.Get(ctx).Informer().AddEventHandler(cache.FilteringResourceEventHandler{
 			FilterFunc: controller.FilterController(&v1alpha1.TektonConfig{}),
-			Handler:    controller.HandleAll(impl.EnqueueControllerOf),
+			Handler:    common.HandleReadinessChanges(impl.EnqueueControllerOf),
 		}); err != nil {
 			logger.Panicf("Couldn't register TektonTrigger informer event handler: %w", err)
 		}
 
 		if _, err := tektonChaininformer.Get(ctx).Informer().AddEventHandler(cache.FilteringResourceEventHandler{
 			FilterFunc: controller.FilterController(&v1alpha1.TektonConfig{}),
-			Handler:    controller.HandleAll(impl.EnqueueControllerOf),
+			Handler:    common.HandleReadinessChanges(impl.EnqueueControllerOf),
 		}); err != nil {
 			logger.Panicf("Couldn't register TektonChain informer event handler: %w", err)
 		}
 
 		if _, err := tektonResultinformer.Get(ctx).Informer().AddEventHandler(cache.FilteringResourceEventHandler{
 			FilterFunc: controller.FilterController(&v1alpha1.TektonConfig{}),
-			Handler:    controller.HandleAll(impl.EnqueueControllerOf),
+			Handler:    common.HandleReadinessChanges(impl.EnqueueControllerOf),
 		}); err != nil {
 			logger.Panicf("Couldn't register TektonResult informer event handler: %w", err)
 		}
 
 		if _, err := tektonInstallerinformer.Get(ctx).Informer().AddEventHandler(cache.FilteringResourceEventHandler{
 			FilterFunc: controller.FilterController(&v1alpha1.TektonConfig{}),
-			Handler:    controller.HandleAll(impl.EnqueueControllerOf),
+			Handler:    common.HandleReadinessChanges(impl.EnqueueControllerOf),
 		}); err != nil {
 			logger.Panicf("Couldn't register TektonInstallerSet informer event handler: %w", err)
 		}
 
-		if _, err := namespaceinformer.Get(ctx).Informer().AddEventHandler(controller.HandleAll(enqueueCustomName(impl, v1alpha1.ConfigResourceName))); err != nil {
+		// the components edited directly are checked for drift once per interval
+		go c.drift.Run(ctx, tektonConfiginformer.Get(ctx).Lister(), func() {
+			impl.EnqueueKey(types.NamespacedName{Name: v1alpha1.ConfigResourceName})
+		})
+
+		// the changes of the ConfigMaps and Secrets of spec.paramsFrom are resolved by a reconcile
+		paramsfrom.Watch(ctx, c.kubeClientSet, system.Namespace(), tektonConfiginformer.Get(ctx).Lister(), func() {
+			impl.EnqueueKey(types.NamespacedName{Name: v1alpha1.ConfigResourceName})
+		})
+
+		enqueue := enqueueCustomName(impl, v1alpha1.ConfigResourceName)
+		if observer, ok := c.extension.(common.NamespaceObserver); ok {
+			enqueue = observeNamespaces(observer, enqueue)
+		}
+		if _, err := namespaceinformer.Get(ctx).Informer().AddEventHandler(common.HandleNamespaceChanges(enqueue)); err != nil {
 			logger.Panicf("Couldn't register Namespace informer event handler: %w", err)
 		}
 
@@ -149,3 +202,12 @@ func enqueueCustomName(impl *controller.Impl, name string) func(obj interface{})
 		}
 	}
 }
+
+// observeNamespaces notifies the observer of the events of the namespaces before enqueuing
+// TektonConfig, so that the reconcile triggered by an event observes the namespace
+func observeNamespaces(observer common.NamespaceObserver, enqueue func(obj interface{})) func(obj interface{}) {
+	return func(obj interface{}) {
+		observer.NamespaceChanged(obj)
+		enqueue(obj)
+	}
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dependency
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/client/clientset/versioned"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+)
+
+// Component is a component installed by the TektonConfig, named after its kind
+type Component string
+
+const (
+	Pipeline Component = v1alpha1.KindTektonPipeline
+	Trigger  Component = v1alpha1.KindTektonTrigger
+	Chain    Component = v1alpha1.KindTektonChain
+	Addon    Component = v1alpha1.KindTektonAddon
+)
+
+// graph holds the components each component depends on, a component is installed only
+// once the components it depends on are ready
+var graph = map[Component][]Component{
+	Trigger: {Pipeline},
+	Chain:   {Pipeline},
+	Addon:   {Pipeline, Trigger},
+}
+
+// DependsOn returns the components the component depends on
+func DependsOn(c Component) []Component {
+	return graph[c]
+}
+
+// WaitingError is returned when a component waits on the components it depends on
+type WaitingError struct {
+	Component Component
+	// Waiting holds the reason of each dependency which is not ready
+	Waiting []string
+}
+
+func (e *WaitingError) Error() string {
+	return fmt.Sprintf("%s is waiting on %s", e.Component, strings.Join(e.Waiting, ", "))
+}
+
+// Check returns a WaitingError when one of the components the component depends on is not ready
+func Check(ctx context.Context, client versioned.Interface, c Component) error {
+	var waiting []string
+	for _, dep := range DependsOn(c) {
+		reason, err := notReadyReason(ctx, client, dep)
+		if err != nil {
+			return err
+		}
+		if reason != "" {
+			waiting = append(waiting, reason)
+		}
+	}
+	if len(waiting) > 0 {
+		return &WaitingError{Component: c, Waiting: waiting}
+	}
+	return nil
+}
+
+// Wait gates the install of the component on the components it depends on. The components
+// waited on are reported in the DependenciesReady condition of the TektonConfig, and
+// REQUEUE_EVENT_AFTER is returned until they are ready.
+func Wait(ctx context.Context, client versioned.Interface, tc *v1alpha1.TektonConfig, c Component) error {
+	err := Check(ctx, client, c)
+	var waiting *WaitingError
+	if errors.As(err, &waiting) {
+		tc.Status.MarkWaitingOnDependencies(waiting.Error())
+		tc.Status.MarkComponentNotReady(waiting.Error())
+		return v1alpha1.REQUEUE_EVENT_AFTER
+	}
+	return err
+}
+
+// notReadyReason returns why the component is not ready, or an empty string when it is ready
+func notReadyReason(ctx context.Context, client versioned.Interface, c Component) (string, error) {
+	component, err := get(ctx, client, c)
+	if apierrs.IsNotFound(err) {
+		return fmt.Sprintf("%s (not installed)", c), nil
+	}
+	if err != nil {
+		return "", err
+	}
+	if component.GetStatus().IsReady() {
+		return "", nil
+	}
+	if ready := component.GetStatus().GetCondition(apis.ConditionReady); ready != nil && ready.Message != "" {
+		return fmt.Sprintf("%s (not ready: %s)", c, ready.Message), nil
+	}
+	return fmt.Sprintf("%s (not ready)", c), nil
+}
+
+func get(ctx context.Context, client versioned.Interface, c Component) (v1alpha1.TektonComponent, error) {
+	operator := client.OperatorV1alpha1()
+	switch c {
+	case Pipeline:
+		return operator.TektonPipelines().Get(ctx, v1alpha1.PipelineResourceName, metav1.GetOptions{})
+	case Trigger:
+		return operator.TektonTriggers().Get(ctx, v1alpha1.TriggerResourceName, metav1.GetOptions{})
+	case Chain:
+		return operator.TektonChains().Get(ctx, v1alpha1.ChainResourceName, metav1.GetOptions{})
+	case Addon:
+		return operator.TektonAddons().Get(ctx, v1alpha1.AddonResourceName, metav1.GetOptions{})
+	default:
+		return nil, fmt.Errorf("unknown component %s", c)
+	}
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dependency
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/client/clientset/versioned/fake"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+)
+
+func TestGraphIsAcyclic(t *testing.T) {
+	var visit func(c Component, path map[Component]bool)
+	visit = func(c Component, path map[Component]bool) {
+		assert.Assert(t, !path[c], "dependency cycle through %s", c)
+		path[c] = true
+		for _, dep := range DependsOn(c) {
+			visit(dep, path)
+		}
+		delete(path, c)
+	}
+	for c := range graph {
+		visit(c, map[Component]bool{})
+	}
+}
+
+func readyPipeline() *v1alpha1.TektonPipeline {
+	tp := &v1alpha1.TektonPipeline{ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.PipelineResourceName}}
+	tp.Status.Conditions = []apis.Condition{{Type: apis.ConditionReady, Status: corev1.ConditionTrue}}
+	return tp
+}
+
+func TestCheck(t *testing.T) {
+	notReadyTrigger := &v1alpha1.TektonTrigger{ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.TriggerResourceName}}
+	notReadyTrigger.Status.Conditions = []apis.Condition{{Type: apis.ConditionReady, Status: corev1.ConditionFalse, Message: "installing"}}
+
+	tests := []struct {
+		name      string
+		component Component
+		pipeline  *v1alpha1.TektonPipeline
+		trigger   *v1alpha1.TektonTrigger
+		wantErr   string
+	}{
+		{
+			name:      "pipeline has no dependency",
+			component: Pipeline,
+		},
+		{
+			name:      "pipeline not installed",
+			component: Chain,
+			wantErr:   "TektonChain is waiting on TektonPipeline (not installed)",
+		},
+		{
+			name:      "pipeline ready",
+			component: Trigger,
+			pipeline:  readyPipeline(),
+		},
+		{
+			name:      "trigger not ready",
+			component: Addon,
+			pipeline:  readyPipeline(),
+			trigger:   notReadyTrigger,
+			wantErr:   "TektonAddon is waiting on TektonTrigger (not ready: installing)",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := fake.NewSimpleClientset()
+			if test.pipeline != nil {
+				_, err := client.OperatorV1alpha1().TektonPipelines().Create(context.TODO(), test.pipeline, metav1.CreateOptions{})
+				assert.NilError(t, err)
+			}
+			if test.trigger != nil {
+				_, err := client.OperatorV1alpha1().TektonTriggers().Create(context.TODO(), test.trigger, metav1.CreateOptions{})
+				assert.NilError(t, err)
+			}
+			err := Check(context.TODO(), client, test.component)
+			if test.wantErr != "" {
+				assert.Error(t, err, test.wantErr)
+				return
+			}
+			assert.NilError(t, err)
+		})
+	}
+}
+
+func TestWait(t *testing.T) {
+	tc := &v1alpha1.TektonConfig{}
+	err := Wait(context.TODO(), fake.NewSimpleClientset(), tc, Trigger)
+	assert.Equal(t, err, v1alpha1.REQUEUE_EVENT_AFTER)
+	condition := tc.Status.GetCondition(v1alpha1.DependenciesReady)
+	assert.Assert(t, condition.IsFalse())
+	assert.Equal(t, condition.Reason, "WaitingOnDependencies")
+	assert.Equal(t, condition.Message, "TektonTrigger is waiting on TektonPipeline (not installed)")
+
+	assert.NilError(t, Wait(context.TODO(), fake.NewSimpleClientset(readyPipeline()), tc, Trigger))
+}
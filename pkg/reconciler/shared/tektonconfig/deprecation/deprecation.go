@@ -0,0 +1,245 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deprecation
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	clientset "github.com/tektoncd/operator/pkg/client/clientset/versioned"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/logging"
+	"knative.dev/pkg/metrics"
+)
+
+var (
+	deprecatedFields = stats.Int64("deprecated_fields",
+		"deprecated fields set in TektonConfig and the component resources, 1 when the field is set",
+		stats.UnitDimensionless)
+
+	resourceTagKey = tag.MustNewKey("resource")
+	fieldTagKey    = tag.MustNewKey("field")
+
+	registerViews = sync.OnceValue(func() error {
+		return view.Register(&view.View{
+			Description: deprecatedFields.Description(),
+			Measure:     deprecatedFields,
+			Aggregation: view.LastValue(),
+			TagKeys:     []tag.Key{resourceTagKey, fieldTagKey},
+		})
+	})
+)
+
+// Usage is a deprecated field set in a resource
+type Usage struct {
+	// Resource is the kind and the name of the resource, e.g. TektonConfig/config
+	Resource string
+	// Field is the path of the field in the resource
+	Field string
+	// Replacement is the guidance to migrate away from the field
+	Replacement string
+}
+
+func (u Usage) String() string {
+	return fmt.Sprintf("%s %s: %s", u.Resource, u.Field, u.Replacement)
+}
+
+// pipelineFields are the deprecated fields of the pipeline properties, by path relative to the properties
+func pipelineFields(p v1alpha1.PipelineProperties) []Usage {
+	var usages []Usage
+	if p.EnableTektonOciBundles != nil {
+		usages = append(usages, Usage{Field: "enable-tekton-oci-bundles",
+			Replacement: "removed from Tekton Pipelines, reference the bundles with the bundles resolver"})
+	}
+	if p.VerificationMode != "" {
+		usages = append(usages, Usage{Field: "verification-mode",
+			Replacement: "not used, configure trusted-resources-verification-no-match-policy"})
+	}
+	if p.ScopeWhenExpressionsToTask != nil {
+		usages = append(usages, Usage{Field: "scope-when-expressions-to-task",
+			Replacement: "not used, the when expressions are always scoped to the task"})
+	}
+	if p.DisableAffinityAssistant != nil {
+		usages = append(usages, Usage{Field: "disable-affinity-assistant",
+			Replacement: "removed from Tekton Pipelines, configure coschedule"})
+	}
+	return usages
+}
+
+func resultFields(r v1alpha1.ResultsAPIProperties) []Usage {
+	if r.TLSHostnameOverride == "" {
+		return nil
+	}
+	return []Usage{{Field: "tls_hostname_override", Replacement: "not used, remove it"}}
+}
+
+func addonFields(a v1alpha1.Addon) []Usage {
+	if a.EnablePAC == nil {
+		return nil
+	}
+	return []Usage{{Field: "enablePipelinesAsCode", Replacement: "configure spec.platforms.openshift.pipelinesAsCode.enable of TektonConfig"}}
+}
+
+// prefix sets the resource of the usages and prefixes their field with the path of the properties
+func prefix(resource, path string, usages []Usage) []Usage {
+	for i := range usages {
+		usages[i].Resource = resource
+		if path != "" {
+			usages[i].Field = path + "." + usages[i].Field
+		}
+	}
+	return usages
+}
+
+// ScanTektonConfig returns the deprecated fields set in TektonConfig
+func ScanTektonConfig(tc *v1alpha1.TektonConfig) []Usage {
+	resource := "TektonConfig/" + tc.Name
+	var usages []Usage
+	usages = append(usages, prefix(resource, "spec.pipeline", pipelineFields(tc.Spec.Pipeline.PipelineProperties))...)
+	usages = append(usages, prefix(resource, "spec.result", resultFields(tc.Spec.Result.ResultsAPIProperties))...)
+	usages = append(usages, prefix(resource, "spec.addon", addonFields(tc.Spec.Addon))...)
+	return usages
+}
+
+// scanComponents returns the deprecated fields set in the component resources, the components which
+// are not installed are skipped
+func scanComponents(ctx context.Context, client clientset.Interface) ([]Usage, error) {
+	var usages []Usage
+	operator := client.OperatorV1alpha1()
+
+	tp, err := operator.TektonPipelines().Get(ctx, v1alpha1.PipelineResourceName, metav1.GetOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+	if err == nil {
+		usages = append(usages, prefix("TektonPipeline/"+tp.Name, "spec", pipelineFields(tp.Spec.PipelineProperties))...)
+	}
+
+	tr, err := operator.TektonResults().Get(ctx, v1alpha1.ResultResourceName, metav1.GetOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+	if err == nil {
+		usages = append(usages, prefix("TektonResult/"+tr.Name, "spec", resultFields(tr.Spec.ResultsAPIProperties))...)
+	}
+
+	ta, err := operator.TektonAddons().Get(ctx, v1alpha1.AddonResourceName, metav1.GetOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+	if err == nil {
+		usages = append(usages, prefix("TektonAddon/"+ta.Name, "spec", addonFields(ta.Spec.Addon))...)
+	}
+
+	th, err := operator.TektonHubs().Get(ctx, v1alpha1.HubResourceName, metav1.GetOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+	if err == nil && th.Spec.Api.HubConfigUrl != "" {
+		usages = append(usages, Usage{Resource: "TektonHub/" + th.Name, Field: "spec.api.hubConfigUrl",
+			Replacement: "configure the categories and the catalogs in spec.categories and spec.catalogs"})
+	}
+	return usages, nil
+}
+
+// Scanner reports the deprecated fields set in TektonConfig and the component resources in the
+// DeprecationsResolved condition of TektonConfig and in the deprecated_fields metric
+type Scanner struct {
+	operatorClientSet clientset.Interface
+
+	mutex sync.Mutex
+	// reported are the usages last reported in the metric, they are reset once resolved
+	reported map[Usage]bool
+}
+
+func New(operatorClientSet clientset.Interface) *Scanner {
+	return &Scanner{operatorClientSet: operatorClientSet, reported: map[Usage]bool{}}
+}
+
+// Reconcile scans the resources and updates the condition of TektonConfig. The fields are read from the
+// resources as they are stored, the defaulting of the operator webhook clears most of them on the next update.
+func (s *Scanner) Reconcile(ctx context.Context, tc *v1alpha1.TektonConfig) {
+	logger := logging.FromContext(ctx)
+	usages := ScanTektonConfig(tc)
+	components, err := scanComponents(ctx, s.operatorClientSet)
+	if err != nil {
+		// the condition is kept as it is until the components can be read
+		logger.Debugf("failed to scan the components for deprecated fields: %v", err)
+		return
+	}
+	usages = append(usages, components...)
+	sort.Slice(usages, func(i, j int) bool {
+		if usages[i].Resource != usages[j].Resource {
+			return usages[i].Resource < usages[j].Resource
+		}
+		return usages[i].Field < usages[j].Field
+	})
+
+	if len(usages) == 0 {
+		tc.Status.MarkDeprecationsResolved()
+	} else {
+		messages := make([]string, 0, len(usages))
+		for _, u := range usages {
+			messages = append(messages, u.String())
+		}
+		tc.Status.MarkDeprecatedFieldsInUse("deprecated fields are set: " + strings.Join(messages, "; "))
+	}
+	s.record(ctx, usages)
+}
+
+// record sets the metric of the usages to 1, and resets the metric of the resolved usages to 0
+func (s *Scanner) record(ctx context.Context, usages []Usage) {
+	if err := registerViews(); err != nil {
+		logging.FromContext(ctx).Debugf("failed to register the deprecated fields metrics: %v", err)
+		return
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	current := map[Usage]bool{}
+	for _, u := range usages {
+		current[key(u)] = true
+		s.reported[key(u)] = true
+	}
+	for u := range s.reported {
+		value := int64(0)
+		if current[u] {
+			value = 1
+		}
+		metricsCtx, err := tag.New(context.Background(), tag.Insert(resourceTagKey, u.Resource), tag.Insert(fieldTagKey, u.Field))
+		if err != nil {
+			logging.FromContext(ctx).Debugf("failed to record the deprecated fields metrics: %v", err)
+			continue
+		}
+		metrics.Record(metricsCtx, deprecatedFields.M(value))
+		if !current[u] {
+			delete(s.reported, u)
+		}
+	}
+}
+
+// key is the usage identifying a metric row
+func key(u Usage) Usage {
+	return Usage{Resource: u.Resource, Field: u.Field}
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deprecation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/client/clientset/versioned/fake"
+	"go.opencensus.io/stats/view"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	_ "knative.dev/pkg/metrics/testing"
+	"knative.dev/pkg/ptr"
+)
+
+// lastValue returns the last value of the deprecated_fields row of the field, the rows of
+// the other fields are reported in any order
+func lastValue(t *testing.T, resource, field string) float64 {
+	t.Helper()
+	rows, err := view.RetrieveData(deprecatedFields.Name())
+	assert.NilError(t, err)
+	for _, row := range rows {
+		tags := map[string]string{}
+		for _, tag := range row.Tags {
+			tags[tag.Key.Name()] = tag.Value
+		}
+		if tags["resource"] == resource && tags["field"] == field {
+			return row.Data.(*view.LastValueData).Value
+		}
+	}
+	t.Fatalf("no deprecated_fields row for %s %s", resource, field)
+	return 0
+}
+
+func TestReconcile(t *testing.T) {
+	ctx := context.TODO()
+	tc := &v1alpha1.TektonConfig{ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.ConfigResourceName}}
+	tc.Spec.Pipeline.ScopeWhenExpressionsToTask = ptr.Bool(true)
+	tc.Spec.Addon.EnablePAC = ptr.Bool(false)
+	tp := &v1alpha1.TektonPipeline{ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.PipelineResourceName}}
+	tp.Spec.DisableAffinityAssistant = ptr.Bool(true)
+	th := &v1alpha1.TektonHub{ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.HubResourceName}}
+	th.Spec.Api.HubConfigUrl = "https://example.com/hub/config.yaml"
+	client := fake.NewSimpleClientset(tp, th)
+	s := New(client)
+
+	s.Reconcile(ctx, tc)
+	cond := tc.Status.GetCondition(v1alpha1.DeprecationsResolved)
+	assert.Assert(t, cond != nil && cond.IsFalse())
+	assert.Equal(t, cond.Reason, "DeprecatedFieldsInUse")
+	assert.Equal(t, cond.Message, "deprecated fields are set: "+
+		"TektonConfig/config spec.addon.enablePipelinesAsCode: configure spec.platforms.openshift.pipelinesAsCode.enable of TektonConfig; "+
+		"TektonConfig/config spec.pipeline.scope-when-expressions-to-task: not used, the when expressions are always scoped to the task; "+
+		"TektonHub/hub spec.api.hubConfigUrl: configure the categories and the catalogs in spec.categories and spec.catalogs; "+
+		"TektonPipeline/pipeline spec.disable-affinity-assistant: removed from Tekton Pipelines, configure coschedule")
+	// the deprecations do not affect the readiness
+	tc.Status.MarkPreInstallComplete()
+	tc.Status.MarkComponentsReady()
+	tc.Status.MarkPostInstallComplete()
+	tc.Status.MarkPreUpgradeComplete()
+	tc.Status.MarkPostUpgradeComplete()
+	assert.Assert(t, tc.Status.IsReady())
+	assert.Equal(t, lastValue(t, "TektonPipeline/pipeline", "spec.disable-affinity-assistant"), 1.0)
+
+	// the migrated fields are resolved
+	tc.Spec.Pipeline.ScopeWhenExpressionsToTask = nil
+	tc.Spec.Addon.EnablePAC = nil
+	tp.Spec.DisableAffinityAssistant = nil
+	_, err := client.OperatorV1alpha1().TektonPipelines().Update(ctx, tp, metav1.UpdateOptions{})
+	assert.NilError(t, err)
+	assert.NilError(t, client.OperatorV1alpha1().TektonHubs().Delete(ctx, th.Name, metav1.DeleteOptions{}))
+	s.Reconcile(ctx, tc)
+	cond = tc.Status.GetCondition(v1alpha1.DeprecationsResolved)
+	assert.Equal(t, cond.Status, corev1.ConditionTrue)
+	assert.Equal(t, lastValue(t, "TektonPipeline/pipeline", "spec.disable-affinity-assistant"), 0.0)
+}
@@ -0,0 +1,326 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drift
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	clientset "github.com/tektoncd/operator/pkg/client/clientset/versioned"
+	listers "github.com/tektoncd/operator/pkg/client/listers/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/hash"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/chain"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/pipeline"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/result"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/trigger"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/logging"
+)
+
+const (
+	defaultInterval = 10 * time.Minute
+
+	// DriftReason is the reason of the events of the drifted components
+	DriftReason = "ConfigDrift"
+)
+
+type component struct {
+	kind    string
+	get     func(ctx context.Context, c clientset.Interface) (interface{}, error)
+	desired func(tc *v1alpha1.TektonConfig) interface{}
+}
+
+// components are the components whose fields are set from TektonConfig
+var components = []component{
+	{
+		kind: v1alpha1.KindTektonPipeline,
+		get: func(ctx context.Context, c clientset.Interface) (interface{}, error) {
+			return c.OperatorV1alpha1().TektonPipelines().Get(ctx, v1alpha1.PipelineResourceName, metav1.GetOptions{})
+		},
+		desired: func(tc *v1alpha1.TektonConfig) interface{} { return pipeline.GetTektonPipelineCR(tc, "") },
+	},
+	{
+		kind: v1alpha1.KindTektonTrigger,
+		get: func(ctx context.Context, c clientset.Interface) (interface{}, error) {
+			return c.OperatorV1alpha1().TektonTriggers().Get(ctx, v1alpha1.TriggerResourceName, metav1.GetOptions{})
+		},
+		desired: func(tc *v1alpha1.TektonConfig) interface{} { return trigger.GetTektonTriggerCR(tc, "") },
+	},
+	{
+		kind: v1alpha1.KindTektonChain,
+		get: func(ctx context.Context, c clientset.Interface) (interface{}, error) {
+			return c.OperatorV1alpha1().TektonChains().Get(ctx, v1alpha1.ChainResourceName, metav1.GetOptions{})
+		},
+		desired: func(tc *v1alpha1.TektonConfig) interface{} { return chain.GetTektonChainCR(tc, "") },
+	},
+	{
+		kind: v1alpha1.KindTektonResult,
+		get: func(ctx context.Context, c clientset.Interface) (interface{}, error) {
+			return c.OperatorV1alpha1().TektonResults().Get(ctx, v1alpha1.ResultResourceName, metav1.GetOptions{})
+		},
+		desired: func(tc *v1alpha1.TektonConfig) interface{} { return result.GetTektonResultCR(tc, "") },
+	},
+}
+
+// fields returns the kind of the component and pointers to the fields updated from TektonConfig,
+// by their path in TektonConfig
+func fields(obj interface{}) (string, map[string]interface{}) {
+	switch c := obj.(type) {
+	case *v1alpha1.TektonPipeline:
+		return v1alpha1.KindTektonPipeline, map[string]interface{}{
+			"spec.targetNamespace": &c.Spec.TargetNamespace,
+			"spec.pipeline":        &c.Spec.Pipeline,
+			"spec.config":          &c.Spec.Config,
+		}
+	case *v1alpha1.TektonTrigger:
+		return v1alpha1.KindTektonTrigger, map[string]interface{}{
+			"spec.targetNamespace": &c.Spec.TargetNamespace,
+			"spec.trigger":         &c.Spec.Trigger,
+			"spec.config":          &c.Spec.Config,
+		}
+	case *v1alpha1.TektonChain:
+		return v1alpha1.KindTektonChain, map[string]interface{}{
+			"spec.targetNamespace": &c.Spec.TargetNamespace,
+			"spec.chain":           &c.Spec.Chain,
+			"spec.config":          &c.Spec.Config,
+		}
+	case *v1alpha1.TektonResult:
+		return v1alpha1.KindTektonResult, map[string]interface{}{
+			"spec.targetNamespace":             &c.Spec.TargetNamespace,
+			"spec.result":                      &c.Spec.ResultsAPIProperties,
+			"spec.result.loki_stack_name":      &c.Spec.LokiStackName,
+			"spec.result.loki_stack_namespace": &c.Spec.LokiStackNamespace,
+			"spec.result.options":              &c.Spec.Options,
+			"spec.result.performance":          &c.Spec.Performance,
+			"spec.result.retention_policy":     &c.Spec.RetentionPolicy,
+			"spec.config":                      &c.Spec.Config,
+		}
+	}
+	return "", nil
+}
+
+// diff returns the fields whose live value differs from the desired value, in the order of their
+// paths. The values are compared in JSON, as the empty and unset values are the same once stored.
+func diff(desired, live map[string]interface{}) []string {
+	var changed []string
+	for path, value := range desired {
+		want, err := json.Marshal(value)
+		if err != nil {
+			continue
+		}
+		got, err := json.Marshal(live[path])
+		if err != nil || string(got) != string(want) {
+			changed = append(changed, path)
+		}
+	}
+	sort.Strings(changed)
+	return changed
+}
+
+// drifted returns the fields of the component changed since it was last found in sync with
+// TektonConfig. The fields differing from TektonConfig are not drifted when the component did not
+// change since, as TektonConfig changed and is being propagated to the component.
+func drifted(desired, live map[string]interface{}, applied string) []string {
+	changed := diff(desired, live)
+	if len(changed) == 0 || applied == "" {
+		return nil
+	}
+	if h, err := hash.Compute(live); err != nil || h == applied {
+		return nil
+	}
+	return changed
+}
+
+// Detector compares the fields of the components set from TektonConfig with their live values.
+// The components edited directly are reported in the status of TektonConfig, their fields are
+// kept with the Report policy and restored by the reconcile of TektonConfig with the Repair policy.
+type Detector struct {
+	operatorClientSet clientset.Interface
+	now               func() time.Time
+}
+
+func New(operatorClientSet clientset.Interface) *Detector {
+	return &Detector{operatorClientSet: operatorClientSet, now: time.Now}
+}
+
+// Reconcile checks the components once per interval when the drift detection is enabled, the
+// status is removed when it is disabled. It is run before the components are updated from
+// TektonConfig, so that the drift is found before it is repaired.
+func (d *Detector) Reconcile(ctx context.Context, tc *v1alpha1.TektonConfig) {
+	logger := logging.FromContext(ctx).Named("drift")
+	spec := tc.Spec.DriftDetection
+	if spec == nil || !spec.Enabled {
+		tc.Status.Drift = nil
+		return
+	}
+	now := d.now()
+	previous := tc.Status.Drift
+	if previous == nil {
+		previous = &v1alpha1.ConfigDriftStatus{}
+	} else if now.Sub(previous.LastCheckTime.Time) < interval(ctx, spec.Interval) {
+		return
+	}
+	detected := map[string]metav1.Time{}
+	for _, c := range previous.Components {
+		detected[c.Kind] = c.DetectedTime
+	}
+
+	status := &v1alpha1.ConfigDriftStatus{LastCheckTime: metav1.NewTime(now), Applied: map[string]string{}}
+	for _, c := range components {
+		live, err := c.get(ctx, d.operatorClientSet)
+		if err != nil {
+			if !apierrors.IsNotFound(err) {
+				logger.Errorf("failed to get %s to check its drift: %v", c.kind, err)
+				if applied, ok := previous.Applied[c.kind]; ok {
+					status.Applied[c.kind] = applied
+				}
+			}
+			continue
+		}
+		_, want := fields(c.desired(tc))
+		_, got := fields(live)
+		if len(diff(want, got)) == 0 {
+			if h, err := hash.Compute(got); err == nil {
+				status.Applied[c.kind] = h
+			}
+			continue
+		}
+		applied := previous.Applied[c.kind]
+		if applied != "" {
+			status.Applied[c.kind] = applied
+		}
+		changed := drifted(want, got, applied)
+		if len(changed) == 0 {
+			continue
+		}
+		drift := v1alpha1.ComponentDrift{
+			Kind:         c.kind,
+			Fields:       changed,
+			DetectedTime: metav1.NewTime(now),
+			Repaired:     spec.GetPolicy() == v1alpha1.DriftPolicyRepair,
+		}
+		if t, ok := detected[c.kind]; ok {
+			drift.DetectedTime = t
+		} else {
+			d.report(ctx, tc, drift)
+		}
+		status.Components = append(status.Components, drift)
+	}
+	if len(status.Applied) == 0 {
+		status.Applied = nil
+	}
+	tc.Status.Drift = status
+}
+
+// report logs and records an event for a newly drifted component
+func (d *Detector) report(ctx context.Context, tc *v1alpha1.TektonConfig, drift v1alpha1.ComponentDrift) {
+	logger := logging.FromContext(ctx).Named("drift")
+	action := "keeping the drifted fields"
+	if drift.Repaired {
+		action = "restoring the fields of TektonConfig"
+	}
+	message := fmt.Sprintf("%s drifted from TektonConfig (%s), %s", drift.Kind, strings.Join(drift.Fields, ", "), action)
+	logger.Warn(message)
+	recorder := controller.GetEventRecorder(ctx)
+	if recorder == nil {
+		logger.Debug("no event recorder in the context to report the drift")
+		return
+	}
+	recorder.Event(tc, corev1.EventTypeWarning, DriftReason, message)
+}
+
+// Preserve sets the drifted fields of the component in the desired component with the Report
+// policy, so that the component is not updated with the fields of TektonConfig. The desired
+// component is not changed with the Repair policy, or before the component was checked once.
+func (d *Detector) Preserve(ctx context.Context, tc *v1alpha1.TektonConfig, desired interface{}) {
+	spec := tc.Spec.DriftDetection
+	if spec == nil || !spec.Enabled || spec.GetPolicy() != v1alpha1.DriftPolicyReport || tc.Status.Drift == nil {
+		return
+	}
+	kind, want := fields(desired)
+	applied := tc.Status.Drift.Applied[kind]
+	if applied == "" {
+		return
+	}
+	for _, c := range components {
+		if c.kind != kind {
+			continue
+		}
+		live, err := c.get(ctx, d.operatorClientSet)
+		if err != nil {
+			return
+		}
+		_, got := fields(live)
+		changed := drifted(want, got, applied)
+		for _, path := range changed {
+			reflect.ValueOf(want[path]).Elem().Set(reflect.ValueOf(got[path]).Elem())
+		}
+		if len(changed) > 0 {
+			logging.FromContext(ctx).Debugf("keeping the drifted fields %s of %s", strings.Join(changed, ", "), kind)
+		}
+		return
+	}
+}
+
+// Run enqueues TektonConfig once per interval while the drift detection is enabled, as the
+// changes of the components do not trigger the reconcile of TektonConfig
+func (d *Detector) Run(ctx context.Context, lister listers.TektonConfigLister, enqueue func()) {
+	for {
+		next := defaultInterval
+		if spec := driftDetection(lister); spec != nil {
+			next = interval(ctx, spec.Interval)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(next):
+		}
+		if driftDetection(lister) != nil {
+			enqueue()
+		}
+	}
+}
+
+// driftDetection returns the enabled drift detection of TektonConfig, nil when it is disabled
+func driftDetection(lister listers.TektonConfigLister) *v1alpha1.DriftDetection {
+	tc, err := lister.Get(v1alpha1.ConfigResourceName)
+	if err != nil || tc.Spec.DriftDetection == nil || !tc.Spec.DriftDetection.Enabled {
+		return nil
+	}
+	return tc.Spec.DriftDetection
+}
+
+func interval(ctx context.Context, value string) time.Duration {
+	if value == "" {
+		return defaultInterval
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		logging.FromContext(ctx).Warnf("invalid interval %q of the drift detection, using default %s", value, defaultInterval)
+		return defaultInterval
+	}
+	return d
+}
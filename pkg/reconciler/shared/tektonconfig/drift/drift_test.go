@@ -0,0 +1,119 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drift
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	fakeoperator "github.com/tektoncd/operator/pkg/client/clientset/versioned/fake"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/pipeline"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var now = time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+func tektonConfig(policy string) *v1alpha1.TektonConfig {
+	tc := &v1alpha1.TektonConfig{ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.ConfigResourceName}}
+	tc.Spec.TargetNamespace = "tekton-pipelines"
+	tc.Spec.Pipeline.EnableApiFields = "beta"
+	tc.Spec.DriftDetection = &v1alpha1.DriftDetection{Enabled: true, Policy: policy}
+	return tc
+}
+
+func TestDetector(t *testing.T) {
+	ctx := context.TODO()
+	tc := tektonConfig(v1alpha1.DriftPolicyReport)
+	operatorClient := fakeoperator.NewSimpleClientset(pipeline.GetTektonPipelineCR(tc, "v0.70.0"))
+	clock := now
+	d := &Detector{operatorClientSet: operatorClient, now: func() time.Time { return clock }}
+
+	// the components in sync are recorded
+	d.Reconcile(ctx, tc)
+	assert.Equal(t, len(tc.Status.Drift.Components), 0)
+	assert.Assert(t, tc.Status.Drift.Applied[v1alpha1.KindTektonPipeline] != "")
+
+	// a change of TektonConfig is not a drift, it is propagated to the component
+	tc.Spec.Pipeline.EnableApiFields = "alpha"
+	clock = clock.Add(defaultInterval)
+	d.Reconcile(ctx, tc)
+	assert.Equal(t, len(tc.Status.Drift.Components), 0)
+	desired := pipeline.GetTektonPipelineCR(tc, "v0.70.0")
+	d.Preserve(ctx, tc, desired)
+	assert.Equal(t, desired.Spec.Pipeline.EnableApiFields, "alpha")
+
+	// an edit of the component is a drift, kept with the Report policy
+	tp, err := operatorClient.OperatorV1alpha1().TektonPipelines().Get(ctx, v1alpha1.PipelineResourceName, metav1.GetOptions{})
+	assert.NilError(t, err)
+	tp.Spec.Pipeline.EnableApiFields = "stable"
+	_, err = operatorClient.OperatorV1alpha1().TektonPipelines().Update(ctx, tp, metav1.UpdateOptions{})
+	assert.NilError(t, err)
+	tc.Spec.Pipeline.EnableApiFields = "beta"
+
+	// the components are not checked before the interval
+	d.Reconcile(ctx, tc)
+	assert.Equal(t, len(tc.Status.Drift.Components), 0)
+
+	clock = clock.Add(defaultInterval)
+	d.Reconcile(ctx, tc)
+	assert.DeepEqual(t, tc.Status.Drift.Components, []v1alpha1.ComponentDrift{{
+		Kind:         v1alpha1.KindTektonPipeline,
+		Fields:       []string{"spec.pipeline"},
+		DetectedTime: metav1.NewTime(clock),
+	}})
+	desired = pipeline.GetTektonPipelineCR(tc, "v0.70.0")
+	d.Preserve(ctx, tc, desired)
+	assert.Equal(t, desired.Spec.Pipeline.EnableApiFields, "stable")
+
+	// the time of the drift is kept by the next checks
+	detected := clock
+	clock = clock.Add(defaultInterval)
+	d.Reconcile(ctx, tc)
+	assert.Equal(t, tc.Status.Drift.Components[0].DetectedTime.Time, detected)
+
+	// the drift is restored by the reconcile with the Repair policy
+	tc.Spec.DriftDetection.Policy = v1alpha1.DriftPolicyRepair
+	clock = clock.Add(defaultInterval)
+	d.Reconcile(ctx, tc)
+	assert.Assert(t, tc.Status.Drift.Components[0].Repaired)
+	desired = pipeline.GetTektonPipelineCR(tc, "v0.70.0")
+	d.Preserve(ctx, tc, desired)
+	assert.Equal(t, desired.Spec.Pipeline.EnableApiFields, "beta")
+
+	// the status is removed when the drift detection is disabled
+	tc.Spec.DriftDetection.Enabled = false
+	d.Reconcile(ctx, tc)
+	assert.Assert(t, tc.Status.Drift == nil)
+}
+
+func TestDiff(t *testing.T) {
+	desired := &v1alpha1.TektonResult{}
+	live := &v1alpha1.TektonResult{}
+	live.Spec.Options.ConfigMaps = map[string]corev1.ConfigMap{}
+	_, want := fields(desired)
+	_, got := fields(live)
+	// the empty and unset values are the same once stored
+	assert.Equal(t, len(diff(want, got)), 0)
+
+	live.Spec.TargetNamespace = "other"
+	live.Spec.LokiStackName = "loki"
+	assert.DeepEqual(t, diff(want, got), []string{"spec.result.loki_stack_name", "spec.targetNamespace"})
+}
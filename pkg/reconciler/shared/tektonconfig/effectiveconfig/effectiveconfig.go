@@ -0,0 +1,218 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package effectiveconfig exports the effective configuration of a component: the section of
+// TektonConfig configuring it with the defaults applied, the spec of the component custom resource
+// derived from it, and the ConfigMaps and container environments rendered into its installer sets
+package effectiveconfig
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	clientset "github.com/tektoncd/operator/pkg/client/clientset/versioned"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Snapshot is the effective configuration of a component
+type Snapshot struct {
+	Component       string          `json:"component"`
+	TargetNamespace string          `json:"targetNamespace"`
+	Profile         string          `json:"profile"`
+	Config          v1alpha1.Config `json:"config"`
+	// TektonConfig is the section of the TektonConfig spec configuring the component, with the defaults applied
+	TektonConfig interface{} `json:"tektonConfig"`
+	// Spec of the component custom resource, nil when it is not created
+	Spec interface{} `json:"spec,omitempty"`
+	// ConfigMaps holds the data of the ConfigMaps of the installer sets, by <namespace>/<name>
+	ConfigMaps map[string]map[string]string `json:"configMaps,omitempty"`
+	// Env holds the environment of the containers of the Deployments and StatefulSets of the
+	// installer sets, by <kind>/<name>/<container>
+	Env map[string][]corev1.EnvVar `json:"env,omitempty"`
+}
+
+type component struct {
+	// kind creating the installer sets of the component
+	kind string
+	// section returns the section of the TektonConfig spec configuring the component
+	section func(spec *v1alpha1.TektonConfigSpec) interface{}
+	// spec returns the spec of the component custom resource
+	spec func(ctx context.Context, c clientset.Interface) (interface{}, error)
+}
+
+var components = map[string]component{
+	"pipeline": {
+		kind:    v1alpha1.KindTektonPipeline,
+		section: func(spec *v1alpha1.TektonConfigSpec) interface{} { return spec.Pipeline },
+		spec: func(ctx context.Context, c clientset.Interface) (interface{}, error) {
+			tp, err := c.OperatorV1alpha1().TektonPipelines().Get(ctx, v1alpha1.PipelineResourceName, metav1.GetOptions{})
+			if err != nil {
+				return nil, err
+			}
+			return tp.Spec, nil
+		},
+	},
+	"trigger": {
+		kind:    v1alpha1.KindTektonTrigger,
+		section: func(spec *v1alpha1.TektonConfigSpec) interface{} { return spec.Trigger },
+		spec: func(ctx context.Context, c clientset.Interface) (interface{}, error) {
+			tt, err := c.OperatorV1alpha1().TektonTriggers().Get(ctx, v1alpha1.TriggerResourceName, metav1.GetOptions{})
+			if err != nil {
+				return nil, err
+			}
+			return tt.Spec, nil
+		},
+	},
+	"chain": {
+		kind:    v1alpha1.KindTektonChain,
+		section: func(spec *v1alpha1.TektonConfigSpec) interface{} { return spec.Chain },
+		spec: func(ctx context.Context, c clientset.Interface) (interface{}, error) {
+			tc, err := c.OperatorV1alpha1().TektonChains().Get(ctx, v1alpha1.ChainResourceName, metav1.GetOptions{})
+			if err != nil {
+				return nil, err
+			}
+			return tc.Spec, nil
+		},
+	},
+	"result": {
+		kind:    v1alpha1.KindTektonResult,
+		section: func(spec *v1alpha1.TektonConfigSpec) interface{} { return spec.Result },
+		spec: func(ctx context.Context, c clientset.Interface) (interface{}, error) {
+			tr, err := c.OperatorV1alpha1().TektonResults().Get(ctx, v1alpha1.ResultResourceName, metav1.GetOptions{})
+			if err != nil {
+				return nil, err
+			}
+			return tr.Spec, nil
+		},
+	},
+	"dashboard": {
+		kind:    v1alpha1.KindTektonDashboard,
+		section: func(spec *v1alpha1.TektonConfigSpec) interface{} { return spec.Dashboard },
+		spec: func(ctx context.Context, c clientset.Interface) (interface{}, error) {
+			td, err := c.OperatorV1alpha1().TektonDashboards().Get(ctx, v1alpha1.DashboardResourceName, metav1.GetOptions{})
+			if err != nil {
+				return nil, err
+			}
+			return td.Spec, nil
+		},
+	},
+}
+
+// Components returns the names of the components which can be exported
+func Components() []string {
+	names := make([]string, 0, len(components))
+	for name := range components {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Export returns the effective configuration of the component
+func Export(ctx context.Context, c clientset.Interface, name string) (*Snapshot, error) {
+	comp, ok := components[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown component %q, expected one of %v", name, Components())
+	}
+	tc, err := c.OperatorV1alpha1().TektonConfigs().Get(ctx, v1alpha1.ConfigResourceName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get TektonConfig %s: %w", v1alpha1.ConfigResourceName, err)
+	}
+	tc.SetDefaults(ctx)
+	snapshot := &Snapshot{
+		Component:       name,
+		TargetNamespace: tc.Spec.TargetNamespace,
+		Profile:         tc.Spec.Profile,
+		Config:          tc.Spec.Config,
+		TektonConfig:    comp.section(&tc.Spec),
+	}
+	snapshot.Spec, err = comp.spec(ctx, c)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+
+	sets, err := c.OperatorV1alpha1().TektonInstallerSets().List(ctx, metav1.ListOptions{
+		LabelSelector: labels.Set{v1alpha1.CreatedByKey: comp.kind}.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, set := range sets.Items {
+		if set.GetDeletionTimestamp() != nil {
+			continue
+		}
+		manifests, err := common.InstallerSetManifests(ctx, &set)
+		if err != nil {
+			return nil, err
+		}
+		for _, u := range manifests {
+			if err := snapshot.add(u); err != nil {
+				return nil, fmt.Errorf("failed to read %s %s of installer set %s: %w", u.GetKind(), u.GetName(), set.Name, err)
+			}
+		}
+	}
+	return snapshot, nil
+}
+
+// add records the data of a ConfigMap or the environment of the containers of a workload
+func (s *Snapshot) add(u unstructured.Unstructured) error {
+	var template *corev1.PodTemplateSpec
+	switch u.GetKind() {
+	case "ConfigMap":
+		cm := &corev1.ConfigMap{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, cm); err != nil {
+			return err
+		}
+		if s.ConfigMaps == nil {
+			s.ConfigMaps = map[string]map[string]string{}
+		}
+		s.ConfigMaps[cm.Namespace+"/"+cm.Name] = cm.Data
+		return nil
+	case "Deployment":
+		d := &appsv1.Deployment{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, d); err != nil {
+			return err
+		}
+		template = &d.Spec.Template
+	case "StatefulSet":
+		ss := &appsv1.StatefulSet{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, ss); err != nil {
+			return err
+		}
+		template = &ss.Spec.Template
+	default:
+		return nil
+	}
+	for _, c := range template.Spec.Containers {
+		if len(c.Env) == 0 {
+			continue
+		}
+		if s.Env == nil {
+			s.Env = map[string][]corev1.EnvVar{}
+		}
+		s.Env[fmt.Sprintf("%s/%s/%s", u.GetKind(), u.GetName(), c.Name)] = c.Env
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package effectiveconfig
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/client/clientset/versioned/fake"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func installerSet(name, kind string, manifests ...map[string]interface{}) *v1alpha1.TektonInstallerSet {
+	set := &v1alpha1.TektonInstallerSet{ObjectMeta: metav1.ObjectMeta{
+		Name:   name,
+		Labels: map[string]string{v1alpha1.CreatedByKey: kind},
+	}}
+	for _, m := range manifests {
+		set.Spec.Manifests = append(set.Spec.Manifests, unstructured.Unstructured{Object: m})
+	}
+	return set
+}
+
+func TestExport(t *testing.T) {
+	ctx := context.TODO()
+	featureFlags := map[string]interface{}{
+		"apiVersion": "v1", "kind": "ConfigMap",
+		"metadata": map[string]interface{}{"name": "feature-flags", "namespace": "tekton-pipelines"},
+		"data":     map[string]interface{}{"enable-api-fields": "beta"},
+	}
+	controller := map[string]interface{}{
+		"apiVersion": "apps/v1", "kind": "Deployment",
+		"metadata": map[string]interface{}{"name": "tekton-pipelines-controller", "namespace": "tekton-pipelines"},
+		"spec": map[string]interface{}{"template": map[string]interface{}{"spec": map[string]interface{}{
+			"containers": []interface{}{
+				map[string]interface{}{"name": "tekton-pipelines-controller", "env": []interface{}{
+					map[string]interface{}{"name": "KUBERNETES_MIN_VERSION", "value": "v1.28.0"},
+				}},
+				map[string]interface{}{"name": "sidecar"},
+			},
+		}}},
+	}
+	triggersConfig := map[string]interface{}{
+		"apiVersion": "v1", "kind": "ConfigMap",
+		"metadata": map[string]interface{}{"name": "feature-flags-triggers", "namespace": "tekton-pipelines"},
+	}
+	client := fake.NewSimpleClientset(
+		&v1alpha1.TektonConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.ConfigResourceName},
+			Spec: v1alpha1.TektonConfigSpec{
+				CommonSpec: v1alpha1.CommonSpec{TargetNamespace: "tekton-pipelines"},
+			},
+		},
+		&v1alpha1.TektonPipeline{
+			ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.PipelineResourceName},
+			Spec: v1alpha1.TektonPipelineSpec{
+				CommonSpec: v1alpha1.CommonSpec{TargetNamespace: "tekton-pipelines"},
+			},
+		},
+		installerSet("pipeline-main-static", v1alpha1.KindTektonPipeline, featureFlags, controller),
+		installerSet("trigger-main-static", v1alpha1.KindTektonTrigger, triggersConfig),
+	)
+
+	snapshot, err := Export(ctx, client, "pipeline")
+	assert.NilError(t, err)
+	assert.Equal(t, snapshot.Component, "pipeline")
+	assert.Equal(t, snapshot.TargetNamespace, "tekton-pipelines")
+	// the defaults are applied
+	assert.Equal(t, snapshot.Profile, v1alpha1.ProfileBasic)
+	pipeline := snapshot.TektonConfig.(v1alpha1.Pipeline)
+	assert.Assert(t, pipeline.DisableCredsInit != nil)
+	assert.Equal(t, snapshot.Spec.(v1alpha1.TektonPipelineSpec).TargetNamespace, "tekton-pipelines")
+	assert.DeepEqual(t, snapshot.ConfigMaps, map[string]map[string]string{
+		"tekton-pipelines/feature-flags": {"enable-api-fields": "beta"},
+	})
+	assert.DeepEqual(t, snapshot.Env, map[string][]corev1.EnvVar{
+		"Deployment/tekton-pipelines-controller/tekton-pipelines-controller": {{Name: "KUBERNETES_MIN_VERSION", Value: "v1.28.0"}},
+	})
+
+	// the component custom resource is not created
+	snapshot, err = Export(ctx, client, "chain")
+	assert.NilError(t, err)
+	assert.Assert(t, snapshot.Spec == nil)
+	assert.Assert(t, snapshot.ConfigMaps == nil)
+
+	_, err = Export(ctx, client, "operator")
+	assert.ErrorContains(t, err, `unknown component "operator"`)
+}
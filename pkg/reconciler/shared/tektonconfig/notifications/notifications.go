@@ -0,0 +1,203 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifications
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/logging"
+)
+
+const (
+	// degradedGracePeriod is how long the installation is not ready before it is notified as degraded,
+	// the components are not ready for a while during an install or an upgrade
+	degradedGracePeriod = 5 * time.Minute
+	// secretURLKey is the key of the URL in the Secret of a sink
+	secretURLKey = "url"
+	sendTimeout  = 10 * time.Second
+)
+
+// Notification is a lifecycle event of the installation, posted as JSON to the webhook sinks
+type Notification struct {
+	Type     string    `json:"type"`
+	Message  string    `json:"message"`
+	Version  string    `json:"version"`
+	Resource string    `json:"resource"`
+	Time     time.Time `json:"time"`
+}
+
+// Notifier sends the install, upgrade, degraded and recovered events of TektonConfig to the
+// notification sinks configured in TektonConfig
+type Notifier struct {
+	kubeClientSet kubernetes.Interface
+	// namespace of the operator, holding the Secrets of the sinks
+	namespace string
+	// version of the operator
+	version    string
+	httpClient *http.Client
+	now        func() time.Time
+}
+
+func New(kubeClientSet kubernetes.Interface, namespace, version string) *Notifier {
+	return &Notifier{
+		kubeClientSet: kubeClientSet,
+		namespace:     namespace,
+		version:       version,
+		httpClient:    &http.Client{Timeout: sendTimeout},
+		now:           time.Now,
+	}
+}
+
+// Reconcile compares the readiness of TektonConfig with the state last notified in its status and
+// sends the resulting event to the sinks it is routed to. The status is updated even when no sink
+// is configured so that enabling the notifications does not report the existing install.
+// Failing to notify a sink is logged and does not fail the reconcile.
+func (n *Notifier) Reconcile(ctx context.Context, tc *v1alpha1.TektonConfig) {
+	event, message := n.transition(tc)
+	if event == "" || tc.Spec.Notifications == nil {
+		return
+	}
+	logger := logging.FromContext(ctx).Named("notifications")
+	notification := Notification{
+		Type:     event,
+		Message:  message,
+		Version:  n.version,
+		Resource: fmt.Sprintf("TektonConfig/%s", tc.Name),
+		Time:     n.now().UTC(),
+	}
+	for i := range tc.Spec.Notifications.Sinks {
+		sink := &tc.Spec.Notifications.Sinks[i]
+		if !sink.Routes(event) {
+			continue
+		}
+		if err := n.send(ctx, tc, sink, notification); err != nil {
+			logger.Errorw("failed to notify the sink", "sink", sink.Name, "event", event, "error", err)
+		}
+	}
+}
+
+// transition returns the event to notify, if any, and records it in the status
+func (n *Notifier) transition(tc *v1alpha1.TektonConfig) (string, string) {
+	if tc.Status.Notifications == nil {
+		tc.Status.Notifications = &v1alpha1.NotificationsStatus{}
+	}
+	state := tc.Status.Notifications
+	ready := tc.Status.GetCondition(apis.ConditionReady)
+	if ready.IsTrue() {
+		var event, message string
+		switch {
+		case state.ReadyVersion == "":
+			event, message = v1alpha1.NotificationInstalled, fmt.Sprintf("Tekton %s is installed", n.version)
+		case state.ReadyVersion != n.version:
+			event, message = v1alpha1.NotificationUpgraded, fmt.Sprintf("Tekton is upgraded from %s to %s", state.ReadyVersion, n.version)
+		case state.Degraded:
+			event, message = v1alpha1.NotificationRecovered, fmt.Sprintf("Tekton %s is ready again", n.version)
+		}
+		state.ReadyVersion, state.Degraded = n.version, false
+		return event, message
+	}
+	// an install or an upgrade in progress is not degraded
+	if ready == nil || state.Degraded || state.ReadyVersion != n.version ||
+		n.now().Sub(ready.LastTransitionTime.Inner.Time) < degradedGracePeriod {
+		return "", ""
+	}
+	state.Degraded = true
+	return v1alpha1.NotificationDegraded, fmt.Sprintf("Tekton %s is not ready: %s", n.version, ready.Message)
+}
+
+func (n *Notifier) send(ctx context.Context, tc *v1alpha1.TektonConfig, sink *v1alpha1.NotificationSink, notification Notification) error {
+	if sink.Type == v1alpha1.NotificationSinkEvents {
+		recorder := controller.GetEventRecorder(ctx)
+		if recorder == nil {
+			return fmt.Errorf("no event recorder in the context")
+		}
+		eventType := corev1.EventTypeNormal
+		if notification.Type == v1alpha1.NotificationDegraded {
+			eventType = corev1.EventTypeWarning
+		}
+		recorder.Event(tc, eventType, eventReason(notification.Type), notification.Message)
+		return nil
+	}
+
+	url, err := n.url(ctx, sink)
+	if err != nil {
+		return err
+	}
+	var payload interface{} = notification
+	if sink.Type == v1alpha1.NotificationSinkSlack {
+		payload = map[string]string{"text": notification.Message}
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := n.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return nil
+}
+
+// url returns the URL of the sink, read from its Secret when it is not set
+func (n *Notifier) url(ctx context.Context, sink *v1alpha1.NotificationSink) (string, error) {
+	if sink.URL != "" {
+		return sink.URL, nil
+	}
+	secret, err := n.kubeClientSet.CoreV1().Secrets(n.namespace).Get(ctx, sink.SecretName, metav1.GetOptions{})
+	if err != nil {
+		return "", fmt.Errorf("failed to get the secret %s/%s: %w", n.namespace, sink.SecretName, err)
+	}
+	url, ok := secret.Data[secretURLKey]
+	if !ok {
+		return "", fmt.Errorf("secret %s/%s has no key %s", n.namespace, sink.SecretName, secretURLKey)
+	}
+	return string(url), nil
+}
+
+// eventReason returns the reason of the Kubernetes Event, e.g. TektonUpgraded
+func eventReason(event string) string {
+	switch event {
+	case v1alpha1.NotificationInstalled:
+		return "TektonInstalled"
+	case v1alpha1.NotificationUpgraded:
+		return "TektonUpgraded"
+	case v1alpha1.NotificationDegraded:
+		return "TektonDegraded"
+	default:
+		return "TektonRecovered"
+	}
+}
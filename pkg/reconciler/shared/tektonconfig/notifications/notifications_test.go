@@ -0,0 +1,139 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/record"
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/controller"
+)
+
+const operatorNamespace = "tekton-operator"
+
+// sinkServer records the bodies posted to the sink
+func sinkServer(t *testing.T) (*httptest.Server, *[]map[string]interface{}) {
+	t.Helper()
+	var bodies []map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := map[string]interface{}{}
+		assert.NilError(t, json.NewDecoder(r.Body).Decode(&body))
+		bodies = append(bodies, body)
+	}))
+	t.Cleanup(server.Close)
+	return server, &bodies
+}
+
+func setReady(tc *v1alpha1.TektonConfig, ready bool, at time.Time) {
+	status := corev1.ConditionTrue
+	if !ready {
+		status = corev1.ConditionFalse
+	}
+	tc.Status.SetConditions(apis.Conditions{{
+		Type:               apis.ConditionReady,
+		Status:             status,
+		Message:            "Components not in ready state",
+		LastTransitionTime: apis.VolatileTime{Inner: metav1.NewTime(at)},
+	}})
+}
+
+func TestReconcile(t *testing.T) {
+	slack, slackBodies := sinkServer(t)
+	webhook, webhookBodies := sinkServer(t)
+	kubeClient := fake.NewSimpleClientset(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "slack-webhook", Namespace: operatorNamespace},
+		Data:       map[string][]byte{"url": []byte(slack.URL)},
+	})
+	recorder := record.NewFakeRecorder(10)
+	ctx := controller.WithEventRecorder(context.TODO(), recorder)
+	tc := &v1alpha1.TektonConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.ConfigResourceName},
+		Spec: v1alpha1.TektonConfigSpec{Notifications: &v1alpha1.Notifications{Sinks: []v1alpha1.NotificationSink{
+			{Name: "slack", Type: v1alpha1.NotificationSinkSlack, SecretName: "slack-webhook",
+				Events: []string{v1alpha1.NotificationDegraded, v1alpha1.NotificationRecovered}},
+			{Name: "webhook", Type: v1alpha1.NotificationSinkWebhook, URL: webhook.URL},
+			{Name: "events", Type: v1alpha1.NotificationSinkEvents, Events: []string{v1alpha1.NotificationUpgraded}},
+		}}},
+	}
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	n := New(kubeClient, operatorNamespace, "v0.75.0")
+	n.now = func() time.Time { return now }
+
+	// the install is notified once it is ready
+	setReady(tc, false, now)
+	n.Reconcile(ctx, tc)
+	assert.Equal(t, len(*webhookBodies), 0)
+	setReady(tc, true, now)
+	n.Reconcile(ctx, tc)
+	n.Reconcile(ctx, tc)
+	assert.Equal(t, len(*webhookBodies), 1)
+	assert.Equal(t, (*webhookBodies)[0]["type"], v1alpha1.NotificationInstalled)
+	assert.Equal(t, (*webhookBodies)[0]["version"], "v0.75.0")
+	assert.Equal(t, (*webhookBodies)[0]["resource"], "TektonConfig/config")
+	assert.Equal(t, len(*slackBodies), 0)
+
+	// degraded after the grace period, then recovered
+	setReady(tc, false, now)
+	now = now.Add(time.Minute)
+	n.Reconcile(ctx, tc)
+	assert.Equal(t, len(*slackBodies), 0)
+	now = now.Add(degradedGracePeriod)
+	n.Reconcile(ctx, tc)
+	n.Reconcile(ctx, tc)
+	assert.DeepEqual(t, *slackBodies, []map[string]interface{}{
+		{"text": "Tekton v0.75.0 is not ready: Components not in ready state"},
+	})
+	assert.Equal(t, tc.Status.Notifications.Degraded, true)
+	setReady(tc, true, now)
+	n.Reconcile(ctx, tc)
+	assert.Equal(t, len(*slackBodies), 2)
+	assert.Equal(t, (*slackBodies)[1]["text"], "Tekton v0.75.0 is ready again")
+	assert.Equal(t, len(*webhookBodies), 3)
+
+	// upgraded, a not ready upgrade in progress is not degraded
+	n = New(kubeClient, operatorNamespace, "v0.76.0")
+	n.now = func() time.Time { return now }
+	setReady(tc, false, now.Add(-time.Hour))
+	n.Reconcile(ctx, tc)
+	assert.Equal(t, len(*webhookBodies), 3)
+	setReady(tc, true, now)
+	n.Reconcile(ctx, tc)
+	assert.Equal(t, len(*webhookBodies), 4)
+	assert.Equal(t, (*webhookBodies)[3]["message"], "Tekton is upgraded from v0.75.0 to v0.76.0")
+	assert.Equal(t, <-recorder.Events, "Normal TektonUpgraded Tekton is upgraded from v0.75.0 to v0.76.0")
+	assert.Equal(t, len(recorder.Events), 0)
+	assert.Equal(t, tc.Status.Notifications.ReadyVersion, "v0.76.0")
+}
+
+func TestReconcileWithoutSinks(t *testing.T) {
+	tc := &v1alpha1.TektonConfig{ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.ConfigResourceName}}
+	setReady(tc, true, time.Now())
+	New(fake.NewSimpleClientset(), operatorNamespace, "v0.75.0").Reconcile(context.TODO(), tc)
+	// enabling the notifications later does not report the existing install
+	assert.DeepEqual(t, tc.Status.Notifications, &v1alpha1.NotificationsStatus{ReadyVersion: "v0.75.0"})
+}
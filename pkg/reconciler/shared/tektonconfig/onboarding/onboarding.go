@@ -0,0 +1,194 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package onboarding
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+
+	mf "github.com/manifestival/manifestival"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"knative.dev/pkg/logging"
+)
+
+const (
+	// onboardingLabel marks the resources created by the namespace onboarding
+	onboardingLabel = "operator.tekton.dev/onboarding"
+)
+
+// Onboarding creates the ServiceAccount and the RoleBindings configured in TektonConfig
+// in the namespaces requesting onboarding, and seeds them with the starter resources
+type Onboarding struct {
+	kubeClientSet kubernetes.Interface
+	// mfClient creates the starter resources
+	mfClient mf.Client
+}
+
+func New(kubeClientSet kubernetes.Interface, mfClient mf.Client) *Onboarding {
+	return &Onboarding{kubeClientSet: kubeClientSet, mfClient: mfClient}
+}
+
+// Reconcile onboards the namespaces annotated with operator.tekton.dev/onboard, and removes
+// the RoleBindings which are no longer configured or of namespaces which are no longer onboarded.
+// The ServiceAccounts are kept, so that the workloads using them are not broken.
+func (o *Onboarding) Reconcile(ctx context.Context, tc *v1alpha1.TektonConfig) error {
+	logger := logging.FromContext(ctx).Named("onboarding")
+	spec := tc.Spec.NamespaceOnboarding
+
+	// the RoleBindings expected in each onboarded namespace
+	desired := map[string]map[string]bool{}
+	var errs []error
+	if spec != nil && spec.Enable {
+		namespaces, err := o.kubeClientSet.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return err
+		}
+		ownerRef := *metav1.NewControllerRef(tc, tc.GetGroupVersionKind())
+		starter, err := o.starterResources(ctx, tc)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		ignorePattern := regexp.MustCompile(common.NamespaceIgnorePattern)
+		for _, ns := range namespaces.Items {
+			if ignorePattern.MatchString(ns.Name) || ns.Status.Phase == corev1.NamespaceTerminating ||
+				ns.Annotations[v1alpha1.NamespaceOnboardingAnnotation] != "true" {
+				continue
+			}
+			desired[ns.Name] = map[string]bool{}
+			for _, rb := range spec.RoleBindings {
+				desired[ns.Name][rb.Name] = true
+			}
+			// the namespace may enter Terminating after it was listed
+			err := o.onboardNamespace(ctx, ns.Name, spec, ownerRef)
+			if err == nil {
+				if err = o.seedNamespace(ctx, &ns, starter); err != nil {
+					err = fmt.Errorf("failed to seed namespace %s with the starter resources: %w", ns.Name, err)
+				}
+			} else {
+				err = fmt.Errorf("failed to onboard namespace %s: %w", ns.Name, err)
+			}
+			if common.NamespaceGoingAway(ctx, o.kubeClientSet, ns.Name, err) {
+				logger.Infof("namespace %s is terminating, skipping onboarding", ns.Name)
+				continue
+			}
+			if err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	rbs, err := o.kubeClientSet.RbacV1().RoleBindings(metav1.NamespaceAll).List(ctx, metav1.ListOptions{LabelSelector: onboardingLabel})
+	if err != nil {
+		return errors.Join(append(errs, err)...)
+	}
+	for _, rb := range rbs.Items {
+		if desired[rb.Namespace][rb.Name] {
+			continue
+		}
+		logger.Infof("removing rolebinding %s/%s, it is no longer part of the namespace onboarding", rb.Namespace, rb.Name)
+		if err := o.kubeClientSet.RbacV1().RoleBindings(rb.Namespace).Delete(ctx, rb.Name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("failed to remove rolebinding %s/%s: %w", rb.Namespace, rb.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (o *Onboarding) onboardNamespace(ctx context.Context, namespace string, spec *v1alpha1.NamespaceOnboarding, ownerRef metav1.OwnerReference) error {
+	saName := spec.GetServiceAccountName()
+	if err := o.ensureServiceAccount(ctx, namespace, saName, ownerRef); err != nil {
+		return err
+	}
+	for _, rb := range spec.RoleBindings {
+		if err := o.ensureRoleBinding(ctx, namespace, saName, rb, ownerRef); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ensureServiceAccount creates the ServiceAccount, an existing ServiceAccount is used as it is
+func (o *Onboarding) ensureServiceAccount(ctx context.Context, namespace, name string, ownerRef metav1.OwnerReference) error {
+	saClient := o.kubeClientSet.CoreV1().ServiceAccounts(namespace)
+	if _, err := saClient.Get(ctx, name, metav1.GetOptions{}); err == nil || !apierrors.IsNotFound(err) {
+		return err
+	}
+	logging.FromContext(ctx).Infof("creating serviceaccount %s/%s for the namespace onboarding", namespace, name)
+	_, err := saClient.Create(ctx, &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       namespace,
+			Labels:          map[string]string{onboardingLabel: "true"},
+			OwnerReferences: []metav1.OwnerReference{ownerRef},
+		},
+	}, metav1.CreateOptions{})
+	if apierrors.IsAlreadyExists(err) {
+		return nil
+	}
+	return err
+}
+
+// ensureRoleBinding creates or updates the RoleBinding, it is recreated when its ClusterRole
+// changes as the roleRef of a RoleBinding cannot be updated
+func (o *Onboarding) ensureRoleBinding(ctx context.Context, namespace, saName string, binding v1alpha1.OnboardingRoleBinding, ownerRef metav1.OwnerReference) error {
+	rbClient := o.kubeClientSet.RbacV1().RoleBindings(namespace)
+	roleRef := rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: binding.ClusterRole}
+	subjects := []rbacv1.Subject{{Kind: rbacv1.ServiceAccountKind, Name: saName, Namespace: namespace}}
+
+	existing, err := rbClient.Get(ctx, binding.Name, metav1.GetOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	if err == nil {
+		if existing.RoleRef == roleRef {
+			if existing.Labels[onboardingLabel] == "true" && len(existing.Subjects) == 1 && existing.Subjects[0] == subjects[0] {
+				return nil
+			}
+			existing = existing.DeepCopy()
+			if existing.Labels == nil {
+				existing.Labels = map[string]string{}
+			}
+			existing.Labels[onboardingLabel] = "true"
+			existing.Subjects = subjects
+			_, err = rbClient.Update(ctx, existing, metav1.UpdateOptions{})
+			return err
+		}
+		if err := rbClient.Delete(ctx, binding.Name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	logging.FromContext(ctx).Infof("creating rolebinding %s/%s to clusterrole %s for the namespace onboarding", namespace, binding.Name, binding.ClusterRole)
+	_, err = rbClient.Create(ctx, &rbacv1.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            binding.Name,
+			Namespace:       namespace,
+			Labels:          map[string]string{onboardingLabel: "true"},
+			OwnerReferences: []metav1.OwnerReference{ownerRef},
+		},
+		RoleRef:  roleRef,
+		Subjects: subjects,
+	}, metav1.CreateOptions{})
+	return err
+}
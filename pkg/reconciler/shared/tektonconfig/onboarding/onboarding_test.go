@@ -0,0 +1,101 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package onboarding
+
+import (
+	"context"
+	"testing"
+
+	mffake "github.com/manifestival/manifestival/fake"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func namespace(name string, annotations map[string]string) *corev1.Namespace {
+	return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Annotations: annotations}}
+}
+
+func TestOnboardingReconcile(t *testing.T) {
+	ctx := context.TODO()
+	onboard := map[string]string{v1alpha1.NamespaceOnboardingAnnotation: "true"}
+	kubeClient := fake.NewSimpleClientset(
+		namespace("team-a", onboard),
+		namespace("team-b", nil),
+		namespace("openshift-infra", onboard),
+	)
+	tc := &v1alpha1.TektonConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.ConfigResourceName},
+		Spec: v1alpha1.TektonConfigSpec{
+			NamespaceOnboarding: &v1alpha1.NamespaceOnboarding{
+				Enable:       true,
+				RoleBindings: []v1alpha1.OnboardingRoleBinding{{Name: "tekton-edit", ClusterRole: "edit"}},
+			},
+		},
+	}
+	o := New(kubeClient, mffake.New())
+
+	assert.NilError(t, o.Reconcile(ctx, tc))
+	sa, err := kubeClient.CoreV1().ServiceAccounts("team-a").Get(ctx, v1alpha1.DefaultOnboardingServiceAccount, metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, sa.Labels[onboardingLabel], "true")
+	rb, err := kubeClient.RbacV1().RoleBindings("team-a").Get(ctx, "tekton-edit", metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, rb.RoleRef.Name, "edit")
+	assert.DeepEqual(t, rb.Subjects, []rbacv1.Subject{{Kind: rbacv1.ServiceAccountKind, Name: "pipeline", Namespace: "team-a"}})
+
+	// namespaces which are not annotated or ignored are not onboarded
+	for _, ns := range []string{"team-b", "openshift-infra"} {
+		_, err := kubeClient.CoreV1().ServiceAccounts(ns).Get(ctx, v1alpha1.DefaultOnboardingServiceAccount, metav1.GetOptions{})
+		assert.Assert(t, apierrors.IsNotFound(err), "namespace %s should not be onboarded", ns)
+	}
+
+	// the RoleBinding is recreated when its ClusterRole changes
+	tc.Spec.NamespaceOnboarding.RoleBindings[0].ClusterRole = "view"
+	assert.NilError(t, o.Reconcile(ctx, tc))
+	rb, err = kubeClient.RbacV1().RoleBindings("team-a").Get(ctx, "tekton-edit", metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, rb.RoleRef.Name, "view")
+
+	// RoleBindings are removed when the namespace is no longer onboarded, the ServiceAccount is kept
+	_, err = kubeClient.CoreV1().Namespaces().Update(ctx, namespace("team-a", nil), metav1.UpdateOptions{})
+	assert.NilError(t, err)
+	assert.NilError(t, o.Reconcile(ctx, tc))
+	_, err = kubeClient.RbacV1().RoleBindings("team-a").Get(ctx, "tekton-edit", metav1.GetOptions{})
+	assert.Assert(t, apierrors.IsNotFound(err))
+	_, err = kubeClient.CoreV1().ServiceAccounts("team-a").Get(ctx, v1alpha1.DefaultOnboardingServiceAccount, metav1.GetOptions{})
+	assert.NilError(t, err)
+}
+
+func TestOnboardingDisabled(t *testing.T) {
+	ctx := context.TODO()
+	kubeClient := fake.NewSimpleClientset(
+		namespace("team-a", map[string]string{v1alpha1.NamespaceOnboardingAnnotation: "true"}),
+		&rbacv1.RoleBinding{ObjectMeta: metav1.ObjectMeta{Name: "tekton-edit", Namespace: "team-a", Labels: map[string]string{onboardingLabel: "true"}}},
+		&rbacv1.RoleBinding{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "team-a"}},
+	)
+
+	assert.NilError(t, New(kubeClient, mffake.New()).Reconcile(ctx, &v1alpha1.TektonConfig{}))
+	rbs, err := kubeClient.RbacV1().RoleBindings("team-a").List(ctx, metav1.ListOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, len(rbs.Items), 1)
+	assert.Equal(t, rbs.Items[0].Name, "other")
+}
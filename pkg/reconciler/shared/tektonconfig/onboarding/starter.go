@@ -0,0 +1,113 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package onboarding
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	mf "github.com/manifestival/manifestival"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"knative.dev/pkg/logging"
+)
+
+// starter holds the manifests of the starter resources and the namespaces they are created in
+type starter struct {
+	configMap string
+	selector  labels.Selector
+	// manifests by key of the ConfigMap
+	manifests map[string]string
+}
+
+// starterResources reads the manifests of the starter resources from the ConfigMap in the
+// target namespace, nil is returned when no starter resources are configured
+func (o *Onboarding) starterResources(ctx context.Context, tc *v1alpha1.TektonConfig) (*starter, error) {
+	spec := tc.Spec.NamespaceOnboarding.StarterResources
+	if spec == nil {
+		return nil, nil
+	}
+	selector := labels.Everything()
+	if spec.NamespaceSelector != nil {
+		var err error
+		if selector, err = metav1.LabelSelectorAsSelector(spec.NamespaceSelector); err != nil {
+			return nil, fmt.Errorf("invalid namespace selector of the starter resources: %w", err)
+		}
+	}
+	namespace := tc.Spec.GetTargetNamespace()
+	cm, err := o.kubeClientSet.CoreV1().ConfigMaps(namespace).Get(ctx, spec.ConfigMap, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the starter resources ConfigMap %s/%s: %w", namespace, spec.ConfigMap, err)
+	}
+	return &starter{configMap: spec.ConfigMap, selector: selector, manifests: cm.Data}, nil
+}
+
+// seedNamespace creates the starter resources in a namespace selected by the starter resources,
+// the namespace is annotated once they are all created and it is not seeded again. Existing
+// resources are left as they are.
+func (o *Onboarding) seedNamespace(ctx context.Context, ns *corev1.Namespace, s *starter) error {
+	if s == nil || !s.selector.Matches(labels.Set(ns.Labels)) {
+		return nil
+	}
+	if _, seeded := ns.Annotations[v1alpha1.StarterResourcesAppliedAnnotation]; seeded {
+		return nil
+	}
+	logger := logging.FromContext(ctx)
+
+	keys := make([]string, 0, len(s.manifests))
+	for key := range s.manifests {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		manifest := strings.ReplaceAll(s.manifests[key], v1alpha1.StarterResourcesNamespacePlaceholder, ns.Name)
+		resources, err := mf.Reader(strings.NewReader(manifest)).Parse()
+		if err != nil {
+			return fmt.Errorf("invalid manifests in key %s of ConfigMap %s: %w", key, s.configMap, err)
+		}
+		for i := range resources {
+			u := &resources[i]
+			u.SetNamespace(ns.Name)
+			_, err := o.mfClient.Get(u)
+			if err == nil {
+				continue
+			}
+			if !apierrors.IsNotFound(err) {
+				return err
+			}
+			logger.Infof("creating starter resource %s %s/%s", u.GetKind(), ns.Name, u.GetName())
+			if err := o.mfClient.Create(u); err != nil && !apierrors.IsAlreadyExists(err) {
+				return fmt.Errorf("failed to create %s %s: %w", u.GetKind(), u.GetName(), err)
+			}
+		}
+	}
+
+	err := common.PatchNamespaceMetadata(ctx, o.kubeClientSet, ns.Name, common.NamespaceMetadataPatch{
+		SetAnnotations: map[string]string{v1alpha1.StarterResourcesAppliedAnnotation: s.configMap},
+	})
+	if errors.Is(err, common.ErrNamespaceTerminating) {
+		return nil
+	}
+	return err
+}
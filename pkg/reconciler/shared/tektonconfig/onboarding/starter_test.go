@@ -0,0 +1,126 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package onboarding
+
+import (
+	"context"
+	"testing"
+
+	mffake "github.com/manifestival/manifestival/fake"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+const starterPipeline = `apiVersion: tekton.dev/v1
+kind: Pipeline
+metadata:
+  name: starter
+spec:
+  tasks: []
+---
+apiVersion: pipelinesascode.tekton.dev/v1alpha1
+kind: Repository
+metadata:
+  name: $(namespace)-repository
+spec:
+  url: https://github.com/example/$(namespace)
+`
+
+func starterResource(t *testing.T, client mffake.Client, kind schema.GroupVersionKind, namespace, name string) *unstructured.Unstructured {
+	t.Helper()
+	u := &unstructured.Unstructured{}
+	u.SetGroupVersionKind(kind)
+	u.SetNamespace(namespace)
+	u.SetName(name)
+	found, err := client.Get(u)
+	if err != nil {
+		return nil
+	}
+	return found
+}
+
+func TestSeedNamespaces(t *testing.T) {
+	ctx := context.TODO()
+	onboard := map[string]string{v1alpha1.NamespaceOnboardingAnnotation: "true"}
+	teamA := namespace("team-a", onboard)
+	teamA.Labels = map[string]string{"tier": "dev"}
+	teamB := namespace("team-b", onboard)
+	kubeClient := fake.NewSimpleClientset(teamA, teamB, &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "starter", Namespace: "tekton-pipelines"},
+		Data:       map[string]string{"pipeline.yaml": starterPipeline},
+	})
+	mfClient := mffake.New()
+	tc := &v1alpha1.TektonConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.ConfigResourceName},
+		Spec: v1alpha1.TektonConfigSpec{
+			CommonSpec: v1alpha1.CommonSpec{TargetNamespace: "tekton-pipelines"},
+			NamespaceOnboarding: &v1alpha1.NamespaceOnboarding{
+				Enable: true,
+				StarterResources: &v1alpha1.StarterResources{
+					ConfigMap:         "starter",
+					NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "dev"}},
+				},
+			},
+		},
+	}
+	pipelineKind := schema.GroupVersionKind{Group: "tekton.dev", Version: "v1", Kind: "Pipeline"}
+	repositoryKind := schema.GroupVersionKind{Group: "pipelinesascode.tekton.dev", Version: "v1alpha1", Kind: "Repository"}
+
+	assert.NilError(t, New(kubeClient, mfClient).Reconcile(ctx, tc))
+	assert.Assert(t, starterResource(t, mfClient, pipelineKind, "team-a", "starter") != nil)
+	repository := starterResource(t, mfClient, repositoryKind, "team-a", "team-a-repository")
+	assert.Assert(t, repository != nil)
+	url, _, _ := unstructured.NestedString(repository.Object, "spec", "url")
+	assert.Equal(t, url, "https://github.com/example/team-a")
+	ns, err := kubeClient.CoreV1().Namespaces().Get(ctx, "team-a", metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, ns.Annotations[v1alpha1.StarterResourcesAppliedAnnotation], "starter")
+
+	// namespaces not matching the selector are not seeded
+	assert.Assert(t, starterResource(t, mfClient, pipelineKind, "team-b", "starter") == nil)
+
+	// a seeded namespace is not seeded again, the resources deleted by its users are not recreated
+	assert.NilError(t, mfClient.Delete(starterResource(t, mfClient, pipelineKind, "team-a", "starter")))
+	assert.NilError(t, New(kubeClient, mfClient).Reconcile(ctx, tc))
+	assert.Assert(t, starterResource(t, mfClient, pipelineKind, "team-a", "starter") == nil)
+}
+
+func TestSeedNamespacesMissingConfigMap(t *testing.T) {
+	ctx := context.TODO()
+	kubeClient := fake.NewSimpleClientset(namespace("team-a", map[string]string{v1alpha1.NamespaceOnboardingAnnotation: "true"}))
+	tc := &v1alpha1.TektonConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.ConfigResourceName},
+		Spec: v1alpha1.TektonConfigSpec{
+			CommonSpec: v1alpha1.CommonSpec{TargetNamespace: "tekton-pipelines"},
+			NamespaceOnboarding: &v1alpha1.NamespaceOnboarding{
+				Enable:           true,
+				StarterResources: &v1alpha1.StarterResources{ConfigMap: "starter"},
+			},
+		},
+	}
+	err := New(kubeClient, mffake.New()).Reconcile(ctx, tc)
+	assert.ErrorContains(t, err, "failed to get the starter resources ConfigMap tekton-pipelines/starter")
+
+	// the namespace is onboarded even though it cannot be seeded
+	_, err = kubeClient.CoreV1().ServiceAccounts("team-a").Get(ctx, v1alpha1.DefaultOnboardingServiceAccount, metav1.GetOptions{})
+	assert.NilError(t, err)
+}
@@ -0,0 +1,256 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package orphanedpvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"knative.dev/pkg/logging"
+)
+
+const (
+	defaultMinAge   = time.Hour
+	defaultInterval = time.Hour
+
+	// pipelineRunLabel links the PersistentVolumeClaims to their PipelineRun
+	pipelineRunLabel = "tekton.dev/pipelineRun"
+)
+
+var (
+	pipelineRunsGVR = schema.GroupVersionResource{Group: "tekton.dev", Version: "v1", Resource: "pipelineruns"}
+	// affinityAssistantClaim matches the PersistentVolumeClaims created by the StatefulSet of an
+	// affinity assistant from its volumeClaimTemplates, <template>-<statefulset>-<ordinal>
+	affinityAssistantClaim = regexp.MustCompile(`-(affinity-assistant-[0-9a-f]+)-[0-9]+$`)
+)
+
+// Scanner finds the PersistentVolumeClaims created for the workspaces of the PipelineRuns which
+// are no longer used, because their PipelineRun or affinity assistant is gone, and reports them
+// in the status of TektonConfig. They are deleted with the Delete cleanup policy.
+type Scanner struct {
+	kubeClientSet kubernetes.Interface
+	dynamicClient dynamic.Interface
+	now           func() time.Time
+}
+
+func New(kubeClientSet kubernetes.Interface, dynamicClient dynamic.Interface) *Scanner {
+	return &Scanner{kubeClientSet: kubeClientSet, dynamicClient: dynamicClient, now: time.Now}
+}
+
+// Reconcile scans the PersistentVolumeClaims once per interval when the scan is enabled in the
+// pruner configuration, the status is removed when it is disabled
+func (s *Scanner) Reconcile(ctx context.Context, tc *v1alpha1.TektonConfig) error {
+	logger := logging.FromContext(ctx).Named("orphanedpvc")
+	spec := tc.Spec.Pruner.OrphanedPVCs
+	if spec == nil || !spec.Enabled {
+		tc.Status.OrphanedPVCs = nil
+		return nil
+	}
+	now := s.now()
+	if last := tc.Status.OrphanedPVCs; last != nil && now.Sub(last.LastScanTime.Time) < duration(ctx, spec.Interval, defaultInterval) {
+		return nil
+	}
+
+	orphans, err := s.scan(ctx, now.Add(-duration(ctx, spec.MinAge, defaultMinAge)))
+	if err != nil {
+		return err
+	}
+	status := summarize(orphans)
+	status.LastScanTime = metav1.NewTime(now)
+
+	var errs []error
+	if spec.GetCleanupPolicy() == v1alpha1.OrphanedPVCPolicyDelete {
+		for _, pvc := range orphans {
+			logger.Infof("deleting the orphaned persistentvolumeclaim %s/%s", pvc.Namespace, pvc.Name)
+			err := s.kubeClientSet.CoreV1().PersistentVolumeClaims(pvc.Namespace).Delete(ctx, pvc.Name, metav1.DeleteOptions{
+				Preconditions: &metav1.Preconditions{UID: &pvc.UID},
+			})
+			if err != nil && !apierrors.IsNotFound(err) {
+				errs = append(errs, fmt.Errorf("failed to delete the orphaned persistentvolumeclaim %s/%s: %w", pvc.Namespace, pvc.Name, err))
+				continue
+			}
+			status.Deleted++
+		}
+	} else if status.Count > 0 {
+		logger.Infof("found %d orphaned persistentvolumeclaims of %s", status.Count, status.TotalSize)
+	}
+	tc.Status.OrphanedPVCs = status
+	return errors.Join(errs...)
+}
+
+// scan returns the PersistentVolumeClaims of the workspaces created before the cutoff which
+// are orphaned
+func (s *Scanner) scan(ctx context.Context, cutoff time.Time) ([]corev1.PersistentVolumeClaim, error) {
+	pipelineRuns, err := s.dynamicClient.Resource(pipelineRunsGVR).Namespace(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the pipelineruns: %w", err)
+	}
+	// the PipelineRuns by namespace/name and the claims used by the running PipelineRuns
+	runs := map[string]types.UID{}
+	claimed := map[string]bool{}
+	for _, pr := range pipelineRuns.Items {
+		runs[pr.GetNamespace()+"/"+pr.GetName()] = pr.GetUID()
+		if done(&pr) {
+			continue
+		}
+		for _, claim := range claimNames(&pr) {
+			claimed[pr.GetNamespace()+"/"+claim] = true
+		}
+	}
+
+	statefulSets, err := s.kubeClientSet.AppsV1().StatefulSets(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the statefulsets: %w", err)
+	}
+	assistants := map[string]bool{}
+	for _, sts := range statefulSets.Items {
+		assistants[sts.Namespace+"/"+sts.Name] = true
+	}
+
+	pvcs, err := s.kubeClientSet.CoreV1().PersistentVolumeClaims(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the persistentvolumeclaims: %w", err)
+	}
+	var orphans []corev1.PersistentVolumeClaim
+	for _, pvc := range pvcs.Items {
+		if pvc.DeletionTimestamp != nil || pvc.CreationTimestamp.Time.After(cutoff) || claimed[pvc.Namespace+"/"+pvc.Name] {
+			continue
+		}
+		if orphaned(&pvc, runs, assistants) {
+			orphans = append(orphans, pvc)
+		}
+	}
+	return orphans, nil
+}
+
+// orphaned returns true if the PersistentVolumeClaim was created for a workspace and its
+// PipelineRun or affinity assistant no longer exists, the other claims are never orphaned
+func orphaned(pvc *corev1.PersistentVolumeClaim, runs map[string]types.UID, assistants map[string]bool) bool {
+	for _, ref := range pvc.OwnerReferences {
+		if ref.Kind != "PipelineRun" {
+			continue
+		}
+		if gv, err := schema.ParseGroupVersion(ref.APIVersion); err != nil || gv.Group != pipelineRunsGVR.Group {
+			continue
+		}
+		uid, ok := runs[pvc.Namespace+"/"+ref.Name]
+		return !ok || uid != ref.UID
+	}
+	if name, ok := pvc.Labels[pipelineRunLabel]; ok {
+		_, ok := runs[pvc.Namespace+"/"+name]
+		return !ok
+	}
+	if m := affinityAssistantClaim.FindStringSubmatch(pvc.Name); m != nil {
+		return !assistants[pvc.Namespace+"/"+m[1]]
+	}
+	return false
+}
+
+func done(pr *unstructured.Unstructured) bool {
+	conditions, _, _ := unstructured.NestedSlice(pr.Object, "status", "conditions")
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if ok && condition["type"] == "Succeeded" {
+			return condition["status"] != string(corev1.ConditionUnknown)
+		}
+	}
+	return false
+}
+
+// claimNames returns the PersistentVolumeClaims bound to the workspaces of the PipelineRun
+func claimNames(pr *unstructured.Unstructured) []string {
+	workspaces, _, _ := unstructured.NestedSlice(pr.Object, "spec", "workspaces")
+	var names []string
+	for _, w := range workspaces {
+		workspace, ok := w.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if name, ok, _ := unstructured.NestedString(workspace, "persistentVolumeClaim", "claimName"); ok {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// summarize returns the count, size and oldest orphaned PersistentVolumeClaim by namespace
+func summarize(orphans []corev1.PersistentVolumeClaim) *v1alpha1.OrphanedPVCsStatus {
+	status := &v1alpha1.OrphanedPVCsStatus{Count: len(orphans)}
+	total := resource.Quantity{}
+	byNamespace := map[string]*v1alpha1.OrphanedPVCNamespaceSummary{}
+	sizes := map[string]*resource.Quantity{}
+	for _, pvc := range orphans {
+		summary, ok := byNamespace[pvc.Namespace]
+		if !ok {
+			summary = &v1alpha1.OrphanedPVCNamespaceSummary{Namespace: pvc.Namespace, Oldest: pvc.CreationTimestamp}
+			byNamespace[pvc.Namespace] = summary
+			sizes[pvc.Namespace] = &resource.Quantity{}
+		}
+		summary.Count++
+		if pvc.CreationTimestamp.Before(&summary.Oldest) {
+			summary.Oldest = pvc.CreationTimestamp
+		}
+		size := storage(&pvc)
+		sizes[pvc.Namespace].Add(size)
+		total.Add(size)
+	}
+	for namespace, summary := range byNamespace {
+		if !sizes[namespace].IsZero() {
+			summary.Size = sizes[namespace].String()
+		}
+		status.Namespaces = append(status.Namespaces, *summary)
+	}
+	sort.Slice(status.Namespaces, func(i, j int) bool { return status.Namespaces[i].Namespace < status.Namespaces[j].Namespace })
+	if !total.IsZero() {
+		status.TotalSize = total.String()
+	}
+	return status
+}
+
+// storage returns the capacity of the bound PersistentVolumeClaim or the storage it requests
+func storage(pvc *corev1.PersistentVolumeClaim) resource.Quantity {
+	if capacity, ok := pvc.Status.Capacity[corev1.ResourceStorage]; ok {
+		return capacity
+	}
+	return pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+}
+
+func duration(ctx context.Context, value string, def time.Duration) time.Duration {
+	if value == "" {
+		return def
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		logging.FromContext(ctx).Warnf("invalid duration %q of the orphaned persistentvolumeclaims scan, using default %s", value, def)
+		return def
+	}
+	return d
+}
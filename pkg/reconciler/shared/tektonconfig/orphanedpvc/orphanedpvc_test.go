@@ -0,0 +1,196 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package orphanedpvc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"gotest.tools/v3/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	dynamicFake "k8s.io/client-go/dynamic/fake"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+)
+
+var now = time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+func pipelineRun(namespace, name string, uid types.UID, running bool, claims ...string) *unstructured.Unstructured {
+	status := "True"
+	if running {
+		status = "Unknown"
+	}
+	var workspaces []interface{}
+	for _, claim := range claims {
+		workspaces = append(workspaces, map[string]interface{}{
+			"name":                  claim,
+			"persistentVolumeClaim": map[string]interface{}{"claimName": claim},
+		})
+	}
+	pr := &unstructured.Unstructured{Object: map[string]interface{}{
+		"spec":   map[string]interface{}{"workspaces": workspaces},
+		"status": map[string]interface{}{"conditions": []interface{}{map[string]interface{}{"type": "Succeeded", "status": status}}},
+	}}
+	pr.SetAPIVersion("tekton.dev/v1")
+	pr.SetKind("PipelineRun")
+	pr.SetNamespace(namespace)
+	pr.SetName(name)
+	pr.SetUID(uid)
+	return pr
+}
+
+func pvc(namespace, name string, age time.Duration, size string, modify ...func(*corev1.PersistentVolumeClaim)) *corev1.PersistentVolumeClaim {
+	p := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         namespace,
+			Name:              name,
+			UID:               types.UID(namespace + "-" + name),
+			CreationTimestamp: metav1.NewTime(now.Add(-age)),
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{Resources: corev1.VolumeResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse(size)},
+		}},
+	}
+	for _, m := range modify {
+		m(p)
+	}
+	return p
+}
+
+func ownedBy(name string, uid types.UID) func(*corev1.PersistentVolumeClaim) {
+	return func(p *corev1.PersistentVolumeClaim) {
+		p.OwnerReferences = []metav1.OwnerReference{{APIVersion: "tekton.dev/v1", Kind: "PipelineRun", Name: name, UID: uid}}
+	}
+}
+
+func labeled(name string) func(*corev1.PersistentVolumeClaim) {
+	return func(p *corev1.PersistentVolumeClaim) {
+		p.Labels = map[string]string{pipelineRunLabel: name}
+	}
+}
+
+func newScanner(t *testing.T, objects ...runtime.Object) (*Scanner, *kubefake.Clientset) {
+	t.Helper()
+	kube := kubefake.NewSimpleClientset(
+		pvc("dev", "pvc-owned-running", 3*time.Hour, "1Gi", ownedBy("build", "build-uid")),
+		pvc("dev", "pvc-owned-gone", 3*time.Hour, "1Gi", ownedBy("deleted", "deleted-uid")),
+		pvc("dev", "pvc-owned-recreated", 5*time.Hour, "2Gi", ownedBy("build", "old-uid")),
+		pvc("dev", "pvc-young", 10*time.Minute, "1Gi", ownedBy("deleted", "deleted-uid")),
+		pvc("dev", "shared", 48*time.Hour, "10Gi", labeled("deleted")),
+		pvc("dev", "cache", 48*time.Hour, "10Gi"),
+		pvc("prod", "source-affinity-assistant-0123abcd-0", 24*time.Hour, "500Mi"),
+		pvc("prod", "source-affinity-assistant-4567ef01-0", 24*time.Hour, "500Mi"),
+		pvc("prod", "pvc-labeled", 2*time.Hour, "1Gi", labeled("release")),
+		&appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Namespace: "prod", Name: "affinity-assistant-4567ef01"}},
+	)
+	resources := []runtime.Object{
+		pipelineRun("dev", "build", "build-uid", false),
+		// the running PipelineRun uses the shared claim of a deleted PipelineRun
+		pipelineRun("dev", "lint", "lint-uid", true, "shared"),
+	}
+	resources = append(resources, objects...)
+	dc := dynamicFake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{pipelineRunsGVR: "PipelineRunList"}, resources...)
+	s := New(kube, dc)
+	s.now = func() time.Time { return now }
+	return s, kube
+}
+
+func newTektonConfig(scan *v1alpha1.OrphanedPVCScan) *v1alpha1.TektonConfig {
+	tc := &v1alpha1.TektonConfig{}
+	tc.Spec.Pruner.OrphanedPVCs = scan
+	return tc
+}
+
+func TestReconcileReport(t *testing.T) {
+	s, kube := newScanner(t)
+	tc := newTektonConfig(&v1alpha1.OrphanedPVCScan{Enabled: true})
+
+	assert.NilError(t, s.Reconcile(context.Background(), tc))
+	assert.DeepEqual(t, tc.Status.OrphanedPVCs, &v1alpha1.OrphanedPVCsStatus{
+		LastScanTime: metav1.NewTime(now),
+		Count:        4,
+		TotalSize:    "4596Mi",
+		Namespaces: []v1alpha1.OrphanedPVCNamespaceSummary{
+			{Namespace: "dev", Count: 2, Size: "3Gi", Oldest: metav1.NewTime(now.Add(-5 * time.Hour))},
+			{Namespace: "prod", Count: 2, Size: "1524Mi", Oldest: metav1.NewTime(now.Add(-24 * time.Hour))},
+		},
+	})
+	pvcs, err := kube.CoreV1().PersistentVolumeClaims(metav1.NamespaceAll).List(context.Background(), metav1.ListOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, len(pvcs.Items), 9)
+
+	// the scan runs once per interval
+	kube.ClearActions()
+	s.now = func() time.Time { return now.Add(30 * time.Minute) }
+	assert.NilError(t, s.Reconcile(context.Background(), tc))
+	assert.Equal(t, len(kube.Actions()), 0)
+	assert.Equal(t, tc.Status.OrphanedPVCs.LastScanTime, metav1.NewTime(now))
+
+	s.now = func() time.Time { return now.Add(2 * time.Hour) }
+	assert.NilError(t, s.Reconcile(context.Background(), tc))
+	assert.Equal(t, tc.Status.OrphanedPVCs.LastScanTime, metav1.NewTime(now.Add(2*time.Hour)))
+	// pvc-young is old enough now
+	assert.Equal(t, tc.Status.OrphanedPVCs.Count, 5)
+}
+
+func TestReconcileMinAge(t *testing.T) {
+	s, _ := newScanner(t)
+	tc := newTektonConfig(&v1alpha1.OrphanedPVCScan{Enabled: true, MinAge: "12h"})
+
+	assert.NilError(t, s.Reconcile(context.Background(), tc))
+	assert.Equal(t, tc.Status.OrphanedPVCs.Count, 1)
+	assert.Equal(t, tc.Status.OrphanedPVCs.Namespaces[0].Namespace, "prod")
+}
+
+func TestReconcileDelete(t *testing.T) {
+	s, kube := newScanner(t, pipelineRun("prod", "release", "release-uid", false))
+	tc := newTektonConfig(&v1alpha1.OrphanedPVCScan{Enabled: true, CleanupPolicy: v1alpha1.OrphanedPVCPolicyDelete})
+
+	assert.NilError(t, s.Reconcile(context.Background(), tc))
+	assert.Equal(t, tc.Status.OrphanedPVCs.Count, 3)
+	assert.Equal(t, tc.Status.OrphanedPVCs.Deleted, 3)
+	for _, deleted := range []string{"pvc-owned-gone", "pvc-owned-recreated"} {
+		_, err := kube.CoreV1().PersistentVolumeClaims("dev").Get(context.Background(), deleted, metav1.GetOptions{})
+		assert.Assert(t, apierrors.IsNotFound(err), deleted)
+	}
+	for _, kept := range []string{"pvc-owned-running", "pvc-young", "shared", "cache"} {
+		_, err := kube.CoreV1().PersistentVolumeClaims("dev").Get(context.Background(), kept, metav1.GetOptions{})
+		assert.NilError(t, err, kept)
+	}
+	_, err := kube.CoreV1().PersistentVolumeClaims("prod").Get(context.Background(), "pvc-labeled", metav1.GetOptions{})
+	assert.NilError(t, err)
+}
+
+func TestReconcileDisabled(t *testing.T) {
+	s, kube := newScanner(t)
+	tc := newTektonConfig(&v1alpha1.OrphanedPVCScan{Enabled: false})
+	tc.Status.OrphanedPVCs = &v1alpha1.OrphanedPVCsStatus{Count: 1}
+
+	assert.NilError(t, s.Reconcile(context.Background(), tc))
+	assert.Assert(t, tc.Status.OrphanedPVCs == nil)
+	assert.Equal(t, len(kube.Actions()), 0)
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package outputs
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/client/clientset/versioned"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/readiness"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// ConfigMapName is the ConfigMap in the target namespace holding the outputs of the install
+	ConfigMapName = "tekton-operator-outputs"
+
+	// VersionKeyPrefix followed by the name of a component holds its installed version
+	VersionKeyPrefix = "version."
+	// URLKeyPrefix followed by the name of a component holds its in-cluster URL
+	URLKeyPrefix = "url."
+	// WebhookCABundleKeyPrefix followed by the name of a component holds the PEM encoded
+	// CA bundle of its admission webhooks
+	WebhookCABundleKeyPrefix = "webhook-ca-bundle."
+
+	operatorName = "operator"
+
+	// the PipelinesAsCode info ConfigMap holds the URL of its controller
+	pacInfoConfigMapName = "pipelines-as-code-info"
+	pacControllerURLKey  = "controller-url"
+)
+
+// endpoint is the Service of a component from which its in-cluster URL is computed
+type endpoint struct {
+	name    string
+	service string
+	scheme  string
+}
+
+var endpoints = []endpoint{
+	{name: v1alpha1.DashboardResourceName, service: "tekton-dashboard", scheme: "http"},
+	{name: v1alpha1.ResultResourceName, service: "tekton-results-api-service", scheme: "https"},
+	{name: v1alpha1.OpenShiftPipelinesAsCodeName, service: "pipelines-as-code-controller", scheme: "http"},
+}
+
+// webhooks are the validating webhook configurations holding the CA bundle of the components
+var webhooks = map[string]string{
+	v1alpha1.PipelineResourceName: "validation.webhook.pipeline.tekton.dev",
+	v1alpha1.TriggerResourceName:  "validation.webhook.triggers.tekton.dev",
+}
+
+// Outputs publishes the computed endpoints, the installed versions and the webhook CA bundles
+// in a ConfigMap with a stable name, for external automation to consume without parsing the
+// status of the custom resources
+type Outputs struct {
+	kubeClientSet     kubernetes.Interface
+	operatorClientSet versioned.Interface
+	operatorVersion   string
+}
+
+func New(operatorVersion string, kubeClientSet kubernetes.Interface, operatorClientSet versioned.Interface) *Outputs {
+	return &Outputs{
+		kubeClientSet:     kubeClientSet,
+		operatorClientSet: operatorClientSet,
+		operatorVersion:   operatorVersion,
+	}
+}
+
+// Reconcile computes the outputs and creates or updates the ConfigMap in the target namespace
+func (o *Outputs) Reconcile(ctx context.Context, tc *v1alpha1.TektonConfig) error {
+	targetNamespace := tc.Spec.GetTargetNamespace()
+	data, err := o.compute(ctx, targetNamespace)
+	if err != nil {
+		return err
+	}
+
+	cmClient := o.kubeClientSet.CoreV1().ConfigMaps(targetNamespace)
+	existing, err := cmClient.Get(ctx, ConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+		_, err = cmClient.Create(ctx, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            ConfigMapName,
+				Namespace:       targetNamespace,
+				OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(tc, tc.GetGroupVersionKind())},
+			},
+			Data: data,
+		}, metav1.CreateOptions{})
+		return err
+	}
+	if reflect.DeepEqual(existing.Data, data) {
+		return nil
+	}
+	existing = existing.DeepCopy()
+	existing.Data = data
+	_, err = cmClient.Update(ctx, existing, metav1.UpdateOptions{})
+	return err
+}
+
+func (o *Outputs) compute(ctx context.Context, targetNamespace string) (map[string]string, error) {
+	data := map[string]string{VersionKeyPrefix + operatorName: o.operatorVersion}
+
+	// the versions of the installed components, as reported in their status
+	for _, component := range readiness.Check(ctx, o.operatorClientSet).Components {
+		if component.Kind == "TektonConfig" || component.Version == "" {
+			continue
+		}
+		data[VersionKeyPrefix+component.Name] = component.Version
+	}
+
+	for _, e := range endpoints {
+		svc, err := o.kubeClientSet.CoreV1().Services(targetNamespace).Get(ctx, e.service, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(svc.Spec.Ports) == 0 {
+			continue
+		}
+		data[URLKeyPrefix+e.name] = fmt.Sprintf("%s://%s.%s.svc.cluster.local:%d", e.scheme, svc.Name, svc.Namespace, svc.Spec.Ports[0].Port)
+	}
+
+	// PipelinesAsCode publishes the URL its controller is reachable at, e.g. its route
+	pacInfo, err := o.kubeClientSet.CoreV1().ConfigMaps(targetNamespace).Get(ctx, pacInfoConfigMapName, metav1.GetOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+	if err == nil && pacInfo.Data[pacControllerURLKey] != "" {
+		data[URLKeyPrefix+v1alpha1.OpenShiftPipelinesAsCodeName] = pacInfo.Data[pacControllerURLKey]
+	}
+
+	for component, name := range webhooks {
+		webhook, err := o.kubeClientSet.AdmissionregistrationV1().ValidatingWebhookConfigurations().Get(ctx, name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(webhook.Webhooks) > 0 && len(webhook.Webhooks[0].ClientConfig.CABundle) > 0 {
+			data[WebhookCABundleKeyPrefix+component] = string(webhook.Webhooks[0].ClientConfig.CABundle)
+		}
+	}
+	return data, nil
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package outputs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	operatorfake "github.com/tektoncd/operator/pkg/client/clientset/versioned/fake"
+	"gotest.tools/v3/assert"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestOutputsReconcile(t *testing.T) {
+	ctx := context.TODO()
+	tc := &v1alpha1.TektonConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.ConfigResourceName},
+		Spec:       v1alpha1.TektonConfigSpec{CommonSpec: v1alpha1.CommonSpec{TargetNamespace: "tekton-pipelines"}},
+	}
+	tp := &v1alpha1.TektonPipeline{ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.PipelineResourceName}}
+	tp.Status.SetVersion("v0.59.0")
+	operatorClient := operatorfake.NewSimpleClientset(tc, tp)
+	kubeClient := kubefake.NewSimpleClientset(
+		&corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "tekton-results-api-service", Namespace: "tekton-pipelines"},
+			Spec:       corev1.ServiceSpec{Ports: []corev1.ServicePort{{Name: "server", Port: 8080}}},
+		},
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: pacInfoConfigMapName, Namespace: "tekton-pipelines"},
+			Data:       map[string]string{pacControllerURLKey: "https://pac.apps.example.com"},
+		},
+		&admissionregistrationv1.ValidatingWebhookConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Name: "validation.webhook.pipeline.tekton.dev"},
+			Webhooks: []admissionregistrationv1.ValidatingWebhook{{
+				Name:         "validation.webhook.pipeline.tekton.dev",
+				ClientConfig: admissionregistrationv1.WebhookClientConfig{CABundle: []byte("ca-bundle")},
+			}},
+		},
+	)
+
+	o := New("v0.70.0", kubeClient, operatorClient)
+	assert.NilError(t, o.Reconcile(ctx, tc))
+	cm, err := kubeClient.CoreV1().ConfigMaps("tekton-pipelines").Get(ctx, ConfigMapName, metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, cm.Data, map[string]string{
+		"version.operator":           "v0.70.0",
+		"version.pipeline":           "v0.59.0",
+		"url.result":                 "https://tekton-results-api-service.tekton-pipelines.svc.cluster.local:8080",
+		"url.pipelines-as-code":      "https://pac.apps.example.com",
+		"webhook-ca-bundle.pipeline": "ca-bundle",
+	})
+	assert.Equal(t, cm.OwnerReferences[0].Name, v1alpha1.ConfigResourceName)
+
+	// the ConfigMap is updated when the outputs change
+	tp.Status.SetVersion("v0.60.0")
+	_, err = operatorClient.OperatorV1alpha1().TektonPipelines().UpdateStatus(ctx, tp, metav1.UpdateOptions{})
+	assert.NilError(t, err)
+	assert.NilError(t, o.Reconcile(ctx, tc))
+	cm, err = kubeClient.CoreV1().ConfigMaps("tekton-pipelines").Get(ctx, ConfigMapName, metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, cm.Data["version.pipeline"], "v0.60.0")
+}
@@ -0,0 +1,351 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package paramsfrom resolves the params of TektonConfig and the properties of its components
+// from the ConfigMaps and Secrets referenced in spec.paramsFrom, so that the values which are
+// sensitive or specific to a cluster are not set in the TektonConfig kept in Git.
+package paramsfrom
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	listers "github.com/tektoncd/operator/pkg/client/listers/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/hash"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubeinformers "k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/kmeta"
+	"knative.dev/pkg/logging"
+)
+
+// ParamsFromChangedReason is the reason of the event recorded on TektonConfig when the values
+// resolved from spec.paramsFrom changed
+const ParamsFromChangedReason = "ParamsFromChanged"
+
+// Resolver resolves the spec of TektonConfig with the values of the sources of spec.paramsFrom
+type Resolver struct {
+	kubeClientSet kubernetes.Interface
+	namespace     string
+	now           func() time.Time
+}
+
+// New returns the Resolver of the sources of the operator namespace
+func New(kubeClientSet kubernetes.Interface, namespace string) *Resolver {
+	return &Resolver{kubeClientSet: kubeClientSet, namespace: namespace, now: time.Now}
+}
+
+// resolvedSource is a source as hashed to detect the changes of the resolved values
+type resolvedSource struct {
+	Ref       string            `json:"ref"`
+	Component string            `json:"component,omitempty"`
+	Data      map[string]string `json:"data,omitempty"`
+	Missing   bool              `json:"missing,omitempty"`
+}
+
+// Resolve returns a copy of TektonConfig with the params and the properties of the components
+// read from the sources set in its spec, in the order of the sources, and validates them. The
+// resolved copy is only used by the reconcile and is not persisted, the spec of TektonConfig is
+// not changed. The hash of the resolved values is recorded in the status of TektonConfig, and
+// their changes are reported with an event on TektonConfig.
+func (r *Resolver) Resolve(ctx context.Context, tc *v1alpha1.TektonConfig) (*v1alpha1.TektonConfig, error) {
+	if len(tc.Spec.ParamsFrom) == 0 {
+		tc.Status.ParamsFrom = nil
+		return tc.DeepCopy(), nil
+	}
+	spec := tc.Spec.DeepCopy()
+	resolved := make([]resolvedSource, 0, len(spec.ParamsFrom))
+	for _, source := range spec.ParamsFrom {
+		s, err := r.read(ctx, source)
+		if err != nil {
+			return nil, err
+		}
+		resolved = append(resolved, s)
+		if s.Missing {
+			continue
+		}
+		if source.Component == "" {
+			setParams(spec, s.Data)
+			continue
+		}
+		if err := setProperties(spec, source.Component, s.Data); err != nil {
+			return nil, fmt.Errorf("invalid properties of %s: %w", s.Ref, err)
+		}
+	}
+	if errs := spec.ValidateResolvedParams(); errs != nil {
+		return nil, fmt.Errorf("invalid values resolved from spec.paramsFrom: %w", errs)
+	}
+	resolvedHash, err := hash.Compute(resolved)
+	if err != nil {
+		return nil, err
+	}
+	r.recordChange(ctx, tc, resolved, resolvedHash)
+
+	resolvedTC := tc.DeepCopy()
+	resolvedTC.Spec = *spec
+	return resolvedTC, nil
+}
+
+// recordChange sets the hash of the resolved values in the status of TektonConfig and reports
+// with an event the change of the values resolved previously
+func (r *Resolver) recordChange(ctx context.Context, tc *v1alpha1.TektonConfig, resolved []resolvedSource, resolvedHash string) {
+	previous := tc.Status.ParamsFrom
+	if previous == nil {
+		tc.Status.ParamsFrom = &v1alpha1.ParamsFromStatus{Hash: resolvedHash}
+		return
+	}
+	if previous.Hash == resolvedHash {
+		return
+	}
+	now := metav1.NewTime(r.now())
+	tc.Status.ParamsFrom = &v1alpha1.ParamsFromStatus{Hash: resolvedHash, LastChangeTime: &now}
+
+	refs := make([]string, 0, len(resolved))
+	for _, s := range resolved {
+		refs = append(refs, s.Ref)
+	}
+	message := fmt.Sprintf("the values resolved from %s changed", strings.Join(refs, ", "))
+	logger := logging.FromContext(ctx)
+	logger.Info(message)
+	recorder := controller.GetEventRecorder(ctx)
+	if recorder == nil {
+		logger.Debug("no event recorder in the context to report the change of the values of spec.paramsFrom")
+		return
+	}
+	recorder.Event(tc, corev1.EventTypeNormal, ParamsFromChangedReason, message)
+}
+
+// read returns the data of the ConfigMap or Secret of the source, a missing optional source is
+// marked missing
+func (r *Resolver) read(ctx context.Context, source v1alpha1.ParamsFromSource) (resolvedSource, error) {
+	s := resolvedSource{Component: source.Component}
+	var err error
+	switch {
+	case source.ConfigMap != nil:
+		s.Ref = fmt.Sprintf("configmap %s/%s", r.namespace, source.ConfigMap.Name)
+		var cm *corev1.ConfigMap
+		if cm, err = r.kubeClientSet.CoreV1().ConfigMaps(r.namespace).Get(ctx, source.ConfigMap.Name, metav1.GetOptions{}); err == nil {
+			s.Data = cm.Data
+		}
+	case source.Secret != nil:
+		s.Ref = fmt.Sprintf("secret %s/%s", r.namespace, source.Secret.Name)
+		var secret *corev1.Secret
+		if secret, err = r.kubeClientSet.CoreV1().Secrets(r.namespace).Get(ctx, source.Secret.Name, metav1.GetOptions{}); err == nil {
+			s.Data = map[string]string{}
+			for key, value := range secret.Data {
+				s.Data[key] = string(value)
+			}
+		}
+	default:
+		return s, fmt.Errorf("a source of spec.paramsFrom has neither a configmap nor a secret")
+	}
+	if apierrors.IsNotFound(err) && source.Optional {
+		s.Missing = true
+		return s, nil
+	}
+	if err != nil {
+		return s, fmt.Errorf("failed to get the params of %s: %w", s.Ref, err)
+	}
+	return s, nil
+}
+
+// setParams sets the params of the data in spec.params, replacing the params with the same name
+func setParams(spec *v1alpha1.TektonConfigSpec, data map[string]string) {
+	for _, name := range sortedKeys(data) {
+		found := false
+		for i := range spec.Params {
+			if spec.Params[i].Name == name {
+				spec.Params[i].Value = data[name]
+				found = true
+			}
+		}
+		if !found {
+			spec.Params = append(spec.Params, v1alpha1.Param{Name: name, Value: data[name]})
+		}
+	}
+}
+
+// setProperties sets the properties of the data in the field of the component in the spec
+func setProperties(spec *v1alpha1.TektonConfigSpec, component string, data map[string]string) error {
+	v := reflect.ValueOf(spec).Elem()
+	field, ok := fieldByJSONName(v, component)
+	if !ok {
+		return fmt.Errorf("unknown component %s", component)
+	}
+	for _, key := range sortedKeys(data) {
+		found, err := setProperty(field, key, data[key])
+		if err != nil {
+			return fmt.Errorf("invalid value of property %s: %w", key, err)
+		}
+		if !found {
+			return fmt.Errorf("unknown property %s of %s", key, component)
+		}
+	}
+	return nil
+}
+
+// fieldByJSONName returns the field of the struct with the JSON name
+func fieldByJSONName(v reflect.Value, name string) (reflect.Value, bool) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		if n, _ := jsonName(t.Field(i)); n == name {
+			return v.Field(i), true
+		}
+	}
+	return reflect.Value{}, false
+}
+
+// setProperty sets the value of the field of the struct named with the key, the key of a nested
+// field is the JSON names of the fields joined with dots, e.g. performance.buckets. The JSON
+// names may hold dots, e.g. artifacts.oci.storage. It returns false when there is no such field.
+func setProperty(v reflect.Value, key, value string) (bool, error) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		name, inline := jsonName(f)
+		switch {
+		case name == "-":
+			continue
+		case inline:
+			if f.Type.Kind() != reflect.Struct {
+				continue
+			}
+			if found, err := setProperty(v.Field(i), key, value); found || err != nil {
+				return found, err
+			}
+		case name == key:
+			return true, setValue(v.Field(i), value)
+		case strings.HasPrefix(key, name+"."):
+			ft := f.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() != reflect.Struct {
+				continue
+			}
+			// the nested struct is only allocated when it holds the property
+			nested := reflect.New(ft)
+			if field := v.Field(i); field.Kind() != reflect.Ptr {
+				nested.Elem().Set(field)
+			} else if !field.IsNil() {
+				nested.Elem().Set(field.Elem())
+			}
+			found, err := setProperty(nested.Elem(), strings.TrimPrefix(key, name+"."), value)
+			if err != nil {
+				return true, err
+			}
+			if !found {
+				continue
+			}
+			if v.Field(i).Kind() == reflect.Ptr {
+				v.Field(i).Set(nested)
+			} else {
+				v.Field(i).Set(nested.Elem())
+			}
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// setValue sets the field from the value parsed as JSON, the values of the string fields are not
+// quoted, e.g. beta or "true"
+func setValue(field reflect.Value, value string) error {
+	parsed := reflect.New(field.Type())
+	if err := json.Unmarshal([]byte(value), parsed.Interface()); err != nil {
+		quoted, _ := json.Marshal(value)
+		if json.Unmarshal(quoted, parsed.Interface()) != nil {
+			return err
+		}
+	}
+	field.Set(parsed.Elem())
+	return nil
+}
+
+// jsonName returns the JSON name of the field, and whether its fields are inlined
+func jsonName(f reflect.StructField) (string, bool) {
+	tag := f.Tag.Get("json")
+	name, options, _ := strings.Cut(tag, ",")
+	if strings.Contains(options, "inline") || (f.Anonymous && name == "") {
+		return "", true
+	}
+	if name == "" {
+		name = f.Name
+	}
+	return name, false
+}
+
+func sortedKeys(data map[string]string) []string {
+	keys := make([]string, 0, len(data))
+	for key := range data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Watch enqueues TektonConfig when a ConfigMap or a Secret of the operator namespace referenced
+// in spec.paramsFrom changes
+func Watch(ctx context.Context, kubeClient kubernetes.Interface, namespace string, lister listers.TektonConfigLister, enqueue func()) {
+	logger := logging.FromContext(ctx)
+	factory := kubeinformers.NewSharedInformerFactoryWithOptions(kubeClient, controller.GetResyncPeriod(ctx), kubeinformers.WithNamespace(namespace))
+	handler := func(kind string) cache.ResourceEventHandler {
+		return cache.FilteringResourceEventHandler{
+			FilterFunc: func(obj interface{}) bool {
+				object, err := kmeta.DeletionHandlingAccessor(obj)
+				return err == nil && referenced(lister, kind, object.GetName())
+			},
+			Handler: controller.HandleAll(func(interface{}) { enqueue() }),
+		}
+	}
+	if _, err := factory.Core().V1().ConfigMaps().Informer().AddEventHandler(handler("ConfigMap")); err != nil {
+		logger.Panicf("Couldn't register the configmap informer event handler of spec.paramsFrom: %w", err)
+	}
+	if _, err := factory.Core().V1().Secrets().Informer().AddEventHandler(handler("Secret")); err != nil {
+		logger.Panicf("Couldn't register the secret informer event handler of spec.paramsFrom: %w", err)
+	}
+	factory.Start(ctx.Done())
+}
+
+// referenced returns true when the ConfigMap or Secret is a source of spec.paramsFrom
+func referenced(lister listers.TektonConfigLister, kind, name string) bool {
+	tc, err := lister.Get(v1alpha1.ConfigResourceName)
+	if err != nil {
+		return false
+	}
+	for _, source := range tc.Spec.ParamsFrom {
+		switch {
+		case kind == "ConfigMap" && source.ConfigMap != nil && source.ConfigMap.Name == name:
+			return true
+		case kind == "Secret" && source.Secret != nil && source.Secret.Name == name:
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package paramsfrom
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	listers "github.com/tektoncd/operator/pkg/client/listers/operator/v1alpha1"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/ptr"
+)
+
+const namespace = "tekton-operator"
+
+func configMap(name string, data map[string]string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}, Data: data}
+}
+
+func secret(name string, data map[string]string) *corev1.Secret {
+	s := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}, Data: map[string][]byte{}}
+	for key, value := range data {
+		s.Data[key] = []byte(value)
+	}
+	return s
+}
+
+func tektonConfig(sources ...v1alpha1.ParamsFromSource) *v1alpha1.TektonConfig {
+	tc := &v1alpha1.TektonConfig{ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.ConfigResourceName}}
+	tc.Spec.Params = []v1alpha1.Param{{Name: v1alpha1.CreateRbacResourceParam, Value: "true"}}
+	tc.Spec.Pipeline.EnableApiFields = "stable"
+	tc.Spec.ParamsFrom = sources
+	return tc
+}
+
+func fromConfigMap(name, component string) v1alpha1.ParamsFromSource {
+	return v1alpha1.ParamsFromSource{ConfigMap: &corev1.LocalObjectReference{Name: name}, Component: component}
+}
+
+func fromSecret(name, component string) v1alpha1.ParamsFromSource {
+	return v1alpha1.ParamsFromSource{Secret: &corev1.LocalObjectReference{Name: name}, Component: component}
+}
+
+func TestResolve(t *testing.T) {
+	client := fake.NewSimpleClientset(
+		configMap("params", map[string]string{
+			v1alpha1.CreateRbacResourceParam: "False",
+			v1alpha1.LegacyPipelineRbacParam: "false",
+		}),
+		configMap("pipeline", map[string]string{
+			"enable-api-fields":   "beta",
+			"disable-creds-init":  "true",
+			"performance.buckets": "4",
+		}),
+		secret("chain", map[string]string{"artifacts.oci.storage": "oci"}),
+	)
+	tc := tektonConfig(fromConfigMap("params", ""), fromConfigMap("pipeline", "pipeline"), fromSecret("chain", "chain"))
+	resolved, err := New(client, namespace).Resolve(context.TODO(), tc)
+	assert.NilError(t, err)
+
+	// the params are replaced or appended, and normalized
+	assert.DeepEqual(t, resolved.Spec.Params, []v1alpha1.Param{
+		{Name: v1alpha1.CreateRbacResourceParam, Value: "false"},
+		{Name: v1alpha1.LegacyPipelineRbacParam, Value: "false"},
+	})
+	assert.Equal(t, resolved.Spec.Pipeline.EnableApiFields, "beta")
+	assert.DeepEqual(t, resolved.Spec.Pipeline.DisableCredsInit, ptr.Bool(true))
+	assert.Equal(t, *resolved.Spec.Pipeline.Performance.Buckets, uint(4))
+	assert.Equal(t, *resolved.Spec.Chain.ArtifactsOCIStorage, "oci")
+	// the spec of TektonConfig is not changed
+	assert.DeepEqual(t, tc.Spec, tektonConfig(fromConfigMap("params", ""), fromConfigMap("pipeline", "pipeline"), fromSecret("chain", "chain")).Spec)
+	// the values of the chain secret are not in the status
+	assert.Assert(t, tc.Status.ParamsFrom.Hash != "")
+	assert.Assert(t, tc.Status.ParamsFrom.LastChangeTime == nil)
+	assert.DeepEqual(t, resolved.Status, tc.Status)
+}
+
+func TestResolveErrors(t *testing.T) {
+	client := fake.NewSimpleClientset(
+		configMap("unknown", map[string]string{"enable-everything": "true"}),
+		configMap("invalid-value", map[string]string{"performance.buckets": "many"}),
+		configMap("invalid-param", map[string]string{v1alpha1.NamespaceFailurePolicyParam: "retry"}),
+		configMap("invalid-property", map[string]string{"enable-api-fields": "experimental"}),
+	)
+	tests := []struct {
+		name   string
+		source v1alpha1.ParamsFromSource
+		err    string
+	}{
+		{name: "unknown property", source: fromConfigMap("unknown", "pipeline"), err: "invalid properties of configmap tekton-operator/unknown: unknown property enable-everything of pipeline"},
+		{name: "invalid value", source: fromConfigMap("invalid-value", "pipeline"), err: "invalid properties of configmap tekton-operator/invalid-value: invalid value of property performance.buckets"},
+		{name: "invalid param", source: fromConfigMap("invalid-param", ""), err: "invalid values resolved from spec.paramsFrom: invalid value: retry"},
+		{name: "invalid property", source: fromConfigMap("invalid-property", "pipeline"), err: "invalid values resolved from spec.paramsFrom: invalid value: experimental: spec.pipeline.enable-api-fields"},
+		{name: "missing source", source: fromSecret("missing", ""), err: `failed to get the params of secret tekton-operator/missing: secrets "missing" not found`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tc := tektonConfig(test.source)
+			_, err := New(client, namespace).Resolve(context.TODO(), tc)
+			assert.ErrorContains(t, err, test.err)
+			// the spec is not changed by a failed resolution
+			assert.DeepEqual(t, tc.Spec, tektonConfig(test.source).Spec)
+		})
+	}
+}
+
+func TestResolveOptional(t *testing.T) {
+	source := fromConfigMap("params", "")
+	source.Optional = true
+	tc := tektonConfig(source)
+	resolved, err := New(fake.NewSimpleClientset(), namespace).Resolve(context.TODO(), tc)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, resolved.Spec.Params, []v1alpha1.Param{{Name: v1alpha1.CreateRbacResourceParam, Value: "true"}})
+	assert.Assert(t, tc.Status.ParamsFrom != nil)
+}
+
+func TestResolveChanges(t *testing.T) {
+	ctx := context.TODO()
+	client := fake.NewSimpleClientset(configMap("params", map[string]string{v1alpha1.CreateRbacResourceParam: "false"}))
+	recorder := record.NewFakeRecorder(10)
+	ctx = controller.WithEventRecorder(ctx, recorder)
+	resolver := New(client, namespace)
+	resolver.now = func() time.Time { return time.Date(2026, 10, 15, 8, 0, 0, 0, time.UTC) }
+
+	// the first values are recorded without event
+	tc := tektonConfig(fromConfigMap("params", ""))
+	_, err := resolver.Resolve(ctx, tc)
+	assert.NilError(t, err)
+	status := tc.Status.ParamsFrom
+	assert.Equal(t, len(recorder.Events), 0)
+
+	// unchanged values are not reported
+	tc = &v1alpha1.TektonConfig{Spec: tektonConfig(fromConfigMap("params", "")).Spec, Status: tc.Status}
+	_, err = resolver.Resolve(ctx, tc)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, tc.Status.ParamsFrom, status)
+	assert.Equal(t, len(recorder.Events), 0)
+
+	_, err = client.CoreV1().ConfigMaps(namespace).Update(ctx, configMap("params", map[string]string{v1alpha1.CreateRbacResourceParam: "true"}), metav1.UpdateOptions{})
+	assert.NilError(t, err)
+	tc = &v1alpha1.TektonConfig{Spec: tektonConfig(fromConfigMap("params", "")).Spec, Status: tc.Status}
+	_, err = resolver.Resolve(ctx, tc)
+	assert.NilError(t, err)
+	changeTime := metav1.NewTime(time.Date(2026, 10, 15, 8, 0, 0, 0, time.UTC))
+	assert.Assert(t, tc.Status.ParamsFrom.Hash != status.Hash)
+	assert.DeepEqual(t, tc.Status.ParamsFrom.LastChangeTime, &changeTime)
+	assert.Equal(t, <-recorder.Events, "Normal ParamsFromChanged the values resolved from configmap tekton-operator/params changed")
+
+	// the status is cleared without sources
+	tc.Spec.ParamsFrom = nil
+	_, err = resolver.Resolve(ctx, tc)
+	assert.NilError(t, err)
+	assert.Assert(t, tc.Status.ParamsFrom == nil)
+}
+
+func TestReferenced(t *testing.T) {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	lister := listers.NewTektonConfigLister(indexer)
+	assert.Assert(t, !referenced(lister, "ConfigMap", "params"))
+
+	assert.NilError(t, indexer.Add(tektonConfig(fromConfigMap("params", ""), fromSecret("chain", "chain"))))
+	assert.Assert(t, referenced(lister, "ConfigMap", "params"))
+	assert.Assert(t, referenced(lister, "Secret", "chain"))
+	assert.Assert(t, !referenced(lister, "Secret", "params"))
+	assert.Assert(t, !referenced(lister, "ConfigMap", "other"))
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonconfig
+
+import (
+	"context"
+	"sort"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	clientset "github.com/tektoncd/operator/pkg/client/clientset/versioned"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/readiness"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/logging"
+)
+
+// reconcilePayloadVersions records the version of the operator binary and, by component kind,
+// the payload version and the SHA256 of the manifests of the installer sets, so that an operator
+// running with the payload of another release after a partial upgrade is visible in the status
+func (r *Reconciler) reconcilePayloadVersions(ctx context.Context, tc *v1alpha1.TektonConfig) error {
+	tc.Status.Operator = &v1alpha1.OperatorBuildStatus{Version: r.operatorVersion, Commit: common.OperatorCommit()}
+	payloads, err := payloadVersions(ctx, r.operatorClientSet)
+	if err != nil {
+		return err
+	}
+	for kind, payload := range payloads {
+		for _, version := range payload.OperatorVersions {
+			if version != r.operatorVersion {
+				logging.FromContext(ctx).Warnf("the installer sets of %s were created by the operator %v, the operator is %s",
+					kind, payload.OperatorVersions, r.operatorVersion)
+				break
+			}
+		}
+	}
+	tc.Status.PayloadVersions = payloads
+	return nil
+}
+
+// payloadVersions groups the installer sets by the kind of the component which created them, the
+// SHA256 of an installer set is the one recorded by the deterministic rendering when there is one
+func payloadVersions(ctx context.Context, client clientset.Interface) (map[string]v1alpha1.PayloadVersionStatus, error) {
+	sets, err := client.OperatorV1alpha1().TektonInstallerSets().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	sums := map[string][]string{}
+	operatorVersions := map[string]map[string]bool{}
+	for _, set := range sets.Items {
+		kind := set.GetLabels()[v1alpha1.CreatedByKey]
+		if kind == "" || set.GetDeletionTimestamp() != nil {
+			continue
+		}
+		sum, ok := set.GetAnnotations()[v1alpha1.ManifestsSHA256Key]
+		if ref := set.Spec.ManifestsRef; !ok && ref != nil && len(set.Spec.Manifests) == 0 {
+			// the manifests stored in ConfigMaps are named after the same SHA256
+			sum, ok = ref.SHA256, true
+		}
+		if !ok {
+			if sum, err = common.ManifestsSHA256(set.Spec.Manifests); err != nil {
+				return nil, err
+			}
+		}
+		sums[kind] = append(sums[kind], sum)
+		if operatorVersions[kind] == nil {
+			operatorVersions[kind] = map[string]bool{}
+		}
+		if version := set.GetLabels()[v1alpha1.ReleaseVersionKey]; version != "" {
+			operatorVersions[kind][version] = true
+		}
+	}
+
+	versions := map[string]string{}
+	for _, c := range readiness.Check(ctx, client).Components {
+		versions[c.Kind] = c.Version
+	}
+	payloads := map[string]v1alpha1.PayloadVersionStatus{}
+	for kind, kindSums := range sums {
+		payload := v1alpha1.PayloadVersionStatus{Version: versions[kind], SHA256: common.BundleSHA256(kindSums)}
+		for version := range operatorVersions[kind] {
+			payload.OperatorVersions = append(payload.OperatorVersions, version)
+		}
+		sort.Strings(payload.OperatorVersions)
+		payloads[kind] = payload
+	}
+	return payloads, nil
+}
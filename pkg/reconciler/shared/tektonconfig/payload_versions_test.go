@@ -0,0 +1,77 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonconfig
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	operatorfake "github.com/tektoncd/operator/pkg/client/clientset/versioned/fake"
+	"gotest.tools/v3/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func payloadInstallerSet(name, createdBy, releaseVersion string, annotations map[string]string) *v1alpha1.TektonInstallerSet {
+	return &v1alpha1.TektonInstallerSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Labels:      map[string]string{v1alpha1.CreatedByKey: createdBy, v1alpha1.ReleaseVersionKey: releaseVersion},
+			Annotations: annotations,
+		},
+		Spec: v1alpha1.TektonInstallerSetSpec{Manifests: []unstructured.Unstructured{{Object: map[string]interface{}{
+			"apiVersion": "v1", "kind": "ConfigMap", "metadata": map[string]interface{}{"name": name},
+		}}}},
+	}
+}
+
+func TestReconcilePayloadVersions(t *testing.T) {
+	tp := &v1alpha1.TektonPipeline{ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.PipelineResourceName}}
+	tp.Status.SetVersion("v0.59.0")
+	tc := &v1alpha1.TektonConfig{ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.ConfigResourceName}}
+	operatorClient := operatorfake.NewSimpleClientset(tc, tp,
+		payloadInstallerSet("pipeline-main-static", v1alpha1.KindTektonPipeline, "v0.70.0",
+			map[string]string{v1alpha1.ManifestsSHA256Key: "aaaa"}),
+		payloadInstallerSet("pipeline-main-deployment", v1alpha1.KindTektonPipeline, "v0.70.0", nil),
+		payloadInstallerSet("trigger-main-static", v1alpha1.KindTektonTrigger, "v0.69.0", nil),
+		payloadInstallerSet("trigger-main-deployment", v1alpha1.KindTektonTrigger, "v0.70.0", nil),
+		payloadInstallerSet("unlabeled", "", "", nil),
+	)
+	r := &Reconciler{operatorClientSet: operatorClient, operatorVersion: "v0.70.0"}
+
+	assert.NilError(t, r.reconcilePayloadVersions(context.TODO(), tc))
+	assert.Equal(t, tc.Status.Operator.Version, "v0.70.0")
+	assert.Equal(t, len(tc.Status.PayloadVersions), 2)
+
+	pipeline := tc.Status.PayloadVersions[v1alpha1.KindTektonPipeline]
+	assert.Equal(t, pipeline.Version, "v0.59.0")
+	assert.DeepEqual(t, pipeline.OperatorVersions, []string{"v0.70.0"})
+	assert.Assert(t, pipeline.SHA256 != "")
+
+	trigger := tc.Status.PayloadVersions[v1alpha1.KindTektonTrigger]
+	assert.Equal(t, trigger.Version, "")
+	assert.DeepEqual(t, trigger.OperatorVersions, []string{"v0.69.0", "v0.70.0"})
+
+	// the SHA256 recorded by the deterministic rendering is used when there is one
+	withoutAnnotation, err := payloadVersions(context.TODO(), operatorfake.NewSimpleClientset(
+		payloadInstallerSet("pipeline-main-static", v1alpha1.KindTektonPipeline, "v0.70.0", nil),
+		payloadInstallerSet("pipeline-main-deployment", v1alpha1.KindTektonPipeline, "v0.70.0", nil),
+	))
+	assert.NilError(t, err)
+	assert.Assert(t, withoutAnnotation[v1alpha1.KindTektonPipeline].SHA256 != pipeline.SHA256)
+}
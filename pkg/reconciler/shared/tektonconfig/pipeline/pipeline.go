@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"strconv"
 	"strings"
 
 	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
@@ -64,6 +65,11 @@ func GetPipeline(ctx context.Context, clients op.TektonPipelineInterface, name s
 
 func GetTektonPipelineCR(config *v1alpha1.TektonConfig, operatorVersion string) *v1alpha1.TektonPipeline {
 	ownerRef := *metav1.NewControllerRef(config, config.GroupVersionKind())
+	pipeline := config.Spec.Pipeline
+	// the matrix fan out limit of the concurrency section applies unless the pipeline section sets it
+	if c := config.Spec.Concurrency; c != nil && c.MaxMatrixCombinations != nil && pipeline.DefaultMaxMatrixCombinationsCount == "" {
+		pipeline.DefaultMaxMatrixCombinationsCount = strconv.Itoa(*c.MaxMatrixCombinations)
+	}
 	return &v1alpha1.TektonPipeline{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:            v1alpha1.PipelineResourceName,
@@ -76,7 +82,7 @@ func GetTektonPipelineCR(config *v1alpha1.TektonConfig, operatorVersion string)
 			CommonSpec: v1alpha1.CommonSpec{
 				TargetNamespace: config.Spec.TargetNamespace,
 			},
-			Pipeline: config.Spec.Pipeline,
+			Pipeline: pipeline,
 			Config:   config.Spec.Config,
 		},
 	}
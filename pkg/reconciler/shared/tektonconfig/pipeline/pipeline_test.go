@@ -125,3 +125,16 @@ func setDummyVersionLabel(t *testing.T, tp *v1alpha1.TektonPipeline) {
 	labels[v1alpha1.ReleaseVersionKey] = oprVersion
 	tp.SetLabels(labels)
 }
+
+func TestGetTektonPipelineCRMaxMatrixCombinations(t *testing.T) {
+	tc := GetTektonConfig()
+	matrix := 64
+	tc.Spec.Concurrency = &v1alpha1.Concurrency{MaxMatrixCombinations: &matrix}
+	tp := GetTektonPipelineCR(tc, "v0.70.0")
+	util.AssertEqual(t, tp.Spec.Pipeline.DefaultMaxMatrixCombinationsCount, "64")
+
+	// the pipeline section takes precedence
+	tc.Spec.Pipeline.DefaultMaxMatrixCombinationsCount = "32"
+	tp = GetTektonPipelineCR(tc, "v0.70.0")
+	util.AssertEqual(t, tp.Spec.Pipeline.DefaultMaxMatrixCombinationsCount, "32")
+}
@@ -0,0 +1,327 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policies
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/client/clientset/versioned"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/hash"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	"knative.dev/pkg/logging"
+)
+
+const (
+	// BundleInstallerSetType is the type of the installer set of the policy bundle
+	BundleInstallerSetType = "policy-bundle"
+
+	kyvernoGroupVersion    = "kyverno.io/v1"
+	kyvernoPolicyKind      = "ClusterPolicy"
+	gatekeeperTemplateGV   = "templates.gatekeeper.sh/v1"
+	gatekeeperTemplateKind = "ConstraintTemplate"
+	gatekeeperConstraintGV = "constraints.gatekeeper.sh/v1beta1"
+	gatekeeperTarget       = "admission.k8s.gatekeeper.sh"
+)
+
+var bundleInstallerSetLabels = metav1.LabelSelector{
+	MatchLabels: map[string]string{
+		v1alpha1.CreatedByKey:     "TektonConfig",
+		v1alpha1.InstallerSetType: BundleInstallerSetType,
+	},
+}
+
+// policySet is a policy of the bundle validating the steps of the Tasks, written for each engine
+type policySet struct {
+	// name of the Kyverno ClusterPolicy and of the Gatekeeper constraint
+	name string
+	// kind of the Gatekeeper constraint, the ConstraintTemplate is named after it in lower case
+	kind    string
+	message string
+	// step is the Kyverno pattern the steps match
+	step map[string]interface{}
+	// violation is the body of the Gatekeeper rule, with the step variable
+	violation string
+}
+
+var policySets = map[string]policySet{
+	v1alpha1.PolicySetPinnedImages: {
+		name:      "tekton-pinned-images",
+		kind:      "TektonPinnedImages",
+		message:   "the images of the steps must not use the latest tag",
+		step:      map[string]interface{}{"image": "!*:latest"},
+		violation: `endswith(step.image, ":latest")`,
+	},
+	v1alpha1.PolicySetUnprivilegedSteps: {
+		name:      "tekton-unprivileged-steps",
+		kind:      "TektonUnprivilegedSteps",
+		message:   "the steps must not be privileged",
+		step:      map[string]interface{}{"=(securityContext)": map[string]interface{}{"=(privileged)": false}},
+		violation: "step.securityContext.privileged",
+	},
+	v1alpha1.PolicySetStepLimits: {
+		name:      "tekton-step-limits",
+		kind:      "TektonStepLimits",
+		message:   "the steps must have a memory limit",
+		step:      map[string]interface{}{"computeResources": map[string]interface{}{"limits": map[string]interface{}{"memory": "?*"}}},
+		violation: "not step.computeResources.limits.memory",
+	},
+}
+
+// Bundle installs the policy bundle configured in TektonConfig with the policy engine of the
+// cluster, through an installer set
+type Bundle struct {
+	kubeClientSet     kubernetes.Interface
+	operatorClientSet versioned.Interface
+	operatorVersion   string
+}
+
+func NewBundle(kubeClientSet kubernetes.Interface, operatorClientSet versioned.Interface, operatorVersion string) *Bundle {
+	return &Bundle{kubeClientSet: kubeClientSet, operatorClientSet: operatorClientSet, operatorVersion: operatorVersion}
+}
+
+// Reconcile creates or updates the installer set of the policy bundle when it is enabled, and
+// deletes it otherwise. An error is returned when the policy engine is not installed.
+func (b *Bundle) Reconcile(ctx context.Context, tc *v1alpha1.TektonConfig) error {
+	logger := logging.FromContext(ctx)
+
+	labelSelector, err := common.LabelSelector(bundleInstallerSetLabels)
+	if err != nil {
+		return err
+	}
+	installerSets := b.operatorClientSet.OperatorV1alpha1().TektonInstallerSets()
+	existing, err := installerSets.List(ctx, metav1.ListOptions{LabelSelector: labelSelector})
+	if err != nil {
+		return err
+	}
+
+	spec := tc.Spec.Policies
+	if spec == nil || spec.Bundle == nil || !spec.Bundle.Enable {
+		for _, is := range existing.Items {
+			logger.Infof("removing the installer set %s of the policy bundle, it is disabled", is.Name)
+			if err := installerSets.Delete(ctx, is.Name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+				return err
+			}
+		}
+		return nil
+	}
+
+	engine, err := b.engine(spec.Bundle.GetEngine())
+	if err != nil {
+		return err
+	}
+	manifests, err := makeBundle(engine, spec)
+	if err != nil {
+		return err
+	}
+	bundleHash, err := hash.Compute(manifests)
+	if err != nil {
+		return err
+	}
+
+	switch len(existing.Items) {
+	case 0:
+	case 1:
+		is := existing.Items[0].DeepCopy()
+		if is.Annotations[v1alpha1.LastAppliedHashKey] == bundleHash && is.Labels[v1alpha1.ReleaseVersionKey] == b.operatorVersion {
+			return nil
+		}
+		logger.Infof("updating the installer set %s of the %s policy bundle", is.Name, engine)
+		is.Spec.Manifests = manifests
+		if is.Annotations == nil {
+			is.Annotations = map[string]string{}
+		}
+		is.Annotations[v1alpha1.LastAppliedHashKey] = bundleHash
+		is.Labels[v1alpha1.ReleaseVersionKey] = b.operatorVersion
+		_, err = installerSets.Update(ctx, is, metav1.UpdateOptions{})
+		return err
+	default:
+		for _, is := range existing.Items {
+			if err := installerSets.Delete(ctx, is.Name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+				return err
+			}
+		}
+	}
+
+	labels := map[string]string{v1alpha1.ReleaseVersionKey: b.operatorVersion}
+	for k, v := range bundleInstallerSetLabels.MatchLabels {
+		labels[k] = v
+	}
+	logger.Infof("installing the %s policy bundle", engine)
+	_, err = installerSets.Create(ctx, &v1alpha1.TektonInstallerSet{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName:    "tekton-config-policy-bundle-",
+			Labels:          labels,
+			Annotations:     map[string]string{v1alpha1.LastAppliedHashKey: bundleHash},
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(tc, tc.GetGroupVersionKind())},
+		},
+		Spec: v1alpha1.TektonInstallerSetSpec{Manifests: manifests},
+	}, metav1.CreateOptions{})
+	return err
+}
+
+// engine returns the policy engine of the bundle, Gatekeeper is selected before Kyverno when
+// both are installed
+func (b *Bundle) engine(engine string) (string, error) {
+	candidates := []string{engine}
+	if engine == v1alpha1.PolicyEngineAuto {
+		candidates = []string{v1alpha1.PolicyEngineGatekeeper, v1alpha1.PolicyEngineKyverno}
+	}
+	for _, candidate := range candidates {
+		groupVersion, kind := kyvernoGroupVersion, kyvernoPolicyKind
+		if candidate == v1alpha1.PolicyEngineGatekeeper {
+			groupVersion, kind = gatekeeperTemplateGV, gatekeeperTemplateKind
+		}
+		served, err := b.served(groupVersion, kind)
+		if err != nil {
+			return "", err
+		}
+		if served {
+			return candidate, nil
+		}
+	}
+	if engine == v1alpha1.PolicyEngineAuto {
+		return "", fmt.Errorf("the policy bundle is enabled but neither Gatekeeper nor Kyverno is installed")
+	}
+	return "", fmt.Errorf("the policy bundle uses %s but it is not installed", engine)
+}
+
+func (b *Bundle) served(groupVersion, kind string) (bool, error) {
+	resources, err := b.kubeClientSet.Discovery().ServerResourcesForGroupVersion(groupVersion)
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	for _, r := range resources.APIResources {
+		if r.Kind == kind {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// makeBundle returns the resources of the policy sets for the engine
+func makeBundle(engine string, spec *v1alpha1.Policies) ([]unstructured.Unstructured, error) {
+	var namespaceSelector map[string]interface{}
+	if spec.NamespaceSelector != nil {
+		selector, err := runtime.DefaultUnstructuredConverter.ToUnstructured(spec.NamespaceSelector)
+		if err != nil {
+			return nil, err
+		}
+		namespaceSelector = selector
+	}
+	enforce := spec.Bundle.GetMode() == v1alpha1.PolicyBundleModeEnforce
+
+	var resources []unstructured.Unstructured
+	for _, name := range spec.Bundle.GetSets() {
+		set, ok := policySets[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown policy set %q", name)
+		}
+		if engine == v1alpha1.PolicyEngineGatekeeper {
+			resources = append(resources, set.constraintTemplate(), set.constraint(enforce, namespaceSelector))
+			continue
+		}
+		resources = append(resources, set.clusterPolicy(enforce, namespaceSelector))
+	}
+	return resources, nil
+}
+
+func newResource(apiVersion, kind, name string, spec map[string]interface{}) unstructured.Unstructured {
+	u := unstructured.Unstructured{Object: map[string]interface{}{"spec": spec}}
+	u.SetAPIVersion(apiVersion)
+	u.SetKind(kind)
+	u.SetName(name)
+	u.SetLabels(map[string]string{"app.kubernetes.io/part-of": "tekton-pipelines"})
+	return u
+}
+
+// clusterPolicy returns the Kyverno ClusterPolicy validating the steps of the Tasks
+func (s policySet) clusterPolicy(enforce bool, namespaceSelector map[string]interface{}) unstructured.Unstructured {
+	action := "Audit"
+	if enforce {
+		action = "Enforce"
+	}
+	match := map[string]interface{}{"kinds": []interface{}{"tekton.dev/*/Task"}}
+	if namespaceSelector != nil {
+		match["namespaceSelector"] = namespaceSelector
+	}
+	return newResource(kyvernoGroupVersion, kyvernoPolicyKind, s.name, map[string]interface{}{
+		"validationFailureAction": action,
+		"background":              true,
+		"rules": []interface{}{map[string]interface{}{
+			"name":  s.name,
+			"match": map[string]interface{}{"any": []interface{}{map[string]interface{}{"resources": match}}},
+			"validate": map[string]interface{}{
+				"message": s.message,
+				"pattern": map[string]interface{}{
+					"spec": map[string]interface{}{"steps": []interface{}{s.step}},
+				},
+			},
+		}},
+	})
+}
+
+// constraintTemplate returns the Gatekeeper ConstraintTemplate reporting the violating steps of the Tasks
+func (s policySet) constraintTemplate() unstructured.Unstructured {
+	pkg := strings.ToLower(s.kind)
+	rego := fmt.Sprintf(`package %s
+
+violation[{"msg": msg}] {
+  input.review.object.kind == "Task"
+  step := input.review.object.spec.steps[_]
+  %s
+  msg := sprintf("step %%v: %s", [step.name])
+}
+`, pkg, s.violation, s.message)
+	return newResource(gatekeeperTemplateGV, gatekeeperTemplateKind, pkg, map[string]interface{}{
+		"crd": map[string]interface{}{
+			"spec": map[string]interface{}{"names": map[string]interface{}{"kind": s.kind}},
+		},
+		"targets": []interface{}{map[string]interface{}{"target": gatekeeperTarget, "rego": rego}},
+	})
+}
+
+// constraint returns the Gatekeeper constraint of the template
+func (s policySet) constraint(enforce bool, namespaceSelector map[string]interface{}) unstructured.Unstructured {
+	action := "dryrun"
+	if enforce {
+		action = "deny"
+	}
+	match := map[string]interface{}{
+		"kinds": []interface{}{map[string]interface{}{
+			"apiGroups": []interface{}{tektonGroup},
+			"kinds":     []interface{}{"Task"},
+		}},
+	}
+	if namespaceSelector != nil {
+		match["namespaceSelector"] = namespaceSelector
+	}
+	return newResource(gatekeeperConstraintGV, s.kind, s.name, map[string]interface{}{
+		"enforcementAction": action,
+		"match":             match,
+	})
+}
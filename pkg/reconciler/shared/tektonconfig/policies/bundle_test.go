@@ -0,0 +1,131 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policies
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	operatorfake "github.com/tektoncd/operator/pkg/client/clientset/versioned/fake"
+	"gotest.tools/v3/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func serveEngine(kubeClient *fake.Clientset, groupVersion, kind string) {
+	discovery := kubeClient.Discovery().(*fakediscovery.FakeDiscovery)
+	discovery.Resources = append(discovery.Resources, &metav1.APIResourceList{
+		GroupVersion: groupVersion,
+		APIResources: []metav1.APIResource{{Kind: kind}},
+	})
+}
+
+func bundleInstallerSets(t *testing.T, operatorClient *operatorfake.Clientset) []v1alpha1.TektonInstallerSet {
+	t.Helper()
+	list, err := operatorClient.OperatorV1alpha1().TektonInstallerSets().List(context.TODO(), metav1.ListOptions{})
+	assert.NilError(t, err)
+	return list.Items
+}
+
+func TestKyvernoBundle(t *testing.T) {
+	ctx := context.TODO()
+	kubeClient := fake.NewSimpleClientset()
+	operatorClient := operatorfake.NewSimpleClientset()
+	b := NewBundle(kubeClient, operatorClient, "v0.79.0")
+	tc := policiesConfig(&v1alpha1.Policies{Bundle: &v1alpha1.PolicyBundle{Enable: true}})
+
+	// no policy engine installed
+	assert.ErrorContains(t, b.Reconcile(ctx, tc), "neither Gatekeeper nor Kyverno is installed")
+	assert.Equal(t, len(bundleInstallerSets(t, operatorClient)), 0)
+
+	serveEngine(kubeClient, kyvernoGroupVersion, kyvernoPolicyKind)
+	assert.NilError(t, b.Reconcile(ctx, tc))
+	sets := bundleInstallerSets(t, operatorClient)
+	assert.Equal(t, len(sets), 1)
+	assert.Equal(t, sets[0].Labels[v1alpha1.InstallerSetType], BundleInstallerSetType)
+	assert.Equal(t, sets[0].Labels[v1alpha1.ReleaseVersionKey], "v0.79.0")
+	manifests := sets[0].Spec.Manifests
+	assert.Equal(t, len(manifests), len(v1alpha1.PolicySets))
+	for _, m := range manifests {
+		assert.Equal(t, m.GetKind(), kyvernoPolicyKind)
+		action, _, _ := unstructured.NestedString(m.Object, "spec", "validationFailureAction")
+		assert.Equal(t, action, "Audit")
+	}
+
+	// the installer set is updated with the enforced policy sets
+	tc.Spec.Policies.Bundle.Mode = v1alpha1.PolicyBundleModeEnforce
+	tc.Spec.Policies.Bundle.Sets = []string{v1alpha1.PolicySetPinnedImages}
+	assert.NilError(t, b.Reconcile(ctx, tc))
+	sets = bundleInstallerSets(t, operatorClient)
+	assert.Equal(t, len(sets), 1)
+	assert.Equal(t, len(sets[0].Spec.Manifests), 1)
+	policy := sets[0].Spec.Manifests[0]
+	assert.Equal(t, policy.GetName(), "tekton-pinned-images")
+	action, _, _ := unstructured.NestedString(policy.Object, "spec", "validationFailureAction")
+	assert.Equal(t, action, "Enforce")
+
+	// the installer set is removed with the bundle
+	tc.Spec.Policies.Bundle.Enable = false
+	assert.NilError(t, b.Reconcile(ctx, tc))
+	assert.Equal(t, len(bundleInstallerSets(t, operatorClient)), 0)
+}
+
+func TestGatekeeperBundle(t *testing.T) {
+	ctx := context.TODO()
+	kubeClient := fake.NewSimpleClientset()
+	operatorClient := operatorfake.NewSimpleClientset()
+	b := NewBundle(kubeClient, operatorClient, "v0.79.0")
+	tc := policiesConfig(&v1alpha1.Policies{
+		NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "shared"}},
+		Bundle: &v1alpha1.PolicyBundle{Enable: true, Engine: v1alpha1.PolicyEngineGatekeeper,
+			Sets: []string{v1alpha1.PolicySetUnprivilegedSteps}},
+	})
+
+	serveEngine(kubeClient, kyvernoGroupVersion, kyvernoPolicyKind)
+	assert.ErrorContains(t, b.Reconcile(ctx, tc), "the policy bundle uses gatekeeper but it is not installed")
+
+	serveEngine(kubeClient, gatekeeperTemplateGV, gatekeeperTemplateKind)
+	assert.NilError(t, b.Reconcile(ctx, tc))
+	sets := bundleInstallerSets(t, operatorClient)
+	assert.Equal(t, len(sets), 1)
+	manifests := sets[0].Spec.Manifests
+	assert.Equal(t, len(manifests), 2)
+
+	template := manifests[0]
+	assert.Equal(t, template.GetKind(), gatekeeperTemplateKind)
+	assert.Equal(t, template.GetName(), "tektonunprivilegedsteps")
+	kind, _, _ := unstructured.NestedString(template.Object, "spec", "crd", "spec", "names", "kind")
+	assert.Equal(t, kind, "TektonUnprivilegedSteps")
+
+	constraint := manifests[1]
+	assert.Equal(t, constraint.GetAPIVersion(), gatekeeperConstraintGV)
+	assert.Equal(t, constraint.GetKind(), "TektonUnprivilegedSteps")
+	action, _, _ := unstructured.NestedString(constraint.Object, "spec", "enforcementAction")
+	assert.Equal(t, action, "dryrun")
+	selector, _, _ := unstructured.NestedStringMap(constraint.Object, "spec", "match", "namespaceSelector", "matchLabels")
+	assert.DeepEqual(t, selector, map[string]string{"tier": "shared"})
+
+	// an unchanged bundle is not updated
+	operatorClient.ClearActions()
+	assert.NilError(t, b.Reconcile(ctx, tc))
+	for _, action := range operatorClient.Actions() {
+		assert.Equal(t, action.GetVerb(), "list")
+	}
+}
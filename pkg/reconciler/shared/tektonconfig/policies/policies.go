@@ -0,0 +1,259 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policies
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/hash"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"knative.dev/pkg/logging"
+)
+
+const (
+	// ForbidLatestImagesPolicy is the name of the policy rejecting the TaskRuns with latest images
+	ForbidLatestImagesPolicy = "tekton-forbid-latest-images"
+	// RequiredLabelsPolicy is the name of the policy rejecting the PipelineRuns without the required labels
+	RequiredLabelsPolicy = "tekton-required-pipelinerun-labels"
+
+	// policyLabel marks the policies and bindings generated by the operator
+	policyLabel   = "operator.tekton.dev/policy"
+	policyGroup   = "admissionregistration.k8s.io/v1"
+	policyPlural  = "validatingadmissionpolicies"
+	tektonGroup   = "tekton.dev"
+	containersVar = "containers"
+)
+
+// Policies generates the ValidatingAdmissionPolicies configured in TektonConfig and their bindings
+type Policies struct {
+	kubeClientSet kubernetes.Interface
+}
+
+func New(kubeClientSet kubernetes.Interface) *Policies {
+	return &Policies{kubeClientSet: kubeClientSet}
+}
+
+// Reconcile creates or updates the policies and bindings configured, and removes the generated
+// policies and bindings which are no longer configured. An error is returned when policies are
+// configured on a cluster not serving ValidatingAdmissionPolicies.
+func (p *Policies) Reconcile(ctx context.Context, tc *v1alpha1.TektonConfig) error {
+	served, err := p.policiesServed()
+	if err != nil {
+		return err
+	}
+	desired := makePolicies(tc.Spec.Policies)
+	if !served {
+		if len(desired) > 0 {
+			return fmt.Errorf("policies are configured but %s %s are not served by the cluster", policyGroup, policyPlural)
+		}
+		return nil
+	}
+
+	var errs []error
+	ownerRef := *metav1.NewControllerRef(tc, tc.GetGroupVersionKind())
+	names := map[string]bool{}
+	for _, policy := range desired {
+		names[policy.Name] = true
+		if err := p.ensurePolicy(ctx, policy, makeBinding(policy.Name, tc.Spec.Policies), ownerRef); err != nil {
+			errs = append(errs, fmt.Errorf("failed to apply the validating admission policy %s: %w", policy.Name, err))
+		}
+	}
+	return errors.Join(append(errs, p.removeStale(ctx, names))...)
+}
+
+// makePolicies returns the policies configured
+func makePolicies(spec *v1alpha1.Policies) []*admissionregistrationv1.ValidatingAdmissionPolicy {
+	if spec == nil {
+		return nil
+	}
+	var policies []*admissionregistrationv1.ValidatingAdmissionPolicy
+	if spec.ForbidLatestImages {
+		policies = append(policies, makePolicy(ForbidLatestImagesPolicy, "taskruns",
+			[]admissionregistrationv1.Variable{{
+				Name: containersVar,
+				Expression: "(has(object.spec.taskSpec) && has(object.spec.taskSpec.steps) ? object.spec.taskSpec.steps : []) + " +
+					"(has(object.spec.taskSpec) && has(object.spec.taskSpec.sidecars) ? object.spec.taskSpec.sidecars : [])",
+			}},
+			admissionregistrationv1.Validation{
+				Expression: "variables." + containersVar + ".all(c, !has(c.image) || !(c.image.endsWith(':latest') || " +
+					"(!c.image.contains('@') && !c.image.split('/')[c.image.split('/').size() - 1].contains(':'))))",
+				Message: "the images of the steps and sidecars must be pinned to a tag other than latest or to a digest",
+			}))
+	}
+	if len(spec.RequiredPipelineRunLabels) > 0 {
+		quoted := make([]string, 0, len(spec.RequiredPipelineRunLabels))
+		for _, label := range spec.RequiredPipelineRunLabels {
+			quoted = append(quoted, strconv.Quote(label))
+		}
+		policies = append(policies, makePolicy(RequiredLabelsPolicy, "pipelineruns", nil,
+			admissionregistrationv1.Validation{
+				Expression: fmt.Sprintf("[%s].all(l, has(object.metadata.labels) && l in object.metadata.labels)", strings.Join(quoted, ", ")),
+				Message:    "PipelineRuns must have the labels " + strings.Join(spec.RequiredPipelineRunLabels, ", "),
+			}))
+	}
+	return policies
+}
+
+// makePolicy returns a policy validating the creation of a Tekton resource
+func makePolicy(name, resource string, variables []admissionregistrationv1.Variable, validation admissionregistrationv1.Validation) *admissionregistrationv1.ValidatingAdmissionPolicy {
+	failurePolicy := admissionregistrationv1.Fail
+	return &admissionregistrationv1.ValidatingAdmissionPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{policyLabel: "true"}},
+		Spec: admissionregistrationv1.ValidatingAdmissionPolicySpec{
+			FailurePolicy: &failurePolicy,
+			MatchConstraints: &admissionregistrationv1.MatchResources{
+				ResourceRules: []admissionregistrationv1.NamedRuleWithOperations{{
+					RuleWithOperations: admissionregistrationv1.RuleWithOperations{
+						Operations: []admissionregistrationv1.OperationType{admissionregistrationv1.Create},
+						Rule: admissionregistrationv1.Rule{
+							APIGroups:   []string{tektonGroup},
+							APIVersions: []string{"*"},
+							Resources:   []string{resource},
+						},
+					},
+				}},
+			},
+			Variables:   variables,
+			Validations: []admissionregistrationv1.Validation{validation},
+		},
+	}
+}
+
+// makeBinding returns the binding of a policy, named after it
+func makeBinding(policy string, spec *v1alpha1.Policies) *admissionregistrationv1.ValidatingAdmissionPolicyBinding {
+	binding := &admissionregistrationv1.ValidatingAdmissionPolicyBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: policy, Labels: map[string]string{policyLabel: "true"}},
+		Spec: admissionregistrationv1.ValidatingAdmissionPolicyBindingSpec{
+			PolicyName:        policy,
+			ValidationActions: spec.GetValidationActions(),
+		},
+	}
+	if spec.NamespaceSelector != nil {
+		binding.Spec.MatchResources = &admissionregistrationv1.MatchResources{NamespaceSelector: spec.NamespaceSelector}
+	}
+	return binding
+}
+
+// ensurePolicy creates or updates the policy and its binding, they are updated when the hash of
+// their spec changed as the API server defaults some of the fields
+func (p *Policies) ensurePolicy(ctx context.Context, policy *admissionregistrationv1.ValidatingAdmissionPolicy,
+	binding *admissionregistrationv1.ValidatingAdmissionPolicyBinding, ownerRef metav1.OwnerReference) error {
+	logger := logging.FromContext(ctx)
+	policyHash, err := hash.Compute(policy.Spec)
+	if err != nil {
+		return err
+	}
+	policy.Annotations = map[string]string{v1alpha1.LastAppliedHashKey: policyHash}
+	policy.OwnerReferences = []metav1.OwnerReference{ownerRef}
+	policies := p.kubeClientSet.AdmissionregistrationV1().ValidatingAdmissionPolicies()
+	existing, err := policies.Get(ctx, policy.Name, metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		logger.Infof("creating the validating admission policy %s", policy.Name)
+		if _, err := policies.Create(ctx, policy, metav1.CreateOptions{}); err != nil {
+			return err
+		}
+	case err != nil:
+		return err
+	case existing.Annotations[v1alpha1.LastAppliedHashKey] != policyHash:
+		logger.Infof("updating the validating admission policy %s", policy.Name)
+		policy.ResourceVersion = existing.ResourceVersion
+		if _, err := policies.Update(ctx, policy, metav1.UpdateOptions{}); err != nil {
+			return err
+		}
+	}
+
+	bindingHash, err := hash.Compute(binding.Spec)
+	if err != nil {
+		return err
+	}
+	binding.Annotations = map[string]string{v1alpha1.LastAppliedHashKey: bindingHash}
+	binding.OwnerReferences = []metav1.OwnerReference{ownerRef}
+	bindings := p.kubeClientSet.AdmissionregistrationV1().ValidatingAdmissionPolicyBindings()
+	existingBinding, err := bindings.Get(ctx, binding.Name, metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		_, err = bindings.Create(ctx, binding, metav1.CreateOptions{})
+		return err
+	case err != nil:
+		return err
+	case existingBinding.Annotations[v1alpha1.LastAppliedHashKey] != bindingHash:
+		binding.ResourceVersion = existingBinding.ResourceVersion
+		_, err = bindings.Update(ctx, binding, metav1.UpdateOptions{})
+		return err
+	}
+	return nil
+}
+
+// removeStale deletes the generated policies and bindings which are no longer configured
+func (p *Policies) removeStale(ctx context.Context, names map[string]bool) error {
+	logger := logging.FromContext(ctx)
+	var errs []error
+	bindings := p.kubeClientSet.AdmissionregistrationV1().ValidatingAdmissionPolicyBindings()
+	bindingList, err := bindings.List(ctx, metav1.ListOptions{LabelSelector: policyLabel})
+	if err != nil {
+		return err
+	}
+	for _, b := range bindingList.Items {
+		if names[b.Name] {
+			continue
+		}
+		logger.Infof("removing the validating admission policy binding %s, it is no longer configured", b.Name)
+		if err := bindings.Delete(ctx, b.Name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			errs = append(errs, err)
+		}
+	}
+	policies := p.kubeClientSet.AdmissionregistrationV1().ValidatingAdmissionPolicies()
+	policyList, err := policies.List(ctx, metav1.ListOptions{LabelSelector: policyLabel})
+	if err != nil {
+		return errors.Join(append(errs, err)...)
+	}
+	for _, policy := range policyList.Items {
+		if names[policy.Name] {
+			continue
+		}
+		logger.Infof("removing the validating admission policy %s, it is no longer configured", policy.Name)
+		if err := policies.Delete(ctx, policy.Name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (p *Policies) policiesServed() (bool, error) {
+	resources, err := p.kubeClientSet.Discovery().ServerResourcesForGroupVersion(policyGroup)
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	for _, r := range resources.APIResources {
+		if r.Name == policyPlural {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policies
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"gotest.tools/v3/assert"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func servePolicies(kubeClient *fake.Clientset) {
+	kubeClient.Discovery().(*fakediscovery.FakeDiscovery).Resources = []*metav1.APIResourceList{{
+		GroupVersion: policyGroup,
+		APIResources: []metav1.APIResource{{Name: policyPlural}},
+	}}
+}
+
+func policiesConfig(spec *v1alpha1.Policies) *v1alpha1.TektonConfig {
+	return &v1alpha1.TektonConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.ConfigResourceName},
+		Spec:       v1alpha1.TektonConfigSpec{Policies: spec},
+	}
+}
+
+// evaluate evaluates the validation of the policy on the object, with the split function of the
+// Kubernetes CEL strings library
+func evaluate(t *testing.T, policy *admissionregistrationv1.ValidatingAdmissionPolicy, object map[string]interface{}) bool {
+	t.Helper()
+	env, err := cel.NewEnv(
+		cel.Variable("object", cel.DynType),
+		cel.Variable("variables", cel.MapType(cel.StringType, cel.DynType)),
+		cel.Function("split", cel.MemberOverload("string_split_string", []*cel.Type{cel.StringType, cel.StringType},
+			cel.ListType(cel.StringType), cel.BinaryBinding(func(s, sep ref.Val) ref.Val {
+				return types.DefaultTypeAdapter.NativeToValue(strings.Split(string(s.(types.String)), string(sep.(types.String))))
+			}))),
+	)
+	assert.NilError(t, err)
+	eval := func(expression string, variables map[string]interface{}) ref.Val {
+		ast, issues := env.Compile(expression)
+		assert.NilError(t, issues.Err())
+		program, err := env.Program(ast)
+		assert.NilError(t, err)
+		out, _, err := program.Eval(map[string]interface{}{"object": object, "variables": variables})
+		assert.NilError(t, err)
+		return out
+	}
+	variables := map[string]interface{}{}
+	for _, v := range policy.Spec.Variables {
+		variables[v.Name] = eval(v.Expression, variables)
+	}
+	return eval(policy.Spec.Validations[0].Expression, variables) == types.True
+}
+
+func TestForbidLatestImages(t *testing.T) {
+	policies := makePolicies(&v1alpha1.Policies{ForbidLatestImages: true})
+	assert.Equal(t, len(policies), 1)
+	taskRun := func(images ...string) map[string]interface{} {
+		var steps []interface{}
+		for _, image := range images {
+			steps = append(steps, map[string]interface{}{"name": "step", "image": image})
+		}
+		return map[string]interface{}{"spec": map[string]interface{}{"taskSpec": map[string]interface{}{
+			"steps":    steps,
+			"sidecars": []interface{}{map[string]interface{}{"name": "sidecar", "image": "registry:5000/redis:7"}},
+		}}}
+	}
+
+	for image, allowed := range map[string]bool{
+		"alpine:3.20":                true,
+		"registry:5000/alpine:3.20":  true,
+		"alpine@sha256:0123456789ab": true,
+		"alpine:latest":              false,
+		"alpine":                     false,
+		"registry:5000/alpine":       false,
+	} {
+		assert.Equal(t, evaluate(t, policies[0], taskRun("golang:1.23", image)), allowed, "unexpected validation of image %s", image)
+	}
+	// the TaskRuns referencing a Task are allowed
+	assert.Assert(t, evaluate(t, policies[0], map[string]interface{}{"spec": map[string]interface{}{"taskRef": map[string]interface{}{"name": "build"}}}))
+}
+
+func TestRequiredPipelineRunLabels(t *testing.T) {
+	policies := makePolicies(&v1alpha1.Policies{RequiredPipelineRunLabels: []string{"team", "app.kubernetes.io/name"}})
+	assert.Equal(t, len(policies), 1)
+	assert.Equal(t, policies[0].Spec.Validations[0].Message, "PipelineRuns must have the labels team, app.kubernetes.io/name")
+	pipelineRun := func(labels map[string]interface{}) map[string]interface{} {
+		metadata := map[string]interface{}{"name": "run"}
+		if labels != nil {
+			metadata["labels"] = labels
+		}
+		return map[string]interface{}{"metadata": metadata}
+	}
+
+	assert.Assert(t, evaluate(t, policies[0], pipelineRun(map[string]interface{}{"team": "a", "app.kubernetes.io/name": "b"})))
+	assert.Assert(t, !evaluate(t, policies[0], pipelineRun(map[string]interface{}{"team": "a"})))
+	assert.Assert(t, !evaluate(t, policies[0], pipelineRun(nil)))
+}
+
+func TestReconcile(t *testing.T) {
+	ctx := context.TODO()
+	kubeClient := fake.NewSimpleClientset()
+	tc := policiesConfig(&v1alpha1.Policies{
+		ForbidLatestImages:        true,
+		RequiredPipelineRunLabels: []string{"team"},
+		ValidationActions:         []admissionregistrationv1.ValidationAction{admissionregistrationv1.Warn},
+		NamespaceSelector:         &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "shared"}},
+	})
+
+	// ValidatingAdmissionPolicies are not served
+	err := New(kubeClient).Reconcile(ctx, tc)
+	assert.ErrorContains(t, err, "policies are configured but admissionregistration.k8s.io/v1 validatingadmissionpolicies are not served by the cluster")
+	assert.NilError(t, New(kubeClient).Reconcile(ctx, policiesConfig(nil)))
+
+	servePolicies(kubeClient)
+	assert.NilError(t, New(kubeClient).Reconcile(ctx, tc))
+	for _, name := range []string{ForbidLatestImagesPolicy, RequiredLabelsPolicy} {
+		_, err := kubeClient.AdmissionregistrationV1().ValidatingAdmissionPolicies().Get(ctx, name, metav1.GetOptions{})
+		assert.NilError(t, err)
+		binding, err := kubeClient.AdmissionregistrationV1().ValidatingAdmissionPolicyBindings().Get(ctx, name, metav1.GetOptions{})
+		assert.NilError(t, err)
+		assert.Equal(t, binding.Spec.PolicyName, name)
+		assert.DeepEqual(t, binding.Spec.ValidationActions, []admissionregistrationv1.ValidationAction{admissionregistrationv1.Warn})
+		assert.DeepEqual(t, binding.Spec.MatchResources.NamespaceSelector, tc.Spec.Policies.NamespaceSelector)
+	}
+
+	// the required labels are updated, and the policies no longer configured are removed
+	tc.Spec.Policies = &v1alpha1.Policies{RequiredPipelineRunLabels: []string{"team", "cost-center"}}
+	assert.NilError(t, New(kubeClient).Reconcile(ctx, tc))
+	policy, err := kubeClient.AdmissionregistrationV1().ValidatingAdmissionPolicies().Get(ctx, RequiredLabelsPolicy, metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, policy.Spec.Validations[0].Message, "PipelineRuns must have the labels team, cost-center")
+	binding, err := kubeClient.AdmissionregistrationV1().ValidatingAdmissionPolicyBindings().Get(ctx, RequiredLabelsPolicy, metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, binding.Spec.ValidationActions, []admissionregistrationv1.ValidationAction{admissionregistrationv1.Deny})
+	_, err = kubeClient.AdmissionregistrationV1().ValidatingAdmissionPolicies().Get(ctx, ForbidLatestImagesPolicy, metav1.GetOptions{})
+	assert.Assert(t, apierrors.IsNotFound(err))
+	_, err = kubeClient.AdmissionregistrationV1().ValidatingAdmissionPolicyBindings().Get(ctx, ForbidLatestImagesPolicy, metav1.GetOptions{})
+	assert.Assert(t, apierrors.IsNotFound(err))
+}
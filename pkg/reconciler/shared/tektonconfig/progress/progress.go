@@ -0,0 +1,188 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package progress
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	clientset "github.com/tektoncd/operator/pkg/client/clientset/versioned"
+	"github.com/tektoncd/operator/pkg/reconciler/kubernetes/tektoninstallerset"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/logging"
+)
+
+const (
+	// RolloutProgressReason is the reason of the progress events recorded on TektonConfig
+	RolloutProgressReason = "RolloutProgress"
+	// eventInterval is the minimum time between two progress events of a component
+	eventInterval = 30 * time.Second
+)
+
+// versions returns the installed version of the components, by the kind creating their installer sets
+var versions = map[string]func(ctx context.Context, c clientset.Interface) (string, error){
+	"TektonPipeline": func(ctx context.Context, c clientset.Interface) (string, error) {
+		tp, err := c.OperatorV1alpha1().TektonPipelines().Get(ctx, v1alpha1.PipelineResourceName, metav1.GetOptions{})
+		if err != nil {
+			return "", err
+		}
+		return tp.Status.GetVersion(), nil
+	},
+	"TektonTrigger": func(ctx context.Context, c clientset.Interface) (string, error) {
+		tt, err := c.OperatorV1alpha1().TektonTriggers().Get(ctx, v1alpha1.TriggerResourceName, metav1.GetOptions{})
+		if err != nil {
+			return "", err
+		}
+		return tt.Status.GetVersion(), nil
+	},
+	"TektonChain": func(ctx context.Context, c clientset.Interface) (string, error) {
+		tc, err := c.OperatorV1alpha1().TektonChains().Get(ctx, v1alpha1.ChainResourceName, metav1.GetOptions{})
+		if err != nil {
+			return "", err
+		}
+		return tc.Status.GetVersion(), nil
+	},
+	"TektonResult": func(ctx context.Context, c clientset.Interface) (string, error) {
+		tr, err := c.OperatorV1alpha1().TektonResults().Get(ctx, v1alpha1.ResultResourceName, metav1.GetOptions{})
+		if err != nil {
+			return "", err
+		}
+		return tr.Status.GetVersion(), nil
+	},
+}
+
+// report is the last progress event recorded for a component
+type report struct {
+	applied int
+	total   int
+	time    time.Time
+}
+
+// Reporter records in the status of TektonConfig the progress of the install or upgrade of the
+// components, and records events on TektonConfig while the resources of a component are applied
+type Reporter struct {
+	operatorClientSet clientset.Interface
+	now               func() time.Time
+
+	mutex    sync.Mutex
+	reported map[string]report
+}
+
+func New(operatorClientSet clientset.Interface) *Reporter {
+	return &Reporter{operatorClientSet: operatorClientSet, now: time.Now, reported: map[string]report{}}
+}
+
+// Reconcile counts the applied resources of the installer sets by the kind creating them. An event
+// is recorded for a component when its count changed at most once every 30 seconds, and once all
+// its resources are applied. No event is recorded for the components which are already installed.
+func (r *Reporter) Reconcile(ctx context.Context, tc *v1alpha1.TektonConfig) {
+	logger := logging.FromContext(ctx)
+	sets, err := r.operatorClientSet.OperatorV1alpha1().TektonInstallerSets().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		logger.Debugf("failed to list the installer sets to report the rollout progress: %v", err)
+		return
+	}
+	progress := &v1alpha1.RolloutProgressStatus{Components: map[string]v1alpha1.ComponentRolloutProgress{}}
+	for i := range sets.Items {
+		kind := sets.Items[i].GetLabels()[v1alpha1.CreatedByKey]
+		if kind == "" {
+			continue
+		}
+		applied, total, err := tektoninstallerset.Progress(ctx, &sets.Items[i])
+		if err != nil {
+			logger.Debugf("failed to read the manifests of installer set %s to report the rollout progress: %v", sets.Items[i].Name, err)
+			continue
+		}
+		c := progress.Components[kind]
+		c.Applied += applied
+		c.Total += total
+		progress.Components[kind] = c
+		progress.AppliedResources += applied
+		progress.TotalResources += total
+	}
+	if progress.TotalResources == 0 {
+		tc.Status.Progress = nil
+		return
+	}
+	progress.Percentage = progress.AppliedResources * 100 / progress.TotalResources
+
+	kinds := make([]string, 0, len(progress.Components))
+	for kind := range progress.Components {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+	for _, kind := range kinds {
+		c := progress.Components[kind]
+		if get, ok := versions[kind]; ok {
+			version, err := get(ctx, r.operatorClientSet)
+			if err != nil && !apierrors.IsNotFound(err) {
+				logger.Debugf("failed to get the version of %s to report the rollout progress: %v", kind, err)
+			}
+			c.Version = version
+			progress.Components[kind] = c
+		}
+		r.recordEvent(ctx, tc, kind, c)
+	}
+	r.forget(progress.Components)
+	tc.Status.Progress = progress
+}
+
+func (r *Reporter) recordEvent(ctx context.Context, tc *v1alpha1.TektonConfig, kind string, c v1alpha1.ComponentRolloutProgress) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	last, reported := r.reported[kind]
+	done := c.Applied == c.Total
+	switch {
+	case done && (!reported || last.applied == last.total):
+		// the component was installed before, or its completion is already reported
+		return
+	case reported && last.applied == c.Applied && last.total == c.Total:
+		return
+	case !done && reported && r.now().Sub(last.time) < eventInterval:
+		return
+	}
+
+	recorder := controller.GetEventRecorder(ctx)
+	if recorder == nil {
+		logging.FromContext(ctx).Debug("no event recorder in the context to report the rollout progress")
+		return
+	}
+	name := kind
+	if c.Version != "" {
+		name = fmt.Sprintf("%s %s", kind, c.Version)
+	}
+	recorder.Eventf(tc, corev1.EventTypeNormal, RolloutProgressReason, "applied %d/%d resources of %s", c.Applied, c.Total, name)
+	r.reported[kind] = report{applied: c.Applied, total: c.Total, time: r.now()}
+}
+
+// forget drops the reports of the components which no longer have installer sets
+func (r *Reporter) forget(components map[string]v1alpha1.ComponentRolloutProgress) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	for kind := range r.reported {
+		if _, ok := components[kind]; !ok {
+			delete(r.reported, kind)
+		}
+	}
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package progress
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mf "github.com/manifestival/manifestival"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/client/clientset/versioned/fake"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/tools/record"
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/controller"
+)
+
+func resource(kind, name string) unstructured.Unstructured {
+	u := unstructured.Unstructured{}
+	u.SetAPIVersion("v1")
+	u.SetKind(kind)
+	u.SetName(name)
+	return u
+}
+
+func installerSet(name, createdBy string, conditions ...apis.ConditionType) *v1alpha1.TektonInstallerSet {
+	set := &v1alpha1.TektonInstallerSet{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{v1alpha1.CreatedByKey: createdBy}},
+		Spec: v1alpha1.TektonInstallerSetSpec{Manifests: mf.Slice{
+			resource("CustomResourceDefinition", "tasks.tekton.dev"),
+			resource("ClusterRole", "tekton-pipelines-controller"),
+			resource("ConfigMap", "config-defaults"),
+			resource("Deployment", "tekton-pipelines-controller"),
+		}},
+	}
+	for _, c := range conditions {
+		set.Status.Conditions = append(set.Status.Conditions, apis.Condition{Type: c, Status: corev1.ConditionTrue})
+	}
+	return set
+}
+
+func updateSet(t *testing.T, client *fake.Clientset, set *v1alpha1.TektonInstallerSet) {
+	t.Helper()
+	_, err := client.OperatorV1alpha1().TektonInstallerSets().Update(context.TODO(), set, metav1.UpdateOptions{})
+	assert.NilError(t, err)
+}
+
+func events(recorder *record.FakeRecorder) []string {
+	var got []string
+	for {
+		select {
+		case e := <-recorder.Events:
+			got = append(got, e)
+		default:
+			return got
+		}
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	client := fake.NewSimpleClientset(
+		&v1alpha1.TektonPipeline{
+			ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.PipelineResourceName},
+			Status:     v1alpha1.TektonPipelineStatus{Version: "v0.65.0"},
+		},
+		installerSet("pipeline-main", "TektonPipeline", v1alpha1.CrdInstalled),
+		installerSet("trigger-main", "TektonTrigger"),
+		// installer sets not created by a component are not counted
+		&v1alpha1.TektonInstallerSet{ObjectMeta: metav1.ObjectMeta{Name: "custom"}},
+	)
+	recorder := record.NewFakeRecorder(10)
+	ctx := controller.WithEventRecorder(context.TODO(), recorder)
+	now := time.Now()
+	r := New(client)
+	r.now = func() time.Time { return now }
+	tc := &v1alpha1.TektonConfig{ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.ConfigResourceName}}
+
+	r.Reconcile(ctx, tc)
+	assert.DeepEqual(t, tc.Status.Progress, &v1alpha1.RolloutProgressStatus{
+		Percentage:       12,
+		AppliedResources: 1,
+		TotalResources:   8,
+		Components: map[string]v1alpha1.ComponentRolloutProgress{
+			"TektonPipeline": {Version: "v0.65.0", Applied: 1, Total: 4},
+			"TektonTrigger":  {Applied: 0, Total: 4},
+		},
+	})
+	assert.DeepEqual(t, events(recorder), []string{
+		"Normal RolloutProgress applied 1/4 resources of TektonPipeline v0.65.0",
+		"Normal RolloutProgress applied 0/4 resources of TektonTrigger",
+	})
+
+	// the events of a component are recorded at most every 30 seconds
+	updateSet(t, client, installerSet("pipeline-main", "TektonPipeline", v1alpha1.CrdInstalled, v1alpha1.ClustersScoped))
+	r.Reconcile(ctx, tc)
+	assert.Equal(t, tc.Status.Progress.Components["TektonPipeline"].Applied, 2)
+	assert.Equal(t, len(events(recorder)), 0)
+	now = now.Add(eventInterval)
+	r.Reconcile(ctx, tc)
+	assert.DeepEqual(t, events(recorder), []string{"Normal RolloutProgress applied 2/4 resources of TektonPipeline v0.65.0"})
+
+	// the completion is recorded right away, once
+	updateSet(t, client, installerSet("pipeline-main", "TektonPipeline", apis.ConditionReady))
+	r.Reconcile(ctx, tc)
+	assert.DeepEqual(t, events(recorder), []string{"Normal RolloutProgress applied 4/4 resources of TektonPipeline v0.65.0"})
+	now = now.Add(eventInterval)
+	r.Reconcile(ctx, tc)
+	assert.Equal(t, len(events(recorder)), 0)
+	assert.Equal(t, tc.Status.Progress.Percentage, 50)
+}
+
+func TestReconcileInstalled(t *testing.T) {
+	client := fake.NewSimpleClientset(installerSet("pipeline-main", "TektonPipeline", apis.ConditionReady))
+	recorder := record.NewFakeRecorder(10)
+	tc := &v1alpha1.TektonConfig{ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.ConfigResourceName}}
+
+	New(client).Reconcile(controller.WithEventRecorder(context.TODO(), recorder), tc)
+	assert.Equal(t, tc.Status.Progress.Percentage, 100)
+	assert.Equal(t, len(events(recorder)), 0)
+
+	// no progress is reported without installer sets
+	assert.NilError(t, client.OperatorV1alpha1().TektonInstallerSets().Delete(context.TODO(), "pipeline-main", metav1.DeleteOptions{}))
+	New(client).Reconcile(context.TODO(), tc)
+	assert.Assert(t, tc.Status.Progress == nil)
+}
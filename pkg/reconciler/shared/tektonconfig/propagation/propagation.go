@@ -0,0 +1,216 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package propagation
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	clientset "github.com/tektoncd/operator/pkg/client/clientset/versioned"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/logging"
+	"knative.dev/pkg/metrics"
+)
+
+const (
+	// StageUpdated is the propagation of a change to the spec of a component
+	StageUpdated = "updated"
+	// StageReady is the propagation of a change until the component is ready with it
+	StageReady = "ready"
+)
+
+var (
+	propagationLatency = stats.Float64("config_propagation_latency",
+		"time from the observation of a TektonConfig spec change until a component is updated or ready with it",
+		stats.UnitMilliseconds)
+
+	componentTagKey = tag.MustNewKey("component")
+	stageTagKey     = tag.MustNewKey("stage")
+
+	registerViews = sync.OnceValue(func() error {
+		return view.Register(&view.View{
+			Description: propagationLatency.Description(),
+			Measure:     propagationLatency,
+			Aggregation: view.Distribution(100, 500, 1000, 5000, 10000, 30000, 60000, 120000, 300000, 600000, 1800000),
+			TagKeys:     []tag.Key{componentTagKey, stageTagKey},
+		})
+	})
+)
+
+// state is the state of a component relevant to the propagation
+type state struct {
+	generation int64
+	// observedGeneration is zero for the components which do not report it
+	observedGeneration int64
+	created            metav1.Time
+	status             v1alpha1.TektonComponentStatus
+}
+
+type component struct {
+	kind string
+	name string
+	get  func(ctx context.Context, client clientset.Interface, name string) (*state, error)
+}
+
+// components are the components configured from TektonConfig, whose changes trigger a reconcile of TektonConfig
+var components = []component{
+	{kind: "TektonPipeline", name: v1alpha1.PipelineResourceName, get: func(ctx context.Context, c clientset.Interface, name string) (*state, error) {
+		tp, err := c.OperatorV1alpha1().TektonPipelines().Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return &state{generation: tp.Generation, observedGeneration: tp.Status.ObservedGeneration, created: tp.CreationTimestamp, status: &tp.Status}, nil
+	}},
+	{kind: "TektonTrigger", name: v1alpha1.TriggerResourceName, get: func(ctx context.Context, c clientset.Interface, name string) (*state, error) {
+		tt, err := c.OperatorV1alpha1().TektonTriggers().Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return &state{generation: tt.Generation, observedGeneration: tt.Status.ObservedGeneration, created: tt.CreationTimestamp, status: &tt.Status}, nil
+	}},
+	{kind: "TektonChain", name: v1alpha1.ChainResourceName, get: func(ctx context.Context, c clientset.Interface, name string) (*state, error) {
+		tc, err := c.OperatorV1alpha1().TektonChains().Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return &state{generation: tc.Generation, observedGeneration: tc.Status.ObservedGeneration, created: tc.CreationTimestamp, status: &tc.Status}, nil
+	}},
+	{kind: "TektonResult", name: v1alpha1.ResultResourceName, get: func(ctx context.Context, c clientset.Interface, name string) (*state, error) {
+		tr, err := c.OperatorV1alpha1().TektonResults().Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return &state{generation: tr.Generation, observedGeneration: tr.Status.ObservedGeneration, created: tr.CreationTimestamp, status: &tr.Status}, nil
+	}},
+}
+
+// Tracker records in the status of TektonConfig the propagation of its spec changes to the
+// components, and exports the latency of the propagation
+type Tracker struct {
+	operatorClientSet clientset.Interface
+	now               func() time.Time
+}
+
+func New(operatorClientSet clientset.Interface) *Tracker {
+	return &Tracker{operatorClientSet: operatorClientSet, now: time.Now}
+}
+
+// Observe starts tracking a change of the spec of TektonConfig, it records the generations of the
+// installed components before the change is propagated to them. The last propagation duration of
+// the components is kept.
+func (t *Tracker) Observe(ctx context.Context, tc *v1alpha1.TektonConfig) {
+	previous := tc.Status.Propagation
+	if previous != nil && previous.Generation == tc.Generation {
+		return
+	}
+	propagation := &v1alpha1.ConfigPropagationStatus{
+		Generation:   tc.Generation,
+		ObservedTime: metav1.NewTime(t.now()),
+		Components:   map[string]v1alpha1.ComponentPropagationStatus{},
+	}
+	for _, c := range components {
+		s, err := c.get(ctx, t.operatorClientSet, c.name)
+		if err != nil {
+			if !apierrors.IsNotFound(err) {
+				logging.FromContext(ctx).Debugf("failed to get %s %s to track the propagation: %v", c.kind, c.name, err)
+			}
+			continue
+		}
+		status := v1alpha1.ComponentPropagationStatus{BaseGeneration: s.generation}
+		if previous != nil {
+			status.LastPropagationDuration = previous.Components[c.kind].LastPropagationDuration
+		}
+		propagation.Components[c.kind] = status
+	}
+	tc.Status.Propagation = propagation
+}
+
+// Record records when the components were updated with the observed change and when they became
+// ready with it. A component is ready with the change when it reports the observed generation
+// of its spec, or for the components which do not report it, when it became ready after the update.
+func (t *Tracker) Record(ctx context.Context, tc *v1alpha1.TektonConfig) {
+	propagation := tc.Status.Propagation
+	if propagation == nil {
+		return
+	}
+	logger := logging.FromContext(ctx)
+	for _, c := range components {
+		s, err := c.get(ctx, t.operatorClientSet, c.name)
+		if err != nil {
+			if !apierrors.IsNotFound(err) {
+				logger.Debugf("failed to get %s %s to track the propagation: %v", c.kind, c.name, err)
+			}
+			continue
+		}
+		status, ok := propagation.Components[c.kind]
+		if !ok {
+			// components created by the change are tracked from their creation
+			if s.created.Before(&metav1.Time{Time: propagation.ObservedTime.Truncate(time.Second)}) {
+				continue
+			}
+		}
+		if s.generation <= status.BaseGeneration || status.ReadyTime != nil {
+			propagation.Components[c.kind] = status
+			continue
+		}
+
+		now := metav1.NewTime(t.now())
+		if status.UpdatedTime == nil {
+			status.UpdatedTime = &now
+			t.record(ctx, c.kind, StageUpdated, now.Sub(propagation.ObservedTime.Time))
+		}
+		if readyWithChange(s, status.UpdatedTime.Time) {
+			duration := now.Sub(propagation.ObservedTime.Time)
+			status.ReadyTime = &now
+			status.LastPropagationDuration = &metav1.Duration{Duration: duration}
+			logger.Infof("the change of generation %d was propagated to %s in %s", propagation.Generation, c.kind, duration)
+			t.record(ctx, c.kind, StageReady, duration)
+		}
+		propagation.Components[c.kind] = status
+	}
+}
+
+func readyWithChange(s *state, updated time.Time) bool {
+	if !s.status.IsReady() {
+		return false
+	}
+	if s.observedGeneration != 0 {
+		return s.observedGeneration == s.generation
+	}
+	cond := s.status.GetCondition(apis.ConditionReady)
+	return cond != nil && !cond.LastTransitionTime.Inner.Time.Before(updated.Truncate(time.Second))
+}
+
+func (t *Tracker) record(ctx context.Context, kind, stage string, latency time.Duration) {
+	if err := registerViews(); err != nil {
+		logging.FromContext(ctx).Debugf("failed to register the propagation metrics: %v", err)
+		return
+	}
+	metricsCtx, err := tag.New(context.Background(), tag.Insert(componentTagKey, kind), tag.Insert(stageTagKey, stage))
+	if err != nil {
+		logging.FromContext(ctx).Debugf("failed to record the propagation metrics: %v", err)
+		return
+	}
+	metrics.Record(metricsCtx, propagationLatency.M(float64(latency.Milliseconds())))
+}
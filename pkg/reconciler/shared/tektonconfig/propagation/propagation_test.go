@@ -0,0 +1,105 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package propagation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/client/clientset/versioned/fake"
+	"gotest.tools/v3/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/metrics/metricstest"
+	_ "knative.dev/pkg/metrics/testing"
+)
+
+func markReady(status *v1alpha1.TektonPipelineStatus, at time.Time) {
+	status.SetConditions(apis.Conditions{{
+		Type:               apis.ConditionReady,
+		Status:             "True",
+		LastTransitionTime: apis.VolatileTime{Inner: metav1.NewTime(at)},
+	}})
+}
+
+func TestPropagation(t *testing.T) {
+	ctx := context.TODO()
+	start := time.Date(2026, 10, 14, 10, 0, 0, 0, time.UTC)
+	now := start
+	tp := &v1alpha1.TektonPipeline{ObjectMeta: metav1.ObjectMeta{
+		Name: v1alpha1.PipelineResourceName, Generation: 1, CreationTimestamp: metav1.NewTime(start.Add(-time.Hour))}}
+	markReady(&tp.Status, start.Add(-time.Hour))
+	client := fake.NewSimpleClientset(tp)
+	tracker := New(client)
+	tracker.now = func() time.Time { return now }
+
+	tc := &v1alpha1.TektonConfig{ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.ConfigResourceName, Generation: 2}}
+	tracker.Observe(ctx, tc)
+	assert.Equal(t, tc.Status.Propagation.Generation, int64(2))
+	assert.DeepEqual(t, tc.Status.Propagation.Components, map[string]v1alpha1.ComponentPropagationStatus{
+		"TektonPipeline": {BaseGeneration: 1},
+	})
+	// the component is not changed yet
+	tracker.Record(ctx, tc)
+	assert.Assert(t, tc.Status.Propagation.Components["TektonPipeline"].UpdatedTime == nil)
+
+	// the spec of the component is updated, its deployments are rolled out
+	now = start.Add(2 * time.Second)
+	tp.Generation = 2
+	tp.Status.MarkNotReady("rolling out")
+	_, err := client.OperatorV1alpha1().TektonPipelines().Update(ctx, tp, metav1.UpdateOptions{})
+	assert.NilError(t, err)
+	tracker.Record(ctx, tc)
+	status := tc.Status.Propagation.Components["TektonPipeline"]
+	assert.Equal(t, status.UpdatedTime.Time, now)
+	assert.Assert(t, status.ReadyTime == nil)
+
+	// a component created by the change reports its observed generation
+	now = start.Add(30 * time.Second)
+	markReady(&tp.Status, now)
+	_, err = client.OperatorV1alpha1().TektonPipelines().Update(ctx, tp, metav1.UpdateOptions{})
+	assert.NilError(t, err)
+	tt := &v1alpha1.TektonTrigger{ObjectMeta: metav1.ObjectMeta{
+		Name: v1alpha1.TriggerResourceName, Generation: 1, CreationTimestamp: metav1.NewTime(start.Add(time.Second))}}
+	tt.Status.ObservedGeneration = 1
+	tt.Status.SetConditions(apis.Conditions{{Type: apis.ConditionReady, Status: "True"}})
+	_, err = client.OperatorV1alpha1().TektonTriggers().Create(ctx, tt, metav1.CreateOptions{})
+	assert.NilError(t, err)
+	tracker.Record(ctx, tc)
+	for _, kind := range []string{"TektonPipeline", "TektonTrigger"} {
+		status = tc.Status.Propagation.Components[kind]
+		assert.Equal(t, status.ReadyTime.Time, now, kind)
+		assert.Equal(t, status.LastPropagationDuration.Duration, 30*time.Second, kind)
+	}
+	metricstest.CheckStatsReported(t, "config_propagation_latency")
+
+	// the times are not recorded again
+	now = start.Add(time.Minute)
+	tracker.Record(ctx, tc)
+	assert.Equal(t, tc.Status.Propagation.Components["TektonPipeline"].ReadyTime.Time, start.Add(30*time.Second))
+
+	// a new change keeps the last propagation duration
+	tc.Generation = 3
+	tracker.Observe(ctx, tc)
+	assert.Equal(t, tc.Status.Propagation.ObservedTime.Time, now)
+	assert.DeepEqual(t, tc.Status.Propagation.Components["TektonPipeline"], v1alpha1.ComponentPropagationStatus{
+		BaseGeneration:          2,
+		LastPropagationDuration: &metav1.Duration{Duration: 30 * time.Second},
+	})
+}
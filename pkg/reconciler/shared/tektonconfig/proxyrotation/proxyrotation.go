@@ -0,0 +1,169 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxyrotation
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	clientset "github.com/tektoncd/operator/pkg/client/clientset/versioned"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/logging"
+)
+
+const (
+	// ProxyChangedReason is the reason of the event recorded on TektonConfig when the proxy changed
+	ProxyChangedReason = "ProxyChanged"
+	// defaultPodTemplateConfig is the config updated with the proxy of the operator
+	defaultPodTemplateConfig = "default-pod-template of config-defaults"
+)
+
+// Tracker records in the status of TektonConfig the proxy of the operator injected in the
+// components, the proxy changes when the operator is restarted with other proxy environment
+// variables, e.g. after a change of the cluster Proxy on OpenShift
+type Tracker struct {
+	operatorClientSet clientset.Interface
+	now               func() time.Time
+}
+
+func New(operatorClientSet clientset.Interface) *Tracker {
+	return &Tracker{operatorClientSet: operatorClientSet, now: time.Now}
+}
+
+// Reconcile compares the proxy of the operator with the proxy recorded in the status. When it
+// changed, the configs updated and the components whose deployments are restarted by the change
+// are recorded along with the changed variables, and an event summarizing the change is recorded
+// on TektonConfig. The first proxy observed is recorded without event.
+func (t *Tracker) Reconcile(ctx context.Context, tc *v1alpha1.TektonConfig) {
+	env := map[string]string{}
+	for _, e := range common.ProxyEnv() {
+		env[e.Name] = e.Value
+	}
+	previous := tc.Status.Proxy
+	if previous == nil {
+		if len(env) > 0 {
+			tc.Status.Proxy = &v1alpha1.ProxyStatus{Env: env}
+		}
+		return
+	}
+	changed := changedVariables(previous.Env, env)
+	if len(changed) == 0 {
+		return
+	}
+
+	logger := logging.FromContext(ctx)
+	// the deployments of the components are not restarted when the control plane proxy is disabled
+	var components []string
+	if common.ControlPlaneProxyEnabled(tc.Spec.Config) {
+		var err error
+		if components, err = t.deploymentComponents(ctx); err != nil {
+			logger.Errorf("failed to list the components restarted by the change of the proxy: %v", err)
+		}
+	}
+	now := metav1.NewTime(t.now())
+	tc.Status.Proxy = &v1alpha1.ProxyStatus{
+		Env:                 env,
+		LastChangeTime:      &now,
+		Changed:             changed,
+		RestartedComponents: components,
+	}
+	if len(env) == 0 {
+		tc.Status.Proxy.Env = nil
+	}
+	if tc.Spec.Config.ProxyInDefaultPodTemplate && common.WorkloadProxyEnabled(tc.Spec.Config) {
+		tc.Status.Proxy.UpdatedConfigs = []string{defaultPodTemplateConfig}
+	}
+
+	summary := []string{}
+	if len(tc.Status.Proxy.UpdatedConfigs) > 0 {
+		summary = append(summary, fmt.Sprintf("updated the %s", strings.Join(tc.Status.Proxy.UpdatedConfigs, ", ")))
+	}
+	if len(components) > 0 {
+		summary = append(summary, fmt.Sprintf("restarting the deployments of %s", strings.Join(components, ", ")))
+	}
+	message := fmt.Sprintf("proxy changed (%s)", strings.Join(changed, ", "))
+	if len(summary) > 0 {
+		message += ": " + strings.Join(summary, ", ")
+	}
+	logger.Info(message)
+	recorder := controller.GetEventRecorder(ctx)
+	if recorder == nil {
+		logger.Debug("no event recorder in the context to report the change of the proxy")
+		return
+	}
+	recorder.Event(tc, corev1.EventTypeNormal, ProxyChangedReason, message)
+}
+
+// changedVariables returns the names of the variables whose value changed, in the order of their names
+func changedVariables(previous, current map[string]string) []string {
+	names := map[string]bool{}
+	for name, value := range previous {
+		if current[name] != value {
+			names[name] = true
+		}
+	}
+	for name, value := range current {
+		if previous[name] != value {
+			names[name] = true
+		}
+	}
+	changed := make([]string, 0, len(names))
+	for name := range names {
+		changed = append(changed, name)
+	}
+	sort.Strings(changed)
+	return changed
+}
+
+// deploymentComponents returns the kinds creating installer sets with deployments, the proxy is
+// injected in the deployments so they are restarted when it changes
+func (t *Tracker) deploymentComponents(ctx context.Context) ([]string, error) {
+	sets, err := t.operatorClientSet.OperatorV1alpha1().TektonInstallerSets().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	kinds := map[string]bool{}
+	for _, set := range sets.Items {
+		kind := set.GetLabels()[v1alpha1.CreatedByKey]
+		if kind == "" || kinds[kind] {
+			continue
+		}
+		manifests, err := common.InstallerSetManifests(ctx, &set)
+		if err != nil {
+			return nil, err
+		}
+		for _, u := range manifests {
+			if u.GetKind() == "Deployment" {
+				kinds[kind] = true
+				break
+			}
+		}
+	}
+	components := make([]string, 0, len(kinds))
+	for kind := range kinds {
+		components = append(components, kind)
+	}
+	sort.Strings(components)
+	return components, nil
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonconfig
+
+import (
+	"context"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// reconcileRenderedManifests records the SHA256 of the deterministically rendered manifests of
+// the installer sets into the status, the status is cleared when the manifests are not rendered
+// deterministically
+func (r *Reconciler) reconcileRenderedManifests(ctx context.Context, tc *v1alpha1.TektonConfig) error {
+	if !common.DeterministicRender() {
+		tc.Status.RenderedManifests = nil
+		return nil
+	}
+	sets, err := r.operatorClientSet.OperatorV1alpha1().TektonInstallerSets().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	installerSets := map[string]string{}
+	sums := []string{}
+	for _, set := range sets.Items {
+		sum, ok := set.GetAnnotations()[v1alpha1.ManifestsSHA256Key]
+		if !ok || set.GetDeletionTimestamp() != nil {
+			continue
+		}
+		installerSets[set.GetName()] = sum
+		sums = append(sums, sum)
+	}
+	tc.Status.RenderedManifests = &v1alpha1.RenderedManifestsStatus{
+		SHA256:        common.BundleSHA256(sums),
+		InstallerSets: installerSets,
+	}
+	return nil
+}
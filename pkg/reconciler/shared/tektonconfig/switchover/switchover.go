@@ -0,0 +1,342 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package switchover
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/client/clientset/versioned"
+	"github.com/tektoncd/operator/pkg/reconciler/kubernetes/tektoninstallerset/client"
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes"
+	"knative.dev/pkg/logging"
+)
+
+// Switchover stages the payload of the next operator version alongside the current one, and
+// switches the admission webhooks to it once it is verified and approved. The components stage
+// their payload and render their webhook configurations, the switchover only drives them through
+// the annotations of their installer sets.
+type Switchover struct {
+	kubeClientSet     kubernetes.Interface
+	operatorClientSet versioned.Interface
+	operatorVersion   string
+}
+
+func New(operatorVersion string, kubeClientSet kubernetes.Interface, operatorClientSet versioned.Interface) *Switchover {
+	return &Switchover{
+		kubeClientSet:     kubeClientSet,
+		operatorClientSet: operatorClientSet,
+		operatorVersion:   operatorVersion,
+	}
+}
+
+// StagingNamespace returns the namespace in which the payload of the group is installed,
+// each group gets its own namespace so that both can be installed at the same time
+func StagingNamespace(spec *v1alpha1.PayloadSwitchover, group string) string {
+	return fmt.Sprintf("%s-%s", spec.StagingNamespace, group)
+}
+
+// Reconcile moves the switchover through its phases, the status of the TektonConfig is
+// updated in place. Waiting for the components is recorded in the status, errors are only
+// returned when the cluster cannot be read or updated.
+func (s *Switchover) Reconcile(ctx context.Context, tc *v1alpha1.TektonConfig) error {
+	spec := tc.Spec.PayloadSwitchover
+	if spec == nil || !spec.Enable {
+		return s.rollback(ctx, tc)
+	}
+
+	status := tc.Status.PayloadSwitchover
+	if status == nil {
+		status = &v1alpha1.PayloadSwitchoverStatus{ActiveNamespace: tc.Spec.GetTargetNamespace()}
+		tc.Status.PayloadSwitchover = status
+	}
+
+	// start a new cycle for every operator version
+	if status.StagedVersion != s.operatorVersion {
+		status.StagedGroup = v1alpha1.OtherPayloadGroup(status.ActiveGroup)
+		status.StagedVersion = s.operatorVersion
+		status.Phase = v1alpha1.SwitchoverPhaseStaging
+		status.Message = ""
+	}
+
+	mainSets, err := s.listSets(ctx, client.InstallerTypeMain)
+	if err != nil {
+		return err
+	}
+	payloadSets, err := s.listSets(ctx, client.InstallerTypePayload)
+	if err != nil {
+		return err
+	}
+	if err := s.advance(ctx, tc, status, mainSets, payloadSets); err != nil {
+		return err
+	}
+
+	// hold the components of the operator version, the next version is staged next to them
+	// instead of upgrading them in place
+	nextGroup := v1alpha1.OtherPayloadGroup(status.ActiveGroup)
+	nextNamespace := StagingNamespace(spec, nextGroup)
+	if err := s.ensureNamespace(ctx, nextNamespace); err != nil {
+		return err
+	}
+	return s.hold(ctx, mainSets, nextGroup, nextNamespace)
+}
+
+// advance moves the switchover of the operator version through its phases
+func (s *Switchover) advance(ctx context.Context, tc *v1alpha1.TektonConfig, status *v1alpha1.PayloadSwitchoverStatus, mainSets, payloadSets []v1alpha1.TektonInstallerSet) error {
+	logger := logging.FromContext(ctx).Named("switchover")
+	spec := tc.Spec.PayloadSwitchover
+
+	stagingNamespace := StagingNamespace(spec, status.StagedGroup)
+	switch status.Phase {
+	case v1alpha1.SwitchoverPhaseStaging:
+		staged, pending := s.staged(mainSets, payloadSets, status.StagedGroup)
+		if len(staged) == 0 && len(pending) == 0 {
+			// all the components were upgraded in place and serve their webhooks again
+			if err := s.deletePayloads(ctx, payloadSets, func(*v1alpha1.TektonInstallerSet) bool { return true }); err != nil {
+				return err
+			}
+			status.ActiveGroup = ""
+			status.ActiveNamespace = tc.Spec.GetTargetNamespace()
+			status.Phase = v1alpha1.SwitchoverPhaseCompleted
+			status.Message = ""
+			return nil
+		}
+		if len(pending) != 0 {
+			status.Message = fmt.Sprintf("waiting for %s to stage payload %s in namespace %s", strings.Join(pending, ", "), status.StagedGroup, stagingNamespace)
+			return nil
+		}
+		logger.Infow("staged payload verified", "group", status.StagedGroup, "namespace", stagingNamespace)
+		status.Phase = v1alpha1.SwitchoverPhaseVerified
+		fallthrough
+
+	case v1alpha1.SwitchoverPhaseVerified:
+		if !spec.Switch {
+			status.Message = "staged payload is verified, set spec.payloadSwitchover.switch to switch over"
+			return nil
+		}
+		logger.Infow("switching webhooks to staged payload", "from", status.ActiveNamespace, "to", stagingNamespace)
+		status.ActiveGroup = status.StagedGroup
+		status.ActiveNamespace = stagingNamespace
+		status.Phase = v1alpha1.SwitchoverPhaseSwitched
+		status.Message = ""
+		fallthrough
+
+	case v1alpha1.SwitchoverPhaseSwitched, v1alpha1.SwitchoverPhaseCompleted:
+		if status.ActiveGroup == "" {
+			return nil
+		}
+		// the components switch their webhooks and replace their main installer sets
+		if err := s.markSwitched(ctx, payloadSets, status.ActiveGroup); err != nil {
+			return err
+		}
+		for _, set := range mainSets {
+			if set.Labels[v1alpha1.ReleaseVersionKey] != s.operatorVersion {
+				status.Message = fmt.Sprintf("waiting for %s to retire the previous payload", set.Labels[v1alpha1.CreatedByKey])
+				return nil
+			}
+		}
+		if err := s.deletePayloads(ctx, payloadSets, func(set *v1alpha1.TektonInstallerSet) bool {
+			return set.Labels[v1alpha1.PayloadGroupKey] != status.ActiveGroup
+		}); err != nil {
+			return err
+		}
+		status.Phase = v1alpha1.SwitchoverPhaseCompleted
+		status.Message = ""
+	}
+	return nil
+}
+
+// rollback releases the components once the switchover is disabled, they point their webhooks
+// back to the target namespace, and the staged payloads are removed once none of them is used
+func (s *Switchover) rollback(ctx context.Context, tc *v1alpha1.TektonConfig) error {
+	status := tc.Status.PayloadSwitchover
+	if status == nil {
+		return nil
+	}
+
+	mainSets, err := s.listSets(ctx, client.InstallerTypeMain)
+	if err != nil {
+		return err
+	}
+	payloadSets, err := s.listSets(ctx, client.InstallerTypePayload)
+	if err != nil {
+		return err
+	}
+
+	if err := s.hold(ctx, mainSets, "", ""); err != nil {
+		return err
+	}
+	for i := range payloadSets {
+		if err := s.annotate(ctx, &payloadSets[i], map[string]string{v1alpha1.PayloadSwitchedKey: ""}); err != nil {
+			return err
+		}
+	}
+	for _, set := range mainSets {
+		if ns := set.Annotations[v1alpha1.WebhookNamespaceKey]; ns != "" {
+			status.Message = fmt.Sprintf("waiting for %s to serve its webhooks from the target namespace", set.Labels[v1alpha1.CreatedByKey])
+			return nil
+		}
+	}
+	if err := s.deletePayloads(ctx, payloadSets, func(*v1alpha1.TektonInstallerSet) bool { return true }); err != nil {
+		return err
+	}
+	tc.Status.PayloadSwitchover = nil
+	return nil
+}
+
+// hold records the staging namespace of the next version on the main installer sets of the
+// operator version, an empty namespace releases them
+func (s *Switchover) hold(ctx context.Context, mainSets []v1alpha1.TektonInstallerSet, group, namespace string) error {
+	for i := range mainSets {
+		set := &mainSets[i]
+		if namespace != "" && set.Labels[v1alpha1.ReleaseVersionKey] != s.operatorVersion {
+			continue
+		}
+		if err := s.annotate(ctx, set, map[string]string{
+			v1alpha1.PayloadGroupKey:            group,
+			v1alpha1.PayloadStagingNamespaceKey: namespace,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// staged returns the payload sets of the group staged for the operator version, and the
+// components which have not staged a ready payload yet while they are left at a previous version
+func (s *Switchover) staged(mainSets, payloadSets []v1alpha1.TektonInstallerSet, group string) ([]v1alpha1.TektonInstallerSet, []string) {
+	var staged []v1alpha1.TektonInstallerSet
+	upgrading := sets.NewString()
+	ready := map[string]bool{}
+	for _, set := range payloadSets {
+		if set.Labels[v1alpha1.ReleaseVersionKey] != s.operatorVersion || set.Labels[v1alpha1.PayloadGroupKey] != group {
+			continue
+		}
+		staged = append(staged, set)
+		kind := set.Labels[v1alpha1.CreatedByKey]
+		upgrading.Insert(kind)
+		if isReady, found := ready[kind]; !found || isReady {
+			ready[kind] = set.Status.IsReady()
+		}
+	}
+	// the components left at a previous version either stage their payload or upgrade in place
+	for _, set := range mainSets {
+		if set.Labels[v1alpha1.ReleaseVersionKey] != s.operatorVersion {
+			upgrading.Insert(set.Labels[v1alpha1.CreatedByKey])
+		}
+	}
+
+	var pending []string
+	for _, kind := range upgrading.List() {
+		if !ready[kind] {
+			pending = append(pending, kind)
+		}
+	}
+	return staged, pending
+}
+
+// markSwitched switches the webhooks of the components to the ready payload sets of the group
+func (s *Switchover) markSwitched(ctx context.Context, payloadSets []v1alpha1.TektonInstallerSet, group string) error {
+	for i := range payloadSets {
+		set := &payloadSets[i]
+		if set.Labels[v1alpha1.ReleaseVersionKey] != s.operatorVersion || set.Labels[v1alpha1.PayloadGroupKey] != group ||
+			!set.Status.IsReady() {
+			continue
+		}
+		if err := s.annotate(ctx, set, map[string]string{v1alpha1.PayloadSwitchedKey: "true"}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// annotate sets the annotations of the installer set, empty values are removed
+func (s *Switchover) annotate(ctx context.Context, set *v1alpha1.TektonInstallerSet, annotations map[string]string) error {
+	changed := false
+	for key, value := range annotations {
+		if set.Annotations[key] != value {
+			changed = true
+		}
+	}
+	if !changed {
+		return nil
+	}
+	onCluster, err := s.operatorClientSet.OperatorV1alpha1().TektonInstallerSets().Get(ctx, set.Name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if onCluster.Annotations == nil {
+		onCluster.Annotations = map[string]string{}
+	}
+	for key, value := range annotations {
+		if value == "" {
+			delete(onCluster.Annotations, key)
+		} else {
+			onCluster.Annotations[key] = value
+		}
+	}
+	_, err = s.operatorClientSet.OperatorV1alpha1().TektonInstallerSets().Update(ctx, onCluster, metav1.UpdateOptions{})
+	return err
+}
+
+func (s *Switchover) ensureNamespace(ctx context.Context, name string) error {
+	_, err := s.kubeClientSet.CoreV1().Namespaces().Get(ctx, name, metav1.GetOptions{})
+	if err == nil || !apierrs.IsNotFound(err) {
+		return err
+	}
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	if _, err := s.kubeClientSet.CoreV1().Namespaces().Create(ctx, ns, metav1.CreateOptions{}); err != nil && !apierrs.IsAlreadyExists(err) {
+		return err
+	}
+	return nil
+}
+
+// deletePayloads deletes the staged payload sets selected by the filter
+func (s *Switchover) deletePayloads(ctx context.Context, payloadSets []v1alpha1.TektonInstallerSet, filter func(*v1alpha1.TektonInstallerSet) bool) error {
+	for i := range payloadSets {
+		set := &payloadSets[i]
+		if !filter(set) {
+			continue
+		}
+		if err := s.operatorClientSet.OperatorV1alpha1().TektonInstallerSets().Delete(ctx, set.Name, metav1.DeleteOptions{}); err != nil && !apierrs.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Switchover) listSets(ctx context.Context, setType string) ([]v1alpha1.TektonInstallerSet, error) {
+	req, err := labels.NewRequirement(v1alpha1.InstallerSetType, selection.Equals, []string{setType})
+	if err != nil {
+		return nil, err
+	}
+	list, err := s.operatorClientSet.OperatorV1alpha1().TektonInstallerSets().List(ctx, metav1.ListOptions{
+		LabelSelector: labels.NewSelector().Add(*req).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
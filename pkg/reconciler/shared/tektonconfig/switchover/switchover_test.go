@@ -0,0 +1,184 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package switchover
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	operatorfake "github.com/tektoncd/operator/pkg/client/clientset/versioned/fake"
+	"github.com/tektoncd/operator/pkg/reconciler/kubernetes/tektoninstallerset/client"
+	"gotest.tools/v3/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+)
+
+func installerSet(name, kind, setType, version string) *v1alpha1.TektonInstallerSet {
+	return &v1alpha1.TektonInstallerSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+			Labels: map[string]string{
+				v1alpha1.CreatedByKey:      kind,
+				v1alpha1.InstallerSetType:  setType,
+				v1alpha1.ReleaseVersionKey: version,
+			},
+			Annotations: map[string]string{},
+		},
+	}
+}
+
+func readySet(set *v1alpha1.TektonInstallerSet) *v1alpha1.TektonInstallerSet {
+	set.Status.InitializeConditions()
+	set.Status.MarkCRDsInstalled()
+	set.Status.MarkClustersScopedResourcesInstalled()
+	set.Status.MarkNamespaceScopedResourcesInstalled()
+	set.Status.MarkDeploymentsAvailable()
+	set.Status.MarkStatefulSetReady()
+	set.Status.MarkWebhookReady()
+	set.Status.MarkControllerReady()
+	set.Status.MarkAllDeploymentsReady()
+	set.Status.MarkJobsInstalled()
+	return set
+}
+
+func getSet(t *testing.T, operatorClient *operatorfake.Clientset, name string) *v1alpha1.TektonInstallerSet {
+	set, err := operatorClient.OperatorV1alpha1().TektonInstallerSets().Get(context.TODO(), name, metav1.GetOptions{})
+	assert.NilError(t, err)
+	return set
+}
+
+func newTektonConfig() *v1alpha1.TektonConfig {
+	return &v1alpha1.TektonConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.ConfigResourceName},
+		Spec: v1alpha1.TektonConfigSpec{
+			CommonSpec:        v1alpha1.CommonSpec{TargetNamespace: "tekton-pipelines"},
+			PayloadSwitchover: &v1alpha1.PayloadSwitchover{Enable: true, StagingNamespace: "tekton-staging"},
+		},
+	}
+}
+
+func TestSwitchoverHoldsMainSets(t *testing.T) {
+	ctx := context.TODO()
+	kubeClient := kubefake.NewSimpleClientset()
+	operatorClient := operatorfake.NewSimpleClientset(
+		readySet(installerSet("pipeline-main-deployment-abcde", "TektonPipeline", client.InstallerTypeMain, "v1")),
+	)
+	s := New("v1", kubeClient, operatorClient)
+	tc := newTektonConfig()
+
+	// nothing to stage for the operator version, the next version is staged in the blue group
+	assert.NilError(t, s.Reconcile(ctx, tc))
+	assert.Equal(t, tc.Status.PayloadSwitchover.Phase, v1alpha1.SwitchoverPhaseCompleted)
+	assert.Equal(t, tc.Status.PayloadSwitchover.ActiveNamespace, "tekton-pipelines")
+
+	set := getSet(t, operatorClient, "pipeline-main-deployment-abcde")
+	assert.Equal(t, set.Annotations[v1alpha1.PayloadGroupKey], v1alpha1.PayloadGroupBlue)
+	assert.Equal(t, set.Annotations[v1alpha1.PayloadStagingNamespaceKey], "tekton-staging-blue")
+	_, err := kubeClient.CoreV1().Namespaces().Get(ctx, "tekton-staging-blue", metav1.GetOptions{})
+	assert.NilError(t, err)
+
+	// disabling the switchover releases the main installer sets
+	tc.Spec.PayloadSwitchover = nil
+	assert.NilError(t, s.Reconcile(ctx, tc))
+	assert.Assert(t, tc.Status.PayloadSwitchover == nil)
+	set = getSet(t, operatorClient, "pipeline-main-deployment-abcde")
+	_, held := set.Annotations[v1alpha1.PayloadStagingNamespaceKey]
+	assert.Assert(t, !held)
+}
+
+func TestSwitchoverReconcile(t *testing.T) {
+	ctx := context.TODO()
+	// the pipeline stages its payload, the triggers are still at the previous version
+	previous := readySet(installerSet("pipeline-main-deployment-abcde", "TektonPipeline", client.InstallerTypeMain, "v1"))
+	previous.Annotations[v1alpha1.PayloadGroupKey] = v1alpha1.PayloadGroupBlue
+	previous.Annotations[v1alpha1.PayloadStagingNamespaceKey] = "tekton-staging-blue"
+	staged := installerSet("pipeline-payload-blue-abcde", "TektonPipeline", client.InstallerTypePayload, "v2")
+	staged.Labels[v1alpha1.PayloadGroupKey] = v1alpha1.PayloadGroupBlue
+	triggers := readySet(installerSet("trigger-main-deployment-abcde", "TektonTrigger", client.InstallerTypeMain, "v1"))
+
+	kubeClient := kubefake.NewSimpleClientset()
+	operatorClient := operatorfake.NewSimpleClientset(previous, staged, triggers)
+	s := New("v2", kubeClient, operatorClient)
+	tc := newTektonConfig()
+	tc.Status.PayloadSwitchover = &v1alpha1.PayloadSwitchoverStatus{
+		Phase:           v1alpha1.SwitchoverPhaseCompleted,
+		ActiveNamespace: "tekton-pipelines",
+		StagedGroup:     v1alpha1.PayloadGroupBlue,
+		StagedVersion:   "v1",
+	}
+
+	// the components which did not stage a ready payload are waited for
+	assert.NilError(t, s.Reconcile(ctx, tc))
+	assert.Equal(t, tc.Status.PayloadSwitchover.Phase, v1alpha1.SwitchoverPhaseStaging)
+	assert.Equal(t, tc.Status.PayloadSwitchover.StagedGroup, v1alpha1.PayloadGroupBlue)
+	assert.Equal(t, tc.Status.PayloadSwitchover.Message,
+		"waiting for TektonPipeline, TektonTrigger to stage payload blue in namespace tekton-staging-blue")
+
+	// the triggers have no webhook to stage and are upgraded in place
+	assert.NilError(t, operatorClient.OperatorV1alpha1().TektonInstallerSets().Delete(ctx, triggers.Name, metav1.DeleteOptions{}))
+	_, err := operatorClient.OperatorV1alpha1().TektonInstallerSets().Create(ctx,
+		readySet(installerSet("trigger-main-deployment-fghij", "TektonTrigger", client.InstallerTypeMain, "v2")), metav1.CreateOptions{})
+	assert.NilError(t, err)
+	_, err = operatorClient.OperatorV1alpha1().TektonInstallerSets().Update(ctx, readySet(getSet(t, operatorClient, staged.Name)), metav1.UpdateOptions{})
+	assert.NilError(t, err)
+
+	// once the staged payload is ready it is verified but not switched without approval
+	assert.NilError(t, s.Reconcile(ctx, tc))
+	assert.Equal(t, tc.Status.PayloadSwitchover.Phase, v1alpha1.SwitchoverPhaseVerified)
+	_, switched := getSet(t, operatorClient, staged.Name).Annotations[v1alpha1.PayloadSwitchedKey]
+	assert.Assert(t, !switched)
+	// the main installer sets at the operator version are held for the next version
+	assert.Equal(t, getSet(t, operatorClient, "trigger-main-deployment-fghij").Annotations[v1alpha1.PayloadStagingNamespaceKey], "tekton-staging-blue")
+
+	// approving the switchover switches the components to the staged payload
+	tc.Spec.PayloadSwitchover.Switch = true
+	assert.NilError(t, s.Reconcile(ctx, tc))
+	assert.Equal(t, tc.Status.PayloadSwitchover.Phase, v1alpha1.SwitchoverPhaseSwitched)
+	assert.Equal(t, tc.Status.PayloadSwitchover.ActiveGroup, v1alpha1.PayloadGroupBlue)
+	assert.Equal(t, tc.Status.PayloadSwitchover.ActiveNamespace, "tekton-staging-blue")
+	assert.Equal(t, tc.Status.PayloadSwitchover.Message, "waiting for TektonPipeline to retire the previous payload")
+	assert.Equal(t, getSet(t, operatorClient, staged.Name).Annotations[v1alpha1.PayloadSwitchedKey], "true")
+	assert.Equal(t, getSet(t, operatorClient, "trigger-main-deployment-fghij").Annotations[v1alpha1.PayloadStagingNamespaceKey], "tekton-staging-green")
+
+	// the switchover completes once the pipeline replaced its main installer sets
+	assert.NilError(t, operatorClient.OperatorV1alpha1().TektonInstallerSets().Delete(ctx, previous.Name, metav1.DeleteOptions{}))
+	current := readySet(installerSet("pipeline-main-deployment-fghij", "TektonPipeline", client.InstallerTypeMain, "v2"))
+	current.Annotations[v1alpha1.WebhookNamespaceKey] = "tekton-staging-blue"
+	_, err = operatorClient.OperatorV1alpha1().TektonInstallerSets().Create(ctx, current, metav1.CreateOptions{})
+	assert.NilError(t, err)
+	assert.NilError(t, s.Reconcile(ctx, tc))
+	assert.Equal(t, tc.Status.PayloadSwitchover.Phase, v1alpha1.SwitchoverPhaseCompleted)
+	assert.Equal(t, getSet(t, operatorClient, current.Name).Annotations[v1alpha1.PayloadGroupKey], v1alpha1.PayloadGroupGreen)
+
+	// disabling the switchover keeps the staged payload until the webhooks are served from the target namespace
+	tc.Spec.PayloadSwitchover = nil
+	assert.NilError(t, s.Reconcile(ctx, tc))
+	assert.Assert(t, tc.Status.PayloadSwitchover != nil)
+	_, switched = getSet(t, operatorClient, staged.Name).Annotations[v1alpha1.PayloadSwitchedKey]
+	assert.Assert(t, !switched)
+
+	current = getSet(t, operatorClient, current.Name)
+	delete(current.Annotations, v1alpha1.WebhookNamespaceKey)
+	_, err = operatorClient.OperatorV1alpha1().TektonInstallerSets().Update(ctx, current, metav1.UpdateOptions{})
+	assert.NilError(t, err)
+	assert.NilError(t, s.Reconcile(ctx, tc))
+	assert.Assert(t, tc.Status.PayloadSwitchover == nil)
+	payloads, err := s.listSets(ctx, client.InstallerTypePayload)
+	assert.NilError(t, err)
+	assert.Equal(t, len(payloads), 0)
+}
@@ -18,6 +18,7 @@ package tektonconfig
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	mf "github.com/manifestival/manifestival"
@@ -25,15 +26,33 @@ import (
 	clientset "github.com/tektoncd/operator/pkg/client/clientset/versioned"
 	tektonConfigreconciler "github.com/tektoncd/operator/pkg/client/injection/reconciler/operator/v1alpha1/tektonconfig"
 	"github.com/tektoncd/operator/pkg/reconciler/common"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/permissions"
 	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/chain"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/concurrency"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/dependency"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/deprecation"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/drift"
 	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/multiclusterproxyaae"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/notifications"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/onboarding"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/orphanedpvc"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/outputs"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/paramsfrom"
 	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/pipeline"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/policies"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/progress"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/propagation"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/proxyrotation"
 	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/pruner"
 	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/result"
 	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/scheduler"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/switchover"
 	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/syncerservice"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/tlspolicy"
 	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/trigger"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/trustedca"
 	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/upgrade"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/vulnerability"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 	"knative.dev/pkg/apis"
@@ -53,6 +72,42 @@ type Reconciler struct {
 	operatorVersion string
 	// performs pre and post upgrade operations
 	upgrade *upgrade.Upgrade
+	// performs blue/green payload switchovers
+	switchover *switchover.Switchover
+	// onboards the namespaces requesting it
+	onboarding *onboarding.Onboarding
+	// publishes the endpoints and versions of the install
+	outputs *outputs.Outputs
+	// scans the payload images before the components are installed
+	vulnerability *vulnerability.Gate
+	// distributes the trusted CA certificates on Kubernetes
+	trustedCA *trustedca.TrustedCA
+	// limits the PipelineRuns of the namespaces
+	concurrency *concurrency.Quotas
+	// reports and cleans up the orphaned PersistentVolumeClaims of the workspaces
+	orphanedPVCs *orphanedpvc.Scanner
+	// generates the validating admission policies of the guardrails
+	policies *policies.Policies
+	// installs the policy bundle with Gatekeeper or Kyverno
+	policyBundle *policies.Bundle
+	// tracks the propagation of the spec changes to the components
+	propagation *propagation.Tracker
+	// reports the deprecated fields set in TektonConfig and the components
+	deprecation *deprecation.Scanner
+	// notifies the sinks of the install, upgrade and degraded events
+	notifier *notifications.Notifier
+	// reports the progress of the install or upgrade of the components
+	progress *progress.Reporter
+	// records the changes of the proxy injected in the components
+	proxy *proxyrotation.Tracker
+	// reports the permissions needed by the operator and not granted to it
+	permissions *permissions.Checker
+	// verifies the TLS policy on the endpoints of the components
+	tlsPolicy *tlspolicy.Prober
+	// reports or repairs the fields of the components changed directly
+	drift *drift.Detector
+	// resolves the params and properties of the ConfigMaps and Secrets of spec.paramsFrom
+	paramsFrom *paramsfrom.Resolver
 }
 
 // Check that our Reconciler implements controller.Reconciler
@@ -63,51 +118,56 @@ var (
 
 // FinalizeKind removes all resources after deletion of a TektonConfig.
 func (r *Reconciler) FinalizeKind(ctx context.Context, original *v1alpha1.TektonConfig) pkgreconciler.Event {
-	logger := logging.FromContext(ctx)
-
-	if err := r.extension.Finalize(ctx, original); err != nil {
-		logger.Error("Failed to finalize platform resources", err)
+	operator := r.operatorClientSet.OperatorV1alpha1()
+	component := func(name string, ensure func(context.Context) error) common.FinalizationStep {
+		return common.FinalizationStep{Name: name, Stage: common.FinalizeComponents, Run: ensure}
 	}
 
-	if original.Spec.Profile == v1alpha1.ProfileLite {
-		return pipeline.EnsureTektonPipelineCRNotExists(ctx, r.operatorClientSet.OperatorV1alpha1().TektonPipelines())
-	} else {
+	steps := []common.FinalizationStep{{
+		// the components of the platform are finalized first, their errors do not block the deletion
+		Name:     "platform",
+		Stage:    common.FinalizeComponents,
+		Optional: true,
+		Run:      func(ctx context.Context) error { return r.extension.Finalize(ctx, original) },
+	}}
+	if original.Spec.Profile != v1alpha1.ProfileLite {
 		// TektonPipeline and TektonTrigger is common for profile type basic and all
-		if err := trigger.EnsureTektonTriggerCRNotExists(ctx, r.operatorClientSet.OperatorV1alpha1().TektonTriggers()); err != nil {
-			return err
-		}
-		if err := chain.EnsureTektonChainCRNotExists(ctx, r.operatorClientSet.OperatorV1alpha1().TektonChains()); err != nil {
-			return err
-		}
-		if err := result.EnsureTektonResultCRNotExists(ctx, r.operatorClientSet.OperatorV1alpha1().TektonResults()); err != nil {
-			return err
-		}
-		if err := syncerservice.EnsureSyncerServiceCRNotExists(ctx, r.operatorClientSet.OperatorV1alpha1().SyncerServices()); err != nil {
-			return err
-		}
-		if err := pipeline.EnsureTektonPipelineCRNotExists(ctx, r.operatorClientSet.OperatorV1alpha1().TektonPipelines()); err != nil {
-			return err
-		}
-		if err := multiclusterproxyaae.EnsureTektonMulticlusterProxyAAECRNotExists(ctx, r.operatorClientSet.OperatorV1alpha1().TektonMulticlusterProxyAAEs()); err != nil {
-			return err
-		}
-	}
-
-	// remove pruner tektonInstallerSet
-	labelSelector, err := common.LabelSelector(prunerInstallerSetLabel)
-	if err != nil {
-		return err
+		steps = append(steps,
+			component(v1alpha1.KindTektonTrigger, func(ctx context.Context) error {
+				return trigger.EnsureTektonTriggerCRNotExists(ctx, operator.TektonTriggers())
+			}),
+			component(v1alpha1.KindTektonChain, func(ctx context.Context) error {
+				return chain.EnsureTektonChainCRNotExists(ctx, operator.TektonChains())
+			}),
+			component(v1alpha1.KindTektonResult, func(ctx context.Context) error {
+				return result.EnsureTektonResultCRNotExists(ctx, operator.TektonResults())
+			}),
+			component(v1alpha1.KindSyncerService, func(ctx context.Context) error {
+				return syncerservice.EnsureSyncerServiceCRNotExists(ctx, operator.SyncerServices())
+			}),
+		)
 	}
-	if err := r.operatorClientSet.OperatorV1alpha1().TektonInstallerSets().DeleteCollection(
-		ctx,
-		metav1.DeleteOptions{},
-		metav1.ListOptions{LabelSelector: labelSelector},
-	); err != nil {
-		logger.Error("failed to delete pruner installerSet", err)
-		return err
+	steps = append(steps, component(v1alpha1.KindTektonPipeline, func(ctx context.Context) error {
+		return pipeline.EnsureTektonPipelineCRNotExists(ctx, operator.TektonPipelines())
+	}))
+	if original.Spec.Profile != v1alpha1.ProfileLite {
+		steps = append(steps, component(v1alpha1.KindTektonMulticlusterProxyAAE, func(ctx context.Context) error {
+			return multiclusterproxyaae.EnsureTektonMulticlusterProxyAAECRNotExists(ctx, operator.TektonMulticlusterProxyAAEs())
+		}))
 	}
+	steps = append(steps, common.FinalizationStep{
+		Name:  "pruner installer sets",
+		Stage: common.FinalizeInstallerSets,
+		Run: func(ctx context.Context) error {
+			labelSelector, err := common.LabelSelector(prunerInstallerSetLabel)
+			if err != nil {
+				return err
+			}
+			return operator.TektonInstallerSets().DeleteCollection(ctx, metav1.DeleteOptions{}, metav1.ListOptions{LabelSelector: labelSelector})
+		},
+	})
 
-	return nil
+	return common.Finalize(ctx, original, &original.Status, steps...)
 }
 
 // ReconcileKind compares the actual state with the desired, and attempts to
@@ -132,6 +192,33 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, tc *v1alpha1.TektonConfi
 		return nil
 	}
 
+	// the values of spec.paramsFrom are resolved in a copy of TektonConfig which is read by the
+	// reconcile and is not persisted, the status set on the copy is the status of TektonConfig
+	resolved, err := r.paramsFrom.Resolve(ctx, tc)
+	if err != nil {
+		logger.Errorw("Failed to resolve spec.paramsFrom", "error", err)
+		tc.Status.MarkPreInstallFailed(err.Error())
+		return err
+	}
+	defer func() { tc.Status = resolved.Status }()
+
+	return r.reconcile(ctx, tc, resolved)
+}
+
+// reconcile converges the components with the resolved TektonConfig, the original TektonConfig is
+// the one updated on upgrade
+func (r *Reconciler) reconcile(ctx context.Context, original, tc *v1alpha1.TektonConfig) pkgreconciler.Event {
+	logger := logging.FromContext(ctx).With("tektonconfig", tc.Name)
+
+	r.propagation.Observe(ctx, tc)
+	defer r.propagation.Record(ctx, tc)
+	defer r.notifier.Reconcile(ctx, tc)
+	defer r.progress.Reconcile(ctx, tc)
+	r.proxy.Reconcile(ctx, tc)
+	r.deprecation.Reconcile(ctx, tc)
+	r.permissions.Reconcile(ctx, tc)
+	r.drift.Reconcile(ctx, tc)
+
 	// run pre upgrade
 	if err := r.upgrade.RunPreUpgrade(ctx); err != nil {
 		logger.Errorw("Pre-upgrade failed", "error", err)
@@ -140,11 +227,18 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, tc *v1alpha1.TektonConfi
 	logger.Debug("Pre-upgrade completed successfully")
 
 	// Mark TektonConfig Instance as Not Ready if an upgrade is needed
-	if err := r.markUpgrade(ctx, tc); err != nil {
+	if err := r.markUpgrade(ctx, original, tc); err != nil {
 		logger.Errorw("Failed to mark upgrade status", "error", err)
 		return err
 	}
 
+	// select the node architectures the images are available for before installing the components
+	if err := common.RefreshArchPlacement(ctx, r.kubeClientSet); err != nil {
+		logger.Errorw("Failed to select the node architectures of the workloads", "error", err)
+		tc.Status.MarkPreInstallFailed(err.Error())
+		return err
+	}
+
 	// reconcile target namespace
 	nsMetaLabels := map[string]string{}
 	nsMetaAnnotations := map[string]string{}
@@ -162,6 +256,13 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, tc *v1alpha1.TektonConfi
 	}
 	logger.Debug("Target namespace reconciled successfully")
 
+	// the pods of the components are rejected when their profiles are not allowed in the target namespace
+	if err := common.CheckPodSecurityLevel(ctx, r.kubeClientSet, tc.Spec.GetTargetNamespace(), tc.Spec.Config.PodSecurity); err != nil {
+		logger.Errorw("Invalid pod security defaults", "error", err)
+		tc.Status.MarkPreInstallFailed(err.Error())
+		return err
+	}
+
 	// Pre-reconcile extension hooks
 	if err := r.extension.PreReconcile(ctx, tc); err != nil {
 		if err == v1alpha1.RECONCILE_AGAIN_ERR {
@@ -173,11 +274,30 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, tc *v1alpha1.TektonConfi
 		return err
 	}
 
+	// scan the payload images before rolling them out
+	if err := r.vulnerability.Reconcile(ctx, tc); err != nil {
+		logger.Errorw("Vulnerability gate failed", "error", err)
+		tc.Status.MarkPreInstallFailed(err.Error())
+		if errors.Is(err, vulnerability.ErrBlocked) {
+			return v1alpha1.REQUEUE_EVENT_AFTER
+		}
+		return err
+	}
+
+	// Blue/green payload switchover, it runs before the components are ensured as they stage
+	// the next version next to the current one instead of upgrading in place
+	if err := r.switchover.Reconcile(ctx, tc); err != nil {
+		logger.Errorw("Payload switchover failed", "error", err)
+		tc.Status.MarkPreInstallFailed(err.Error())
+		return err
+	}
+
 	tc.Status.MarkPreInstallComplete()
 	logger.Debug("Pre-install completed successfully")
 
 	// Ensure Pipeline CR
 	tektonpipeline := pipeline.GetTektonPipelineCR(tc, r.operatorVersion)
+	r.drift.Preserve(ctx, tc, tektonpipeline)
 	logger.Debug("Ensuring TektonPipeline CR exists")
 	if _, err := pipeline.EnsureTektonPipelineExists(ctx, r.operatorClientSet.OperatorV1alpha1().TektonPipelines(), tektonpipeline); err != nil {
 		errMsg := fmt.Sprintf("TektonPipeline: %s", err.Error())
@@ -257,17 +377,30 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, tc *v1alpha1.TektonConfi
 		return err
 	}
 
+	// the components waiting on their dependencies are skipped, the others are still ensured and
+	// the reconcile is requeued once they are
+	waitingOnDependencies := false
+
 	// Ensure Pipeline Trigger
 	if !tc.Spec.Trigger.Disabled && (tc.Spec.Profile == v1alpha1.ProfileAll || tc.Spec.Profile == v1alpha1.ProfileBasic) {
-		tektontrigger := trigger.GetTektonTriggerCR(tc, r.operatorVersion)
-		logger.Debug("Ensuring TektonTrigger CR exists")
-		if _, err := trigger.EnsureTektonTriggerExists(ctx, r.operatorClientSet.OperatorV1alpha1().TektonTriggers(), tektontrigger); err != nil {
-			errMsg := fmt.Sprintf("TektonTrigger: %s", err.Error())
-			logger.Errorw("Failed to ensure TektonTrigger exists", "error", err)
-			tc.Status.MarkComponentNotReady(errMsg)
-			return v1alpha1.REQUEUE_EVENT_AFTER
+		switch err := dependency.Wait(ctx, r.operatorClientSet, tc, dependency.Trigger); {
+		case err == v1alpha1.REQUEUE_EVENT_AFTER:
+			logger.Infow("TektonTrigger is waiting on its dependencies")
+			waitingOnDependencies = true
+		case err != nil:
+			return err
+		default:
+			tektontrigger := trigger.GetTektonTriggerCR(tc, r.operatorVersion)
+			r.drift.Preserve(ctx, tc, tektontrigger)
+			logger.Debug("Ensuring TektonTrigger CR exists")
+			if _, err := trigger.EnsureTektonTriggerExists(ctx, r.operatorClientSet.OperatorV1alpha1().TektonTriggers(), tektontrigger); err != nil {
+				errMsg := fmt.Sprintf("TektonTrigger: %s", err.Error())
+				logger.Errorw("Failed to ensure TektonTrigger exists", "error", err)
+				tc.Status.MarkComponentNotReady(errMsg)
+				return v1alpha1.REQUEUE_EVENT_AFTER
+			}
+			logger.Debug("TektonTrigger CR reconciled successfully")
 		}
-		logger.Debug("TektonTrigger CR reconciled successfully")
 	} else {
 		logger.Debugw("Ensuring TektonTrigger CR doesn't exist", "profile", tc.Spec.Profile, "triggerDisabled", tc.Spec.Trigger.Disabled)
 		if err := trigger.EnsureTektonTriggerCRNotExists(ctx, r.operatorClientSet.OperatorV1alpha1().TektonTriggers()); err != nil {
@@ -281,15 +414,24 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, tc *v1alpha1.TektonConfi
 
 	// Ensure Chain CR
 	if !tc.Spec.Chain.Disabled {
-		tektonchain := chain.GetTektonChainCR(tc, r.operatorVersion)
-		logger.Debug("Ensuring TektonChain CR exists")
-		if _, err := chain.EnsureTektonChainExists(ctx, r.operatorClientSet.OperatorV1alpha1().TektonChains(), tektonchain); err != nil {
-			errMsg := fmt.Sprintf("TektonChain: %s", err.Error())
-			logger.Errorw("Failed to ensure TektonChain exists", "error", err)
-			tc.Status.MarkComponentNotReady(errMsg)
-			return v1alpha1.REQUEUE_EVENT_AFTER
+		switch err := dependency.Wait(ctx, r.operatorClientSet, tc, dependency.Chain); {
+		case err == v1alpha1.REQUEUE_EVENT_AFTER:
+			logger.Infow("TektonChain is waiting on its dependencies")
+			waitingOnDependencies = true
+		case err != nil:
+			return err
+		default:
+			tektonchain := chain.GetTektonChainCR(tc, r.operatorVersion)
+			r.drift.Preserve(ctx, tc, tektonchain)
+			logger.Debug("Ensuring TektonChain CR exists")
+			if _, err := chain.EnsureTektonChainExists(ctx, r.operatorClientSet.OperatorV1alpha1().TektonChains(), tektonchain); err != nil {
+				errMsg := fmt.Sprintf("TektonChain: %s", err.Error())
+				logger.Errorw("Failed to ensure TektonChain exists", "error", err)
+				tc.Status.MarkComponentNotReady(errMsg)
+				return v1alpha1.REQUEUE_EVENT_AFTER
+			}
+			logger.Debug("TektonChain CR reconciled successfully")
 		}
-		logger.Debug("TektonChain CR reconciled successfully")
 	} else {
 		logger.Debugw("Ensuring TektonChain CR doesn't exist", "chainDisabled", tc.Spec.Chain.Disabled)
 		if err := chain.EnsureTektonChainCRNotExists(ctx, r.operatorClientSet.OperatorV1alpha1().TektonChains()); err != nil {
@@ -304,6 +446,7 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, tc *v1alpha1.TektonConfi
 	// Ensure Result CR
 	if !tc.Spec.Result.Disabled {
 		tektonresult := result.GetTektonResultCR(tc, r.operatorVersion)
+		r.drift.Preserve(ctx, tc, tektonresult)
 		logger.Debug("Ensuring TektonResult CR exists")
 		if _, err := result.EnsureTektonResultExists(ctx, r.operatorClientSet.OperatorV1alpha1().TektonResults(), tektonresult); err != nil {
 			errMsg := fmt.Sprintf("TektonResult %s", err.Error())
@@ -372,8 +515,69 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, tc *v1alpha1.TektonConfi
 		logger.Debug("Resource pruning completed successfully")
 	}
 
-	tc.Status.MarkComponentsReady()
-	logger.Debug("All components marked ready")
+	// Publish the defaults requested through namespace annotations
+	if err := common.ReconcileNamespaceDefaults(ctx, r.kubeClientSet, tc); err != nil {
+		logger.Errorw("Failed to publish namespace defaults", "error", err)
+	}
+
+	// Create the ServiceAccount and RoleBindings in the namespaces requesting onboarding
+	if err := r.onboarding.Reconcile(ctx, tc); err != nil {
+		logger.Errorw("Failed to onboard namespaces", "error", err)
+	}
+
+	// Distribute the trusted CA certificates to the namespaces on Kubernetes
+	if err := r.trustedCA.Reconcile(ctx, tc); err != nil {
+		logger.Errorw("Failed to distribute the trusted CA certificates", "error", err)
+	}
+
+	// Limit the PipelineRuns of the namespaces with ResourceQuotas
+	if err := r.concurrency.Reconcile(ctx, tc); err != nil {
+		logger.Errorw("Failed to limit the PipelineRuns of the namespaces", "error", err)
+	}
+
+	// Report the PersistentVolumeClaims of the workspaces left by the PipelineRuns
+	if err := r.orphanedPVCs.Reconcile(ctx, tc); err != nil {
+		logger.Errorw("Failed to scan the orphaned persistentvolumeclaims", "error", err)
+	}
+
+	// Generate the ValidatingAdmissionPolicies enforcing the guardrails on the Tekton resources
+	if err := r.policies.Reconcile(ctx, tc); err != nil {
+		logger.Errorw("Failed to generate the validating admission policies", "error", err)
+	}
+	if err := r.policyBundle.Reconcile(ctx, tc); err != nil {
+		logger.Errorw("Failed to install the policy bundle", "error", err)
+	}
+
+	// Generate the serving certificates of the metrics served over TLS on Kubernetes
+	if tc.Spec.Config.MetricsTLS != nil && tc.Spec.Config.MetricsTLS.Enable {
+		if err := common.ReconcileMetricsTLSSecrets(ctx, r.kubeClientSet, tc.Spec.GetTargetNamespace()); err != nil {
+			logger.Errorw("Failed to generate the metrics serving certificates", "error", err)
+		}
+	}
+
+	// Publish the endpoints, versions and webhook CA bundles for external automation
+	if err := r.outputs.Reconcile(ctx, tc); err != nil {
+		logger.Errorw("Failed to publish the install outputs", "error", err)
+	}
+
+	// Record the SHA256 of the deterministically rendered manifests for reproducibility audits
+	if err := r.reconcileRenderedManifests(ctx, tc); err != nil {
+		logger.Errorw("Failed to record the SHA256 of the rendered manifests", "error", err)
+	}
+
+	// Record the operator and payload versions to surface partial upgrades
+	if err := r.reconcilePayloadVersions(ctx, tc); err != nil {
+		logger.Errorw("Failed to record the payload versions", "error", err)
+	}
+
+	// Verify that the endpoints of the components comply with the TLS policy
+	r.tlsPolicy.Reconcile(ctx, tc)
+
+	if !waitingOnDependencies {
+		tc.Status.MarkDependenciesReady()
+		tc.Status.MarkComponentsReady()
+		logger.Debug("All components marked ready")
+	}
 
 	// Post-reconcile extension hooks
 	if err := r.extension.PostReconcile(ctx, tc); err != nil {
@@ -384,6 +588,11 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, tc *v1alpha1.TektonConfi
 	tc.Status.MarkPostInstallComplete()
 	logger.Debug("Post-install completed successfully")
 
+	if waitingOnDependencies {
+		logger.Infow("Requeue the components waiting on their dependencies")
+		return v1alpha1.REQUEUE_EVENT_AFTER
+	}
+
 	// Update the object for any spec changes
 	logger.Debug("Updating TektonConfig status")
 	if _, err := r.operatorClientSet.OperatorV1alpha1().TektonConfigs().UpdateStatus(ctx, tc, metav1.UpdateOptions{}); err != nil {
@@ -404,7 +613,7 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, tc *v1alpha1.TektonConfi
 	return nil
 }
 
-func (r *Reconciler) markUpgrade(ctx context.Context, tc *v1alpha1.TektonConfig) error {
+func (r *Reconciler) markUpgrade(ctx context.Context, original, tc *v1alpha1.TektonConfig) error {
 	labels := tc.GetLabels()
 	ver, ok := labels[v1alpha1.ReleaseVersionKey]
 	if ok && ver == r.operatorVersion {
@@ -421,9 +630,11 @@ func (r *Reconciler) markUpgrade(ctx context.Context, tc *v1alpha1.TektonConfig)
 	}
 	labels[v1alpha1.ReleaseVersionKey] = r.operatorVersion
 	tc.SetLabels(labels)
+	original.SetLabels(labels)
 
-	// Update the object for any spec changes
-	if _, err := r.operatorClientSet.OperatorV1alpha1().TektonConfigs().Update(ctx, tc, metav1.UpdateOptions{}); err != nil {
+	// Update the object for any spec changes, the original object is updated as the spec of the
+	// resolved one holds the values of spec.paramsFrom
+	if _, err := r.operatorClientSet.OperatorV1alpha1().TektonConfigs().Update(ctx, original, metav1.UpdateOptions{}); err != nil {
 		return err
 	}
 	return v1alpha1.RECONCILE_AGAIN_ERR
@@ -0,0 +1,232 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tlspolicy
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"knative.dev/pkg/logging"
+)
+
+const (
+	reasonNotCompliant = "NotCompliant"
+	reasonProbeFailed  = "ProbeFailed"
+
+	dialTimeout = 5 * time.Second
+)
+
+// endpoint is a TLS endpoint of the components
+type endpoint struct {
+	// address is the host and port of the Service
+	address string
+	// cipherSuites is true when the endpoint enforces the cipher suites of the policy, the
+	// webhooks only enforce the minimum TLS version
+	cipherSuites bool
+}
+
+// Prober verifies that the webhooks and the metrics TLS proxies of the components reject the TLS
+// versions and cipher suites excluded by the TLS policy of the TektonConfig
+type Prober struct {
+	kubeClientSet kubernetes.Interface
+	// handshake returns true when the endpoint completes a handshake with the TLS config, and an
+	// error when the endpoint cannot be reached, it is replaced in the tests
+	handshake func(ctx context.Context, address string, config *tls.Config) (bool, error)
+}
+
+func New(kubeClientSet kubernetes.Interface) *Prober {
+	return &Prober{kubeClientSet: kubeClientSet, handshake: handshake}
+}
+
+func handshake(ctx context.Context, address string, config *tls.Config) (bool, error) {
+	dialer := &net.Dialer{Timeout: dialTimeout}
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return false, err
+	}
+	defer conn.Close()
+	if err := conn.SetDeadline(time.Now().Add(dialTimeout)); err != nil {
+		return false, err
+	}
+	// a handshake error means the endpoint rejected the TLS version or the cipher suites
+	return tls.Client(conn, config).HandshakeContext(ctx) == nil, nil
+}
+
+// Reconcile probes the endpoints of the components in the target namespace and reports the
+// endpoints accepting the TLS versions or cipher suites excluded by the policy in the
+// TLSPolicyCompliant condition. It does not fail the reconcile.
+func (p *Prober) Reconcile(ctx context.Context, tc *v1alpha1.TektonConfig) {
+	logger := logging.FromContext(ctx).Named("tls-policy")
+	if tc.Spec.Security == nil || tc.Spec.Security.TLS == nil {
+		tc.Status.ClearTLSPolicyCompliant()
+		return
+	}
+	policy := tc.Spec.Security.TLS
+
+	endpoints, err := p.endpoints(ctx, tc.Spec.GetTargetNamespace())
+	if err != nil {
+		logger.Warnw("Failed to list the TLS endpoints of the components", "error", err)
+		tc.Status.MarkTLSPolicyNotCompliant(reasonProbeFailed, fmt.Sprintf("failed to list the TLS endpoints: %v", err))
+		return
+	}
+	var notCompliant, unreachable []string
+	for _, e := range endpoints {
+		violations, err := p.probe(ctx, policy, e)
+		if err != nil {
+			logger.Debugw("Failed to probe the TLS endpoint", "address", e.address, "error", err)
+			unreachable = append(unreachable, e.address)
+			continue
+		}
+		if len(violations) > 0 {
+			logger.Warnw("TLS endpoint does not comply with the TLS policy", "address", e.address, "accepts", violations)
+			notCompliant = append(notCompliant, fmt.Sprintf("%s accepts %s", e.address, strings.Join(violations, " and ")))
+		}
+	}
+	switch {
+	case len(notCompliant) > 0:
+		tc.Status.MarkTLSPolicyNotCompliant(reasonNotCompliant, strings.Join(notCompliant, ", "))
+	case len(unreachable) > 0:
+		tc.Status.MarkTLSPolicyNotCompliant(reasonProbeFailed, "failed to reach "+strings.Join(unreachable, ", "))
+	default:
+		tc.Status.MarkTLSPolicyCompliant()
+	}
+}
+
+// probe returns the TLS versions and cipher suites excluded by the policy which are accepted by
+// the endpoint
+func (p *Prober) probe(ctx context.Context, policy *v1alpha1.TLSPolicy, e endpoint) ([]string, error) {
+	var violations []string
+	if policy.MinVersion != "" {
+		// the handshake only offers the versions below the minimum version
+		maxVersion := common.TLSVersion(policy.MinVersion) - 1
+		//nolint:gosec // the probe verifies the TLS versions accepted by the endpoint, not its certificate
+		accepted, err := p.handshake(ctx, e.address, &tls.Config{InsecureSkipVerify: true, MinVersion: tls.VersionTLS10, MaxVersion: maxVersion})
+		if err != nil {
+			return nil, err
+		}
+		if accepted {
+			violations = append(violations, "TLS versions below "+policy.MinVersion)
+		}
+	}
+	if excluded := excludedCipherSuites(policy.CipherSuites); e.cipherSuites && len(excluded) > 0 {
+		//nolint:gosec // the probe verifies the cipher suites accepted by the endpoint, not its certificate
+		accepted, err := p.handshake(ctx, e.address, &tls.Config{InsecureSkipVerify: true, MinVersion: tls.VersionTLS12, MaxVersion: tls.VersionTLS12, CipherSuites: excluded})
+		if err != nil {
+			return nil, err
+		}
+		if accepted {
+			violations = append(violations, "cipher suites excluded by the policy")
+		}
+	}
+	return violations, nil
+}
+
+// excludedCipherSuites returns the secure TLS 1.2 cipher suites which are not in the allowed
+// cipher suites, there are none when the cipher suites are not configured
+func excludedCipherSuites(allowed []string) []uint16 {
+	if len(allowed) == 0 {
+		return nil
+	}
+	names := map[string]bool{}
+	for _, name := range allowed {
+		names[name] = true
+	}
+	var excluded []uint16
+	for _, c := range tls.CipherSuites() {
+		for _, v := range c.SupportedVersions {
+			if v == tls.VersionTLS12 && !names[c.Name] {
+				excluded = append(excluded, c.ID)
+				break
+			}
+		}
+	}
+	return excluded
+}
+
+// endpoints returns the Services of the webhooks and of the metrics served over TLS in the target
+// namespace
+func (p *Prober) endpoints(ctx context.Context, namespace string) ([]endpoint, error) {
+	addresses := map[string]endpoint{}
+	addWebhook := func(cc admissionregistrationv1.WebhookClientConfig) {
+		if cc.Service == nil || cc.Service.Namespace != namespace {
+			return
+		}
+		port := int32(443)
+		if cc.Service.Port != nil {
+			port = *cc.Service.Port
+		}
+		address := serviceAddress(cc.Service.Name, namespace, port)
+		addresses[address] = endpoint{address: address}
+	}
+
+	admission := p.kubeClientSet.AdmissionregistrationV1()
+	validating, err := admission.ValidatingWebhookConfigurations().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, wc := range validating.Items {
+		for _, w := range wc.Webhooks {
+			addWebhook(w.ClientConfig)
+		}
+	}
+	mutating, err := admission.MutatingWebhookConfigurations().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, wc := range mutating.Items {
+		for _, w := range wc.Webhooks {
+			addWebhook(w.ClientConfig)
+		}
+	}
+
+	services, err := p.kubeClientSet.CoreV1().Services(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, svc := range services.Items {
+		if _, ok := svc.Annotations[common.MetricsTLSSecretAnnotation]; !ok {
+			continue
+		}
+		for _, port := range svc.Spec.Ports {
+			if port.Name == common.MetricsTLSPortName {
+				address := serviceAddress(svc.Name, namespace, port.Port)
+				addresses[address] = endpoint{address: address, cipherSuites: true}
+			}
+		}
+	}
+
+	endpoints := make([]endpoint, 0, len(addresses))
+	for _, e := range addresses {
+		endpoints = append(endpoints, e)
+	}
+	sort.Slice(endpoints, func(i, j int) bool { return endpoints[i].address < endpoints[j].address })
+	return endpoints, nil
+}
+
+func serviceAddress(name, namespace string, port int32) string {
+	return net.JoinHostPort(fmt.Sprintf("%s.%s.svc", name, namespace), fmt.Sprint(port))
+}
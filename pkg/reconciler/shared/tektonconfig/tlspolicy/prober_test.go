@@ -0,0 +1,128 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tlspolicy
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"testing"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
+	"gotest.tools/v3/assert"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestReconcile(t *testing.T) {
+	webhookPort := int32(8443)
+	kubeClient := fake.NewSimpleClientset(
+		&admissionregistrationv1.ValidatingWebhookConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Name: "validation.webhook.pipeline.tekton.dev"},
+			Webhooks: []admissionregistrationv1.ValidatingWebhook{{
+				Name:         "validation.webhook.pipeline.tekton.dev",
+				ClientConfig: admissionregistrationv1.WebhookClientConfig{Service: &admissionregistrationv1.ServiceReference{Name: "tekton-pipelines-webhook", Namespace: "tekton-pipelines"}},
+			}},
+		},
+		&admissionregistrationv1.MutatingWebhookConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Name: "webhook.other.dev"},
+			Webhooks: []admissionregistrationv1.MutatingWebhook{{
+				Name:         "webhook.other.dev",
+				ClientConfig: admissionregistrationv1.WebhookClientConfig{Service: &admissionregistrationv1.ServiceReference{Name: "other", Namespace: "other", Port: &webhookPort}},
+			}},
+		},
+		&corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "tekton-pipelines-controller", Namespace: "tekton-pipelines",
+				Annotations: map[string]string{common.MetricsTLSSecretAnnotation: "tekton-pipelines-controller-metrics-tls"}},
+			Spec: corev1.ServiceSpec{Ports: []corev1.ServicePort{{Name: common.MetricsTLSPortName, Port: 9443}}},
+		},
+		&corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "tekton-pipelines-remote-resolvers", Namespace: "tekton-pipelines"},
+			Spec:       corev1.ServiceSpec{Ports: []corev1.ServicePort{{Name: "http-metrics", Port: 9090}}},
+		},
+	)
+
+	tests := []struct {
+		name       string
+		accepts    func(address string, config *tls.Config) (bool, error)
+		wantStatus corev1.ConditionStatus
+		wantReason string
+		wantMsg    string
+	}{{
+		name:       "compliant",
+		accepts:    func(string, *tls.Config) (bool, error) { return false, nil },
+		wantStatus: corev1.ConditionTrue,
+	}, {
+		name: "older TLS version and excluded cipher suites accepted",
+		accepts: func(address string, config *tls.Config) (bool, error) {
+			// the webhook is not probed for the cipher suites
+			if address == "tekton-pipelines-webhook.tekton-pipelines.svc:443" {
+				assert.Assert(t, config.CipherSuites == nil)
+			}
+			return address == "tekton-pipelines-controller.tekton-pipelines.svc:9443", nil
+		},
+		wantStatus: corev1.ConditionFalse,
+		wantReason: "NotCompliant",
+		wantMsg:    "tekton-pipelines-controller.tekton-pipelines.svc:9443 accepts TLS versions below 1.2 and cipher suites excluded by the policy",
+	}, {
+		name:       "unreachable",
+		accepts:    func(string, *tls.Config) (bool, error) { return false, errors.New("connection refused") },
+		wantStatus: corev1.ConditionFalse,
+		wantReason: "ProbeFailed",
+		wantMsg:    "failed to reach tekton-pipelines-controller.tekton-pipelines.svc:9443, tekton-pipelines-webhook.tekton-pipelines.svc:443",
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p := New(kubeClient)
+			p.handshake = func(_ context.Context, address string, config *tls.Config) (bool, error) {
+				return test.accepts(address, config)
+			}
+			tc := &v1alpha1.TektonConfig{Spec: v1alpha1.TektonConfigSpec{
+				CommonSpec: v1alpha1.CommonSpec{TargetNamespace: "tekton-pipelines"},
+				Security: &v1alpha1.Security{TLS: &v1alpha1.TLSPolicy{
+					MinVersion:   v1alpha1.TLSVersion12,
+					CipherSuites: []string{"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256"},
+				}},
+			}}
+			p.Reconcile(context.TODO(), tc)
+			condition := tc.Status.GetCondition(v1alpha1.TLSPolicyCompliant)
+			assert.Assert(t, condition != nil)
+			assert.Equal(t, condition.Status, test.wantStatus)
+			assert.Equal(t, condition.Reason, test.wantReason)
+			assert.Equal(t, condition.Message, test.wantMsg)
+
+			// the condition is removed with the policy
+			tc.Spec.Security = nil
+			p.Reconcile(context.TODO(), tc)
+			assert.Assert(t, tc.Status.GetCondition(v1alpha1.TLSPolicyCompliant) == nil)
+		})
+	}
+}
+
+func TestExcludedCipherSuites(t *testing.T) {
+	assert.Assert(t, excludedCipherSuites(nil) == nil)
+	excluded := excludedCipherSuites([]string{"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256"})
+	assert.Assert(t, len(excluded) > 0)
+	for _, id := range excluded {
+		assert.Assert(t, id != tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256)
+		// the cipher suites of TLS 1.3 cannot be offered
+		assert.Assert(t, id != tls.TLS_AES_128_GCM_SHA256)
+	}
+}
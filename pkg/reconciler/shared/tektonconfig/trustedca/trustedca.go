@@ -0,0 +1,271 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trustedca
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+
+	mf "github.com/manifestival/manifestival"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	"knative.dev/pkg/logging"
+)
+
+const (
+	bundleGroupVersion = "trust.cert-manager.io/v1alpha1"
+	bundleKind         = "Bundle"
+)
+
+// TrustedCA distributes the trusted CA certificates configured in TektonConfig to the
+// config-trusted-cabundle ConfigMap of the namespaces on Kubernetes, either by copying them
+// from a ConfigMap of the operator namespace or through a trust-manager Bundle
+type TrustedCA struct {
+	kubeClientSet kubernetes.Interface
+	// mfClient applies the trust-manager Bundle
+	mfClient mf.Client
+	// namespace of the operator, holding the source ConfigMap
+	namespace string
+}
+
+func New(kubeClientSet kubernetes.Interface, mfClient mf.Client, namespace string) *TrustedCA {
+	return &TrustedCA{kubeClientSet: kubeClientSet, mfClient: mfClient, namespace: namespace}
+}
+
+// Reconcile distributes the certificates when they are enabled, it does nothing on OpenShift
+// where the ConfigMaps are injected by the platform. The trust-manager Bundle is deleted when
+// the TrustManager source is no longer used, the ConfigMaps copied by the operator are kept.
+func (t *TrustedCA) Reconcile(ctx context.Context, tc *v1alpha1.TektonConfig) error {
+	if v1alpha1.IsOpenShiftPlatform() {
+		return nil
+	}
+	spec := tc.Spec.TrustedCA
+	enabled := spec != nil && spec.Enable
+	trustManager := enabled && spec.GetSource() == v1alpha1.TrustedCASourceTrustManager
+	if err := t.reconcileBundle(ctx, spec, trustManager); err != nil {
+		return err
+	}
+	if !enabled || trustManager {
+		return nil
+	}
+	return t.copyCertificates(ctx, spec)
+}
+
+// copyCertificates creates the config-trusted-cabundle ConfigMap in the selected namespaces, and
+// writes the certificates of the source ConfigMap to all the ConfigMaps labeled with
+// config.openshift.io/inject-trusted-cabundle. Existing ConfigMaps without the label are kept as they are.
+// The namespaces opting out of the CA bundle ConfigMaps are skipped, the extra certificates of a
+// namespace are appended to its config-trusted-cabundle ConfigMap.
+func (t *TrustedCA) copyCertificates(ctx context.Context, spec *v1alpha1.TrustedCA) error {
+	logger := logging.FromContext(ctx).Named("trustedca")
+	source, err := t.kubeClientSet.CoreV1().ConfigMaps(t.namespace).Get(ctx, spec.ConfigMap, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get the trusted CA ConfigMap %s/%s: %w", t.namespace, spec.ConfigMap, err)
+	}
+	certificates, ok := source.Data[spec.GetKey()]
+	if !ok {
+		return fmt.Errorf("trusted CA ConfigMap %s/%s has no key %s", t.namespace, spec.ConfigMap, spec.GetKey())
+	}
+	selector, err := namespaceSelector(spec)
+	if err != nil {
+		return err
+	}
+
+	namespaces, err := t.kubeClientSet.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	var errs []error
+	byName := map[string]*corev1.Namespace{}
+	ignorePattern := regexp.MustCompile(common.NamespaceIgnorePattern)
+	for i := range namespaces.Items {
+		ns := &namespaces.Items[i]
+		byName[ns.Name] = ns
+		if ignorePattern.MatchString(ns.Name) || ns.Status.Phase == corev1.NamespaceTerminating ||
+			common.CABundleOptedOut(ns) || !selector.Matches(labels.Set(ns.Labels)) {
+			continue
+		}
+		err := t.ensureConfigMap(ctx, ns.Name, certificates)
+		if common.NamespaceGoingAway(ctx, t.kubeClientSet, ns.Name, err) {
+			logger.Infof("namespace %s is terminating, skipping configmap %s", ns.Name, common.TrustedCAConfigMapName)
+			continue
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to create configmap %s/%s: %w", ns.Name, common.TrustedCAConfigMapName, err))
+		}
+	}
+
+	injected, err := t.kubeClientSet.CoreV1().ConfigMaps(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.Set{v1alpha1.TrustedCAInjectionLabel: "true"}.String(),
+	})
+	if err != nil {
+		return errors.Join(append(errs, err)...)
+	}
+	for _, cm := range injected.Items {
+		want := certificates
+		// the config-trusted-cabundle ConfigMap of a namespace holds the extra certificates of the namespace
+		if ns := byName[cm.Namespace]; ns != nil && cm.Name == common.TrustedCAConfigMapName {
+			if common.CABundleOptedOut(ns) {
+				continue
+			}
+			extra, err := common.ExtraCertificates(ctx, t.kubeClientSet.CoreV1(), ns)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			want = common.AppendCertificates(certificates, extra)
+		}
+		if cm.Data[common.TrustedCAKey] == want {
+			continue
+		}
+		logger.Infof("injecting the trusted CA certificates in configmap %s/%s", cm.Namespace, cm.Name)
+		cm := cm.DeepCopy()
+		if cm.Data == nil {
+			cm.Data = map[string]string{}
+		}
+		cm.Data[common.TrustedCAKey] = want
+		if _, err := t.kubeClientSet.CoreV1().ConfigMaps(cm.Namespace).Update(ctx, cm, metav1.UpdateOptions{}); err != nil {
+			errs = append(errs, fmt.Errorf("failed to inject the trusted CA certificates in configmap %s/%s: %w", cm.Namespace, cm.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ensureConfigMap creates the config-trusted-cabundle ConfigMap with the injection label, an
+// existing ConfigMap is left to the injection of the labeled ConfigMaps
+func (t *TrustedCA) ensureConfigMap(ctx context.Context, namespace, certificates string) error {
+	cmClient := t.kubeClientSet.CoreV1().ConfigMaps(namespace)
+	if _, err := cmClient.Get(ctx, common.TrustedCAConfigMapName, metav1.GetOptions{}); err == nil || !apierrors.IsNotFound(err) {
+		return err
+	}
+	logging.FromContext(ctx).Infof("creating configmap %s/%s with the trusted CA certificates", namespace, common.TrustedCAConfigMapName)
+	_, err := cmClient.Create(ctx, &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      common.TrustedCAConfigMapName,
+			Namespace: namespace,
+			Labels: map[string]string{
+				"app.kubernetes.io/part-of":      "tekton-pipelines",
+				v1alpha1.TrustedCAInjectionLabel: "true",
+			},
+			// No OwnerReferences, the ConfigMap is mounted by the workloads of the namespace
+		},
+		Data: map[string]string{common.TrustedCAKey: certificates},
+	}, metav1.CreateOptions{})
+	if apierrors.IsAlreadyExists(err) {
+		return nil
+	}
+	return err
+}
+
+// reconcileBundle applies the trust-manager Bundle writing the certificates to the
+// config-trusted-cabundle ConfigMap of the selected namespaces, or deletes it when it is not
+// wanted. An error is returned when it is wanted on a cluster without trust-manager.
+func (t *TrustedCA) reconcileBundle(ctx context.Context, spec *v1alpha1.TrustedCA, want bool) error {
+	served, err := t.bundleServed()
+	if err != nil {
+		return err
+	}
+	if !served {
+		if want {
+			return fmt.Errorf("the trusted CA source is %s but %s %s is not served by the cluster",
+				v1alpha1.TrustedCASourceTrustManager, bundleGroupVersion, bundleKind)
+		}
+		return nil
+	}
+	bundle, err := makeBundle(spec)
+	if err != nil {
+		return err
+	}
+	manifest, err := mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{*bundle}), mf.UseClient(t.mfClient))
+	if err != nil {
+		return err
+	}
+	if !want {
+		return manifest.Delete(mf.IgnoreNotFound(true))
+	}
+	logging.FromContext(ctx).Infof("applying the trust-manager bundle %s", common.TrustedCAConfigMapName)
+	return manifest.Apply()
+}
+
+func (t *TrustedCA) bundleServed() (bool, error) {
+	resources, err := t.kubeClientSet.Discovery().ServerResourcesForGroupVersion(bundleGroupVersion)
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	for _, r := range resources.APIResources {
+		if r.Kind == bundleKind {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// makeBundle returns the trust-manager Bundle, trust-manager names the target ConfigMaps after it
+func makeBundle(spec *v1alpha1.TrustedCA) (*unstructured.Unstructured, error) {
+	bundle := &unstructured.Unstructured{}
+	bundle.SetAPIVersion(bundleGroupVersion)
+	bundle.SetKind(bundleKind)
+	bundle.SetName(common.TrustedCAConfigMapName)
+	bundle.SetLabels(map[string]string{"app.kubernetes.io/part-of": "tekton-pipelines"})
+	if spec == nil {
+		return bundle, nil
+	}
+
+	sources := []interface{}{
+		map[string]interface{}{
+			"configMap": map[string]interface{}{"name": spec.ConfigMap, "key": spec.GetKey()},
+		},
+	}
+	if spec.UseDefaultCAs {
+		sources = append(sources, map[string]interface{}{"useDefaultCAs": true})
+	}
+	target := map[string]interface{}{
+		"configMap": map[string]interface{}{"key": common.TrustedCAKey},
+	}
+	if spec.NamespaceSelector != nil {
+		selector, err := runtime.DefaultUnstructuredConverter.ToUnstructured(spec.NamespaceSelector)
+		if err != nil {
+			return nil, err
+		}
+		target["namespaceSelector"] = selector
+	}
+	bundle.Object["spec"] = map[string]interface{}{"sources": sources, "target": target}
+	return bundle, nil
+}
+
+func namespaceSelector(spec *v1alpha1.TrustedCA) (labels.Selector, error) {
+	if spec.NamespaceSelector == nil {
+		return labels.Everything(), nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(spec.NamespaceSelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid namespace selector of the trusted CA: %w", err)
+	}
+	return selector, nil
+}
@@ -0,0 +1,210 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trustedca
+
+import (
+	"context"
+	"testing"
+
+	mffake "github.com/manifestival/manifestival/fake"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+const (
+	operatorNamespace = "tekton-operator"
+	certificates      = "-----BEGIN CERTIFICATE-----\ncorporate\n-----END CERTIFICATE-----\n"
+)
+
+func namespace(name string, labels map[string]string) *corev1.Namespace {
+	return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}
+}
+
+func trustedCAConfig(spec *v1alpha1.TrustedCA) *v1alpha1.TektonConfig {
+	return &v1alpha1.TektonConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.ConfigResourceName},
+		Spec:       v1alpha1.TektonConfigSpec{TrustedCA: spec},
+	}
+}
+
+func serveBundles(kubeClient *fake.Clientset) {
+	kubeClient.Discovery().(*fakediscovery.FakeDiscovery).Resources = []*metav1.APIResourceList{{
+		GroupVersion: bundleGroupVersion,
+		APIResources: []metav1.APIResource{{Name: "bundles", Kind: bundleKind}},
+	}}
+}
+
+func bundle(t *testing.T, client mffake.Client) *unstructured.Unstructured {
+	t.Helper()
+	u := &unstructured.Unstructured{}
+	u.SetAPIVersion(bundleGroupVersion)
+	u.SetKind(bundleKind)
+	u.SetName(common.TrustedCAConfigMapName)
+	got, err := client.Get(u)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	assert.NilError(t, err)
+	return got
+}
+
+func TestCopyCertificates(t *testing.T) {
+	ctx := context.TODO()
+	kubeClient := fake.NewSimpleClientset(
+		namespace("team-a", map[string]string{"ca": "corporate"}),
+		namespace("team-b", nil),
+		namespace("kube-system", map[string]string{"ca": "corporate"}),
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "corporate-ca", Namespace: operatorNamespace},
+			Data:       map[string]string{"ca.crt": certificates},
+		},
+		// a ConfigMap of the user requesting the injection
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "registry-ca", Namespace: "team-b",
+				Labels: map[string]string{v1alpha1.TrustedCAInjectionLabel: "true"}},
+		},
+	)
+	tc := trustedCAConfig(&v1alpha1.TrustedCA{
+		Enable:            true,
+		ConfigMap:         "corporate-ca",
+		Key:               "ca.crt",
+		NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"ca": "corporate"}},
+	})
+
+	assert.NilError(t, New(kubeClient, mffake.New(), operatorNamespace).Reconcile(ctx, tc))
+	cm, err := kubeClient.CoreV1().ConfigMaps("team-a").Get(ctx, common.TrustedCAConfigMapName, metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, cm.Data[common.TrustedCAKey], certificates)
+	assert.Equal(t, cm.Labels[v1alpha1.TrustedCAInjectionLabel], "true")
+	for _, ns := range []string{"team-b", "kube-system"} {
+		_, err = kubeClient.CoreV1().ConfigMaps(ns).Get(ctx, common.TrustedCAConfigMapName, metav1.GetOptions{})
+		assert.Assert(t, apierrors.IsNotFound(err), "unexpected configmap in namespace %s", ns)
+	}
+	cm, err = kubeClient.CoreV1().ConfigMaps("team-b").Get(ctx, "registry-ca", metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, cm.Data[common.TrustedCAKey], certificates)
+
+	// the rotated certificates are written to the existing ConfigMaps
+	rotated := certificates + "-----BEGIN CERTIFICATE-----\nrotated\n-----END CERTIFICATE-----\n"
+	_, err = kubeClient.CoreV1().ConfigMaps(operatorNamespace).Update(ctx, &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "corporate-ca", Namespace: operatorNamespace},
+		Data:       map[string]string{"ca.crt": rotated},
+	}, metav1.UpdateOptions{})
+	assert.NilError(t, err)
+	assert.NilError(t, New(kubeClient, mffake.New(), operatorNamespace).Reconcile(ctx, tc))
+	for _, ref := range [][2]string{{"team-a", common.TrustedCAConfigMapName}, {"team-b", "registry-ca"}} {
+		cm, err = kubeClient.CoreV1().ConfigMaps(ref[0]).Get(ctx, ref[1], metav1.GetOptions{})
+		assert.NilError(t, err)
+		assert.Equal(t, cm.Data[common.TrustedCAKey], rotated)
+	}
+}
+
+func TestCopyCertificatesMissingKey(t *testing.T) {
+	kubeClient := fake.NewSimpleClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "corporate-ca", Namespace: operatorNamespace},
+	})
+	tc := trustedCAConfig(&v1alpha1.TrustedCA{Enable: true, ConfigMap: "corporate-ca"})
+	err := New(kubeClient, mffake.New(), operatorNamespace).Reconcile(context.TODO(), tc)
+	assert.ErrorContains(t, err, "trusted CA ConfigMap tekton-operator/corporate-ca has no key ca-bundle.crt")
+}
+
+func TestTrustManagerBundle(t *testing.T) {
+	ctx := context.TODO()
+	kubeClient := fake.NewSimpleClientset(namespace("team-a", nil))
+	mfClient := mffake.New()
+	tc := trustedCAConfig(&v1alpha1.TrustedCA{
+		Enable:            true,
+		Source:            v1alpha1.TrustedCASourceTrustManager,
+		ConfigMap:         "corporate-ca",
+		UseDefaultCAs:     true,
+		NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"ca": "corporate"}},
+	})
+
+	// trust-manager is not installed
+	err := New(kubeClient, mfClient, operatorNamespace).Reconcile(ctx, tc)
+	assert.ErrorContains(t, err, "trust.cert-manager.io/v1alpha1 Bundle is not served by the cluster")
+
+	serveBundles(kubeClient)
+	assert.NilError(t, New(kubeClient, mfClient, operatorNamespace).Reconcile(ctx, tc))
+	b := bundle(t, mfClient)
+	assert.Assert(t, b != nil)
+	sources, _, _ := unstructured.NestedSlice(b.Object, "spec", "sources")
+	assert.DeepEqual(t, sources, []interface{}{
+		map[string]interface{}{"configMap": map[string]interface{}{"name": "corporate-ca", "key": v1alpha1.DefaultTrustedCAKey}},
+		map[string]interface{}{"useDefaultCAs": true},
+	})
+	key, _, _ := unstructured.NestedString(b.Object, "spec", "target", "configMap", "key")
+	assert.Equal(t, key, common.TrustedCAKey)
+	selector, _, _ := unstructured.NestedStringMap(b.Object, "spec", "target", "namespaceSelector", "matchLabels")
+	assert.DeepEqual(t, selector, map[string]string{"ca": "corporate"})
+	// trust-manager writes the ConfigMaps
+	_, err = kubeClient.CoreV1().ConfigMaps("team-a").Get(ctx, common.TrustedCAConfigMapName, metav1.GetOptions{})
+	assert.Assert(t, apierrors.IsNotFound(err))
+
+	// the bundle is deleted when the certificates are no longer distributed
+	tc.Spec.TrustedCA.Enable = false
+	assert.NilError(t, New(kubeClient, mfClient, operatorNamespace).Reconcile(ctx, tc))
+	assert.Assert(t, bundle(t, mfClient) == nil)
+}
+
+func TestTrustedCAOnOpenShift(t *testing.T) {
+	t.Setenv("PLATFORM", "openshift")
+	kubeClient := fake.NewSimpleClientset(namespace("team-a", nil))
+	tc := trustedCAConfig(&v1alpha1.TrustedCA{Enable: true, ConfigMap: "corporate-ca"})
+	assert.NilError(t, New(kubeClient, mffake.New(), operatorNamespace).Reconcile(context.TODO(), tc))
+	_, err := kubeClient.CoreV1().ConfigMaps("team-a").Get(context.TODO(), common.TrustedCAConfigMapName, metav1.GetOptions{})
+	assert.Assert(t, apierrors.IsNotFound(err))
+}
+
+func TestCopyCertificatesNamespaceAnnotations(t *testing.T) {
+	ctx := context.TODO()
+	extra := "-----BEGIN CERTIFICATE-----\nteam-b\n-----END CERTIFICATE-----\n"
+	optedOut := namespace("team-a", nil)
+	optedOut.Annotations = map[string]string{v1alpha1.NamespaceCABundleOptOutAnnotation: "true"}
+	withExtra := namespace("team-b", nil)
+	withExtra.Annotations = map[string]string{v1alpha1.NamespaceExtraCAAnnotation: "team-b-ca"}
+	kubeClient := fake.NewSimpleClientset(optedOut, withExtra,
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "corporate-ca", Namespace: operatorNamespace},
+			Data:       map[string]string{v1alpha1.DefaultTrustedCAKey: certificates},
+		},
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "team-b-ca", Namespace: "team-b"},
+			Data:       map[string]string{"ca.crt": extra},
+		},
+	)
+	tc := trustedCAConfig(&v1alpha1.TrustedCA{Enable: true, ConfigMap: "corporate-ca"})
+
+	assert.NilError(t, New(kubeClient, mffake.New(), operatorNamespace).Reconcile(ctx, tc))
+	_, err := kubeClient.CoreV1().ConfigMaps("team-a").Get(ctx, common.TrustedCAConfigMapName, metav1.GetOptions{})
+	assert.Assert(t, apierrors.IsNotFound(err))
+	cm, err := kubeClient.CoreV1().ConfigMaps("team-b").Get(ctx, common.TrustedCAConfigMapName, metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, cm.Data[common.TrustedCAKey], certificates+extra)
+
+	// the extra ConfigMap is missing
+	assert.NilError(t, kubeClient.CoreV1().ConfigMaps("team-b").Delete(ctx, "team-b-ca", metav1.DeleteOptions{}))
+	err = New(kubeClient, mffake.New(), operatorNamespace).Reconcile(ctx, tc)
+	assert.ErrorContains(t, err, "failed to get the extra CA ConfigMap team-b/team-b-ca")
+}
@@ -21,6 +21,7 @@ import (
 
 	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
 	"github.com/tektoncd/operator/pkg/client/clientset/versioned"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/handover"
 	"go.uber.org/zap"
 	apierrs "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -58,6 +59,7 @@ type Upgrade struct {
 	k8sClient       kubernetes.Interface
 	operatorClient  versioned.Interface
 	restConfig      *rest.Config
+	handover        *handover.Handover
 }
 
 func New(operatorVersion string, k8sClient kubernetes.Interface, operatorClient versioned.Interface, restConfig *rest.Config) *Upgrade {
@@ -69,6 +71,13 @@ func New(operatorVersion string, k8sClient kubernetes.Interface, operatorClient
 	}
 }
 
+// WithHandover claims the handover Lease before the upgrade functions are executed, so that the
+// replicas of the previous version of the operator stop reconciling before the migrations start
+func (ug *Upgrade) WithHandover(h *handover.Handover) *Upgrade {
+	ug.handover = h
+	return ug
+}
+
 func (ug *Upgrade) RunPreUpgrade(ctx context.Context) error {
 	return ug.executeUpgrade(ctx, preUpgradeFunctions, true)
 }
@@ -90,6 +99,17 @@ func (ug *Upgrade) executeUpgrade(ctx context.Context, upgradeFunctions []upgrad
 		return ug.markUpgradeComplete(ctx, isPreUpgrade)
 	}
 
+	// a replica of a newer version of the operator fences this one, the replicas of the previous
+	// version are given the time to observe the handover
+	ready, err := ug.handover.Claim(ctx)
+	if err != nil {
+		return err
+	}
+	if !ready {
+		ug.logger.Info("waiting for the replicas of the previous operator version to observe the handover")
+		return v1alpha1.REQUEUE_EVENT_AFTER
+	}
+
 	if isPreUpgrade {
 		if err := ug.markUpgradeFalse(ctx, isPreUpgrade, "Performing PreUpgrade", "Pre upgrade is in progress"); err != nil {
 			return err
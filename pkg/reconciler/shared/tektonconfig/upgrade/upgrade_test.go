@@ -4,12 +4,15 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
 	"github.com/tektoncd/operator/pkg/client/clientset/versioned"
 	operatorFake "github.com/tektoncd/operator/pkg/client/clientset/versioned/fake"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/handover"
 	"go.uber.org/zap"
+	coordinationv1 "k8s.io/api/coordination/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 	k8sFake "k8s.io/client-go/kubernetes/fake"
@@ -273,6 +276,51 @@ func TestRunPostUpgrade(t *testing.T) {
 	assert.Equal(t, operatorVersion, tc.Status.GetPostUpgradeVersion())
 }
 
+func TestRunPreUpgradeWithHandover(t *testing.T) {
+	operatorVersion := "0.68.0"
+	ctx := context.TODO()
+	ug := getUpgradeStructWithFakeClients(ctx, operatorVersion)
+	tc := &v1alpha1.TektonConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: v1alpha1.ConfigResourceName,
+		},
+	}
+	_, err := ug.operatorClient.OperatorV1alpha1().TektonConfigs().Create(ctx, tc, metav1.CreateOptions{})
+	assert.NoError(t, err)
+	executed := false
+	preUpgradeFunctions = []upgradeFunc{
+		func(ctx context.Context, logger *zap.SugaredLogger, k8sClient kubernetes.Interface, operatorClient versioned.Interface, restConfig *rest.Config) error {
+			executed = true
+			return nil
+		},
+	}
+
+	// the handover lease is claimed and renewed by a newer operator, the upgrade is refused
+	renewed := metav1.NewMicroTime(time.Now())
+	lease := &coordinationv1.Lease{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        handover.LeaseName,
+			Namespace:   "tekton-operator",
+			Annotations: map[string]string{handover.VersionAnnotation: "0.69.0"},
+		},
+		Spec: coordinationv1.LeaseSpec{AcquireTime: &renewed, RenewTime: &renewed},
+	}
+	_, err = ug.k8sClient.CoordinationV1().Leases(lease.Namespace).Create(ctx, lease, metav1.CreateOptions{})
+	assert.NoError(t, err)
+	ug.WithHandover(handover.New(ug.k8sClient, lease.Namespace, "operator", operatorVersion))
+	err = ug.RunPreUpgrade(ctx)
+	assert.ErrorContains(t, err, "fenced")
+	assert.False(t, executed)
+
+	// the handover lease is claimed from an older operator, the upgrade waits for its replicas
+	lease.Annotations[handover.VersionAnnotation] = "0.67.0"
+	_, err = ug.k8sClient.CoordinationV1().Leases(lease.Namespace).Update(ctx, lease, metav1.UpdateOptions{})
+	assert.NoError(t, err)
+	err = ug.RunPreUpgrade(ctx)
+	assert.Equal(t, v1alpha1.REQUEUE_EVENT_AFTER, err)
+	assert.False(t, executed)
+}
+
 func getUpgradeStructWithFakeClients(ctx context.Context, operatorVersion string) *Upgrade {
 	operatorClient := operatorFake.NewSimpleClientset()
 	k8sClient := k8sFake.NewSimpleClientset()
@@ -0,0 +1,320 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vulnerability
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	mf "github.com/manifestival/manifestival"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"knative.dev/pkg/logging"
+)
+
+const (
+	// ReportConfigMapName is the ConfigMap in the target namespace holding the findings of the gate
+	ReportConfigMapName = "tekton-vulnerability-report"
+	// ReportKey holds the findings of each payload image, as a JSON list
+	ReportKey = "report.json"
+
+	// credentialsTokenKey is the key of the bearer token in the credentials secret
+	credentialsTokenKey = "token"
+	// rescanInterval is the time after which an image is scanned again, as new
+	// vulnerabilities are published for the packages of the image
+	rescanInterval = 6 * time.Hour
+	// imageEnvPrefix is the prefix of the environment variables overriding the payload images
+	imageEnvPrefix = "IMAGE_"
+
+	statusScanned = "scanned"
+	statusSkipped = "skipped"
+	statusFailed  = "failed"
+)
+
+// ErrBlocked is returned when the gate blocks the install of the components
+var ErrBlocked = errors.New("the vulnerability gate blocked the install of the components")
+
+// ImageReport holds the findings of the scanner for an image, only the vulnerabilities
+// failing the gate are listed, the others are counted by severity
+type ImageReport struct {
+	Image           string          `json:"image"`
+	Status          string          `json:"status"`
+	Message         string          `json:"message,omitempty"`
+	ScannedAt       string          `json:"scannedAt,omitempty"`
+	Counts          map[string]int  `json:"counts,omitempty"`
+	Vulnerabilities []Vulnerability `json:"vulnerabilities,omitempty"`
+}
+
+type scanResult struct {
+	vulnerabilities []Vulnerability
+	scannedAt       time.Time
+}
+
+// Gate scans the payload images with the scanner configured in TektonConfig before the
+// components are installed, and warns about or blocks the install of images with
+// vulnerabilities of the gate severity or above
+type Gate struct {
+	kubeClientSet kubernetes.Interface
+	httpClient    *http.Client
+	now           func() time.Time
+	// images returns the images of the payload, they do not change while the operator runs
+	images func() ([]string, error)
+
+	mutex sync.Mutex
+	// results of the scans, by scanner endpoint and image
+	results map[string]scanResult
+}
+
+func New(kubeClientSet kubernetes.Interface) *Gate {
+	return &Gate{
+		kubeClientSet: kubeClientSet,
+		httpClient:    &http.Client{Timeout: 30 * time.Second},
+		now:           time.Now,
+		images:        sync.OnceValues(payloadImages),
+		results:       map[string]scanResult{},
+	}
+}
+
+// Reconcile scans the payload images and writes the findings to the report ConfigMap. The
+// result is reported in the VulnerabilityGatePassed condition, and when the action of the
+// gate is block an error wrapping ErrBlocked is returned until the gate passes. Images are
+// scanned again once the rescan interval has passed.
+func (g *Gate) Reconcile(ctx context.Context, tc *v1alpha1.TektonConfig) error {
+	logger := logging.FromContext(ctx).Named("vulnerability-gate")
+	gate := tc.Spec.VulnerabilityGate
+	if gate == nil || !gate.Enable {
+		tc.Status.ClearVulnerabilityGate()
+		return nil
+	}
+	targetNamespace := tc.Spec.GetTargetNamespace()
+
+	token, err := g.token(ctx, targetNamespace, gate.CredentialsSecret)
+	if err != nil {
+		return err
+	}
+	s, err := newScanner(gate, token, g.httpClient)
+	if err != nil {
+		return err
+	}
+	images, err := g.images()
+	if err != nil {
+		return fmt.Errorf("failed to read the payload images: %w", err)
+	}
+
+	reports := make([]ImageReport, 0, len(images))
+	var failing, failed []string
+	for _, image := range images {
+		report := g.scan(ctx, gate, s, image)
+		switch {
+		case report.Status == statusFailed:
+			logger.Warnf("image %s could not be scanned: %s", image, report.Message)
+			failed = append(failed, image)
+		case len(report.Vulnerabilities) > 0:
+			failing = append(failing, image)
+		}
+		reports = append(reports, report)
+	}
+	if err := g.writeReport(ctx, tc, reports); err != nil {
+		return err
+	}
+
+	if len(failing) == 0 && len(failed) == 0 {
+		tc.Status.MarkVulnerabilityGatePassed()
+		return nil
+	}
+	var msgs []string
+	if len(failing) > 0 {
+		msgs = append(msgs, fmt.Sprintf("%d payload images have vulnerabilities of severity %s or above: %s",
+			len(failing), gate.GetSeverity(), strings.Join(failing, ", ")))
+	}
+	if len(failed) > 0 {
+		msgs = append(msgs, fmt.Sprintf("%d payload images could not be scanned: %s", len(failed), strings.Join(failed, ", ")))
+	}
+	msg := fmt.Sprintf("%s, see ConfigMap %s/%s", strings.Join(msgs, "; "), targetNamespace, ReportConfigMapName)
+	tc.Status.MarkVulnerabilityGateFailed(msg)
+	if gate.GetAction() == v1alpha1.VulnerabilityGateActionBlock {
+		return fmt.Errorf("%w: %s", ErrBlocked, msg)
+	}
+	logger.Warn(msg)
+	return nil
+}
+
+// scan returns the report of an image, the results of the scanner are reused until the
+// rescan interval has passed
+func (g *Gate) scan(ctx context.Context, gate *v1alpha1.VulnerabilityGate, s scanner, image string) ImageReport {
+	report := ImageReport{Image: image}
+	if !strings.Contains(image, "@sha256:") {
+		report.Status = statusSkipped
+		report.Message = "the image is not pinned by digest"
+		return report
+	}
+
+	key := gate.Scanner + " " + gate.Endpoint + " " + image
+	g.mutex.Lock()
+	result, ok := g.results[key]
+	g.mutex.Unlock()
+	if !ok || g.now().Sub(result.scannedAt) >= rescanInterval {
+		vulnerabilities, err := s.scan(ctx, image)
+		if errors.Is(err, errNotInRegistry) {
+			report.Status = statusSkipped
+			report.Message = err.Error()
+			return report
+		}
+		if err != nil {
+			report.Status = statusFailed
+			report.Message = err.Error()
+			return report
+		}
+		result = scanResult{vulnerabilities: vulnerabilities, scannedAt: g.now()}
+		g.mutex.Lock()
+		g.results[key] = result
+		g.mutex.Unlock()
+	}
+
+	report.Status = statusScanned
+	report.ScannedAt = result.scannedAt.UTC().Format(time.RFC3339)
+	for _, v := range result.vulnerabilities {
+		if gate.Fails(v.Severity) {
+			report.Vulnerabilities = append(report.Vulnerabilities, v)
+			continue
+		}
+		if report.Counts == nil {
+			report.Counts = map[string]int{}
+		}
+		report.Counts[v.Severity]++
+	}
+	sort.Slice(report.Vulnerabilities, func(i, j int) bool {
+		return report.Vulnerabilities[i].ID < report.Vulnerabilities[j].ID
+	})
+	return report
+}
+
+func (g *Gate) token(ctx context.Context, namespace, secretName string) (string, error) {
+	if secretName == "" {
+		return "", nil
+	}
+	secret, err := g.kubeClientSet.CoreV1().Secrets(namespace).Get(ctx, secretName, metav1.GetOptions{})
+	if err != nil {
+		return "", fmt.Errorf("failed to get the credentials of the vulnerability scanner: %w", err)
+	}
+	token := strings.TrimSpace(string(secret.Data[credentialsTokenKey]))
+	if token == "" {
+		return "", fmt.Errorf("secret %s/%s has no %q key", namespace, secretName, credentialsTokenKey)
+	}
+	return token, nil
+}
+
+// writeReport creates or updates the report ConfigMap in the target namespace
+func (g *Gate) writeReport(ctx context.Context, tc *v1alpha1.TektonConfig, reports []ImageReport) error {
+	report, err := json.MarshalIndent(reports, "", "  ")
+	if err != nil {
+		return err
+	}
+	data := map[string]string{ReportKey: string(report)}
+
+	targetNamespace := tc.Spec.GetTargetNamespace()
+	cmClient := g.kubeClientSet.CoreV1().ConfigMaps(targetNamespace)
+	existing, err := cmClient.Get(ctx, ReportConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+		_, err = cmClient.Create(ctx, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            ReportConfigMapName,
+				Namespace:       targetNamespace,
+				OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(tc, tc.GetGroupVersionKind())},
+			},
+			Data: data,
+		}, metav1.CreateOptions{})
+		return err
+	}
+	if reflect.DeepEqual(existing.Data, data) {
+		return nil
+	}
+	existing = existing.DeepCopy()
+	existing.Data = data
+	_, err = cmClient.Update(ctx, existing, metav1.UpdateOptions{})
+	return err
+}
+
+// payloadImages returns the images of the containers in the payload manifests and the
+// images overriding them in the environment of the operator
+func payloadImages() ([]string, error) {
+	manifest, err := mf.ManifestFrom(mf.Recursive(common.ComponentBaseDir()))
+	if err != nil {
+		return nil, err
+	}
+	images := map[string]bool{}
+	for _, u := range manifest.Resources() {
+		collectImages(u.Object, images)
+	}
+	for _, kv := range os.Environ() {
+		key, value, _ := strings.Cut(kv, "=")
+		if strings.HasPrefix(key, imageEnvPrefix) && strings.Contains(value, "/") {
+			images[value] = true
+		}
+	}
+	return sortedKeys(images), nil
+}
+
+// collectImages adds the images of the containers and init containers in the object
+func collectImages(obj interface{}, images map[string]bool) {
+	switch o := obj.(type) {
+	case map[string]interface{}:
+		for key, value := range o {
+			if key == "containers" || key == "initContainers" {
+				containers, _ := value.([]interface{})
+				for _, c := range containers {
+					if container, ok := c.(map[string]interface{}); ok {
+						if image, ok := container["image"].(string); ok && image != "" {
+							images[image] = true
+						}
+					}
+				}
+				continue
+			}
+			collectImages(value, images)
+		}
+	case []interface{}:
+		for _, value := range o {
+			collectImages(value, images)
+		}
+	}
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
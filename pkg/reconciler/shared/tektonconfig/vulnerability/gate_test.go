@@ -0,0 +1,198 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vulnerability
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+const (
+	vulnerableImage = "quay.io/tekton/controller@sha256:1111111111111111111111111111111111111111111111111111111111111111"
+	cleanImage      = "quay.io/tekton/webhook@sha256:2222222222222222222222222222222222222222222222222222222222222222"
+	taggedImage     = "quay.io/tekton/events:v1.0.0"
+)
+
+// genericServer returns the vulnerabilities of the vulnerable image and counts the scans
+func genericServer(t *testing.T, scans *int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*scans++
+		assert.Equal(t, r.Header.Get("Authorization"), "Bearer s3cr3t")
+		body := map[string]string{}
+		assert.NilError(t, json.NewDecoder(r.Body).Decode(&body))
+		vulnerabilities := []Vulnerability{}
+		if body["image"] == vulnerableImage {
+			vulnerabilities = []Vulnerability{
+				{ID: "CVE-2026-0002", Package: "openssl@3.0.1", Severity: "Critical"},
+				{ID: "CVE-2026-0001", Package: "zlib@1.2.11", Severity: "Low"},
+			}
+		}
+		assert.NilError(t, json.NewEncoder(w).Encode(map[string]interface{}{"vulnerabilities": vulnerabilities}))
+	}))
+}
+
+func tektonConfig(gate *v1alpha1.VulnerabilityGate) *v1alpha1.TektonConfig {
+	return &v1alpha1.TektonConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.ConfigResourceName},
+		Spec: v1alpha1.TektonConfigSpec{
+			CommonSpec:        v1alpha1.CommonSpec{TargetNamespace: "tekton-pipelines"},
+			VulnerabilityGate: gate,
+		},
+	}
+}
+
+func TestGateReconcile(t *testing.T) {
+	ctx := context.TODO()
+	scans := 0
+	server := genericServer(t, &scans)
+	defer server.Close()
+
+	kubeClient := fake.NewSimpleClientset(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "scanner", Namespace: "tekton-pipelines"},
+		Data:       map[string][]byte{credentialsTokenKey: []byte("s3cr3t")},
+	})
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	g := New(kubeClient)
+	g.now = func() time.Time { return now }
+	g.images = func() ([]string, error) { return []string{cleanImage, taggedImage, vulnerableImage}, nil }
+
+	gate := &v1alpha1.VulnerabilityGate{
+		Enable:            true,
+		Scanner:           v1alpha1.VulnerabilityScannerGeneric,
+		Endpoint:          server.URL,
+		CredentialsSecret: "scanner",
+	}
+	tc := tektonConfig(gate)
+	assert.NilError(t, g.Reconcile(ctx, tc))
+	condition := tc.Status.GetCondition(v1alpha1.VulnerabilityGatePassed)
+	assert.Equal(t, condition.Status, corev1.ConditionFalse)
+	assert.Assert(t, strings.Contains(condition.Message, "1 payload images have vulnerabilities of severity High or above: "+vulnerableImage))
+	assert.Equal(t, scans, 2)
+
+	cm, err := kubeClient.CoreV1().ConfigMaps("tekton-pipelines").Get(ctx, ReportConfigMapName, metav1.GetOptions{})
+	assert.NilError(t, err)
+	reports := []ImageReport{}
+	assert.NilError(t, json.Unmarshal([]byte(cm.Data[ReportKey]), &reports))
+	assert.DeepEqual(t, reports, []ImageReport{
+		{Image: cleanImage, Status: statusScanned, ScannedAt: "2026-01-01T00:00:00Z"},
+		{Image: taggedImage, Status: statusSkipped, Message: "the image is not pinned by digest"},
+		{
+			Image: vulnerableImage, Status: statusScanned, ScannedAt: "2026-01-01T00:00:00Z",
+			Counts:          map[string]int{"Low": 1},
+			Vulnerabilities: []Vulnerability{{ID: "CVE-2026-0002", Package: "openssl@3.0.1", Severity: "Critical"}},
+		},
+	})
+
+	// blocking gate, the results of the scanner are reused
+	gate.Action = v1alpha1.VulnerabilityGateActionBlock
+	err = g.Reconcile(ctx, tc)
+	assert.Assert(t, errors.Is(err, ErrBlocked))
+	assert.Equal(t, scans, 2)
+
+	// the images are scanned again once the rescan interval has passed
+	now = now.Add(rescanInterval)
+	gate.Severity = "critical"
+	assert.Assert(t, errors.Is(g.Reconcile(ctx, tc), ErrBlocked))
+	assert.Equal(t, scans, 4)
+
+	// images with vulnerabilities below the gate severity pass
+	g.images = func() ([]string, error) { return []string{cleanImage}, nil }
+	assert.NilError(t, g.Reconcile(ctx, tc))
+	assert.Equal(t, tc.Status.GetCondition(v1alpha1.VulnerabilityGatePassed).Status, corev1.ConditionTrue)
+
+	// disabling the gate clears the condition
+	tc.Spec.VulnerabilityGate = nil
+	assert.NilError(t, g.Reconcile(ctx, tc))
+	assert.Assert(t, tc.Status.GetCondition(v1alpha1.VulnerabilityGatePassed) == nil)
+}
+
+func TestGateScannerFailure(t *testing.T) {
+	ctx := context.TODO()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "database is updating", http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	g := New(fake.NewSimpleClientset())
+	g.images = func() ([]string, error) { return []string{cleanImage}, nil }
+	gate := &v1alpha1.VulnerabilityGate{Enable: true, Scanner: v1alpha1.VulnerabilityScannerGeneric, Endpoint: server.URL}
+	tc := tektonConfig(gate)
+
+	// a warning gate does not stop the install when the scanner is unavailable
+	assert.NilError(t, g.Reconcile(ctx, tc))
+	assert.Assert(t, strings.Contains(tc.Status.GetCondition(v1alpha1.VulnerabilityGatePassed).Message,
+		"1 payload images could not be scanned"))
+
+	gate.Action = v1alpha1.VulnerabilityGateActionBlock
+	err := g.Reconcile(ctx, tc)
+	assert.Assert(t, errors.Is(err, ErrBlocked))
+	assert.ErrorContains(t, err, "could not be scanned")
+
+	gate.CredentialsSecret = "missing"
+	assert.ErrorContains(t, g.Reconcile(ctx, tc), "failed to get the credentials of the vulnerability scanner")
+}
+
+func TestQuayScanner(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.URL.Path, "/api/v1/repository/tekton/controller/manifest/sha256:1111111111111111111111111111111111111111111111111111111111111111/security")
+		assert.Equal(t, r.URL.Query().Get("vulnerabilities"), "true")
+		fmt.Fprint(w, `{"status":"scanned","data":{"Layer":{"Features":[
+			{"Name":"openssl","Version":"3.0.1","Vulnerabilities":[{"Name":"CVE-2026-0002","Severity":"Critical"}]},
+			{"Name":"zlib","Version":"1.2.11","Vulnerabilities":[]}]}}}`)
+	}))
+	defer server.Close()
+
+	host := strings.TrimPrefix(server.URL, "http://")
+	s, err := newScanner(&v1alpha1.VulnerabilityGate{Scanner: v1alpha1.VulnerabilityScannerQuay, Endpoint: server.URL}, "", server.Client())
+	assert.NilError(t, err)
+
+	vulnerabilities, err := s.scan(context.TODO(), strings.Replace(vulnerableImage, "quay.io", host, 1))
+	assert.NilError(t, err)
+	assert.DeepEqual(t, vulnerabilities, []Vulnerability{{ID: "CVE-2026-0002", Package: "openssl@3.0.1", Severity: "Critical"}})
+
+	_, err = s.scan(context.TODO(), "ghcr.io/tekton/controller@sha256:1111111111111111111111111111111111111111111111111111111111111111")
+	assert.Assert(t, errors.Is(err, errNotInRegistry))
+}
+
+func TestCollectImages(t *testing.T) {
+	images := map[string]bool{}
+	collectImages(map[string]interface{}{
+		"kind": "CronJob",
+		"spec": map[string]interface{}{
+			"jobTemplate": map[string]interface{}{"spec": map[string]interface{}{"template": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"initContainers": []interface{}{map[string]interface{}{"name": "init", "image": "init:v1"}},
+					"containers":     []interface{}{map[string]interface{}{"name": "main", "image": "main:v1"}},
+				},
+			}}},
+		},
+	}, images)
+	assert.DeepEqual(t, sortedKeys(images), []string{"init:v1", "main:v1"})
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vulnerability
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+)
+
+// Vulnerability is a vulnerability found by the scanner in an image
+type Vulnerability struct {
+	ID       string `json:"id"`
+	Package  string `json:"package,omitempty"`
+	Severity string `json:"severity"`
+}
+
+// scanner returns the vulnerabilities of an image pinned by digest
+type scanner interface {
+	scan(ctx context.Context, image string) ([]Vulnerability, error)
+}
+
+// errNotInRegistry is returned by the scanners which only scan the images of their registry
+var errNotInRegistry = errors.New("the image is not in the registry of the scanner")
+
+func newScanner(gate *v1alpha1.VulnerabilityGate, token string, client *http.Client) (scanner, error) {
+	endpoint, err := url.Parse(gate.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+	switch gate.Scanner {
+	case v1alpha1.VulnerabilityScannerQuay:
+		return &quayScanner{endpoint: endpoint, token: token, client: client}, nil
+	case v1alpha1.VulnerabilityScannerGeneric:
+		return &genericScanner{endpoint: endpoint, token: token, client: client}, nil
+	}
+	return nil, fmt.Errorf("unsupported scanner %q", gate.Scanner)
+}
+
+// quayScanner reads the Clair findings of an image from the security API of Quay
+type quayScanner struct {
+	endpoint *url.URL
+	token    string
+	client   *http.Client
+}
+
+type quaySecurity struct {
+	Status string `json:"status"`
+	Data   struct {
+		Layer struct {
+			Features []struct {
+				Name            string `json:"Name"`
+				Version         string `json:"Version"`
+				Vulnerabilities []struct {
+					Name     string `json:"Name"`
+					Severity string `json:"Severity"`
+				} `json:"Vulnerabilities"`
+			} `json:"Features"`
+		} `json:"Layer"`
+	} `json:"data"`
+}
+
+func (s *quayScanner) scan(ctx context.Context, image string) ([]Vulnerability, error) {
+	name, digest, _ := strings.Cut(image, "@")
+	host, repository, ok := strings.Cut(name, "/")
+	if !ok || host != s.endpoint.Host {
+		return nil, errNotInRegistry
+	}
+	u := s.endpoint.JoinPath("api/v1/repository", repository, "manifest", digest, "security")
+	u.RawQuery = "vulnerabilities=true"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	security := &quaySecurity{}
+	if err := do(s.client, req, s.token, security); err != nil {
+		return nil, err
+	}
+	if security.Status != "scanned" {
+		return nil, fmt.Errorf("the image is not scanned, scan status %q", security.Status)
+	}
+	vulnerabilities := []Vulnerability{}
+	for _, f := range security.Data.Layer.Features {
+		for _, v := range f.Vulnerabilities {
+			vulnerabilities = append(vulnerabilities, Vulnerability{
+				ID:       v.Name,
+				Package:  f.Name + "@" + f.Version,
+				Severity: v.Severity,
+			})
+		}
+	}
+	return vulnerabilities, nil
+}
+
+// genericScanner posts {"image": <image>} to the endpoint, which returns the vulnerabilities
+// of the image as {"vulnerabilities": [{"id": ..., "package": ..., "severity": ...}]}
+type genericScanner struct {
+	endpoint *url.URL
+	token    string
+	client   *http.Client
+}
+
+func (s *genericScanner) scan(ctx context.Context, image string) ([]Vulnerability, error) {
+	body, err := json.Marshal(map[string]string{"image": image})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.endpoint.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	result := &struct {
+		Vulnerabilities []Vulnerability `json:"vulnerabilities"`
+	}{}
+	if err := do(s.client, req, s.token, result); err != nil {
+		return nil, err
+	}
+	return result.Vulnerabilities, nil
+}
+
+func do(client *http.Client, req *http.Request, token string, into interface{}) error {
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("scanner returned %s: %s", resp.Status, strings.TrimSpace(string(msg)))
+	}
+	return json.NewDecoder(resp.Body).Decode(into)
+}
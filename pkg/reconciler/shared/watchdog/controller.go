@@ -0,0 +1,300 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package watchdog
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/client/clientset/versioned"
+	operatorclient "github.com/tektoncd/operator/pkg/client/injection/client"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	kubeclient "knative.dev/pkg/client/injection/kube/client"
+	deploymentinformer "knative.dev/pkg/client/injection/kube/informers/apps/v1/deployment"
+	"knative.dev/pkg/configmap"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/logging"
+	pkgreconciler "knative.dev/pkg/reconciler"
+)
+
+const (
+	// checkInterval is the interval between two checks of the workqueues of a deployment
+	checkInterval = time.Minute
+	// defaultStallTimeout is the time without progress after which a controller is restarted
+	defaultStallTimeout = 15 * time.Minute
+
+	// WedgedControllerRestartedReason is the reason of the event recorded on a deployment when one of its pods is restarted
+	WedgedControllerRestartedReason = "WedgedControllerRestarted"
+)
+
+// progress is the last progress of the workqueues of a pod
+type progress struct {
+	processed uint64
+	at        time.Time
+}
+
+// Reconciler restarts the pods of the operator-managed deployments which are Running but whose
+// workqueues have keys waiting and did not process any key for the stall timeout. The workqueues
+// are read from the knative metrics of the pods. A single pod of a deployment is restarted per
+// stall timeout, the restart is recorded in an event of the deployment.
+type Reconciler struct {
+	pkgreconciler.LeaderAwareFuncs
+
+	kubeClientSet     kubernetes.Interface
+	operatorClientSet versioned.Interface
+	// events are not limited, the restarts are limited to one per stall timeout and deployment
+	events *common.Events
+	scrape scrapeFunc
+	now    func() time.Time
+
+	mutex sync.Mutex
+	// progress of the workqueues of the pods by deployment
+	progress map[types.NamespacedName]map[types.UID]progress
+	// lastRestart is the time of the last restart by deployment
+	lastRestart map[types.NamespacedName]time.Time
+}
+
+var _ controller.Reconciler = (*Reconciler)(nil)
+
+// NewController watches the deployments owned by the installer sets
+func NewController(ctx context.Context, cmw configmap.Watcher) *controller.Impl {
+	logger := logging.FromContext(ctx)
+	r := newReconciler(kubeclient.Get(ctx), operatorclient.Get(ctx))
+	impl := controller.NewContext(ctx, r, controller.ControllerOptions{WorkQueueName: "Watchdog", Logger: logger})
+
+	informer := deploymentinformer.Get(ctx).Informer()
+	filter := controller.FilterController(&v1alpha1.TektonInstallerSet{})
+	if _, err := informer.AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: filter,
+		Handler:    controller.HandleAll(impl.Enqueue),
+	}); err != nil {
+		logger.Panicf("Couldn't register Deployment informer event handler: %w", err)
+	}
+
+	// the workqueues are checked by the leader, enqueue the deployments on promotion
+	r.PromoteFunc = func(bkt pkgreconciler.Bucket, enq func(pkgreconciler.Bucket, types.NamespacedName)) error {
+		for _, obj := range informer.GetStore().List() {
+			if o, ok := obj.(metav1.Object); ok && filter(obj) {
+				enq(bkt, types.NamespacedName{Namespace: o.GetNamespace(), Name: o.GetName()})
+			}
+		}
+		return nil
+	}
+	return impl
+}
+
+func newReconciler(kubeClientSet kubernetes.Interface, operatorClientSet versioned.Interface) *Reconciler {
+	return &Reconciler{
+		kubeClientSet:     kubeClientSet,
+		operatorClientSet: operatorClientSet,
+		events:            common.NewEvents(kubeClientSet, 0),
+		scrape:            scrapeMetrics,
+		now:               time.Now,
+		progress:          map[types.NamespacedName]map[types.UID]progress{},
+		lastRestart:       map[types.NamespacedName]time.Time{},
+	}
+}
+
+// Reconcile checks the workqueues of the pods of a deployment, and checks them again after the
+// check interval while the watchdog is enabled
+func (r *Reconciler) Reconcile(ctx context.Context, key string) error {
+	logger := logging.FromContext(ctx)
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		logger.Errorf("invalid resource key: %s", key)
+		return nil
+	}
+	deploymentKey := types.NamespacedName{Namespace: namespace, Name: name}
+	if !r.IsLeaderFor(deploymentKey) {
+		return nil
+	}
+
+	enabled, stallTimeout, err := r.config(ctx)
+	if err != nil {
+		return err
+	}
+	if !enabled {
+		r.forget(deploymentKey, nil)
+		return nil
+	}
+
+	d, err := r.kubeClientSet.AppsV1().Deployments(namespace).Get(ctx, name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		r.forget(deploymentKey, nil)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	selector, err := metav1.LabelSelectorAsSelector(d.Spec.Selector)
+	if err != nil {
+		return err
+	}
+	pods, err := r.kubeClientSet.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return err
+	}
+
+	current := map[types.UID]bool{}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		current[pod.UID] = true
+	}
+	r.forget(deploymentKey, current)
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.Status.Phase != corev1.PodRunning || pod.DeletionTimestamp != nil {
+			continue
+		}
+		wedged := r.check(ctx, deploymentKey, pod, stallTimeout)
+		if wedged == 0 {
+			continue
+		}
+		if err := r.restart(ctx, deploymentKey, pod, wedged, stallTimeout); err != nil {
+			return err
+		}
+	}
+	return controller.NewRequeueAfter(checkInterval)
+}
+
+// check returns how long the workqueues of the pod did not make progress while keys were waiting,
+// zero when they made progress within the stall timeout or when their state is unknown
+func (r *Reconciler) check(ctx context.Context, deployment types.NamespacedName, pod *corev1.Pod, stallTimeout time.Duration) time.Duration {
+	state, found, err := r.scrape(ctx, pod)
+	if err != nil {
+		// the metrics may not be reachable, e.g. when they are only served over TLS
+		logging.FromContext(ctx).Debugf("failed to read the metrics of pod %s/%s: %v", pod.Namespace, pod.Name, err)
+		return 0
+	}
+	if !found {
+		return 0
+	}
+
+	now := r.now()
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	pods := r.progress[deployment]
+	if pods == nil {
+		pods = map[types.UID]progress{}
+		r.progress[deployment] = pods
+	}
+	last, ok := pods[pod.UID]
+	if !ok || state.depth == 0 || state.processed != last.processed {
+		pods[pod.UID] = progress{processed: state.processed, at: now}
+		return 0
+	}
+	if stalled := now.Sub(last.at); stalled >= stallTimeout {
+		return stalled
+	}
+	return 0
+}
+
+// restart deletes the wedged pod unless a pod of the deployment was restarted within the stall timeout
+func (r *Reconciler) restart(ctx context.Context, deployment types.NamespacedName, pod *corev1.Pod, stalled, stallTimeout time.Duration) error {
+	logger := logging.FromContext(ctx)
+	now := r.now()
+	r.mutex.Lock()
+	last, restarted := r.lastRestart[deployment]
+	r.mutex.Unlock()
+	if restarted && now.Sub(last) < stallTimeout {
+		logger.Warnf("the workqueues of pod %s/%s made no progress for %s, a pod of deployment %s was already restarted at %s",
+			pod.Namespace, pod.Name, stalled.Round(time.Second), deployment.Name, last.Format(time.RFC3339))
+		return nil
+	}
+
+	logger.Warnf("the workqueues of pod %s/%s made no progress for %s, restarting it", pod.Namespace, pod.Name, stalled.Round(time.Second))
+	if err := r.kubeClientSet.CoreV1().Pods(pod.Namespace).Delete(ctx, pod.Name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to restart pod %s/%s: %w", pod.Namespace, pod.Name, err)
+	}
+	r.mutex.Lock()
+	r.lastRestart[deployment] = now
+	delete(r.progress[deployment], pod.UID)
+	r.mutex.Unlock()
+
+	err := r.events.Emit(ctx, common.Event{
+		Object: corev1.ObjectReference{
+			Kind:       "Deployment",
+			APIVersion: "apps/v1",
+			Name:       deployment.Name,
+			Namespace:  deployment.Namespace,
+		},
+		GenerateName:        deployment.Name + "-watchdog-",
+		Reason:              WedgedControllerRestartedReason,
+		Type:                corev1.EventTypeWarning,
+		Action:              "Restart",
+		Message:             fmt.Sprintf("Pod %s was restarted, its workqueues had keys waiting and made no progress for %s", pod.Name, stalled.Round(time.Second)),
+		ReportingController: "tekton-operator-watchdog",
+		ReportingInstance:   pod.Name,
+	})
+	if err != nil {
+		logger.Errorf("failed to record the restart of pod %s/%s: %v", pod.Namespace, pod.Name, err)
+	}
+	return nil
+}
+
+// forget drops the progress of the pods of the deployment which no longer exist, and the state of
+// the deployment when current is nil
+func (r *Reconciler) forget(deployment types.NamespacedName, current map[types.UID]bool) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if current == nil {
+		delete(r.progress, deployment)
+		delete(r.lastRestart, deployment)
+		return
+	}
+	for uid := range r.progress[deployment] {
+		if !current[uid] {
+			delete(r.progress[deployment], uid)
+		}
+	}
+}
+
+// config returns whether the watchdog is enabled and the stall timeout, from the params of TektonConfig
+func (r *Reconciler) config(ctx context.Context) (bool, time.Duration, error) {
+	tc, err := r.operatorClientSet.OperatorV1alpha1().TektonConfigs().Get(ctx, v1alpha1.ConfigResourceName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return false, 0, nil
+	}
+	if err != nil {
+		return false, 0, err
+	}
+	enabled := true
+	stallTimeout := defaultStallTimeout
+	for _, p := range tc.Spec.Params {
+		switch p.Name {
+		case v1alpha1.ControllerWatchdogParam:
+			enabled = p.Value != "false"
+		case v1alpha1.ControllerWatchdogStallTimeoutParam:
+			d, err := time.ParseDuration(p.Value)
+			if err != nil || d <= 0 {
+				logging.FromContext(ctx).Warnf("invalid value %q for param %s, using default %s", p.Value, p.Name, defaultStallTimeout)
+				continue
+			}
+			stallTimeout = d
+		}
+	}
+	return enabled, stallTimeout, nil
+}
@@ -0,0 +1,195 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package watchdog
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/client/clientset/versioned/fake"
+	"gotest.tools/v3/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"knative.dev/pkg/controller"
+	pkgreconciler "knative.dev/pkg/reconciler"
+)
+
+const testMetrics = `# TYPE tekton_pipelines_controller_workqueue_depth gauge
+tekton_pipelines_controller_workqueue_depth{name="TaskRun"} 3
+tekton_pipelines_controller_workqueue_depth{name="PipelineRun"} 2
+# TYPE tekton_pipelines_controller_workqueue_work_duration_seconds histogram
+tekton_pipelines_controller_workqueue_work_duration_seconds_bucket{name="TaskRun",le="+Inf"} 10
+tekton_pipelines_controller_workqueue_work_duration_seconds_sum{name="TaskRun"} 1.5
+tekton_pipelines_controller_workqueue_work_duration_seconds_count{name="TaskRun"} 10
+tekton_pipelines_controller_workqueue_work_duration_seconds_bucket{name="PipelineRun",le="+Inf"} 4
+tekton_pipelines_controller_workqueue_work_duration_seconds_sum{name="PipelineRun"} 0.5
+tekton_pipelines_controller_workqueue_work_duration_seconds_count{name="PipelineRun"} 4
+# TYPE go_goroutines gauge
+go_goroutines 42
+`
+
+func TestParseMetrics(t *testing.T) {
+	state, found, err := parseMetrics(strings.NewReader(testMetrics))
+	assert.NilError(t, err)
+	assert.Assert(t, found)
+	assert.Equal(t, state, workqueue{depth: 5, processed: 14})
+
+	_, found, err = parseMetrics(strings.NewReader("# TYPE go_goroutines gauge\ngo_goroutines 42\n"))
+	assert.NilError(t, err)
+	assert.Assert(t, !found)
+}
+
+func TestReconcile(t *testing.T) {
+	ctx := context.TODO()
+	labels := map[string]string{"app": "tekton-pipelines-controller"}
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "tekton-pipelines-controller", Namespace: "tekton-pipelines"},
+		Spec:       appsv1.DeploymentSpec{Selector: &metav1.LabelSelector{MatchLabels: labels}},
+	}
+	pod := func(name string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "tekton-pipelines", UID: types.UID(name), Labels: labels},
+			Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+		}
+	}
+	kubeClient := kubefake.NewSimpleClientset(deployment, pod("controller-a"), pod("controller-b"))
+	// the fake clientset does not generate the names
+	generated := 0
+	kubeClient.PrependReactor("create", "events", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		event := action.(k8stesting.CreateAction).GetObject().(*corev1.Event)
+		generated++
+		event.Name = fmt.Sprintf("%s%d", event.GenerateName, generated)
+		return false, nil, nil
+	})
+	tc := &v1alpha1.TektonConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.ConfigResourceName},
+		Spec: v1alpha1.TektonConfigSpec{Params: []v1alpha1.Param{
+			{Name: v1alpha1.ControllerWatchdogStallTimeoutParam, Value: "10m"},
+		}},
+	}
+	operatorClient := fake.NewSimpleClientset(tc)
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	states := map[string]workqueue{
+		"controller-a": {depth: 3, processed: 10},
+		"controller-b": {depth: 0, processed: 5},
+	}
+	r := newReconciler(kubeClient, operatorClient)
+	r.now = func() time.Time { return now }
+	r.scrape = func(_ context.Context, pod *corev1.Pod) (workqueue, bool, error) {
+		state, ok := states[pod.Name]
+		return state, ok, nil
+	}
+	assert.NilError(t, r.Promote(pkgreconciler.UniversalBucket(), func(pkgreconciler.Bucket, types.NamespacedName) {}))
+
+	key := "tekton-pipelines/tekton-pipelines-controller"
+	reconcile := func() {
+		t.Helper()
+		requeue, after := controller.IsRequeueKey(r.Reconcile(ctx, key))
+		assert.Assert(t, requeue)
+		assert.Equal(t, after, checkInterval)
+	}
+	podExists := func(name string) bool {
+		t.Helper()
+		_, err := kubeClient.CoreV1().Pods("tekton-pipelines").Get(ctx, name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return false
+		}
+		assert.NilError(t, err)
+		return true
+	}
+	events := func() []corev1.Event {
+		t.Helper()
+		list, err := kubeClient.CoreV1().Events("tekton-pipelines").List(ctx, metav1.ListOptions{})
+		assert.NilError(t, err)
+		return list.Items
+	}
+
+	reconcile()
+	// the workqueues are idle or made progress within the stall timeout
+	now = now.Add(5 * time.Minute)
+	states["controller-b"] = workqueue{depth: 1, processed: 6}
+	reconcile()
+	assert.Assert(t, podExists("controller-a"))
+	assert.Assert(t, podExists("controller-b"))
+	assert.Equal(t, len(events()), 0)
+
+	// controller-a made no progress for the stall timeout with keys waiting
+	now = now.Add(5 * time.Minute)
+	reconcile()
+	assert.Assert(t, !podExists("controller-a"))
+	assert.Assert(t, podExists("controller-b"))
+	list := events()
+	assert.Equal(t, len(list), 1)
+	assert.Equal(t, list[0].Reason, WedgedControllerRestartedReason)
+	assert.Equal(t, list[0].Type, corev1.EventTypeWarning)
+	assert.Equal(t, list[0].InvolvedObject.Name, "tekton-pipelines-controller")
+
+	// a single pod of the deployment is restarted per stall timeout
+	now = now.Add(5 * time.Minute)
+	reconcile()
+	assert.Assert(t, podExists("controller-b"))
+	now = now.Add(5 * time.Minute)
+	reconcile()
+	assert.Assert(t, !podExists("controller-b"))
+	assert.Equal(t, len(events()), 2)
+}
+
+func TestReconcileDisabled(t *testing.T) {
+	ctx := context.TODO()
+	labels := map[string]string{"app": "tekton-pipelines-controller"}
+	kubeClient := kubefake.NewSimpleClientset(
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "tekton-pipelines-controller", Namespace: "tekton-pipelines"},
+			Spec:       appsv1.DeploymentSpec{Selector: &metav1.LabelSelector{MatchLabels: labels}},
+		},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "controller", Namespace: "tekton-pipelines", UID: "controller", Labels: labels},
+			Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+		},
+	)
+	operatorClient := fake.NewSimpleClientset(&v1alpha1.TektonConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.ConfigResourceName},
+		Spec: v1alpha1.TektonConfigSpec{Params: []v1alpha1.Param{
+			{Name: v1alpha1.ControllerWatchdogParam, Value: "false"},
+		}},
+	})
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	r := newReconciler(kubeClient, operatorClient)
+	r.now = func() time.Time { return now }
+	r.scrape = func(context.Context, *corev1.Pod) (workqueue, bool, error) {
+		return workqueue{depth: 1, processed: 1}, true, nil
+	}
+	assert.NilError(t, r.Promote(pkgreconciler.UniversalBucket(), func(pkgreconciler.Bucket, types.NamespacedName) {}))
+
+	for range 3 {
+		assert.NilError(t, r.Reconcile(ctx, "tekton-pipelines/tekton-pipelines-controller"))
+		now = now.Add(time.Hour)
+	}
+	_, err := kubeClient.CoreV1().Pods("tekton-pipelines").Get(ctx, "controller", metav1.GetOptions{})
+	assert.NilError(t, err)
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package watchdog
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"github.com/prometheus/common/model"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// metricsPortName is the name of the metrics port of the knative controllers
+	metricsPortName = "metrics"
+	scrapeTimeout   = 5 * time.Second
+
+	// the workqueue metrics of knative, prefixed with the name of the component
+	workqueueDepthSuffix     = "_workqueue_depth"
+	workqueueProcessedSuffix = "_workqueue_work_duration_seconds"
+)
+
+// workqueue is the state of the workqueues of a controller
+type workqueue struct {
+	// depth is the number of keys waiting in the workqueues
+	depth float64
+	// processed is the number of keys processed by the workqueues
+	processed uint64
+}
+
+// scrapeFunc returns the state of the workqueues of a pod, found is false when the pod does
+// not export the workqueue metrics
+type scrapeFunc func(ctx context.Context, pod *corev1.Pod) (state workqueue, found bool, err error)
+
+var httpClient = &http.Client{Timeout: scrapeTimeout}
+
+// scrapeMetrics reads the workqueue metrics from the metrics port of the pod
+func scrapeMetrics(ctx context.Context, pod *corev1.Pod) (workqueue, bool, error) {
+	port := metricsPort(pod)
+	if port == 0 || pod.Status.PodIP == "" {
+		return workqueue{}, false, nil
+	}
+	url := fmt.Sprintf("http://%s/metrics", net.JoinHostPort(pod.Status.PodIP, strconv.Itoa(int(port))))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return workqueue{}, false, err
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return workqueue{}, false, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return workqueue{}, false, fmt.Errorf("unexpected status %d from %s", resp.StatusCode, url)
+	}
+	return parseMetrics(resp.Body)
+}
+
+func metricsPort(pod *corev1.Pod) int32 {
+	for _, c := range pod.Spec.Containers {
+		for _, p := range c.Ports {
+			if p.Name == metricsPortName {
+				return p.ContainerPort
+			}
+		}
+	}
+	return 0
+}
+
+// parseMetrics sums the depth and the processed keys of all the workqueues of the metrics
+func parseMetrics(in io.Reader) (workqueue, bool, error) {
+	parser := expfmt.NewTextParser(model.UTF8Validation)
+	families, err := parser.TextToMetricFamilies(in)
+	if err != nil {
+		return workqueue{}, false, err
+	}
+	state := workqueue{}
+	found := false
+	for name, family := range families {
+		switch {
+		case strings.HasSuffix(name, workqueueDepthSuffix):
+			found = true
+			for _, m := range family.GetMetric() {
+				state.depth += value(m)
+			}
+		case strings.HasSuffix(name, workqueueProcessedSuffix):
+			found = true
+			for _, m := range family.GetMetric() {
+				state.processed += m.GetHistogram().GetSampleCount()
+			}
+		}
+	}
+	return state, found, nil
+}
+
+func value(m *dto.Metric) float64 {
+	switch {
+	case m.GetGauge() != nil:
+		return m.GetGauge().GetValue()
+	case m.GetCounter() != nil:
+		return m.GetCounter().GetValue()
+	default:
+		return m.GetUntyped().GetValue()
+	}
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/logging"
+)
+
+// DeletionBlockedReason is the reason of the events recorded when a deletion of TektonConfig is blocked
+const DeletionBlockedReason = "DeletionBlocked"
+
+// deletionProtection returns the callback blocking the deletions of TektonConfig not confirmed
+// when its deletion protection is enabled, each blocked attempt is recorded in a warning event
+func deletionProtection(kubeClientSet kubernetes.Interface) func(context.Context, *unstructured.Unstructured) error {
+	return func(ctx context.Context, u *unstructured.Unstructured) error {
+		tc := &v1alpha1.TektonConfig{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, tc); err != nil {
+			return err
+		}
+		if !tc.DeletionBlocked() {
+			return nil
+		}
+
+		user := "unknown"
+		if info := apis.GetUserInfo(ctx); info != nil {
+			user = info.Username
+		}
+		msg := fmt.Sprintf("deletion of TektonConfig %s by %s blocked, the deletion protection is enabled", tc.Name, user)
+		logging.FromContext(ctx).Warn(msg)
+		if err := recordDeletionBlocked(ctx, kubeClientSet, tc, msg); err != nil {
+			logging.FromContext(ctx).Errorf("failed to record the blocked deletion of TektonConfig %s: %v", tc.Name, err)
+		}
+		return fmt.Errorf("TektonConfig %s is protected from deletion, annotate it with %s=true or set spec.deletionProtection to %s to delete it",
+			tc.Name, v1alpha1.DeletionConfirmationAnnotation, v1alpha1.DeletionProtectionDisabled)
+	}
+}
+
+// recordDeletionBlocked records a warning event on TektonConfig, in the default namespace as
+// TektonConfig is cluster scoped, a repeated attempt increments the count of the event
+func recordDeletionBlocked(ctx context.Context, kubeClientSet kubernetes.Interface, tc *v1alpha1.TektonConfig, msg string) error {
+	return common.NewEvents(kubeClientSet, 0).Emit(ctx, common.Event{
+		Object: corev1.ObjectReference{
+			APIVersion: v1alpha1.SchemeGroupVersion.String(),
+			Kind:       v1alpha1.KindTektonConfig,
+			Name:       tc.Name,
+			UID:        tc.UID,
+		},
+		Namespace:           metav1.NamespaceDefault,
+		Reason:              DeletionBlockedReason,
+		Message:             msg,
+		Type:                corev1.EventTypeWarning,
+		ReportingController: "tekton-operator-webhook",
+	})
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"gotest.tools/v3/assert"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	"knative.dev/pkg/apis"
+)
+
+func tektonConfig(t *testing.T, protection string, annotations map[string]string) *unstructured.Unstructured {
+	t.Helper()
+	tc := &v1alpha1.TektonConfig{
+		TypeMeta:   metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: v1alpha1.KindTektonConfig},
+		ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.ConfigResourceName, Annotations: annotations},
+		Spec:       v1alpha1.TektonConfigSpec{DeletionProtection: protection},
+	}
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(tc)
+	assert.NilError(t, err)
+	return &unstructured.Unstructured{Object: obj}
+}
+
+func TestDeletionProtection(t *testing.T) {
+	kubeClient := fake.NewSimpleClientset()
+	callback := deletionProtection(kubeClient)
+	ctx := apis.WithUserInfo(context.TODO(), &authenticationv1.UserInfo{Username: "alice"})
+
+	err := callback(ctx, tektonConfig(t, v1alpha1.DeletionProtectionEnabled, nil))
+	assert.ErrorContains(t, err, "TektonConfig config is protected from deletion")
+	events, err := kubeClient.CoreV1().Events(metav1.NamespaceDefault).List(ctx, metav1.ListOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, len(events.Items), 1)
+	assert.Equal(t, events.Items[0].Reason, DeletionBlockedReason)
+	assert.Equal(t, events.Items[0].Type, corev1.EventTypeWarning)
+	assert.Equal(t, events.Items[0].InvolvedObject.Name, v1alpha1.ConfigResourceName)
+	assert.Equal(t, events.Items[0].Message, "deletion of TektonConfig config by alice blocked, the deletion protection is enabled")
+
+	// the deletions are allowed when confirmed or when the protection is not enabled
+	for _, u := range []*unstructured.Unstructured{
+		tektonConfig(t, v1alpha1.DeletionProtectionEnabled, map[string]string{v1alpha1.DeletionConfirmationAnnotation: "true"}),
+		tektonConfig(t, v1alpha1.DeletionProtectionDisabled, nil),
+		tektonConfig(t, "", nil),
+	} {
+		assert.NilError(t, callback(ctx, u))
+	}
+}
@@ -0,0 +1,245 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	security "github.com/openshift/client-go/security/clientset/versioned"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	clientset "github.com/tektoncd/operator/pkg/client/clientset/versioned"
+	operatorclient "github.com/tektoncd/operator/pkg/client/injection/client"
+	"github.com/tektoncd/operator/pkg/common"
+	"github.com/tektoncd/operator/pkg/reconciler/openshift"
+	"go.uber.org/zap"
+	admissionv1 "k8s.io/api/admission/v1"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ktypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	admissionlisters "k8s.io/client-go/listers/admissionregistration/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	kubeclient "knative.dev/pkg/client/injection/kube/client"
+	vwhinformer "knative.dev/pkg/client/injection/kube/informers/admissionregistration/v1/validatingwebhookconfiguration"
+	"knative.dev/pkg/configmap"
+	"knative.dev/pkg/controller"
+	secretinformer "knative.dev/pkg/injection/clients/namespacedkube/informers/core/v1/secret"
+	"knative.dev/pkg/kmp"
+	"knative.dev/pkg/logging"
+	"knative.dev/pkg/ptr"
+	pkgreconciler "knative.dev/pkg/reconciler"
+	"knative.dev/pkg/system"
+	"knative.dev/pkg/webhook"
+	certresources "knative.dev/pkg/webhook/certificates/resources"
+)
+
+const (
+	// namespaceSCCWebhookName is the name of the ValidatingWebhookConfiguration validating the
+	// SCC annotation of the namespaces
+	namespaceSCCWebhookName = "namespace.webhook.operator.tekton.dev"
+	namespaceSCCWebhookPath = "/namespace-scc-validation"
+)
+
+// namespaceSCCAdmission validates the SCC requested by the namespace annotation at admission,
+// instead of reporting it in an event once the namespace is reconciled
+type namespaceSCCAdmission struct {
+	webhook.StatelessAdmissionImpl
+	pkgreconciler.LeaderAwareFuncs
+
+	key  ktypes.NamespacedName
+	path string
+
+	client            kubernetes.Interface
+	operatorClientSet clientset.Interface
+	securityClientSet security.Interface
+	vwhlister         admissionlisters.ValidatingWebhookConfigurationLister
+	secretlister      corelisters.SecretLister
+
+	secretName string
+}
+
+var (
+	_ controller.Reconciler                = (*namespaceSCCAdmission)(nil)
+	_ pkgreconciler.LeaderAware            = (*namespaceSCCAdmission)(nil)
+	_ webhook.AdmissionController          = (*namespaceSCCAdmission)(nil)
+	_ webhook.StatelessAdmissionController = (*namespaceSCCAdmission)(nil)
+)
+
+// NewNamespaceSCCAdmissionController constructs the admission controller validating the SCC
+// annotation of the namespaces, it is only run on OpenShift
+func NewNamespaceSCCAdmissionController(ctx context.Context, cmw configmap.Watcher) *controller.Impl {
+	vwhInformer := vwhinformer.Get(ctx)
+	secretInformer := secretinformer.Get(ctx)
+	key := ktypes.NamespacedName{Name: namespaceSCCWebhookName}
+
+	ac := &namespaceSCCAdmission{
+		LeaderAwareFuncs: pkgreconciler.LeaderAwareFuncs{
+			PromoteFunc: func(bkt pkgreconciler.Bucket, enq func(pkgreconciler.Bucket, ktypes.NamespacedName)) error {
+				enq(bkt, key)
+				return nil
+			},
+		},
+		key:               key,
+		path:              namespaceSCCWebhookPath,
+		client:            kubeclient.Get(ctx),
+		operatorClientSet: operatorclient.Get(ctx),
+		securityClientSet: common.GetSecurityClient(ctx),
+		vwhlister:         vwhInformer.Lister(),
+		secretlister:      secretInformer.Lister(),
+		secretName:        webhook.GetOptions(ctx).SecretName,
+	}
+
+	const queueName = "NamespaceSCCWebhook"
+	c := controller.NewContext(ctx, ac, controller.ControllerOptions{WorkQueueName: queueName, Logger: logging.FromContext(ctx).Named(queueName)})
+
+	// the webhook is reconciled when its configuration or the cert bundle changes
+	vwhInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: controller.FilterWithName(namespaceSCCWebhookName),
+		Handler:    controller.HandleAll(c.Enqueue),
+	})
+	secretInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: controller.FilterWithNameAndNamespace(system.Namespace(), ac.secretName),
+		Handler:    controller.HandleAll(c.Enqueue),
+	})
+	return c
+}
+
+// Reconcile implements controller.Reconciler, it sets the rules, path and CA bundle of the webhook
+func (ac *namespaceSCCAdmission) Reconcile(ctx context.Context, key string) error {
+	if !ac.IsLeaderFor(ac.key) {
+		return controller.NewSkipKey(key)
+	}
+
+	secret, err := ac.secretlister.Secrets(system.Namespace()).Get(ac.secretName)
+	if err != nil {
+		logging.FromContext(ctx).Errorw("Error fetching secret ", zap.Error(err))
+		return err
+	}
+	caCert, ok := secret.Data[certresources.CACert]
+	if !ok {
+		return fmt.Errorf("secret %q is missing %q key", ac.secretName, certresources.CACert)
+	}
+
+	configured, err := ac.vwhlister.Get(ac.key.Name)
+	if err != nil {
+		return fmt.Errorf("error retrieving webhook: %w", err)
+	}
+	desired := configured.DeepCopy()
+	scope := admissionregistrationv1.ClusterScope
+	for i, wh := range desired.Webhooks {
+		if wh.Name != desired.Name {
+			continue
+		}
+		desired.Webhooks[i].Rules = []admissionregistrationv1.RuleWithOperations{{
+			Operations: []admissionregistrationv1.OperationType{admissionregistrationv1.Create, admissionregistrationv1.Update},
+			Rule: admissionregistrationv1.Rule{
+				APIGroups:   []string{""},
+				APIVersions: []string{"v1"},
+				Resources:   []string{"namespaces"},
+				Scope:       &scope,
+			},
+		}}
+		desired.Webhooks[i].ClientConfig.CABundle = caCert
+		if desired.Webhooks[i].ClientConfig.Service == nil {
+			return errors.New("missing service reference for webhook: " + wh.Name)
+		}
+		desired.Webhooks[i].ClientConfig.Service.Path = ptr.String(ac.Path())
+	}
+
+	if ok, err := kmp.SafeEqual(configured, desired); err != nil {
+		return fmt.Errorf("error diffing webhooks: %w", err)
+	} else if ok {
+		return nil
+	}
+	logging.FromContext(ctx).Info("Updating webhook")
+	if _, err := ac.client.AdmissionregistrationV1().ValidatingWebhookConfigurations().Update(ctx, desired, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("failed to update webhook: %w", err)
+	}
+	return nil
+}
+
+// Path implements AdmissionController
+func (ac *namespaceSCCAdmission) Path() string {
+	return ac.path
+}
+
+// Admit implements AdmissionController
+func (ac *namespaceSCCAdmission) Admit(ctx context.Context, request *admissionv1.AdmissionRequest) *admissionv1.AdmissionResponse {
+	switch request.Operation {
+	case admissionv1.Create, admissionv1.Update:
+	default:
+		return &admissionv1.AdmissionResponse{Allowed: true}
+	}
+
+	ns, old := &corev1.Namespace{}, &corev1.Namespace{}
+	if err := json.Unmarshal(request.Object.Raw, ns); err != nil {
+		return webhook.MakeErrorStatus("cannot decode incoming new object: %v", err)
+	}
+	if len(request.OldObject.Raw) != 0 {
+		if err := json.Unmarshal(request.OldObject.Raw, old); err != nil {
+			return webhook.MakeErrorStatus("cannot decode incoming old object: %v", err)
+		}
+	}
+	if err := ac.validate(ctx, ns, old); err != nil {
+		return webhook.MakeErrorStatus("validation failed: %v", err)
+	}
+	return &admissionv1.AdmissionResponse{Allowed: true}
+}
+
+// validate rejects a namespace requesting an SCC which does not exist, or which is less
+// restrictive than the maxAllowed SCC of TektonConfig. The annotation is only validated when
+// it changes, so that the deletion of an SCC does not block the unrelated updates.
+func (ac *namespaceSCCAdmission) validate(ctx context.Context, ns, old *corev1.Namespace) error {
+	nsSCC := ns.Annotations[openshift.NamespaceSCCAnnotation]
+	if nsSCC == "" || nsSCC == old.Annotations[openshift.NamespaceSCCAnnotation] {
+		return nil
+	}
+
+	if err := common.VerifySCCExists(ctx, nsSCC, ac.securityClientSet); err != nil {
+		return fmt.Errorf("namespace: %s has requested SCC: %s, which does not exist: %w", ns.Name, nsSCC, err)
+	}
+
+	tc, err := ac.operatorClientSet.OperatorV1alpha1().TektonConfigs().Get(ctx, v1alpha1.ConfigResourceName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	scc := tc.Spec.Platforms.OpenShift.SCC
+	if scc == nil || scc.MaxAllowed == "" {
+		return nil
+	}
+	prioritizedSCCList, err := common.GetSCCRestrictiveList(ctx, ac.securityClientSet)
+	if err != nil {
+		return err
+	}
+	isPriority, err := common.SCCAMoreRestrictiveThanB(prioritizedSCCList, nsSCC, scc.MaxAllowed)
+	if err != nil {
+		return err
+	}
+	if !isPriority {
+		return fmt.Errorf("namespace: %s has requested SCC: %s, but it is less restrictive than the 'maxAllowed' SCC: %s", ns.Name, nsSCC, scc.MaxAllowed)
+	}
+	return nil
+}
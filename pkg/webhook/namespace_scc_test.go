@@ -0,0 +1,126 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	securityv1 "github.com/openshift/api/security/v1"
+	fakesecurity "github.com/openshift/client-go/security/clientset/versioned/fake"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	fakeoperator "github.com/tektoncd/operator/pkg/client/clientset/versioned/fake"
+	"github.com/tektoncd/operator/pkg/reconciler/openshift"
+	"gotest.tools/v3/assert"
+	is "gotest.tools/v3/assert/cmp"
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func namespaceWithSCC(name, scc string) *corev1.Namespace {
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	if scc != "" {
+		ns.Annotations = map[string]string{openshift.NamespaceSCCAnnotation: scc}
+	}
+	return ns
+}
+
+func TestNamespaceSCCAdmission(t *testing.T) {
+	securityClient := fakesecurity.NewSimpleClientset()
+	for _, scc := range []*securityv1.SecurityContextConstraints{
+		{
+			ObjectMeta:               metav1.ObjectMeta{Name: "restricted"},
+			RunAsUser:                securityv1.RunAsUserStrategyOptions{Type: securityv1.RunAsUserStrategyMustRunAsRange},
+			SELinuxContext:           securityv1.SELinuxContextStrategyOptions{Type: securityv1.SELinuxStrategyMustRunAs},
+			RequiredDropCapabilities: []corev1.Capability{"ALL"},
+		},
+		{
+			ObjectMeta:               metav1.ObjectMeta{Name: "privileged"},
+			AllowPrivilegedContainer: true,
+			RunAsUser:                securityv1.RunAsUserStrategyOptions{Type: securityv1.RunAsUserStrategyRunAsAny},
+			SELinuxContext:           securityv1.SELinuxContextStrategyOptions{Type: securityv1.SELinuxStrategyRunAsAny},
+		},
+	} {
+		_, err := securityClient.SecurityV1().SecurityContextConstraints().Create(context.TODO(), scc, metav1.CreateOptions{})
+		assert.NilError(t, err)
+	}
+	tc := &v1alpha1.TektonConfig{ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.ConfigResourceName}}
+	tc.Spec.Platforms.OpenShift.SCC = &v1alpha1.SCC{Default: "restricted", MaxAllowed: "restricted"}
+	ac := &namespaceSCCAdmission{
+		operatorClientSet: fakeoperator.NewSimpleClientset(tc),
+		securityClientSet: securityClient,
+	}
+
+	tests := []struct {
+		name    string
+		ns      *corev1.Namespace
+		old     *corev1.Namespace
+		wantErr string
+	}{
+		{name: "no SCC requested", ns: namespaceWithSCC("foo", "")},
+		{name: "SCC within maxAllowed", ns: namespaceWithSCC("foo", "restricted")},
+		{
+			name:    "missing SCC",
+			ns:      namespaceWithSCC("foo", "missing"),
+			wantErr: "namespace: foo has requested SCC: missing, which does not exist",
+		},
+		{
+			name:    "SCC less restrictive than maxAllowed",
+			ns:      namespaceWithSCC("foo", "privileged"),
+			wantErr: "namespace: foo has requested SCC: privileged, but it is less restrictive than the 'maxAllowed' SCC: restricted",
+		},
+		{
+			name: "unchanged SCC annotation",
+			ns:   namespaceWithSCC("foo", "missing"),
+			old:  namespaceWithSCC("foo", "missing"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := &admissionv1.AdmissionRequest{Operation: admissionv1.Create, Object: runtime.RawExtension{Raw: marshal(t, tt.ns)}}
+			if tt.old != nil {
+				req.Operation = admissionv1.Update
+				req.OldObject = runtime.RawExtension{Raw: marshal(t, tt.old)}
+			}
+			resp := ac.Admit(context.TODO(), req)
+			if tt.wantErr == "" {
+				assert.Assert(t, resp.Allowed)
+				return
+			}
+			assert.Assert(t, !resp.Allowed)
+			assert.Assert(t, is.Contains(resp.Result.Message, tt.wantErr))
+		})
+	}
+
+	// without maxAllowed, any existing SCC is admitted
+	ac.operatorClientSet = fakeoperator.NewSimpleClientset()
+	resp := ac.Admit(context.TODO(), &admissionv1.AdmissionRequest{
+		Operation: admissionv1.Create,
+		Object:    runtime.RawExtension{Raw: marshal(t, namespaceWithSCC("foo", "privileged"))},
+	})
+	assert.Assert(t, resp.Allowed)
+}
+
+func marshal(t *testing.T, obj interface{}) []byte {
+	t.Helper()
+	b, err := json.Marshal(obj)
+	assert.NilError(t, err)
+	return b
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"reflect"
+
+	security "github.com/openshift/client-go/security/clientset/versioned"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/common"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"knative.dev/pkg/apis"
+)
+
+// sccValidation rejects a TektonConfig whose default SCC does not exist, or whose maxAllowed SCC
+// does not exist or is more restrictive than the default SCC. An update is only rejected when
+// it changes the SCCs, so that the deletion of an SCC does not block the unrelated updates.
+func sccValidation(securityClientSet security.Interface) func(context.Context, *unstructured.Unstructured) error {
+	return func(ctx context.Context, u *unstructured.Unstructured) error {
+		tc := &v1alpha1.TektonConfig{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, tc); err != nil {
+			return err
+		}
+		scc := tc.Spec.Platforms.OpenShift.SCC
+		if scc == nil || scc.Default == "" {
+			// the default SCC is set by the defaulting webhook
+			return nil
+		}
+		if old, ok := apis.GetBaseline(ctx).(*v1alpha1.TektonConfig); ok && apis.IsInUpdate(ctx) &&
+			reflect.DeepEqual(old.Spec.Platforms.OpenShift.SCC, scc) {
+			return nil
+		}
+		return common.ValidateSCCConfig(ctx, securityClientSet, scc.Default, scc.MaxAllowed)
+	}
+}
+
+// tektonConfigCallback runs the deletion protection on the deletion of TektonConfig, and the
+// SCC validation on its creation and update when the security client is set
+func tektonConfigCallback(onDelete, onApply func(context.Context, *unstructured.Unstructured) error) func(context.Context, *unstructured.Unstructured) error {
+	return func(ctx context.Context, u *unstructured.Unstructured) error {
+		if apis.IsInDelete(ctx) {
+			return onDelete(ctx, u)
+		}
+		if onApply == nil {
+			return nil
+		}
+		return onApply(ctx, u)
+	}
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"testing"
+
+	securityv1 "github.com/openshift/api/security/v1"
+	fakesecurity "github.com/openshift/client-go/security/clientset/versioned/fake"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	"knative.dev/pkg/apis"
+)
+
+func tektonConfigWithSCC(t *testing.T, defaultSCC, maxAllowed string) (*v1alpha1.TektonConfig, *unstructured.Unstructured) {
+	t.Helper()
+	tc := &v1alpha1.TektonConfig{
+		TypeMeta:   metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: v1alpha1.KindTektonConfig},
+		ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.ConfigResourceName},
+	}
+	tc.Spec.Platforms.OpenShift.SCC = &v1alpha1.SCC{Default: defaultSCC, MaxAllowed: maxAllowed}
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(tc)
+	assert.NilError(t, err)
+	return tc, &unstructured.Unstructured{Object: obj}
+}
+
+func TestSCCValidation(t *testing.T) {
+	ctx := context.TODO()
+	securityClient := fakesecurity.NewSimpleClientset()
+	for _, scc := range []*securityv1.SecurityContextConstraints{
+		{
+			ObjectMeta:               metav1.ObjectMeta{Name: "restricted"},
+			RunAsUser:                securityv1.RunAsUserStrategyOptions{Type: securityv1.RunAsUserStrategyMustRunAsRange},
+			SELinuxContext:           securityv1.SELinuxContextStrategyOptions{Type: securityv1.SELinuxStrategyMustRunAs},
+			RequiredDropCapabilities: []corev1.Capability{"ALL"},
+		},
+		{
+			ObjectMeta:               metav1.ObjectMeta{Name: "privileged"},
+			AllowPrivilegedContainer: true,
+			RunAsUser:                securityv1.RunAsUserStrategyOptions{Type: securityv1.RunAsUserStrategyRunAsAny},
+			SELinuxContext:           securityv1.SELinuxContextStrategyOptions{Type: securityv1.SELinuxStrategyRunAsAny},
+		},
+	} {
+		_, err := securityClient.SecurityV1().SecurityContextConstraints().Create(ctx, scc, metav1.CreateOptions{})
+		assert.NilError(t, err)
+	}
+	validate := sccValidation(securityClient)
+
+	tests := []struct {
+		name       string
+		defaultSCC string
+		maxAllowed string
+		wantErr    string
+	}{
+		{name: "default SCC only", defaultSCC: "restricted"},
+		{name: "maxAllowed less restrictive than the default", defaultSCC: "restricted", maxAllowed: "privileged"},
+		{name: "default SCC set by the defaulting webhook"},
+		{name: "missing default SCC", defaultSCC: "missing", wantErr: "failed to verify scc missing exists"},
+		{name: "missing maxAllowed SCC", defaultSCC: "restricted", maxAllowed: "missing", wantErr: "failed to verify scc missing exists"},
+		{
+			name:       "maxAllowed more restrictive than the default",
+			defaultSCC: "privileged",
+			maxAllowed: "restricted",
+			wantErr:    "maxAllowed SCC: restricted must be less restrictive than the default SCC: privileged",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, u := tektonConfigWithSCC(t, tt.defaultSCC, tt.maxAllowed)
+			err := validate(apis.WithinCreate(ctx), u)
+			if tt.wantErr == "" {
+				assert.NilError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+
+	// an update keeping the SCCs is not rejected once an SCC was deleted
+	old, u := tektonConfigWithSCC(t, "missing", "")
+	assert.NilError(t, validate(apis.WithinUpdate(ctx, old), u))
+	old, _ = tektonConfigWithSCC(t, "restricted", "")
+	assert.ErrorContains(t, validate(apis.WithinUpdate(ctx, old), u), "failed to verify scc missing exists")
+}
+
+func TestTektonConfigCallback(t *testing.T) {
+	ctx := context.TODO()
+	_, u := tektonConfigWithSCC(t, "missing", "")
+	callback := tektonConfigCallback(deletionProtection(fake.NewSimpleClientset()), sccValidation(fakesecurity.NewSimpleClientset()))
+
+	// the SCCs are not validated on deletion
+	assert.NilError(t, callback(apis.WithinDelete(ctx), u))
+	assert.ErrorContains(t, callback(apis.WithinCreate(ctx), u), "failed to verify scc missing exists")
+
+	// the SCCs are not validated on Kubernetes
+	callback = tektonConfigCallback(deletionProtection(fake.NewSimpleClientset()), nil)
+	assert.NilError(t, callback(apis.WithinCreate(ctx), u))
+}
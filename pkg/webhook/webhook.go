@@ -20,10 +20,14 @@ import (
 	"context"
 
 	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/common"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	kubeclient "knative.dev/pkg/client/injection/kube/client"
 	"knative.dev/pkg/configmap"
 	"knative.dev/pkg/controller"
 	"knative.dev/pkg/logging"
+	"knative.dev/pkg/webhook"
 	"knative.dev/pkg/webhook/configmaps"
 	"knative.dev/pkg/webhook/resourcesemantics"
 	"knative.dev/pkg/webhook/resourcesemantics/defaulting"
@@ -40,7 +44,11 @@ var types = map[schema.GroupVersionKind]resourcesemantics.GenericCRD{
 	v1alpha1.SchemeGroupVersion.WithKind(v1alpha1.KindTektonPruner):   &v1alpha1.TektonPruner{},
 }
 
-func SetTypes(platform string) {
+// platform is the platform the webhook runs on, set by SetTypes
+var platform string
+
+func SetTypes(p string) {
+	platform = p
 	if platform == "openshift" {
 		types[v1alpha1.SchemeGroupVersion.WithKind(v1alpha1.KindTektonAddon)] = &v1alpha1.TektonAddon{}
 		types[v1alpha1.SchemeGroupVersion.WithKind(v1alpha1.KindOpenShiftPipelinesAsCode)] = &v1alpha1.OpenShiftPipelinesAsCode{}
@@ -71,6 +79,11 @@ func NewDefaultingAdmissionController(ctx context.Context, cmw configmap.Watcher
 }
 
 func NewValidationAdmissionController(ctx context.Context, cmw configmap.Watcher) *controller.Impl {
+	// the SCCs of TektonConfig are validated on OpenShift
+	var onApply func(context.Context, *unstructured.Unstructured) error
+	if platform == "openshift" {
+		onApply = sccValidation(common.GetSecurityClient(ctx))
+	}
 	return validation.NewAdmissionController(ctx,
 
 		// Name of the resource webhook.
@@ -89,6 +102,12 @@ func NewValidationAdmissionController(ctx context.Context, cmw configmap.Watcher
 
 		// Whether to disallow unknown fields.
 		true,
+
+		// Callbacks run on the admission of the resources.
+		map[schema.GroupVersionKind]validation.Callback{
+			v1alpha1.SchemeGroupVersion.WithKind(v1alpha1.KindTektonConfig): validation.NewCallback(
+				tektonConfigCallback(deletionProtection(kubeclient.Get(ctx)), onApply), webhook.Create, webhook.Update, webhook.Delete),
+		},
 	)
 }
 